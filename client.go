@@ -13,6 +13,7 @@ import (
 
 	"code.cloudfoundry.org/bbs/events"
 	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/trace"
 	"code.cloudfoundry.org/cfhttp"
 	"code.cloudfoundry.org/lager"
 	"github.com/gogo/protobuf/proto"
@@ -22,8 +23,10 @@ import (
 
 const (
 	ContentTypeHeader    = "Content-Type"
+	AcceptHeader         = "Accept"
 	XCfRouterErrorHeader = "X-Cf-Routererror"
 	ProtoContentType     = "application/x-protobuf"
+	JSONContentType      = "application/json"
 	KeepContainer        = true
 	DeleteContainer      = false
 )
@@ -74,6 +77,33 @@ type Client interface {
 
 	// Lists all Cells
 	Cells(logger lager.Logger) ([]*models.CellPresence, error)
+
+	// Lists the calls changing LRPs, tasks and domains recorded in the audit
+	// log that match filter, oldest first
+	AuditEvents(logger lager.Logger, filter models.AuditEventFilter) ([]*models.AuditEvent, error)
+
+	// Lists the LRP and task rows moved to quarantine because they could not
+	// be decoded, in the given table or in every table when it is empty
+	QuarantinedRecords(logger lager.Logger, tableName string) ([]*models.QuarantinedRecord, error)
+
+	// Returns the quarantined row with the given id
+	QuarantinedRecord(logger lager.Logger, id int64) (*models.QuarantinedRecord, error)
+
+	// Puts the quarantined row with the given id back into its table
+	RestoreQuarantinedRecord(logger lager.Logger, id int64) error
+
+	// Puts the quarantined rows of the given table, or of every table when it
+	// is empty, back into their tables, returning the ids of the records that
+	// were restored and of those that stay in quarantine
+	RestoreQuarantinedRecords(logger lager.Logger, tableName string) ([]int64, []int64, error)
+
+	// Returns the label of the key the records were last fully re-encrypted
+	// with and the progress of re-encrypting every table
+	EncryptionProgress(logger lager.Logger) (string, []*models.EncryptionTableProgress, error)
+
+	// Returns whether no record, including the quarantined ones, is encrypted
+	// with the given key anymore, and how many still are
+	VerifyEncryptionKeyRetired(logger lager.Logger, keyLabel string) (bool, int64, error)
 }
 
 /*
@@ -86,6 +116,10 @@ type ExternalTaskClient interface {
 	// Creates a Task from the given TaskDefinition
 	DesireTask(logger lager.Logger, guid, domain string, def *models.TaskDefinition) error
 
+	// Creates a Task for each of the given requests, returning the outcome of
+	// each request in the same order
+	DesireTasks(logger lager.Logger, requests []*models.DesireTaskRequest) ([]*models.TaskLifecycleResult, error)
+
 	// Lists all Tasks
 	Tasks(logger lager.Logger) ([]*models.Task, error)
 
@@ -98,17 +132,28 @@ type ExternalTaskClient interface {
 	// Lists all Tasks on the given cell
 	TasksByCellID(logger lager.Logger, cellId string) ([]*models.Task, error)
 
+	// Lists the Tasks that match filter, filter.PageSize at a time
+	TaskPages(logger lager.Logger, filter models.TaskFilter) TaskIterator
+
 	// Returns the Task with the given guid
 	TaskByGuid(logger lager.Logger, guid string) (*models.Task, error)
 
 	// Cancels the Task with the given task guid
 	CancelTask(logger lager.Logger, taskGuid string) error
 
+	// Cancels the Tasks with the given task guids, returning the outcome for
+	// each task guid in the same order
+	CancelTasks(logger lager.Logger, taskGuids []string) ([]*models.TaskLifecycleResult, error)
+
 	// Resolves a Task with the given guid
 	ResolvingTask(logger lager.Logger, taskGuid string) error
 
 	// Deletes a completed task with the given guid
 	DeleteTask(logger lager.Logger, taskGuid string) error
+
+	// Deletes the completed tasks with the given guids, returning the outcome
+	// for each task guid in the same order
+	DeleteTasks(logger lager.Logger, taskGuids []string) ([]*models.TaskLifecycleResult, error)
 }
 
 /*
@@ -129,6 +174,9 @@ type ExternalActualLRPClient interface {
 	// Returns all ActualLRPGroups matching the given ActualLRPFilter
 	ActualLRPGroups(lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRPGroup, error)
 
+	// Lists the ActualLRPGroups matching the given ActualLRPFilter, filter.PageSize at a time
+	ActualLRPGroupPages(lager.Logger, models.ActualLRPFilter) ActualLRPGroupIterator
+
 	// Returns all ActualLRPGroups that have the given process guid
 	ActualLRPGroupsByProcessGuid(logger lager.Logger, processGuid string) ([]*models.ActualLRPGroup, error)
 
@@ -146,6 +194,9 @@ type ExternalDesiredLRPClient interface {
 	// Lists all DesiredLRPs that match the given DesiredLRPFilter
 	DesiredLRPs(lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, error)
 
+	// Lists the DesiredLRPs that match the given DesiredLRPFilter, filter.PageSize at a time
+	DesiredLRPPages(lager.Logger, models.DesiredLRPFilter) DesiredLRPIterator
+
 	// Returns the DesiredLRP with the given process guid
 	DesiredLRPByProcessGuid(logger lager.Logger, processGuid string) (*models.DesiredLRP, error)
 
@@ -155,11 +206,53 @@ type ExternalDesiredLRPClient interface {
 	// Creates the given DesiredLRP and its corresponding ActualLRPs
 	DesireLRP(lager.Logger, *models.DesiredLRP) error
 
+	// Creates each of the given DesiredLRPs and their corresponding ActualLRPs,
+	// returning the outcome for each DesiredLRP in the same order
+	DesireLRPs(lager.Logger, []*models.DesiredLRP) ([]*models.DesiredLRPLifecycleResult, error)
+
 	// Updates the DesiredLRP matching the given process guid
 	UpdateDesiredLRP(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) error
 
+	// Updates the DesiredLRP matching the given process guid if its ModificationTag
+	// still matches expectedTag, returning a ResourceConflict error otherwise
+	UpdateDesiredLRPWithModificationTag(logger lager.Logger, processGuid string, expectedTag models.ModificationTag, update *models.DesiredLRPUpdate) error
+
 	// Removes the DesiredLRP matching the given process guid
 	RemoveDesiredLRP(logger lager.Logger, processGuid string) error
+
+	// Removes the DesiredLRP matching the given process guid if its ModificationTag
+	// still matches expectedTag, returning a ResourceConflict error otherwise
+	RemoveDesiredLRPWithModificationTag(logger lager.Logger, processGuid string, expectedTag models.ModificationTag) error
+
+	// Removes the DesiredLRPs matching each of the given process guids,
+	// returning the outcome for each process guid in the same order
+	RemoveDesiredLRPs(logger lager.Logger, processGuids []string) ([]*models.DesiredLRPLifecycleResult, error)
+
+	// Atomically creates, updates and removes DesiredLRPs so that the domain
+	// contains exactly the given DesiredLRPs, and upserts the domain with the
+	// given ttl. The response lists the process guids that changed
+	ApplyDesiredLRPs(logger lager.Logger, domain string, desiredLRPs []*models.DesiredLRP, ttl time.Duration) (*models.ApplyDesiredLRPsResponse, error)
+
+	// Replaces the run definition and, if resource is non-nil, the resource
+	// requirements of the DesiredLRP matching the given process guid, then
+	// replaces its running instances, at most maxInFlight at a time
+	RedeployDesiredLRP(logger lager.Logger, processGuid string, runInfo *models.DesiredLRPRunInfo, resource *models.DesiredLRPResource, maxInFlight int32) error
+
+	// Returns the progress of the latest rolling replacement of the instances
+	// of the DesiredLRP matching the given process guid
+	DesiredLRPRollout(logger lager.Logger, processGuid string) (*models.DesiredLRPRollout, error)
+
+	// Lists the recorded revisions of the DesiredLRP matching the given
+	// process guid, oldest first. Requires a BBS backed by a SQL database
+	DesiredLRPRevisions(logger lager.Logger, processGuid string) ([]*models.DesiredLRPRevision, error)
+
+	// Returns the given revision of the DesiredLRP matching the given process guid
+	DesiredLRPRevision(logger lager.Logger, processGuid string, revision int64) (*models.DesiredLRPRevision, error)
+
+	// Updates the DesiredLRP matching the given process guid back to the
+	// instances, routes and annotation of the given revision, and redeploys
+	// its run definition and resources if they differ
+	RollbackDesiredLRP(logger lager.Logger, processGuid string, revision int64) error
 }
 
 /*
@@ -167,6 +260,9 @@ The ExternalEventClient is used to subscribe to groups of Events.
 */
 type ExternalEventClient interface {
 	SubscribeToEvents(logger lager.Logger) (events.EventSource, error)
+	SubscribeToEventsWithFilter(logger lager.Logger, filter models.EventFilter) (events.EventSource, error)
+	SubscribeToTaskEvents(logger lager.Logger) (events.EventSource, error)
+	SubscribeToTaskEventsWithFilter(logger lager.Logger, filter models.EventFilter) (events.EventSource, error)
 }
 
 func newClient(url string) *client {
@@ -484,6 +580,13 @@ func (c *client) DesireLRP(logger lager.Logger, desiredLRP *models.DesiredLRP) e
 	return c.doDesiredLRPLifecycleRequest(logger, DesireDesiredLRPRoute, &request)
 }
 
+func (c *client) DesireLRPs(logger lager.Logger, desiredLRPs []*models.DesiredLRP) ([]*models.DesiredLRPLifecycleResult, error) {
+	request := models.DesireLRPsRequest{
+		DesiredLrps: desiredLRPs,
+	}
+	return c.doDesiredLRPsLifecycleRequest(logger, DesireDesiredLRPsRoute, &request)
+}
+
 func (c *client) UpdateDesiredLRP(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) error {
 	request := models.UpdateDesiredLRPRequest{
 		ProcessGuid: processGuid,
@@ -492,6 +595,15 @@ func (c *client) UpdateDesiredLRP(logger lager.Logger, processGuid string, updat
 	return c.doDesiredLRPLifecycleRequest(logger, UpdateDesiredLRPRoute, &request)
 }
 
+func (c *client) UpdateDesiredLRPWithModificationTag(logger lager.Logger, processGuid string, expectedTag models.ModificationTag, update *models.DesiredLRPUpdate) error {
+	request := models.UpdateDesiredLRPRequest{
+		ProcessGuid:             processGuid,
+		Update:                  update,
+		ExpectedModificationTag: &expectedTag,
+	}
+	return c.doDesiredLRPLifecycleRequest(logger, UpdateDesiredLRPRoute, &request)
+}
+
 func (c *client) RemoveDesiredLRP(logger lager.Logger, processGuid string) error {
 	request := models.RemoveDesiredLRPRequest{
 		ProcessGuid: processGuid,
@@ -499,6 +611,102 @@ func (c *client) RemoveDesiredLRP(logger lager.Logger, processGuid string) error
 	return c.doDesiredLRPLifecycleRequest(logger, RemoveDesiredLRPRoute, &request)
 }
 
+func (c *client) RemoveDesiredLRPWithModificationTag(logger lager.Logger, processGuid string, expectedTag models.ModificationTag) error {
+	request := models.RemoveDesiredLRPRequest{
+		ProcessGuid:             processGuid,
+		ExpectedModificationTag: &expectedTag,
+	}
+	return c.doDesiredLRPLifecycleRequest(logger, RemoveDesiredLRPRoute, &request)
+}
+
+func (c *client) RemoveDesiredLRPs(logger lager.Logger, processGuids []string) ([]*models.DesiredLRPLifecycleResult, error) {
+	request := models.RemoveDesiredLRPsRequest{
+		ProcessGuids: processGuids,
+	}
+	return c.doDesiredLRPsLifecycleRequest(logger, RemoveDesiredLRPsRoute, &request)
+}
+
+func (c *client) ApplyDesiredLRPs(logger lager.Logger, domain string, desiredLRPs []*models.DesiredLRP, ttl time.Duration) (*models.ApplyDesiredLRPsResponse, error) {
+	request := models.ApplyDesiredLRPsRequest{
+		Domain:      domain,
+		DesiredLrps: desiredLRPs,
+		Ttl:         uint32(ttl.Seconds()),
+	}
+	response := models.ApplyDesiredLRPsResponse{}
+	err := c.doRequest(logger, ApplyDesiredLRPsRoute, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, response.Error.ToError()
+}
+
+func (c *client) doDesiredLRPsLifecycleRequest(logger lager.Logger, route string, request proto.Message) ([]*models.DesiredLRPLifecycleResult, error) {
+	response := models.DesiredLRPsLifecycleResponse{}
+	err := c.doRequest(logger, route, nil, nil, request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Results, response.Error.ToError()
+}
+
+func (c *client) RedeployDesiredLRP(logger lager.Logger, processGuid string, runInfo *models.DesiredLRPRunInfo, resource *models.DesiredLRPResource, maxInFlight int32) error {
+	request := models.RedeployDesiredLRPRequest{
+		ProcessGuid: processGuid,
+		RunInfo:     runInfo,
+		Resource:    resource,
+		MaxInFlight: maxInFlight,
+	}
+	return c.doDesiredLRPLifecycleRequest(logger, RedeployDesiredLRPRoute, &request)
+}
+
+func (c *client) DesiredLRPRollout(logger lager.Logger, processGuid string) (*models.DesiredLRPRollout, error) {
+	request := models.DesiredLRPRolloutRequest{
+		ProcessGuid: processGuid,
+	}
+	response := models.DesiredLRPRolloutResponse{}
+	err := c.doRequest(logger, DesiredLRPRolloutRoute, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Rollout, response.Error.ToError()
+}
+
+func (c *client) DesiredLRPRevisions(logger lager.Logger, processGuid string) ([]*models.DesiredLRPRevision, error) {
+	request := models.DesiredLRPRevisionsRequest{
+		ProcessGuid: processGuid,
+	}
+	response := models.DesiredLRPRevisionsResponse{}
+	err := c.doRequest(logger, DesiredLRPRevisionsRoute, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Revisions, response.Error.ToError()
+}
+
+func (c *client) DesiredLRPRevision(logger lager.Logger, processGuid string, revision int64) (*models.DesiredLRPRevision, error) {
+	request := models.DesiredLRPRevisionRequest{
+		ProcessGuid: processGuid,
+		Revision:    revision,
+	}
+	response := models.DesiredLRPRevisionResponse{}
+	err := c.doRequest(logger, DesiredLRPRevisionRoute, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Revision, response.Error.ToError()
+}
+
+func (c *client) RollbackDesiredLRP(logger lager.Logger, processGuid string, revision int64) error {
+	request := models.RollbackDesiredLRPRequest{
+		ProcessGuid: processGuid,
+		Revision:    revision,
+	}
+	return c.doDesiredLRPLifecycleRequest(logger, RollbackDesiredLRPRoute, &request)
+}
+
 func (c *client) Tasks(logger lager.Logger) ([]*models.Task, error) {
 	request := models.TasksRequest{}
 	response := models.TasksResponse{}
@@ -581,6 +789,13 @@ func (c *client) DesireTask(logger lager.Logger, taskGuid, domain string, taskDe
 	return c.doTaskLifecycleRequest(logger, route, &request)
 }
 
+func (c *client) DesireTasks(logger lager.Logger, requests []*models.DesireTaskRequest) ([]*models.TaskLifecycleResult, error) {
+	request := models.DesireTasksRequest{
+		Tasks: requests,
+	}
+	return c.doTasksLifecycleRequest(logger, DesireTasksRoute, &request)
+}
+
 func (c *client) StartTask(logger lager.Logger, taskGuid string, cellId string) (bool, error) {
 	request := &models.StartTaskRequest{
 		TaskGuid: taskGuid,
@@ -602,6 +817,13 @@ func (c *client) CancelTask(logger lager.Logger, taskGuid string) error {
 	return c.doTaskLifecycleRequest(logger, route, &request)
 }
 
+func (c *client) CancelTasks(logger lager.Logger, taskGuids []string) ([]*models.TaskLifecycleResult, error) {
+	request := models.TaskGuidsRequest{
+		TaskGuids: taskGuids,
+	}
+	return c.doTasksLifecycleRequest(logger, CancelTasksRoute, &request)
+}
+
 func (c *client) ResolvingTask(logger lager.Logger, taskGuid string) error {
 	request := models.TaskGuidRequest{
 		TaskGuid: taskGuid,
@@ -618,6 +840,22 @@ func (c *client) DeleteTask(logger lager.Logger, taskGuid string) error {
 	return c.doTaskLifecycleRequest(logger, route, &request)
 }
 
+func (c *client) DeleteTasks(logger lager.Logger, taskGuids []string) ([]*models.TaskLifecycleResult, error) {
+	request := models.TaskGuidsRequest{
+		TaskGuids: taskGuids,
+	}
+	return c.doTasksLifecycleRequest(logger, DeleteTasksRoute, &request)
+}
+
+func (c *client) doTasksLifecycleRequest(logger lager.Logger, route string, request proto.Message) ([]*models.TaskLifecycleResult, error) {
+	response := models.TasksLifecycleResponse{}
+	err := c.doRequest(logger, route, nil, nil, request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Results, response.Error.ToError()
+}
+
 func (c *client) FailTask(logger lager.Logger, taskGuid, failureReason string) error {
 	request := models.FailTaskRequest{
 		TaskGuid:      taskGuid,
@@ -639,13 +877,18 @@ func (c *client) CompleteTask(logger lager.Logger, taskGuid, cellId string, fail
 	return c.doTaskLifecycleRequest(logger, route, &request)
 }
 
-func (c *client) subscribeToEvents(route string) (events.EventSource, error) {
+func (c *client) subscribeToEvents(logger lager.Logger, route string, filter models.EventFilter) (events.EventSource, error) {
+	requestTrace := traceFromLogger(logger)
+
 	eventSource, err := sse.Connect(c.streamingHTTPClient, time.Second, func() *http.Request {
 		request, err := c.reqGen.CreateRequest(route, nil, nil)
 		if err != nil {
 			panic(err) // totally shouldn't happen
 		}
 
+		request.URL.RawQuery = filter.QueryParams().Encode()
+		requestTrace.SetHeaders(request.Header)
+
 		return request
 	})
 
@@ -657,7 +900,19 @@ func (c *client) subscribeToEvents(route string) (events.EventSource, error) {
 }
 
 func (c *client) SubscribeToEvents(logger lager.Logger) (events.EventSource, error) {
-	return c.subscribeToEvents(EventStreamRoute_r0)
+	return c.subscribeToEvents(logger, EventStreamRoute_r0, models.EventFilter{})
+}
+
+func (c *client) SubscribeToEventsWithFilter(logger lager.Logger, filter models.EventFilter) (events.EventSource, error) {
+	return c.subscribeToEvents(logger, EventStreamRoute_r0, filter)
+}
+
+func (c *client) SubscribeToTaskEvents(logger lager.Logger) (events.EventSource, error) {
+	return c.subscribeToEvents(logger, TaskEventStreamRoute_r0, models.EventFilter{})
+}
+
+func (c *client) SubscribeToTaskEventsWithFilter(logger lager.Logger, filter models.EventFilter) (events.EventSource, error) {
+	return c.subscribeToEvents(logger, TaskEventStreamRoute_r0, filter)
 }
 
 func (c *client) Cells(logger lager.Logger) ([]*models.CellPresence, error) {
@@ -669,6 +924,91 @@ func (c *client) Cells(logger lager.Logger) ([]*models.CellPresence, error) {
 	return response.Cells, response.Error.ToError()
 }
 
+func (c *client) AuditEvents(logger lager.Logger, filter models.AuditEventFilter) ([]*models.AuditEvent, error) {
+	request := models.AuditEventsRequest{
+		Domain: filter.Domain,
+		Since:  filter.Since,
+		Until:  filter.Until,
+		Limit:  int32(filter.Limit),
+	}
+	response := models.AuditEventsResponse{}
+	err := c.doRequest(logger, AuditEventsRoute, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.AuditEvents, response.Error.ToError()
+}
+
+func (c *client) QuarantinedRecords(logger lager.Logger, tableName string) ([]*models.QuarantinedRecord, error) {
+	request := models.QuarantinedRecordsRequest{
+		TableName: tableName,
+	}
+	response := models.QuarantinedRecordsResponse{}
+	err := c.doRequest(logger, QuarantinedRecordsRoute, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.QuarantinedRecords, response.Error.ToError()
+}
+
+func (c *client) QuarantinedRecord(logger lager.Logger, id int64) (*models.QuarantinedRecord, error) {
+	request := models.QuarantinedRecordRequest{
+		Id: id,
+	}
+	response := models.QuarantinedRecordResponse{}
+	err := c.doRequest(logger, QuarantinedRecordRoute, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.QuarantinedRecord, response.Error.ToError()
+}
+
+func (c *client) RestoreQuarantinedRecord(logger lager.Logger, id int64) error {
+	request := models.RestoreQuarantinedRecordRequest{
+		Id: id,
+	}
+	response := models.RestoreQuarantinedRecordResponse{}
+	err := c.doRequest(logger, RestoreQuarantinedRecordRoute, nil, nil, &request, &response)
+	if err != nil {
+		return err
+	}
+	return response.Error.ToError()
+}
+
+func (c *client) RestoreQuarantinedRecords(logger lager.Logger, tableName string) ([]int64, []int64, error) {
+	request := models.RestoreQuarantinedRecordsRequest{
+		TableName: tableName,
+	}
+	response := models.RestoreQuarantinedRecordsResponse{}
+	err := c.doRequest(logger, RestoreQuarantinedRecordsRoute, nil, nil, &request, &response)
+	if err != nil {
+		return nil, nil, err
+	}
+	return response.RestoredIds, response.FailedIds, response.Error.ToError()
+}
+
+func (c *client) EncryptionProgress(logger lager.Logger) (string, []*models.EncryptionTableProgress, error) {
+	request := models.EncryptionProgressRequest{}
+	response := models.EncryptionProgressResponse{}
+	err := c.doRequest(logger, EncryptionProgressRoute, nil, nil, &request, &response)
+	if err != nil {
+		return "", nil, err
+	}
+	return response.KeyLabel, response.Tables, response.Error.ToError()
+}
+
+func (c *client) VerifyEncryptionKeyRetired(logger lager.Logger, keyLabel string) (bool, int64, error) {
+	request := models.VerifyEncryptionKeyRetiredRequest{
+		KeyLabel: keyLabel,
+	}
+	response := models.VerifyEncryptionKeyRetiredResponse{}
+	err := c.doRequest(logger, VerifyEncryptionKeyRetiredRoute, nil, nil, &request, &response)
+	if err != nil {
+		return false, 0, err
+	}
+	return response.Retired, response.RemainingRows, response.Error.ToError()
+}
+
 func (c *client) createRequest(requestName string, params rata.Params, queryParams url.Values, message proto.Message) (*http.Request, error) {
 	var messageBody []byte
 	var err error
@@ -701,7 +1041,8 @@ func (c *client) doEvacRequest(logger lager.Logger, route string, defaultKeepCon
 }
 
 func (c *client) doRequest(logger lager.Logger, requestName string, params rata.Params, queryParams url.Values, requestBody, responseBody proto.Message) error {
-	logger = logger.Session("do-request")
+	requestTrace := traceFromLogger(logger)
+	logger = logger.Session("do-request", requestTrace.LagerData())
 	var err error
 	var request *http.Request
 
@@ -712,6 +1053,7 @@ func (c *client) doRequest(logger lager.Logger, requestName string, params rata.
 			logger.Error("failed-creating-request", err)
 			return err
 		}
+		requestTrace.SetHeaders(request.Header)
 
 		logger.Debug("doing-request", lager.Data{"attempt": attempts + 1, "request_path": request.URL.Path})
 
@@ -730,6 +1072,17 @@ func (c *client) doRequest(logger lager.Logger, requestName string, params rata.
 	return err
 }
 
+// traceFromLogger returns the trace carried by logger, so that a component
+// calling the BBS while serving a traced request passes its trace on, or a new
+// trace for the call.
+func traceFromLogger(logger lager.Logger) trace.Trace {
+	requestTrace, ok := trace.FromLogger(logger)
+	if !ok {
+		requestTrace = trace.New()
+	}
+	return requestTrace
+}
+
 func (c *client) do(request *http.Request, responseObject proto.Message) error {
 	response, err := c.httpClient.Do(request)
 	if err != nil {
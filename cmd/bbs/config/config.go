@@ -6,53 +6,73 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
 	"code.cloudfoundry.org/debugserver"
 	"code.cloudfoundry.org/durationjson"
 	"code.cloudfoundry.org/lager/lagerflags"
 	"code.cloudfoundry.org/locket"
 )
 
+// The values of record_compression, which selects how the records are
+// compressed before being encrypted in SQL.
+const (
+	RecordCompressionNone = "none"
+	RecordCompressionGzip = "gzip"
+)
+
 type BBSConfig struct {
-	SessionName                 string                `json:"session_name,omitempty"`
-	AccessLogPath               string                `json:"access_log_path,omitempty"`
-	RequireSSL                  bool                  `json:"require_ssl,omitempty"`
-	CaFile                      string                `json:"ca_file,omitempty"`
-	CertFile                    string                `json:"cert_file,omitempty"`
-	KeyFile                     string                `json:"key_file,omitempty"`
-	ListenAddress               string                `json:"listen_address,omitempty"`
-	HealthAddress               string                `json:"health_address,omitempty"`
-	AdvertiseURL                string                `json:"advertise_url,omitempty"`
-	CommunicationTimeout        durationjson.Duration `json:"communication_timeout,omitempty"`
-	DesiredLRPCreationTimeout   durationjson.Duration `json:"desired_lrp_creation_timeout,omitempty"`
-	ExpireCompletedTaskDuration durationjson.Duration `json:"expire_completed_task_duration,omitempty"`
-	ExpirePendingTaskDuration   durationjson.Duration `json:"expire_pending_task_duration,omitempty"`
-	ConvergeRepeatInterval      durationjson.Duration `json:"converge_repeat_interval,omitempty"`
-	KickTaskDuration            durationjson.Duration `json:"kick_task_duration,omitempty"`
-	LockRetryInterval           durationjson.Duration `json:"lock_retry_interval,omitempty"`
-	LockTTL                     durationjson.Duration `json:"lock_ttl,omitempty"`
-	ReportInterval              durationjson.Duration `json:"report_interval,omitempty"`
-	ConvergenceWorkers          int                   `json:"convergence_workers,omitempty"`
-	UpdateWorkers               int                   `json:"update_workers,omitempty"`
-	TaskCallbackWorkers         int                   `json:"task_callback_workers,omitempty"`
-	ConsulCluster               string                `json:"consul_cluster,omitempty"`
-	DropsondePort               int                   `json:"dropsonde_port,omitempty"`
-	DatabaseConnectionString    string                `json:"database_connection_string"`
-	DatabaseDriver              string                `json:"database_driver,omitempty"`
-	MaxOpenDatabaseConnections  int                   `json:"max_open_database_connections,omitempty"`
-	MaxIdleDatabaseConnections  int                   `json:"max_idle_database_connections,omitempty"`
-	SQLCACertFile               string                `json:"sql_ca_cert_file,omitempty"`
-	AuctioneerAddress           string                `json:"auctioneer_address,omitempty"`
-	AuctioneerCACert            string                `json:"auctioneer_ca_cert,omitempty"`
-	AuctioneerClientCert        string                `json:"auctioneer_client_cert,omitempty"`
-	AuctioneerClientKey         string                `json:"auctioneer_client_key,omitempty"`
-	AuctioneerRequireTLS        bool                  `json:"auctioneer_require_tls,omitempty"`
-	RepCACert                   string                `json:"rep_ca_cert,omitempty"`
-	RepClientCert               string                `json:"rep_client_cert,omitempty"`
-	RepClientKey                string                `json:"rep_client_key,omitempty"`
-	RepClientSessionCacheSize   int                   `json:"rep_client_session_cache_size,omitempty"`
-	RepRequireTLS               bool                  `json:"rep_require_tls,omitempty"`
-	LocketAddress               string                `json:"locket_address,omitempty"`
-	SkipConsulLock              bool                  `json:"skip_consul_lock,omitempty"`
+	SessionName                 string                     `json:"session_name,omitempty"`
+	AccessLogPath               string                     `json:"access_log_path,omitempty"`
+	AccessLogFormat             middleware.AccessLogFormat `json:"access_log_format,omitempty"`
+	EnableAuditLog              bool                       `json:"enable_audit_log,omitempty"`
+	AuditLogRetention           durationjson.Duration      `json:"audit_log_retention,omitempty"`
+	RequireSSL                  bool                       `json:"require_ssl,omitempty"`
+	CaFile                      string                     `json:"ca_file,omitempty"`
+	CertFile                    string                     `json:"cert_file,omitempty"`
+	KeyFile                     string                     `json:"key_file,omitempty"`
+	ListenAddress               string                     `json:"listen_address,omitempty"`
+	HealthAddress               string                     `json:"health_address,omitempty"`
+	GRPCListenAddress           string                     `json:"grpc_listen_address,omitempty"`
+	ClientRoles                 []middleware.RoleMapping   `json:"client_roles,omitempty"`
+	AdvertiseURL                string                     `json:"advertise_url,omitempty"`
+	CommunicationTimeout        durationjson.Duration      `json:"communication_timeout,omitempty"`
+	DesiredLRPCreationTimeout   durationjson.Duration      `json:"desired_lrp_creation_timeout,omitempty"`
+	ExpireCompletedTaskDuration durationjson.Duration      `json:"expire_completed_task_duration,omitempty"`
+	ExpirePendingTaskDuration   durationjson.Duration      `json:"expire_pending_task_duration,omitempty"`
+	ConvergeRepeatInterval      durationjson.Duration      `json:"converge_repeat_interval,omitempty"`
+	KickTaskDuration            durationjson.Duration      `json:"kick_task_duration,omitempty"`
+	LockRetryInterval           durationjson.Duration      `json:"lock_retry_interval,omitempty"`
+	LockTTL                     durationjson.Duration      `json:"lock_ttl,omitempty"`
+	ReportInterval              durationjson.Duration      `json:"report_interval,omitempty"`
+	ConvergenceWorkers          int                        `json:"convergence_workers,omitempty"`
+	UpdateWorkers               int                        `json:"update_workers,omitempty"`
+	MaxBulkRequestItems         int                        `json:"max_bulk_request_items,omitempty"`
+	TaskCallbackWorkers         int                        `json:"task_callback_workers,omitempty"`
+	EventLogSize                int                        `json:"event_log_size,omitempty"`
+	ConsulCluster               string                     `json:"consul_cluster,omitempty"`
+	DropsondePort               int                        `json:"dropsonde_port,omitempty"`
+	EnablePrometheusMetrics     bool                       `json:"enable_prometheus_metrics,omitempty"`
+	DatabaseConnectionString    string                     `json:"database_connection_string"`
+	DatabaseDriver              string                     `json:"database_driver,omitempty"`
+	MaxOpenDatabaseConnections  int                        `json:"max_open_database_connections,omitempty"`
+	MaxIdleDatabaseConnections  int                        `json:"max_idle_database_connections,omitempty"`
+	SQLCACertFile               string                     `json:"sql_ca_cert_file,omitempty"`
+	AuctioneerAddress           string                     `json:"auctioneer_address,omitempty"`
+	AuctioneerCACert            string                     `json:"auctioneer_ca_cert,omitempty"`
+	AuctioneerClientCert        string                     `json:"auctioneer_client_cert,omitempty"`
+	AuctioneerClientKey         string                     `json:"auctioneer_client_key,omitempty"`
+	AuctioneerRequireTLS        bool                       `json:"auctioneer_require_tls,omitempty"`
+	RepCACert                   string                     `json:"rep_ca_cert,omitempty"`
+	RepClientCert               string                     `json:"rep_client_cert,omitempty"`
+	RepClientKey                string                     `json:"rep_client_key,omitempty"`
+	RepClientSessionCacheSize   int                        `json:"rep_client_session_cache_size,omitempty"`
+	RepRequireTLS               bool                       `json:"rep_require_tls,omitempty"`
+	LocketAddress               string                     `json:"locket_address,omitempty"`
+	SkipConsulLock              bool                       `json:"skip_consul_lock,omitempty"`
+	EncryptionBatchSize         int                        `json:"encryption_batch_size,omitempty"`
+	EncryptionBatchInterval     durationjson.Duration      `json:"encryption_batch_interval,omitempty"`
+	EncryptionKeyReloadInterval durationjson.Duration      `json:"encryption_key_reload_interval,omitempty"`
+	RecordCompression           string                     `json:"record_compression,omitempty"`
 	ETCDConfig
 	encryption.EncryptionConfig
 	debugserver.DebugServerConfig
@@ -62,6 +82,8 @@ type BBSConfig struct {
 func DefaultConfig() BBSConfig {
 	return BBSConfig{
 		SessionName:                 "bbs",
+		AccessLogFormat:             middleware.AccessLogFormatJSON,
+		AuditLogRetention:           durationjson.Duration(30 * 24 * time.Hour),
 		CommunicationTimeout:        durationjson.Duration(10 * time.Second),
 		RequireSSL:                  false,
 		DesiredLRPCreationTimeout:   durationjson.Duration(1 * time.Minute),
@@ -74,7 +96,9 @@ func DefaultConfig() BBSConfig {
 		ReportInterval:              durationjson.Duration(1 * time.Minute),
 		ConvergenceWorkers:          20,
 		UpdateWorkers:               1000,
+		MaxBulkRequestItems:         1000,
 		TaskCallbackWorkers:         1000,
+		EventLogSize:                10000,
 		DropsondePort:               3457,
 		DatabaseDriver:              "mysql",
 		MaxOpenDatabaseConnections:  200,
@@ -82,6 +106,8 @@ func DefaultConfig() BBSConfig {
 		AuctioneerRequireTLS:        false,
 		RepClientSessionCacheSize:   0,
 		RepRequireTLS:               false,
+		EncryptionBatchSize:         100,
+		EncryptionKeyReloadInterval: durationjson.Duration(10 * time.Second),
 		ETCDConfig:                  DefaultETCDConfig(),
 		EncryptionConfig:            encryption.DefaultEncryptionConfig(),
 		LagerConfig:                 lagerflags.DefaultLagerConfig(),
@@ -8,6 +8,7 @@ import (
 
 	"code.cloudfoundry.org/bbs/cmd/bbs/config"
 	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
 	"code.cloudfoundry.org/debugserver"
 	"code.cloudfoundry.org/durationjson"
 	"code.cloudfoundry.org/lager/lagerflags"
@@ -24,12 +25,20 @@ var _ = Describe("BBSConfig", func() {
 		configData = `{
   "session_name": "bbs-session",
   "access_log_path": "/var/vcap/sys/log/bbs/access.log",
+  "access_log_format": "combined",
+  "enable_audit_log": true,
+  "audit_log_retention": "168h",
   "require_ssl": true,
   "ca_file": "/var/vcap/jobs/bbs/config/ca.crt",
   "cert_file": "/var/vcap/jobs/bbs/config/bbs.crt",
   "key_file": "/var/vcap/jobs/bbs/config/bbs.key",
   "listen_address": "0.0.0.0:8889",
   "health_address": "127.0.0.1:8890",
+  "grpc_listen_address": "0.0.0.0:8891",
+  "client_roles": [
+    {"role": "cell", "subject_alternative_names": ["cell.service.cf.internal"]},
+    {"role": "scheduler", "common_names": ["nsync", "tps"]}
+  ],
   "advertise_url": "bbs.service.cf.internal",
   "communication_timeout": "20s",
   "desired_lrp_creation_timeout": "1m0s",
@@ -42,9 +51,12 @@ var _ = Describe("BBSConfig", func() {
   "report_interval": "1m0s",
   "convergence_workers": 20,
   "update_workers": 1000,
+  "max_bulk_request_items": 500,
   "task_callback_workers": 1000,
+  "event_log_size": 5000,
   "consul_cluster": "",
   "dropsonde_port": 3457,
+  "enable_prometheus_metrics": true,
   "database_connection_string": "",
   "database_driver": "postgres",
   "max_open_database_connections": 200,
@@ -71,9 +83,24 @@ var _ = Describe("BBSConfig", func() {
   "active_key_label": "label",
   "encryption_keys": {
     "label": "key"
+  },
+  "encryption_key_directory": "/var/vcap/jobs/bbs/keys",
+  "encryption_key_env": {
+    "env-label": "BBS_ENCRYPTION_KEY"
+  },
+  "encryption_kms": {
+    "url": "http://127.0.0.1:8200",
+    "master_key_id": "master",
+    "wrapped_keys": {
+      "kms-label": "d3JhcHBlZA=="
+    }
   },
 	"locket_address": "127.0.0.1:18018",
   "skip_consul_lock": true,
+  "encryption_batch_size": 500,
+  "encryption_batch_interval": "100ms",
+  "encryption_key_reload_interval": "1m",
+  "record_compression": "gzip",
   "debug_address": "127.0.0.1:17017",
   "log_level": "debug"
 }`
@@ -100,14 +127,22 @@ var _ = Describe("BBSConfig", func() {
 		Expect(err).NotTo(HaveOccurred())
 
 		config := config.BBSConfig{
-			SessionName:                 "bbs-session",
-			AccessLogPath:               "/var/vcap/sys/log/bbs/access.log",
-			RequireSSL:                  true,
-			CaFile:                      "/var/vcap/jobs/bbs/config/ca.crt",
-			CertFile:                    "/var/vcap/jobs/bbs/config/bbs.crt",
-			KeyFile:                     "/var/vcap/jobs/bbs/config/bbs.key",
-			ListenAddress:               "0.0.0.0:8889",
-			HealthAddress:               "127.0.0.1:8890",
+			SessionName:       "bbs-session",
+			AccessLogPath:     "/var/vcap/sys/log/bbs/access.log",
+			AccessLogFormat:   middleware.AccessLogFormatCombined,
+			EnableAuditLog:    true,
+			AuditLogRetention: durationjson.Duration(7 * 24 * time.Hour),
+			RequireSSL:        true,
+			CaFile:            "/var/vcap/jobs/bbs/config/ca.crt",
+			CertFile:          "/var/vcap/jobs/bbs/config/bbs.crt",
+			KeyFile:           "/var/vcap/jobs/bbs/config/bbs.key",
+			ListenAddress:     "0.0.0.0:8889",
+			HealthAddress:     "127.0.0.1:8890",
+			GRPCListenAddress: "0.0.0.0:8891",
+			ClientRoles: []middleware.RoleMapping{
+				{Role: middleware.RoleCell, SANs: []string{"cell.service.cf.internal"}},
+				{Role: middleware.RoleScheduler, CommonNames: []string{"nsync", "tps"}},
+			},
 			AdvertiseURL:                "bbs.service.cf.internal",
 			CommunicationTimeout:        durationjson.Duration(20 * time.Second),
 			DesiredLRPCreationTimeout:   durationjson.Duration(1 * time.Minute),
@@ -120,8 +155,11 @@ var _ = Describe("BBSConfig", func() {
 			ReportInterval:              durationjson.Duration(1 * time.Minute),
 			ConvergenceWorkers:          20,
 			UpdateWorkers:               1000,
+			MaxBulkRequestItems:         500,
 			TaskCallbackWorkers:         1000,
+			EventLogSize:                5000,
 			DropsondePort:               3457,
+			EnablePrometheusMetrics:     true,
 			DatabaseDriver:              "postgres",
 			MaxOpenDatabaseConnections:  200,
 			MaxIdleDatabaseConnections:  50,
@@ -141,6 +179,17 @@ var _ = Describe("BBSConfig", func() {
 				EncryptionKeys: map[string]string{
 					"label": "key",
 				},
+				EncryptionKeyDirectory: "/var/vcap/jobs/bbs/keys",
+				EncryptionKeyEnv: map[string]string{
+					"env-label": "BBS_ENCRYPTION_KEY",
+				},
+				EncryptionKMS: encryption.KMSConfig{
+					URL:         "http://127.0.0.1:8200",
+					MasterKeyID: "master",
+					WrappedKeys: map[string]string{
+						"kms-label": "d3JhcHBlZA==",
+					},
+				},
 			},
 			ETCDConfig: config.ETCDConfig{
 				ClusterUrls:            []string{"http://127.0.0.1:8500"},
@@ -156,8 +205,12 @@ var _ = Describe("BBSConfig", func() {
 			LagerConfig: lagerflags.LagerConfig{
 				LogLevel: "debug",
 			},
-			LocketAddress:  "127.0.0.1:18018",
-			SkipConsulLock: true,
+			LocketAddress:               "127.0.0.1:18018",
+			SkipConsulLock:              true,
+			EncryptionBatchSize:         500,
+			EncryptionBatchInterval:     durationjson.Duration(100 * time.Millisecond),
+			EncryptionKeyReloadInterval: durationjson.Duration(time.Minute),
+			RecordCompression:           config.RecordCompressionGzip,
 		}
 
 		Expect(bbsConfig).To(Equal(config))
@@ -1,6 +1,7 @@
 package main_test
 
 import (
+	"database/sql"
 	"fmt"
 	"time"
 
@@ -279,6 +280,78 @@ var _ = Describe("DesiredLRP API", func() {
 		})
 	})
 
+	Describe("DesireLRPs", func() {
+		It("creates each of the desired LRPs and reports an error for the invalid ones", func() {
+			invalidLRP := model_helpers.NewValidDesiredLRP("invalid-lrp")
+			invalidLRP.Domain = ""
+
+			results, err := client.DesireLRPs(logger, []*models.DesiredLRP{
+				model_helpers.NewValidDesiredLRP("super-lrp-1"),
+				invalidLRP,
+				model_helpers.NewValidDesiredLRP("super-lrp-2"),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(results).To(HaveLen(3))
+			Expect(results[0]).To(Equal(&models.DesiredLRPLifecycleResult{ProcessGuid: "super-lrp-1"}))
+			Expect(results[1].ProcessGuid).To(Equal("invalid-lrp"))
+			Expect(results[1].Error.Type).To(Equal(models.Error_InvalidRequest))
+			Expect(results[2]).To(Equal(&models.DesiredLRPLifecycleResult{ProcessGuid: "super-lrp-2"}))
+
+			desiredLRPs, err := client.DesiredLRPs(logger, models.DesiredLRPFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(desiredLRPs).To(HaveLen(2))
+		})
+	})
+
+	Describe("RemoveDesiredLRPs", func() {
+		It("removes each of the desired LRPs and reports an error for the missing ones", func() {
+			err := client.DesireLRP(logger, model_helpers.NewValidDesiredLRP("super-lrp"))
+			Expect(err).NotTo(HaveOccurred())
+
+			results, err := client.RemoveDesiredLRPs(logger, []string{"super-lrp", "missing-lrp"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(results).To(Equal([]*models.DesiredLRPLifecycleResult{
+				{ProcessGuid: "super-lrp"},
+				{ProcessGuid: "missing-lrp", Error: models.ErrResourceNotFound},
+			}))
+
+			_, err = client.DesiredLRPByProcessGuid(logger, "super-lrp")
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+		})
+	})
+
+	Describe("ApplyDesiredLRPs", func() {
+		It("reconciles the desired LRPs of the domain", func() {
+			err := client.DesireLRP(logger, model_helpers.NewValidDesiredLRP("unchanged-lrp"))
+			Expect(err).NotTo(HaveOccurred())
+			err = client.DesireLRP(logger, model_helpers.NewValidDesiredLRP("changed-lrp"))
+			Expect(err).NotTo(HaveOccurred())
+			err = client.DesireLRP(logger, model_helpers.NewValidDesiredLRP("extra-lrp"))
+			Expect(err).NotTo(HaveOccurred())
+
+			changedLRP := model_helpers.NewValidDesiredLRP("changed-lrp")
+			changedLRP.Instances = 2
+
+			response, err := client.ApplyDesiredLRPs(logger, "some-domain", []*models.DesiredLRP{
+				model_helpers.NewValidDesiredLRP("unchanged-lrp"),
+				changedLRP,
+				model_helpers.NewValidDesiredLRP("new-lrp"),
+			}, time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Created).To(Equal([]string{"new-lrp"}))
+			Expect(response.Updated).To(Equal([]string{"changed-lrp"}))
+			Expect(response.Removed).To(Equal([]string{"extra-lrp"}))
+
+			desiredLRP, err := client.DesiredLRPByProcessGuid(logger, "changed-lrp")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(desiredLRP.Instances).To(BeEquivalentTo(2))
+
+			domains, err := client.Domains(logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(domains).To(ContainElement("some-domain"))
+		})
+	})
+
 	Describe("UpdateDesiredLRP", func() {
 		var (
 			desiredLRP *models.DesiredLRP
@@ -301,6 +374,115 @@ var _ = Describe("DesiredLRP API", func() {
 			Expect(persistedDesiredLRP.Instances).To(Equal(int32(3)))
 		})
 	})
+
+	Describe("RedeployDesiredLRP", func() {
+		var (
+			desiredLRP *models.DesiredLRP
+
+			redeployErr error
+		)
+
+		JustBeforeEach(func() {
+			desiredLRP = model_helpers.NewValidDesiredLRP("super-lrp")
+			err := client.DesireLRP(logger, desiredLRP)
+			Expect(err).NotTo(HaveOccurred())
+
+			desiredLRP.EnvironmentVariables = []*models.EnvironmentVariable{{Name: "NEW", Value: "value"}}
+			runInfo := desiredLRP.DesiredLRPRunInfo(time.Now())
+			redeployErr = client.RedeployDesiredLRP(logger, "super-lrp", &runInfo, nil, 1)
+		})
+
+		It("replaces the run info of the desired LRP", func() {
+			Expect(redeployErr).NotTo(HaveOccurred())
+			persistedDesiredLRP, err := client.DesiredLRPByProcessGuid(logger, "super-lrp")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(persistedDesiredLRP.EnvironmentVariables).To(Equal(desiredLRP.EnvironmentVariables))
+			Expect(persistedDesiredLRP.ModificationTag.Index).To(BeEquivalentTo(1))
+		})
+	})
+
+	Describe("DesiredLRPRevisions and RollbackDesiredLRP", func() {
+		var desiredLRP *models.DesiredLRP
+
+		BeforeEach(func() {
+			desiredLRP = model_helpers.NewValidDesiredLRP("super-lrp")
+			Expect(client.DesireLRP(logger, desiredLRP)).To(Succeed())
+
+			three := int32(3)
+			annotation := "bad-push"
+			Expect(client.UpdateDesiredLRP(logger, "super-lrp", &models.DesiredLRPUpdate{Instances: &three, Annotation: &annotation})).To(Succeed())
+		})
+
+		It("lists every change to the desired LRP", func() {
+			revisions, err := client.DesiredLRPRevisions(logger, "super-lrp")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revisions).To(HaveLen(2))
+			Expect(revisions[0].DesiredLrp.Annotation).To(Equal(desiredLRP.Annotation))
+			Expect(revisions[1].DesiredLrp.Annotation).To(Equal("bad-push"))
+
+			revision, err := client.DesiredLRPRevision(logger, "super-lrp", 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revision).To(Equal(revisions[0]))
+		})
+
+		It("rolls the desired LRP back to a previous revision", func() {
+			Expect(client.RollbackDesiredLRP(logger, "super-lrp", 1)).To(Succeed())
+
+			persistedDesiredLRP, err := client.DesiredLRPByProcessGuid(logger, "super-lrp")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(persistedDesiredLRP.Instances).To(Equal(desiredLRP.Instances))
+			Expect(persistedDesiredLRP.Annotation).To(Equal(desiredLRP.Annotation))
+			Expect(persistedDesiredLRP.Routes).To(Equal(desiredLRP.Routes))
+			Expect(persistedDesiredLRP.ModificationTag.Index).To(BeEquivalentTo(2))
+
+			revisions, err := client.DesiredLRPRevisions(logger, "super-lrp")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revisions).To(HaveLen(3))
+		})
+	})
+
+	Describe("QuarantinedRecords", func() {
+		var sqlConn *sql.DB
+
+		BeforeEach(func() {
+			Expect(client.DesireLRP(logger, model_helpers.NewValidDesiredLRP("broken-lrp"))).To(Succeed())
+
+			var err error
+			sqlConn, err = sql.Open(sqlRunner.DriverName(), sqlRunner.ConnectionString())
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = sqlConn.Exec(`UPDATE desired_lrps SET run_info = 'garbage' WHERE process_guid = 'broken-lrp'`)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = client.DesiredLRPByProcessGuid(logger, "broken-lrp")
+			Expect(err).To(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			sqlConn.Close()
+		})
+
+		It("moves the undecodable desired LRP to quarantine and restores it", func() {
+			records, err := client.QuarantinedRecords(logger, models.QuarantinedDesiredLRPsTable)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(records).To(HaveLen(1))
+			Expect(records[0].Guid).To(Equal("broken-lrp"))
+
+			record, err := client.QuarantinedRecord(logger, records[0].Id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(record).To(Equal(records[0]))
+
+			Expect(client.RestoreQuarantinedRecord(logger, records[0].Id)).To(Succeed())
+
+			schedulingInfos, err := client.DesiredLRPSchedulingInfos(logger, models.DesiredLRPFilter{ProcessGuids: []string{"broken-lrp"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(schedulingInfos).To(HaveLen(1))
+
+			records, err = client.QuarantinedRecords(logger, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(records).To(BeEmpty())
+		})
+	})
 })
 
 func createDesiredLRPsInDomains(client bbs.InternalClient, domainCounts map[string]int) map[string][]*models.DesiredLRP {
@@ -72,6 +72,31 @@ var _ = Describe("Encryption", func() {
 				Expect(tasks).To(ContainElement(MatchTask(task)))
 			})
 
+			It("reports the old key as retired once the records are re-encrypted", func() {
+				Eventually(func() string {
+					keyLabel, _, err := client.EncryptionProgress(logger)
+					Expect(err).NotTo(HaveOccurred())
+					return keyLabel
+				}).Should(Equal("newkey"))
+
+				_, tables, err := client.EncryptionProgress(logger)
+				Expect(err).NotTo(HaveOccurred())
+				for _, table := range tables {
+					Expect(table.Completed).To(BeTrue())
+					Expect(table.KeyLabel).To(Equal("newkey"))
+				}
+
+				retired, remainingRows, err := client.VerifyEncryptionKeyRetired(logger, "oldkey")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(retired).To(BeTrue())
+				Expect(remainingRows).To(BeZero())
+
+				retired, remainingRows, err = client.VerifyEncryptionKeyRetired(logger, "newkey")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(retired).To(BeFalse())
+				Expect(remainingRows).To(BeNumerically(">", 0))
+			})
+
 			It("doesn't need the oldkey after migrating", func() {
 				ginkgomon.Interrupt(bbsProcess)
 
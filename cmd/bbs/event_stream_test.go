@@ -325,6 +325,144 @@ var _ = Describe("Events API", func() {
 		})
 	})
 
+	Describe("Task Events", func() {
+		var taskDef *models.TaskDefinition
+
+		JustBeforeEach(func() {
+			var err error
+			eventSource, err = client.SubscribeToTaskEvents(logger)
+			Expect(err).NotTo(HaveOccurred())
+
+			eventChannel = streamEvents(eventSource)
+
+			primerTaskDef := model_helpers.NewValidTaskDefinition()
+			primeEventStream(eventChannel, models.EventTypeTaskRemoved, func() {
+				err := client.DesireTask(logger, "primer-guid", "primer-domain", primerTaskDef)
+				Expect(err).NotTo(HaveOccurred())
+			}, func() {
+				err := client.CancelTask(logger, "primer-guid")
+				Expect(err).NotTo(HaveOccurred())
+				err = client.ResolvingTask(logger, "primer-guid")
+				Expect(err).NotTo(HaveOccurred())
+				err = client.DeleteTask(logger, "primer-guid")
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		AfterEach(func() {
+			err := eventSource.Close()
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(eventChannel).Should(BeClosed())
+		})
+
+		BeforeEach(func() {
+			taskDef = model_helpers.NewValidTaskDefinition()
+		})
+
+		It("receives events", func() {
+			By("desiring a Task")
+			err := client.DesireTask(logger, "task-guid", "some-domain", taskDef)
+			Expect(err).NotTo(HaveOccurred())
+
+			task, err := client.TaskByGuid(logger, "task-guid")
+			Expect(err).NotTo(HaveOccurred())
+
+			var event models.Event
+			Eventually(eventChannel).Should(Receive(&event))
+
+			taskCreatedEvent, ok := event.(*models.TaskCreatedEvent)
+			Expect(ok).To(BeTrue())
+			Expect(taskCreatedEvent.Task).To(Equal(task))
+
+			By("starting the Task")
+			_, err = client.StartTask(logger, "task-guid", "cell-id")
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(eventChannel).Should(Receive(&event))
+
+			taskChangedEvent, ok := event.(*models.TaskChangedEvent)
+			Expect(ok).To(BeTrue())
+			Expect(taskChangedEvent.Before.State).To(Equal(models.Task_Pending))
+			Expect(taskChangedEvent.After.State).To(Equal(models.Task_Running))
+
+			By("completing the Task")
+			err = client.CompleteTask(logger, "task-guid", "cell-id", false, "", "result")
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(eventChannel).Should(Receive(&event))
+
+			taskChangedEvent, ok = event.(*models.TaskChangedEvent)
+			Expect(ok).To(BeTrue())
+			Expect(taskChangedEvent.Before.State).To(Equal(models.Task_Running))
+			Expect(taskChangedEvent.After.State).To(Equal(models.Task_Completed))
+
+			By("resolving the Task")
+			err = client.ResolvingTask(logger, "task-guid")
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(eventChannel).Should(Receive(&event))
+
+			taskChangedEvent, ok = event.(*models.TaskChangedEvent)
+			Expect(ok).To(BeTrue())
+			Expect(taskChangedEvent.After.State).To(Equal(models.Task_Resolving))
+
+			By("deleting the Task")
+			err = client.DeleteTask(logger, "task-guid")
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(eventChannel).Should(Receive(&event))
+
+			taskRemovedEvent, ok := event.(*models.TaskRemovedEvent)
+			Expect(ok).To(BeTrue())
+			Expect(taskRemovedEvent.Task.TaskGuid).To(Equal("task-guid"))
+		})
+	})
+
+	Describe("Filtered Events", func() {
+		JustBeforeEach(func() {
+			var err error
+			eventSource, err = client.SubscribeToEventsWithFilter(logger, models.EventFilter{
+				Domain:     "filtered-domain",
+				EventTypes: []string{models.EventTypeDesiredLRPCreated, models.EventTypeDesiredLRPRemoved},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			eventChannel = streamEvents(eventSource)
+
+			primerLRP := model_helpers.NewValidDesiredLRP("primer-guid")
+			primerLRP.Domain = "filtered-domain"
+			primeEventStream(eventChannel, models.EventTypeDesiredLRPRemoved, func() {
+				err := client.DesireLRP(logger, primerLRP)
+				Expect(err).NotTo(HaveOccurred())
+			}, func() {
+				err := client.RemoveDesiredLRP(logger, "primer-guid")
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		AfterEach(func() {
+			err := eventSource.Close()
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(eventChannel).Should(BeClosed())
+		})
+
+		It("only receives events in the filtered domain", func() {
+			otherLRP := model_helpers.NewValidDesiredLRP("other-guid")
+			otherLRP.Domain = "other-domain"
+			err := client.DesireLRP(logger, otherLRP)
+			Expect(err).NotTo(HaveOccurred())
+
+			filteredLRP := model_helpers.NewValidDesiredLRP("filtered-guid")
+			filteredLRP.Domain = "filtered-domain"
+			err = client.DesireLRP(logger, filteredLRP)
+			Expect(err).NotTo(HaveOccurred())
+
+			var event models.Event
+			Eventually(eventChannel).Should(Receive(&event))
+			Expect(event.Key()).To(Equal("filtered-guid"))
+		})
+	})
+
 	It("cleans up exiting connections when killing the BBS", func(done Done) {
 		var err error
 		eventSource, err = client.SubscribeToEvents(logger)
@@ -0,0 +1,84 @@
+package main_test
+
+import (
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/bbs/cmd/bbs/testrunner"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"github.com/tedsuo/ifrit/ginkgomon"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("gRPC API", func() {
+	var (
+		conn       *grpc.ClientConn
+		grpcClient models.BBSClient
+	)
+
+	BeforeEach(func() {
+		port := 6900 + GinkgoParallelNode()*2
+		grpcAddress := fmt.Sprintf("127.0.0.1:%d", port)
+		bbsConfig.GRPCListenAddress = grpcAddress
+
+		bbsRunner = testrunner.New(bbsBinPath, bbsConfig)
+		bbsProcess = ginkgomon.Invoke(bbsRunner)
+
+		var err error
+		conn, err = grpc.Dial(grpcAddress, grpc.WithInsecure())
+		Expect(err).NotTo(HaveOccurred())
+		grpcClient = models.NewBBSClient(conn)
+	})
+
+	AfterEach(func() {
+		conn.Close()
+	})
+
+	It("serves the same data as the HTTP API", func() {
+		desiredLRP := model_helpers.NewValidDesiredLRP("super-lrp")
+		Expect(client.DesireLRP(logger, desiredLRP)).To(Succeed())
+
+		response, err := grpcClient.DesiredLRPByProcessGuid(context.Background(), &models.DesiredLRPByProcessGuidRequest{
+			ProcessGuid: "super-lrp",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.Error).To(BeNil())
+		Expect(response.DesiredLrp.ProcessGuid).To(Equal("super-lrp"))
+	})
+
+	It("streams events", func() {
+		desiredLRP := model_helpers.NewValidDesiredLRP("super-lrp")
+		Expect(client.DesireLRP(logger, desiredLRP)).To(Succeed())
+
+		stream, err := grpcClient.SubscribeToEvents(context.Background(), &models.EventsRequest{
+			EventTypes: []string{models.EventTypeDesiredLRPChanged},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		// the subscription is set up asynchronously, so keep changing the LRP
+		// until the stream picks it up
+		done := make(chan struct{})
+		defer close(done)
+		go func() {
+			for i := 0; ; i++ {
+				annotation := fmt.Sprintf("annotation-%d", i)
+				client.UpdateDesiredLRP(logger, "super-lrp", &models.DesiredLRPUpdate{Annotation: &annotation})
+
+				select {
+				case <-done:
+					return
+				case <-time.After(100 * time.Millisecond):
+				}
+			}
+		}()
+
+		envelope, err := stream.Recv()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(envelope.Type).To(Equal(models.EventTypeDesiredLRPChanged))
+	})
+})
@@ -12,9 +12,11 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 
 	"code.cloudfoundry.org/auctioneer"
 	"code.cloudfoundry.org/bbs"
@@ -32,10 +34,12 @@ import (
 	"code.cloudfoundry.org/bbs/format"
 	"code.cloudfoundry.org/bbs/guidprovider"
 	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
 	"code.cloudfoundry.org/bbs/metrics"
 	"code.cloudfoundry.org/bbs/migration"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/taskworkpool"
+	"code.cloudfoundry.org/bbs/tracedclients"
 	"code.cloudfoundry.org/cfhttp"
 	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/consuladapter"
@@ -47,6 +51,9 @@ import (
 	locketmodels "code.cloudfoundry.org/locket/models"
 	"code.cloudfoundry.org/rep"
 	"github.com/cloudfoundry/dropsonde"
+	"github.com/cloudfoundry/dropsonde/metric_sender"
+	"github.com/cloudfoundry/dropsonde/metricbatcher"
+	dropsonde_metrics "github.com/cloudfoundry/dropsonde/metrics"
 	etcdclient "github.com/coreos/go-etcd/etcd"
 	"github.com/go-sql-driver/mysql"
 	"github.com/hashicorp/consul/api"
@@ -66,6 +73,7 @@ var configFilePath = flag.String(
 
 const (
 	dropsondeOrigin           = "bbs"
+	dropsondeBatchInterval    = 5 * time.Second
 	bbsWatchRetryWaitDuration = 3 * time.Second
 	bbsLockKey                = "bbs"
 )
@@ -83,7 +91,7 @@ func main() {
 	logger, reconfigurableSink := lagerflags.NewFromConfig(bbsConfig.SessionName, bbsConfig.LagerConfig)
 	logger.Info("starting")
 
-	initializeDropsonde(logger, &bbsConfig)
+	prometheusSender := initializeDropsonde(logger, &bbsConfig)
 
 	clock := clock.NewClock()
 
@@ -119,12 +127,12 @@ func main() {
 	var sqlConn *sql.DB
 	var storeClient etcddb.StoreClient
 	var etcdDB *etcddb.ETCDDB
+	var sqlFormat *format.Format
 
-	key, keys, err := bbsConfig.EncryptionConfig.Parse()
-	if err != nil {
-		logger.Fatal("cannot-setup-encryption", err)
-	}
-	keyManager, err := encryption.NewKeyManager(key, keys)
+	keyManager, err := encryption.NewReloadingKeyManager(
+		bbsConfig.ActiveKeyLabel,
+		bbsConfig.EncryptionConfig.KeyProviders(cfhttp.NewClient())...,
+	)
 	if err != nil {
 		logger.Fatal("cannot-setup-encryption", err)
 	}
@@ -144,6 +152,11 @@ func main() {
 	// If SQL database info is passed in, use SQL instead of ETCD
 	if bbsConfig.DatabaseDriver != "" && bbsConfig.DatabaseConnectionString != "" {
 		var err error
+		sqlFormat, err = recordFormat(bbsConfig.RecordCompression)
+		if err != nil {
+			logger.Fatal("invalid-record-compression", err)
+		}
+
 		connectionString := appendExtraConnectionStringParam(logger,
 			bbsConfig.DatabaseDriver,
 			bbsConfig.DatabaseConnectionString,
@@ -166,7 +179,7 @@ func main() {
 		sqlDB = sqldb.NewSQLDB(sqlConn,
 			bbsConfig.ConvergenceWorkers,
 			bbsConfig.UpdateWorkers,
-			format.ENCRYPTED_PROTO,
+			sqlFormat,
 			cryptor,
 			guidprovider.DefaultGuidProvider,
 			clock,
@@ -188,7 +201,26 @@ func main() {
 		logger.Fatal("no-database-configured", errors.New("no database configured"))
 	}
 
-	encryptor := encryptor.New(logger, activeDB, keyManager, cryptor, clock)
+	var encryptionProgressDB db.EncryptionProgressDB
+	var recordEncoder ifrit.Runner
+	if sqlDB != nil {
+		if bbsConfig.EncryptionBatchSize <= 0 {
+			logger.Fatal("invalid-encryption-batch-size", errors.New("encryption_batch_size must be positive"))
+		}
+		encryptionProgressDB = sqlDB
+		recordEncoder = encryptor.NewRecordEncoder(logger, sqlDB, sqlFormat.Encoding, clock)
+	}
+
+	encryptor := encryptor.New(
+		logger,
+		activeDB,
+		encryptionProgressDB,
+		keyManager,
+		cryptor,
+		clock,
+		bbsConfig.EncryptionBatchSize,
+		time.Duration(bbsConfig.EncryptionBatchInterval),
+	)
 
 	migrationsDone := make(chan struct{})
 
@@ -205,8 +237,10 @@ func main() {
 		bbsConfig.DatabaseDriver,
 	)
 
-	desiredHub := events.NewHub()
-	actualHub := events.NewHub()
+	eventLog := events.NewEventLog(bbsConfig.EventLogSize, uint64(clock.Now().UnixNano()))
+	desiredHub := events.NewHubWithLog(eventLog)
+	actualHub := events.NewHubWithLog(eventLog)
+	taskHub := events.NewHubWithLog(eventLog)
 
 	repTLSConfig := &rep.TLSConfig{
 		RequireTLS:      bbsConfig.RepRequireTLS,
@@ -217,7 +251,7 @@ func main() {
 	}
 
 	httpClient := cfhttp.NewClient()
-	repClientFactory, err := rep.NewClientFactory(httpClient, httpClient, repTLSConfig)
+	repClientFactory, err := tracedclients.NewRepClientFactory(httpClient, repTLSConfig)
 	if err != nil {
 		logger.Fatal("new-rep-client-factory-failed", err)
 	}
@@ -226,15 +260,18 @@ func main() {
 
 	exitChan := make(chan struct{})
 
-	var accessLogger lager.Logger
+	var accessLogger *middleware.AccessLogger
 	if bbsConfig.AccessLogPath != "" {
-		accessLogger = lager.NewLogger("bbs-access")
 		file, err := os.OpenFile(bbsConfig.AccessLogPath, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0666)
 		if err != nil {
 			logger.Error("invalid-access-log-path", err, lager.Data{"access-log-path": bbsConfig.AccessLogPath})
 			os.Exit(1)
 		}
-		accessLogger.RegisterSink(lager.NewWriterSink(file, lager.INFO))
+
+		accessLogger, err = middleware.NewAccessLogger(file, bbsConfig.AccessLogFormat)
+		if err != nil {
+			logger.Fatal("invalid-access-log-format", err)
+		}
 	}
 
 	var tlsConfig *tls.Config
@@ -245,22 +282,64 @@ func main() {
 		}
 	}
 
+	var authorizer *middleware.Authorizer
+	if len(bbsConfig.ClientRoles) > 0 {
+		if !bbsConfig.RequireSSL {
+			logger.Fatal("client-roles-require-ssl", errors.New("client_roles can only be enforced when require_ssl is enabled"))
+		}
+
+		authorizer, err = middleware.NewAuthorizer(logger, bbsConfig.ClientRoles)
+		if err != nil {
+			logger.Fatal("invalid-client-roles", err)
+		}
+	}
+
+	var desiredLRPRevisionDB db.DesiredLRPRevisionDB
+	var quarantineDB db.QuarantineDB
+	if sqlDB != nil {
+		desiredLRPRevisionDB = sqlDB
+		quarantineDB = sqlDB
+	}
+
+	var auditor *handlers.Auditor
+	var auditPruner converger.AuditPruner
+	if bbsConfig.EnableAuditLog {
+		if sqlDB == nil {
+			logger.Fatal("audit-log-requires-sql", errors.New("enable_audit_log requires a SQL database"))
+		}
+
+		auditor = handlers.NewAuditor(logger, sqlDB, activeDB, activeDB, clock)
+		auditPruner = sqlDB
+	}
+
 	cbWorkPool := taskworkpool.New(logger, bbsConfig.TaskCallbackWorkers, taskworkpool.HandleCompletedTask, tlsConfig)
 
+	desiredLRPRolloutController := controllers.NewDesiredLRPRolloutController(activeDB, activeDB, activeDB, activeDB, actualHub, auctioneerClient, serviceClient, repClientFactory, clock)
+
 	handler := handlers.New(
 		logger,
 		accessLogger,
 		bbsConfig.UpdateWorkers,
+		bbsConfig.MaxBulkRequestItems,
 		bbsConfig.ConvergenceWorkers,
 		activeDB,
+		desiredLRPRevisionDB,
+		quarantineDB,
+		encryptionProgressDB,
 		desiredHub,
 		actualHub,
+		taskHub,
+		eventLog,
 		cbWorkPool,
 		serviceClient,
 		auctioneerClient,
 		repClientFactory,
+		desiredLRPRolloutController,
+		clock,
 		migrationsDone,
 		exitChan,
+		authorizer,
+		auditor,
 	)
 
 	metricsNotifier := metrics.NewPeriodicMetronNotifier(logger)
@@ -274,18 +353,21 @@ func main() {
 		actualLRPController,
 		bbsConfig.ConvergenceWorkers,
 	)
-	taskController := controllers.NewTaskController(activeDB, cbWorkPool, auctioneerClient, serviceClient, repClientFactory)
+	taskController := controllers.NewTaskController(activeDB, cbWorkPool, auctioneerClient, serviceClient, repClientFactory, taskHub)
 
 	convergerProcess := converger.New(
 		logger,
 		clock,
 		lrpConvergenceController,
+		desiredLRPRolloutController,
 		taskController,
 		serviceClient,
 		time.Duration(bbsConfig.ConvergeRepeatInterval),
 		time.Duration(bbsConfig.KickTaskDuration),
 		time.Duration(bbsConfig.ExpirePendingTaskDuration),
 		time.Duration(bbsConfig.ExpireCompletedTaskDuration),
+		auditPruner,
+		time.Duration(bbsConfig.AuditLogRetention),
 	)
 
 	var server ifrit.Runner
@@ -295,7 +377,15 @@ func main() {
 		server = http_server.New(bbsConfig.ListenAddress, handler)
 	}
 
-	healthcheckServer := http_server.New(bbsConfig.HealthAddress, http.HandlerFunc(healthCheckHandler))
+	var healthHandler http.Handler = http.HandlerFunc(healthCheckHandler)
+	if prometheusSender != nil {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", prometheusSender.Handler())
+		mux.Handle("/", healthHandler)
+		healthHandler = mux
+	}
+
+	healthcheckServer := http_server.New(bbsConfig.HealthAddress, healthHandler)
 
 	members := grouper.Members{
 		{"healthcheck", healthcheckServer},
@@ -303,12 +393,41 @@ func main() {
 		{"server", server},
 		{"migration-manager", migrationManager},
 		{"encryptor", encryptor},
-		{"hub-maintainer", hubMaintainer(logger, desiredHub, actualHub)},
+		{"hub-maintainer", hubMaintainer(logger, desiredHub, actualHub, taskHub)},
 		{"metrics", *metricsNotifier},
 		{"converger", convergerProcess},
 		{"registration-runner", registrationRunner},
 	}
 
+	if bbsConfig.GRPCListenAddress != "" {
+		grpcHandler := handlers.NewGRPCServer(logger, handler, desiredHub, actualHub, taskHub, eventLog, migrationsDone, authorizer)
+		members = insertToMembersAfter(
+			members,
+			"server",
+			grouper.Member{"grpc-server", grpcServer(logger, bbsConfig.GRPCListenAddress, tlsConfig, grpcHandler)},
+		)
+	}
+
+	if recordEncoder != nil {
+		members = insertToMembersAfter(
+			members,
+			"encryptor",
+			grouper.Member{"record-encoder", recordEncoder},
+		)
+	}
+
+	if bbsConfig.EncryptionKeyDirectory != "" {
+		if bbsConfig.EncryptionKeyReloadInterval <= 0 {
+			logger.Fatal("invalid-encryption-key-reload-interval", errors.New("encryption_key_reload_interval must be positive"))
+		}
+		keyReloader := encryption.NewKeyReloader(logger, keyManager, clock, time.Duration(bbsConfig.EncryptionKeyReloadInterval))
+		members = insertToMembersAfter(
+			members,
+			"encryptor",
+			grouper.Member{"key-reloader", keyReloader},
+		)
+	}
+
 	if bbsConfig.DebugAddress != "" {
 		members = append(grouper.Members{
 			{"debug-server", debugserver.Runner(bbsConfig.DebugAddress, reconfigurableSink)},
@@ -425,6 +544,15 @@ func appendExtraConnectionStringParam(logger lager.Logger, driverName, databaseC
 		} else {
 			databaseConnectionString = fmt.Sprintf("%s sslmode=verify-ca sslrootcert=%s", databaseConnectionString, sqlCACertFile)
 		}
+	case "sqlite3":
+		// wait for the database lock instead of failing, take the write lock
+		// when a transaction begins so that it is never upgraded mid-way, and
+		// let readers proceed alongside the writer
+		separator := "?"
+		if strings.Contains(databaseConnectionString, "?") {
+			separator = "&"
+		}
+		databaseConnectionString += separator + "_busy_timeout=10000&_txlock=immediate&_journal_mode=WAL"
 	}
 
 	return databaseConnectionString
@@ -434,7 +562,7 @@ func healthCheckHandler(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
-func hubMaintainer(logger lager.Logger, desiredHub, actualHub events.Hub) ifrit.RunFunc {
+func hubMaintainer(logger lager.Logger, desiredHub, actualHub, taskHub events.Hub) ifrit.RunFunc {
 	return func(signals <-chan os.Signal, ready chan<- struct{}) error {
 		logger := logger.Session("hub-maintainer")
 		close(ready)
@@ -450,10 +578,53 @@ func hubMaintainer(logger lager.Logger, desiredHub, actualHub events.Hub) ifrit.
 		if err != nil {
 			logger.Error("error-closing-actual-hub", err)
 		}
+		err = taskHub.Close()
+		if err != nil {
+			logger.Error("error-closing-task-hub", err)
+		}
 		return nil
 	}
 }
 
+func grpcServer(logger lager.Logger, listenAddress string, tlsConfig *tls.Config, handler *handlers.GRPCServer) ifrit.RunFunc {
+	return func(signals <-chan os.Signal, ready chan<- struct{}) error {
+		logger := logger.Session("grpc-server")
+
+		listener, err := net.Listen("tcp", listenAddress)
+		if err != nil {
+			logger.Error("failed-to-listen", err)
+			return err
+		}
+
+		options := []grpc.ServerOption{}
+		if tlsConfig != nil {
+			options = append(options, grpc.Creds(credentials.NewTLS(tlsConfig)))
+		}
+
+		server := grpc.NewServer(options...)
+		models.RegisterBBSServer(server, handler)
+		models.RegisterInternalBBSServer(server, handler)
+
+		errChan := make(chan error, 1)
+		go func() {
+			errChan <- server.Serve(listener)
+		}()
+
+		close(ready)
+		logger.Info("started", lager.Data{"listen-address": listenAddress})
+		defer logger.Info("finished")
+
+		select {
+		case <-signals:
+			server.Stop()
+			return nil
+		case err := <-errChan:
+			logger.Error("failed-to-serve", err)
+			return err
+		}
+	}
+}
+
 func initializeRegistrationRunner(
 	logger lager.Logger,
 	consulClient consuladapter.Client,
@@ -498,7 +669,7 @@ func initializeAuctioneerClient(logger lager.Logger, bbsConfig *config.BBSConfig
 	}
 
 	if bbsConfig.AuctioneerCACert != "" || bbsConfig.AuctioneerClientCert != "" || bbsConfig.AuctioneerClientKey != "" {
-		client, err := auctioneer.NewSecureClient(bbsConfig.AuctioneerAddress,
+		client, err := tracedclients.NewSecureAuctioneerClient(bbsConfig.AuctioneerAddress,
 			bbsConfig.AuctioneerCACert,
 			bbsConfig.AuctioneerClientCert,
 			bbsConfig.AuctioneerClientKey,
@@ -510,15 +681,24 @@ func initializeAuctioneerClient(logger lager.Logger, bbsConfig *config.BBSConfig
 		return client
 	}
 
-	return auctioneer.NewClient(bbsConfig.AuctioneerAddress)
+	return tracedclients.NewAuctioneerClient(bbsConfig.AuctioneerAddress)
 }
 
-func initializeDropsonde(logger lager.Logger, bbsConfig *config.BBSConfig) {
+func initializeDropsonde(logger lager.Logger, bbsConfig *config.BBSConfig) *metrics.PrometheusSender {
 	dropsondeDestination := fmt.Sprint("localhost:", bbsConfig.DropsondePort)
 	err := dropsonde.Initialize(dropsondeDestination, dropsondeOrigin)
 	if err != nil {
 		logger.Error("failed-to-initialize-dropsonde", err)
 	}
+
+	if !bbsConfig.EnablePrometheusMetrics {
+		return nil
+	}
+
+	// record every metric for Prometheus as well as sending it to metron
+	sender := metrics.NewPrometheusSender(metric_sender.NewMetricSender(dropsonde.AutowiredEmitter()))
+	dropsonde_metrics.Initialize(sender, metricbatcher.New(sender, dropsondeBatchInterval))
+	return sender
 }
 
 func initializeEtcdDB(
@@ -579,6 +759,19 @@ func initializeEtcdStoreClient(logger lager.Logger, etcdOptions *etcddb.ETCDOpti
 	return etcddb.NewStoreClient(etcdClient)
 }
 
+// recordFormat returns the format the records are stored with in SQL,
+// depending on the record_compression.
+func recordFormat(compression string) (*format.Format, error) {
+	switch compression {
+	case "", config.RecordCompressionNone:
+		return format.ENCRYPTED_PROTO, nil
+	case config.RecordCompressionGzip:
+		return format.COMPRESSED_ENCRYPTED_PROTO, nil
+	default:
+		return nil, fmt.Errorf("unknown record_compression %q", compression)
+	}
+}
+
 func insertToMembersAfter(members grouper.Members, name string, extraMembers ...grouper.Member) grouper.Members {
 	for i, m := range members {
 		if m.Name == name {
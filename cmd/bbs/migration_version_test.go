@@ -9,6 +9,7 @@ import (
 	"code.cloudfoundry.org/bbs/cmd/bbs/testrunner"
 	"code.cloudfoundry.org/bbs/db/etcd"
 	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/test_helpers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gexec"
@@ -89,8 +90,13 @@ var _ = Describe("Migration Version", func() {
 			Expect(version.CurrentVersion).To(BeEquivalentTo(9999999999))
 			Expect(version.TargetVersion).To(BeEquivalentTo(9999999999))
 
+			query := `SELECT count(*) FROM information_schema.tables WHERE table_name = 'sweet_table'`
+			if test_helpers.UseSQLite() {
+				query = `SELECT count(*) FROM sqlite_master WHERE type = 'table' AND name = 'sweet_table'`
+			}
+
 			var count int
-			err = sqlConn.QueryRow(`SELECT count(*) FROM information_schema.tables WHERE table_name = 'sweet_table'`).Scan(&count)
+			err = sqlConn.QueryRow(query).Scan(&count)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(count).To(Equal(1))
 		})
@@ -98,6 +98,26 @@ var _ = Describe("Task API", func() {
 		})
 	})
 
+	Describe("DesireTasks", func() {
+		It("adds each of the desired tasks and reports an error for the invalid ones", func() {
+			taskDef := model_helpers.NewValidTaskDefinition()
+			results, err := client.DesireTasks(logger, []*models.DesireTaskRequest{
+				{TaskGuid: "task-1", Domain: "test", TaskDefinition: taskDef},
+				{TaskGuid: "task-2", Domain: "", TaskDefinition: taskDef},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(results).To(HaveLen(2))
+			Expect(results[0]).To(Equal(&models.TaskLifecycleResult{TaskGuid: "task-1"}))
+			Expect(results[1].TaskGuid).To(Equal("task-2"))
+			Expect(results[1].Error.Type).To(Equal(models.Error_InvalidRequest))
+
+			_, err = client.TaskByGuid(logger, "task-1")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = client.TaskByGuid(logger, "task-2")
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+		})
+	})
+
 	Describe("Task Lifecycle", func() {
 		var taskDef = model_helpers.NewValidTaskDefinition()
 		const taskGuid = "task-1"
@@ -6,8 +6,8 @@ import (
 	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/events"
 	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/tracedclients"
 	"code.cloudfoundry.org/lager"
-	"code.cloudfoundry.org/rep"
 )
 
 type ActualLRPLifecycleController struct {
@@ -16,7 +16,7 @@ type ActualLRPLifecycleController struct {
 	desiredLRPDB     db.DesiredLRPDB
 	auctioneerClient auctioneer.Client
 	serviceClient    bbs.ServiceClient
-	repClientFactory rep.ClientFactory
+	repClientFactory tracedclients.RepClientFactory
 	actualHub        events.Hub
 }
 
@@ -26,7 +26,7 @@ func NewActualLRPLifecycleController(
 	desiredLRPDB db.DesiredLRPDB,
 	auctioneerClient auctioneer.Client,
 	serviceClient bbs.ServiceClient,
-	repClientFactory rep.ClientFactory,
+	repClientFactory tracedclients.RepClientFactory,
 	actualHub events.Hub,
 ) *ActualLRPLifecycleController {
 	return &ActualLRPLifecycleController{
@@ -171,8 +171,8 @@ func (h *ActualLRPLifecycleController) RetireActualLRP(logger lager.Logger, key
 				return err
 			}
 
-			var client rep.Client
-			client, err = h.repClientFactory.CreateClient(cell.RepAddress, cell.RepUrl)
+			var client tracedclients.RepClient
+			client, err = h.repClientFactory.CreateClient(logger, cell.RepAddress, cell.RepUrl)
 			if err != nil {
 				return err
 			}
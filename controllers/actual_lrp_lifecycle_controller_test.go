@@ -10,8 +10,8 @@ import (
 	"code.cloudfoundry.org/bbs/events/eventfakes"
 	"code.cloudfoundry.org/bbs/fake_bbs"
 	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/tracedclients/tracedclientsfakes"
 	"code.cloudfoundry.org/lager/lagertest"
-	"code.cloudfoundry.org/rep/repfakes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -39,8 +39,8 @@ var _ = Describe("ActualLRP Lifecycle Controller", func() {
 		logger = lagertest.NewTestLogger("test")
 
 		fakeServiceClient = new(fake_bbs.FakeServiceClient)
-		fakeRepClientFactory = new(repfakes.FakeClientFactory)
-		fakeRepClient = new(repfakes.FakeClient)
+		fakeRepClientFactory = new(tracedclientsfakes.FakeRepClientFactory)
+		fakeRepClient = new(tracedclientsfakes.FakeRepClient)
 		fakeRepClientFactory.CreateClientReturns(fakeRepClient, nil)
 
 		actualHub = &eventfakes.FakeHub{}
@@ -793,7 +793,8 @@ var _ = Describe("ActualLRP Lifecycle Controller", func() {
 
 					It("stops the LRPs", func() {
 						Expect(fakeRepClientFactory.CreateClientCallCount()).To(Equal(1))
-						Expect(fakeRepClientFactory.CreateClientArgsForCall(0)).To(Equal(cellPresence.RepAddress))
+						_, repAddr, _ := fakeRepClientFactory.CreateClientArgsForCall(0)
+						Expect(repAddr).To(Equal(cellPresence.RepAddress))
 
 						Expect(fakeServiceClient.CellByIdCallCount()).To(Equal(1))
 						_, fetchedCellID := fakeServiceClient.CellByIdArgsForCall(0)
@@ -824,7 +825,7 @@ var _ = Describe("ActualLRP Lifecycle Controller", func() {
 
 						It("passes the url when creating a rep client", func() {
 							Expect(fakeRepClientFactory.CreateClientCallCount()).To(Equal(1))
-							repAddr, repURL := fakeRepClientFactory.CreateClientArgsForCall(0)
+							_, repAddr, repURL := fakeRepClientFactory.CreateClientArgsForCall(0)
 							Expect(repAddr).To(Equal(cellPresence.RepAddress))
 							Expect(repURL).To(Equal(cellPresence.RepUrl))
 						})
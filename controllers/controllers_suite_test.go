@@ -2,9 +2,9 @@ package controllers_test
 
 import (
 	"code.cloudfoundry.org/bbs/fake_bbs"
+	"code.cloudfoundry.org/bbs/tracedclients/tracedclientsfakes"
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
-	"code.cloudfoundry.org/rep/repfakes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -18,15 +18,15 @@ func TestControllers(t *testing.T) {
 
 var (
 	fakeServiceClient    *fake_bbs.FakeServiceClient
-	fakeRepClient        *repfakes.FakeClient
-	fakeRepClientFactory *repfakes.FakeClientFactory
+	fakeRepClient        *tracedclientsfakes.FakeRepClient
+	fakeRepClientFactory *tracedclientsfakes.FakeRepClientFactory
 	logger               lager.Logger
 )
 
 var _ = BeforeEach(func() {
 	logger = lagertest.NewTestLogger("test")
 	fakeServiceClient = new(fake_bbs.FakeServiceClient)
-	fakeRepClientFactory = new(repfakes.FakeClientFactory)
-	fakeRepClient = new(repfakes.FakeClient)
+	fakeRepClientFactory = new(tracedclientsfakes.FakeRepClientFactory)
+	fakeRepClient = new(tracedclientsfakes.FakeRepClient)
 	fakeRepClientFactory.CreateClientReturns(fakeRepClient, nil)
 })
@@ -0,0 +1,475 @@
+package controllers
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/auctioneer"
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/tracedclients"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+)
+
+// DefaultRolloutStartTimeout bounds how long a rollout waits for each batch
+// of replacement instances of DesiredLRPs that do not specify a start timeout.
+const DefaultRolloutStartTimeout = 10 * time.Minute
+
+// maxReplaceAttempts bounds how many times a rollout tries to replace an
+// instance before it fails.
+const maxReplaceAttempts = 3
+
+// DesiredLRPRolloutController replaces the instances of a DesiredLRP with
+// instances of its current definition after its run info changed. The
+// progress of every rollout is recorded in the rolloutDB so that a rollout
+// interrupted by a restart or a change of lock holder is resumed by
+// ResumeRollouts.
+type DesiredLRPRolloutController struct {
+	rolloutDB        db.DesiredLRPRolloutDB
+	desiredLRPDB     db.DesiredLRPDB
+	actualLRPDB      db.ActualLRPDB
+	evacuationDB     db.EvacuationDB
+	actualHub        events.Hub
+	auctioneerClient auctioneer.Client
+	serviceClient    bbs.ServiceClient
+	repClientFactory tracedclients.RepClientFactory
+	clock            clock.Clock
+
+	running     map[string]chan struct{}
+	runningLock sync.Mutex
+}
+
+func NewDesiredLRPRolloutController(
+	rolloutDB db.DesiredLRPRolloutDB,
+	desiredLRPDB db.DesiredLRPDB,
+	actualLRPDB db.ActualLRPDB,
+	evacuationDB db.EvacuationDB,
+	actualHub events.Hub,
+	auctioneerClient auctioneer.Client,
+	serviceClient bbs.ServiceClient,
+	repClientFactory tracedclients.RepClientFactory,
+	clock clock.Clock,
+) *DesiredLRPRolloutController {
+	return &DesiredLRPRolloutController{
+		rolloutDB:        rolloutDB,
+		desiredLRPDB:     desiredLRPDB,
+		actualLRPDB:      actualLRPDB,
+		evacuationDB:     evacuationDB,
+		actualHub:        actualHub,
+		auctioneerClient: auctioneerClient,
+		serviceClient:    serviceClient,
+		repClientFactory: repClientFactory,
+		clock:            clock,
+		running:          map[string]chan struct{}{},
+	}
+}
+
+// StartRollout records a rollout of the DesiredLRP, superseding any rollout
+// in progress for it, and starts replacing its instances at most maxInFlight
+// at a time. The rollout fails once it has taken longer than the start
+// timeout of the DesiredLRP for each batch of maxInFlight instances.
+func (c *DesiredLRPRolloutController) StartRollout(logger lager.Logger, desiredLRP *models.DesiredLRP, maxInFlight int32) error {
+	logger = logger.Session("start-rollout", lager.Data{"process_guid": desiredLRP.ProcessGuid, "max_in_flight": maxInFlight})
+
+	if maxInFlight <= 0 {
+		maxInFlight = 1
+	}
+
+	batches := (desiredLRP.Instances + maxInFlight - 1) / maxInFlight
+	if batches < 1 {
+		batches = 1
+	}
+
+	now := c.clock.Now()
+	rollout := &models.DesiredLRPRollout{
+		ProcessGuid:     desiredLRP.ProcessGuid,
+		ModificationTag: copyModificationTag(desiredLRP.ModificationTag),
+		MaxInFlight:     maxInFlight,
+		StartedAt:       now.UnixNano(),
+		Deadline:        now.Add(startTimeout(desiredLRP) * time.Duration(batches)).UnixNano(),
+		State:           models.DesiredLRPRolloutStateInProgress,
+	}
+
+	err := c.rolloutDB.UpsertDesiredLRPRollout(logger, rollout)
+	if err != nil {
+		logger.Error("failed-recording-rollout", err)
+		return err
+	}
+
+	c.run(logger, desiredLRP, rollout)
+	return nil
+}
+
+// FailRollout records that the rollout of the DesiredLRP could not be
+// started, superseding any rollout in progress for it.
+func (c *DesiredLRPRolloutController) FailRollout(logger lager.Logger, processGuid, reason string) {
+	logger = logger.Session("fail-rollout", lager.Data{"process_guid": processGuid, "reason": reason})
+
+	c.stop(processGuid)
+
+	now := c.clock.Now().UnixNano()
+	err := c.rolloutDB.UpsertDesiredLRPRollout(logger, &models.DesiredLRPRollout{
+		ProcessGuid:   processGuid,
+		StartedAt:     now,
+		Deadline:      now,
+		State:         models.DesiredLRPRolloutStateFailed,
+		FailureReason: reason,
+	})
+	if err != nil {
+		logger.Error("failed-recording-rollout", err)
+	}
+}
+
+// CancelRollout stops the rollout of a removed DesiredLRP and forgets it.
+func (c *DesiredLRPRolloutController) CancelRollout(logger lager.Logger, processGuid string) {
+	logger = logger.Session("cancel-rollout", lager.Data{"process_guid": processGuid})
+
+	c.stop(processGuid)
+
+	err := c.rolloutDB.RemoveDesiredLRPRollout(logger, processGuid)
+	if err != nil && err != models.ErrResourceNotFound {
+		logger.Error("failed-removing-rollout", err)
+	}
+}
+
+// ResumeRollouts continues the rollouts left in progress that are not running
+// in this process, failing those that are past their deadline and forgetting
+// those whose DesiredLRP was removed.
+func (c *DesiredLRPRolloutController) ResumeRollouts(logger lager.Logger) error {
+	logger = logger.Session("resume-rollouts")
+
+	rollouts, err := c.rolloutDB.DesiredLRPRollouts(logger)
+	if err != nil {
+		logger.Error("failed-fetching-rollouts", err)
+		return err
+	}
+
+	now := c.clock.Now().UnixNano()
+	for _, rollout := range rollouts {
+		if rollout.State != models.DesiredLRPRolloutStateInProgress || c.isRunning(rollout.ProcessGuid) {
+			continue
+		}
+
+		rolloutLogger := logger.WithData(lager.Data{"process_guid": rollout.ProcessGuid})
+
+		if rollout.Deadline <= now {
+			c.failRollout(rolloutLogger, rollout, "timed out waiting for replacement instances")
+			continue
+		}
+
+		desiredLRP, err := c.desiredLRPDB.DesiredLRPByProcessGuid(rolloutLogger, rollout.ProcessGuid)
+		if err == models.ErrResourceNotFound {
+			err = c.rolloutDB.RemoveDesiredLRPRollout(rolloutLogger, rollout.ProcessGuid)
+			if err != nil && err != models.ErrResourceNotFound {
+				rolloutLogger.Error("failed-removing-rollout", err)
+			}
+			continue
+		} else if err != nil {
+			rolloutLogger.Error("failed-fetching-desired-lrp", err)
+			continue
+		}
+
+		rolloutLogger.Info("resuming-rollout", lager.Data{"replaced_indices": rollout.ReplacedIndices})
+		c.run(rolloutLogger, desiredLRP, rollout)
+	}
+
+	return nil
+}
+
+func (c *DesiredLRPRolloutController) run(logger lager.Logger, desiredLRP *models.DesiredLRP, rollout *models.DesiredLRPRollout) {
+	c.runningLock.Lock()
+	defer c.runningLock.Unlock()
+
+	if cancel, ok := c.running[rollout.ProcessGuid]; ok {
+		close(cancel)
+	}
+
+	cancel := make(chan struct{})
+	c.running[rollout.ProcessGuid] = cancel
+	go c.roll(logger, desiredLRP, rollout, cancel)
+}
+
+func (c *DesiredLRPRolloutController) stop(processGuid string) {
+	c.runningLock.Lock()
+	defer c.runningLock.Unlock()
+
+	if cancel, ok := c.running[processGuid]; ok {
+		close(cancel)
+		delete(c.running, processGuid)
+	}
+}
+
+func (c *DesiredLRPRolloutController) finish(processGuid string, cancel <-chan struct{}) {
+	c.runningLock.Lock()
+	defer c.runningLock.Unlock()
+
+	if c.running[processGuid] == cancel {
+		delete(c.running, processGuid)
+	}
+}
+
+func (c *DesiredLRPRolloutController) isRunning(processGuid string) bool {
+	c.runningLock.Lock()
+	defer c.runningLock.Unlock()
+
+	_, ok := c.running[processGuid]
+	return ok
+}
+
+// roll replaces the claimed and running instances of the DesiredLRP that the
+// rollout has not replaced yet, at most MaxInFlight at a time. Each instance
+// is evacuated in place and its index auctioned again; the evacuated instance
+// keeps running until its replacement is running, and is then stopped. Every
+// replaced index is recorded. An instance that cannot be replaced is tried
+// again after the others, and the rollout fails when one still cannot be
+// replaced after maxReplaceAttempts or when its deadline passes.
+func (c *DesiredLRPRolloutController) roll(logger lager.Logger, desiredLRP *models.DesiredLRP, rollout *models.DesiredLRPRollout, cancel <-chan struct{}) {
+	logger = logger.Session("roll-actual-lrps", lager.Data{"max_in_flight": rollout.MaxInFlight})
+	logger.Info("starting")
+	defer logger.Info("complete")
+	defer c.finish(rollout.ProcessGuid, cancel)
+
+	source, err := c.actualHub.SubscribeWithFilter(models.EventFilter{
+		ProcessGuids: []string{desiredLRP.ProcessGuid},
+		EventTypes:   []string{models.EventTypeActualLRPChanged},
+	})
+	if err != nil {
+		logger.Error("failed-subscribing-to-actual-lrp-events", err)
+		return
+	}
+	defer source.Close()
+
+	eventChan := make(chan models.Event)
+	errorChan := make(chan error)
+	closeChan := make(chan struct{})
+	defer close(closeChan)
+
+	go func() {
+		for {
+			event, err := source.Next()
+			if err != nil {
+				select {
+				case errorChan <- err:
+				case <-closeChan:
+				}
+				return
+			}
+
+			select {
+			case eventChan <- event:
+			case <-closeChan:
+				return
+			}
+		}
+	}()
+
+	actualLRPGroups, err := c.actualLRPDB.ActualLRPGroupsByProcessGuid(logger.Session("fetch-actuals"), desiredLRP.ProcessGuid)
+	if err != nil {
+		logger.Error("failed-fetching-actual-lrps", err)
+		return
+	}
+
+	groups := map[int32]*models.ActualLRPGroup{}
+	for _, group := range actualLRPGroups {
+		if group.Instance != nil {
+			groups[group.Instance.Index] = group
+		} else if group.Evacuating != nil {
+			groups[group.Evacuating.Index] = group
+		}
+	}
+
+	pending := []*models.ActualLRP{}
+	inFlight := map[int32]*models.ActualLRP{}
+	for index := int32(0); index < desiredLRP.Instances; index++ {
+		group, ok := groups[index]
+		if !ok || rollout.Replaced(index) {
+			continue
+		}
+
+		lrp := group.Instance
+		if group.Evacuating != nil {
+			// the replacement was requested before the rollout was interrupted
+			if lrp != nil && lrp.State == models.ActualLRPStateRunning && !lrp.ActualLRPInstanceKey.Equal(group.Evacuating.ActualLRPInstanceKey) {
+				c.stopActualLRP(logger, group.Evacuating)
+				if !c.recordReplaced(logger, rollout, index) {
+					return
+				}
+			} else {
+				inFlight[index] = group.Evacuating
+			}
+			continue
+		}
+
+		if lrp != nil && (lrp.State == models.ActualLRPStateClaimed || lrp.State == models.ActualLRPStateRunning) {
+			pending = append(pending, lrp)
+		}
+	}
+
+	timeout := startTimeout(desiredLRP)
+	deadline := c.clock.NewTimer(time.Unix(0, rollout.Deadline).Sub(c.clock.Now()))
+	defer deadline.Stop()
+
+	attempts := map[int32]int{}
+	for len(pending) > 0 || len(inFlight) > 0 {
+		for int32(len(inFlight)) < rollout.MaxInFlight && len(pending) > 0 {
+			lrp := pending[0]
+			pending = pending[1:]
+
+			if c.replaceActualLRP(logger, desiredLRP, lrp, timeout) {
+				inFlight[lrp.Index] = lrp
+				continue
+			}
+
+			attempts[lrp.Index]++
+			if attempts[lrp.Index] >= maxReplaceAttempts {
+				logger.Info("gave-up-replacing-actual-lrp", lager.Data{"index": lrp.Index, "attempts": attempts[lrp.Index]})
+				c.failRollout(logger, rollout, fmt.Sprintf("failed to replace the instance at index %d", lrp.Index))
+				return
+			}
+			pending = append(pending, lrp)
+		}
+
+		if len(inFlight) == 0 {
+			continue
+		}
+
+		select {
+		case event := <-eventChan:
+			changed, ok := event.(*models.ActualLRPChangedEvent)
+			if !ok || changed.After == nil || changed.After.Instance == nil {
+				continue
+			}
+
+			replacement := changed.After.Instance
+			lrp, ok := inFlight[replacement.Index]
+			if !ok || replacement.State != models.ActualLRPStateRunning || replacement.ActualLRPInstanceKey.Equal(lrp.ActualLRPInstanceKey) {
+				continue
+			}
+
+			logger.Info("replaced-actual-lrp", lager.Data{"index": lrp.Index, "instance_guid": lrp.InstanceGuid})
+			delete(inFlight, lrp.Index)
+			c.stopActualLRP(logger, lrp)
+			if !c.recordReplaced(logger, rollout, lrp.Index) {
+				return
+			}
+
+		case err := <-errorChan:
+			// the rollout stays in progress and is resumed by ResumeRollouts
+			logger.Error("failed-getting-next-event", err)
+			return
+
+		case <-deadline.C():
+			logger.Info("timed-out-waiting-for-replacements", lager.Data{"pending": len(pending), "in_flight": len(inFlight)})
+			c.failRollout(logger, rollout, "timed out waiting for replacement instances")
+			return
+
+		case <-cancel:
+			logger.Info("cancelled")
+			return
+		}
+	}
+
+	rollout.State = models.DesiredLRPRolloutStateComplete
+	err = c.rolloutDB.UpdateDesiredLRPRollout(logger, rollout)
+	if err != nil {
+		logger.Error("failed-recording-rollout-complete", err)
+	}
+}
+
+// recordReplaced records that the instance at index was replaced, and returns
+// false when the rollout was superseded or removed in the meantime.
+func (c *DesiredLRPRolloutController) recordReplaced(logger lager.Logger, rollout *models.DesiredLRPRollout, index int32) bool {
+	rollout.ReplacedIndices = append(rollout.ReplacedIndices, index)
+	err := c.rolloutDB.UpdateDesiredLRPRollout(logger, rollout)
+	if err == models.ErrResourceNotFound {
+		logger.Info("rollout-superseded")
+		return false
+	} else if err != nil {
+		logger.Error("failed-recording-replaced-index", err, lager.Data{"index": index})
+	}
+	return true
+}
+
+func (c *DesiredLRPRolloutController) failRollout(logger lager.Logger, rollout *models.DesiredLRPRollout, reason string) {
+	rollout.State = models.DesiredLRPRolloutStateFailed
+	rollout.FailureReason = reason
+	err := c.rolloutDB.UpdateDesiredLRPRollout(logger, rollout)
+	if err != nil && err != models.ErrResourceNotFound {
+		logger.Error("failed-recording-rollout-failure", err)
+	}
+}
+
+// replaceActualLRP moves the instance into the evacuating slot of its index,
+// where it keeps running until the StartActualLRP of its replacement removes
+// it, and requests an auction for the replacement.
+func (c *DesiredLRPRolloutController) replaceActualLRP(logger lager.Logger, desiredLRP *models.DesiredLRP, lrp *models.ActualLRP, ttl time.Duration) bool {
+	logger = logger.Session("replace-actual-lrp", lager.Data{"index": lrp.Index, "instance_guid": lrp.InstanceGuid})
+
+	ttlInSeconds := uint64((ttl + time.Second - 1) / time.Second)
+	evacuating, err := c.evacuationDB.EvacuateActualLRP(logger, &lrp.ActualLRPKey, &lrp.ActualLRPInstanceKey, &lrp.ActualLRPNetInfo, ttlInSeconds)
+	if err != nil {
+		logger.Error("failed-evacuating-actual-lrp", err)
+		return false
+	}
+	go c.actualHub.Emit(models.NewActualLRPCreatedEvent(evacuating))
+
+	before, after, err := c.actualLRPDB.UnclaimActualLRP(logger, &lrp.ActualLRPKey)
+	if err != nil {
+		logger.Error("failed-unclaiming-actual-lrp", err)
+		err = c.evacuationDB.RemoveEvacuatingActualLRP(logger, &lrp.ActualLRPKey, &lrp.ActualLRPInstanceKey)
+		if err != nil {
+			logger.Error("failed-removing-evacuating-actual-lrp", err)
+		} else {
+			go c.actualHub.Emit(models.NewActualLRPRemovedEvent(evacuating))
+		}
+		return false
+	}
+	go c.actualHub.Emit(models.NewActualLRPChangedEvent(before, after))
+
+	schedulingInfo := desiredLRP.DesiredLRPSchedulingInfo()
+	start := auctioneer.NewLRPStartRequestFromSchedulingInfo(&schedulingInfo, int(lrp.Index))
+	err = c.auctioneerClient.RequestLRPAuctions(logger, []*auctioneer.LRPStartRequest{&start})
+	if err != nil {
+		logger.Error("failed-to-request-auction", err)
+	}
+
+	return true
+}
+
+func (c *DesiredLRPRolloutController) stopActualLRP(logger lager.Logger, lrp *models.ActualLRP) {
+	cellPresence, err := c.serviceClient.CellById(logger, lrp.CellId)
+	if err != nil {
+		logger.Error("failed-fetching-cell-presence", err)
+		return
+	}
+	repClient, err := c.repClientFactory.CreateClient(logger, cellPresence.RepAddress, cellPresence.RepUrl)
+	if err != nil {
+		logger.Error("create-rep-client-failed", err)
+		return
+	}
+	logger.Debug("stopping-lrp-instance")
+	err = repClient.StopLRPInstance(lrp.ActualLRPKey, lrp.ActualLRPInstanceKey)
+	if err != nil {
+		logger.Error("failed-stopping-lrp-instance", err)
+	}
+}
+
+func startTimeout(desiredLRP *models.DesiredLRP) time.Duration {
+	timeout := time.Duration(desiredLRP.StartTimeoutMs) * time.Millisecond
+	if timeout == 0 {
+		timeout = DefaultRolloutStartTimeout
+	}
+	return timeout
+}
+
+func copyModificationTag(tag *models.ModificationTag) *models.ModificationTag {
+	if tag == nil {
+		return nil
+	}
+	copied := *tag
+	return &copied
+}
@@ -0,0 +1,531 @@
+package controllers_test
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/auctioneer"
+	"code.cloudfoundry.org/auctioneer/auctioneerfakes"
+	"code.cloudfoundry.org/bbs/controllers"
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/events/eventfakes"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DesiredLRP Rollout Controller", func() {
+	var (
+		fakeRolloutDB        *dbfakes.FakeDesiredLRPRolloutDB
+		fakeDesiredLRPDB     *dbfakes.FakeDesiredLRPDB
+		fakeActualLRPDB      *dbfakes.FakeActualLRPDB
+		fakeEvacuationDB     *dbfakes.FakeEvacuationDB
+		fakeAuctioneerClient *auctioneerfakes.FakeClient
+		actualHub            *eventfakes.FakeHub
+		fakeClock            *fakeclock.FakeClock
+
+		processGuid     string
+		desiredLRP      *models.DesiredLRP
+		actualLRPs      []*models.ActualLRP
+		actualLRPGroups []*models.ActualLRPGroup
+
+		actualEvents chan models.Event
+		eventSource  *eventfakes.FakeEventSource
+
+		updatesLock sync.Mutex
+		updates     []models.DesiredLRPRollout
+		updateErr   error
+
+		controller *controllers.DesiredLRPRolloutController
+	)
+
+	recordedUpdates := func() []models.DesiredLRPRollout {
+		updatesLock.Lock()
+		defer updatesLock.Unlock()
+		return append([]models.DesiredLRPRollout{}, updates...)
+	}
+
+	lastUpdate := func() models.DesiredLRPRollout {
+		recorded := recordedUpdates()
+		Expect(recorded).NotTo(BeEmpty())
+		return recorded[len(recorded)-1]
+	}
+
+	runningReplacement := func(lrp *models.ActualLRP) models.Event {
+		replacement := *lrp
+		replacement.State = models.ActualLRPStateRunning
+		replacement.ActualLRPInstanceKey = models.NewActualLRPInstanceKey("new-instance-guid", "other-cell")
+		return models.NewActualLRPChangedEvent(
+			&models.ActualLRPGroup{Instance: &models.ActualLRP{ActualLRPKey: lrp.ActualLRPKey, State: models.ActualLRPStateClaimed}},
+			&models.ActualLRPGroup{Instance: &replacement},
+		)
+	}
+
+	BeforeEach(func() {
+		fakeRolloutDB = new(dbfakes.FakeDesiredLRPRolloutDB)
+		fakeDesiredLRPDB = new(dbfakes.FakeDesiredLRPDB)
+		fakeActualLRPDB = new(dbfakes.FakeActualLRPDB)
+		fakeEvacuationDB = new(dbfakes.FakeEvacuationDB)
+		fakeAuctioneerClient = new(auctioneerfakes.FakeClient)
+		actualHub = new(eventfakes.FakeHub)
+		fakeClock = fakeclock.NewFakeClock(time.Unix(100, 0))
+
+		processGuid = "some-guid"
+		desiredLRP = model_helpers.NewValidDesiredLRP(processGuid)
+		desiredLRP.Instances = 2
+		desiredLRP.StartTimeoutMs = 60000
+		desiredLRP.ModificationTag = &models.ModificationTag{Epoch: "some-epoch", Index: 3}
+
+		actualLRPs = []*models.ActualLRP{}
+		actualLRPGroups = []*models.ActualLRPGroup{}
+		for i := int32(0); i < 3; i++ {
+			lrp := model_helpers.NewValidActualLRP(processGuid, i)
+			lrp.ActualLRPInstanceKey = models.NewActualLRPInstanceKey(fmt.Sprintf("instance-guid-%d", i), "some-cell")
+			actualLRPs = append(actualLRPs, lrp)
+			actualLRPGroups = append(actualLRPGroups, &models.ActualLRPGroup{Instance: lrp})
+		}
+		fakeActualLRPDB.ActualLRPGroupsByProcessGuidStub = func(lager.Logger, string) ([]*models.ActualLRPGroup, error) {
+			return actualLRPGroups, nil
+		}
+
+		fakeServiceClient.CellByIdReturns(&models.CellPresence{RepAddress: "some-address", RepUrl: "http://some-address"}, nil)
+
+		actualEvents = make(chan models.Event, 10)
+		closed := make(chan struct{})
+		eventSource = new(eventfakes.FakeEventSource)
+		eventSource.NextStub = func() (models.Event, error) {
+			select {
+			case event := <-actualEvents:
+				return event, nil
+			case <-closed:
+				return nil, events.ErrSourceClosed
+			}
+		}
+		eventSource.CloseStub = func() error {
+			close(closed)
+			return nil
+		}
+		actualHub.SubscribeWithFilterReturns(eventSource, nil)
+
+		updates = nil
+		updateErr = nil
+		fakeRolloutDB.UpdateDesiredLRPRolloutStub = func(_ lager.Logger, rollout *models.DesiredLRPRollout) error {
+			updatesLock.Lock()
+			defer updatesLock.Unlock()
+
+			recorded := *rollout
+			recorded.ReplacedIndices = append([]int32{}, rollout.ReplacedIndices...)
+			updates = append(updates, recorded)
+			return updateErr
+		}
+
+		controller = controllers.NewDesiredLRPRolloutController(
+			fakeRolloutDB,
+			fakeDesiredLRPDB,
+			fakeActualLRPDB,
+			fakeEvacuationDB,
+			actualHub,
+			fakeAuctioneerClient,
+			fakeServiceClient,
+			fakeRepClientFactory,
+			fakeClock,
+		)
+	})
+
+	Describe("StartRollout", func() {
+		var (
+			maxInFlight int32
+			err         error
+		)
+
+		BeforeEach(func() {
+			maxInFlight = 1
+		})
+
+		JustBeforeEach(func() {
+			err = controller.StartRollout(logger, desiredLRP, maxInFlight)
+		})
+
+		AfterEach(func() {
+			controller.CancelRollout(logger, processGuid)
+		})
+
+		It("records the rollout with a deadline of one start timeout per batch", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeRolloutDB.UpsertDesiredLRPRolloutCallCount()).To(Equal(1))
+			_, rollout := fakeRolloutDB.UpsertDesiredLRPRolloutArgsForCall(0)
+			Expect(rollout.ProcessGuid).To(Equal(processGuid))
+			Expect(rollout.ModificationTag).To(Equal(desiredLRP.ModificationTag))
+			Expect(rollout.MaxInFlight).To(BeEquivalentTo(1))
+			Expect(rollout.StartedAt).To(Equal(fakeClock.Now().UnixNano()))
+			Expect(rollout.Deadline).To(Equal(fakeClock.Now().Add(2 * time.Minute).UnixNano()))
+			Expect(rollout.State).To(Equal(models.DesiredLRPRolloutStateInProgress))
+		})
+
+		It("subscribes to changes of the actual lrps", func() {
+			Eventually(actualHub.SubscribeWithFilterCallCount).Should(Equal(1))
+			Expect(actualHub.SubscribeWithFilterArgsForCall(0)).To(Equal(models.EventFilter{
+				ProcessGuids: []string{processGuid},
+				EventTypes:   []string{models.EventTypeActualLRPChanged},
+			}))
+		})
+
+		It("replaces the first instance", func() {
+			Eventually(fakeEvacuationDB.EvacuateActualLRPCallCount).Should(Equal(1))
+			_, key, instanceKey, netInfo, ttl := fakeEvacuationDB.EvacuateActualLRPArgsForCall(0)
+			Expect(*key).To(Equal(actualLRPs[0].ActualLRPKey))
+			Expect(*instanceKey).To(Equal(actualLRPs[0].ActualLRPInstanceKey))
+			Expect(*netInfo).To(Equal(actualLRPs[0].ActualLRPNetInfo))
+			Expect(ttl).To(BeEquivalentTo(60))
+
+			Eventually(fakeActualLRPDB.UnclaimActualLRPCallCount).Should(Equal(1))
+			_, key = fakeActualLRPDB.UnclaimActualLRPArgsForCall(0)
+			Expect(*key).To(Equal(actualLRPs[0].ActualLRPKey))
+
+			Eventually(fakeAuctioneerClient.RequestLRPAuctionsCallCount).Should(Equal(1))
+			_, startRequests := fakeAuctioneerClient.RequestLRPAuctionsArgsForCall(0)
+			schedulingInfo := desiredLRP.DesiredLRPSchedulingInfo()
+			expectedStartRequest := auctioneer.NewLRPStartRequestFromSchedulingInfo(&schedulingInfo, 0)
+			Expect(startRequests).To(ConsistOf(&expectedStartRequest))
+
+			Consistently(fakeEvacuationDB.EvacuateActualLRPCallCount).Should(Equal(1))
+			Expect(fakeRepClient.StopLRPInstanceCallCount()).To(Equal(0))
+		})
+
+		Context("when the replacement is running", func() {
+			JustBeforeEach(func() {
+				Eventually(fakeEvacuationDB.EvacuateActualLRPCallCount).Should(Equal(1))
+				actualEvents <- runningReplacement(actualLRPs[0])
+			})
+
+			It("stops the old instance, records it replaced and replaces the next one", func() {
+				Eventually(fakeRepClient.StopLRPInstanceCallCount).Should(Equal(1))
+				key, instanceKey := fakeRepClient.StopLRPInstanceArgsForCall(0)
+				Expect(key).To(Equal(actualLRPs[0].ActualLRPKey))
+				Expect(instanceKey).To(Equal(actualLRPs[0].ActualLRPInstanceKey))
+
+				Eventually(recordedUpdates).Should(HaveLen(1))
+				Expect(lastUpdate().ReplacedIndices).To(Equal([]int32{0}))
+
+				Eventually(fakeEvacuationDB.EvacuateActualLRPCallCount).Should(Equal(2))
+				_, key2, _, _, _ := fakeEvacuationDB.EvacuateActualLRPArgsForCall(1)
+				Expect(*key2).To(Equal(actualLRPs[1].ActualLRPKey))
+			})
+
+			It("completes the rollout without replacing instances beyond the desired number of instances", func() {
+				Eventually(fakeEvacuationDB.EvacuateActualLRPCallCount).Should(Equal(2))
+				actualEvents <- runningReplacement(actualLRPs[1])
+
+				Eventually(eventSource.CloseCallCount).Should(Equal(1))
+				Expect(fakeEvacuationDB.EvacuateActualLRPCallCount()).To(Equal(2))
+				Expect(fakeRepClient.StopLRPInstanceCallCount()).To(Equal(2))
+
+				Expect(lastUpdate().State).To(Equal(models.DesiredLRPRolloutStateComplete))
+				Expect(lastUpdate().ReplacedIndices).To(Equal([]int32{0, 1}))
+			})
+
+			Context("when the rollout was superseded", func() {
+				BeforeEach(func() {
+					updateErr = models.ErrResourceNotFound
+				})
+
+				It("stops rolling", func() {
+					Eventually(eventSource.CloseCallCount).Should(Equal(1))
+					Expect(fakeEvacuationDB.EvacuateActualLRPCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		Context("when max_in_flight allows several replacements", func() {
+			BeforeEach(func() {
+				maxInFlight = 2
+			})
+
+			It("replaces that many instances at once", func() {
+				Eventually(fakeEvacuationDB.EvacuateActualLRPCallCount).Should(Equal(2))
+			})
+
+			It("allows one start timeout for the single batch", func() {
+				_, rollout := fakeRolloutDB.UpsertDesiredLRPRolloutArgsForCall(0)
+				Expect(rollout.Deadline).To(Equal(fakeClock.Now().Add(time.Minute).UnixNano()))
+			})
+		})
+
+		Context("when no max_in_flight is given", func() {
+			BeforeEach(func() {
+				maxInFlight = 0
+			})
+
+			It("replaces one instance at a time", func() {
+				_, rollout := fakeRolloutDB.UpsertDesiredLRPRolloutArgsForCall(0)
+				Expect(rollout.MaxInFlight).To(BeEquivalentTo(1))
+			})
+		})
+
+		Context("when an instance is neither claimed nor running", func() {
+			BeforeEach(func() {
+				actualLRPs[0].State = models.ActualLRPStateCrashed
+			})
+
+			It("leaves it alone", func() {
+				Eventually(fakeEvacuationDB.EvacuateActualLRPCallCount).Should(Equal(1))
+				_, key, _, _, _ := fakeEvacuationDB.EvacuateActualLRPArgsForCall(0)
+				Expect(*key).To(Equal(actualLRPs[1].ActualLRPKey))
+			})
+		})
+
+		Context("when unclaiming the instance fails", func() {
+			BeforeEach(func() {
+				fakeActualLRPDB.UnclaimActualLRPReturns(nil, nil, errors.New("boom"))
+			})
+
+			It("restores the instance, tries the others and then fails the rollout", func() {
+				Eventually(eventSource.CloseCallCount).Should(Equal(1))
+
+				Expect(fakeEvacuationDB.RemoveEvacuatingActualLRPCallCount()).To(Equal(5))
+				_, key, instanceKey := fakeEvacuationDB.RemoveEvacuatingActualLRPArgsForCall(0)
+				Expect(*key).To(Equal(actualLRPs[0].ActualLRPKey))
+				Expect(*instanceKey).To(Equal(actualLRPs[0].ActualLRPInstanceKey))
+				_, key, _ = fakeEvacuationDB.RemoveEvacuatingActualLRPArgsForCall(1)
+				Expect(*key).To(Equal(actualLRPs[1].ActualLRPKey))
+
+				Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(0))
+				Expect(lastUpdate().State).To(Equal(models.DesiredLRPRolloutStateFailed))
+				Expect(lastUpdate().FailureReason).To(Equal("failed to replace the instance at index 0"))
+				Expect(lastUpdate().ReplacedIndices).To(BeEmpty())
+			})
+		})
+
+		Context("when replacing an instance fails once", func() {
+			BeforeEach(func() {
+				fakeActualLRPDB.UnclaimActualLRPStub = func(_ lager.Logger, key *models.ActualLRPKey) (*models.ActualLRPGroup, *models.ActualLRPGroup, error) {
+					if fakeActualLRPDB.UnclaimActualLRPCallCount() == 1 {
+						return nil, nil, errors.New("boom")
+					}
+					return &models.ActualLRPGroup{}, &models.ActualLRPGroup{}, nil
+				}
+			})
+
+			It("replaces it after the others", func() {
+				Eventually(fakeEvacuationDB.EvacuateActualLRPCallCount).Should(Equal(2))
+				_, key, _, _, _ := fakeEvacuationDB.EvacuateActualLRPArgsForCall(1)
+				Expect(*key).To(Equal(actualLRPs[1].ActualLRPKey))
+				actualEvents <- runningReplacement(actualLRPs[1])
+
+				Eventually(fakeEvacuationDB.EvacuateActualLRPCallCount).Should(Equal(3))
+				_, key, _, _, _ = fakeEvacuationDB.EvacuateActualLRPArgsForCall(2)
+				Expect(*key).To(Equal(actualLRPs[0].ActualLRPKey))
+				actualEvents <- runningReplacement(actualLRPs[0])
+
+				Eventually(eventSource.CloseCallCount).Should(Equal(1))
+				Expect(lastUpdate().State).To(Equal(models.DesiredLRPRolloutStateComplete))
+				Expect(lastUpdate().ReplacedIndices).To(Equal([]int32{1, 0}))
+			})
+		})
+
+		Context("when the rollout passes its deadline", func() {
+			JustBeforeEach(func() {
+				Eventually(fakeEvacuationDB.EvacuateActualLRPCallCount).Should(Equal(1))
+				actualEvents <- runningReplacement(actualLRPs[0])
+				Eventually(fakeEvacuationDB.EvacuateActualLRPCallCount).Should(Equal(2))
+				fakeClock.WaitForWatcherAndIncrement(2 * time.Minute)
+			})
+
+			It("does not extend it for each replaced instance and marks it failed", func() {
+				Eventually(eventSource.CloseCallCount).Should(Equal(1))
+				Expect(fakeRepClient.StopLRPInstanceCallCount()).To(Equal(1))
+
+				Expect(lastUpdate().State).To(Equal(models.DesiredLRPRolloutStateFailed))
+				Expect(lastUpdate().FailureReason).To(ContainSubstring("timed out"))
+				Expect(lastUpdate().ReplacedIndices).To(Equal([]int32{0}))
+			})
+		})
+
+		Context("when the rollout cannot be recorded", func() {
+			BeforeEach(func() {
+				fakeRolloutDB.UpsertDesiredLRPRolloutReturns(models.ErrUnknownError)
+			})
+
+			It("returns the error without rolling", func() {
+				Expect(err).To(Equal(models.ErrUnknownError))
+				Consistently(actualHub.SubscribeWithFilterCallCount).Should(Equal(0))
+			})
+		})
+	})
+
+	Describe("CancelRollout", func() {
+		BeforeEach(func() {
+			Expect(controller.StartRollout(logger, desiredLRP, 1)).To(Succeed())
+			Eventually(fakeEvacuationDB.EvacuateActualLRPCallCount).Should(Equal(1))
+
+			controller.CancelRollout(logger, processGuid)
+		})
+
+		It("stops the rollout and forgets it", func() {
+			Eventually(eventSource.CloseCallCount).Should(Equal(1))
+			Expect(fakeEvacuationDB.EvacuateActualLRPCallCount()).To(Equal(1))
+
+			Expect(fakeRolloutDB.RemoveDesiredLRPRolloutCallCount()).To(Equal(1))
+			_, removedGuid := fakeRolloutDB.RemoveDesiredLRPRolloutArgsForCall(0)
+			Expect(removedGuid).To(Equal(processGuid))
+		})
+	})
+
+	Describe("FailRollout", func() {
+		BeforeEach(func() {
+			controller.FailRollout(logger, processGuid, "something went wrong")
+		})
+
+		It("records the rollout as failed", func() {
+			Expect(fakeRolloutDB.UpsertDesiredLRPRolloutCallCount()).To(Equal(1))
+			_, rollout := fakeRolloutDB.UpsertDesiredLRPRolloutArgsForCall(0)
+			Expect(rollout.ProcessGuid).To(Equal(processGuid))
+			Expect(rollout.State).To(Equal(models.DesiredLRPRolloutStateFailed))
+			Expect(rollout.FailureReason).To(Equal("something went wrong"))
+		})
+	})
+
+	Describe("ResumeRollouts", func() {
+		var (
+			rollout *models.DesiredLRPRollout
+			err     error
+		)
+
+		BeforeEach(func() {
+			rollout = &models.DesiredLRPRollout{
+				ProcessGuid:     processGuid,
+				ModificationTag: desiredLRP.ModificationTag,
+				MaxInFlight:     1,
+				StartedAt:       fakeClock.Now().Add(-time.Minute).UnixNano(),
+				Deadline:        fakeClock.Now().Add(time.Minute).UnixNano(),
+				ReplacedIndices: []int32{0},
+				State:           models.DesiredLRPRolloutStateInProgress,
+			}
+			fakeRolloutDB.DesiredLRPRolloutsStub = func(lager.Logger) ([]*models.DesiredLRPRollout, error) {
+				return []*models.DesiredLRPRollout{rollout}, nil
+			}
+			fakeDesiredLRPDB.DesiredLRPByProcessGuidReturns(desiredLRP, nil)
+		})
+
+		JustBeforeEach(func() {
+			err = controller.ResumeRollouts(logger)
+		})
+
+		AfterEach(func() {
+			controller.CancelRollout(logger, processGuid)
+		})
+
+		It("continues with the instances that were not replaced yet", func() {
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(fakeEvacuationDB.EvacuateActualLRPCallCount).Should(Equal(1))
+			_, key, _, _, _ := fakeEvacuationDB.EvacuateActualLRPArgsForCall(0)
+			Expect(*key).To(Equal(actualLRPs[1].ActualLRPKey))
+
+			actualEvents <- runningReplacement(actualLRPs[1])
+			Eventually(eventSource.CloseCallCount).Should(Equal(1))
+			Expect(lastUpdate().State).To(Equal(models.DesiredLRPRolloutStateComplete))
+			Expect(lastUpdate().ReplacedIndices).To(Equal([]int32{0, 1}))
+		})
+
+		It("does not resume a rollout that is already running", func() {
+			Eventually(actualHub.SubscribeWithFilterCallCount).Should(Equal(1))
+			Expect(controller.ResumeRollouts(logger)).To(Succeed())
+			Consistently(actualHub.SubscribeWithFilterCallCount).Should(Equal(1))
+		})
+
+		Context("when the replacement of an instance was requested before the interruption", func() {
+			var evacuating *models.ActualLRP
+
+			BeforeEach(func() {
+				evacuating = actualLRPs[1]
+				replacement := *evacuating
+				replacement.State = models.ActualLRPStateUnclaimed
+				replacement.ActualLRPInstanceKey = models.ActualLRPInstanceKey{}
+				actualLRPGroups[1] = &models.ActualLRPGroup{Instance: &replacement, Evacuating: evacuating}
+			})
+
+			It("waits for the replacement and then stops the evacuated instance", func() {
+				Consistently(fakeEvacuationDB.EvacuateActualLRPCallCount).Should(Equal(0))
+
+				actualEvents <- runningReplacement(evacuating)
+				Eventually(fakeRepClient.StopLRPInstanceCallCount).Should(Equal(1))
+				_, instanceKey := fakeRepClient.StopLRPInstanceArgsForCall(0)
+				Expect(instanceKey).To(Equal(evacuating.ActualLRPInstanceKey))
+
+				Eventually(eventSource.CloseCallCount).Should(Equal(1))
+				Expect(lastUpdate().ReplacedIndices).To(Equal([]int32{0, 1}))
+			})
+
+			Context("when the replacement is already running", func() {
+				BeforeEach(func() {
+					actualLRPGroups[1].Instance.State = models.ActualLRPStateRunning
+					actualLRPGroups[1].Instance.ActualLRPInstanceKey = models.NewActualLRPInstanceKey("new-instance-guid", "other-cell")
+				})
+
+				It("stops the evacuated instance and records it replaced", func() {
+					Eventually(fakeRepClient.StopLRPInstanceCallCount).Should(Equal(1))
+					_, instanceKey := fakeRepClient.StopLRPInstanceArgsForCall(0)
+					Expect(instanceKey).To(Equal(evacuating.ActualLRPInstanceKey))
+
+					Eventually(eventSource.CloseCallCount).Should(Equal(1))
+					Expect(fakeEvacuationDB.EvacuateActualLRPCallCount()).To(Equal(0))
+					Expect(lastUpdate().State).To(Equal(models.DesiredLRPRolloutStateComplete))
+				})
+			})
+		})
+
+		Context("when the rollout is past its deadline", func() {
+			BeforeEach(func() {
+				rollout.Deadline = fakeClock.Now().UnixNano()
+			})
+
+			It("marks it failed without rolling", func() {
+				Expect(recordedUpdates()).To(HaveLen(1))
+				Expect(lastUpdate().State).To(Equal(models.DesiredLRPRolloutStateFailed))
+				Consistently(actualHub.SubscribeWithFilterCallCount).Should(Equal(0))
+			})
+		})
+
+		Context("when the rollout is no longer in progress", func() {
+			BeforeEach(func() {
+				rollout.State = models.DesiredLRPRolloutStateComplete
+			})
+
+			It("leaves it alone", func() {
+				Expect(fakeDesiredLRPDB.DesiredLRPByProcessGuidCallCount()).To(Equal(0))
+				Consistently(actualHub.SubscribeWithFilterCallCount).Should(Equal(0))
+			})
+		})
+
+		Context("when the desired lrp was removed", func() {
+			BeforeEach(func() {
+				fakeDesiredLRPDB.DesiredLRPByProcessGuidReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("forgets the rollout", func() {
+				Expect(fakeRolloutDB.RemoveDesiredLRPRolloutCallCount()).To(Equal(1))
+				_, removedGuid := fakeRolloutDB.RemoveDesiredLRPRolloutArgsForCall(0)
+				Expect(removedGuid).To(Equal(processGuid))
+				Consistently(actualHub.SubscribeWithFilterCallCount).Should(Equal(0))
+			})
+		})
+
+		Context("when the rollouts cannot be listed", func() {
+			BeforeEach(func() {
+				fakeRolloutDB.DesiredLRPRolloutsStub = nil
+				fakeRolloutDB.DesiredLRPRolloutsReturns(nil, models.ErrUnknownError)
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(Equal(models.ErrUnknownError))
+			})
+		})
+	})
+})
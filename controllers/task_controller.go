@@ -6,10 +6,11 @@ import (
 	"code.cloudfoundry.org/auctioneer"
 	"code.cloudfoundry.org/bbs"
 	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/events"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/taskworkpool"
+	"code.cloudfoundry.org/bbs/tracedclients"
 	"code.cloudfoundry.org/lager"
-	"code.cloudfoundry.org/rep"
 )
 
 type TaskController struct {
@@ -17,7 +18,8 @@ type TaskController struct {
 	taskCompletionClient taskworkpool.TaskCompletionClient
 	auctioneerClient     auctioneer.Client
 	serviceClient        bbs.ServiceClient
-	repClientFactory     rep.ClientFactory
+	repClientFactory     tracedclients.RepClientFactory
+	taskHub              events.Hub
 }
 
 func NewTaskController(
@@ -25,7 +27,8 @@ func NewTaskController(
 	taskCompletionClient taskworkpool.TaskCompletionClient,
 	auctioneerClient auctioneer.Client,
 	serviceClient bbs.ServiceClient,
-	repClientFactory rep.ClientFactory,
+	repClientFactory tracedclients.RepClientFactory,
+	taskHub events.Hub,
 ) *TaskController {
 	return &TaskController{
 		db:                   db,
@@ -33,14 +36,14 @@ func NewTaskController(
 		auctioneerClient:     auctioneerClient,
 		serviceClient:        serviceClient,
 		repClientFactory:     repClientFactory,
+		taskHub:              taskHub,
 	}
 }
 
-func (h *TaskController) Tasks(logger lager.Logger, domain, cellId string) ([]*models.Task, error) {
+func (h *TaskController) Tasks(logger lager.Logger, filter models.TaskFilter) ([]*models.Task, string, error) {
 	logger = logger.Session("tasks")
 
-	filter := models.TaskFilter{Domain: domain, CellID: cellId}
-	return h.db.Tasks(logger, filter)
+	return h.db.TasksPage(logger, filter)
 }
 
 func (h *TaskController) TaskByGuid(logger lager.Logger, taskGuid string) (*models.Task, error) {
@@ -55,10 +58,11 @@ func (h *TaskController) DesireTask(logger lager.Logger, taskDefinition *models.
 
 	logger = logger.WithData(lager.Data{"task_guid": taskGuid})
 
-	err = h.db.DesireTask(logger, taskDefinition, taskGuid, domain)
+	task, err := h.db.DesireTask(logger, taskDefinition, taskGuid, domain)
 	if err != nil {
 		return err
 	}
+	go h.taskHub.Emit(models.NewTaskCreatedEvent(task))
 
 	logger.Debug("start-task-auction-request")
 	taskStartRequest := auctioneer.NewTaskStartRequestFromModel(taskGuid, domain, taskDefinition)
@@ -75,20 +79,25 @@ func (h *TaskController) DesireTask(logger lager.Logger, taskDefinition *models.
 
 func (h *TaskController) StartTask(logger lager.Logger, taskGuid, cellId string) (shouldStart bool, err error) {
 	logger = logger.Session("start-task", lager.Data{"task_guid": taskGuid, "cell_id": cellId})
-	return h.db.StartTask(logger, taskGuid, cellId)
+	before, after, shouldStart, err := h.db.StartTask(logger, taskGuid, cellId)
+	if err == nil && shouldStart {
+		go h.taskHub.Emit(models.NewTaskChangedEvent(before, after))
+	}
+	return shouldStart, err
 }
 
 func (h *TaskController) CancelTask(logger lager.Logger, taskGuid string) error {
 	logger = logger.Session("cancel-task")
 
-	task, cellID, err := h.db.CancelTask(logger, taskGuid)
+	before, after, cellID, err := h.db.CancelTask(logger, taskGuid)
 	if err != nil {
 		return err
 	}
+	go h.taskHub.Emit(models.NewTaskChangedEvent(before, after))
 
-	if task.CompletionCallbackUrl != "" {
+	if after.CompletionCallbackUrl != "" {
 		logger.Info("task-client-completing-task")
-		go h.taskCompletionClient.Submit(h.db, task)
+		go h.taskCompletionClient.Submit(h.db, h.taskHub, after)
 	}
 
 	if cellID == "" {
@@ -104,7 +113,7 @@ func (h *TaskController) CancelTask(logger lager.Logger, taskGuid string) error
 	}
 	logger.Info("finished-check-cell-presence", lager.Data{"cell_id": cellID})
 
-	repClient, err := h.repClientFactory.CreateClient(cellPresence.RepAddress, cellPresence.RepUrl)
+	repClient, err := h.repClientFactory.CreateClient(logger, cellPresence.RepAddress, cellPresence.RepUrl)
 	if err != nil {
 		logger.Error("create-rep-client-failed", err)
 		return err
@@ -124,14 +133,20 @@ func (h *TaskController) FailTask(logger lager.Logger, taskGuid, failureReason s
 	var err error
 	logger = logger.Session("fail-task")
 
-	task, err := h.db.FailTask(logger, taskGuid, failureReason)
+	before, after, err := h.db.FailTask(logger, taskGuid, failureReason)
 	if err != nil {
 		return err
 	}
+	go h.taskHub.Emit(models.NewTaskChangedEvent(before, after))
+
+	if after.RetryCount > before.RetryCount {
+		h.requestRetryAuction(logger, after)
+		return nil
+	}
 
-	if task.CompletionCallbackUrl != "" {
+	if after.CompletionCallbackUrl != "" {
 		logger.Info("task-client-completing-task")
-		go h.taskCompletionClient.Submit(h.db, task)
+		go h.taskCompletionClient.Submit(h.db, h.taskHub, after)
 	}
 
 	return nil
@@ -148,29 +163,69 @@ func (h *TaskController) CompleteTask(
 	var err error
 	logger = logger.Session("complete-task")
 
-	task, err := h.db.CompleteTask(logger, taskGuid, cellId, failed, failureReason, result)
+	before, after, err := h.db.CompleteTask(logger, taskGuid, cellId, failed, failureReason, result)
 	if err != nil {
 		return err
 	}
+	go h.taskHub.Emit(models.NewTaskChangedEvent(before, after))
 
-	if task.CompletionCallbackUrl != "" {
+	if after.RetryCount > before.RetryCount {
+		h.requestRetryAuction(logger, after)
+		return nil
+	}
+
+	if after.CompletionCallbackUrl != "" {
 		logger.Info("task-client-completing-task")
-		go h.taskCompletionClient.Submit(h.db, task)
+		go h.taskCompletionClient.Submit(h.db, h.taskHub, after)
 	}
 
 	return nil
 }
 
+// requestRetryAuction auctions a task that a failure returned to pending.
+// Tasks whose retry policy asks for a backoff are left to task convergence,
+// which auctions them once the backoff has elapsed.
+func (h *TaskController) requestRetryAuction(logger lager.Logger, task *models.Task) {
+	logger = logger.WithData(lager.Data{"task_guid": task.TaskGuid, "retry_count": task.RetryCount})
+
+	if task.GetRetryPolicy().Backoff() > 0 {
+		logger.Info("deferring-retry-auction-to-convergence")
+		return
+	}
+
+	logger.Debug("start-task-auction-request")
+	taskStartRequest := auctioneer.NewTaskStartRequestFromModel(task.TaskGuid, task.Domain, task.TaskDefinition)
+	err := h.auctioneerClient.RequestTaskAuctions(logger, []*auctioneer.TaskStartRequest{&taskStartRequest})
+	if err != nil {
+		logger.Error("failed-requesting-task-auction", err)
+		// The task is pending again, convergence will kick it
+	} else {
+		logger.Debug("succeeded-requesting-task-auction")
+	}
+}
+
 func (h *TaskController) ResolvingTask(logger lager.Logger, taskGuid string) error {
 	logger = logger.Session("resolving-task")
 
-	return h.db.ResolvingTask(logger, taskGuid)
+	before, after, err := h.db.ResolvingTask(logger, taskGuid)
+	if err != nil {
+		return err
+	}
+	go h.taskHub.Emit(models.NewTaskChangedEvent(before, after))
+
+	return nil
 }
 
 func (h *TaskController) DeleteTask(logger lager.Logger, taskGuid string) error {
 	logger = logger.Session("delete-task")
 
-	return h.db.DeleteTask(logger, taskGuid)
+	task, err := h.db.DeleteTask(logger, taskGuid)
+	if err != nil {
+		return err
+	}
+	go h.taskHub.Emit(models.NewTaskRemovedEvent(task))
+
+	return nil
 }
 
 func (h *TaskController) ConvergeTasks(
@@ -193,7 +248,7 @@ func (h *TaskController) ConvergeTasks(
 	}
 	logger.Debug("succeeded-listing-cells")
 
-	tasksToAuction, tasksToComplete := h.db.ConvergeTasks(
+	tasksToAuction, tasksToComplete, eventsToEmit := h.db.ConvergeTasks(
 		logger,
 		cellSet,
 		kickTaskDuration,
@@ -217,8 +272,14 @@ func (h *TaskController) ConvergeTasks(
 
 	logger.Debug("submitting-tasks-to-be-completed", lager.Data{"num_tasks_to_complete": len(tasksToComplete)})
 	for _, task := range tasksToComplete {
-		h.taskCompletionClient.Submit(h.db, task)
+		h.taskCompletionClient.Submit(h.db, h.taskHub, task)
 	}
 	logger.Debug("done-submitting-tasks-to-be-completed", lager.Data{"num_tasks_to_complete": len(tasksToComplete)})
+
+	logger.Debug("emitting-task-events", lager.Data{"num_events": len(eventsToEmit)})
+	for _, event := range eventsToEmit {
+		go h.taskHub.Emit(event)
+	}
+
 	return nil
 }
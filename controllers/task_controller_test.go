@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/auctioneer/auctioneerfakes"
 	"code.cloudfoundry.org/bbs/controllers"
 	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/events/eventfakes"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
 	"code.cloudfoundry.org/bbs/taskworkpool/taskworkpoolfakes"
@@ -24,6 +25,7 @@ var _ = Describe("Task Controller", func() {
 		fakeTaskDB               *dbfakes.FakeTaskDB
 		fakeAuctioneerClient     *auctioneerfakes.FakeClient
 		fakeTaskCompletionClient *taskworkpoolfakes.FakeTaskCompletionClient
+		taskHub                  *eventfakes.FakeHub
 
 		controller *controllers.TaskController
 	)
@@ -32,9 +34,10 @@ var _ = Describe("Task Controller", func() {
 		fakeTaskDB = new(dbfakes.FakeTaskDB)
 		fakeAuctioneerClient = new(auctioneerfakes.FakeClient)
 		fakeTaskCompletionClient = new(taskworkpoolfakes.FakeTaskCompletionClient)
+		taskHub = new(eventfakes.FakeHub)
 
 		logger = lagertest.NewTestLogger("test")
-		controller = controllers.NewTaskController(fakeTaskDB, fakeTaskCompletionClient, fakeAuctioneerClient, fakeServiceClient, fakeRepClientFactory)
+		controller = controllers.NewTaskController(fakeTaskDB, fakeTaskCompletionClient, fakeAuctioneerClient, fakeServiceClient, fakeRepClientFactory, taskHub)
 	})
 
 	Describe("Tasks", func() {
@@ -43,6 +46,7 @@ var _ = Describe("Task Controller", func() {
 			task1          models.Task
 			task2          models.Task
 			actualTasks    []*models.Task
+			nextPageToken  string
 			err            error
 		)
 
@@ -54,7 +58,7 @@ var _ = Describe("Task Controller", func() {
 		})
 
 		JustBeforeEach(func() {
-			actualTasks, err = controller.Tasks(logger, domain, cellId)
+			actualTasks, nextPageToken, err = controller.Tasks(logger, models.TaskFilter{Domain: domain, CellID: cellId})
 		})
 
 		Context("when reading tasks from DB succeeds", func() {
@@ -62,7 +66,7 @@ var _ = Describe("Task Controller", func() {
 
 			BeforeEach(func() {
 				tasks = []*models.Task{&task1, &task2}
-				fakeTaskDB.TasksReturns(tasks, nil)
+				fakeTaskDB.TasksPageReturns(tasks, "some-token", nil)
 			})
 
 			It("returns a list of task", func() {
@@ -70,9 +74,13 @@ var _ = Describe("Task Controller", func() {
 				Expect(actualTasks).To(Equal(tasks))
 			})
 
+			It("returns the token for the next page", func() {
+				Expect(nextPageToken).To(Equal("some-token"))
+			})
+
 			It("calls the DB with no filter", func() {
-				Expect(fakeTaskDB.TasksCallCount()).To(Equal(1))
-				_, filter := fakeTaskDB.TasksArgsForCall(0)
+				Expect(fakeTaskDB.TasksPageCallCount()).To(Equal(1))
+				_, filter := fakeTaskDB.TasksPageArgsForCall(0)
 				Expect(filter).To(Equal(models.TaskFilter{}))
 			})
 
@@ -82,8 +90,8 @@ var _ = Describe("Task Controller", func() {
 				})
 
 				It("calls the DB with a domain filter", func() {
-					Expect(fakeTaskDB.TasksCallCount()).To(Equal(1))
-					_, filter := fakeTaskDB.TasksArgsForCall(0)
+					Expect(fakeTaskDB.TasksPageCallCount()).To(Equal(1))
+					_, filter := fakeTaskDB.TasksPageArgsForCall(0)
 					Expect(filter.Domain).To(Equal(domain))
 				})
 			})
@@ -94,8 +102,8 @@ var _ = Describe("Task Controller", func() {
 				})
 
 				It("calls the DB with a cell filter", func() {
-					Expect(fakeTaskDB.TasksCallCount()).To(Equal(1))
-					_, filter := fakeTaskDB.TasksArgsForCall(0)
+					Expect(fakeTaskDB.TasksPageCallCount()).To(Equal(1))
+					_, filter := fakeTaskDB.TasksPageArgsForCall(0)
 					Expect(filter.CellID).To(Equal(cellId))
 				})
 			})
@@ -103,7 +111,7 @@ var _ = Describe("Task Controller", func() {
 
 		Context("when the DB returns an error", func() {
 			BeforeEach(func() {
-				fakeTaskDB.TasksReturns(nil, errors.New("kaboom"))
+				fakeTaskDB.TasksPageReturns(nil, "", errors.New("kaboom"))
 			})
 
 			It("returns the error", func() {
@@ -181,6 +189,23 @@ var _ = Describe("Task Controller", func() {
 				Expect(actualDomain).To(Equal(domain))
 			})
 
+			Context("when the db returns the desired task", func() {
+				var desiredTask *models.Task
+
+				BeforeEach(func() {
+					desiredTask = model_helpers.NewValidTask(taskGuid)
+					fakeTaskDB.DesireTaskReturns(desiredTask, nil)
+				})
+
+				It("emits a TaskCreatedEvent to the hub", func() {
+					Eventually(taskHub.EmitCallCount).Should(Equal(1))
+					event := taskHub.EmitArgsForCall(0)
+					createEvent, ok := event.(*models.TaskCreatedEvent)
+					Expect(ok).To(BeTrue())
+					Expect(createEvent.Task).To(Equal(desiredTask))
+				})
+			})
+
 			It("requests an auction", func() {
 				Expect(fakeAuctioneerClient.RequestTaskAuctionsCallCount()).To(Equal(1))
 
@@ -238,12 +263,16 @@ var _ = Describe("Task Controller", func() {
 
 		Context("when desiring the task fails", func() {
 			BeforeEach(func() {
-				fakeTaskDB.DesireTaskReturns(errors.New("kaboom"))
+				fakeTaskDB.DesireTaskReturns(nil, errors.New("kaboom"))
 			})
 
 			It("responds with an error", func() {
 				Expect(err).To(MatchError("kaboom"))
 			})
+
+			It("does not emit an event", func() {
+				Consistently(taskHub.EmitCallCount).Should(Equal(0))
+			})
 		})
 	})
 
@@ -273,30 +302,46 @@ var _ = Describe("Task Controller", func() {
 			})
 
 			Context("when the task should start", func() {
+				var before, after *models.Task
+
 				BeforeEach(func() {
-					fakeTaskDB.StartTaskReturns(true, nil)
+					before = model_helpers.NewValidTask(taskGuid)
+					after = model_helpers.NewValidTask(taskGuid)
+					after.State = models.Task_Running
+					fakeTaskDB.StartTaskReturns(before, after, true, nil)
 				})
 
 				It("responds with true", func() {
 					Expect(err).NotTo(HaveOccurred())
 					Expect(shouldStart).To(BeTrue())
 				})
+
+				It("emits a TaskChangedEvent to the hub", func() {
+					Eventually(taskHub.EmitCallCount).Should(Equal(1))
+					event := taskHub.EmitArgsForCall(0)
+					Expect(event).To(Equal(models.NewTaskChangedEvent(before, after)))
+				})
 			})
 
 			Context("when the task should not start", func() {
 				BeforeEach(func() {
-					fakeTaskDB.StartTaskReturns(false, nil)
+					task := model_helpers.NewValidTask(taskGuid)
+					fakeTaskDB.StartTaskReturns(task, task, false, nil)
 				})
 
 				It("responds with false", func() {
 					Expect(err).NotTo(HaveOccurred())
 					Expect(shouldStart).To(BeFalse())
 				})
+
+				It("does not emit an event", func() {
+					Consistently(taskHub.EmitCallCount).Should(Equal(0))
+				})
 			})
 
 			Context("when the DB fails", func() {
 				BeforeEach(func() {
-					fakeTaskDB.StartTaskReturns(false, errors.New("kaboom"))
+					fakeTaskDB.StartTaskReturns(nil, nil, false, errors.New("kaboom"))
 				})
 
 				It("bubbles up the underlying model error", func() {
@@ -316,7 +361,7 @@ var _ = Describe("Task Controller", func() {
 			taskGuid = "task-guid"
 			cellID = "the-cell"
 			task := model_helpers.NewValidTask("hi-bob")
-			fakeTaskDB.CancelTaskReturns(task, cellID, nil)
+			fakeTaskDB.CancelTaskReturns(task, task, cellID, nil)
 		})
 
 		JustBeforeEach(func() {
@@ -338,22 +383,43 @@ var _ = Describe("Task Controller", func() {
 					Expect(err).NotTo(HaveOccurred())
 				})
 
+				Context("when the db returns the before and after tasks", func() {
+					var before, after *models.Task
+
+					BeforeEach(func() {
+						before = model_helpers.NewValidTask("hi-bob")
+						after = model_helpers.NewValidTask("hi-bob")
+						after.State = models.Task_Completed
+						after.Failed = true
+						after.FailureReason = "task was cancelled"
+						fakeTaskDB.CancelTaskReturns(before, after, cellID, nil)
+					})
+
+					It("emits a TaskChangedEvent to the hub", func() {
+						Eventually(taskHub.EmitCallCount).Should(Equal(1))
+						event := taskHub.EmitArgsForCall(0)
+						Expect(event).To(Equal(models.NewTaskChangedEvent(before, after)))
+					})
+				})
+
 				Context("and the task has a complete URL", func() {
 					BeforeEach(func() {
 						task := model_helpers.NewValidTask("hi-bob")
 						task.CompletionCallbackUrl = "bogus"
-						fakeTaskDB.CancelTaskReturns(task, cellID, nil)
+						fakeTaskDB.CancelTaskReturns(task, task, cellID, nil)
 					})
 
 					It("causes the workpool to complete its callback work", func() {
 						Eventually(fakeTaskCompletionClient.SubmitCallCount).Should(Equal(1))
+						_, hub, _ := fakeTaskCompletionClient.SubmitArgsForCall(0)
+						Expect(hub).To(Equal(taskHub))
 					})
 				})
 
 				Context("but the task has no complete URL", func() {
 					BeforeEach(func() {
 						task := model_helpers.NewValidTask("hi-bob")
-						fakeTaskDB.CancelTaskReturns(task, cellID, nil)
+						fakeTaskDB.CancelTaskReturns(task, task, cellID, nil)
 					})
 
 					It("does not complete the task callback", func() {
@@ -378,7 +444,7 @@ var _ = Describe("Task Controller", func() {
 					})
 
 					It("creates a rep client using the rep url", func() {
-						repAddr, repURL := fakeRepClientFactory.CreateClientArgsForCall(0)
+						_, repAddr, repURL := fakeRepClientFactory.CreateClientArgsForCall(0)
 						Expect(repAddr).To(Equal("some-address"))
 						Expect(repURL).To(Equal("http://some-address"))
 					})
@@ -402,7 +468,7 @@ var _ = Describe("Task Controller", func() {
 				Context("when the task has no cell id", func() {
 					BeforeEach(func() {
 						task := model_helpers.NewValidTask("hi-bob")
-						fakeTaskDB.CancelTaskReturns(task, "", nil)
+						fakeTaskDB.CancelTaskReturns(task, task, "", nil)
 					})
 
 					It("does not return an error", func() {
@@ -445,12 +511,16 @@ var _ = Describe("Task Controller", func() {
 
 			Context("when cancelling the task fails", func() {
 				BeforeEach(func() {
-					fakeTaskDB.CancelTaskReturns(nil, "", errors.New("kaboom"))
+					fakeTaskDB.CancelTaskReturns(nil, nil, "", errors.New("kaboom"))
 				})
 
 				It("responds with an error", func() {
 					Expect(err).To(MatchError("kaboom"))
 				})
+
+				It("does not emit an event", func() {
+					Consistently(taskHub.EmitCallCount).Should(Equal(0))
+				})
 			})
 		})
 	})
@@ -466,7 +536,7 @@ var _ = Describe("Task Controller", func() {
 			taskGuid = "task-guid"
 			failureReason = "just cuz ;)"
 			task := model_helpers.NewValidTask("hi-bob")
-			fakeTaskDB.FailTaskReturns(task, nil)
+			fakeTaskDB.FailTaskReturns(task, task, nil)
 		})
 
 		JustBeforeEach(func() {
@@ -481,11 +551,30 @@ var _ = Describe("Task Controller", func() {
 				Expect(err).NotTo(HaveOccurred())
 			})
 
+			Context("when the db returns the before and after tasks", func() {
+				var before, after *models.Task
+
+				BeforeEach(func() {
+					before = model_helpers.NewValidTask("hi-bob")
+					after = model_helpers.NewValidTask("hi-bob")
+					after.State = models.Task_Completed
+					after.Failed = true
+					after.FailureReason = failureReason
+					fakeTaskDB.FailTaskReturns(before, after, nil)
+				})
+
+				It("emits a TaskChangedEvent to the hub", func() {
+					Eventually(taskHub.EmitCallCount).Should(Equal(1))
+					event := taskHub.EmitArgsForCall(0)
+					Expect(event).To(Equal(models.NewTaskChangedEvent(before, after)))
+				})
+			})
+
 			Context("and the task has a complete URL", func() {
 				BeforeEach(func() {
 					task := model_helpers.NewValidTask("hi-bob")
 					task.CompletionCallbackUrl = "bogus"
-					fakeTaskDB.FailTaskReturns(task, nil)
+					fakeTaskDB.FailTaskReturns(task, task, nil)
 				})
 
 				It("causes the workpool to complete its callback work", func() {
@@ -496,23 +585,71 @@ var _ = Describe("Task Controller", func() {
 			Context("but the task has no complete URL", func() {
 				BeforeEach(func() {
 					task := model_helpers.NewValidTask("hi-bob")
-					fakeTaskDB.FailTaskReturns(task, nil)
+					fakeTaskDB.FailTaskReturns(task, task, nil)
 				})
 
 				It("does not complete the task callback", func() {
 					Consistently(fakeTaskCompletionClient.SubmitCallCount).Should(Equal(0))
 				})
 			})
+
+			Context("and the task was retried", func() {
+				var after *models.Task
+
+				BeforeEach(func() {
+					before := model_helpers.NewValidTask("hi-bob")
+					before.CompletionCallbackUrl = "bogus"
+					before.RetryPolicy = &models.TaskRetryPolicy{MaxAttempts: 2}
+					after = before.Copy()
+					after.RetryCount = 1
+					fakeTaskDB.FailTaskReturns(before, after, nil)
+				})
+
+				It("requests an auction for the task", func() {
+					Expect(fakeAuctioneerClient.RequestTaskAuctionsCallCount()).To(Equal(1))
+					_, requestedTasks := fakeAuctioneerClient.RequestTaskAuctionsArgsForCall(0)
+					expectedStartRequest := auctioneer.NewTaskStartRequestFromModel(after.TaskGuid, after.Domain, after.TaskDefinition)
+					Expect(requestedTasks).To(ConsistOf(&expectedStartRequest))
+				})
+
+				It("does not complete the task callback", func() {
+					Consistently(fakeTaskCompletionClient.SubmitCallCount).Should(Equal(0))
+				})
+
+				Context("when the retry policy has a backoff", func() {
+					BeforeEach(func() {
+						after.RetryPolicy.BackoffMs = 1000
+					})
+
+					It("leaves the auction to convergence", func() {
+						Expect(fakeAuctioneerClient.RequestTaskAuctionsCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when requesting the auction fails", func() {
+					BeforeEach(func() {
+						fakeAuctioneerClient.RequestTaskAuctionsReturns(errors.New("oops"))
+					})
+
+					It("does not return an error", func() {
+						Expect(err).NotTo(HaveOccurred())
+					})
+				})
+			})
 		})
 
 		Context("when failing the task fails", func() {
 			BeforeEach(func() {
-				fakeTaskDB.FailTaskReturns(nil, errors.New("kaboom"))
+				fakeTaskDB.FailTaskReturns(nil, nil, errors.New("kaboom"))
 			})
 
 			It("responds with an error", func() {
 				Expect(err).To(MatchError("kaboom"))
 			})
+
+			It("does not emit an event", func() {
+				Consistently(taskHub.EmitCallCount).Should(Equal(0))
+			})
 		})
 	})
 
@@ -534,7 +671,7 @@ var _ = Describe("Task Controller", func() {
 			result = "yeah"
 
 			task := model_helpers.NewValidTask("hi-bob")
-			fakeTaskDB.CompleteTaskReturns(task, nil)
+			fakeTaskDB.CompleteTaskReturns(task, task, nil)
 		})
 
 		JustBeforeEach(func() {
@@ -553,12 +690,29 @@ var _ = Describe("Task Controller", func() {
 				Expect(err).NotTo(HaveOccurred())
 			})
 
+			Context("when the db returns the before and after tasks", func() {
+				var before, after *models.Task
+
+				BeforeEach(func() {
+					before = model_helpers.NewValidTask("hi-bob")
+					after = model_helpers.NewValidTask("hi-bob")
+					after.State = models.Task_Completed
+					fakeTaskDB.CompleteTaskReturns(before, after, nil)
+				})
+
+				It("emits a TaskChangedEvent to the hub", func() {
+					Eventually(taskHub.EmitCallCount).Should(Equal(1))
+					event := taskHub.EmitArgsForCall(0)
+					Expect(event).To(Equal(models.NewTaskChangedEvent(before, after)))
+				})
+			})
+
 			Context("and completing succeeds", func() {
 				Context("and the task has a complete URL", func() {
 					BeforeEach(func() {
 						task := model_helpers.NewValidTask("hi-bob")
 						task.CompletionCallbackUrl = "bogus"
-						fakeTaskDB.CompleteTaskReturns(task, nil)
+						fakeTaskDB.CompleteTaskReturns(task, task, nil)
 					})
 
 					It("causes the workpool to complete its callback work", func() {
@@ -569,7 +723,7 @@ var _ = Describe("Task Controller", func() {
 				Context("but the task has no complete URL", func() {
 					BeforeEach(func() {
 						task := model_helpers.NewValidTask("hi-bob")
-						fakeTaskDB.CompleteTaskReturns(task, nil)
+						fakeTaskDB.CompleteTaskReturns(task, task, nil)
 					})
 
 					It("does not complete the task callback", func() {
@@ -577,16 +731,44 @@ var _ = Describe("Task Controller", func() {
 					})
 				})
 			})
+
+			Context("and the task was retried", func() {
+				var after *models.Task
+
+				BeforeEach(func() {
+					before := model_helpers.NewValidTask("hi-bob")
+					before.CompletionCallbackUrl = "bogus"
+					before.RetryPolicy = &models.TaskRetryPolicy{MaxAttempts: 2}
+					after = before.Copy()
+					after.RetryCount = 1
+					fakeTaskDB.CompleteTaskReturns(before, after, nil)
+				})
+
+				It("requests an auction for the task", func() {
+					Expect(fakeAuctioneerClient.RequestTaskAuctionsCallCount()).To(Equal(1))
+					_, requestedTasks := fakeAuctioneerClient.RequestTaskAuctionsArgsForCall(0)
+					expectedStartRequest := auctioneer.NewTaskStartRequestFromModel(after.TaskGuid, after.Domain, after.TaskDefinition)
+					Expect(requestedTasks).To(ConsistOf(&expectedStartRequest))
+				})
+
+				It("does not complete the task callback", func() {
+					Consistently(fakeTaskCompletionClient.SubmitCallCount).Should(Equal(0))
+				})
+			})
 		})
 
 		Context("when completing the task fails", func() {
 			BeforeEach(func() {
-				fakeTaskDB.CompleteTaskReturns(nil, errors.New("kaboom"))
+				fakeTaskDB.CompleteTaskReturns(nil, nil, errors.New("kaboom"))
 			})
 
 			It("responds with an error", func() {
 				Expect(err).To(MatchError("kaboom"))
 			})
+
+			It("does not emit an event", func() {
+				Consistently(taskHub.EmitCallCount).Should(Equal(0))
+			})
 		})
 	})
 
@@ -606,22 +788,42 @@ var _ = Describe("Task Controller", func() {
 			})
 
 			Context("when resolvinging the task succeeds", func() {
+				var before, after *models.Task
+
+				BeforeEach(func() {
+					before = model_helpers.NewValidTask(taskGuid)
+					before.State = models.Task_Completed
+					after = model_helpers.NewValidTask(taskGuid)
+					after.State = models.Task_Resolving
+					fakeTaskDB.ResolvingTaskReturns(before, after, nil)
+				})
+
 				It("returns no error", func() {
 					Expect(fakeTaskDB.ResolvingTaskCallCount()).To(Equal(1))
 					_, taskGuid := fakeTaskDB.ResolvingTaskArgsForCall(0)
 					Expect(taskGuid).To(Equal("task-guid"))
 					Expect(err).NotTo(HaveOccurred())
 				})
+
+				It("emits a TaskChangedEvent to the hub", func() {
+					Eventually(taskHub.EmitCallCount).Should(Equal(1))
+					event := taskHub.EmitArgsForCall(0)
+					Expect(event).To(Equal(models.NewTaskChangedEvent(before, after)))
+				})
 			})
 
 			Context("when desiring the task fails", func() {
 				BeforeEach(func() {
-					fakeTaskDB.ResolvingTaskReturns(errors.New("kaboom"))
+					fakeTaskDB.ResolvingTaskReturns(nil, nil, errors.New("kaboom"))
 				})
 
 				It("responds with an error", func() {
 					Expect(err).To(MatchError("kaboom"))
 				})
+
+				It("does not emit an event", func() {
+					Consistently(taskHub.EmitCallCount).Should(Equal(0))
+				})
 			})
 		})
 	})
@@ -642,22 +844,40 @@ var _ = Describe("Task Controller", func() {
 			})
 
 			Context("when deleting the task succeeds", func() {
+				var task *models.Task
+
+				BeforeEach(func() {
+					task = model_helpers.NewValidTask(taskGuid)
+					task.State = models.Task_Resolving
+					fakeTaskDB.DeleteTaskReturns(task, nil)
+				})
+
 				It("returns no error", func() {
 					Expect(fakeTaskDB.DeleteTaskCallCount()).To(Equal(1))
 					_, taskGuid := fakeTaskDB.DeleteTaskArgsForCall(0)
 					Expect(taskGuid).To(Equal("task-guid"))
 					Expect(err).NotTo(HaveOccurred())
 				})
+
+				It("emits a TaskRemovedEvent to the hub", func() {
+					Eventually(taskHub.EmitCallCount).Should(Equal(1))
+					event := taskHub.EmitArgsForCall(0)
+					Expect(event).To(Equal(models.NewTaskRemovedEvent(task)))
+				})
 			})
 
 			Context("when desiring the task fails", func() {
 				BeforeEach(func() {
-					fakeTaskDB.DeleteTaskReturns(errors.New("kaboom"))
+					fakeTaskDB.DeleteTaskReturns(nil, errors.New("kaboom"))
 				})
 
 				It("responds with an error", func() {
 					Expect(err).To(MatchError("kaboom"))
 				})
+
+				It("does not emit an event", func() {
+					Consistently(taskHub.EmitCallCount).Should(Equal(0))
+				})
 			})
 		})
 	})
@@ -724,22 +944,23 @@ var _ = Describe("Task Controller", func() {
 				BeforeEach(func() {
 					task1 := model_helpers.NewValidTask(taskGuid1)
 					task2 := model_helpers.NewValidTask(taskGuid2)
-					fakeTaskDB.ConvergeTasksReturns(nil, []*models.Task{task1, task2})
+					fakeTaskDB.ConvergeTasksReturns(nil, []*models.Task{task1, task2}, nil)
 				})
 
 				It("submits the tasks to the workpool", func() {
 					expectedCallCount := 2
 					Expect(fakeTaskCompletionClient.SubmitCallCount()).To(Equal(expectedCallCount))
 
-					_, submittedTask1 := fakeTaskCompletionClient.SubmitArgsForCall(0)
-					_, submittedTask2 := fakeTaskCompletionClient.SubmitArgsForCall(1)
+					_, _, submittedTask1 := fakeTaskCompletionClient.SubmitArgsForCall(0)
+					_, _, submittedTask2 := fakeTaskCompletionClient.SubmitArgsForCall(1)
 					Expect([]string{submittedTask1.TaskGuid, submittedTask2.TaskGuid}).To(ConsistOf(taskGuid1, taskGuid2))
 
 					task1Completions := 0
 					task2Completions := 0
 					for i := 0; i < expectedCallCount; i++ {
-						db, task := fakeTaskCompletionClient.SubmitArgsForCall(i)
+						db, hub, task := fakeTaskCompletionClient.SubmitArgsForCall(i)
 						Expect(db).To(Equal(fakeTaskDB))
+						Expect(hub).To(Equal(taskHub))
 						if task.TaskGuid == taskGuid1 {
 							task1Completions++
 						} else if task.TaskGuid == taskGuid2 {
@@ -759,7 +980,7 @@ var _ = Describe("Task Controller", func() {
 				BeforeEach(func() {
 					taskStartRequest1 := auctioneer.NewTaskStartRequestFromModel(taskGuid1, "domain", model_helpers.NewValidTaskDefinition())
 					taskStartRequest2 := auctioneer.NewTaskStartRequestFromModel(taskGuid2, "domain", model_helpers.NewValidTaskDefinition())
-					fakeTaskDB.ConvergeTasksReturns([]*auctioneer.TaskStartRequest{&taskStartRequest1, &taskStartRequest2}, nil)
+					fakeTaskDB.ConvergeTasksReturns([]*auctioneer.TaskStartRequest{&taskStartRequest1, &taskStartRequest2}, nil, nil)
 				})
 
 				It("requests an auction", func() {
@@ -780,6 +1001,24 @@ var _ = Describe("Task Controller", func() {
 					})
 				})
 			})
+
+			Context("when there are events to emit", func() {
+				var event1, event2 models.Event
+
+				BeforeEach(func() {
+					task1 := model_helpers.NewValidTask("task-1")
+					task2 := model_helpers.NewValidTask("task-2")
+					event1 = models.NewTaskChangedEvent(task1, task1)
+					event2 = models.NewTaskRemovedEvent(task2)
+					fakeTaskDB.ConvergeTasksReturns(nil, nil, []models.Event{event1, event2})
+				})
+
+				It("emits the events to the hub", func() {
+					Eventually(taskHub.EmitCallCount).Should(Equal(2))
+					emitted := []models.Event{taskHub.EmitArgsForCall(0), taskHub.EmitArgsForCall(1)}
+					Expect(emitted).To(ConsistOf(event1, event2))
+				})
+			})
 		})
 	})
 })
@@ -0,0 +1,13 @@
+package converger
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter -o fake_controllers/fake_audit_pruner.go . AuditPruner
+
+type AuditPruner interface {
+	PruneAuditEvents(logger lager.Logger, before time.Time) error
+}
@@ -17,13 +17,16 @@ type Converger struct {
 	id                          string
 	serviceClient               bbs.ServiceClient
 	lrpConvergenceController    LrpConvergenceController
+	rolloutController           DesiredLRPRolloutController
 	taskController              TaskController
+	auditPruner                 AuditPruner
 	logger                      lager.Logger
 	clock                       clock.Clock
 	convergeRepeatInterval      time.Duration
 	kickTaskDuration            time.Duration
 	expirePendingTaskDuration   time.Duration
 	expireCompletedTaskDuration time.Duration
+	auditRetention              time.Duration
 	closeOnce                   *sync.Once
 }
 
@@ -31,12 +34,15 @@ func New(
 	logger lager.Logger,
 	clock clock.Clock,
 	lrpConvergenceController LrpConvergenceController,
+	rolloutController DesiredLRPRolloutController,
 	taskController TaskController,
 	serviceClient bbs.ServiceClient,
 	convergeRepeatInterval,
 	kickTaskDuration,
 	expirePendingTaskDuration,
 	expireCompletedTaskDuration time.Duration,
+	auditPruner AuditPruner,
+	auditRetention time.Duration,
 ) *Converger {
 
 	uuid, err := uuid.NewV4()
@@ -50,11 +56,14 @@ func New(
 		clock:                       clock,
 		serviceClient:               serviceClient,
 		lrpConvergenceController:    lrpConvergenceController,
+		rolloutController:           rolloutController,
 		taskController:              taskController,
 		convergeRepeatInterval:      convergeRepeatInterval,
 		kickTaskDuration:            kickTaskDuration,
 		expirePendingTaskDuration:   expirePendingTaskDuration,
 		expireCompletedTaskDuration: expireCompletedTaskDuration,
+		auditPruner:                 auditPruner,
+		auditRetention:              auditRetention,
 		closeOnce:                   &sync.Once{},
 	}
 }
@@ -137,5 +146,32 @@ func (c *Converger) converge() {
 		}
 	}()
 
+	wg.Add(1)
+	go func() {
+		logger.Info("resume-rollouts-started")
+
+		defer func() {
+			logger.Info("resume-rollouts-done")
+			wg.Done()
+		}()
+
+		err := c.rolloutController.ResumeRollouts(c.logger)
+		if err != nil {
+			logger.Error("failed-to-resume-rollouts", err)
+		}
+	}()
+
+	if c.auditPruner != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			err := c.auditPruner.PruneAuditEvents(c.logger, c.clock.Now().Add(-c.auditRetention))
+			if err != nil {
+				logger.Error("failed-to-prune-audit-events", err)
+			}
+		}()
+	}
+
 	wg.Wait()
 }
@@ -23,7 +23,10 @@ const aBit = 100 * time.Millisecond
 var _ = Describe("ConvergerProcess", func() {
 	var (
 		fakeLrpConvergenceController *fake_controllers.FakeLrpConvergenceController
+		fakeRolloutController        *fake_controllers.FakeDesiredLRPRolloutController
 		fakeTaskController           *fake_controllers.FakeTaskController
+		fakeAuditPruner              *fake_controllers.FakeAuditPruner
+		auditPruner                  converger.AuditPruner
 		fakeBBSServiceClient         *fake_bbs.FakeServiceClient
 		logger                       *lagertest.TestLogger
 		fakeClock                    *fakeclock.FakeClock
@@ -31,6 +34,7 @@ var _ = Describe("ConvergerProcess", func() {
 		kickTaskDuration             time.Duration
 		expirePendingTaskDuration    time.Duration
 		expireCompletedTaskDuration  time.Duration
+		auditRetention               time.Duration
 
 		process ifrit.Process
 
@@ -40,7 +44,10 @@ var _ = Describe("ConvergerProcess", func() {
 
 	BeforeEach(func() {
 		fakeLrpConvergenceController = new(fake_controllers.FakeLrpConvergenceController)
+		fakeRolloutController = new(fake_controllers.FakeDesiredLRPRolloutController)
 		fakeTaskController = new(fake_controllers.FakeTaskController)
+		fakeAuditPruner = new(fake_controllers.FakeAuditPruner)
+		auditPruner = fakeAuditPruner
 		fakeBBSServiceClient = new(fake_bbs.FakeServiceClient)
 		logger = lagertest.NewTestLogger("test")
 		fakeClock = fakeclock.NewFakeClock(time.Now())
@@ -50,6 +57,7 @@ var _ = Describe("ConvergerProcess", func() {
 		kickTaskDuration = 10 * time.Millisecond
 		expirePendingTaskDuration = 30 * time.Second
 		expireCompletedTaskDuration = 60 * time.Minute
+		auditRetention = 24 * time.Hour
 
 		cellEvents := make(chan models.CellEvent, 100)
 		errs := make(chan error, 100)
@@ -66,12 +74,15 @@ var _ = Describe("ConvergerProcess", func() {
 				logger,
 				fakeClock,
 				fakeLrpConvergenceController,
+				fakeRolloutController,
 				fakeTaskController,
 				fakeBBSServiceClient,
 				convergeRepeatInterval,
 				kickTaskDuration,
 				expirePendingTaskDuration,
 				expireCompletedTaskDuration,
+				auditPruner,
+				auditRetention,
 			),
 		)
 	})
@@ -152,4 +163,52 @@ var _ = Describe("ConvergerProcess", func() {
 			Eventually(fakeLrpConvergenceController.ConvergeLRPsCallCount).Should(Equal(2))
 		})
 	})
+
+	Describe("resuming rollouts", func() {
+		It("resumes unfinished rollouts on every convergence", func() {
+			fakeClock.WaitForWatcherAndIncrement(convergeRepeatInterval + aBit)
+			Eventually(fakeRolloutController.ResumeRolloutsCallCount).Should(Equal(1))
+
+			fakeClock.WaitForWatcherAndIncrement(convergeRepeatInterval + aBit)
+			Eventually(fakeRolloutController.ResumeRolloutsCallCount).Should(Equal(2))
+		})
+
+		Context("when resuming fails", func() {
+			BeforeEach(func() {
+				fakeRolloutController.ResumeRolloutsReturns(errors.New("boom"))
+			})
+
+			It("still converges", func() {
+				fakeClock.WaitForWatcherAndIncrement(convergeRepeatInterval + aBit)
+
+				Eventually(fakeRolloutController.ResumeRolloutsCallCount).Should(Equal(1))
+				Eventually(fakeTaskController.ConvergeTasksCallCount).Should(Equal(1))
+				Eventually(fakeLrpConvergenceController.ConvergeLRPsCallCount).Should(Equal(1))
+			})
+		})
+	})
+
+	Describe("pruning the audit log", func() {
+		It("deletes the audit events older than the retention period on every convergence", func() {
+			fakeClock.WaitForWatcherAndIncrement(convergeRepeatInterval + aBit)
+
+			Eventually(fakeAuditPruner.PruneAuditEventsCallCount).Should(Equal(1))
+			_, before := fakeAuditPruner.PruneAuditEventsArgsForCall(0)
+			Expect(before).To(BeTemporally("==", fakeClock.Now().Add(-auditRetention)))
+		})
+
+		Context("when the audit log is disabled", func() {
+			BeforeEach(func() {
+				auditPruner = nil
+			})
+
+			It("still converges", func() {
+				fakeClock.WaitForWatcherAndIncrement(convergeRepeatInterval + aBit)
+
+				Eventually(fakeTaskController.ConvergeTasksCallCount).Should(Equal(1))
+				Eventually(fakeLrpConvergenceController.ConvergeLRPsCallCount).Should(Equal(1))
+				Expect(fakeAuditPruner.PruneAuditEventsCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
@@ -0,0 +1,9 @@
+package converger
+
+import "code.cloudfoundry.org/lager"
+
+//go:generate counterfeiter -o fake_controllers/fake_desired_lrp_rollout_controller.go . DesiredLRPRolloutController
+
+type DesiredLRPRolloutController interface {
+	ResumeRollouts(logger lager.Logger) error
+}
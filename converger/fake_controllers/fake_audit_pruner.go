@@ -0,0 +1,80 @@
+// This file was generated by counterfeiter
+package fake_controllers
+
+import (
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/bbs/converger"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakeAuditPruner struct {
+	PruneAuditEventsStub        func(logger lager.Logger, before time.Time) error
+	pruneAuditEventsMutex       sync.RWMutex
+	pruneAuditEventsArgsForCall []struct {
+		logger lager.Logger
+		before time.Time
+	}
+	pruneAuditEventsReturns struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAuditPruner) PruneAuditEvents(logger lager.Logger, before time.Time) error {
+	fake.pruneAuditEventsMutex.Lock()
+	fake.pruneAuditEventsArgsForCall = append(fake.pruneAuditEventsArgsForCall, struct {
+		logger lager.Logger
+		before time.Time
+	}{logger, before})
+	fake.recordInvocation("PruneAuditEvents", []interface{}{logger, before})
+	fake.pruneAuditEventsMutex.Unlock()
+	if fake.PruneAuditEventsStub != nil {
+		return fake.PruneAuditEventsStub(logger, before)
+	} else {
+		return fake.pruneAuditEventsReturns.result1
+	}
+}
+
+func (fake *FakeAuditPruner) PruneAuditEventsCallCount() int {
+	fake.pruneAuditEventsMutex.RLock()
+	defer fake.pruneAuditEventsMutex.RUnlock()
+	return len(fake.pruneAuditEventsArgsForCall)
+}
+
+func (fake *FakeAuditPruner) PruneAuditEventsArgsForCall(i int) (lager.Logger, time.Time) {
+	fake.pruneAuditEventsMutex.RLock()
+	defer fake.pruneAuditEventsMutex.RUnlock()
+	return fake.pruneAuditEventsArgsForCall[i].logger, fake.pruneAuditEventsArgsForCall[i].before
+}
+
+func (fake *FakeAuditPruner) PruneAuditEventsReturns(result1 error) {
+	fake.PruneAuditEventsStub = nil
+	fake.pruneAuditEventsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAuditPruner) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.pruneAuditEventsMutex.RLock()
+	defer fake.pruneAuditEventsMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeAuditPruner) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ converger.AuditPruner = new(FakeAuditPruner)
@@ -0,0 +1,77 @@
+// This file was generated by counterfeiter
+package fake_controllers
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/bbs/converger"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakeDesiredLRPRolloutController struct {
+	ResumeRolloutsStub        func(logger lager.Logger) error
+	resumeRolloutsMutex       sync.RWMutex
+	resumeRolloutsArgsForCall []struct {
+		logger lager.Logger
+	}
+	resumeRolloutsReturns struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDesiredLRPRolloutController) ResumeRollouts(logger lager.Logger) error {
+	fake.resumeRolloutsMutex.Lock()
+	fake.resumeRolloutsArgsForCall = append(fake.resumeRolloutsArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("ResumeRollouts", []interface{}{logger})
+	fake.resumeRolloutsMutex.Unlock()
+	if fake.ResumeRolloutsStub != nil {
+		return fake.ResumeRolloutsStub(logger)
+	} else {
+		return fake.resumeRolloutsReturns.result1
+	}
+}
+
+func (fake *FakeDesiredLRPRolloutController) ResumeRolloutsCallCount() int {
+	fake.resumeRolloutsMutex.RLock()
+	defer fake.resumeRolloutsMutex.RUnlock()
+	return len(fake.resumeRolloutsArgsForCall)
+}
+
+func (fake *FakeDesiredLRPRolloutController) ResumeRolloutsArgsForCall(i int) lager.Logger {
+	fake.resumeRolloutsMutex.RLock()
+	defer fake.resumeRolloutsMutex.RUnlock()
+	return fake.resumeRolloutsArgsForCall[i].logger
+}
+
+func (fake *FakeDesiredLRPRolloutController) ResumeRolloutsReturns(result1 error) {
+	fake.ResumeRolloutsStub = nil
+	fake.resumeRolloutsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDesiredLRPRolloutController) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.resumeRolloutsMutex.RLock()
+	defer fake.resumeRolloutsMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeDesiredLRPRolloutController) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ converger.DesiredLRPRolloutController = new(FakeDesiredLRPRolloutController)
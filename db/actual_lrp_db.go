@@ -9,6 +9,10 @@ import (
 
 type ActualLRPDB interface {
 	ActualLRPGroups(logger lager.Logger, filter models.ActualLRPFilter) ([]*models.ActualLRPGroup, error)
+	// ActualLRPGroupsPage returns a page of ActualLRPGroups along with the
+	// token for the next page, which is empty once the last page has been
+	// returned.
+	ActualLRPGroupsPage(logger lager.Logger, filter models.ActualLRPFilter) (groups []*models.ActualLRPGroup, nextPageToken string, err error)
 	ActualLRPGroupsByProcessGuid(logger lager.Logger, processGuid string) ([]*models.ActualLRPGroup, error)
 	ActualLRPGroupByProcessGuidAndIndex(logger lager.Logger, processGuid string, index int32) (*models.ActualLRPGroup, error)
 
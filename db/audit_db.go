@@ -0,0 +1,18 @@
+package db
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter . AuditDB
+
+// AuditDB stores the audit log of the mutating API calls. It is only
+// implemented by the SQL backend.
+type AuditDB interface {
+	RecordAuditEvent(logger lager.Logger, event *models.AuditEvent) error
+	AuditEvents(logger lager.Logger, filter models.AuditEventFilter) ([]*models.AuditEvent, error)
+	PruneAuditEvents(logger lager.Logger, before time.Time) error
+}
@@ -3,6 +3,7 @@ package db
 //go:generate counterfeiter . DB
 
 type DB interface {
+	DesiredLRPRolloutDB
 	DomainDB
 	EncryptionDB
 	EvacuationDB
@@ -20,6 +20,17 @@ type FakeActualLRPDB struct {
 		result1 []*models.ActualLRPGroup
 		result2 error
 	}
+	ActualLRPGroupsPageStub        func(logger lager.Logger, filter models.ActualLRPFilter) (groups []*models.ActualLRPGroup, nextPageToken string, err error)
+	actualLRPGroupsPageMutex       sync.RWMutex
+	actualLRPGroupsPageArgsForCall []struct {
+		logger lager.Logger
+		filter models.ActualLRPFilter
+	}
+	actualLRPGroupsPageReturns struct {
+		result1 []*models.ActualLRPGroup
+		result2 string
+		result3 error
+	}
 	ActualLRPGroupsByProcessGuidStub        func(logger lager.Logger, processGuid string) ([]*models.ActualLRPGroup, error)
 	actualLRPGroupsByProcessGuidMutex       sync.RWMutex
 	actualLRPGroupsByProcessGuidArgsForCall []struct {
@@ -164,6 +175,42 @@ func (fake *FakeActualLRPDB) ActualLRPGroupsReturns(result1 []*models.ActualLRPG
 	}{result1, result2}
 }
 
+func (fake *FakeActualLRPDB) ActualLRPGroupsPage(logger lager.Logger, filter models.ActualLRPFilter) (groups []*models.ActualLRPGroup, nextPageToken string, err error) {
+	fake.actualLRPGroupsPageMutex.Lock()
+	fake.actualLRPGroupsPageArgsForCall = append(fake.actualLRPGroupsPageArgsForCall, struct {
+		logger lager.Logger
+		filter models.ActualLRPFilter
+	}{logger, filter})
+	fake.recordInvocation("ActualLRPGroupsPage", []interface{}{logger, filter})
+	fake.actualLRPGroupsPageMutex.Unlock()
+	if fake.ActualLRPGroupsPageStub != nil {
+		return fake.ActualLRPGroupsPageStub(logger, filter)
+	} else {
+		return fake.actualLRPGroupsPageReturns.result1, fake.actualLRPGroupsPageReturns.result2, fake.actualLRPGroupsPageReturns.result3
+	}
+}
+
+func (fake *FakeActualLRPDB) ActualLRPGroupsPageCallCount() int {
+	fake.actualLRPGroupsPageMutex.RLock()
+	defer fake.actualLRPGroupsPageMutex.RUnlock()
+	return len(fake.actualLRPGroupsPageArgsForCall)
+}
+
+func (fake *FakeActualLRPDB) ActualLRPGroupsPageArgsForCall(i int) (lager.Logger, models.ActualLRPFilter) {
+	fake.actualLRPGroupsPageMutex.RLock()
+	defer fake.actualLRPGroupsPageMutex.RUnlock()
+	return fake.actualLRPGroupsPageArgsForCall[i].logger, fake.actualLRPGroupsPageArgsForCall[i].filter
+}
+
+func (fake *FakeActualLRPDB) ActualLRPGroupsPageReturns(result1 []*models.ActualLRPGroup, result2 string, result3 error) {
+	fake.ActualLRPGroupsPageStub = nil
+	fake.actualLRPGroupsPageReturns = struct {
+		result1 []*models.ActualLRPGroup
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActualLRPDB) ActualLRPGroupsByProcessGuid(logger lager.Logger, processGuid string) ([]*models.ActualLRPGroup, error) {
 	fake.actualLRPGroupsByProcessGuidMutex.Lock()
 	fake.actualLRPGroupsByProcessGuidArgsForCall = append(fake.actualLRPGroupsByProcessGuidArgsForCall, struct {
@@ -499,6 +546,8 @@ func (fake *FakeActualLRPDB) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.actualLRPGroupsMutex.RLock()
 	defer fake.actualLRPGroupsMutex.RUnlock()
+	fake.actualLRPGroupsPageMutex.RLock()
+	defer fake.actualLRPGroupsPageMutex.RUnlock()
 	fake.actualLRPGroupsByProcessGuidMutex.RLock()
 	defer fake.actualLRPGroupsByProcessGuidMutex.RUnlock()
 	fake.actualLRPGroupByProcessGuidAndIndexMutex.RLock()
@@ -0,0 +1,173 @@
+// This file was generated by counterfeiter
+package dbfakes
+
+import (
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakeAuditDB struct {
+	RecordAuditEventStub        func(logger lager.Logger, event *models.AuditEvent) error
+	recordAuditEventMutex       sync.RWMutex
+	recordAuditEventArgsForCall []struct {
+		logger lager.Logger
+		event  *models.AuditEvent
+	}
+	recordAuditEventReturns struct {
+		result1 error
+	}
+	AuditEventsStub        func(logger lager.Logger, filter models.AuditEventFilter) ([]*models.AuditEvent, error)
+	auditEventsMutex       sync.RWMutex
+	auditEventsArgsForCall []struct {
+		logger lager.Logger
+		filter models.AuditEventFilter
+	}
+	auditEventsReturns struct {
+		result1 []*models.AuditEvent
+		result2 error
+	}
+	PruneAuditEventsStub        func(logger lager.Logger, before time.Time) error
+	pruneAuditEventsMutex       sync.RWMutex
+	pruneAuditEventsArgsForCall []struct {
+		logger lager.Logger
+		before time.Time
+	}
+	pruneAuditEventsReturns struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAuditDB) RecordAuditEvent(logger lager.Logger, event *models.AuditEvent) error {
+	fake.recordAuditEventMutex.Lock()
+	fake.recordAuditEventArgsForCall = append(fake.recordAuditEventArgsForCall, struct {
+		logger lager.Logger
+		event  *models.AuditEvent
+	}{logger, event})
+	fake.recordInvocation("RecordAuditEvent", []interface{}{logger, event})
+	fake.recordAuditEventMutex.Unlock()
+	if fake.RecordAuditEventStub != nil {
+		return fake.RecordAuditEventStub(logger, event)
+	} else {
+		return fake.recordAuditEventReturns.result1
+	}
+}
+
+func (fake *FakeAuditDB) RecordAuditEventCallCount() int {
+	fake.recordAuditEventMutex.RLock()
+	defer fake.recordAuditEventMutex.RUnlock()
+	return len(fake.recordAuditEventArgsForCall)
+}
+
+func (fake *FakeAuditDB) RecordAuditEventArgsForCall(i int) (lager.Logger, *models.AuditEvent) {
+	fake.recordAuditEventMutex.RLock()
+	defer fake.recordAuditEventMutex.RUnlock()
+	return fake.recordAuditEventArgsForCall[i].logger, fake.recordAuditEventArgsForCall[i].event
+}
+
+func (fake *FakeAuditDB) RecordAuditEventReturns(result1 error) {
+	fake.RecordAuditEventStub = nil
+	fake.recordAuditEventReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAuditDB) AuditEvents(logger lager.Logger, filter models.AuditEventFilter) ([]*models.AuditEvent, error) {
+	fake.auditEventsMutex.Lock()
+	fake.auditEventsArgsForCall = append(fake.auditEventsArgsForCall, struct {
+		logger lager.Logger
+		filter models.AuditEventFilter
+	}{logger, filter})
+	fake.recordInvocation("AuditEvents", []interface{}{logger, filter})
+	fake.auditEventsMutex.Unlock()
+	if fake.AuditEventsStub != nil {
+		return fake.AuditEventsStub(logger, filter)
+	} else {
+		return fake.auditEventsReturns.result1, fake.auditEventsReturns.result2
+	}
+}
+
+func (fake *FakeAuditDB) AuditEventsCallCount() int {
+	fake.auditEventsMutex.RLock()
+	defer fake.auditEventsMutex.RUnlock()
+	return len(fake.auditEventsArgsForCall)
+}
+
+func (fake *FakeAuditDB) AuditEventsArgsForCall(i int) (lager.Logger, models.AuditEventFilter) {
+	fake.auditEventsMutex.RLock()
+	defer fake.auditEventsMutex.RUnlock()
+	return fake.auditEventsArgsForCall[i].logger, fake.auditEventsArgsForCall[i].filter
+}
+
+func (fake *FakeAuditDB) AuditEventsReturns(result1 []*models.AuditEvent, result2 error) {
+	fake.AuditEventsStub = nil
+	fake.auditEventsReturns = struct {
+		result1 []*models.AuditEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuditDB) PruneAuditEvents(logger lager.Logger, before time.Time) error {
+	fake.pruneAuditEventsMutex.Lock()
+	fake.pruneAuditEventsArgsForCall = append(fake.pruneAuditEventsArgsForCall, struct {
+		logger lager.Logger
+		before time.Time
+	}{logger, before})
+	fake.recordInvocation("PruneAuditEvents", []interface{}{logger, before})
+	fake.pruneAuditEventsMutex.Unlock()
+	if fake.PruneAuditEventsStub != nil {
+		return fake.PruneAuditEventsStub(logger, before)
+	} else {
+		return fake.pruneAuditEventsReturns.result1
+	}
+}
+
+func (fake *FakeAuditDB) PruneAuditEventsCallCount() int {
+	fake.pruneAuditEventsMutex.RLock()
+	defer fake.pruneAuditEventsMutex.RUnlock()
+	return len(fake.pruneAuditEventsArgsForCall)
+}
+
+func (fake *FakeAuditDB) PruneAuditEventsArgsForCall(i int) (lager.Logger, time.Time) {
+	fake.pruneAuditEventsMutex.RLock()
+	defer fake.pruneAuditEventsMutex.RUnlock()
+	return fake.pruneAuditEventsArgsForCall[i].logger, fake.pruneAuditEventsArgsForCall[i].before
+}
+
+func (fake *FakeAuditDB) PruneAuditEventsReturns(result1 error) {
+	fake.PruneAuditEventsStub = nil
+	fake.pruneAuditEventsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAuditDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.recordAuditEventMutex.RLock()
+	defer fake.recordAuditEventMutex.RUnlock()
+	fake.auditEventsMutex.RLock()
+	defer fake.auditEventsMutex.RUnlock()
+	fake.pruneAuditEventsMutex.RLock()
+	defer fake.pruneAuditEventsMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeAuditDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.AuditDB = new(FakeAuditDB)
@@ -12,6 +12,52 @@ import (
 )
 
 type FakeDB struct {
+	DesiredLRPRolloutsStub        func(logger lager.Logger) ([]*models.DesiredLRPRollout, error)
+	desiredLRPRolloutsMutex       sync.RWMutex
+	desiredLRPRolloutsArgsForCall []struct {
+		logger lager.Logger
+	}
+	desiredLRPRolloutsReturns struct {
+		result1 []*models.DesiredLRPRollout
+		result2 error
+	}
+	DesiredLRPRolloutStub        func(logger lager.Logger, processGuid string) (*models.DesiredLRPRollout, error)
+	desiredLRPRolloutMutex       sync.RWMutex
+	desiredLRPRolloutArgsForCall []struct {
+		logger      lager.Logger
+		processGuid string
+	}
+	desiredLRPRolloutReturns struct {
+		result1 *models.DesiredLRPRollout
+		result2 error
+	}
+	UpsertDesiredLRPRolloutStub        func(logger lager.Logger, rollout *models.DesiredLRPRollout) error
+	upsertDesiredLRPRolloutMutex       sync.RWMutex
+	upsertDesiredLRPRolloutArgsForCall []struct {
+		logger  lager.Logger
+		rollout *models.DesiredLRPRollout
+	}
+	upsertDesiredLRPRolloutReturns struct {
+		result1 error
+	}
+	UpdateDesiredLRPRolloutStub        func(logger lager.Logger, rollout *models.DesiredLRPRollout) error
+	updateDesiredLRPRolloutMutex       sync.RWMutex
+	updateDesiredLRPRolloutArgsForCall []struct {
+		logger  lager.Logger
+		rollout *models.DesiredLRPRollout
+	}
+	updateDesiredLRPRolloutReturns struct {
+		result1 error
+	}
+	RemoveDesiredLRPRolloutStub        func(logger lager.Logger, processGuid string) error
+	removeDesiredLRPRolloutMutex       sync.RWMutex
+	removeDesiredLRPRolloutArgsForCall []struct {
+		logger      lager.Logger
+		processGuid string
+	}
+	removeDesiredLRPRolloutReturns struct {
+		result1 error
+	}
 	DomainsStub        func(logger lager.Logger) ([]string, error)
 	domainsMutex       sync.RWMutex
 	domainsArgsForCall []struct {
@@ -90,6 +136,17 @@ type FakeDB struct {
 		result1 []*models.ActualLRPGroup
 		result2 error
 	}
+	ActualLRPGroupsPageStub        func(logger lager.Logger, filter models.ActualLRPFilter) (groups []*models.ActualLRPGroup, nextPageToken string, err error)
+	actualLRPGroupsPageMutex       sync.RWMutex
+	actualLRPGroupsPageArgsForCall []struct {
+		logger lager.Logger
+		filter models.ActualLRPFilter
+	}
+	actualLRPGroupsPageReturns struct {
+		result1 []*models.ActualLRPGroup
+		result2 string
+		result3 error
+	}
 	ActualLRPGroupsByProcessGuidStub        func(logger lager.Logger, processGuid string) ([]*models.ActualLRPGroup, error)
 	actualLRPGroupsByProcessGuidMutex       sync.RWMutex
 	actualLRPGroupsByProcessGuidArgsForCall []struct {
@@ -205,6 +262,17 @@ type FakeDB struct {
 		result1 []*models.DesiredLRP
 		result2 error
 	}
+	DesiredLRPsPageStub        func(logger lager.Logger, filter models.DesiredLRPFilter) (desiredLRPs []*models.DesiredLRP, nextPageToken string, err error)
+	desiredLRPsPageMutex       sync.RWMutex
+	desiredLRPsPageArgsForCall []struct {
+		logger lager.Logger
+		filter models.DesiredLRPFilter
+	}
+	desiredLRPsPageReturns struct {
+		result1 []*models.DesiredLRP
+		result2 string
+		result3 error
+	}
 	DesiredLRPByProcessGuidStub        func(logger lager.Logger, processGuid string) (*models.DesiredLRP, error)
 	desiredLRPByProcessGuidMutex       sync.RWMutex
 	desiredLRPByProcessGuidArgsForCall []struct {
@@ -234,26 +302,52 @@ type FakeDB struct {
 	desireLRPReturns struct {
 		result1 error
 	}
-	UpdateDesiredLRPStub        func(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) (beforeDesiredLRP *models.DesiredLRP, err error)
+	UpdateDesiredLRPStub        func(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate, expectedTag *models.ModificationTag) (beforeDesiredLRP *models.DesiredLRP, err error)
 	updateDesiredLRPMutex       sync.RWMutex
 	updateDesiredLRPArgsForCall []struct {
 		logger      lager.Logger
 		processGuid string
 		update      *models.DesiredLRPUpdate
+		expectedTag *models.ModificationTag
 	}
 	updateDesiredLRPReturns struct {
 		result1 *models.DesiredLRP
 		result2 error
 	}
-	RemoveDesiredLRPStub        func(logger lager.Logger, processGuid string) error
+	RemoveDesiredLRPStub        func(logger lager.Logger, processGuid string, expectedTag *models.ModificationTag) error
 	removeDesiredLRPMutex       sync.RWMutex
 	removeDesiredLRPArgsForCall []struct {
 		logger      lager.Logger
 		processGuid string
+		expectedTag *models.ModificationTag
 	}
 	removeDesiredLRPReturns struct {
 		result1 error
 	}
+	UpdateDesiredLRPRunInfoStub        func(logger lager.Logger, processGuid string, runInfo *models.DesiredLRPRunInfo, resource *models.DesiredLRPResource) (beforeDesiredLRP *models.DesiredLRP, err error)
+	updateDesiredLRPRunInfoMutex       sync.RWMutex
+	updateDesiredLRPRunInfoArgsForCall []struct {
+		logger      lager.Logger
+		processGuid string
+		runInfo     *models.DesiredLRPRunInfo
+		resource    *models.DesiredLRPResource
+	}
+	updateDesiredLRPRunInfoReturns struct {
+		result1 *models.DesiredLRP
+		result2 error
+	}
+	ApplyDesiredLRPsStub        func(logger lager.Logger, domain string, desiredLRPs []*models.DesiredLRP, ttl uint32) (*models.DesiredLRPApplyResult, error)
+	applyDesiredLRPsMutex       sync.RWMutex
+	applyDesiredLRPsArgsForCall []struct {
+		logger      lager.Logger
+		domain      string
+		desiredLRPs []*models.DesiredLRP
+		ttl         uint32
+	}
+	applyDesiredLRPsReturns struct {
+		result1 *models.DesiredLRPApplyResult
+		result2 error
+	}
 	ConvergeLRPsStub        func(logger lager.Logger, cellSet models.CellSet) (startRequests []*auctioneer.LRPStartRequest, keysWithMissingCells []*models.ActualLRPKeyWithSchedulingInfo, keysToRetire []*models.ActualLRPKey)
 	convergeLRPsMutex       sync.RWMutex
 	convergeLRPsArgsForCall []struct {
@@ -285,6 +379,17 @@ type FakeDB struct {
 		result1 []*models.Task
 		result2 error
 	}
+	TasksPageStub        func(logger lager.Logger, filter models.TaskFilter) (tasks []*models.Task, nextPageToken string, err error)
+	tasksPageMutex       sync.RWMutex
+	tasksPageArgsForCall []struct {
+		logger lager.Logger
+		filter models.TaskFilter
+	}
+	tasksPageReturns struct {
+		result1 []*models.Task
+		result2 string
+		result3 error
+	}
 	TaskByGuidStub        func(logger lager.Logger, taskGuid string) (*models.Task, error)
 	taskByGuidMutex       sync.RWMutex
 	taskByGuidArgsForCall []struct {
@@ -295,7 +400,7 @@ type FakeDB struct {
 		result1 *models.Task
 		result2 error
 	}
-	DesireTaskStub        func(logger lager.Logger, taskDefinition *models.TaskDefinition, taskGuid, domain string) error
+	DesireTaskStub        func(logger lager.Logger, taskDefinition *models.TaskDefinition, taskGuid, domain string) (*models.Task, error)
 	desireTaskMutex       sync.RWMutex
 	desireTaskArgsForCall []struct {
 		logger         lager.Logger
@@ -304,9 +409,10 @@ type FakeDB struct {
 		domain         string
 	}
 	desireTaskReturns struct {
-		result1 error
+		result1 *models.Task
+		result2 error
 	}
-	StartTaskStub        func(logger lager.Logger, taskGuid, cellId string) (bool, error)
+	StartTaskStub        func(logger lager.Logger, taskGuid, cellId string) (before *models.Task, after *models.Task, shouldStart bool, err error)
 	startTaskMutex       sync.RWMutex
 	startTaskArgsForCall []struct {
 		logger   lager.Logger
@@ -314,10 +420,12 @@ type FakeDB struct {
 		cellId   string
 	}
 	startTaskReturns struct {
-		result1 bool
-		result2 error
+		result1 *models.Task
+		result2 *models.Task
+		result3 bool
+		result4 error
 	}
-	CancelTaskStub        func(logger lager.Logger, taskGuid string) (task *models.Task, cellID string, err error)
+	CancelTaskStub        func(logger lager.Logger, taskGuid string) (before *models.Task, after *models.Task, cellID string, err error)
 	cancelTaskMutex       sync.RWMutex
 	cancelTaskArgsForCall []struct {
 		logger   lager.Logger
@@ -325,10 +433,11 @@ type FakeDB struct {
 	}
 	cancelTaskReturns struct {
 		result1 *models.Task
-		result2 string
-		result3 error
+		result2 *models.Task
+		result3 string
+		result4 error
 	}
-	FailTaskStub        func(logger lager.Logger, taskGuid, failureReason string) (task *models.Task, err error)
+	FailTaskStub        func(logger lager.Logger, taskGuid, failureReason string) (before *models.Task, after *models.Task, err error)
 	failTaskMutex       sync.RWMutex
 	failTaskArgsForCall []struct {
 		logger        lager.Logger
@@ -337,9 +446,10 @@ type FakeDB struct {
 	}
 	failTaskReturns struct {
 		result1 *models.Task
-		result2 error
+		result2 *models.Task
+		result3 error
 	}
-	CompleteTaskStub        func(logger lager.Logger, taskGuid, cellId string, failed bool, failureReason, result string) (task *models.Task, err error)
+	CompleteTaskStub        func(logger lager.Logger, taskGuid, cellId string, failed bool, failureReason, result string) (before *models.Task, after *models.Task, err error)
 	completeTaskMutex       sync.RWMutex
 	completeTaskArgsForCall []struct {
 		logger        lager.Logger
@@ -351,27 +461,31 @@ type FakeDB struct {
 	}
 	completeTaskReturns struct {
 		result1 *models.Task
-		result2 error
+		result2 *models.Task
+		result3 error
 	}
-	ResolvingTaskStub        func(logger lager.Logger, taskGuid string) error
+	ResolvingTaskStub        func(logger lager.Logger, taskGuid string) (before *models.Task, after *models.Task, err error)
 	resolvingTaskMutex       sync.RWMutex
 	resolvingTaskArgsForCall []struct {
 		logger   lager.Logger
 		taskGuid string
 	}
 	resolvingTaskReturns struct {
-		result1 error
+		result1 *models.Task
+		result2 *models.Task
+		result3 error
 	}
-	DeleteTaskStub        func(logger lager.Logger, taskGuid string) error
+	DeleteTaskStub        func(logger lager.Logger, taskGuid string) (task *models.Task, err error)
 	deleteTaskMutex       sync.RWMutex
 	deleteTaskArgsForCall []struct {
 		logger   lager.Logger
 		taskGuid string
 	}
 	deleteTaskReturns struct {
-		result1 error
+		result1 *models.Task
+		result2 error
 	}
-	ConvergeTasksStub        func(logger lager.Logger, cellSet models.CellSet, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration time.Duration) (tasksToAuction []*auctioneer.TaskStartRequest, tasksToComplete []*models.Task)
+	ConvergeTasksStub        func(logger lager.Logger, cellSet models.CellSet, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration time.Duration) (tasksToAuction []*auctioneer.TaskStartRequest, tasksToComplete []*models.Task, events []models.Event)
 	convergeTasksMutex       sync.RWMutex
 	convergeTasksArgsForCall []struct {
 		logger                      lager.Logger
@@ -383,6 +497,7 @@ type FakeDB struct {
 	convergeTasksReturns struct {
 		result1 []*auctioneer.TaskStartRequest
 		result2 []*models.Task
+		result3 []models.Event
 	}
 	VersionStub        func(logger lager.Logger) (*models.Version, error)
 	versionMutex       sync.RWMutex
@@ -406,6 +521,177 @@ type FakeDB struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeDB) DesiredLRPRollouts(logger lager.Logger) ([]*models.DesiredLRPRollout, error) {
+	fake.desiredLRPRolloutsMutex.Lock()
+	fake.desiredLRPRolloutsArgsForCall = append(fake.desiredLRPRolloutsArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("DesiredLRPRollouts", []interface{}{logger})
+	fake.desiredLRPRolloutsMutex.Unlock()
+	if fake.DesiredLRPRolloutsStub != nil {
+		return fake.DesiredLRPRolloutsStub(logger)
+	} else {
+		return fake.desiredLRPRolloutsReturns.result1, fake.desiredLRPRolloutsReturns.result2
+	}
+}
+
+func (fake *FakeDB) DesiredLRPRolloutsCallCount() int {
+	fake.desiredLRPRolloutsMutex.RLock()
+	defer fake.desiredLRPRolloutsMutex.RUnlock()
+	return len(fake.desiredLRPRolloutsArgsForCall)
+}
+
+func (fake *FakeDB) DesiredLRPRolloutsArgsForCall(i int) lager.Logger {
+	fake.desiredLRPRolloutsMutex.RLock()
+	defer fake.desiredLRPRolloutsMutex.RUnlock()
+	return fake.desiredLRPRolloutsArgsForCall[i].logger
+}
+
+func (fake *FakeDB) DesiredLRPRolloutsReturns(result1 []*models.DesiredLRPRollout, result2 error) {
+	fake.DesiredLRPRolloutsStub = nil
+	fake.desiredLRPRolloutsReturns = struct {
+		result1 []*models.DesiredLRPRollout
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) DesiredLRPRollout(logger lager.Logger, processGuid string) (*models.DesiredLRPRollout, error) {
+	fake.desiredLRPRolloutMutex.Lock()
+	fake.desiredLRPRolloutArgsForCall = append(fake.desiredLRPRolloutArgsForCall, struct {
+		logger      lager.Logger
+		processGuid string
+	}{logger, processGuid})
+	fake.recordInvocation("DesiredLRPRollout", []interface{}{logger, processGuid})
+	fake.desiredLRPRolloutMutex.Unlock()
+	if fake.DesiredLRPRolloutStub != nil {
+		return fake.DesiredLRPRolloutStub(logger, processGuid)
+	} else {
+		return fake.desiredLRPRolloutReturns.result1, fake.desiredLRPRolloutReturns.result2
+	}
+}
+
+func (fake *FakeDB) DesiredLRPRolloutCallCount() int {
+	fake.desiredLRPRolloutMutex.RLock()
+	defer fake.desiredLRPRolloutMutex.RUnlock()
+	return len(fake.desiredLRPRolloutArgsForCall)
+}
+
+func (fake *FakeDB) DesiredLRPRolloutArgsForCall(i int) (lager.Logger, string) {
+	fake.desiredLRPRolloutMutex.RLock()
+	defer fake.desiredLRPRolloutMutex.RUnlock()
+	return fake.desiredLRPRolloutArgsForCall[i].logger, fake.desiredLRPRolloutArgsForCall[i].processGuid
+}
+
+func (fake *FakeDB) DesiredLRPRolloutReturns(result1 *models.DesiredLRPRollout, result2 error) {
+	fake.DesiredLRPRolloutStub = nil
+	fake.desiredLRPRolloutReturns = struct {
+		result1 *models.DesiredLRPRollout
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) UpsertDesiredLRPRollout(logger lager.Logger, rollout *models.DesiredLRPRollout) error {
+	fake.upsertDesiredLRPRolloutMutex.Lock()
+	fake.upsertDesiredLRPRolloutArgsForCall = append(fake.upsertDesiredLRPRolloutArgsForCall, struct {
+		logger  lager.Logger
+		rollout *models.DesiredLRPRollout
+	}{logger, rollout})
+	fake.recordInvocation("UpsertDesiredLRPRollout", []interface{}{logger, rollout})
+	fake.upsertDesiredLRPRolloutMutex.Unlock()
+	if fake.UpsertDesiredLRPRolloutStub != nil {
+		return fake.UpsertDesiredLRPRolloutStub(logger, rollout)
+	} else {
+		return fake.upsertDesiredLRPRolloutReturns.result1
+	}
+}
+
+func (fake *FakeDB) UpsertDesiredLRPRolloutCallCount() int {
+	fake.upsertDesiredLRPRolloutMutex.RLock()
+	defer fake.upsertDesiredLRPRolloutMutex.RUnlock()
+	return len(fake.upsertDesiredLRPRolloutArgsForCall)
+}
+
+func (fake *FakeDB) UpsertDesiredLRPRolloutArgsForCall(i int) (lager.Logger, *models.DesiredLRPRollout) {
+	fake.upsertDesiredLRPRolloutMutex.RLock()
+	defer fake.upsertDesiredLRPRolloutMutex.RUnlock()
+	return fake.upsertDesiredLRPRolloutArgsForCall[i].logger, fake.upsertDesiredLRPRolloutArgsForCall[i].rollout
+}
+
+func (fake *FakeDB) UpsertDesiredLRPRolloutReturns(result1 error) {
+	fake.UpsertDesiredLRPRolloutStub = nil
+	fake.upsertDesiredLRPRolloutReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) UpdateDesiredLRPRollout(logger lager.Logger, rollout *models.DesiredLRPRollout) error {
+	fake.updateDesiredLRPRolloutMutex.Lock()
+	fake.updateDesiredLRPRolloutArgsForCall = append(fake.updateDesiredLRPRolloutArgsForCall, struct {
+		logger  lager.Logger
+		rollout *models.DesiredLRPRollout
+	}{logger, rollout})
+	fake.recordInvocation("UpdateDesiredLRPRollout", []interface{}{logger, rollout})
+	fake.updateDesiredLRPRolloutMutex.Unlock()
+	if fake.UpdateDesiredLRPRolloutStub != nil {
+		return fake.UpdateDesiredLRPRolloutStub(logger, rollout)
+	} else {
+		return fake.updateDesiredLRPRolloutReturns.result1
+	}
+}
+
+func (fake *FakeDB) UpdateDesiredLRPRolloutCallCount() int {
+	fake.updateDesiredLRPRolloutMutex.RLock()
+	defer fake.updateDesiredLRPRolloutMutex.RUnlock()
+	return len(fake.updateDesiredLRPRolloutArgsForCall)
+}
+
+func (fake *FakeDB) UpdateDesiredLRPRolloutArgsForCall(i int) (lager.Logger, *models.DesiredLRPRollout) {
+	fake.updateDesiredLRPRolloutMutex.RLock()
+	defer fake.updateDesiredLRPRolloutMutex.RUnlock()
+	return fake.updateDesiredLRPRolloutArgsForCall[i].logger, fake.updateDesiredLRPRolloutArgsForCall[i].rollout
+}
+
+func (fake *FakeDB) UpdateDesiredLRPRolloutReturns(result1 error) {
+	fake.UpdateDesiredLRPRolloutStub = nil
+	fake.updateDesiredLRPRolloutReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) RemoveDesiredLRPRollout(logger lager.Logger, processGuid string) error {
+	fake.removeDesiredLRPRolloutMutex.Lock()
+	fake.removeDesiredLRPRolloutArgsForCall = append(fake.removeDesiredLRPRolloutArgsForCall, struct {
+		logger      lager.Logger
+		processGuid string
+	}{logger, processGuid})
+	fake.recordInvocation("RemoveDesiredLRPRollout", []interface{}{logger, processGuid})
+	fake.removeDesiredLRPRolloutMutex.Unlock()
+	if fake.RemoveDesiredLRPRolloutStub != nil {
+		return fake.RemoveDesiredLRPRolloutStub(logger, processGuid)
+	} else {
+		return fake.removeDesiredLRPRolloutReturns.result1
+	}
+}
+
+func (fake *FakeDB) RemoveDesiredLRPRolloutCallCount() int {
+	fake.removeDesiredLRPRolloutMutex.RLock()
+	defer fake.removeDesiredLRPRolloutMutex.RUnlock()
+	return len(fake.removeDesiredLRPRolloutArgsForCall)
+}
+
+func (fake *FakeDB) RemoveDesiredLRPRolloutArgsForCall(i int) (lager.Logger, string) {
+	fake.removeDesiredLRPRolloutMutex.RLock()
+	defer fake.removeDesiredLRPRolloutMutex.RUnlock()
+	return fake.removeDesiredLRPRolloutArgsForCall[i].logger, fake.removeDesiredLRPRolloutArgsForCall[i].processGuid
+}
+
+func (fake *FakeDB) RemoveDesiredLRPRolloutReturns(result1 error) {
+	fake.RemoveDesiredLRPRolloutStub = nil
+	fake.removeDesiredLRPRolloutReturns = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeDB) Domains(logger lager.Logger) ([]string, error) {
 	fake.domainsMutex.Lock()
 	fake.domainsArgsForCall = append(fake.domainsArgsForCall, struct {
@@ -684,6 +970,42 @@ func (fake *FakeDB) ActualLRPGroupsReturns(result1 []*models.ActualLRPGroup, res
 	}{result1, result2}
 }
 
+func (fake *FakeDB) ActualLRPGroupsPage(logger lager.Logger, filter models.ActualLRPFilter) (groups []*models.ActualLRPGroup, nextPageToken string, err error) {
+	fake.actualLRPGroupsPageMutex.Lock()
+	fake.actualLRPGroupsPageArgsForCall = append(fake.actualLRPGroupsPageArgsForCall, struct {
+		logger lager.Logger
+		filter models.ActualLRPFilter
+	}{logger, filter})
+	fake.recordInvocation("ActualLRPGroupsPage", []interface{}{logger, filter})
+	fake.actualLRPGroupsPageMutex.Unlock()
+	if fake.ActualLRPGroupsPageStub != nil {
+		return fake.ActualLRPGroupsPageStub(logger, filter)
+	} else {
+		return fake.actualLRPGroupsPageReturns.result1, fake.actualLRPGroupsPageReturns.result2, fake.actualLRPGroupsPageReturns.result3
+	}
+}
+
+func (fake *FakeDB) ActualLRPGroupsPageCallCount() int {
+	fake.actualLRPGroupsPageMutex.RLock()
+	defer fake.actualLRPGroupsPageMutex.RUnlock()
+	return len(fake.actualLRPGroupsPageArgsForCall)
+}
+
+func (fake *FakeDB) ActualLRPGroupsPageArgsForCall(i int) (lager.Logger, models.ActualLRPFilter) {
+	fake.actualLRPGroupsPageMutex.RLock()
+	defer fake.actualLRPGroupsPageMutex.RUnlock()
+	return fake.actualLRPGroupsPageArgsForCall[i].logger, fake.actualLRPGroupsPageArgsForCall[i].filter
+}
+
+func (fake *FakeDB) ActualLRPGroupsPageReturns(result1 []*models.ActualLRPGroup, result2 string, result3 error) {
+	fake.ActualLRPGroupsPageStub = nil
+	fake.actualLRPGroupsPageReturns = struct {
+		result1 []*models.ActualLRPGroup
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeDB) ActualLRPGroupsByProcessGuid(logger lager.Logger, processGuid string) ([]*models.ActualLRPGroup, error) {
 	fake.actualLRPGroupsByProcessGuidMutex.Lock()
 	fake.actualLRPGroupsByProcessGuidArgsForCall = append(fake.actualLRPGroupsByProcessGuidArgsForCall, struct {
@@ -1049,6 +1371,42 @@ func (fake *FakeDB) DesiredLRPsReturns(result1 []*models.DesiredLRP, result2 err
 	}{result1, result2}
 }
 
+func (fake *FakeDB) DesiredLRPsPage(logger lager.Logger, filter models.DesiredLRPFilter) (desiredLRPs []*models.DesiredLRP, nextPageToken string, err error) {
+	fake.desiredLRPsPageMutex.Lock()
+	fake.desiredLRPsPageArgsForCall = append(fake.desiredLRPsPageArgsForCall, struct {
+		logger lager.Logger
+		filter models.DesiredLRPFilter
+	}{logger, filter})
+	fake.recordInvocation("DesiredLRPsPage", []interface{}{logger, filter})
+	fake.desiredLRPsPageMutex.Unlock()
+	if fake.DesiredLRPsPageStub != nil {
+		return fake.DesiredLRPsPageStub(logger, filter)
+	} else {
+		return fake.desiredLRPsPageReturns.result1, fake.desiredLRPsPageReturns.result2, fake.desiredLRPsPageReturns.result3
+	}
+}
+
+func (fake *FakeDB) DesiredLRPsPageCallCount() int {
+	fake.desiredLRPsPageMutex.RLock()
+	defer fake.desiredLRPsPageMutex.RUnlock()
+	return len(fake.desiredLRPsPageArgsForCall)
+}
+
+func (fake *FakeDB) DesiredLRPsPageArgsForCall(i int) (lager.Logger, models.DesiredLRPFilter) {
+	fake.desiredLRPsPageMutex.RLock()
+	defer fake.desiredLRPsPageMutex.RUnlock()
+	return fake.desiredLRPsPageArgsForCall[i].logger, fake.desiredLRPsPageArgsForCall[i].filter
+}
+
+func (fake *FakeDB) DesiredLRPsPageReturns(result1 []*models.DesiredLRP, result2 string, result3 error) {
+	fake.DesiredLRPsPageStub = nil
+	fake.desiredLRPsPageReturns = struct {
+		result1 []*models.DesiredLRP
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeDB) DesiredLRPByProcessGuid(logger lager.Logger, processGuid string) (*models.DesiredLRP, error) {
 	fake.desiredLRPByProcessGuidMutex.Lock()
 	fake.desiredLRPByProcessGuidArgsForCall = append(fake.desiredLRPByProcessGuidArgsForCall, struct {
@@ -1153,17 +1511,18 @@ func (fake *FakeDB) DesireLRPReturns(result1 error) {
 	}{result1}
 }
 
-func (fake *FakeDB) UpdateDesiredLRP(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) (beforeDesiredLRP *models.DesiredLRP, err error) {
+func (fake *FakeDB) UpdateDesiredLRP(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate, expectedTag *models.ModificationTag) (beforeDesiredLRP *models.DesiredLRP, err error) {
 	fake.updateDesiredLRPMutex.Lock()
 	fake.updateDesiredLRPArgsForCall = append(fake.updateDesiredLRPArgsForCall, struct {
 		logger      lager.Logger
 		processGuid string
 		update      *models.DesiredLRPUpdate
-	}{logger, processGuid, update})
-	fake.recordInvocation("UpdateDesiredLRP", []interface{}{logger, processGuid, update})
+		expectedTag *models.ModificationTag
+	}{logger, processGuid, update, expectedTag})
+	fake.recordInvocation("UpdateDesiredLRP", []interface{}{logger, processGuid, update, expectedTag})
 	fake.updateDesiredLRPMutex.Unlock()
 	if fake.UpdateDesiredLRPStub != nil {
-		return fake.UpdateDesiredLRPStub(logger, processGuid, update)
+		return fake.UpdateDesiredLRPStub(logger, processGuid, update, expectedTag)
 	} else {
 		return fake.updateDesiredLRPReturns.result1, fake.updateDesiredLRPReturns.result2
 	}
@@ -1175,10 +1534,10 @@ func (fake *FakeDB) UpdateDesiredLRPCallCount() int {
 	return len(fake.updateDesiredLRPArgsForCall)
 }
 
-func (fake *FakeDB) UpdateDesiredLRPArgsForCall(i int) (lager.Logger, string, *models.DesiredLRPUpdate) {
+func (fake *FakeDB) UpdateDesiredLRPArgsForCall(i int) (lager.Logger, string, *models.DesiredLRPUpdate, *models.ModificationTag) {
 	fake.updateDesiredLRPMutex.RLock()
 	defer fake.updateDesiredLRPMutex.RUnlock()
-	return fake.updateDesiredLRPArgsForCall[i].logger, fake.updateDesiredLRPArgsForCall[i].processGuid, fake.updateDesiredLRPArgsForCall[i].update
+	return fake.updateDesiredLRPArgsForCall[i].logger, fake.updateDesiredLRPArgsForCall[i].processGuid, fake.updateDesiredLRPArgsForCall[i].update, fake.updateDesiredLRPArgsForCall[i].expectedTag
 }
 
 func (fake *FakeDB) UpdateDesiredLRPReturns(result1 *models.DesiredLRP, result2 error) {
@@ -1189,16 +1548,17 @@ func (fake *FakeDB) UpdateDesiredLRPReturns(result1 *models.DesiredLRP, result2
 	}{result1, result2}
 }
 
-func (fake *FakeDB) RemoveDesiredLRP(logger lager.Logger, processGuid string) error {
+func (fake *FakeDB) RemoveDesiredLRP(logger lager.Logger, processGuid string, expectedTag *models.ModificationTag) error {
 	fake.removeDesiredLRPMutex.Lock()
 	fake.removeDesiredLRPArgsForCall = append(fake.removeDesiredLRPArgsForCall, struct {
 		logger      lager.Logger
 		processGuid string
-	}{logger, processGuid})
-	fake.recordInvocation("RemoveDesiredLRP", []interface{}{logger, processGuid})
+		expectedTag *models.ModificationTag
+	}{logger, processGuid, expectedTag})
+	fake.recordInvocation("RemoveDesiredLRP", []interface{}{logger, processGuid, expectedTag})
 	fake.removeDesiredLRPMutex.Unlock()
 	if fake.RemoveDesiredLRPStub != nil {
-		return fake.RemoveDesiredLRPStub(logger, processGuid)
+		return fake.RemoveDesiredLRPStub(logger, processGuid, expectedTag)
 	} else {
 		return fake.removeDesiredLRPReturns.result1
 	}
@@ -1210,10 +1570,10 @@ func (fake *FakeDB) RemoveDesiredLRPCallCount() int {
 	return len(fake.removeDesiredLRPArgsForCall)
 }
 
-func (fake *FakeDB) RemoveDesiredLRPArgsForCall(i int) (lager.Logger, string) {
+func (fake *FakeDB) RemoveDesiredLRPArgsForCall(i int) (lager.Logger, string, *models.ModificationTag) {
 	fake.removeDesiredLRPMutex.RLock()
 	defer fake.removeDesiredLRPMutex.RUnlock()
-	return fake.removeDesiredLRPArgsForCall[i].logger, fake.removeDesiredLRPArgsForCall[i].processGuid
+	return fake.removeDesiredLRPArgsForCall[i].logger, fake.removeDesiredLRPArgsForCall[i].processGuid, fake.removeDesiredLRPArgsForCall[i].expectedTag
 }
 
 func (fake *FakeDB) RemoveDesiredLRPReturns(result1 error) {
@@ -1223,6 +1583,85 @@ func (fake *FakeDB) RemoveDesiredLRPReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeDB) UpdateDesiredLRPRunInfo(logger lager.Logger, processGuid string, runInfo *models.DesiredLRPRunInfo, resource *models.DesiredLRPResource) (beforeDesiredLRP *models.DesiredLRP, err error) {
+	fake.updateDesiredLRPRunInfoMutex.Lock()
+	fake.updateDesiredLRPRunInfoArgsForCall = append(fake.updateDesiredLRPRunInfoArgsForCall, struct {
+		logger      lager.Logger
+		processGuid string
+		runInfo     *models.DesiredLRPRunInfo
+		resource    *models.DesiredLRPResource
+	}{logger, processGuid, runInfo, resource})
+	fake.recordInvocation("UpdateDesiredLRPRunInfo", []interface{}{logger, processGuid, runInfo, resource})
+	fake.updateDesiredLRPRunInfoMutex.Unlock()
+	if fake.UpdateDesiredLRPRunInfoStub != nil {
+		return fake.UpdateDesiredLRPRunInfoStub(logger, processGuid, runInfo, resource)
+	} else {
+		return fake.updateDesiredLRPRunInfoReturns.result1, fake.updateDesiredLRPRunInfoReturns.result2
+	}
+}
+
+func (fake *FakeDB) UpdateDesiredLRPRunInfoCallCount() int {
+	fake.updateDesiredLRPRunInfoMutex.RLock()
+	defer fake.updateDesiredLRPRunInfoMutex.RUnlock()
+	return len(fake.updateDesiredLRPRunInfoArgsForCall)
+}
+
+func (fake *FakeDB) UpdateDesiredLRPRunInfoArgsForCall(i int) (lager.Logger, string, *models.DesiredLRPRunInfo, *models.DesiredLRPResource) {
+	fake.updateDesiredLRPRunInfoMutex.RLock()
+	defer fake.updateDesiredLRPRunInfoMutex.RUnlock()
+	return fake.updateDesiredLRPRunInfoArgsForCall[i].logger, fake.updateDesiredLRPRunInfoArgsForCall[i].processGuid, fake.updateDesiredLRPRunInfoArgsForCall[i].runInfo, fake.updateDesiredLRPRunInfoArgsForCall[i].resource
+}
+
+func (fake *FakeDB) UpdateDesiredLRPRunInfoReturns(result1 *models.DesiredLRP, result2 error) {
+	fake.UpdateDesiredLRPRunInfoStub = nil
+	fake.updateDesiredLRPRunInfoReturns = struct {
+		result1 *models.DesiredLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ApplyDesiredLRPs(logger lager.Logger, domain string, desiredLRPs []*models.DesiredLRP, ttl uint32) (*models.DesiredLRPApplyResult, error) {
+	var desiredLRPsCopy []*models.DesiredLRP
+	if desiredLRPs != nil {
+		desiredLRPsCopy = make([]*models.DesiredLRP, len(desiredLRPs))
+		copy(desiredLRPsCopy, desiredLRPs)
+	}
+	fake.applyDesiredLRPsMutex.Lock()
+	fake.applyDesiredLRPsArgsForCall = append(fake.applyDesiredLRPsArgsForCall, struct {
+		logger      lager.Logger
+		domain      string
+		desiredLRPs []*models.DesiredLRP
+		ttl         uint32
+	}{logger, domain, desiredLRPsCopy, ttl})
+	fake.recordInvocation("ApplyDesiredLRPs", []interface{}{logger, domain, desiredLRPsCopy, ttl})
+	fake.applyDesiredLRPsMutex.Unlock()
+	if fake.ApplyDesiredLRPsStub != nil {
+		return fake.ApplyDesiredLRPsStub(logger, domain, desiredLRPs, ttl)
+	} else {
+		return fake.applyDesiredLRPsReturns.result1, fake.applyDesiredLRPsReturns.result2
+	}
+}
+
+func (fake *FakeDB) ApplyDesiredLRPsCallCount() int {
+	fake.applyDesiredLRPsMutex.RLock()
+	defer fake.applyDesiredLRPsMutex.RUnlock()
+	return len(fake.applyDesiredLRPsArgsForCall)
+}
+
+func (fake *FakeDB) ApplyDesiredLRPsArgsForCall(i int) (lager.Logger, string, []*models.DesiredLRP, uint32) {
+	fake.applyDesiredLRPsMutex.RLock()
+	defer fake.applyDesiredLRPsMutex.RUnlock()
+	return fake.applyDesiredLRPsArgsForCall[i].logger, fake.applyDesiredLRPsArgsForCall[i].domain, fake.applyDesiredLRPsArgsForCall[i].desiredLRPs, fake.applyDesiredLRPsArgsForCall[i].ttl
+}
+
+func (fake *FakeDB) ApplyDesiredLRPsReturns(result1 *models.DesiredLRPApplyResult, result2 error) {
+	fake.ApplyDesiredLRPsStub = nil
+	fake.applyDesiredLRPsReturns = struct {
+		result1 *models.DesiredLRPApplyResult
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) ConvergeLRPs(logger lager.Logger, cellSet models.CellSet) (startRequests []*auctioneer.LRPStartRequest, keysWithMissingCells []*models.ActualLRPKeyWithSchedulingInfo, keysToRetire []*models.ActualLRPKey) {
 	fake.convergeLRPsMutex.Lock()
 	fake.convergeLRPsArgsForCall = append(fake.convergeLRPsArgsForCall, struct {
@@ -1329,6 +1768,42 @@ func (fake *FakeDB) TasksReturns(result1 []*models.Task, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeDB) TasksPage(logger lager.Logger, filter models.TaskFilter) (tasks []*models.Task, nextPageToken string, err error) {
+	fake.tasksPageMutex.Lock()
+	fake.tasksPageArgsForCall = append(fake.tasksPageArgsForCall, struct {
+		logger lager.Logger
+		filter models.TaskFilter
+	}{logger, filter})
+	fake.recordInvocation("TasksPage", []interface{}{logger, filter})
+	fake.tasksPageMutex.Unlock()
+	if fake.TasksPageStub != nil {
+		return fake.TasksPageStub(logger, filter)
+	} else {
+		return fake.tasksPageReturns.result1, fake.tasksPageReturns.result2, fake.tasksPageReturns.result3
+	}
+}
+
+func (fake *FakeDB) TasksPageCallCount() int {
+	fake.tasksPageMutex.RLock()
+	defer fake.tasksPageMutex.RUnlock()
+	return len(fake.tasksPageArgsForCall)
+}
+
+func (fake *FakeDB) TasksPageArgsForCall(i int) (lager.Logger, models.TaskFilter) {
+	fake.tasksPageMutex.RLock()
+	defer fake.tasksPageMutex.RUnlock()
+	return fake.tasksPageArgsForCall[i].logger, fake.tasksPageArgsForCall[i].filter
+}
+
+func (fake *FakeDB) TasksPageReturns(result1 []*models.Task, result2 string, result3 error) {
+	fake.TasksPageStub = nil
+	fake.tasksPageReturns = struct {
+		result1 []*models.Task
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeDB) TaskByGuid(logger lager.Logger, taskGuid string) (*models.Task, error) {
 	fake.taskByGuidMutex.Lock()
 	fake.taskByGuidArgsForCall = append(fake.taskByGuidArgsForCall, struct {
@@ -1364,7 +1839,7 @@ func (fake *FakeDB) TaskByGuidReturns(result1 *models.Task, result2 error) {
 	}{result1, result2}
 }
 
-func (fake *FakeDB) DesireTask(logger lager.Logger, taskDefinition *models.TaskDefinition, taskGuid string, domain string) error {
+func (fake *FakeDB) DesireTask(logger lager.Logger, taskDefinition *models.TaskDefinition, taskGuid string, domain string) (*models.Task, error) {
 	fake.desireTaskMutex.Lock()
 	fake.desireTaskArgsForCall = append(fake.desireTaskArgsForCall, struct {
 		logger         lager.Logger
@@ -1377,7 +1852,7 @@ func (fake *FakeDB) DesireTask(logger lager.Logger, taskDefinition *models.TaskD
 	if fake.DesireTaskStub != nil {
 		return fake.DesireTaskStub(logger, taskDefinition, taskGuid, domain)
 	} else {
-		return fake.desireTaskReturns.result1
+		return fake.desireTaskReturns.result1, fake.desireTaskReturns.result2
 	}
 }
 
@@ -1393,14 +1868,15 @@ func (fake *FakeDB) DesireTaskArgsForCall(i int) (lager.Logger, *models.TaskDefi
 	return fake.desireTaskArgsForCall[i].logger, fake.desireTaskArgsForCall[i].taskDefinition, fake.desireTaskArgsForCall[i].taskGuid, fake.desireTaskArgsForCall[i].domain
 }
 
-func (fake *FakeDB) DesireTaskReturns(result1 error) {
+func (fake *FakeDB) DesireTaskReturns(result1 *models.Task, result2 error) {
 	fake.DesireTaskStub = nil
 	fake.desireTaskReturns = struct {
-		result1 error
-	}{result1}
+		result1 *models.Task
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeDB) StartTask(logger lager.Logger, taskGuid string, cellId string) (bool, error) {
+func (fake *FakeDB) StartTask(logger lager.Logger, taskGuid string, cellId string) (before *models.Task, after *models.Task, shouldStart bool, err error) {
 	fake.startTaskMutex.Lock()
 	fake.startTaskArgsForCall = append(fake.startTaskArgsForCall, struct {
 		logger   lager.Logger
@@ -1412,7 +1888,7 @@ func (fake *FakeDB) StartTask(logger lager.Logger, taskGuid string, cellId strin
 	if fake.StartTaskStub != nil {
 		return fake.StartTaskStub(logger, taskGuid, cellId)
 	} else {
-		return fake.startTaskReturns.result1, fake.startTaskReturns.result2
+		return fake.startTaskReturns.result1, fake.startTaskReturns.result2, fake.startTaskReturns.result3, fake.startTaskReturns.result4
 	}
 }
 
@@ -1428,15 +1904,17 @@ func (fake *FakeDB) StartTaskArgsForCall(i int) (lager.Logger, string, string) {
 	return fake.startTaskArgsForCall[i].logger, fake.startTaskArgsForCall[i].taskGuid, fake.startTaskArgsForCall[i].cellId
 }
 
-func (fake *FakeDB) StartTaskReturns(result1 bool, result2 error) {
+func (fake *FakeDB) StartTaskReturns(result1 *models.Task, result2 *models.Task, result3 bool, result4 error) {
 	fake.StartTaskStub = nil
 	fake.startTaskReturns = struct {
-		result1 bool
-		result2 error
-	}{result1, result2}
+		result1 *models.Task
+		result2 *models.Task
+		result3 bool
+		result4 error
+	}{result1, result2, result3, result4}
 }
 
-func (fake *FakeDB) CancelTask(logger lager.Logger, taskGuid string) (task *models.Task, cellID string, err error) {
+func (fake *FakeDB) CancelTask(logger lager.Logger, taskGuid string) (before *models.Task, after *models.Task, cellID string, err error) {
 	fake.cancelTaskMutex.Lock()
 	fake.cancelTaskArgsForCall = append(fake.cancelTaskArgsForCall, struct {
 		logger   lager.Logger
@@ -1447,7 +1925,7 @@ func (fake *FakeDB) CancelTask(logger lager.Logger, taskGuid string) (task *mode
 	if fake.CancelTaskStub != nil {
 		return fake.CancelTaskStub(logger, taskGuid)
 	} else {
-		return fake.cancelTaskReturns.result1, fake.cancelTaskReturns.result2, fake.cancelTaskReturns.result3
+		return fake.cancelTaskReturns.result1, fake.cancelTaskReturns.result2, fake.cancelTaskReturns.result3, fake.cancelTaskReturns.result4
 	}
 }
 
@@ -1463,16 +1941,17 @@ func (fake *FakeDB) CancelTaskArgsForCall(i int) (lager.Logger, string) {
 	return fake.cancelTaskArgsForCall[i].logger, fake.cancelTaskArgsForCall[i].taskGuid
 }
 
-func (fake *FakeDB) CancelTaskReturns(result1 *models.Task, result2 string, result3 error) {
+func (fake *FakeDB) CancelTaskReturns(result1 *models.Task, result2 *models.Task, result3 string, result4 error) {
 	fake.CancelTaskStub = nil
 	fake.cancelTaskReturns = struct {
 		result1 *models.Task
-		result2 string
-		result3 error
-	}{result1, result2, result3}
+		result2 *models.Task
+		result3 string
+		result4 error
+	}{result1, result2, result3, result4}
 }
 
-func (fake *FakeDB) FailTask(logger lager.Logger, taskGuid string, failureReason string) (task *models.Task, err error) {
+func (fake *FakeDB) FailTask(logger lager.Logger, taskGuid string, failureReason string) (before *models.Task, after *models.Task, err error) {
 	fake.failTaskMutex.Lock()
 	fake.failTaskArgsForCall = append(fake.failTaskArgsForCall, struct {
 		logger        lager.Logger
@@ -1484,7 +1963,7 @@ func (fake *FakeDB) FailTask(logger lager.Logger, taskGuid string, failureReason
 	if fake.FailTaskStub != nil {
 		return fake.FailTaskStub(logger, taskGuid, failureReason)
 	} else {
-		return fake.failTaskReturns.result1, fake.failTaskReturns.result2
+		return fake.failTaskReturns.result1, fake.failTaskReturns.result2, fake.failTaskReturns.result3
 	}
 }
 
@@ -1500,15 +1979,16 @@ func (fake *FakeDB) FailTaskArgsForCall(i int) (lager.Logger, string, string) {
 	return fake.failTaskArgsForCall[i].logger, fake.failTaskArgsForCall[i].taskGuid, fake.failTaskArgsForCall[i].failureReason
 }
 
-func (fake *FakeDB) FailTaskReturns(result1 *models.Task, result2 error) {
+func (fake *FakeDB) FailTaskReturns(result1 *models.Task, result2 *models.Task, result3 error) {
 	fake.FailTaskStub = nil
 	fake.failTaskReturns = struct {
 		result1 *models.Task
-		result2 error
-	}{result1, result2}
+		result2 *models.Task
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeDB) CompleteTask(logger lager.Logger, taskGuid string, cellId string, failed bool, failureReason string, result string) (task *models.Task, err error) {
+func (fake *FakeDB) CompleteTask(logger lager.Logger, taskGuid string, cellId string, failed bool, failureReason string, result string) (before *models.Task, after *models.Task, err error) {
 	fake.completeTaskMutex.Lock()
 	fake.completeTaskArgsForCall = append(fake.completeTaskArgsForCall, struct {
 		logger        lager.Logger
@@ -1523,7 +2003,7 @@ func (fake *FakeDB) CompleteTask(logger lager.Logger, taskGuid string, cellId st
 	if fake.CompleteTaskStub != nil {
 		return fake.CompleteTaskStub(logger, taskGuid, cellId, failed, failureReason, result)
 	} else {
-		return fake.completeTaskReturns.result1, fake.completeTaskReturns.result2
+		return fake.completeTaskReturns.result1, fake.completeTaskReturns.result2, fake.completeTaskReturns.result3
 	}
 }
 
@@ -1539,15 +2019,16 @@ func (fake *FakeDB) CompleteTaskArgsForCall(i int) (lager.Logger, string, string
 	return fake.completeTaskArgsForCall[i].logger, fake.completeTaskArgsForCall[i].taskGuid, fake.completeTaskArgsForCall[i].cellId, fake.completeTaskArgsForCall[i].failed, fake.completeTaskArgsForCall[i].failureReason, fake.completeTaskArgsForCall[i].result
 }
 
-func (fake *FakeDB) CompleteTaskReturns(result1 *models.Task, result2 error) {
+func (fake *FakeDB) CompleteTaskReturns(result1 *models.Task, result2 *models.Task, result3 error) {
 	fake.CompleteTaskStub = nil
 	fake.completeTaskReturns = struct {
 		result1 *models.Task
-		result2 error
-	}{result1, result2}
+		result2 *models.Task
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeDB) ResolvingTask(logger lager.Logger, taskGuid string) error {
+func (fake *FakeDB) ResolvingTask(logger lager.Logger, taskGuid string) (before *models.Task, after *models.Task, err error) {
 	fake.resolvingTaskMutex.Lock()
 	fake.resolvingTaskArgsForCall = append(fake.resolvingTaskArgsForCall, struct {
 		logger   lager.Logger
@@ -1558,7 +2039,7 @@ func (fake *FakeDB) ResolvingTask(logger lager.Logger, taskGuid string) error {
 	if fake.ResolvingTaskStub != nil {
 		return fake.ResolvingTaskStub(logger, taskGuid)
 	} else {
-		return fake.resolvingTaskReturns.result1
+		return fake.resolvingTaskReturns.result1, fake.resolvingTaskReturns.result2, fake.resolvingTaskReturns.result3
 	}
 }
 
@@ -1574,14 +2055,16 @@ func (fake *FakeDB) ResolvingTaskArgsForCall(i int) (lager.Logger, string) {
 	return fake.resolvingTaskArgsForCall[i].logger, fake.resolvingTaskArgsForCall[i].taskGuid
 }
 
-func (fake *FakeDB) ResolvingTaskReturns(result1 error) {
+func (fake *FakeDB) ResolvingTaskReturns(result1 *models.Task, result2 *models.Task, result3 error) {
 	fake.ResolvingTaskStub = nil
 	fake.resolvingTaskReturns = struct {
-		result1 error
-	}{result1}
+		result1 *models.Task
+		result2 *models.Task
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeDB) DeleteTask(logger lager.Logger, taskGuid string) error {
+func (fake *FakeDB) DeleteTask(logger lager.Logger, taskGuid string) (task *models.Task, err error) {
 	fake.deleteTaskMutex.Lock()
 	fake.deleteTaskArgsForCall = append(fake.deleteTaskArgsForCall, struct {
 		logger   lager.Logger
@@ -1592,7 +2075,7 @@ func (fake *FakeDB) DeleteTask(logger lager.Logger, taskGuid string) error {
 	if fake.DeleteTaskStub != nil {
 		return fake.DeleteTaskStub(logger, taskGuid)
 	} else {
-		return fake.deleteTaskReturns.result1
+		return fake.deleteTaskReturns.result1, fake.deleteTaskReturns.result2
 	}
 }
 
@@ -1608,14 +2091,15 @@ func (fake *FakeDB) DeleteTaskArgsForCall(i int) (lager.Logger, string) {
 	return fake.deleteTaskArgsForCall[i].logger, fake.deleteTaskArgsForCall[i].taskGuid
 }
 
-func (fake *FakeDB) DeleteTaskReturns(result1 error) {
+func (fake *FakeDB) DeleteTaskReturns(result1 *models.Task, result2 error) {
 	fake.DeleteTaskStub = nil
 	fake.deleteTaskReturns = struct {
-		result1 error
-	}{result1}
+		result1 *models.Task
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeDB) ConvergeTasks(logger lager.Logger, cellSet models.CellSet, kickTaskDuration time.Duration, expirePendingTaskDuration time.Duration, expireCompletedTaskDuration time.Duration) (tasksToAuction []*auctioneer.TaskStartRequest, tasksToComplete []*models.Task) {
+func (fake *FakeDB) ConvergeTasks(logger lager.Logger, cellSet models.CellSet, kickTaskDuration time.Duration, expirePendingTaskDuration time.Duration, expireCompletedTaskDuration time.Duration) (tasksToAuction []*auctioneer.TaskStartRequest, tasksToComplete []*models.Task, events []models.Event) {
 	fake.convergeTasksMutex.Lock()
 	fake.convergeTasksArgsForCall = append(fake.convergeTasksArgsForCall, struct {
 		logger                      lager.Logger
@@ -1629,7 +2113,7 @@ func (fake *FakeDB) ConvergeTasks(logger lager.Logger, cellSet models.CellSet, k
 	if fake.ConvergeTasksStub != nil {
 		return fake.ConvergeTasksStub(logger, cellSet, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration)
 	} else {
-		return fake.convergeTasksReturns.result1, fake.convergeTasksReturns.result2
+		return fake.convergeTasksReturns.result1, fake.convergeTasksReturns.result2, fake.convergeTasksReturns.result3
 	}
 }
 
@@ -1645,12 +2129,13 @@ func (fake *FakeDB) ConvergeTasksArgsForCall(i int) (lager.Logger, models.CellSe
 	return fake.convergeTasksArgsForCall[i].logger, fake.convergeTasksArgsForCall[i].cellSet, fake.convergeTasksArgsForCall[i].kickTaskDuration, fake.convergeTasksArgsForCall[i].expirePendingTaskDuration, fake.convergeTasksArgsForCall[i].expireCompletedTaskDuration
 }
 
-func (fake *FakeDB) ConvergeTasksReturns(result1 []*auctioneer.TaskStartRequest, result2 []*models.Task) {
+func (fake *FakeDB) ConvergeTasksReturns(result1 []*auctioneer.TaskStartRequest, result2 []*models.Task, result3 []models.Event) {
 	fake.ConvergeTasksStub = nil
 	fake.convergeTasksReturns = struct {
 		result1 []*auctioneer.TaskStartRequest
 		result2 []*models.Task
-	}{result1, result2}
+		result3 []models.Event
+	}{result1, result2, result3}
 }
 
 func (fake *FakeDB) Version(logger lager.Logger) (*models.Version, error) {
@@ -1724,6 +2209,16 @@ func (fake *FakeDB) SetVersionReturns(result1 error) {
 func (fake *FakeDB) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.desiredLRPRolloutsMutex.RLock()
+	defer fake.desiredLRPRolloutsMutex.RUnlock()
+	fake.desiredLRPRolloutMutex.RLock()
+	defer fake.desiredLRPRolloutMutex.RUnlock()
+	fake.upsertDesiredLRPRolloutMutex.RLock()
+	defer fake.upsertDesiredLRPRolloutMutex.RUnlock()
+	fake.updateDesiredLRPRolloutMutex.RLock()
+	defer fake.updateDesiredLRPRolloutMutex.RUnlock()
+	fake.removeDesiredLRPRolloutMutex.RLock()
+	defer fake.removeDesiredLRPRolloutMutex.RUnlock()
 	fake.domainsMutex.RLock()
 	defer fake.domainsMutex.RUnlock()
 	fake.upsertDomainMutex.RLock()
@@ -1740,6 +2235,8 @@ func (fake *FakeDB) Invocations() map[string][][]interface{} {
 	defer fake.evacuateActualLRPMutex.RUnlock()
 	fake.actualLRPGroupsMutex.RLock()
 	defer fake.actualLRPGroupsMutex.RUnlock()
+	fake.actualLRPGroupsPageMutex.RLock()
+	defer fake.actualLRPGroupsPageMutex.RUnlock()
 	fake.actualLRPGroupsByProcessGuidMutex.RLock()
 	defer fake.actualLRPGroupsByProcessGuidMutex.RUnlock()
 	fake.actualLRPGroupByProcessGuidAndIndexMutex.RLock()
@@ -1760,6 +2257,8 @@ func (fake *FakeDB) Invocations() map[string][][]interface{} {
 	defer fake.removeActualLRPMutex.RUnlock()
 	fake.desiredLRPsMutex.RLock()
 	defer fake.desiredLRPsMutex.RUnlock()
+	fake.desiredLRPsPageMutex.RLock()
+	defer fake.desiredLRPsPageMutex.RUnlock()
 	fake.desiredLRPByProcessGuidMutex.RLock()
 	defer fake.desiredLRPByProcessGuidMutex.RUnlock()
 	fake.desiredLRPSchedulingInfosMutex.RLock()
@@ -1770,12 +2269,18 @@ func (fake *FakeDB) Invocations() map[string][][]interface{} {
 	defer fake.updateDesiredLRPMutex.RUnlock()
 	fake.removeDesiredLRPMutex.RLock()
 	defer fake.removeDesiredLRPMutex.RUnlock()
+	fake.updateDesiredLRPRunInfoMutex.RLock()
+	defer fake.updateDesiredLRPRunInfoMutex.RUnlock()
+	fake.applyDesiredLRPsMutex.RLock()
+	defer fake.applyDesiredLRPsMutex.RUnlock()
 	fake.convergeLRPsMutex.RLock()
 	defer fake.convergeLRPsMutex.RUnlock()
 	fake.gatherAndPruneLRPsMutex.RLock()
 	defer fake.gatherAndPruneLRPsMutex.RUnlock()
 	fake.tasksMutex.RLock()
 	defer fake.tasksMutex.RUnlock()
+	fake.tasksPageMutex.RLock()
+	defer fake.tasksPageMutex.RUnlock()
 	fake.taskByGuidMutex.RLock()
 	defer fake.taskByGuidMutex.RUnlock()
 	fake.desireTaskMutex.RLock()
@@ -0,0 +1,184 @@
+// This file was generated by counterfeiter
+package dbfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakeDesiredLRPRevisionDB struct {
+	DesiredLRPRevisionsStub        func(logger lager.Logger, processGuid string) ([]*models.DesiredLRPRevision, error)
+	desiredLRPRevisionsMutex       sync.RWMutex
+	desiredLRPRevisionsArgsForCall []struct {
+		logger      lager.Logger
+		processGuid string
+	}
+	desiredLRPRevisionsReturns struct {
+		result1 []*models.DesiredLRPRevision
+		result2 error
+	}
+	DesiredLRPRevisionStub        func(logger lager.Logger, processGuid string, revision int64) (*models.DesiredLRPRevision, error)
+	desiredLRPRevisionMutex       sync.RWMutex
+	desiredLRPRevisionArgsForCall []struct {
+		logger      lager.Logger
+		processGuid string
+		revision    int64
+	}
+	desiredLRPRevisionReturns struct {
+		result1 *models.DesiredLRPRevision
+		result2 error
+	}
+	RollbackDesiredLRPStub        func(logger lager.Logger, processGuid string, revision int64, expectedTag *models.ModificationTag) (before *models.DesiredLRP, after *models.DesiredLRP, err error)
+	rollbackDesiredLRPMutex       sync.RWMutex
+	rollbackDesiredLRPArgsForCall []struct {
+		logger      lager.Logger
+		processGuid string
+		revision    int64
+		expectedTag *models.ModificationTag
+	}
+	rollbackDesiredLRPReturns struct {
+		result1 *models.DesiredLRP
+		result2 *models.DesiredLRP
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDesiredLRPRevisionDB) DesiredLRPRevisions(logger lager.Logger, processGuid string) ([]*models.DesiredLRPRevision, error) {
+	fake.desiredLRPRevisionsMutex.Lock()
+	fake.desiredLRPRevisionsArgsForCall = append(fake.desiredLRPRevisionsArgsForCall, struct {
+		logger      lager.Logger
+		processGuid string
+	}{logger, processGuid})
+	fake.recordInvocation("DesiredLRPRevisions", []interface{}{logger, processGuid})
+	fake.desiredLRPRevisionsMutex.Unlock()
+	if fake.DesiredLRPRevisionsStub != nil {
+		return fake.DesiredLRPRevisionsStub(logger, processGuid)
+	} else {
+		return fake.desiredLRPRevisionsReturns.result1, fake.desiredLRPRevisionsReturns.result2
+	}
+}
+
+func (fake *FakeDesiredLRPRevisionDB) DesiredLRPRevisionsCallCount() int {
+	fake.desiredLRPRevisionsMutex.RLock()
+	defer fake.desiredLRPRevisionsMutex.RUnlock()
+	return len(fake.desiredLRPRevisionsArgsForCall)
+}
+
+func (fake *FakeDesiredLRPRevisionDB) DesiredLRPRevisionsArgsForCall(i int) (lager.Logger, string) {
+	fake.desiredLRPRevisionsMutex.RLock()
+	defer fake.desiredLRPRevisionsMutex.RUnlock()
+	return fake.desiredLRPRevisionsArgsForCall[i].logger, fake.desiredLRPRevisionsArgsForCall[i].processGuid
+}
+
+func (fake *FakeDesiredLRPRevisionDB) DesiredLRPRevisionsReturns(result1 []*models.DesiredLRPRevision, result2 error) {
+	fake.DesiredLRPRevisionsStub = nil
+	fake.desiredLRPRevisionsReturns = struct {
+		result1 []*models.DesiredLRPRevision
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDesiredLRPRevisionDB) DesiredLRPRevision(logger lager.Logger, processGuid string, revision int64) (*models.DesiredLRPRevision, error) {
+	fake.desiredLRPRevisionMutex.Lock()
+	fake.desiredLRPRevisionArgsForCall = append(fake.desiredLRPRevisionArgsForCall, struct {
+		logger      lager.Logger
+		processGuid string
+		revision    int64
+	}{logger, processGuid, revision})
+	fake.recordInvocation("DesiredLRPRevision", []interface{}{logger, processGuid, revision})
+	fake.desiredLRPRevisionMutex.Unlock()
+	if fake.DesiredLRPRevisionStub != nil {
+		return fake.DesiredLRPRevisionStub(logger, processGuid, revision)
+	} else {
+		return fake.desiredLRPRevisionReturns.result1, fake.desiredLRPRevisionReturns.result2
+	}
+}
+
+func (fake *FakeDesiredLRPRevisionDB) DesiredLRPRevisionCallCount() int {
+	fake.desiredLRPRevisionMutex.RLock()
+	defer fake.desiredLRPRevisionMutex.RUnlock()
+	return len(fake.desiredLRPRevisionArgsForCall)
+}
+
+func (fake *FakeDesiredLRPRevisionDB) DesiredLRPRevisionArgsForCall(i int) (lager.Logger, string, int64) {
+	fake.desiredLRPRevisionMutex.RLock()
+	defer fake.desiredLRPRevisionMutex.RUnlock()
+	return fake.desiredLRPRevisionArgsForCall[i].logger, fake.desiredLRPRevisionArgsForCall[i].processGuid, fake.desiredLRPRevisionArgsForCall[i].revision
+}
+
+func (fake *FakeDesiredLRPRevisionDB) DesiredLRPRevisionReturns(result1 *models.DesiredLRPRevision, result2 error) {
+	fake.DesiredLRPRevisionStub = nil
+	fake.desiredLRPRevisionReturns = struct {
+		result1 *models.DesiredLRPRevision
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDesiredLRPRevisionDB) RollbackDesiredLRP(logger lager.Logger, processGuid string, revision int64, expectedTag *models.ModificationTag) (before *models.DesiredLRP, after *models.DesiredLRP, err error) {
+	fake.rollbackDesiredLRPMutex.Lock()
+	fake.rollbackDesiredLRPArgsForCall = append(fake.rollbackDesiredLRPArgsForCall, struct {
+		logger      lager.Logger
+		processGuid string
+		revision    int64
+		expectedTag *models.ModificationTag
+	}{logger, processGuid, revision, expectedTag})
+	fake.recordInvocation("RollbackDesiredLRP", []interface{}{logger, processGuid, revision, expectedTag})
+	fake.rollbackDesiredLRPMutex.Unlock()
+	if fake.RollbackDesiredLRPStub != nil {
+		return fake.RollbackDesiredLRPStub(logger, processGuid, revision, expectedTag)
+	} else {
+		return fake.rollbackDesiredLRPReturns.result1, fake.rollbackDesiredLRPReturns.result2, fake.rollbackDesiredLRPReturns.result3
+	}
+}
+
+func (fake *FakeDesiredLRPRevisionDB) RollbackDesiredLRPCallCount() int {
+	fake.rollbackDesiredLRPMutex.RLock()
+	defer fake.rollbackDesiredLRPMutex.RUnlock()
+	return len(fake.rollbackDesiredLRPArgsForCall)
+}
+
+func (fake *FakeDesiredLRPRevisionDB) RollbackDesiredLRPArgsForCall(i int) (lager.Logger, string, int64, *models.ModificationTag) {
+	fake.rollbackDesiredLRPMutex.RLock()
+	defer fake.rollbackDesiredLRPMutex.RUnlock()
+	return fake.rollbackDesiredLRPArgsForCall[i].logger, fake.rollbackDesiredLRPArgsForCall[i].processGuid, fake.rollbackDesiredLRPArgsForCall[i].revision, fake.rollbackDesiredLRPArgsForCall[i].expectedTag
+}
+
+func (fake *FakeDesiredLRPRevisionDB) RollbackDesiredLRPReturns(result1 *models.DesiredLRP, result2 *models.DesiredLRP, result3 error) {
+	fake.RollbackDesiredLRPStub = nil
+	fake.rollbackDesiredLRPReturns = struct {
+		result1 *models.DesiredLRP
+		result2 *models.DesiredLRP
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDesiredLRPRevisionDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.desiredLRPRevisionsMutex.RLock()
+	defer fake.desiredLRPRevisionsMutex.RUnlock()
+	fake.desiredLRPRevisionMutex.RLock()
+	defer fake.desiredLRPRevisionMutex.RUnlock()
+	fake.rollbackDesiredLRPMutex.RLock()
+	defer fake.rollbackDesiredLRPMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeDesiredLRPRevisionDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.DesiredLRPRevisionDB = new(FakeDesiredLRPRevisionDB)
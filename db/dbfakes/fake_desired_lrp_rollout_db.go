@@ -0,0 +1,262 @@
+// This file was generated by counterfeiter
+package dbfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakeDesiredLRPRolloutDB struct {
+	DesiredLRPRolloutsStub        func(logger lager.Logger) ([]*models.DesiredLRPRollout, error)
+	desiredLRPRolloutsMutex       sync.RWMutex
+	desiredLRPRolloutsArgsForCall []struct {
+		logger lager.Logger
+	}
+	desiredLRPRolloutsReturns struct {
+		result1 []*models.DesiredLRPRollout
+		result2 error
+	}
+	DesiredLRPRolloutStub        func(logger lager.Logger, processGuid string) (*models.DesiredLRPRollout, error)
+	desiredLRPRolloutMutex       sync.RWMutex
+	desiredLRPRolloutArgsForCall []struct {
+		logger      lager.Logger
+		processGuid string
+	}
+	desiredLRPRolloutReturns struct {
+		result1 *models.DesiredLRPRollout
+		result2 error
+	}
+	UpsertDesiredLRPRolloutStub        func(logger lager.Logger, rollout *models.DesiredLRPRollout) error
+	upsertDesiredLRPRolloutMutex       sync.RWMutex
+	upsertDesiredLRPRolloutArgsForCall []struct {
+		logger  lager.Logger
+		rollout *models.DesiredLRPRollout
+	}
+	upsertDesiredLRPRolloutReturns struct {
+		result1 error
+	}
+	UpdateDesiredLRPRolloutStub        func(logger lager.Logger, rollout *models.DesiredLRPRollout) error
+	updateDesiredLRPRolloutMutex       sync.RWMutex
+	updateDesiredLRPRolloutArgsForCall []struct {
+		logger  lager.Logger
+		rollout *models.DesiredLRPRollout
+	}
+	updateDesiredLRPRolloutReturns struct {
+		result1 error
+	}
+	RemoveDesiredLRPRolloutStub        func(logger lager.Logger, processGuid string) error
+	removeDesiredLRPRolloutMutex       sync.RWMutex
+	removeDesiredLRPRolloutArgsForCall []struct {
+		logger      lager.Logger
+		processGuid string
+	}
+	removeDesiredLRPRolloutReturns struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDesiredLRPRolloutDB) DesiredLRPRollouts(logger lager.Logger) ([]*models.DesiredLRPRollout, error) {
+	fake.desiredLRPRolloutsMutex.Lock()
+	fake.desiredLRPRolloutsArgsForCall = append(fake.desiredLRPRolloutsArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("DesiredLRPRollouts", []interface{}{logger})
+	fake.desiredLRPRolloutsMutex.Unlock()
+	if fake.DesiredLRPRolloutsStub != nil {
+		return fake.DesiredLRPRolloutsStub(logger)
+	} else {
+		return fake.desiredLRPRolloutsReturns.result1, fake.desiredLRPRolloutsReturns.result2
+	}
+}
+
+func (fake *FakeDesiredLRPRolloutDB) DesiredLRPRolloutsCallCount() int {
+	fake.desiredLRPRolloutsMutex.RLock()
+	defer fake.desiredLRPRolloutsMutex.RUnlock()
+	return len(fake.desiredLRPRolloutsArgsForCall)
+}
+
+func (fake *FakeDesiredLRPRolloutDB) DesiredLRPRolloutsArgsForCall(i int) lager.Logger {
+	fake.desiredLRPRolloutsMutex.RLock()
+	defer fake.desiredLRPRolloutsMutex.RUnlock()
+	return fake.desiredLRPRolloutsArgsForCall[i].logger
+}
+
+func (fake *FakeDesiredLRPRolloutDB) DesiredLRPRolloutsReturns(result1 []*models.DesiredLRPRollout, result2 error) {
+	fake.DesiredLRPRolloutsStub = nil
+	fake.desiredLRPRolloutsReturns = struct {
+		result1 []*models.DesiredLRPRollout
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDesiredLRPRolloutDB) DesiredLRPRollout(logger lager.Logger, processGuid string) (*models.DesiredLRPRollout, error) {
+	fake.desiredLRPRolloutMutex.Lock()
+	fake.desiredLRPRolloutArgsForCall = append(fake.desiredLRPRolloutArgsForCall, struct {
+		logger      lager.Logger
+		processGuid string
+	}{logger, processGuid})
+	fake.recordInvocation("DesiredLRPRollout", []interface{}{logger, processGuid})
+	fake.desiredLRPRolloutMutex.Unlock()
+	if fake.DesiredLRPRolloutStub != nil {
+		return fake.DesiredLRPRolloutStub(logger, processGuid)
+	} else {
+		return fake.desiredLRPRolloutReturns.result1, fake.desiredLRPRolloutReturns.result2
+	}
+}
+
+func (fake *FakeDesiredLRPRolloutDB) DesiredLRPRolloutCallCount() int {
+	fake.desiredLRPRolloutMutex.RLock()
+	defer fake.desiredLRPRolloutMutex.RUnlock()
+	return len(fake.desiredLRPRolloutArgsForCall)
+}
+
+func (fake *FakeDesiredLRPRolloutDB) DesiredLRPRolloutArgsForCall(i int) (lager.Logger, string) {
+	fake.desiredLRPRolloutMutex.RLock()
+	defer fake.desiredLRPRolloutMutex.RUnlock()
+	return fake.desiredLRPRolloutArgsForCall[i].logger, fake.desiredLRPRolloutArgsForCall[i].processGuid
+}
+
+func (fake *FakeDesiredLRPRolloutDB) DesiredLRPRolloutReturns(result1 *models.DesiredLRPRollout, result2 error) {
+	fake.DesiredLRPRolloutStub = nil
+	fake.desiredLRPRolloutReturns = struct {
+		result1 *models.DesiredLRPRollout
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDesiredLRPRolloutDB) UpsertDesiredLRPRollout(logger lager.Logger, rollout *models.DesiredLRPRollout) error {
+	fake.upsertDesiredLRPRolloutMutex.Lock()
+	fake.upsertDesiredLRPRolloutArgsForCall = append(fake.upsertDesiredLRPRolloutArgsForCall, struct {
+		logger  lager.Logger
+		rollout *models.DesiredLRPRollout
+	}{logger, rollout})
+	fake.recordInvocation("UpsertDesiredLRPRollout", []interface{}{logger, rollout})
+	fake.upsertDesiredLRPRolloutMutex.Unlock()
+	if fake.UpsertDesiredLRPRolloutStub != nil {
+		return fake.UpsertDesiredLRPRolloutStub(logger, rollout)
+	} else {
+		return fake.upsertDesiredLRPRolloutReturns.result1
+	}
+}
+
+func (fake *FakeDesiredLRPRolloutDB) UpsertDesiredLRPRolloutCallCount() int {
+	fake.upsertDesiredLRPRolloutMutex.RLock()
+	defer fake.upsertDesiredLRPRolloutMutex.RUnlock()
+	return len(fake.upsertDesiredLRPRolloutArgsForCall)
+}
+
+func (fake *FakeDesiredLRPRolloutDB) UpsertDesiredLRPRolloutArgsForCall(i int) (lager.Logger, *models.DesiredLRPRollout) {
+	fake.upsertDesiredLRPRolloutMutex.RLock()
+	defer fake.upsertDesiredLRPRolloutMutex.RUnlock()
+	return fake.upsertDesiredLRPRolloutArgsForCall[i].logger, fake.upsertDesiredLRPRolloutArgsForCall[i].rollout
+}
+
+func (fake *FakeDesiredLRPRolloutDB) UpsertDesiredLRPRolloutReturns(result1 error) {
+	fake.UpsertDesiredLRPRolloutStub = nil
+	fake.upsertDesiredLRPRolloutReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDesiredLRPRolloutDB) UpdateDesiredLRPRollout(logger lager.Logger, rollout *models.DesiredLRPRollout) error {
+	fake.updateDesiredLRPRolloutMutex.Lock()
+	fake.updateDesiredLRPRolloutArgsForCall = append(fake.updateDesiredLRPRolloutArgsForCall, struct {
+		logger  lager.Logger
+		rollout *models.DesiredLRPRollout
+	}{logger, rollout})
+	fake.recordInvocation("UpdateDesiredLRPRollout", []interface{}{logger, rollout})
+	fake.updateDesiredLRPRolloutMutex.Unlock()
+	if fake.UpdateDesiredLRPRolloutStub != nil {
+		return fake.UpdateDesiredLRPRolloutStub(logger, rollout)
+	} else {
+		return fake.updateDesiredLRPRolloutReturns.result1
+	}
+}
+
+func (fake *FakeDesiredLRPRolloutDB) UpdateDesiredLRPRolloutCallCount() int {
+	fake.updateDesiredLRPRolloutMutex.RLock()
+	defer fake.updateDesiredLRPRolloutMutex.RUnlock()
+	return len(fake.updateDesiredLRPRolloutArgsForCall)
+}
+
+func (fake *FakeDesiredLRPRolloutDB) UpdateDesiredLRPRolloutArgsForCall(i int) (lager.Logger, *models.DesiredLRPRollout) {
+	fake.updateDesiredLRPRolloutMutex.RLock()
+	defer fake.updateDesiredLRPRolloutMutex.RUnlock()
+	return fake.updateDesiredLRPRolloutArgsForCall[i].logger, fake.updateDesiredLRPRolloutArgsForCall[i].rollout
+}
+
+func (fake *FakeDesiredLRPRolloutDB) UpdateDesiredLRPRolloutReturns(result1 error) {
+	fake.UpdateDesiredLRPRolloutStub = nil
+	fake.updateDesiredLRPRolloutReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDesiredLRPRolloutDB) RemoveDesiredLRPRollout(logger lager.Logger, processGuid string) error {
+	fake.removeDesiredLRPRolloutMutex.Lock()
+	fake.removeDesiredLRPRolloutArgsForCall = append(fake.removeDesiredLRPRolloutArgsForCall, struct {
+		logger      lager.Logger
+		processGuid string
+	}{logger, processGuid})
+	fake.recordInvocation("RemoveDesiredLRPRollout", []interface{}{logger, processGuid})
+	fake.removeDesiredLRPRolloutMutex.Unlock()
+	if fake.RemoveDesiredLRPRolloutStub != nil {
+		return fake.RemoveDesiredLRPRolloutStub(logger, processGuid)
+	} else {
+		return fake.removeDesiredLRPRolloutReturns.result1
+	}
+}
+
+func (fake *FakeDesiredLRPRolloutDB) RemoveDesiredLRPRolloutCallCount() int {
+	fake.removeDesiredLRPRolloutMutex.RLock()
+	defer fake.removeDesiredLRPRolloutMutex.RUnlock()
+	return len(fake.removeDesiredLRPRolloutArgsForCall)
+}
+
+func (fake *FakeDesiredLRPRolloutDB) RemoveDesiredLRPRolloutArgsForCall(i int) (lager.Logger, string) {
+	fake.removeDesiredLRPRolloutMutex.RLock()
+	defer fake.removeDesiredLRPRolloutMutex.RUnlock()
+	return fake.removeDesiredLRPRolloutArgsForCall[i].logger, fake.removeDesiredLRPRolloutArgsForCall[i].processGuid
+}
+
+func (fake *FakeDesiredLRPRolloutDB) RemoveDesiredLRPRolloutReturns(result1 error) {
+	fake.RemoveDesiredLRPRolloutStub = nil
+	fake.removeDesiredLRPRolloutReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDesiredLRPRolloutDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.desiredLRPRolloutsMutex.RLock()
+	defer fake.desiredLRPRolloutsMutex.RUnlock()
+	fake.desiredLRPRolloutMutex.RLock()
+	defer fake.desiredLRPRolloutMutex.RUnlock()
+	fake.upsertDesiredLRPRolloutMutex.RLock()
+	defer fake.upsertDesiredLRPRolloutMutex.RUnlock()
+	fake.updateDesiredLRPRolloutMutex.RLock()
+	defer fake.updateDesiredLRPRolloutMutex.RUnlock()
+	fake.removeDesiredLRPRolloutMutex.RLock()
+	defer fake.removeDesiredLRPRolloutMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeDesiredLRPRolloutDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.DesiredLRPRolloutDB = new(FakeDesiredLRPRolloutDB)
@@ -20,6 +20,17 @@ type FakeDesiredLRPDB struct {
 		result1 []*models.DesiredLRP
 		result2 error
 	}
+	DesiredLRPsPageStub        func(logger lager.Logger, filter models.DesiredLRPFilter) (desiredLRPs []*models.DesiredLRP, nextPageToken string, err error)
+	desiredLRPsPageMutex       sync.RWMutex
+	desiredLRPsPageArgsForCall []struct {
+		logger lager.Logger
+		filter models.DesiredLRPFilter
+	}
+	desiredLRPsPageReturns struct {
+		result1 []*models.DesiredLRP
+		result2 string
+		result3 error
+	}
 	DesiredLRPByProcessGuidStub        func(logger lager.Logger, processGuid string) (*models.DesiredLRP, error)
 	desiredLRPByProcessGuidMutex       sync.RWMutex
 	desiredLRPByProcessGuidArgsForCall []struct {
@@ -49,26 +60,52 @@ type FakeDesiredLRPDB struct {
 	desireLRPReturns struct {
 		result1 error
 	}
-	UpdateDesiredLRPStub        func(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) (beforeDesiredLRP *models.DesiredLRP, err error)
+	UpdateDesiredLRPStub        func(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate, expectedTag *models.ModificationTag) (beforeDesiredLRP *models.DesiredLRP, err error)
 	updateDesiredLRPMutex       sync.RWMutex
 	updateDesiredLRPArgsForCall []struct {
 		logger      lager.Logger
 		processGuid string
 		update      *models.DesiredLRPUpdate
+		expectedTag *models.ModificationTag
 	}
 	updateDesiredLRPReturns struct {
 		result1 *models.DesiredLRP
 		result2 error
 	}
-	RemoveDesiredLRPStub        func(logger lager.Logger, processGuid string) error
+	RemoveDesiredLRPStub        func(logger lager.Logger, processGuid string, expectedTag *models.ModificationTag) error
 	removeDesiredLRPMutex       sync.RWMutex
 	removeDesiredLRPArgsForCall []struct {
 		logger      lager.Logger
 		processGuid string
+		expectedTag *models.ModificationTag
 	}
 	removeDesiredLRPReturns struct {
 		result1 error
 	}
+	UpdateDesiredLRPRunInfoStub        func(logger lager.Logger, processGuid string, runInfo *models.DesiredLRPRunInfo, resource *models.DesiredLRPResource) (beforeDesiredLRP *models.DesiredLRP, err error)
+	updateDesiredLRPRunInfoMutex       sync.RWMutex
+	updateDesiredLRPRunInfoArgsForCall []struct {
+		logger      lager.Logger
+		processGuid string
+		runInfo     *models.DesiredLRPRunInfo
+		resource    *models.DesiredLRPResource
+	}
+	updateDesiredLRPRunInfoReturns struct {
+		result1 *models.DesiredLRP
+		result2 error
+	}
+	ApplyDesiredLRPsStub        func(logger lager.Logger, domain string, desiredLRPs []*models.DesiredLRP, ttl uint32) (*models.DesiredLRPApplyResult, error)
+	applyDesiredLRPsMutex       sync.RWMutex
+	applyDesiredLRPsArgsForCall []struct {
+		logger      lager.Logger
+		domain      string
+		desiredLRPs []*models.DesiredLRP
+		ttl         uint32
+	}
+	applyDesiredLRPsReturns struct {
+		result1 *models.DesiredLRPApplyResult
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -108,6 +145,42 @@ func (fake *FakeDesiredLRPDB) DesiredLRPsReturns(result1 []*models.DesiredLRP, r
 	}{result1, result2}
 }
 
+func (fake *FakeDesiredLRPDB) DesiredLRPsPage(logger lager.Logger, filter models.DesiredLRPFilter) (desiredLRPs []*models.DesiredLRP, nextPageToken string, err error) {
+	fake.desiredLRPsPageMutex.Lock()
+	fake.desiredLRPsPageArgsForCall = append(fake.desiredLRPsPageArgsForCall, struct {
+		logger lager.Logger
+		filter models.DesiredLRPFilter
+	}{logger, filter})
+	fake.recordInvocation("DesiredLRPsPage", []interface{}{logger, filter})
+	fake.desiredLRPsPageMutex.Unlock()
+	if fake.DesiredLRPsPageStub != nil {
+		return fake.DesiredLRPsPageStub(logger, filter)
+	} else {
+		return fake.desiredLRPsPageReturns.result1, fake.desiredLRPsPageReturns.result2, fake.desiredLRPsPageReturns.result3
+	}
+}
+
+func (fake *FakeDesiredLRPDB) DesiredLRPsPageCallCount() int {
+	fake.desiredLRPsPageMutex.RLock()
+	defer fake.desiredLRPsPageMutex.RUnlock()
+	return len(fake.desiredLRPsPageArgsForCall)
+}
+
+func (fake *FakeDesiredLRPDB) DesiredLRPsPageArgsForCall(i int) (lager.Logger, models.DesiredLRPFilter) {
+	fake.desiredLRPsPageMutex.RLock()
+	defer fake.desiredLRPsPageMutex.RUnlock()
+	return fake.desiredLRPsPageArgsForCall[i].logger, fake.desiredLRPsPageArgsForCall[i].filter
+}
+
+func (fake *FakeDesiredLRPDB) DesiredLRPsPageReturns(result1 []*models.DesiredLRP, result2 string, result3 error) {
+	fake.DesiredLRPsPageStub = nil
+	fake.desiredLRPsPageReturns = struct {
+		result1 []*models.DesiredLRP
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeDesiredLRPDB) DesiredLRPByProcessGuid(logger lager.Logger, processGuid string) (*models.DesiredLRP, error) {
 	fake.desiredLRPByProcessGuidMutex.Lock()
 	fake.desiredLRPByProcessGuidArgsForCall = append(fake.desiredLRPByProcessGuidArgsForCall, struct {
@@ -212,17 +285,18 @@ func (fake *FakeDesiredLRPDB) DesireLRPReturns(result1 error) {
 	}{result1}
 }
 
-func (fake *FakeDesiredLRPDB) UpdateDesiredLRP(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) (beforeDesiredLRP *models.DesiredLRP, err error) {
+func (fake *FakeDesiredLRPDB) UpdateDesiredLRP(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate, expectedTag *models.ModificationTag) (beforeDesiredLRP *models.DesiredLRP, err error) {
 	fake.updateDesiredLRPMutex.Lock()
 	fake.updateDesiredLRPArgsForCall = append(fake.updateDesiredLRPArgsForCall, struct {
 		logger      lager.Logger
 		processGuid string
 		update      *models.DesiredLRPUpdate
-	}{logger, processGuid, update})
-	fake.recordInvocation("UpdateDesiredLRP", []interface{}{logger, processGuid, update})
+		expectedTag *models.ModificationTag
+	}{logger, processGuid, update, expectedTag})
+	fake.recordInvocation("UpdateDesiredLRP", []interface{}{logger, processGuid, update, expectedTag})
 	fake.updateDesiredLRPMutex.Unlock()
 	if fake.UpdateDesiredLRPStub != nil {
-		return fake.UpdateDesiredLRPStub(logger, processGuid, update)
+		return fake.UpdateDesiredLRPStub(logger, processGuid, update, expectedTag)
 	} else {
 		return fake.updateDesiredLRPReturns.result1, fake.updateDesiredLRPReturns.result2
 	}
@@ -234,10 +308,10 @@ func (fake *FakeDesiredLRPDB) UpdateDesiredLRPCallCount() int {
 	return len(fake.updateDesiredLRPArgsForCall)
 }
 
-func (fake *FakeDesiredLRPDB) UpdateDesiredLRPArgsForCall(i int) (lager.Logger, string, *models.DesiredLRPUpdate) {
+func (fake *FakeDesiredLRPDB) UpdateDesiredLRPArgsForCall(i int) (lager.Logger, string, *models.DesiredLRPUpdate, *models.ModificationTag) {
 	fake.updateDesiredLRPMutex.RLock()
 	defer fake.updateDesiredLRPMutex.RUnlock()
-	return fake.updateDesiredLRPArgsForCall[i].logger, fake.updateDesiredLRPArgsForCall[i].processGuid, fake.updateDesiredLRPArgsForCall[i].update
+	return fake.updateDesiredLRPArgsForCall[i].logger, fake.updateDesiredLRPArgsForCall[i].processGuid, fake.updateDesiredLRPArgsForCall[i].update, fake.updateDesiredLRPArgsForCall[i].expectedTag
 }
 
 func (fake *FakeDesiredLRPDB) UpdateDesiredLRPReturns(result1 *models.DesiredLRP, result2 error) {
@@ -248,16 +322,17 @@ func (fake *FakeDesiredLRPDB) UpdateDesiredLRPReturns(result1 *models.DesiredLRP
 	}{result1, result2}
 }
 
-func (fake *FakeDesiredLRPDB) RemoveDesiredLRP(logger lager.Logger, processGuid string) error {
+func (fake *FakeDesiredLRPDB) RemoveDesiredLRP(logger lager.Logger, processGuid string, expectedTag *models.ModificationTag) error {
 	fake.removeDesiredLRPMutex.Lock()
 	fake.removeDesiredLRPArgsForCall = append(fake.removeDesiredLRPArgsForCall, struct {
 		logger      lager.Logger
 		processGuid string
-	}{logger, processGuid})
-	fake.recordInvocation("RemoveDesiredLRP", []interface{}{logger, processGuid})
+		expectedTag *models.ModificationTag
+	}{logger, processGuid, expectedTag})
+	fake.recordInvocation("RemoveDesiredLRP", []interface{}{logger, processGuid, expectedTag})
 	fake.removeDesiredLRPMutex.Unlock()
 	if fake.RemoveDesiredLRPStub != nil {
-		return fake.RemoveDesiredLRPStub(logger, processGuid)
+		return fake.RemoveDesiredLRPStub(logger, processGuid, expectedTag)
 	} else {
 		return fake.removeDesiredLRPReturns.result1
 	}
@@ -269,10 +344,10 @@ func (fake *FakeDesiredLRPDB) RemoveDesiredLRPCallCount() int {
 	return len(fake.removeDesiredLRPArgsForCall)
 }
 
-func (fake *FakeDesiredLRPDB) RemoveDesiredLRPArgsForCall(i int) (lager.Logger, string) {
+func (fake *FakeDesiredLRPDB) RemoveDesiredLRPArgsForCall(i int) (lager.Logger, string, *models.ModificationTag) {
 	fake.removeDesiredLRPMutex.RLock()
 	defer fake.removeDesiredLRPMutex.RUnlock()
-	return fake.removeDesiredLRPArgsForCall[i].logger, fake.removeDesiredLRPArgsForCall[i].processGuid
+	return fake.removeDesiredLRPArgsForCall[i].logger, fake.removeDesiredLRPArgsForCall[i].processGuid, fake.removeDesiredLRPArgsForCall[i].expectedTag
 }
 
 func (fake *FakeDesiredLRPDB) RemoveDesiredLRPReturns(result1 error) {
@@ -282,11 +357,92 @@ func (fake *FakeDesiredLRPDB) RemoveDesiredLRPReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeDesiredLRPDB) UpdateDesiredLRPRunInfo(logger lager.Logger, processGuid string, runInfo *models.DesiredLRPRunInfo, resource *models.DesiredLRPResource) (beforeDesiredLRP *models.DesiredLRP, err error) {
+	fake.updateDesiredLRPRunInfoMutex.Lock()
+	fake.updateDesiredLRPRunInfoArgsForCall = append(fake.updateDesiredLRPRunInfoArgsForCall, struct {
+		logger      lager.Logger
+		processGuid string
+		runInfo     *models.DesiredLRPRunInfo
+		resource    *models.DesiredLRPResource
+	}{logger, processGuid, runInfo, resource})
+	fake.recordInvocation("UpdateDesiredLRPRunInfo", []interface{}{logger, processGuid, runInfo, resource})
+	fake.updateDesiredLRPRunInfoMutex.Unlock()
+	if fake.UpdateDesiredLRPRunInfoStub != nil {
+		return fake.UpdateDesiredLRPRunInfoStub(logger, processGuid, runInfo, resource)
+	} else {
+		return fake.updateDesiredLRPRunInfoReturns.result1, fake.updateDesiredLRPRunInfoReturns.result2
+	}
+}
+
+func (fake *FakeDesiredLRPDB) UpdateDesiredLRPRunInfoCallCount() int {
+	fake.updateDesiredLRPRunInfoMutex.RLock()
+	defer fake.updateDesiredLRPRunInfoMutex.RUnlock()
+	return len(fake.updateDesiredLRPRunInfoArgsForCall)
+}
+
+func (fake *FakeDesiredLRPDB) UpdateDesiredLRPRunInfoArgsForCall(i int) (lager.Logger, string, *models.DesiredLRPRunInfo, *models.DesiredLRPResource) {
+	fake.updateDesiredLRPRunInfoMutex.RLock()
+	defer fake.updateDesiredLRPRunInfoMutex.RUnlock()
+	return fake.updateDesiredLRPRunInfoArgsForCall[i].logger, fake.updateDesiredLRPRunInfoArgsForCall[i].processGuid, fake.updateDesiredLRPRunInfoArgsForCall[i].runInfo, fake.updateDesiredLRPRunInfoArgsForCall[i].resource
+}
+
+func (fake *FakeDesiredLRPDB) UpdateDesiredLRPRunInfoReturns(result1 *models.DesiredLRP, result2 error) {
+	fake.UpdateDesiredLRPRunInfoStub = nil
+	fake.updateDesiredLRPRunInfoReturns = struct {
+		result1 *models.DesiredLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDesiredLRPDB) ApplyDesiredLRPs(logger lager.Logger, domain string, desiredLRPs []*models.DesiredLRP, ttl uint32) (*models.DesiredLRPApplyResult, error) {
+	var desiredLRPsCopy []*models.DesiredLRP
+	if desiredLRPs != nil {
+		desiredLRPsCopy = make([]*models.DesiredLRP, len(desiredLRPs))
+		copy(desiredLRPsCopy, desiredLRPs)
+	}
+	fake.applyDesiredLRPsMutex.Lock()
+	fake.applyDesiredLRPsArgsForCall = append(fake.applyDesiredLRPsArgsForCall, struct {
+		logger      lager.Logger
+		domain      string
+		desiredLRPs []*models.DesiredLRP
+		ttl         uint32
+	}{logger, domain, desiredLRPsCopy, ttl})
+	fake.recordInvocation("ApplyDesiredLRPs", []interface{}{logger, domain, desiredLRPsCopy, ttl})
+	fake.applyDesiredLRPsMutex.Unlock()
+	if fake.ApplyDesiredLRPsStub != nil {
+		return fake.ApplyDesiredLRPsStub(logger, domain, desiredLRPs, ttl)
+	} else {
+		return fake.applyDesiredLRPsReturns.result1, fake.applyDesiredLRPsReturns.result2
+	}
+}
+
+func (fake *FakeDesiredLRPDB) ApplyDesiredLRPsCallCount() int {
+	fake.applyDesiredLRPsMutex.RLock()
+	defer fake.applyDesiredLRPsMutex.RUnlock()
+	return len(fake.applyDesiredLRPsArgsForCall)
+}
+
+func (fake *FakeDesiredLRPDB) ApplyDesiredLRPsArgsForCall(i int) (lager.Logger, string, []*models.DesiredLRP, uint32) {
+	fake.applyDesiredLRPsMutex.RLock()
+	defer fake.applyDesiredLRPsMutex.RUnlock()
+	return fake.applyDesiredLRPsArgsForCall[i].logger, fake.applyDesiredLRPsArgsForCall[i].domain, fake.applyDesiredLRPsArgsForCall[i].desiredLRPs, fake.applyDesiredLRPsArgsForCall[i].ttl
+}
+
+func (fake *FakeDesiredLRPDB) ApplyDesiredLRPsReturns(result1 *models.DesiredLRPApplyResult, result2 error) {
+	fake.ApplyDesiredLRPsStub = nil
+	fake.applyDesiredLRPsReturns = struct {
+		result1 *models.DesiredLRPApplyResult
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDesiredLRPDB) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.desiredLRPsMutex.RLock()
 	defer fake.desiredLRPsMutex.RUnlock()
+	fake.desiredLRPsPageMutex.RLock()
+	defer fake.desiredLRPsPageMutex.RUnlock()
 	fake.desiredLRPByProcessGuidMutex.RLock()
 	defer fake.desiredLRPByProcessGuidMutex.RUnlock()
 	fake.desiredLRPSchedulingInfosMutex.RLock()
@@ -297,6 +453,10 @@ func (fake *FakeDesiredLRPDB) Invocations() map[string][][]interface{} {
 	defer fake.updateDesiredLRPMutex.RUnlock()
 	fake.removeDesiredLRPMutex.RLock()
 	defer fake.removeDesiredLRPMutex.RUnlock()
+	fake.updateDesiredLRPRunInfoMutex.RLock()
+	defer fake.updateDesiredLRPRunInfoMutex.RUnlock()
+	fake.applyDesiredLRPsMutex.RLock()
+	defer fake.applyDesiredLRPsMutex.RUnlock()
 	return fake.invocations
 }
 
@@ -0,0 +1,221 @@
+// This file was generated by counterfeiter
+package dbfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakeEncryptionProgressDB struct {
+	EncryptionProgressStub        func(logger lager.Logger) ([]*models.EncryptionTableProgress, error)
+	encryptionProgressMutex       sync.RWMutex
+	encryptionProgressArgsForCall []struct {
+		logger lager.Logger
+	}
+	encryptionProgressReturns struct {
+		result1 []*models.EncryptionTableProgress
+		result2 error
+	}
+	EncryptionCheckpointsStub        func(logger lager.Logger) ([]*models.EncryptionTableProgress, error)
+	encryptionCheckpointsMutex       sync.RWMutex
+	encryptionCheckpointsArgsForCall []struct {
+		logger lager.Logger
+	}
+	encryptionCheckpointsReturns struct {
+		result1 []*models.EncryptionTableProgress
+		result2 error
+	}
+	ReEncryptBatchStub        func(logger lager.Logger, tableName, keyLabel string, batchSize int) (*models.EncryptionTableProgress, error)
+	reEncryptBatchMutex       sync.RWMutex
+	reEncryptBatchArgsForCall []struct {
+		logger    lager.Logger
+		tableName string
+		keyLabel  string
+		batchSize int
+	}
+	reEncryptBatchReturns struct {
+		result1 *models.EncryptionTableProgress
+		result2 error
+	}
+	QuarantinedRowsByKeyLabelStub        func(logger lager.Logger) ([]*models.EncryptionKeyRows, error)
+	quarantinedRowsByKeyLabelMutex       sync.RWMutex
+	quarantinedRowsByKeyLabelArgsForCall []struct {
+		logger lager.Logger
+	}
+	quarantinedRowsByKeyLabelReturns struct {
+		result1 []*models.EncryptionKeyRows
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeEncryptionProgressDB) EncryptionProgress(logger lager.Logger) ([]*models.EncryptionTableProgress, error) {
+	fake.encryptionProgressMutex.Lock()
+	fake.encryptionProgressArgsForCall = append(fake.encryptionProgressArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("EncryptionProgress", []interface{}{logger})
+	fake.encryptionProgressMutex.Unlock()
+	if fake.EncryptionProgressStub != nil {
+		return fake.EncryptionProgressStub(logger)
+	} else {
+		return fake.encryptionProgressReturns.result1, fake.encryptionProgressReturns.result2
+	}
+}
+
+func (fake *FakeEncryptionProgressDB) EncryptionProgressCallCount() int {
+	fake.encryptionProgressMutex.RLock()
+	defer fake.encryptionProgressMutex.RUnlock()
+	return len(fake.encryptionProgressArgsForCall)
+}
+
+func (fake *FakeEncryptionProgressDB) EncryptionProgressArgsForCall(i int) lager.Logger {
+	fake.encryptionProgressMutex.RLock()
+	defer fake.encryptionProgressMutex.RUnlock()
+	return fake.encryptionProgressArgsForCall[i].logger
+}
+
+func (fake *FakeEncryptionProgressDB) EncryptionProgressReturns(result1 []*models.EncryptionTableProgress, result2 error) {
+	fake.EncryptionProgressStub = nil
+	fake.encryptionProgressReturns = struct {
+		result1 []*models.EncryptionTableProgress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEncryptionProgressDB) EncryptionCheckpoints(logger lager.Logger) ([]*models.EncryptionTableProgress, error) {
+	fake.encryptionCheckpointsMutex.Lock()
+	fake.encryptionCheckpointsArgsForCall = append(fake.encryptionCheckpointsArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("EncryptionCheckpoints", []interface{}{logger})
+	fake.encryptionCheckpointsMutex.Unlock()
+	if fake.EncryptionCheckpointsStub != nil {
+		return fake.EncryptionCheckpointsStub(logger)
+	} else {
+		return fake.encryptionCheckpointsReturns.result1, fake.encryptionCheckpointsReturns.result2
+	}
+}
+
+func (fake *FakeEncryptionProgressDB) EncryptionCheckpointsCallCount() int {
+	fake.encryptionCheckpointsMutex.RLock()
+	defer fake.encryptionCheckpointsMutex.RUnlock()
+	return len(fake.encryptionCheckpointsArgsForCall)
+}
+
+func (fake *FakeEncryptionProgressDB) EncryptionCheckpointsArgsForCall(i int) lager.Logger {
+	fake.encryptionCheckpointsMutex.RLock()
+	defer fake.encryptionCheckpointsMutex.RUnlock()
+	return fake.encryptionCheckpointsArgsForCall[i].logger
+}
+
+func (fake *FakeEncryptionProgressDB) EncryptionCheckpointsReturns(result1 []*models.EncryptionTableProgress, result2 error) {
+	fake.EncryptionCheckpointsStub = nil
+	fake.encryptionCheckpointsReturns = struct {
+		result1 []*models.EncryptionTableProgress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEncryptionProgressDB) ReEncryptBatch(logger lager.Logger, tableName string, keyLabel string, batchSize int) (*models.EncryptionTableProgress, error) {
+	fake.reEncryptBatchMutex.Lock()
+	fake.reEncryptBatchArgsForCall = append(fake.reEncryptBatchArgsForCall, struct {
+		logger    lager.Logger
+		tableName string
+		keyLabel  string
+		batchSize int
+	}{logger, tableName, keyLabel, batchSize})
+	fake.recordInvocation("ReEncryptBatch", []interface{}{logger, tableName, keyLabel, batchSize})
+	fake.reEncryptBatchMutex.Unlock()
+	if fake.ReEncryptBatchStub != nil {
+		return fake.ReEncryptBatchStub(logger, tableName, keyLabel, batchSize)
+	} else {
+		return fake.reEncryptBatchReturns.result1, fake.reEncryptBatchReturns.result2
+	}
+}
+
+func (fake *FakeEncryptionProgressDB) ReEncryptBatchCallCount() int {
+	fake.reEncryptBatchMutex.RLock()
+	defer fake.reEncryptBatchMutex.RUnlock()
+	return len(fake.reEncryptBatchArgsForCall)
+}
+
+func (fake *FakeEncryptionProgressDB) ReEncryptBatchArgsForCall(i int) (lager.Logger, string, string, int) {
+	fake.reEncryptBatchMutex.RLock()
+	defer fake.reEncryptBatchMutex.RUnlock()
+	return fake.reEncryptBatchArgsForCall[i].logger, fake.reEncryptBatchArgsForCall[i].tableName, fake.reEncryptBatchArgsForCall[i].keyLabel, fake.reEncryptBatchArgsForCall[i].batchSize
+}
+
+func (fake *FakeEncryptionProgressDB) ReEncryptBatchReturns(result1 *models.EncryptionTableProgress, result2 error) {
+	fake.ReEncryptBatchStub = nil
+	fake.reEncryptBatchReturns = struct {
+		result1 *models.EncryptionTableProgress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEncryptionProgressDB) QuarantinedRowsByKeyLabel(logger lager.Logger) ([]*models.EncryptionKeyRows, error) {
+	fake.quarantinedRowsByKeyLabelMutex.Lock()
+	fake.quarantinedRowsByKeyLabelArgsForCall = append(fake.quarantinedRowsByKeyLabelArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("QuarantinedRowsByKeyLabel", []interface{}{logger})
+	fake.quarantinedRowsByKeyLabelMutex.Unlock()
+	if fake.QuarantinedRowsByKeyLabelStub != nil {
+		return fake.QuarantinedRowsByKeyLabelStub(logger)
+	} else {
+		return fake.quarantinedRowsByKeyLabelReturns.result1, fake.quarantinedRowsByKeyLabelReturns.result2
+	}
+}
+
+func (fake *FakeEncryptionProgressDB) QuarantinedRowsByKeyLabelCallCount() int {
+	fake.quarantinedRowsByKeyLabelMutex.RLock()
+	defer fake.quarantinedRowsByKeyLabelMutex.RUnlock()
+	return len(fake.quarantinedRowsByKeyLabelArgsForCall)
+}
+
+func (fake *FakeEncryptionProgressDB) QuarantinedRowsByKeyLabelArgsForCall(i int) lager.Logger {
+	fake.quarantinedRowsByKeyLabelMutex.RLock()
+	defer fake.quarantinedRowsByKeyLabelMutex.RUnlock()
+	return fake.quarantinedRowsByKeyLabelArgsForCall[i].logger
+}
+
+func (fake *FakeEncryptionProgressDB) QuarantinedRowsByKeyLabelReturns(result1 []*models.EncryptionKeyRows, result2 error) {
+	fake.QuarantinedRowsByKeyLabelStub = nil
+	fake.quarantinedRowsByKeyLabelReturns = struct {
+		result1 []*models.EncryptionKeyRows
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEncryptionProgressDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.encryptionProgressMutex.RLock()
+	defer fake.encryptionProgressMutex.RUnlock()
+	fake.encryptionCheckpointsMutex.RLock()
+	defer fake.encryptionCheckpointsMutex.RUnlock()
+	fake.reEncryptBatchMutex.RLock()
+	defer fake.reEncryptBatchMutex.RUnlock()
+	fake.quarantinedRowsByKeyLabelMutex.RLock()
+	defer fake.quarantinedRowsByKeyLabelMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeEncryptionProgressDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.EncryptionProgressDB = new(FakeEncryptionProgressDB)
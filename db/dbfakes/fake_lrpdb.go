@@ -21,6 +21,17 @@ type FakeLRPDB struct {
 		result1 []*models.ActualLRPGroup
 		result2 error
 	}
+	ActualLRPGroupsPageStub        func(logger lager.Logger, filter models.ActualLRPFilter) (groups []*models.ActualLRPGroup, nextPageToken string, err error)
+	actualLRPGroupsPageMutex       sync.RWMutex
+	actualLRPGroupsPageArgsForCall []struct {
+		logger lager.Logger
+		filter models.ActualLRPFilter
+	}
+	actualLRPGroupsPageReturns struct {
+		result1 []*models.ActualLRPGroup
+		result2 string
+		result3 error
+	}
 	ActualLRPGroupsByProcessGuidStub        func(logger lager.Logger, processGuid string) ([]*models.ActualLRPGroup, error)
 	actualLRPGroupsByProcessGuidMutex       sync.RWMutex
 	actualLRPGroupsByProcessGuidArgsForCall []struct {
@@ -136,6 +147,17 @@ type FakeLRPDB struct {
 		result1 []*models.DesiredLRP
 		result2 error
 	}
+	DesiredLRPsPageStub        func(logger lager.Logger, filter models.DesiredLRPFilter) (desiredLRPs []*models.DesiredLRP, nextPageToken string, err error)
+	desiredLRPsPageMutex       sync.RWMutex
+	desiredLRPsPageArgsForCall []struct {
+		logger lager.Logger
+		filter models.DesiredLRPFilter
+	}
+	desiredLRPsPageReturns struct {
+		result1 []*models.DesiredLRP
+		result2 string
+		result3 error
+	}
 	DesiredLRPByProcessGuidStub        func(logger lager.Logger, processGuid string) (*models.DesiredLRP, error)
 	desiredLRPByProcessGuidMutex       sync.RWMutex
 	desiredLRPByProcessGuidArgsForCall []struct {
@@ -165,26 +187,52 @@ type FakeLRPDB struct {
 	desireLRPReturns struct {
 		result1 error
 	}
-	UpdateDesiredLRPStub        func(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) (beforeDesiredLRP *models.DesiredLRP, err error)
+	UpdateDesiredLRPStub        func(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate, expectedTag *models.ModificationTag) (beforeDesiredLRP *models.DesiredLRP, err error)
 	updateDesiredLRPMutex       sync.RWMutex
 	updateDesiredLRPArgsForCall []struct {
 		logger      lager.Logger
 		processGuid string
 		update      *models.DesiredLRPUpdate
+		expectedTag *models.ModificationTag
 	}
 	updateDesiredLRPReturns struct {
 		result1 *models.DesiredLRP
 		result2 error
 	}
-	RemoveDesiredLRPStub        func(logger lager.Logger, processGuid string) error
+	RemoveDesiredLRPStub        func(logger lager.Logger, processGuid string, expectedTag *models.ModificationTag) error
 	removeDesiredLRPMutex       sync.RWMutex
 	removeDesiredLRPArgsForCall []struct {
 		logger      lager.Logger
 		processGuid string
+		expectedTag *models.ModificationTag
 	}
 	removeDesiredLRPReturns struct {
 		result1 error
 	}
+	UpdateDesiredLRPRunInfoStub        func(logger lager.Logger, processGuid string, runInfo *models.DesiredLRPRunInfo, resource *models.DesiredLRPResource) (beforeDesiredLRP *models.DesiredLRP, err error)
+	updateDesiredLRPRunInfoMutex       sync.RWMutex
+	updateDesiredLRPRunInfoArgsForCall []struct {
+		logger      lager.Logger
+		processGuid string
+		runInfo     *models.DesiredLRPRunInfo
+		resource    *models.DesiredLRPResource
+	}
+	updateDesiredLRPRunInfoReturns struct {
+		result1 *models.DesiredLRP
+		result2 error
+	}
+	ApplyDesiredLRPsStub        func(logger lager.Logger, domain string, desiredLRPs []*models.DesiredLRP, ttl uint32) (*models.DesiredLRPApplyResult, error)
+	applyDesiredLRPsMutex       sync.RWMutex
+	applyDesiredLRPsArgsForCall []struct {
+		logger      lager.Logger
+		domain      string
+		desiredLRPs []*models.DesiredLRP
+		ttl         uint32
+	}
+	applyDesiredLRPsReturns struct {
+		result1 *models.DesiredLRPApplyResult
+		result2 error
+	}
 	ConvergeLRPsStub        func(logger lager.Logger, cellSet models.CellSet) (startRequests []*auctioneer.LRPStartRequest, keysWithMissingCells []*models.ActualLRPKeyWithSchedulingInfo, keysToRetire []*models.ActualLRPKey)
 	convergeLRPsMutex       sync.RWMutex
 	convergeLRPsArgsForCall []struct {
@@ -245,6 +293,42 @@ func (fake *FakeLRPDB) ActualLRPGroupsReturns(result1 []*models.ActualLRPGroup,
 	}{result1, result2}
 }
 
+func (fake *FakeLRPDB) ActualLRPGroupsPage(logger lager.Logger, filter models.ActualLRPFilter) (groups []*models.ActualLRPGroup, nextPageToken string, err error) {
+	fake.actualLRPGroupsPageMutex.Lock()
+	fake.actualLRPGroupsPageArgsForCall = append(fake.actualLRPGroupsPageArgsForCall, struct {
+		logger lager.Logger
+		filter models.ActualLRPFilter
+	}{logger, filter})
+	fake.recordInvocation("ActualLRPGroupsPage", []interface{}{logger, filter})
+	fake.actualLRPGroupsPageMutex.Unlock()
+	if fake.ActualLRPGroupsPageStub != nil {
+		return fake.ActualLRPGroupsPageStub(logger, filter)
+	} else {
+		return fake.actualLRPGroupsPageReturns.result1, fake.actualLRPGroupsPageReturns.result2, fake.actualLRPGroupsPageReturns.result3
+	}
+}
+
+func (fake *FakeLRPDB) ActualLRPGroupsPageCallCount() int {
+	fake.actualLRPGroupsPageMutex.RLock()
+	defer fake.actualLRPGroupsPageMutex.RUnlock()
+	return len(fake.actualLRPGroupsPageArgsForCall)
+}
+
+func (fake *FakeLRPDB) ActualLRPGroupsPageArgsForCall(i int) (lager.Logger, models.ActualLRPFilter) {
+	fake.actualLRPGroupsPageMutex.RLock()
+	defer fake.actualLRPGroupsPageMutex.RUnlock()
+	return fake.actualLRPGroupsPageArgsForCall[i].logger, fake.actualLRPGroupsPageArgsForCall[i].filter
+}
+
+func (fake *FakeLRPDB) ActualLRPGroupsPageReturns(result1 []*models.ActualLRPGroup, result2 string, result3 error) {
+	fake.ActualLRPGroupsPageStub = nil
+	fake.actualLRPGroupsPageReturns = struct {
+		result1 []*models.ActualLRPGroup
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeLRPDB) ActualLRPGroupsByProcessGuid(logger lager.Logger, processGuid string) ([]*models.ActualLRPGroup, error) {
 	fake.actualLRPGroupsByProcessGuidMutex.Lock()
 	fake.actualLRPGroupsByProcessGuidArgsForCall = append(fake.actualLRPGroupsByProcessGuidArgsForCall, struct {
@@ -610,6 +694,42 @@ func (fake *FakeLRPDB) DesiredLRPsReturns(result1 []*models.DesiredLRP, result2
 	}{result1, result2}
 }
 
+func (fake *FakeLRPDB) DesiredLRPsPage(logger lager.Logger, filter models.DesiredLRPFilter) (desiredLRPs []*models.DesiredLRP, nextPageToken string, err error) {
+	fake.desiredLRPsPageMutex.Lock()
+	fake.desiredLRPsPageArgsForCall = append(fake.desiredLRPsPageArgsForCall, struct {
+		logger lager.Logger
+		filter models.DesiredLRPFilter
+	}{logger, filter})
+	fake.recordInvocation("DesiredLRPsPage", []interface{}{logger, filter})
+	fake.desiredLRPsPageMutex.Unlock()
+	if fake.DesiredLRPsPageStub != nil {
+		return fake.DesiredLRPsPageStub(logger, filter)
+	} else {
+		return fake.desiredLRPsPageReturns.result1, fake.desiredLRPsPageReturns.result2, fake.desiredLRPsPageReturns.result3
+	}
+}
+
+func (fake *FakeLRPDB) DesiredLRPsPageCallCount() int {
+	fake.desiredLRPsPageMutex.RLock()
+	defer fake.desiredLRPsPageMutex.RUnlock()
+	return len(fake.desiredLRPsPageArgsForCall)
+}
+
+func (fake *FakeLRPDB) DesiredLRPsPageArgsForCall(i int) (lager.Logger, models.DesiredLRPFilter) {
+	fake.desiredLRPsPageMutex.RLock()
+	defer fake.desiredLRPsPageMutex.RUnlock()
+	return fake.desiredLRPsPageArgsForCall[i].logger, fake.desiredLRPsPageArgsForCall[i].filter
+}
+
+func (fake *FakeLRPDB) DesiredLRPsPageReturns(result1 []*models.DesiredLRP, result2 string, result3 error) {
+	fake.DesiredLRPsPageStub = nil
+	fake.desiredLRPsPageReturns = struct {
+		result1 []*models.DesiredLRP
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeLRPDB) DesiredLRPByProcessGuid(logger lager.Logger, processGuid string) (*models.DesiredLRP, error) {
 	fake.desiredLRPByProcessGuidMutex.Lock()
 	fake.desiredLRPByProcessGuidArgsForCall = append(fake.desiredLRPByProcessGuidArgsForCall, struct {
@@ -714,17 +834,18 @@ func (fake *FakeLRPDB) DesireLRPReturns(result1 error) {
 	}{result1}
 }
 
-func (fake *FakeLRPDB) UpdateDesiredLRP(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) (beforeDesiredLRP *models.DesiredLRP, err error) {
+func (fake *FakeLRPDB) UpdateDesiredLRP(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate, expectedTag *models.ModificationTag) (beforeDesiredLRP *models.DesiredLRP, err error) {
 	fake.updateDesiredLRPMutex.Lock()
 	fake.updateDesiredLRPArgsForCall = append(fake.updateDesiredLRPArgsForCall, struct {
 		logger      lager.Logger
 		processGuid string
 		update      *models.DesiredLRPUpdate
-	}{logger, processGuid, update})
-	fake.recordInvocation("UpdateDesiredLRP", []interface{}{logger, processGuid, update})
+		expectedTag *models.ModificationTag
+	}{logger, processGuid, update, expectedTag})
+	fake.recordInvocation("UpdateDesiredLRP", []interface{}{logger, processGuid, update, expectedTag})
 	fake.updateDesiredLRPMutex.Unlock()
 	if fake.UpdateDesiredLRPStub != nil {
-		return fake.UpdateDesiredLRPStub(logger, processGuid, update)
+		return fake.UpdateDesiredLRPStub(logger, processGuid, update, expectedTag)
 	} else {
 		return fake.updateDesiredLRPReturns.result1, fake.updateDesiredLRPReturns.result2
 	}
@@ -736,10 +857,10 @@ func (fake *FakeLRPDB) UpdateDesiredLRPCallCount() int {
 	return len(fake.updateDesiredLRPArgsForCall)
 }
 
-func (fake *FakeLRPDB) UpdateDesiredLRPArgsForCall(i int) (lager.Logger, string, *models.DesiredLRPUpdate) {
+func (fake *FakeLRPDB) UpdateDesiredLRPArgsForCall(i int) (lager.Logger, string, *models.DesiredLRPUpdate, *models.ModificationTag) {
 	fake.updateDesiredLRPMutex.RLock()
 	defer fake.updateDesiredLRPMutex.RUnlock()
-	return fake.updateDesiredLRPArgsForCall[i].logger, fake.updateDesiredLRPArgsForCall[i].processGuid, fake.updateDesiredLRPArgsForCall[i].update
+	return fake.updateDesiredLRPArgsForCall[i].logger, fake.updateDesiredLRPArgsForCall[i].processGuid, fake.updateDesiredLRPArgsForCall[i].update, fake.updateDesiredLRPArgsForCall[i].expectedTag
 }
 
 func (fake *FakeLRPDB) UpdateDesiredLRPReturns(result1 *models.DesiredLRP, result2 error) {
@@ -750,16 +871,17 @@ func (fake *FakeLRPDB) UpdateDesiredLRPReturns(result1 *models.DesiredLRP, resul
 	}{result1, result2}
 }
 
-func (fake *FakeLRPDB) RemoveDesiredLRP(logger lager.Logger, processGuid string) error {
+func (fake *FakeLRPDB) RemoveDesiredLRP(logger lager.Logger, processGuid string, expectedTag *models.ModificationTag) error {
 	fake.removeDesiredLRPMutex.Lock()
 	fake.removeDesiredLRPArgsForCall = append(fake.removeDesiredLRPArgsForCall, struct {
 		logger      lager.Logger
 		processGuid string
-	}{logger, processGuid})
-	fake.recordInvocation("RemoveDesiredLRP", []interface{}{logger, processGuid})
+		expectedTag *models.ModificationTag
+	}{logger, processGuid, expectedTag})
+	fake.recordInvocation("RemoveDesiredLRP", []interface{}{logger, processGuid, expectedTag})
 	fake.removeDesiredLRPMutex.Unlock()
 	if fake.RemoveDesiredLRPStub != nil {
-		return fake.RemoveDesiredLRPStub(logger, processGuid)
+		return fake.RemoveDesiredLRPStub(logger, processGuid, expectedTag)
 	} else {
 		return fake.removeDesiredLRPReturns.result1
 	}
@@ -771,10 +893,10 @@ func (fake *FakeLRPDB) RemoveDesiredLRPCallCount() int {
 	return len(fake.removeDesiredLRPArgsForCall)
 }
 
-func (fake *FakeLRPDB) RemoveDesiredLRPArgsForCall(i int) (lager.Logger, string) {
+func (fake *FakeLRPDB) RemoveDesiredLRPArgsForCall(i int) (lager.Logger, string, *models.ModificationTag) {
 	fake.removeDesiredLRPMutex.RLock()
 	defer fake.removeDesiredLRPMutex.RUnlock()
-	return fake.removeDesiredLRPArgsForCall[i].logger, fake.removeDesiredLRPArgsForCall[i].processGuid
+	return fake.removeDesiredLRPArgsForCall[i].logger, fake.removeDesiredLRPArgsForCall[i].processGuid, fake.removeDesiredLRPArgsForCall[i].expectedTag
 }
 
 func (fake *FakeLRPDB) RemoveDesiredLRPReturns(result1 error) {
@@ -784,6 +906,85 @@ func (fake *FakeLRPDB) RemoveDesiredLRPReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeLRPDB) UpdateDesiredLRPRunInfo(logger lager.Logger, processGuid string, runInfo *models.DesiredLRPRunInfo, resource *models.DesiredLRPResource) (beforeDesiredLRP *models.DesiredLRP, err error) {
+	fake.updateDesiredLRPRunInfoMutex.Lock()
+	fake.updateDesiredLRPRunInfoArgsForCall = append(fake.updateDesiredLRPRunInfoArgsForCall, struct {
+		logger      lager.Logger
+		processGuid string
+		runInfo     *models.DesiredLRPRunInfo
+		resource    *models.DesiredLRPResource
+	}{logger, processGuid, runInfo, resource})
+	fake.recordInvocation("UpdateDesiredLRPRunInfo", []interface{}{logger, processGuid, runInfo, resource})
+	fake.updateDesiredLRPRunInfoMutex.Unlock()
+	if fake.UpdateDesiredLRPRunInfoStub != nil {
+		return fake.UpdateDesiredLRPRunInfoStub(logger, processGuid, runInfo, resource)
+	} else {
+		return fake.updateDesiredLRPRunInfoReturns.result1, fake.updateDesiredLRPRunInfoReturns.result2
+	}
+}
+
+func (fake *FakeLRPDB) UpdateDesiredLRPRunInfoCallCount() int {
+	fake.updateDesiredLRPRunInfoMutex.RLock()
+	defer fake.updateDesiredLRPRunInfoMutex.RUnlock()
+	return len(fake.updateDesiredLRPRunInfoArgsForCall)
+}
+
+func (fake *FakeLRPDB) UpdateDesiredLRPRunInfoArgsForCall(i int) (lager.Logger, string, *models.DesiredLRPRunInfo, *models.DesiredLRPResource) {
+	fake.updateDesiredLRPRunInfoMutex.RLock()
+	defer fake.updateDesiredLRPRunInfoMutex.RUnlock()
+	return fake.updateDesiredLRPRunInfoArgsForCall[i].logger, fake.updateDesiredLRPRunInfoArgsForCall[i].processGuid, fake.updateDesiredLRPRunInfoArgsForCall[i].runInfo, fake.updateDesiredLRPRunInfoArgsForCall[i].resource
+}
+
+func (fake *FakeLRPDB) UpdateDesiredLRPRunInfoReturns(result1 *models.DesiredLRP, result2 error) {
+	fake.UpdateDesiredLRPRunInfoStub = nil
+	fake.updateDesiredLRPRunInfoReturns = struct {
+		result1 *models.DesiredLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLRPDB) ApplyDesiredLRPs(logger lager.Logger, domain string, desiredLRPs []*models.DesiredLRP, ttl uint32) (*models.DesiredLRPApplyResult, error) {
+	var desiredLRPsCopy []*models.DesiredLRP
+	if desiredLRPs != nil {
+		desiredLRPsCopy = make([]*models.DesiredLRP, len(desiredLRPs))
+		copy(desiredLRPsCopy, desiredLRPs)
+	}
+	fake.applyDesiredLRPsMutex.Lock()
+	fake.applyDesiredLRPsArgsForCall = append(fake.applyDesiredLRPsArgsForCall, struct {
+		logger      lager.Logger
+		domain      string
+		desiredLRPs []*models.DesiredLRP
+		ttl         uint32
+	}{logger, domain, desiredLRPsCopy, ttl})
+	fake.recordInvocation("ApplyDesiredLRPs", []interface{}{logger, domain, desiredLRPsCopy, ttl})
+	fake.applyDesiredLRPsMutex.Unlock()
+	if fake.ApplyDesiredLRPsStub != nil {
+		return fake.ApplyDesiredLRPsStub(logger, domain, desiredLRPs, ttl)
+	} else {
+		return fake.applyDesiredLRPsReturns.result1, fake.applyDesiredLRPsReturns.result2
+	}
+}
+
+func (fake *FakeLRPDB) ApplyDesiredLRPsCallCount() int {
+	fake.applyDesiredLRPsMutex.RLock()
+	defer fake.applyDesiredLRPsMutex.RUnlock()
+	return len(fake.applyDesiredLRPsArgsForCall)
+}
+
+func (fake *FakeLRPDB) ApplyDesiredLRPsArgsForCall(i int) (lager.Logger, string, []*models.DesiredLRP, uint32) {
+	fake.applyDesiredLRPsMutex.RLock()
+	defer fake.applyDesiredLRPsMutex.RUnlock()
+	return fake.applyDesiredLRPsArgsForCall[i].logger, fake.applyDesiredLRPsArgsForCall[i].domain, fake.applyDesiredLRPsArgsForCall[i].desiredLRPs, fake.applyDesiredLRPsArgsForCall[i].ttl
+}
+
+func (fake *FakeLRPDB) ApplyDesiredLRPsReturns(result1 *models.DesiredLRPApplyResult, result2 error) {
+	fake.ApplyDesiredLRPsStub = nil
+	fake.applyDesiredLRPsReturns = struct {
+		result1 *models.DesiredLRPApplyResult
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeLRPDB) ConvergeLRPs(logger lager.Logger, cellSet models.CellSet) (startRequests []*auctioneer.LRPStartRequest, keysWithMissingCells []*models.ActualLRPKeyWithSchedulingInfo, keysToRetire []*models.ActualLRPKey) {
 	fake.convergeLRPsMutex.Lock()
 	fake.convergeLRPsArgsForCall = append(fake.convergeLRPsArgsForCall, struct {
@@ -860,6 +1061,8 @@ func (fake *FakeLRPDB) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.actualLRPGroupsMutex.RLock()
 	defer fake.actualLRPGroupsMutex.RUnlock()
+	fake.actualLRPGroupsPageMutex.RLock()
+	defer fake.actualLRPGroupsPageMutex.RUnlock()
 	fake.actualLRPGroupsByProcessGuidMutex.RLock()
 	defer fake.actualLRPGroupsByProcessGuidMutex.RUnlock()
 	fake.actualLRPGroupByProcessGuidAndIndexMutex.RLock()
@@ -880,6 +1083,8 @@ func (fake *FakeLRPDB) Invocations() map[string][][]interface{} {
 	defer fake.removeActualLRPMutex.RUnlock()
 	fake.desiredLRPsMutex.RLock()
 	defer fake.desiredLRPsMutex.RUnlock()
+	fake.desiredLRPsPageMutex.RLock()
+	defer fake.desiredLRPsPageMutex.RUnlock()
 	fake.desiredLRPByProcessGuidMutex.RLock()
 	defer fake.desiredLRPByProcessGuidMutex.RUnlock()
 	fake.desiredLRPSchedulingInfosMutex.RLock()
@@ -890,6 +1095,10 @@ func (fake *FakeLRPDB) Invocations() map[string][][]interface{} {
 	defer fake.updateDesiredLRPMutex.RUnlock()
 	fake.removeDesiredLRPMutex.RLock()
 	defer fake.removeDesiredLRPMutex.RUnlock()
+	fake.updateDesiredLRPRunInfoMutex.RLock()
+	defer fake.updateDesiredLRPRunInfoMutex.RUnlock()
+	fake.applyDesiredLRPsMutex.RLock()
+	defer fake.applyDesiredLRPsMutex.RUnlock()
 	fake.convergeLRPsMutex.RLock()
 	defer fake.convergeLRPsMutex.RUnlock()
 	fake.gatherAndPruneLRPsMutex.RLock()
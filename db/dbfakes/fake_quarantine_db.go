@@ -0,0 +1,223 @@
+// This file was generated by counterfeiter
+package dbfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakeQuarantineDB struct {
+	QuarantinedRecordsStub        func(logger lager.Logger, tableName string) ([]*models.QuarantinedRecord, error)
+	quarantinedRecordsMutex       sync.RWMutex
+	quarantinedRecordsArgsForCall []struct {
+		logger    lager.Logger
+		tableName string
+	}
+	quarantinedRecordsReturns struct {
+		result1 []*models.QuarantinedRecord
+		result2 error
+	}
+	QuarantinedRecordStub        func(logger lager.Logger, id int64) (*models.QuarantinedRecord, error)
+	quarantinedRecordMutex       sync.RWMutex
+	quarantinedRecordArgsForCall []struct {
+		logger lager.Logger
+		id     int64
+	}
+	quarantinedRecordReturns struct {
+		result1 *models.QuarantinedRecord
+		result2 error
+	}
+	RestoreQuarantinedRecordStub        func(logger lager.Logger, id int64) error
+	restoreQuarantinedRecordMutex       sync.RWMutex
+	restoreQuarantinedRecordArgsForCall []struct {
+		logger lager.Logger
+		id     int64
+	}
+	restoreQuarantinedRecordReturns struct {
+		result1 error
+	}
+	RestoreQuarantinedRecordsStub        func(logger lager.Logger, tableName string) ([]int64, []int64, error)
+	restoreQuarantinedRecordsMutex       sync.RWMutex
+	restoreQuarantinedRecordsArgsForCall []struct {
+		logger    lager.Logger
+		tableName string
+	}
+	restoreQuarantinedRecordsReturns struct {
+		result1 []int64
+		result2 []int64
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeQuarantineDB) QuarantinedRecords(logger lager.Logger, tableName string) ([]*models.QuarantinedRecord, error) {
+	fake.quarantinedRecordsMutex.Lock()
+	fake.quarantinedRecordsArgsForCall = append(fake.quarantinedRecordsArgsForCall, struct {
+		logger    lager.Logger
+		tableName string
+	}{logger, tableName})
+	fake.recordInvocation("QuarantinedRecords", []interface{}{logger, tableName})
+	fake.quarantinedRecordsMutex.Unlock()
+	if fake.QuarantinedRecordsStub != nil {
+		return fake.QuarantinedRecordsStub(logger, tableName)
+	} else {
+		return fake.quarantinedRecordsReturns.result1, fake.quarantinedRecordsReturns.result2
+	}
+}
+
+func (fake *FakeQuarantineDB) QuarantinedRecordsCallCount() int {
+	fake.quarantinedRecordsMutex.RLock()
+	defer fake.quarantinedRecordsMutex.RUnlock()
+	return len(fake.quarantinedRecordsArgsForCall)
+}
+
+func (fake *FakeQuarantineDB) QuarantinedRecordsArgsForCall(i int) (lager.Logger, string) {
+	fake.quarantinedRecordsMutex.RLock()
+	defer fake.quarantinedRecordsMutex.RUnlock()
+	return fake.quarantinedRecordsArgsForCall[i].logger, fake.quarantinedRecordsArgsForCall[i].tableName
+}
+
+func (fake *FakeQuarantineDB) QuarantinedRecordsReturns(result1 []*models.QuarantinedRecord, result2 error) {
+	fake.QuarantinedRecordsStub = nil
+	fake.quarantinedRecordsReturns = struct {
+		result1 []*models.QuarantinedRecord
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeQuarantineDB) QuarantinedRecord(logger lager.Logger, id int64) (*models.QuarantinedRecord, error) {
+	fake.quarantinedRecordMutex.Lock()
+	fake.quarantinedRecordArgsForCall = append(fake.quarantinedRecordArgsForCall, struct {
+		logger lager.Logger
+		id     int64
+	}{logger, id})
+	fake.recordInvocation("QuarantinedRecord", []interface{}{logger, id})
+	fake.quarantinedRecordMutex.Unlock()
+	if fake.QuarantinedRecordStub != nil {
+		return fake.QuarantinedRecordStub(logger, id)
+	} else {
+		return fake.quarantinedRecordReturns.result1, fake.quarantinedRecordReturns.result2
+	}
+}
+
+func (fake *FakeQuarantineDB) QuarantinedRecordCallCount() int {
+	fake.quarantinedRecordMutex.RLock()
+	defer fake.quarantinedRecordMutex.RUnlock()
+	return len(fake.quarantinedRecordArgsForCall)
+}
+
+func (fake *FakeQuarantineDB) QuarantinedRecordArgsForCall(i int) (lager.Logger, int64) {
+	fake.quarantinedRecordMutex.RLock()
+	defer fake.quarantinedRecordMutex.RUnlock()
+	return fake.quarantinedRecordArgsForCall[i].logger, fake.quarantinedRecordArgsForCall[i].id
+}
+
+func (fake *FakeQuarantineDB) QuarantinedRecordReturns(result1 *models.QuarantinedRecord, result2 error) {
+	fake.QuarantinedRecordStub = nil
+	fake.quarantinedRecordReturns = struct {
+		result1 *models.QuarantinedRecord
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeQuarantineDB) RestoreQuarantinedRecord(logger lager.Logger, id int64) error {
+	fake.restoreQuarantinedRecordMutex.Lock()
+	fake.restoreQuarantinedRecordArgsForCall = append(fake.restoreQuarantinedRecordArgsForCall, struct {
+		logger lager.Logger
+		id     int64
+	}{logger, id})
+	fake.recordInvocation("RestoreQuarantinedRecord", []interface{}{logger, id})
+	fake.restoreQuarantinedRecordMutex.Unlock()
+	if fake.RestoreQuarantinedRecordStub != nil {
+		return fake.RestoreQuarantinedRecordStub(logger, id)
+	} else {
+		return fake.restoreQuarantinedRecordReturns.result1
+	}
+}
+
+func (fake *FakeQuarantineDB) RestoreQuarantinedRecordCallCount() int {
+	fake.restoreQuarantinedRecordMutex.RLock()
+	defer fake.restoreQuarantinedRecordMutex.RUnlock()
+	return len(fake.restoreQuarantinedRecordArgsForCall)
+}
+
+func (fake *FakeQuarantineDB) RestoreQuarantinedRecordArgsForCall(i int) (lager.Logger, int64) {
+	fake.restoreQuarantinedRecordMutex.RLock()
+	defer fake.restoreQuarantinedRecordMutex.RUnlock()
+	return fake.restoreQuarantinedRecordArgsForCall[i].logger, fake.restoreQuarantinedRecordArgsForCall[i].id
+}
+
+func (fake *FakeQuarantineDB) RestoreQuarantinedRecordReturns(result1 error) {
+	fake.RestoreQuarantinedRecordStub = nil
+	fake.restoreQuarantinedRecordReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeQuarantineDB) RestoreQuarantinedRecords(logger lager.Logger, tableName string) ([]int64, []int64, error) {
+	fake.restoreQuarantinedRecordsMutex.Lock()
+	fake.restoreQuarantinedRecordsArgsForCall = append(fake.restoreQuarantinedRecordsArgsForCall, struct {
+		logger    lager.Logger
+		tableName string
+	}{logger, tableName})
+	fake.recordInvocation("RestoreQuarantinedRecords", []interface{}{logger, tableName})
+	fake.restoreQuarantinedRecordsMutex.Unlock()
+	if fake.RestoreQuarantinedRecordsStub != nil {
+		return fake.RestoreQuarantinedRecordsStub(logger, tableName)
+	} else {
+		return fake.restoreQuarantinedRecordsReturns.result1, fake.restoreQuarantinedRecordsReturns.result2, fake.restoreQuarantinedRecordsReturns.result3
+	}
+}
+
+func (fake *FakeQuarantineDB) RestoreQuarantinedRecordsCallCount() int {
+	fake.restoreQuarantinedRecordsMutex.RLock()
+	defer fake.restoreQuarantinedRecordsMutex.RUnlock()
+	return len(fake.restoreQuarantinedRecordsArgsForCall)
+}
+
+func (fake *FakeQuarantineDB) RestoreQuarantinedRecordsArgsForCall(i int) (lager.Logger, string) {
+	fake.restoreQuarantinedRecordsMutex.RLock()
+	defer fake.restoreQuarantinedRecordsMutex.RUnlock()
+	return fake.restoreQuarantinedRecordsArgsForCall[i].logger, fake.restoreQuarantinedRecordsArgsForCall[i].tableName
+}
+
+func (fake *FakeQuarantineDB) RestoreQuarantinedRecordsReturns(result1 []int64, result2 []int64, result3 error) {
+	fake.RestoreQuarantinedRecordsStub = nil
+	fake.restoreQuarantinedRecordsReturns = struct {
+		result1 []int64
+		result2 []int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeQuarantineDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.quarantinedRecordsMutex.RLock()
+	defer fake.quarantinedRecordsMutex.RUnlock()
+	fake.quarantinedRecordMutex.RLock()
+	defer fake.quarantinedRecordMutex.RUnlock()
+	fake.restoreQuarantinedRecordMutex.RLock()
+	defer fake.restoreQuarantinedRecordMutex.RUnlock()
+	fake.restoreQuarantinedRecordsMutex.RLock()
+	defer fake.restoreQuarantinedRecordsMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeQuarantineDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.QuarantineDB = new(FakeQuarantineDB)
@@ -0,0 +1,167 @@
+// This file was generated by counterfeiter
+package dbfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakeRecordEncodingDB struct {
+	RecordEncodingStub        func(logger lager.Logger) (string, error)
+	recordEncodingMutex       sync.RWMutex
+	recordEncodingArgsForCall []struct {
+		logger lager.Logger
+	}
+	recordEncodingReturns struct {
+		result1 string
+		result2 error
+	}
+	SetRecordEncodingStub        func(logger lager.Logger, encoding string) error
+	setRecordEncodingMutex       sync.RWMutex
+	setRecordEncodingArgsForCall []struct {
+		logger   lager.Logger
+		encoding string
+	}
+	setRecordEncodingReturns struct {
+		result1 error
+	}
+	PerformReEncodingStub        func(logger lager.Logger) error
+	performReEncodingMutex       sync.RWMutex
+	performReEncodingArgsForCall []struct {
+		logger lager.Logger
+	}
+	performReEncodingReturns struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRecordEncodingDB) RecordEncoding(logger lager.Logger) (string, error) {
+	fake.recordEncodingMutex.Lock()
+	fake.recordEncodingArgsForCall = append(fake.recordEncodingArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("RecordEncoding", []interface{}{logger})
+	fake.recordEncodingMutex.Unlock()
+	if fake.RecordEncodingStub != nil {
+		return fake.RecordEncodingStub(logger)
+	} else {
+		return fake.recordEncodingReturns.result1, fake.recordEncodingReturns.result2
+	}
+}
+
+func (fake *FakeRecordEncodingDB) RecordEncodingCallCount() int {
+	fake.recordEncodingMutex.RLock()
+	defer fake.recordEncodingMutex.RUnlock()
+	return len(fake.recordEncodingArgsForCall)
+}
+
+func (fake *FakeRecordEncodingDB) RecordEncodingArgsForCall(i int) lager.Logger {
+	fake.recordEncodingMutex.RLock()
+	defer fake.recordEncodingMutex.RUnlock()
+	return fake.recordEncodingArgsForCall[i].logger
+}
+
+func (fake *FakeRecordEncodingDB) RecordEncodingReturns(result1 string, result2 error) {
+	fake.RecordEncodingStub = nil
+	fake.recordEncodingReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRecordEncodingDB) SetRecordEncoding(logger lager.Logger, encoding string) error {
+	fake.setRecordEncodingMutex.Lock()
+	fake.setRecordEncodingArgsForCall = append(fake.setRecordEncodingArgsForCall, struct {
+		logger   lager.Logger
+		encoding string
+	}{logger, encoding})
+	fake.recordInvocation("SetRecordEncoding", []interface{}{logger, encoding})
+	fake.setRecordEncodingMutex.Unlock()
+	if fake.SetRecordEncodingStub != nil {
+		return fake.SetRecordEncodingStub(logger, encoding)
+	} else {
+		return fake.setRecordEncodingReturns.result1
+	}
+}
+
+func (fake *FakeRecordEncodingDB) SetRecordEncodingCallCount() int {
+	fake.setRecordEncodingMutex.RLock()
+	defer fake.setRecordEncodingMutex.RUnlock()
+	return len(fake.setRecordEncodingArgsForCall)
+}
+
+func (fake *FakeRecordEncodingDB) SetRecordEncodingArgsForCall(i int) (lager.Logger, string) {
+	fake.setRecordEncodingMutex.RLock()
+	defer fake.setRecordEncodingMutex.RUnlock()
+	return fake.setRecordEncodingArgsForCall[i].logger, fake.setRecordEncodingArgsForCall[i].encoding
+}
+
+func (fake *FakeRecordEncodingDB) SetRecordEncodingReturns(result1 error) {
+	fake.SetRecordEncodingStub = nil
+	fake.setRecordEncodingReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRecordEncodingDB) PerformReEncoding(logger lager.Logger) error {
+	fake.performReEncodingMutex.Lock()
+	fake.performReEncodingArgsForCall = append(fake.performReEncodingArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("PerformReEncoding", []interface{}{logger})
+	fake.performReEncodingMutex.Unlock()
+	if fake.PerformReEncodingStub != nil {
+		return fake.PerformReEncodingStub(logger)
+	} else {
+		return fake.performReEncodingReturns.result1
+	}
+}
+
+func (fake *FakeRecordEncodingDB) PerformReEncodingCallCount() int {
+	fake.performReEncodingMutex.RLock()
+	defer fake.performReEncodingMutex.RUnlock()
+	return len(fake.performReEncodingArgsForCall)
+}
+
+func (fake *FakeRecordEncodingDB) PerformReEncodingArgsForCall(i int) lager.Logger {
+	fake.performReEncodingMutex.RLock()
+	defer fake.performReEncodingMutex.RUnlock()
+	return fake.performReEncodingArgsForCall[i].logger
+}
+
+func (fake *FakeRecordEncodingDB) PerformReEncodingReturns(result1 error) {
+	fake.PerformReEncodingStub = nil
+	fake.performReEncodingReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRecordEncodingDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.recordEncodingMutex.RLock()
+	defer fake.recordEncodingMutex.RUnlock()
+	fake.setRecordEncodingMutex.RLock()
+	defer fake.setRecordEncodingMutex.RUnlock()
+	fake.performReEncodingMutex.RLock()
+	defer fake.performReEncodingMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeRecordEncodingDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.RecordEncodingDB = new(FakeRecordEncodingDB)
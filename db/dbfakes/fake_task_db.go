@@ -22,6 +22,17 @@ type FakeTaskDB struct {
 		result1 []*models.Task
 		result2 error
 	}
+	TasksPageStub        func(logger lager.Logger, filter models.TaskFilter) (tasks []*models.Task, nextPageToken string, err error)
+	tasksPageMutex       sync.RWMutex
+	tasksPageArgsForCall []struct {
+		logger lager.Logger
+		filter models.TaskFilter
+	}
+	tasksPageReturns struct {
+		result1 []*models.Task
+		result2 string
+		result3 error
+	}
 	TaskByGuidStub        func(logger lager.Logger, taskGuid string) (*models.Task, error)
 	taskByGuidMutex       sync.RWMutex
 	taskByGuidArgsForCall []struct {
@@ -32,7 +43,7 @@ type FakeTaskDB struct {
 		result1 *models.Task
 		result2 error
 	}
-	DesireTaskStub        func(logger lager.Logger, taskDefinition *models.TaskDefinition, taskGuid, domain string) error
+	DesireTaskStub        func(logger lager.Logger, taskDefinition *models.TaskDefinition, taskGuid, domain string) (*models.Task, error)
 	desireTaskMutex       sync.RWMutex
 	desireTaskArgsForCall []struct {
 		logger         lager.Logger
@@ -41,9 +52,10 @@ type FakeTaskDB struct {
 		domain         string
 	}
 	desireTaskReturns struct {
-		result1 error
+		result1 *models.Task
+		result2 error
 	}
-	StartTaskStub        func(logger lager.Logger, taskGuid, cellId string) (bool, error)
+	StartTaskStub        func(logger lager.Logger, taskGuid, cellId string) (before *models.Task, after *models.Task, shouldStart bool, err error)
 	startTaskMutex       sync.RWMutex
 	startTaskArgsForCall []struct {
 		logger   lager.Logger
@@ -51,10 +63,12 @@ type FakeTaskDB struct {
 		cellId   string
 	}
 	startTaskReturns struct {
-		result1 bool
-		result2 error
+		result1 *models.Task
+		result2 *models.Task
+		result3 bool
+		result4 error
 	}
-	CancelTaskStub        func(logger lager.Logger, taskGuid string) (task *models.Task, cellID string, err error)
+	CancelTaskStub        func(logger lager.Logger, taskGuid string) (before *models.Task, after *models.Task, cellID string, err error)
 	cancelTaskMutex       sync.RWMutex
 	cancelTaskArgsForCall []struct {
 		logger   lager.Logger
@@ -62,10 +76,11 @@ type FakeTaskDB struct {
 	}
 	cancelTaskReturns struct {
 		result1 *models.Task
-		result2 string
-		result3 error
+		result2 *models.Task
+		result3 string
+		result4 error
 	}
-	FailTaskStub        func(logger lager.Logger, taskGuid, failureReason string) (task *models.Task, err error)
+	FailTaskStub        func(logger lager.Logger, taskGuid, failureReason string) (before *models.Task, after *models.Task, err error)
 	failTaskMutex       sync.RWMutex
 	failTaskArgsForCall []struct {
 		logger        lager.Logger
@@ -74,9 +89,10 @@ type FakeTaskDB struct {
 	}
 	failTaskReturns struct {
 		result1 *models.Task
-		result2 error
+		result2 *models.Task
+		result3 error
 	}
-	CompleteTaskStub        func(logger lager.Logger, taskGuid, cellId string, failed bool, failureReason, result string) (task *models.Task, err error)
+	CompleteTaskStub        func(logger lager.Logger, taskGuid, cellId string, failed bool, failureReason, result string) (before *models.Task, after *models.Task, err error)
 	completeTaskMutex       sync.RWMutex
 	completeTaskArgsForCall []struct {
 		logger        lager.Logger
@@ -88,27 +104,31 @@ type FakeTaskDB struct {
 	}
 	completeTaskReturns struct {
 		result1 *models.Task
-		result2 error
+		result2 *models.Task
+		result3 error
 	}
-	ResolvingTaskStub        func(logger lager.Logger, taskGuid string) error
+	ResolvingTaskStub        func(logger lager.Logger, taskGuid string) (before *models.Task, after *models.Task, err error)
 	resolvingTaskMutex       sync.RWMutex
 	resolvingTaskArgsForCall []struct {
 		logger   lager.Logger
 		taskGuid string
 	}
 	resolvingTaskReturns struct {
-		result1 error
+		result1 *models.Task
+		result2 *models.Task
+		result3 error
 	}
-	DeleteTaskStub        func(logger lager.Logger, taskGuid string) error
+	DeleteTaskStub        func(logger lager.Logger, taskGuid string) (task *models.Task, err error)
 	deleteTaskMutex       sync.RWMutex
 	deleteTaskArgsForCall []struct {
 		logger   lager.Logger
 		taskGuid string
 	}
 	deleteTaskReturns struct {
-		result1 error
+		result1 *models.Task
+		result2 error
 	}
-	ConvergeTasksStub        func(logger lager.Logger, cellSet models.CellSet, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration time.Duration) (tasksToAuction []*auctioneer.TaskStartRequest, tasksToComplete []*models.Task)
+	ConvergeTasksStub        func(logger lager.Logger, cellSet models.CellSet, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration time.Duration) (tasksToAuction []*auctioneer.TaskStartRequest, tasksToComplete []*models.Task, events []models.Event)
 	convergeTasksMutex       sync.RWMutex
 	convergeTasksArgsForCall []struct {
 		logger                      lager.Logger
@@ -120,6 +140,7 @@ type FakeTaskDB struct {
 	convergeTasksReturns struct {
 		result1 []*auctioneer.TaskStartRequest
 		result2 []*models.Task
+		result3 []models.Event
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
@@ -160,6 +181,42 @@ func (fake *FakeTaskDB) TasksReturns(result1 []*models.Task, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeTaskDB) TasksPage(logger lager.Logger, filter models.TaskFilter) (tasks []*models.Task, nextPageToken string, err error) {
+	fake.tasksPageMutex.Lock()
+	fake.tasksPageArgsForCall = append(fake.tasksPageArgsForCall, struct {
+		logger lager.Logger
+		filter models.TaskFilter
+	}{logger, filter})
+	fake.recordInvocation("TasksPage", []interface{}{logger, filter})
+	fake.tasksPageMutex.Unlock()
+	if fake.TasksPageStub != nil {
+		return fake.TasksPageStub(logger, filter)
+	} else {
+		return fake.tasksPageReturns.result1, fake.tasksPageReturns.result2, fake.tasksPageReturns.result3
+	}
+}
+
+func (fake *FakeTaskDB) TasksPageCallCount() int {
+	fake.tasksPageMutex.RLock()
+	defer fake.tasksPageMutex.RUnlock()
+	return len(fake.tasksPageArgsForCall)
+}
+
+func (fake *FakeTaskDB) TasksPageArgsForCall(i int) (lager.Logger, models.TaskFilter) {
+	fake.tasksPageMutex.RLock()
+	defer fake.tasksPageMutex.RUnlock()
+	return fake.tasksPageArgsForCall[i].logger, fake.tasksPageArgsForCall[i].filter
+}
+
+func (fake *FakeTaskDB) TasksPageReturns(result1 []*models.Task, result2 string, result3 error) {
+	fake.TasksPageStub = nil
+	fake.tasksPageReturns = struct {
+		result1 []*models.Task
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTaskDB) TaskByGuid(logger lager.Logger, taskGuid string) (*models.Task, error) {
 	fake.taskByGuidMutex.Lock()
 	fake.taskByGuidArgsForCall = append(fake.taskByGuidArgsForCall, struct {
@@ -195,7 +252,7 @@ func (fake *FakeTaskDB) TaskByGuidReturns(result1 *models.Task, result2 error) {
 	}{result1, result2}
 }
 
-func (fake *FakeTaskDB) DesireTask(logger lager.Logger, taskDefinition *models.TaskDefinition, taskGuid string, domain string) error {
+func (fake *FakeTaskDB) DesireTask(logger lager.Logger, taskDefinition *models.TaskDefinition, taskGuid string, domain string) (*models.Task, error) {
 	fake.desireTaskMutex.Lock()
 	fake.desireTaskArgsForCall = append(fake.desireTaskArgsForCall, struct {
 		logger         lager.Logger
@@ -208,7 +265,7 @@ func (fake *FakeTaskDB) DesireTask(logger lager.Logger, taskDefinition *models.T
 	if fake.DesireTaskStub != nil {
 		return fake.DesireTaskStub(logger, taskDefinition, taskGuid, domain)
 	} else {
-		return fake.desireTaskReturns.result1
+		return fake.desireTaskReturns.result1, fake.desireTaskReturns.result2
 	}
 }
 
@@ -224,14 +281,15 @@ func (fake *FakeTaskDB) DesireTaskArgsForCall(i int) (lager.Logger, *models.Task
 	return fake.desireTaskArgsForCall[i].logger, fake.desireTaskArgsForCall[i].taskDefinition, fake.desireTaskArgsForCall[i].taskGuid, fake.desireTaskArgsForCall[i].domain
 }
 
-func (fake *FakeTaskDB) DesireTaskReturns(result1 error) {
+func (fake *FakeTaskDB) DesireTaskReturns(result1 *models.Task, result2 error) {
 	fake.DesireTaskStub = nil
 	fake.desireTaskReturns = struct {
-		result1 error
-	}{result1}
+		result1 *models.Task
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeTaskDB) StartTask(logger lager.Logger, taskGuid string, cellId string) (bool, error) {
+func (fake *FakeTaskDB) StartTask(logger lager.Logger, taskGuid string, cellId string) (before *models.Task, after *models.Task, shouldStart bool, err error) {
 	fake.startTaskMutex.Lock()
 	fake.startTaskArgsForCall = append(fake.startTaskArgsForCall, struct {
 		logger   lager.Logger
@@ -243,7 +301,7 @@ func (fake *FakeTaskDB) StartTask(logger lager.Logger, taskGuid string, cellId s
 	if fake.StartTaskStub != nil {
 		return fake.StartTaskStub(logger, taskGuid, cellId)
 	} else {
-		return fake.startTaskReturns.result1, fake.startTaskReturns.result2
+		return fake.startTaskReturns.result1, fake.startTaskReturns.result2, fake.startTaskReturns.result3, fake.startTaskReturns.result4
 	}
 }
 
@@ -259,15 +317,17 @@ func (fake *FakeTaskDB) StartTaskArgsForCall(i int) (lager.Logger, string, strin
 	return fake.startTaskArgsForCall[i].logger, fake.startTaskArgsForCall[i].taskGuid, fake.startTaskArgsForCall[i].cellId
 }
 
-func (fake *FakeTaskDB) StartTaskReturns(result1 bool, result2 error) {
+func (fake *FakeTaskDB) StartTaskReturns(result1 *models.Task, result2 *models.Task, result3 bool, result4 error) {
 	fake.StartTaskStub = nil
 	fake.startTaskReturns = struct {
-		result1 bool
-		result2 error
-	}{result1, result2}
+		result1 *models.Task
+		result2 *models.Task
+		result3 bool
+		result4 error
+	}{result1, result2, result3, result4}
 }
 
-func (fake *FakeTaskDB) CancelTask(logger lager.Logger, taskGuid string) (task *models.Task, cellID string, err error) {
+func (fake *FakeTaskDB) CancelTask(logger lager.Logger, taskGuid string) (before *models.Task, after *models.Task, cellID string, err error) {
 	fake.cancelTaskMutex.Lock()
 	fake.cancelTaskArgsForCall = append(fake.cancelTaskArgsForCall, struct {
 		logger   lager.Logger
@@ -278,7 +338,7 @@ func (fake *FakeTaskDB) CancelTask(logger lager.Logger, taskGuid string) (task *
 	if fake.CancelTaskStub != nil {
 		return fake.CancelTaskStub(logger, taskGuid)
 	} else {
-		return fake.cancelTaskReturns.result1, fake.cancelTaskReturns.result2, fake.cancelTaskReturns.result3
+		return fake.cancelTaskReturns.result1, fake.cancelTaskReturns.result2, fake.cancelTaskReturns.result3, fake.cancelTaskReturns.result4
 	}
 }
 
@@ -294,16 +354,17 @@ func (fake *FakeTaskDB) CancelTaskArgsForCall(i int) (lager.Logger, string) {
 	return fake.cancelTaskArgsForCall[i].logger, fake.cancelTaskArgsForCall[i].taskGuid
 }
 
-func (fake *FakeTaskDB) CancelTaskReturns(result1 *models.Task, result2 string, result3 error) {
+func (fake *FakeTaskDB) CancelTaskReturns(result1 *models.Task, result2 *models.Task, result3 string, result4 error) {
 	fake.CancelTaskStub = nil
 	fake.cancelTaskReturns = struct {
 		result1 *models.Task
-		result2 string
-		result3 error
-	}{result1, result2, result3}
+		result2 *models.Task
+		result3 string
+		result4 error
+	}{result1, result2, result3, result4}
 }
 
-func (fake *FakeTaskDB) FailTask(logger lager.Logger, taskGuid string, failureReason string) (task *models.Task, err error) {
+func (fake *FakeTaskDB) FailTask(logger lager.Logger, taskGuid string, failureReason string) (before *models.Task, after *models.Task, err error) {
 	fake.failTaskMutex.Lock()
 	fake.failTaskArgsForCall = append(fake.failTaskArgsForCall, struct {
 		logger        lager.Logger
@@ -315,7 +376,7 @@ func (fake *FakeTaskDB) FailTask(logger lager.Logger, taskGuid string, failureRe
 	if fake.FailTaskStub != nil {
 		return fake.FailTaskStub(logger, taskGuid, failureReason)
 	} else {
-		return fake.failTaskReturns.result1, fake.failTaskReturns.result2
+		return fake.failTaskReturns.result1, fake.failTaskReturns.result2, fake.failTaskReturns.result3
 	}
 }
 
@@ -331,15 +392,16 @@ func (fake *FakeTaskDB) FailTaskArgsForCall(i int) (lager.Logger, string, string
 	return fake.failTaskArgsForCall[i].logger, fake.failTaskArgsForCall[i].taskGuid, fake.failTaskArgsForCall[i].failureReason
 }
 
-func (fake *FakeTaskDB) FailTaskReturns(result1 *models.Task, result2 error) {
+func (fake *FakeTaskDB) FailTaskReturns(result1 *models.Task, result2 *models.Task, result3 error) {
 	fake.FailTaskStub = nil
 	fake.failTaskReturns = struct {
 		result1 *models.Task
-		result2 error
-	}{result1, result2}
+		result2 *models.Task
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeTaskDB) CompleteTask(logger lager.Logger, taskGuid string, cellId string, failed bool, failureReason string, result string) (task *models.Task, err error) {
+func (fake *FakeTaskDB) CompleteTask(logger lager.Logger, taskGuid string, cellId string, failed bool, failureReason string, result string) (before *models.Task, after *models.Task, err error) {
 	fake.completeTaskMutex.Lock()
 	fake.completeTaskArgsForCall = append(fake.completeTaskArgsForCall, struct {
 		logger        lager.Logger
@@ -354,7 +416,7 @@ func (fake *FakeTaskDB) CompleteTask(logger lager.Logger, taskGuid string, cellI
 	if fake.CompleteTaskStub != nil {
 		return fake.CompleteTaskStub(logger, taskGuid, cellId, failed, failureReason, result)
 	} else {
-		return fake.completeTaskReturns.result1, fake.completeTaskReturns.result2
+		return fake.completeTaskReturns.result1, fake.completeTaskReturns.result2, fake.completeTaskReturns.result3
 	}
 }
 
@@ -370,15 +432,16 @@ func (fake *FakeTaskDB) CompleteTaskArgsForCall(i int) (lager.Logger, string, st
 	return fake.completeTaskArgsForCall[i].logger, fake.completeTaskArgsForCall[i].taskGuid, fake.completeTaskArgsForCall[i].cellId, fake.completeTaskArgsForCall[i].failed, fake.completeTaskArgsForCall[i].failureReason, fake.completeTaskArgsForCall[i].result
 }
 
-func (fake *FakeTaskDB) CompleteTaskReturns(result1 *models.Task, result2 error) {
+func (fake *FakeTaskDB) CompleteTaskReturns(result1 *models.Task, result2 *models.Task, result3 error) {
 	fake.CompleteTaskStub = nil
 	fake.completeTaskReturns = struct {
 		result1 *models.Task
-		result2 error
-	}{result1, result2}
+		result2 *models.Task
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeTaskDB) ResolvingTask(logger lager.Logger, taskGuid string) error {
+func (fake *FakeTaskDB) ResolvingTask(logger lager.Logger, taskGuid string) (before *models.Task, after *models.Task, err error) {
 	fake.resolvingTaskMutex.Lock()
 	fake.resolvingTaskArgsForCall = append(fake.resolvingTaskArgsForCall, struct {
 		logger   lager.Logger
@@ -389,7 +452,7 @@ func (fake *FakeTaskDB) ResolvingTask(logger lager.Logger, taskGuid string) erro
 	if fake.ResolvingTaskStub != nil {
 		return fake.ResolvingTaskStub(logger, taskGuid)
 	} else {
-		return fake.resolvingTaskReturns.result1
+		return fake.resolvingTaskReturns.result1, fake.resolvingTaskReturns.result2, fake.resolvingTaskReturns.result3
 	}
 }
 
@@ -405,14 +468,16 @@ func (fake *FakeTaskDB) ResolvingTaskArgsForCall(i int) (lager.Logger, string) {
 	return fake.resolvingTaskArgsForCall[i].logger, fake.resolvingTaskArgsForCall[i].taskGuid
 }
 
-func (fake *FakeTaskDB) ResolvingTaskReturns(result1 error) {
+func (fake *FakeTaskDB) ResolvingTaskReturns(result1 *models.Task, result2 *models.Task, result3 error) {
 	fake.ResolvingTaskStub = nil
 	fake.resolvingTaskReturns = struct {
-		result1 error
-	}{result1}
+		result1 *models.Task
+		result2 *models.Task
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeTaskDB) DeleteTask(logger lager.Logger, taskGuid string) error {
+func (fake *FakeTaskDB) DeleteTask(logger lager.Logger, taskGuid string) (task *models.Task, err error) {
 	fake.deleteTaskMutex.Lock()
 	fake.deleteTaskArgsForCall = append(fake.deleteTaskArgsForCall, struct {
 		logger   lager.Logger
@@ -423,7 +488,7 @@ func (fake *FakeTaskDB) DeleteTask(logger lager.Logger, taskGuid string) error {
 	if fake.DeleteTaskStub != nil {
 		return fake.DeleteTaskStub(logger, taskGuid)
 	} else {
-		return fake.deleteTaskReturns.result1
+		return fake.deleteTaskReturns.result1, fake.deleteTaskReturns.result2
 	}
 }
 
@@ -439,14 +504,15 @@ func (fake *FakeTaskDB) DeleteTaskArgsForCall(i int) (lager.Logger, string) {
 	return fake.deleteTaskArgsForCall[i].logger, fake.deleteTaskArgsForCall[i].taskGuid
 }
 
-func (fake *FakeTaskDB) DeleteTaskReturns(result1 error) {
+func (fake *FakeTaskDB) DeleteTaskReturns(result1 *models.Task, result2 error) {
 	fake.DeleteTaskStub = nil
 	fake.deleteTaskReturns = struct {
-		result1 error
-	}{result1}
+		result1 *models.Task
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeTaskDB) ConvergeTasks(logger lager.Logger, cellSet models.CellSet, kickTaskDuration time.Duration, expirePendingTaskDuration time.Duration, expireCompletedTaskDuration time.Duration) (tasksToAuction []*auctioneer.TaskStartRequest, tasksToComplete []*models.Task) {
+func (fake *FakeTaskDB) ConvergeTasks(logger lager.Logger, cellSet models.CellSet, kickTaskDuration time.Duration, expirePendingTaskDuration time.Duration, expireCompletedTaskDuration time.Duration) (tasksToAuction []*auctioneer.TaskStartRequest, tasksToComplete []*models.Task, events []models.Event) {
 	fake.convergeTasksMutex.Lock()
 	fake.convergeTasksArgsForCall = append(fake.convergeTasksArgsForCall, struct {
 		logger                      lager.Logger
@@ -460,7 +526,7 @@ func (fake *FakeTaskDB) ConvergeTasks(logger lager.Logger, cellSet models.CellSe
 	if fake.ConvergeTasksStub != nil {
 		return fake.ConvergeTasksStub(logger, cellSet, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration)
 	} else {
-		return fake.convergeTasksReturns.result1, fake.convergeTasksReturns.result2
+		return fake.convergeTasksReturns.result1, fake.convergeTasksReturns.result2, fake.convergeTasksReturns.result3
 	}
 }
 
@@ -476,12 +542,13 @@ func (fake *FakeTaskDB) ConvergeTasksArgsForCall(i int) (lager.Logger, models.Ce
 	return fake.convergeTasksArgsForCall[i].logger, fake.convergeTasksArgsForCall[i].cellSet, fake.convergeTasksArgsForCall[i].kickTaskDuration, fake.convergeTasksArgsForCall[i].expirePendingTaskDuration, fake.convergeTasksArgsForCall[i].expireCompletedTaskDuration
 }
 
-func (fake *FakeTaskDB) ConvergeTasksReturns(result1 []*auctioneer.TaskStartRequest, result2 []*models.Task) {
+func (fake *FakeTaskDB) ConvergeTasksReturns(result1 []*auctioneer.TaskStartRequest, result2 []*models.Task, result3 []models.Event) {
 	fake.ConvergeTasksStub = nil
 	fake.convergeTasksReturns = struct {
 		result1 []*auctioneer.TaskStartRequest
 		result2 []*models.Task
-	}{result1, result2}
+		result3 []models.Event
+	}{result1, result2, result3}
 }
 
 func (fake *FakeTaskDB) Invocations() map[string][][]interface{} {
@@ -489,6 +556,8 @@ func (fake *FakeTaskDB) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.tasksMutex.RLock()
 	defer fake.tasksMutex.RUnlock()
+	fake.tasksPageMutex.RLock()
+	defer fake.tasksPageMutex.RUnlock()
 	fake.taskByGuidMutex.RLock()
 	defer fake.taskByGuidMutex.RUnlock()
 	fake.desireTaskMutex.RLock()
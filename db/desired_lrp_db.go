@@ -9,11 +9,25 @@ import (
 
 type DesiredLRPDB interface {
 	DesiredLRPs(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, error)
+	// DesiredLRPsPage returns a page of DesiredLRPs along with the token for
+	// the next page, which is empty once the last page has been returned.
+	DesiredLRPsPage(logger lager.Logger, filter models.DesiredLRPFilter) (desiredLRPs []*models.DesiredLRP, nextPageToken string, err error)
 	DesiredLRPByProcessGuid(logger lager.Logger, processGuid string) (*models.DesiredLRP, error)
 
 	DesiredLRPSchedulingInfos(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, error)
 
 	DesireLRP(logger lager.Logger, desiredLRP *models.DesiredLRP) error
-	UpdateDesiredLRP(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) (beforeDesiredLRP *models.DesiredLRP, err error)
-	RemoveDesiredLRP(logger lager.Logger, processGuid string) error
+
+	// UpdateDesiredLRP and RemoveDesiredLRP return ErrResourceConflict if
+	// expectedTag is non-nil and does not match the stored ModificationTag.
+	UpdateDesiredLRP(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate, expectedTag *models.ModificationTag) (beforeDesiredLRP *models.DesiredLRP, err error)
+	RemoveDesiredLRP(logger lager.Logger, processGuid string, expectedTag *models.ModificationTag) error
+
+	// UpdateDesiredLRPRunInfo replaces the run definition and, if resource is
+	// non-nil, the resource requirements of the DesiredLRP, keeping its key.
+	UpdateDesiredLRPRunInfo(logger lager.Logger, processGuid string, runInfo *models.DesiredLRPRunInfo, resource *models.DesiredLRPResource) (beforeDesiredLRP *models.DesiredLRP, err error)
+
+	// ApplyDesiredLRPs creates, replaces and removes DesiredLRPs so that the
+	// domain contains exactly desiredLRPs, and refreshes the domain's ttl.
+	ApplyDesiredLRPs(logger lager.Logger, domain string, desiredLRPs []*models.DesiredLRP, ttl uint32) (*models.DesiredLRPApplyResult, error)
 }
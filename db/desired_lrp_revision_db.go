@@ -0,0 +1,17 @@
+package db
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter . DesiredLRPRevisionDB
+
+// DesiredLRPRevisionDB reads the numbered revisions recorded for every change
+// to a DesiredLRP and rolls it back to them. It is only implemented by the SQL
+// backend.
+type DesiredLRPRevisionDB interface {
+	DesiredLRPRevisions(logger lager.Logger, processGuid string) ([]*models.DesiredLRPRevision, error)
+	DesiredLRPRevision(logger lager.Logger, processGuid string, revision int64) (*models.DesiredLRPRevision, error)
+	RollbackDesiredLRP(logger lager.Logger, processGuid string, revision int64, expectedTag *models.ModificationTag) (before *models.DesiredLRP, after *models.DesiredLRP, err error)
+}
@@ -0,0 +1,26 @@
+package db
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter . DesiredLRPRolloutDB
+
+// DesiredLRPRolloutDB records the progress of rolling redeploys so that the
+// lock holder can resume them after a restart.
+type DesiredLRPRolloutDB interface {
+	DesiredLRPRollouts(logger lager.Logger) ([]*models.DesiredLRPRollout, error)
+	DesiredLRPRollout(logger lager.Logger, processGuid string) (*models.DesiredLRPRollout, error)
+
+	// UpsertDesiredLRPRollout replaces any rollout recorded for the process
+	// guid, superseding a rollout that may still be in progress.
+	UpsertDesiredLRPRollout(logger lager.Logger, rollout *models.DesiredLRPRollout) error
+
+	// UpdateDesiredLRPRollout saves the progress of the rollout. It fails with
+	// ErrResourceNotFound once the rollout has been removed or superseded by a
+	// rollout of a later modification tag.
+	UpdateDesiredLRPRollout(logger lager.Logger, rollout *models.DesiredLRPRollout) error
+
+	RemoveDesiredLRPRollout(logger lager.Logger, processGuid string) error
+}
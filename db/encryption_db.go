@@ -1,6 +1,9 @@
 package db
 
-import "code.cloudfoundry.org/lager"
+import (
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
 
 //go:generate counterfeiter . EncryptionDB
 
@@ -9,3 +12,29 @@ type EncryptionDB interface {
 	SetEncryptionKeyLabel(logger lager.Logger, encryptionKeyLabel string) error
 	PerformEncryption(logger lager.Logger) error
 }
+
+//go:generate counterfeiter . EncryptionProgressDB
+
+// EncryptionProgressDB re-encrypts the records one table and one batch at a
+// time, checkpointing after every batch so that a pass interrupted by a
+// restart resumes where it stopped. Quarantined rows are not re-encrypted,
+// but are counted by the key they are encrypted with so that a key is not
+// retired while they still need it. It is only implemented by the SQL
+// backend.
+type EncryptionProgressDB interface {
+	EncryptionProgress(logger lager.Logger) ([]*models.EncryptionTableProgress, error)
+	EncryptionCheckpoints(logger lager.Logger) ([]*models.EncryptionTableProgress, error)
+	ReEncryptBatch(logger lager.Logger, tableName, keyLabel string, batchSize int) (*models.EncryptionTableProgress, error)
+	QuarantinedRowsByKeyLabel(logger lager.Logger) ([]*models.EncryptionKeyRows, error)
+}
+
+//go:generate counterfeiter . RecordEncodingDB
+
+// RecordEncodingDB rewrites the records with the encoding the BBS is
+// configured with, e.g. to compress them. It is only implemented by the SQL
+// backend.
+type RecordEncodingDB interface {
+	RecordEncoding(logger lager.Logger) (string, error)
+	SetRecordEncoding(logger lager.Logger, encoding string) error
+	PerformReEncoding(logger lager.Logger) error
+}
@@ -47,6 +47,37 @@ var _ = Describe("CrashActualLRP", func() {
 		var crashTest = t
 		crashTest.Test()
 	}
+
+	Context("when the desired lrp has a restart policy", func() {
+		var actualLRP models.ActualLRP
+
+		BeforeEach(func() {
+			actualLRP = lrpForState(models.ActualLRPStateRunning, time.Minute)
+
+			desiredLRP := models.DesiredLRP{
+				ProcessGuid:   actualLRP.ProcessGuid,
+				Domain:        actualLRP.Domain,
+				Instances:     actualLRP.Index + 1,
+				RootFs:        "foo:bar",
+				Action:        models.WrapAction(&models.RunAction{Path: "true", User: "me"}),
+				RestartPolicy: &models.RestartPolicy{NeverRestart: true},
+			}
+
+			etcdHelper.SetRawDesiredLRP(&desiredLRP)
+			etcdHelper.SetRawActualLRP(&actualLRP)
+		})
+
+		It("uses the policy to decide whether to restart", func() {
+			_, _, shouldRestart, err := etcdDB.CrashActualLRP(logger, &actualLRP.ActualLRPKey, &actualLRP.ActualLRPInstanceKey, "crashed")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(shouldRestart).To(BeFalse())
+
+			lrp, err := etcdHelper.GetInstanceActualLRP(&actualLRP.ActualLRPKey)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lrp.State).To(Equal(models.ActualLRPStateCrashed))
+			Expect(lrp.CrashCount).To(BeEquivalentTo(1))
+		})
+	})
 })
 
 func resetOnlyRunningLRPsThatHaveNotCrashedRecently() []crashTest {
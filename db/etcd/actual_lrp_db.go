@@ -20,16 +20,21 @@ const (
 )
 
 func (db *ETCDDB) ActualLRPGroups(logger lager.Logger, filter models.ActualLRPFilter) ([]*models.ActualLRPGroup, error) {
+	groups, _, err := db.ActualLRPGroupsPage(logger, filter)
+	return groups, err
+}
+
+func (db *ETCDDB) ActualLRPGroupsPage(logger lager.Logger, filter models.ActualLRPFilter) ([]*models.ActualLRPGroup, string, error) {
 	node, err := db.fetchRecursiveRaw(logger, ActualLRPSchemaRoot)
 	bbsErr := models.ConvertError(err)
 	if bbsErr != nil {
 		if bbsErr.Type == models.Error_ResourceNotFound {
-			return []*models.ActualLRPGroup{}, nil
+			return []*models.ActualLRPGroup{}, "", nil
 		}
-		return nil, err
+		return nil, "", err
 	}
 	if len(node.Nodes) == 0 {
-		return []*models.ActualLRPGroup{}, nil
+		return []*models.ActualLRPGroup{}, "", nil
 	}
 
 	groups := []*models.ActualLRPGroup{}
@@ -65,11 +70,12 @@ func (db *ETCDDB) ActualLRPGroups(logger lager.Logger, filter models.ActualLRPFi
 
 	if err, ok := workErr.Load().(error); ok {
 		logger.Error("failed-performing-deserialization-work", err)
-		return []*models.ActualLRPGroup{}, models.ErrUnknownError
+		return []*models.ActualLRPGroup{}, "", models.ErrUnknownError
 	}
 	logger.Debug("succeeded-performing-deserialization-work", lager.Data{"num_actual_lrp_groups": len(groups)})
 
-	return groups, nil
+	groups, nextPageToken := pageActualLRPGroups(groups, filter)
+	return groups, nextPageToken, nil
 }
 
 func (db *ETCDDB) ActualLRPGroupsByProcessGuid(logger lager.Logger, processGuid string) ([]*models.ActualLRPGroup, error) {
@@ -273,8 +279,17 @@ func (db *ETCDDB) CrashActualLRP(logger lager.Logger, key *models.ActualLRPKey,
 	lrp.ModificationTag.Increment()
 	lrp.CrashReason = errorMessage
 
+	var restartPolicy *models.RestartPolicy
+	schedulingInfo, _, err := db.rawDesiredLRPSchedulingInfo(logger, key.ProcessGuid)
+	if err == nil {
+		restartPolicy = schedulingInfo.RestartPolicy
+	} else if err != models.ErrResourceNotFound {
+		logger.Error("failed-to-get-desired-lrp-scheduling-info", err)
+		return nil, nil, false, err
+	}
+
 	var immediateRestart bool
-	if lrp.ShouldRestartImmediately(models.NewDefaultRestartCalculator()) {
+	if lrp.ShouldRestartImmediately(restartPolicy.RestartCalculator()) {
 		lrp.State = models.ActualLRPStateUnclaimed
 		immediateRestart = true
 	}
@@ -370,6 +385,9 @@ func (db *ETCDDB) parseActualLRPGroups(logger lager.Logger, node *etcd.Node, fil
 			if filter.Domain != "" && lrp.Domain != filter.Domain {
 				continue
 			}
+			if !domainAllowed(filter.AllowedDomains, lrp.Domain) {
+				continue
+			}
 			if filter.CellID != "" && lrp.CellId != filter.CellID {
 				continue
 			}
@@ -34,6 +34,11 @@ func (g guidSet) ToMap() map[string]struct{} {
 }
 
 func (db *ETCDDB) DesiredLRPs(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, error) {
+	desireds, _, err := db.DesiredLRPsPage(logger, filter)
+	return desireds, err
+}
+
+func (db *ETCDDB) DesiredLRPsPage(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, string, error) {
 	logger = logger.WithData(lager.Data{"filter": filter})
 	logger.Info("start")
 	defer logger.Info("complete")
@@ -41,8 +46,11 @@ func (db *ETCDDB) DesiredLRPs(logger lager.Logger, filter models.DesiredLRPFilte
 	desireds, _, err := db.desiredLRPs(logger, filter)
 	if err != nil {
 		logger.Error("failed", err)
+		return desireds, "", err
 	}
-	return desireds, err
+
+	desireds, nextPageToken := pageDesiredLRPs(desireds, filter)
+	return desireds, nextPageToken, nil
 }
 
 func (db *ETCDDB) DesiredLRPSchedulingInfos(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, error) {
@@ -133,7 +141,7 @@ func (db *ETCDDB) deserializeScheduleInfos(logger lager.Logger, nodes etcd.Nodes
 			malformedModels.Add(model.ProcessGuid)
 			continue
 		}
-		if filter.Domain == "" || model.Domain == filter.Domain {
+		if (filter.Domain == "" || model.Domain == filter.Domain) && domainAllowed(filter.AllowedDomains, model.Domain) {
 			components[model.ProcessGuid] = model
 		}
 	}
@@ -156,7 +164,7 @@ func (db *ETCDDB) deserializeRunInfos(logger lager.Logger, nodes etcd.Nodes, fil
 			malformedModels.Add(model.ProcessGuid)
 			continue
 		}
-		if filter.Domain == "" || model.Domain == filter.Domain {
+		if (filter.Domain == "" || model.Domain == filter.Domain) && domainAllowed(filter.AllowedDomains, model.Domain) {
 			components[model.ProcessGuid] = model
 		}
 	}
@@ -264,6 +272,15 @@ func (db *ETCDDB) DesireLRP(logger lager.Logger, desiredLRP *models.DesiredLRP)
 	return nil
 }
 
+// ErrApplyDesiredLRPsUnsupported is returned by ApplyDesiredLRPs, as etcd
+// cannot apply the changes to a domain in a single transaction.
+var ErrApplyDesiredLRPsUnsupported = models.NewError(models.Error_InvalidRequest, "applying the desired lrps of a domain requires a SQL database")
+
+func (db *ETCDDB) ApplyDesiredLRPs(logger lager.Logger, domain string, desiredLRPs []*models.DesiredLRP, ttl uint32) (*models.DesiredLRPApplyResult, error) {
+	logger.Session("apply-desired-lrps", lager.Data{"domain": domain}).Info("unsupported")
+	return nil, ErrApplyDesiredLRPsUnsupported
+}
+
 func (db *ETCDDB) createDesiredLRPSchedulingInfo(logger lager.Logger, schedulingInfo *models.DesiredLRPSchedulingInfo) error {
 	epochGuid, err := uuid.NewV4()
 	if err != nil {
@@ -321,7 +338,23 @@ func (db *ETCDDB) createDesiredLRPRunInfo(logger lager.Logger, runInfo *models.D
 	return nil
 }
 
-func (db *ETCDDB) UpdateDesiredLRP(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) (*models.DesiredLRP, error) {
+func (db *ETCDDB) updateDesiredLRPRunInfo(logger lager.Logger, runInfo *models.DesiredLRPRunInfo) error {
+	serializedRunInfo, err := db.serializeModel(logger, runInfo)
+	if err != nil {
+		logger.Error("failed-to-serialize", err)
+		return err
+	}
+
+	_, err = db.client.Set(DesiredLRPRunInfoSchemaPath(runInfo.ProcessGuid), serializedRunInfo, NO_TTL)
+	if err != nil {
+		logger.Error("failed-persisting-run-info", err)
+		return ErrorFromEtcdError(logger, err)
+	}
+
+	return nil
+}
+
+func (db *ETCDDB) UpdateDesiredLRP(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate, expectedTag *models.ModificationTag) (*models.DesiredLRP, error) {
 	logger.Info("starting")
 	defer logger.Info("complete")
 
@@ -338,6 +371,12 @@ func (db *ETCDDB) UpdateDesiredLRP(logger lager.Logger, processGuid string, upda
 			break
 		}
 
+		if expectedTag != nil && !expectedTag.Equal(beforeDesiredLRP.ModificationTag) {
+			logger.Info("stale-modification-tag", lager.Data{"expected": expectedTag, "actual": beforeDesiredLRP.ModificationTag})
+			err = models.ErrResourceConflict
+			break
+		}
+
 		schedulingInfoValue := beforeDesiredLRP.DesiredLRPSchedulingInfo()
 		schedulingInfo = &schedulingInfoValue
 		schedulingInfo.ApplyUpdate(update)
@@ -363,18 +402,82 @@ func (db *ETCDDB) UpdateDesiredLRP(logger lager.Logger, processGuid string, upda
 	return beforeDesiredLRP, nil
 }
 
+// UpdateDesiredLRPRunInfo replaces the DesiredLRPRunInfo before swapping in the
+// updated DesiredLRPSchedulingInfo, so that watchers of the scheduling info
+// always see the new run definition.
+func (db *ETCDDB) UpdateDesiredLRPRunInfo(logger lager.Logger, processGuid string, runInfo *models.DesiredLRPRunInfo, resource *models.DesiredLRPResource) (*models.DesiredLRP, error) {
+	logger = logger.WithData(lager.Data{"process_guid": processGuid})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	var err error
+	var beforeDesiredLRP *models.DesiredLRP
+
+	for i := 0; i < 2; i++ {
+		var index uint64
+
+		beforeDesiredLRP, index, err = db.rawDesiredLRPByProcessGuid(logger, processGuid)
+		if err != nil {
+			logger.Error("failed-to-fetch-desired-lrp", err)
+			break
+		}
+
+		schedulingInfo := beforeDesiredLRP.DesiredLRPSchedulingInfo()
+		schedulingInfo.ApplyRedeploy(runInfo, resource)
+
+		newRunInfo := *runInfo
+		newRunInfo.DesiredLRPKey = schedulingInfo.DesiredLRPKey
+		newRunInfo.CreatedAt = db.clock.Now().UnixNano()
+
+		err = db.updateDesiredLRPRunInfo(logger, &newRunInfo)
+		if err != nil {
+			break
+		}
+
+		err = db.updateDesiredLRPSchedulingInfo(logger, &schedulingInfo, index)
+		if err != nil {
+			logger.Error("update-scheduling-info-failed", err)
+			modelErr := models.ConvertError(err)
+			if modelErr != models.ErrResourceConflict {
+				break
+			}
+			// Retry on CAS fail
+			continue
+		}
+
+		break
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return beforeDesiredLRP, nil
+}
+
 // RemoveDesiredLRP deletes the DesiredLRPSchedulingInfo and the DesiredLRPRunInfo
 // from the database. We delete DesiredLRPSchedulingInfo first because the system
 // uses it to determine wheter the lrp is present. In the event that only the
 // RunInfo fails to delete, the orphaned DesiredLRPRunInfo will be garbage
-// collected later by convergence.
-func (db *ETCDDB) RemoveDesiredLRP(logger lager.Logger, processGuid string) error {
+// collected later by convergence. When an expected ModificationTag is given,
+// the DesiredLRPSchedulingInfo is only deleted if it has not been modified
+// since it was checked.
+func (db *ETCDDB) RemoveDesiredLRP(logger lager.Logger, processGuid string, expectedTag *models.ModificationTag) error {
 	logger = logger.WithData(lager.Data{"process_guid": processGuid})
 	logger.Info("starting")
 	defer logger.Info("complete")
 
-	_, schedulingInfoErr := db.client.Delete(DesiredLRPSchedulingInfoSchemaPath(processGuid), true)
-	schedulingInfoErr = ErrorFromEtcdError(logger, schedulingInfoErr)
+	var schedulingInfoErr error
+	if expectedTag == nil {
+		_, schedulingInfoErr = db.client.Delete(DesiredLRPSchedulingInfoSchemaPath(processGuid), true)
+		schedulingInfoErr = ErrorFromEtcdError(logger, schedulingInfoErr)
+	} else {
+		schedulingInfoErr = db.compareAndDeleteDesiredLRPSchedulingInfo(logger, processGuid, expectedTag)
+		if schedulingInfoErr == models.ErrResourceNotFound {
+			return schedulingInfoErr
+		}
+	}
+
 	if schedulingInfoErr != nil && schedulingInfoErr != models.ErrResourceNotFound {
 		logger.Error("failed-deleting-scheduling-info", schedulingInfoErr)
 		return schedulingInfoErr
@@ -394,3 +497,19 @@ func (db *ETCDDB) RemoveDesiredLRP(logger lager.Logger, processGuid string) erro
 
 	return nil
 }
+
+func (db *ETCDDB) compareAndDeleteDesiredLRPSchedulingInfo(logger lager.Logger, processGuid string, expectedTag *models.ModificationTag) error {
+	schedulingInfo, index, err := db.rawDesiredLRPSchedulingInfo(logger, processGuid)
+	if err != nil {
+		logger.Error("failed-to-fetch-scheduling-info", err)
+		return err
+	}
+
+	if !expectedTag.Equal(schedulingInfo.ModificationTag) {
+		logger.Info("stale-modification-tag", lager.Data{"expected": expectedTag, "actual": schedulingInfo.ModificationTag})
+		return models.ErrResourceConflict
+	}
+
+	_, err = db.client.CompareAndDelete(DesiredLRPSchedulingInfoSchemaPath(processGuid), index)
+	return ErrorFromEtcdError(logger, err)
+}
@@ -324,7 +324,7 @@ var _ = Describe("DesiredLRPDB", func() {
 			})
 
 			It("should delete it", func() {
-				err := etcdDB.RemoveDesiredLRP(logger, lrp.ProcessGuid)
+				err := etcdDB.RemoveDesiredLRP(logger, lrp.ProcessGuid, nil)
 				Expect(err).NotTo(HaveOccurred())
 
 				_, err = etcdDB.DesiredLRPByProcessGuid(logger, lrp.ProcessGuid)
@@ -342,7 +342,7 @@ var _ = Describe("DesiredLRPDB", func() {
 			})
 
 			It("deletes the RunInfo", func() {
-				err := etcdDB.RemoveDesiredLRP(logger, lrp.ProcessGuid)
+				err := etcdDB.RemoveDesiredLRP(logger, lrp.ProcessGuid, nil)
 				Expect(err).ToNot(HaveOccurred())
 				_, err = etcdDB.DesiredLRPByProcessGuid(logger, lrp.ProcessGuid)
 				Expect(err).To(Equal(models.ErrResourceNotFound))
@@ -360,7 +360,7 @@ var _ = Describe("DesiredLRPDB", func() {
 			})
 
 			It("does not remove the RunInfo", func() {
-				err := etcdDBWithFakeStore.RemoveDesiredLRP(logger, lrp.ProcessGuid)
+				err := etcdDBWithFakeStore.RemoveDesiredLRP(logger, lrp.ProcessGuid, nil)
 				Expect(err).To(HaveOccurred())
 
 				Expect(fakeStoreClient.DeleteCallCount()).To(Equal(1))
@@ -369,9 +369,109 @@ var _ = Describe("DesiredLRPDB", func() {
 			})
 		})
 
+		Context("when an expected modification tag is given", func() {
+			var desiredLRP *models.DesiredLRP
+
+			BeforeEach(func() {
+				err := etcdDB.DesireLRP(logger, lrp)
+				Expect(err).NotTo(HaveOccurred())
+
+				desiredLRP, err = etcdDB.DesiredLRPByProcessGuid(logger, lrp.ProcessGuid)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("deletes it if the tag matches", func() {
+				expectedTag := *desiredLRP.ModificationTag
+				err := etcdDB.RemoveDesiredLRP(logger, lrp.ProcessGuid, &expectedTag)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = etcdDB.DesiredLRPByProcessGuid(logger, lrp.ProcessGuid)
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+			})
+
+			It("returns a resource conflict error if the tag is stale", func() {
+				staleTag := models.NewModificationTag(desiredLRP.ModificationTag.Epoch, desiredLRP.ModificationTag.Index+1)
+				err := etcdDB.RemoveDesiredLRP(logger, lrp.ProcessGuid, &staleTag)
+				Expect(err).To(Equal(models.ErrResourceConflict))
+
+				_, err = etcdDB.DesiredLRPByProcessGuid(logger, lrp.ProcessGuid)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the desired LRP does not exist", func() {
+			It("returns a resource not found error", func() {
+				err := etcdDB.RemoveDesiredLRP(logger, "monkey", nil)
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+	})
+
+	Describe("ApplyDesiredLRPs", func() {
+		BeforeEach(func() {
+			Expect(etcdDB.DesireLRP(logger, model_helpers.NewValidDesiredLRP("extra-guid"))).To(Succeed())
+		})
+
+		It("is not supported and changes nothing", func() {
+			_, err := etcdDB.ApplyDesiredLRPs(logger, "some-domain", []*models.DesiredLRP{
+				model_helpers.NewValidDesiredLRP("created-guid"),
+			}, 60)
+			Expect(err).To(Equal(etcd.ErrApplyDesiredLRPsUnsupported))
+
+			_, err = etcdDB.DesiredLRPByProcessGuid(logger, "created-guid")
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+			_, err = etcdDB.DesiredLRPByProcessGuid(logger, "extra-guid")
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("UpdateDesiredLRPRunInfo", func() {
+		var (
+			desiredLRP *models.DesiredLRP
+			lrp        *models.DesiredLRP
+			runInfo    models.DesiredLRPRunInfo
+		)
+
+		BeforeEach(func() {
+			lrp = model_helpers.NewValidDesiredLRP("some-process-guid")
+			err := etcdDB.DesireLRP(logger, lrp)
+			Expect(err).NotTo(HaveOccurred())
+
+			desiredLRP, err = etcdDB.DesiredLRPByProcessGuid(logger, lrp.ProcessGuid)
+			Expect(err).NotTo(HaveOccurred())
+
+			redeployed := model_helpers.NewValidDesiredLRP("some-process-guid")
+			redeployed.EnvironmentVariables = []*models.EnvironmentVariable{{Name: "NEW", Value: "value"}}
+			runInfo = redeployed.DesiredLRPRunInfo(clock.Now())
+		})
+
+		It("replaces the run info and increments the modification tag", func() {
+			before, err := etcdDB.UpdateDesiredLRPRunInfo(logger, lrp.ProcessGuid, &runInfo, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(before).To(Equal(desiredLRP))
+
+			updated, err := etcdDB.DesiredLRPByProcessGuid(logger, lrp.ProcessGuid)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(updated.EnvironmentVariables).To(ConsistOf(&models.EnvironmentVariable{Name: "NEW", Value: "value"}))
+			Expect(updated.DesiredLRPResource()).To(Equal(desiredLRP.DesiredLRPResource()))
+			Expect(updated.ModificationTag.Epoch).To(Equal(desiredLRP.ModificationTag.Epoch))
+			Expect(updated.ModificationTag.Index).To(Equal(desiredLRP.ModificationTag.Index + 1))
+		})
+
+		It("replaces the resource if one is given", func() {
+			resource := models.NewDesiredLRPResource(1024, 2048, 10, "preloaded:other-stack")
+			_, err := etcdDB.UpdateDesiredLRPRunInfo(logger, lrp.ProcessGuid, &runInfo, &resource)
+			Expect(err).NotTo(HaveOccurred())
+
+			updated, err := etcdDB.DesiredLRPByProcessGuid(logger, lrp.ProcessGuid)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated.DesiredLRPResource()).To(Equal(resource))
+		})
+
 		Context("when the desired LRP does not exist", func() {
 			It("returns a resource not found error", func() {
-				err := etcdDB.RemoveDesiredLRP(logger, "monkey")
+				_, err := etcdDB.UpdateDesiredLRPRunInfo(logger, "monkey", &runInfo, nil)
 				Expect(err).To(Equal(models.ErrResourceNotFound))
 			})
 		})
@@ -410,7 +510,7 @@ var _ = Describe("DesiredLRPDB", func() {
 			})
 
 			It("updates an existing DesireLRP", func() {
-				_, modelErr := etcdDB.UpdateDesiredLRP(logger, lrp.ProcessGuid, update)
+				_, modelErr := etcdDB.UpdateDesiredLRP(logger, lrp.ProcessGuid, update, nil)
 				Expect(modelErr).NotTo(HaveOccurred())
 
 				updated, modelErr := etcdDB.DesiredLRPByProcessGuid(logger, lrp.ProcessGuid)
@@ -428,8 +528,28 @@ var _ = Describe("DesiredLRPDB", func() {
 				Expect(updated.ModificationTag.Index).To(Equal(desiredLRP.ModificationTag.Index + 1))
 			})
 
+			It("updates the DesiredLRP if the expected modification tag matches", func() {
+				expectedTag := *desiredLRP.ModificationTag
+				_, modelErr := etcdDB.UpdateDesiredLRP(logger, lrp.ProcessGuid, update, &expectedTag)
+				Expect(modelErr).NotTo(HaveOccurred())
+
+				updated, modelErr := etcdDB.DesiredLRPByProcessGuid(logger, lrp.ProcessGuid)
+				Expect(modelErr).NotTo(HaveOccurred())
+				Expect(updated.Instances).To(Equal(*update.Instances))
+			})
+
+			It("returns a resource conflict error if the expected modification tag is stale", func() {
+				staleTag := models.NewModificationTag(desiredLRP.ModificationTag.Epoch, desiredLRP.ModificationTag.Index+1)
+				_, modelErr := etcdDB.UpdateDesiredLRP(logger, lrp.ProcessGuid, update, &staleTag)
+				Expect(modelErr).To(Equal(models.ErrResourceConflict))
+
+				unchanged, modelErr := etcdDB.DesiredLRPByProcessGuid(logger, lrp.ProcessGuid)
+				Expect(modelErr).NotTo(HaveOccurred())
+				Expect(unchanged.Instances).To(Equal(lrp.Instances))
+			})
+
 			It("returns the previous instance count", func() {
-				beforeDesiredLRP, modelErr := etcdDB.UpdateDesiredLRP(logger, lrp.ProcessGuid, update)
+				beforeDesiredLRP, modelErr := etcdDB.UpdateDesiredLRP(logger, lrp.ProcessGuid, update, nil)
 				Expect(modelErr).NotTo(HaveOccurred())
 				beforeDesiredLRP.ModificationTag.Epoch = "epoch"
 				Expect(beforeDesiredLRP).To(Equal(lrp))
@@ -459,7 +579,7 @@ var _ = Describe("DesiredLRPDB", func() {
 
 					It("retries the update up to 2 times", func() {
 						Expect(fakeStoreClient.CompareAndSwapCallCount()).To(Equal(0))
-						_, modelErr := etcdDBWithFakeStore.UpdateDesiredLRP(logger, lrp.ProcessGuid, update)
+						_, modelErr := etcdDBWithFakeStore.UpdateDesiredLRP(logger, lrp.ProcessGuid, update, nil)
 						Expect(modelErr).To(HaveOccurred())
 						Expect(fakeStoreClient.CompareAndSwapCallCount()).To(Equal(2))
 					})
@@ -472,7 +592,7 @@ var _ = Describe("DesiredLRPDB", func() {
 
 					It("fails immediately", func() {
 						Expect(fakeStoreClient.CompareAndSwapCallCount()).To(Equal(0))
-						_, modelErr := etcdDBWithFakeStore.UpdateDesiredLRP(logger, lrp.ProcessGuid, update)
+						_, modelErr := etcdDBWithFakeStore.UpdateDesiredLRP(logger, lrp.ProcessGuid, update, nil)
 						Expect(modelErr).To(HaveOccurred())
 						Expect(fakeStoreClient.CompareAndSwapCallCount()).To(Equal(1))
 					})
@@ -486,7 +606,7 @@ var _ = Describe("DesiredLRPDB", func() {
 
 				_, err := etcdDB.UpdateDesiredLRP(logger, "garbage-guid", &models.DesiredLRPUpdate{
 					Instances: &instances,
-				})
+				}, nil)
 				Expect(err).To(Equal(models.ErrResourceNotFound))
 			})
 		})
@@ -0,0 +1,117 @@
+package etcd
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+func (db *ETCDDB) DesiredLRPRollouts(logger lager.Logger) ([]*models.DesiredLRPRollout, error) {
+	root, err := db.fetchRecursiveRaw(logger, DesiredLRPRolloutSchemaRoot)
+	bbsErr := models.ConvertError(err)
+	if bbsErr != nil {
+		if bbsErr.Type == models.Error_ResourceNotFound {
+			return []*models.DesiredLRPRollout{}, nil
+		}
+		return nil, err
+	}
+
+	rollouts := []*models.DesiredLRPRollout{}
+	for _, node := range root.Nodes {
+		rollout := new(models.DesiredLRPRollout)
+		err := db.deserializeModel(logger, node, rollout)
+		if err != nil {
+			logger.Error("failed-parsing-desired-lrp-rollout", err, lager.Data{"key": node.Key})
+			continue
+		}
+		rollouts = append(rollouts, rollout)
+	}
+
+	return rollouts, nil
+}
+
+func (db *ETCDDB) DesiredLRPRollout(logger lager.Logger, processGuid string) (*models.DesiredLRPRollout, error) {
+	rollout, _, err := db.rawDesiredLRPRollout(logger, processGuid)
+	return rollout, err
+}
+
+func (db *ETCDDB) rawDesiredLRPRollout(logger lager.Logger, processGuid string) (*models.DesiredLRPRollout, uint64, error) {
+	node, err := db.fetchRaw(logger, DesiredLRPRolloutSchemaPath(processGuid))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rollout := new(models.DesiredLRPRollout)
+	err = db.deserializeModel(logger, node, rollout)
+	if err != nil {
+		logger.Error("failed-parsing-desired-lrp-rollout", err)
+		return nil, 0, err
+	}
+
+	return rollout, node.ModifiedIndex, nil
+}
+
+func (db *ETCDDB) UpsertDesiredLRPRollout(logger lager.Logger, rollout *models.DesiredLRPRollout) error {
+	logger = logger.Session("upsert-desired-lrp-rollout", lager.Data{"process_guid": rollout.ProcessGuid})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	value, err := db.serializeModel(logger, rollout)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.client.Set(DesiredLRPRolloutSchemaPath(rollout.ProcessGuid), value, NO_TTL)
+	if err != nil {
+		logger.Error("failed-setting-desired-lrp-rollout", err)
+		return ErrorFromEtcdError(logger, err)
+	}
+
+	return nil
+}
+
+func (db *ETCDDB) UpdateDesiredLRPRollout(logger lager.Logger, rollout *models.DesiredLRPRollout) error {
+	logger = logger.Session("update-desired-lrp-rollout", lager.Data{"process_guid": rollout.ProcessGuid})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	existing, index, err := db.rawDesiredLRPRollout(logger, rollout.ProcessGuid)
+	if err != nil {
+		return err
+	}
+
+	if !existing.ModificationTag.Equal(rollout.ModificationTag) {
+		logger.Info("rollout-superseded", lager.Data{"modification_tag": existing.ModificationTag})
+		return models.ErrResourceNotFound
+	}
+
+	value, err := db.serializeModel(logger, rollout)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.client.CompareAndSwap(DesiredLRPRolloutSchemaPath(rollout.ProcessGuid), value, NO_TTL, index)
+	if err != nil {
+		logger.Error("failed-compare-and-swapping-desired-lrp-rollout", err)
+		if etcdErrCode(err) == ETCDErrIndexComparisonFailed {
+			// another rollout replaced this one since it was read
+			return models.ErrResourceNotFound
+		}
+		return ErrorFromEtcdError(logger, err)
+	}
+
+	return nil
+}
+
+func (db *ETCDDB) RemoveDesiredLRPRollout(logger lager.Logger, processGuid string) error {
+	logger = logger.Session("remove-desired-lrp-rollout", lager.Data{"process_guid": processGuid})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	_, err := db.client.Delete(DesiredLRPRolloutSchemaPath(processGuid), false)
+	if err != nil {
+		logger.Error("failed-deleting-desired-lrp-rollout", err)
+		return ErrorFromEtcdError(logger, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package etcd_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DesiredLRPRolloutDB", func() {
+	var rollout *models.DesiredLRPRollout
+
+	BeforeEach(func() {
+		rollout = &models.DesiredLRPRollout{
+			ProcessGuid:     "some-guid",
+			ModificationTag: &models.ModificationTag{Epoch: "abc", Index: 1},
+			MaxInFlight:     2,
+			StartedAt:       100,
+			Deadline:        200,
+			State:           models.DesiredLRPRolloutStateInProgress,
+		}
+	})
+
+	Describe("UpsertDesiredLRPRollout", func() {
+		It("records the rollout", func() {
+			Expect(etcdDB.UpsertDesiredLRPRollout(logger, rollout)).To(Succeed())
+
+			stored, err := etcdDB.DesiredLRPRollout(logger, "some-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stored).To(Equal(rollout))
+		})
+
+		It("replaces an existing rollout", func() {
+			Expect(etcdDB.UpsertDesiredLRPRollout(logger, rollout)).To(Succeed())
+
+			rollout.ModificationTag = &models.ModificationTag{Epoch: "abc", Index: 2}
+			Expect(etcdDB.UpsertDesiredLRPRollout(logger, rollout)).To(Succeed())
+
+			stored, err := etcdDB.DesiredLRPRollout(logger, "some-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stored.ModificationTag.Index).To(BeEquivalentTo(2))
+		})
+	})
+
+	Describe("DesiredLRPRollouts", func() {
+		It("returns no rollouts when none were recorded", func() {
+			rollouts, err := etcdDB.DesiredLRPRollouts(logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rollouts).To(BeEmpty())
+		})
+
+		It("returns every recorded rollout", func() {
+			Expect(etcdDB.UpsertDesiredLRPRollout(logger, rollout)).To(Succeed())
+			other := &models.DesiredLRPRollout{
+				ProcessGuid:     "other-guid",
+				ModificationTag: &models.ModificationTag{Epoch: "def", Index: 3},
+				State:           models.DesiredLRPRolloutStateComplete,
+			}
+			Expect(etcdDB.UpsertDesiredLRPRollout(logger, other)).To(Succeed())
+
+			rollouts, err := etcdDB.DesiredLRPRollouts(logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rollouts).To(ConsistOf(rollout, other))
+		})
+	})
+
+	Describe("UpdateDesiredLRPRollout", func() {
+		BeforeEach(func() {
+			Expect(etcdDB.UpsertDesiredLRPRollout(logger, rollout)).To(Succeed())
+		})
+
+		It("saves the progress of the rollout", func() {
+			rollout.ReplacedIndices = []int32{0, 1}
+			Expect(etcdDB.UpdateDesiredLRPRollout(logger, rollout)).To(Succeed())
+
+			stored, err := etcdDB.DesiredLRPRollout(logger, "some-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stored.ReplacedIndices).To(Equal([]int32{0, 1}))
+		})
+
+		Context("when the rollout was superseded", func() {
+			BeforeEach(func() {
+				newer := *rollout
+				newer.ModificationTag = &models.ModificationTag{Epoch: "abc", Index: 2}
+				Expect(etcdDB.UpsertDesiredLRPRollout(logger, &newer)).To(Succeed())
+			})
+
+			It("returns a resource not found error", func() {
+				rollout.ReplacedIndices = []int32{0}
+				Expect(etcdDB.UpdateDesiredLRPRollout(logger, rollout)).To(Equal(models.ErrResourceNotFound))
+
+				stored, err := etcdDB.DesiredLRPRollout(logger, "some-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stored.ReplacedIndices).To(BeEmpty())
+			})
+		})
+
+		Context("when the rollout was removed", func() {
+			BeforeEach(func() {
+				Expect(etcdDB.RemoveDesiredLRPRollout(logger, "some-guid")).To(Succeed())
+			})
+
+			It("returns a resource not found error", func() {
+				Expect(etcdDB.UpdateDesiredLRPRollout(logger, rollout)).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+	})
+
+	Describe("RemoveDesiredLRPRollout", func() {
+		It("removes the rollout", func() {
+			Expect(etcdDB.UpsertDesiredLRPRollout(logger, rollout)).To(Succeed())
+			Expect(etcdDB.RemoveDesiredLRPRollout(logger, "some-guid")).To(Succeed())
+
+			_, err := etcdDB.DesiredLRPRollout(logger, "some-guid")
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+		})
+
+		It("returns a resource not found error when there is no rollout", func() {
+			Expect(etcdDB.RemoveDesiredLRPRollout(logger, "some-guid")).To(Equal(models.ErrResourceNotFound))
+		})
+	})
+})
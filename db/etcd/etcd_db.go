@@ -32,6 +32,8 @@ const (
 	DesiredLRPRunInfoKey               = "run"
 	DesiredLRPRunInfoSchemaRoot        = DesiredLRPComponentsSchemaRoot + "/" + DesiredLRPRunInfoKey
 
+	DesiredLRPRolloutSchemaRoot = V1SchemaRoot + "desired_lrp_rollout"
+
 	TaskSchemaRoot = V1SchemaRoot + "task"
 )
 
@@ -59,6 +61,10 @@ func DesiredLRPRunInfoSchemaPath(processGuid string) string {
 	return path.Join(DesiredLRPComponentsSchemaRoot, DesiredLRPRunInfoKey, processGuid)
 }
 
+func DesiredLRPRolloutSchemaPath(processGuid string) string {
+	return path.Join(DesiredLRPRolloutSchemaRoot, processGuid)
+}
+
 func TaskSchemaPath(task *models.Task) string {
 	return TaskSchemaPathByGuid(task.GetTaskGuid())
 }
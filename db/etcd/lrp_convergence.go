@@ -513,6 +513,11 @@ func CalculateConvergence(
 				}
 			}
 
+			calculator := restartCalculator
+			if desired.RestartPolicy != nil {
+				calculator = desired.RestartPolicy.RestartCalculator()
+			}
+
 			for i, actual := range actualsByIndex {
 				if actual.CellIsMissing(input.Cells) {
 					pLog.Info("missing-cell", lager.Data{"index": i, "cell_id": actual.CellId})
@@ -527,7 +532,7 @@ func CalculateConvergence(
 					continue
 				}
 
-				if actual.ShouldRestartCrash(now, restartCalculator) {
+				if actual.ShouldRestartCrash(now, calculator) {
 					pLog.Info("restart-crash", lager.Data{"index": i})
 					changes.RestartableCrashedActualLRPs = append(changes.RestartableCrashedActualLRPs, actual)
 					continue
@@ -552,6 +552,33 @@ var _ = Describe("LRPConvergence", func() {
 				})
 			})
 
+			Context("crashed actual LRPs whose desired LRP has a restart policy", func() {
+				var lrpWithPolicy *models.DesiredLRP
+
+				BeforeEach(func() {
+					lrpWithPolicy = &models.DesiredLRP{
+						ProcessGuid:   "process-guid-with-policy",
+						Instances:     1,
+						Domain:        domainA,
+						RestartPolicy: &models.RestartPolicy{NeverRestart: true},
+					}
+
+					input = &models.ConvergenceInput{
+						AllProcessGuids: map[string]struct{}{lrpWithPolicy.ProcessGuid: struct{}{}},
+						DesiredLRPs:     desiredLRPs(lrpWithPolicy),
+						ActualLRPs: actualLRPs(
+							newStartableCrashedActualLRP(lrpWithPolicy, 0),
+						),
+						Domains: models.NewDomainSet([]string{domainA}),
+						Cells:   cellSet(cellA),
+					}
+				})
+
+				It("uses the policy instead of the default restart calculator", func() {
+					changesEqual(changes, &models.ConvergenceChanges{})
+				})
+			})
+
 			Context("with stale unclaimed actual LRPs", func() {
 				BeforeEach(func() {
 					input = &models.ConvergenceInput{
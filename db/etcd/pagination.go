@@ -0,0 +1,120 @@
+package etcd
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/bbs/models"
+)
+
+// The etcd store has no ordered range queries, so paging is applied to the
+// fully fetched records after sorting them by their key. Records that fail to
+// deserialize or are filtered out, including those outside the filter's
+// allowed domains, never reach the pager, so a page is only followed by another
+// when records remain past its limit.
+
+// domainAllowed reports whether domain is one of allowedDomains. A nil list
+// allows every domain.
+func domainAllowed(allowedDomains []string, domain string) bool {
+	if allowedDomains == nil {
+		return true
+	}
+
+	for _, allowed := range allowedDomains {
+		if allowed == domain {
+			return true
+		}
+	}
+	return false
+}
+
+type tasksByGuid []*models.Task
+
+func (t tasksByGuid) Len() int           { return len(t) }
+func (t tasksByGuid) Swap(i, j int)      { t[i], t[j] = t[j], t[i] }
+func (t tasksByGuid) Less(i, j int) bool { return t[i].TaskGuid < t[j].TaskGuid }
+
+func pageTasks(tasks []*models.Task, filter models.TaskFilter) ([]*models.Task, string) {
+	if filter.PageSize <= 0 && filter.AfterTaskGuid == "" {
+		return tasks, ""
+	}
+
+	sort.Sort(tasksByGuid(tasks))
+
+	start := sort.Search(len(tasks), func(i int) bool {
+		return tasks[i].TaskGuid > filter.AfterTaskGuid
+	})
+	tasks = tasks[start:]
+
+	if filter.PageSize > 0 && len(tasks) > filter.PageSize {
+		tasks = tasks[:filter.PageSize]
+		return tasks, filter.NextPageToken(tasks[len(tasks)-1].TaskGuid)
+	}
+
+	return tasks, ""
+}
+
+type desiredLRPsByProcessGuid []*models.DesiredLRP
+
+func (d desiredLRPsByProcessGuid) Len() int      { return len(d) }
+func (d desiredLRPsByProcessGuid) Swap(i, j int) { d[i], d[j] = d[j], d[i] }
+func (d desiredLRPsByProcessGuid) Less(i, j int) bool {
+	return d[i].ProcessGuid < d[j].ProcessGuid
+}
+
+func pageDesiredLRPs(desiredLRPs []*models.DesiredLRP, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, string) {
+	if filter.PageSize <= 0 && filter.AfterProcessGuid == "" {
+		return desiredLRPs, ""
+	}
+
+	sort.Sort(desiredLRPsByProcessGuid(desiredLRPs))
+
+	start := sort.Search(len(desiredLRPs), func(i int) bool {
+		return desiredLRPs[i].ProcessGuid > filter.AfterProcessGuid
+	})
+	desiredLRPs = desiredLRPs[start:]
+
+	if filter.PageSize > 0 && len(desiredLRPs) > filter.PageSize {
+		desiredLRPs = desiredLRPs[:filter.PageSize]
+		return desiredLRPs, filter.NextPageToken(desiredLRPs[len(desiredLRPs)-1].ProcessGuid)
+	}
+
+	return desiredLRPs, ""
+}
+
+type actualLRPGroupsByKey []*models.ActualLRPGroup
+
+func (a actualLRPGroupsByKey) Len() int      { return len(a) }
+func (a actualLRPGroupsByKey) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a actualLRPGroupsByKey) Less(i, j int) bool {
+	left, right := actualLRPGroupKey(a[i]), actualLRPGroupKey(a[j])
+	return left.ProcessGuid < right.ProcessGuid ||
+		(left.ProcessGuid == right.ProcessGuid && left.Index < right.Index)
+}
+
+func actualLRPGroupKey(group *models.ActualLRPGroup) models.ActualLRPKey {
+	actualLRP, _ := group.Resolve()
+	return actualLRP.ActualLRPKey
+}
+
+func pageActualLRPGroups(groups []*models.ActualLRPGroup, filter models.ActualLRPFilter) ([]*models.ActualLRPGroup, string) {
+	if filter.PageSize <= 0 && filter.AfterProcessGuid == "" {
+		return groups, ""
+	}
+
+	sort.Sort(actualLRPGroupsByKey(groups))
+
+	start := sort.Search(len(groups), func(i int) bool {
+		key := actualLRPGroupKey(groups[i])
+		return key.ProcessGuid > filter.AfterProcessGuid ||
+			(key.ProcessGuid == filter.AfterProcessGuid && key.Index > filter.AfterIndex)
+	})
+	groups = groups[start:]
+
+	if filter.PageSize > 0 && len(groups) > filter.PageSize {
+		groups = groups[:filter.PageSize]
+		last := actualLRPGroupKey(groups[len(groups)-1])
+		return groups, filter.NextPageToken(last.ProcessGuid, last.Index)
+	}
+
+	return groups, ""
+}
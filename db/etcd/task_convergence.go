@@ -1,6 +1,7 @@
 package etcd
 
 import (
+	"sync"
 	"time"
 
 	"code.cloudfoundry.org/auctioneer"
@@ -25,6 +26,7 @@ const (
 
 type compareAndSwappableTask struct {
 	OldIndex uint64
+	OldTask  *models.Task
 	NewTask  *models.Task
 }
 
@@ -32,7 +34,7 @@ func (db *ETCDDB) ConvergeTasks(
 	logger lager.Logger,
 	cellSet models.CellSet,
 	kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration time.Duration,
-) ([]*auctioneer.TaskStartRequest, []*models.Task) {
+) ([]*auctioneer.TaskStartRequest, []*models.Task, []models.Event) {
 	logger.Info("starting-convergence")
 	defer logger.Info("finished-convergence")
 
@@ -52,7 +54,7 @@ func (db *ETCDDB) ConvergeTasks(
 	if modelErr != nil {
 		logger.Debug("failed-listing-task")
 		sendTaskMetrics(logger, -1, -1, -1, -1)
-		return nil, nil
+		return nil, nil, nil
 	}
 	logger.Debug("succeeded-listing-task")
 
@@ -71,11 +73,17 @@ func (db *ETCDDB) ConvergeTasks(
 	}
 
 	keysToDelete := []string{}
+	tasksToDelete := map[string]*models.Task{}
+	scheduleForDeletion := func(key string, task *models.Task) {
+		keysToDelete = append(keysToDelete, key)
+		tasksToDelete[key] = task
+	}
 
 	tasksToCAS := []compareAndSwappableTask{}
-	scheduleForCASByIndex := func(index uint64, newTask *models.Task) {
+	scheduleForCASByIndex := func(index uint64, oldTask, newTask *models.Task) {
 		tasksToCAS = append(tasksToCAS, compareAndSwappableTask{
 			OldIndex: index,
+			OldTask:  oldTask,
 			NewTask:  newTask,
 		})
 	}
@@ -108,13 +116,16 @@ func (db *ETCDDB) ConvergeTasks(
 		switch task.State {
 		case models.Task_Pending:
 			pendingCount++
-			shouldMarkAsFailed := db.durationSinceTaskCreated(task) >= expirePendingTaskDuration
+			// a pending task's UpdatedAt is its creation time, or the time it was
+			// last returned to pending by a retry
+			shouldMarkAsFailed := db.durationSinceTaskUpdated(task) >= expirePendingTaskDuration
 			if shouldMarkAsFailed {
 				logError(task, "failed-to-start-in-time")
+				beforeTask := task.Copy()
 				db.markTaskFailed(task, "not started within time limit")
-				scheduleForCASByIndex(node.ModifiedIndex, task)
+				scheduleForCASByIndex(node.ModifiedIndex, beforeTask, task)
 				tasksKicked++
-			} else if shouldKickTask {
+			} else if shouldKickTask && !task.InRetryBackoff(db.clock.Now().UnixNano()) {
 				logger.Info("requesting-auction-for-pending-task", lager.Data{"task_guid": task.TaskGuid})
 				start := auctioneer.NewTaskStartRequestFromModel(task.TaskGuid, task.Domain, task.TaskDefinition)
 				tasksToAuction = append(tasksToAuction, &start)
@@ -125,8 +136,18 @@ func (db *ETCDDB) ConvergeTasks(
 			cellIsAlive := cellSet.HasCellID(task.CellId)
 			if !cellIsAlive {
 				logError(task, "cell-disappeared")
-				db.markTaskFailed(task, "cell disappeared before completion")
-				scheduleForCASByIndex(node.ModifiedIndex, task)
+				beforeTask := task.Copy()
+				now := db.clock.Now().UnixNano()
+				if task.FailAttempt(models.TaskFailureClass_CellDisappeared, "cell disappeared before completion", now) {
+					logger.Info("retrying-task", lager.Data{"task_guid": task.TaskGuid, "retry_count": task.RetryCount})
+					if !task.InRetryBackoff(now) {
+						start := auctioneer.NewTaskStartRequestFromModel(task.TaskGuid, task.Domain, task.TaskDefinition)
+						tasksToAuction = append(tasksToAuction, &start)
+					}
+				} else {
+					db.markTaskFailed(task, "cell disappeared before completion")
+				}
+				scheduleForCASByIndex(node.ModifiedIndex, beforeTask, task)
 				tasksKicked++
 			}
 		case models.Task_Completed:
@@ -134,7 +155,7 @@ func (db *ETCDDB) ConvergeTasks(
 			shouldDeleteTask := db.durationSinceTaskFirstCompleted(task) >= expireCompletedTaskDuration
 			if shouldDeleteTask {
 				logError(task, "failed-to-start-resolving-in-time")
-				keysToDelete = append(keysToDelete, node.Key)
+				scheduleForDeletion(node.Key, task)
 			} else if shouldKickTask {
 				logger.Info("kicking-completed-task", lager.Data{"task_guid": task.TaskGuid})
 				scheduleForCompletion(task)
@@ -145,11 +166,12 @@ func (db *ETCDDB) ConvergeTasks(
 			shouldDeleteTask := db.durationSinceTaskFirstCompleted(task) >= expireCompletedTaskDuration
 			if shouldDeleteTask {
 				logError(task, "failed-to-resolve-in-time")
-				keysToDelete = append(keysToDelete, node.Key)
+				scheduleForDeletion(node.Key, task)
 			} else if shouldKickTask {
 				logger.Info("demoting-resolving-to-completed", lager.Data{"task_guid": task.TaskGuid})
+				beforeTask := task.Copy()
 				demoted := demoteToCompleted(task)
-				scheduleForCASByIndex(node.ModifiedIndex, demoted)
+				scheduleForCASByIndex(node.ModifiedIndex, beforeTask, demoted)
 				scheduleForCompletion(demoted)
 				tasksKicked++
 			}
@@ -166,22 +188,18 @@ func (db *ETCDDB) ConvergeTasks(
 
 	tasksKickedCounter.Add(tasksKicked)
 	logger.Debug("compare-and-swapping-tasks", lager.Data{"num_tasks_to_cas": len(tasksToCAS)})
-	err := db.batchCompareAndSwapTasks(tasksToCAS, logger)
+	events, err := db.batchCompareAndSwapTasks(tasksToCAS, logger)
 	if err != nil {
-		return nil, nil
+		return nil, nil, nil
 	}
 	logger.Debug("done-compare-and-swapping-tasks", lager.Data{"num_tasks_to_cas": len(tasksToCAS)})
 
 	tasksPrunedCounter.Add(uint64(len(keysToDelete)))
 	logger.Debug("deleting-keys", lager.Data{"num_keys_to_delete": len(keysToDelete)})
-	db.batchDeleteTasks(keysToDelete, logger)
+	events = append(events, db.batchDeleteTasks(keysToDelete, tasksToDelete, logger)...)
 	logger.Debug("done-deleting-keys", lager.Data{"num_keys_to_delete": len(keysToDelete)})
 
-	return tasksToAuction, tasksToComplete
-}
-
-func (db *ETCDDB) durationSinceTaskCreated(task *models.Task) time.Duration {
-	return db.clock.Now().Sub(time.Unix(0, task.CreatedAt))
+	return tasksToAuction, tasksToComplete, events
 }
 
 func (db *ETCDDB) durationSinceTaskUpdated(task *models.Task) time.Duration {
@@ -204,11 +222,13 @@ func demoteToCompleted(task *models.Task) *models.Task {
 	return task
 }
 
-func (db *ETCDDB) batchCompareAndSwapTasks(tasksToCAS []compareAndSwappableTask, logger lager.Logger) error {
+func (db *ETCDDB) batchCompareAndSwapTasks(tasksToCAS []compareAndSwappableTask, logger lager.Logger) ([]models.Event, error) {
+	events := []models.Event{}
 	if len(tasksToCAS) == 0 {
-		return nil
+		return events, nil
 	}
 
+	var eventsLock sync.Mutex
 	works := []func(){}
 
 	for _, taskToCAS := range tasksToCAS {
@@ -223,30 +243,39 @@ func (db *ETCDDB) batchCompareAndSwapTasks(tasksToCAS []compareAndSwappableTask,
 		}
 
 		index := taskToCAS.OldIndex
+		oldTask := taskToCAS.OldTask
 		works = append(works, func() {
 			_, err := db.client.CompareAndSwap(TaskSchemaPathByGuid(task.TaskGuid), value, NO_TTL, index)
 			if err != nil {
 				logger.Error("failed-to-compare-and-swap", err, lager.Data{
 					"task_guid": task.TaskGuid,
 				})
+				return
 			}
+
+			eventsLock.Lock()
+			events = append(events, models.NewTaskChangedEvent(oldTask, task))
+			eventsLock.Unlock()
 		})
 	}
 
 	throttler, err := workpool.NewThrottler(db.convergenceWorkersSize, works)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	throttler.Work()
-	return nil
+	return events, nil
 }
 
-func (db *ETCDDB) batchDeleteTasks(taskGuids []string, logger lager.Logger) {
+func (db *ETCDDB) batchDeleteTasks(taskGuids []string, tasks map[string]*models.Task, logger lager.Logger) []models.Event {
+	events := []models.Event{}
 	if len(taskGuids) == 0 {
-		return
+		return events
 	}
 
+	var eventsLock sync.Mutex
+
 	works := []func(){}
 
 	for _, taskGuid := range taskGuids {
@@ -257,17 +286,28 @@ func (db *ETCDDB) batchDeleteTasks(taskGuids []string, logger lager.Logger) {
 				logger.Error("failed-to-delete", err, lager.Data{
 					"task_guid": taskGuid,
 				})
+				return
+			}
+
+			task, ok := tasks[taskGuid]
+			if !ok {
+				return
 			}
+
+			eventsLock.Lock()
+			events = append(events, models.NewTaskRemovedEvent(task))
+			eventsLock.Unlock()
 		})
 	}
 
 	throttler, err := workpool.NewThrottler(db.convergenceWorkersSize, works)
 	if err != nil {
 		logger.Error("failed-to-create-throttler", err)
+		return events
 	}
 
 	throttler.Work()
-	return
+	return events
 }
 
 func sendTaskMetrics(logger lager.Logger, pendingCount, runningCount, completedCount, resolvingCount int) {
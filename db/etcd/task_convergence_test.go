@@ -44,6 +44,7 @@ var _ = Describe("Convergence of Tasks", func() {
 		var (
 			tasksToAuction  []*auctioneer.TaskStartRequest
 			tasksToComplete []*models.Task
+			taskEvents      []models.Event
 			cells           models.CellSet
 		)
 
@@ -52,7 +53,7 @@ var _ = Describe("Convergence of Tasks", func() {
 		})
 
 		JustBeforeEach(func() {
-			tasksToAuction, tasksToComplete = etcdDB.ConvergeTasks(logger, cells, kickTasksDuration, expirePendingTaskDuration, expireCompletedTaskDuration)
+			tasksToAuction, tasksToComplete, taskEvents = etcdDB.ConvergeTasks(logger, cells, kickTasksDuration, expirePendingTaskDuration, expireCompletedTaskDuration)
 		})
 
 		It("bumps the convergence counter", func() {
@@ -161,6 +162,17 @@ var _ = Describe("Convergence of Tasks", func() {
 					Expect(returnedTask.FailureReason).To(ContainSubstring("time limit"))
 				})
 
+				It("returns TaskChangedEvents for the failed tasks", func() {
+					Expect(taskEvents).To(HaveLen(2))
+					for _, event := range taskEvents {
+						changedEvent, ok := event.(*models.TaskChangedEvent)
+						Expect(ok).To(BeTrue())
+						Expect(changedEvent.Before.State).To(Equal(models.Task_Pending))
+						Expect(changedEvent.After.State).To(Equal(models.Task_Completed))
+						Expect(changedEvent.After.Failed).To(BeTrue())
+					}
+				})
+
 				It("bumps the compare-and-swap counter", func() {
 					Expect(sender.GetCounter("ConvergenceTasksKicked")).To(Equal(uint64(2)))
 				})
@@ -169,10 +181,10 @@ var _ = Describe("Convergence of Tasks", func() {
 
 		Context("when a Task is running", func() {
 			BeforeEach(func() {
-				err := etcdDB.DesireTask(logger, model_helpers.NewValidTaskDefinition(), taskGuid, domain)
+				_, err := etcdDB.DesireTask(logger, model_helpers.NewValidTaskDefinition(), taskGuid, domain)
 				Expect(err).NotTo(HaveOccurred())
 
-				_, err = etcdDB.StartTask(logger, taskGuid, "cell-id")
+				_, _, _, err = etcdDB.StartTask(logger, taskGuid, "cell-id")
 				Expect(err).NotTo(HaveOccurred())
 			})
 
@@ -219,28 +231,84 @@ var _ = Describe("Convergence of Tasks", func() {
 			})
 		})
 
+		Context("when a Task with a retry policy is running on a missing cell", func() {
+			var taskDef *models.TaskDefinition
+
+			BeforeEach(func() {
+				taskDef = model_helpers.NewValidTaskDefinition()
+				taskDef.RetryPolicy = &models.TaskRetryPolicy{MaxAttempts: 2}
+			})
+
+			JustBeforeEach(func() {
+				_, err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, _, _, err = etcdDB.StartTask(logger, taskGuid, "cell-id")
+				Expect(err).NotTo(HaveOccurred())
+
+				tasksToAuction, tasksToComplete, taskEvents = etcdDB.ConvergeTasks(logger, cells, kickTasksDuration, expirePendingTaskDuration, expireCompletedTaskDuration)
+			})
+
+			It("returns the task to pending and records the failure", func() {
+				returnedTask, err := etcdDB.TaskByGuid(logger, taskGuid)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(returnedTask.State).To(Equal(models.Task_Pending))
+				Expect(returnedTask.Failed).To(BeFalse())
+				Expect(returnedTask.CellId).To(Equal(""))
+				Expect(returnedTask.RetryCount).To(BeEquivalentTo(1))
+				Expect(returnedTask.FailureHistory).To(HaveLen(1))
+				Expect(returnedTask.FailureHistory[0].FailureClass).To(Equal(models.TaskFailureClass_CellDisappeared))
+				Expect(returnedTask.FailureHistory[0].CellId).To(Equal("cell-id"))
+			})
+
+			It("returns the task to be auctioned", func() {
+				start := auctioneer.NewTaskStartRequestFromModel(taskGuid, domain, taskDef)
+				Expect(tasksToAuction).To(ConsistOf(&start))
+			})
+
+			Context("when the retry policy has a backoff", func() {
+				BeforeEach(func() {
+					taskDef.RetryPolicy.BackoffMs = (kickTasksDuration * 2).Nanoseconds() / 1000000
+				})
+
+				It("does not auction the task", func() {
+					Expect(tasksToAuction).To(BeEmpty())
+				})
+
+				It("does not kick the task until the backoff has elapsed", func() {
+					clock.Increment(kickTasksDuration + time.Second)
+					tasksToAuction, _, _ = etcdDB.ConvergeTasks(logger, cells, kickTasksDuration, expirePendingTaskDuration, expireCompletedTaskDuration)
+					Expect(tasksToAuction).To(BeEmpty())
+
+					clock.Increment(kickTasksDuration)
+					tasksToAuction, _, _ = etcdDB.ConvergeTasks(logger, cells, kickTasksDuration, expirePendingTaskDuration, expireCompletedTaskDuration)
+					Expect(tasksToAuction).To(HaveLen(1))
+				})
+			})
+		})
+
 		Describe("Completed tasks", func() {
 			Context("when Tasks with a complete URL are completed", func() {
 				BeforeEach(func() {
 					taskDef := model_helpers.NewValidTaskDefinition()
 					taskDef.CompletionCallbackUrl = "blah"
 
-					err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
+					_, err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
 					Expect(err).NotTo(HaveOccurred())
 
-					_, err = etcdDB.StartTask(logger, taskGuid, cellId)
+					_, _, _, err = etcdDB.StartTask(logger, taskGuid, cellId)
 					Expect(err).NotTo(HaveOccurred())
 
-					task, err := etcdDB.CompleteTask(logger, taskGuid, cellId, true, "'cause I said so", "a magical result")
+					_, task, err := etcdDB.CompleteTask(logger, taskGuid, cellId, true, "'cause I said so", "a magical result")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(task.TaskGuid).To(Equal(taskGuid))
 
-					err = etcdDB.DesireTask(logger, taskDef, taskGuid2, domain)
+					_, err = etcdDB.DesireTask(logger, taskDef, taskGuid2, domain)
 
-					_, err = etcdDB.StartTask(logger, taskGuid2, cellId)
+					_, _, _, err = etcdDB.StartTask(logger, taskGuid2, cellId)
 					Expect(err).NotTo(HaveOccurred())
 
-					task, err = etcdDB.CompleteTask(logger, taskGuid2, cellId, true, "'cause I said so", "a magical result")
+					_, task, err = etcdDB.CompleteTask(logger, taskGuid2, cellId, true, "'cause I said so", "a magical result")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(task.TaskGuid).To(Equal(taskGuid2))
 				})
@@ -273,6 +341,10 @@ var _ = Describe("Convergence of Tasks", func() {
 						_, modelErr := etcdDB.TaskByGuid(logger, taskGuid)
 						Expect(modelErr).To(Equal(models.ErrResourceNotFound))
 					})
+
+					It("returns a TaskRemovedEvent for the deleted task", func() {
+						Expect(taskEvents).To(ContainElement(BeAssignableToTypeOf(&models.TaskRemovedEvent{})))
+					})
 				})
 
 				Context("when the task has been completed for less than the convergence interval", func() {
@@ -298,17 +370,17 @@ var _ = Describe("Convergence of Tasks", func() {
 				taskDef := model_helpers.NewValidTaskDefinition()
 				taskDef.CompletionCallbackUrl = "blah"
 
-				err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
+				_, err := etcdDB.DesireTask(logger, taskDef, taskGuid, domain)
 				Expect(err).NotTo(HaveOccurred())
 
-				_, err = etcdDB.StartTask(logger, taskGuid, cellId)
+				_, _, _, err = etcdDB.StartTask(logger, taskGuid, cellId)
 				Expect(err).NotTo(HaveOccurred())
 
-				task, err := etcdDB.CompleteTask(logger, taskGuid, cellId, true, "'cause I said so", "a magical result")
+				_, task, err := etcdDB.CompleteTask(logger, taskGuid, cellId, true, "'cause I said so", "a magical result")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(task.TaskGuid).To(Equal(taskGuid))
 
-				err = etcdDB.ResolvingTask(logger, taskGuid)
+				_, _, err = etcdDB.ResolvingTask(logger, taskGuid)
 				Expect(err).NotTo(HaveOccurred())
 			})
 
@@ -338,6 +410,14 @@ var _ = Describe("Convergence of Tasks", func() {
 					Expect(returnedTask.UpdatedAt).To(Equal(clock.Now().UnixNano()))
 				})
 
+				It("returns a TaskChangedEvent for the demoted task", func() {
+					Expect(taskEvents).To(HaveLen(1))
+					changedEvent, ok := taskEvents[0].(*models.TaskChangedEvent)
+					Expect(ok).To(BeTrue())
+					Expect(changedEvent.Before.State).To(Equal(models.Task_Resolving))
+					Expect(changedEvent.After.State).To(Equal(models.Task_Completed))
+				})
+
 				It("returns the task to complete", func() {
 					Expect(tasksToComplete).To(HaveLen(1))
 					Expect(tasksToComplete[0].TaskGuid).To(Equal(taskGuid))
@@ -12,6 +12,7 @@ import (
 	"code.cloudfoundry.org/bbs/migration"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"code.cloudfoundry.org/bbs/test_helpers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -131,7 +132,11 @@ var _ = Describe("ETCD to SQL Migration", func() {
 
 			It("creates the sql schema and returns", func() {
 				Expect(migrationErr).NotTo(HaveOccurred())
-				rows, err := rawSQLDB.Query(`SELECT table_name FROM information_schema.tables`)
+				query := `SELECT table_name FROM information_schema.tables`
+				if test_helpers.UseSQLite() {
+					query = `SELECT name FROM sqlite_master WHERE type = 'table'`
+				}
+				rows, err := rawSQLDB.Query(query)
 				Expect(err).NotTo(HaveOccurred())
 				defer rows.Close()
 
@@ -67,7 +67,10 @@ func (e *IncreaseErrorColumnsSize) Down(logger lager.Logger) error {
 func (e *IncreaseErrorColumnsSize) alterTables(logger lager.Logger, db *sql.DB, flavor string) error {
 	var alterActualLRPsSQL string
 
-	if e.dbFlavor == "mysql" {
+	if e.dbFlavor == "sqlite3" {
+		// sqlite does not enforce the length of VARCHAR columns
+		return nil
+	} else if e.dbFlavor == "mysql" {
 		alterActualLRPsSQL = `ALTER TABLE actual_lrps
 	MODIFY crash_reason VARCHAR(1024) NOT NULL DEFAULT '',
 	MODIFY placement_error VARCHAR(1024) NOT NULL DEFAULT ''`
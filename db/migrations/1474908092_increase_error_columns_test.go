@@ -92,7 +92,7 @@ var _ = Describe("Increase Error Columns Migration", func() {
 		It("does not remove non null constraint", func() {
 			query := helpers.RebindForFlavor("insert into actual_lrps(crash_reason) values(?)", flavor)
 			_, err := rawSQLDB.Exec(query, nil)
-			Expect(err).To(MatchError(ContainSubstring("null")))
+			Expect(err).To(MatchError(MatchRegexp("(?i)null")))
 		})
 	})
 
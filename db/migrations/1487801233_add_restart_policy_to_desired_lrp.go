@@ -0,0 +1,72 @@
+package migrations
+
+import (
+	"database/sql"
+	"errors"
+
+	"code.cloudfoundry.org/bbs/db/etcd"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+)
+
+func init() {
+	AppendMigration(NewAddRestartPolicyToDesiredLRPs())
+}
+
+type AddRestartPolicyToDesiredLRPs struct {
+	serializer  format.Serializer
+	storeClient etcd.StoreClient
+	clock       clock.Clock
+	rawSQLDB    *sql.DB
+	dbFlavor    string
+}
+
+func NewAddRestartPolicyToDesiredLRPs() migration.Migration {
+	return &AddRestartPolicyToDesiredLRPs{}
+}
+
+func (e *AddRestartPolicyToDesiredLRPs) String() string {
+	return "1487801233"
+}
+
+func (e *AddRestartPolicyToDesiredLRPs) Version() int64 {
+	return 1487801233
+}
+
+func (e *AddRestartPolicyToDesiredLRPs) SetStoreClient(storeClient etcd.StoreClient) {
+	e.storeClient = storeClient
+}
+
+func (e *AddRestartPolicyToDesiredLRPs) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddRestartPolicyToDesiredLRPs) SetRawSQLDB(db *sql.DB) {
+	e.rawSQLDB = db
+}
+
+func (e *AddRestartPolicyToDesiredLRPs) RequiresSQL() bool         { return true }
+func (e *AddRestartPolicyToDesiredLRPs) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddRestartPolicyToDesiredLRPs) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddRestartPolicyToDesiredLRPs) Up(logger lager.Logger) error {
+	logger.Info("altering the table", lager.Data{"query": alterDesiredLRPAddRestartPolicySQL})
+	_, err := e.rawSQLDB.Exec(alterDesiredLRPAddRestartPolicySQL)
+	if err != nil {
+		logger.Error("failed-altering-tables", err)
+		return err
+	}
+	logger.Info("altered the table", lager.Data{"query": alterDesiredLRPAddRestartPolicySQL})
+
+	return nil
+}
+
+const alterDesiredLRPAddRestartPolicySQL = `ALTER TABLE desired_lrps
+	ADD COLUMN restart_policy TEXT;`
+
+func (e *AddRestartPolicyToDesiredLRPs) Down(logger lager.Logger) error {
+	return errors.New("not implemented")
+}
@@ -0,0 +1,86 @@
+package migrations
+
+import (
+	"database/sql"
+	"errors"
+
+	"code.cloudfoundry.org/bbs/db/etcd"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+)
+
+func init() {
+	AppendMigration(NewAddRetryStateToTasks())
+}
+
+type AddRetryStateToTasks struct {
+	serializer  format.Serializer
+	storeClient etcd.StoreClient
+	clock       clock.Clock
+	rawSQLDB    *sql.DB
+	dbFlavor    string
+}
+
+func NewAddRetryStateToTasks() migration.Migration {
+	return &AddRetryStateToTasks{}
+}
+
+func (e *AddRetryStateToTasks) String() string {
+	return "1488215447"
+}
+
+func (e *AddRetryStateToTasks) Version() int64 {
+	return 1488215447
+}
+
+func (e *AddRetryStateToTasks) SetStoreClient(storeClient etcd.StoreClient) {
+	e.storeClient = storeClient
+}
+
+func (e *AddRetryStateToTasks) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddRetryStateToTasks) SetRawSQLDB(db *sql.DB) {
+	e.rawSQLDB = db
+}
+
+func (e *AddRetryStateToTasks) RequiresSQL() bool         { return true }
+func (e *AddRetryStateToTasks) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddRetryStateToTasks) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddRetryStateToTasks) Up(logger lager.Logger) error {
+	queries := []string{alterTasksAddRetryStateSQL}
+	if e.dbFlavor == "sqlite3" {
+		// sqlite can only add one column per ALTER TABLE
+		queries = alterTasksAddRetryStateSQLite
+	}
+
+	for _, query := range queries {
+		logger.Info("altering the table", lager.Data{"query": query})
+		_, err := e.rawSQLDB.Exec(query)
+		if err != nil {
+			logger.Error("failed-altering-tables", err)
+			return err
+		}
+		logger.Info("altered the table", lager.Data{"query": query})
+	}
+
+	return nil
+}
+
+const alterTasksAddRetryStateSQL = `ALTER TABLE tasks
+	ADD COLUMN retry_count INTEGER DEFAULT 0,
+	ADD COLUMN failure_history TEXT;`
+
+var alterTasksAddRetryStateSQLite = []string{
+	`ALTER TABLE tasks ADD COLUMN retry_count INTEGER DEFAULT 0`,
+	`ALTER TABLE tasks ADD COLUMN failure_history TEXT`,
+}
+
+func (e *AddRetryStateToTasks) Down(logger lager.Logger) error {
+	return errors.New("not implemented")
+}
@@ -0,0 +1,119 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"time"
+
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Add Retry State to Tasks", func() {
+	var (
+		mig       migration.Migration
+		migErr    error
+		fakeClock *fakeclock.FakeClock
+	)
+
+	BeforeEach(func() {
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		rawSQLDB.Exec("DROP TABLE domains;")
+		rawSQLDB.Exec("DROP TABLE tasks;")
+		rawSQLDB.Exec("DROP TABLE desired_lrps;")
+		rawSQLDB.Exec("DROP TABLE actual_lrps;")
+
+		mig = migrations.NewAddRetryStateToTasks()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.Migrations).To(ContainElement(mig))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(mig.Version()).To(BeEquivalentTo(1488215447))
+		})
+	})
+
+	Describe("Up", func() {
+		var initialMigrations migration.Migrations
+
+		BeforeEach(func() {
+			initialMigrations = []migration.Migration{
+				migrations.NewETCDToSQL(),
+				migrations.NewIncreaseRunInfoColumnSize(),
+			}
+
+			for _, m := range initialMigrations {
+				m.SetRawSQLDB(rawSQLDB)
+				m.SetDBFlavor(flavor)
+				m.SetClock(fakeClock)
+				err := m.Up(logger)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			// Can't do this in the Describe BeforeEach
+			// as the test on line 37 will cause ginkgo to panic
+			mig.SetRawSQLDB(rawSQLDB)
+			mig.SetDBFlavor(flavor)
+		})
+
+		JustBeforeEach(func() {
+			migErr = mig.Up(logger)
+		})
+
+		It("does not error out", func() {
+			Expect(migErr).NotTo(HaveOccurred())
+		})
+
+		It("should add retry_count and failure_history columns to tasks", func() {
+			_, err := rawSQLDB.Exec(
+				helpers.RebindForFlavor(
+					`INSERT INTO tasks
+						  (guid, domain, task_definition, retry_count, failure_history)
+						  VALUES (?, ?, ?, ?, ?)`,
+					flavor,
+				),
+				"guid", "domain", "task def", 2, "history",
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			var retryCount int
+			var failureHistory string
+			query := helpers.RebindForFlavor("select retry_count, failure_history from tasks limit 1", flavor)
+			row := rawSQLDB.QueryRow(query)
+			Expect(row.Scan(&retryCount, &failureHistory)).NotTo(HaveOccurred())
+			Expect(retryCount).To(Equal(2))
+			Expect(failureHistory).To(Equal("history"))
+		})
+
+		It("should default retry_count to 0 and failure_history to NULL", func() {
+			_, err := rawSQLDB.Exec(
+				helpers.RebindForFlavor(
+					`INSERT INTO tasks (guid, domain, task_definition) VALUES (?, ?, ?)`,
+					flavor,
+				),
+				"guid", "domain", "task def",
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			var retryCount int
+			var failureHistory sql.NullString
+			query := helpers.RebindForFlavor("select retry_count, failure_history from tasks limit 1", flavor)
+			row := rawSQLDB.QueryRow(query)
+			Expect(row.Scan(&retryCount, &failureHistory)).NotTo(HaveOccurred())
+			Expect(retryCount).To(Equal(0))
+			Expect(failureHistory.Valid).To(BeFalse())
+		})
+	})
+
+	Describe("Down", func() {
+		It("returns a not implemented error", func() {
+			Expect(mig.Down(logger)).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,113 @@
+package migrations
+
+import (
+	"database/sql"
+	"errors"
+
+	"code.cloudfoundry.org/bbs/db/etcd"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+)
+
+func init() {
+	AppendMigration(NewAddAuditEvents())
+}
+
+type AddAuditEvents struct {
+	serializer  format.Serializer
+	storeClient etcd.StoreClient
+	clock       clock.Clock
+	rawSQLDB    *sql.DB
+	dbFlavor    string
+}
+
+func NewAddAuditEvents() migration.Migration {
+	return &AddAuditEvents{}
+}
+
+func (e *AddAuditEvents) String() string {
+	return "1489683121"
+}
+
+func (e *AddAuditEvents) Version() int64 {
+	return 1489683121
+}
+
+func (e *AddAuditEvents) SetStoreClient(storeClient etcd.StoreClient) {
+	e.storeClient = storeClient
+}
+
+func (e *AddAuditEvents) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddAuditEvents) SetRawSQLDB(db *sql.DB) {
+	e.rawSQLDB = db
+}
+
+func (e *AddAuditEvents) RequiresSQL() bool         { return true }
+func (e *AddAuditEvents) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddAuditEvents) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddAuditEvents) Up(logger lager.Logger) error {
+	logger = logger.Session("add-audit-events")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	var createAuditEventsSQL string
+	switch e.dbFlavor {
+	case helpers.MySQL:
+		createAuditEventsSQL = createAuditEventsMySQL
+	case helpers.SQLite:
+		createAuditEventsSQL = createAuditEventsSQLite
+	default:
+		createAuditEventsSQL = createAuditEventsPostgres
+	}
+
+	queries := append([]string{helpers.RebindForFlavor(createAuditEventsSQL, e.dbFlavor)}, createAuditEventsIndices...)
+	for _, query := range queries {
+		logger.Info("executing", lager.Data{"query": query})
+		_, err := e.rawSQLDB.Exec(query)
+		if err != nil {
+			logger.Error("failed-creating-audit-events", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *AddAuditEvents) Down(logger lager.Logger) error {
+	return errors.New("not implemented")
+}
+
+const auditEventsColumnsSQL = `
+	created_at BIGINT NOT NULL DEFAULT 0,
+	route VARCHAR(255) NOT NULL DEFAULT '',
+	actor VARCHAR(255) NOT NULL DEFAULT '',
+	remote_address VARCHAR(255) NOT NULL DEFAULT '',
+	request_id VARCHAR(255) NOT NULL DEFAULT '',
+	domain VARCHAR(255) NOT NULL DEFAULT '',
+	guid VARCHAR(255) NOT NULL DEFAULT '',
+	summary MEDIUMTEXT,
+	error_type VARCHAR(255) NOT NULL DEFAULT '',
+	error_message MEDIUMTEXT
+);`
+
+const createAuditEventsMySQL = `CREATE TABLE audit_events(
+	id BIGINT AUTO_INCREMENT PRIMARY KEY,` + auditEventsColumnsSQL
+
+const createAuditEventsPostgres = `CREATE TABLE audit_events(
+	id BIGSERIAL PRIMARY KEY,` + auditEventsColumnsSQL
+
+const createAuditEventsSQLite = `CREATE TABLE audit_events(
+	id INTEGER PRIMARY KEY AUTOINCREMENT,` + auditEventsColumnsSQL
+
+var createAuditEventsIndices = []string{
+	`CREATE INDEX audit_events_created_at_idx ON audit_events (created_at)`,
+	`CREATE INDEX audit_events_domain_idx ON audit_events (domain)`,
+}
@@ -0,0 +1,110 @@
+package migrations_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Add Audit Events", func() {
+	var (
+		mig       migration.Migration
+		migErr    error
+		fakeClock *fakeclock.FakeClock
+	)
+
+	BeforeEach(func() {
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		rawSQLDB.Exec("DROP TABLE domains;")
+		rawSQLDB.Exec("DROP TABLE tasks;")
+		rawSQLDB.Exec("DROP TABLE desired_lrps;")
+		rawSQLDB.Exec("DROP TABLE actual_lrps;")
+		rawSQLDB.Exec("DROP TABLE audit_events;")
+
+		mig = migrations.NewAddAuditEvents()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.Migrations).To(ContainElement(mig))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(mig.Version()).To(BeEquivalentTo(1489683121))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			initialMigration := migrations.NewETCDToSQL()
+			initialMigration.SetRawSQLDB(rawSQLDB)
+			initialMigration.SetDBFlavor(flavor)
+			initialMigration.SetClock(fakeClock)
+			Expect(initialMigration.Up(logger)).To(Succeed())
+
+			mig.SetRawSQLDB(rawSQLDB)
+			mig.SetDBFlavor(flavor)
+		})
+
+		JustBeforeEach(func() {
+			migErr = mig.Up(logger)
+		})
+
+		It("does not error out", func() {
+			Expect(migErr).NotTo(HaveOccurred())
+		})
+
+		It("creates the audit_events table with generated ids", func() {
+			for _, guid := range []string{"guid-1", "guid-2"} {
+				_, err := rawSQLDB.Exec(
+					helpers.RebindForFlavor(
+						`INSERT INTO audit_events
+							(created_at, route, actor, domain, guid, summary, error_type, error_message)
+							VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+						flavor,
+					),
+					1000, "RemoveDesiredLRP", "cc", "some-domain", guid, "", "ResourceNotFound", "the requested resource could not be found",
+				)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			var ids []int64
+			rows, err := rawSQLDB.Query("SELECT id FROM audit_events ORDER BY id")
+			Expect(err).NotTo(HaveOccurred())
+			defer rows.Close()
+			for rows.Next() {
+				var id int64
+				Expect(rows.Scan(&id)).To(Succeed())
+				ids = append(ids, id)
+			}
+			Expect(ids).To(HaveLen(2))
+			Expect(ids[1]).To(BeNumerically(">", ids[0]))
+		})
+
+		It("defaults the optional columns", func() {
+			_, err := rawSQLDB.Exec(
+				helpers.RebindForFlavor(`INSERT INTO audit_events (created_at) VALUES (?)`, flavor),
+				1000,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			var route, actor, errorType string
+			row := rawSQLDB.QueryRow("SELECT route, actor, error_type FROM audit_events")
+			Expect(row.Scan(&route, &actor, &errorType)).To(Succeed())
+			Expect(route).To(BeEmpty())
+			Expect(actor).To(BeEmpty())
+			Expect(errorType).To(BeEmpty())
+		})
+	})
+
+	Describe("Down", func() {
+		It("returns a not implemented error", func() {
+			Expect(mig.Down(logger)).To(HaveOccurred())
+		})
+	})
+})
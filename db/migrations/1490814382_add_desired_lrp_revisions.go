@@ -0,0 +1,84 @@
+package migrations
+
+import (
+	"database/sql"
+	"errors"
+
+	"code.cloudfoundry.org/bbs/db/etcd"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+)
+
+func init() {
+	AppendMigration(NewAddDesiredLRPRevisions())
+}
+
+type AddDesiredLRPRevisions struct {
+	serializer  format.Serializer
+	storeClient etcd.StoreClient
+	clock       clock.Clock
+	rawSQLDB    *sql.DB
+	dbFlavor    string
+}
+
+func NewAddDesiredLRPRevisions() migration.Migration {
+	return &AddDesiredLRPRevisions{}
+}
+
+func (e *AddDesiredLRPRevisions) String() string {
+	return "1490814382"
+}
+
+func (e *AddDesiredLRPRevisions) Version() int64 {
+	return 1490814382
+}
+
+func (e *AddDesiredLRPRevisions) SetStoreClient(storeClient etcd.StoreClient) {
+	e.storeClient = storeClient
+}
+
+func (e *AddDesiredLRPRevisions) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddDesiredLRPRevisions) SetRawSQLDB(db *sql.DB) {
+	e.rawSQLDB = db
+}
+
+func (e *AddDesiredLRPRevisions) RequiresSQL() bool         { return true }
+func (e *AddDesiredLRPRevisions) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddDesiredLRPRevisions) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddDesiredLRPRevisions) Up(logger lager.Logger) error {
+	logger = logger.Session("add-desired-lrp-revisions")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	query := helpers.RebindForFlavor(createDesiredLRPRevisionsSQL, e.dbFlavor)
+	logger.Info("executing", lager.Data{"query": query})
+	_, err := e.rawSQLDB.Exec(query)
+	if err != nil {
+		logger.Error("failed-creating-desired-lrp-revisions", err)
+		return err
+	}
+
+	return nil
+}
+
+func (e *AddDesiredLRPRevisions) Down(logger lager.Logger) error {
+	return errors.New("not implemented")
+}
+
+const createDesiredLRPRevisionsSQL = `CREATE TABLE desired_lrp_revisions(
+	process_guid VARCHAR(255) NOT NULL,
+	revision BIGINT NOT NULL,
+	modification_tag_epoch VARCHAR(255) NOT NULL,
+	modification_tag_index INT NOT NULL,
+	created_at BIGINT NOT NULL DEFAULT 0,
+	desired_lrp MEDIUMTEXT NOT NULL,
+	PRIMARY KEY(process_guid, revision)
+);`
@@ -0,0 +1,85 @@
+package migrations_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Add DesiredLRP Revisions", func() {
+	var (
+		mig       migration.Migration
+		migErr    error
+		fakeClock *fakeclock.FakeClock
+	)
+
+	BeforeEach(func() {
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		rawSQLDB.Exec("DROP TABLE domains;")
+		rawSQLDB.Exec("DROP TABLE tasks;")
+		rawSQLDB.Exec("DROP TABLE desired_lrps;")
+		rawSQLDB.Exec("DROP TABLE actual_lrps;")
+		rawSQLDB.Exec("DROP TABLE desired_lrp_revisions;")
+
+		mig = migrations.NewAddDesiredLRPRevisions()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.Migrations).To(ContainElement(mig))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(mig.Version()).To(BeEquivalentTo(1490814382))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			initialMigration := migrations.NewETCDToSQL()
+			initialMigration.SetRawSQLDB(rawSQLDB)
+			initialMigration.SetDBFlavor(flavor)
+			initialMigration.SetClock(fakeClock)
+			Expect(initialMigration.Up(logger)).To(Succeed())
+
+			mig.SetRawSQLDB(rawSQLDB)
+			mig.SetDBFlavor(flavor)
+		})
+
+		JustBeforeEach(func() {
+			migErr = mig.Up(logger)
+		})
+
+		It("does not error out", func() {
+			Expect(migErr).NotTo(HaveOccurred())
+		})
+
+		It("creates the desired_lrp_revisions table keyed by process guid and revision", func() {
+			insert := helpers.RebindForFlavor(
+				`INSERT INTO desired_lrp_revisions
+					(process_guid, revision, modification_tag_epoch, modification_tag_index, created_at, desired_lrp)
+					VALUES (?, ?, ?, ?, ?, ?)`,
+				flavor,
+			)
+
+			_, err := rawSQLDB.Exec(insert, "some-guid", 1, "some-epoch", 0, 1000, "some-lrp")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = rawSQLDB.Exec(insert, "some-guid", 2, "some-epoch", 1, 2000, "some-lrp")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = rawSQLDB.Exec(insert, "some-guid", 2, "some-epoch", 2, 3000, "some-lrp")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("Down", func() {
+		It("returns a not implemented error", func() {
+			Expect(mig.Down(logger)).To(HaveOccurred())
+		})
+	})
+})
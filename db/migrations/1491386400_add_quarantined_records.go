@@ -0,0 +1,108 @@
+package migrations
+
+import (
+	"database/sql"
+	"errors"
+
+	"code.cloudfoundry.org/bbs/db/etcd"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+)
+
+func init() {
+	AppendMigration(NewAddQuarantinedRecords())
+}
+
+type AddQuarantinedRecords struct {
+	serializer  format.Serializer
+	storeClient etcd.StoreClient
+	clock       clock.Clock
+	rawSQLDB    *sql.DB
+	dbFlavor    string
+}
+
+func NewAddQuarantinedRecords() migration.Migration {
+	return &AddQuarantinedRecords{}
+}
+
+func (e *AddQuarantinedRecords) String() string {
+	return "1491386400"
+}
+
+func (e *AddQuarantinedRecords) Version() int64 {
+	return 1491386400
+}
+
+func (e *AddQuarantinedRecords) SetStoreClient(storeClient etcd.StoreClient) {
+	e.storeClient = storeClient
+}
+
+func (e *AddQuarantinedRecords) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddQuarantinedRecords) SetRawSQLDB(db *sql.DB) {
+	e.rawSQLDB = db
+}
+
+func (e *AddQuarantinedRecords) RequiresSQL() bool         { return true }
+func (e *AddQuarantinedRecords) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddQuarantinedRecords) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddQuarantinedRecords) Up(logger lager.Logger) error {
+	logger = logger.Session("add-quarantined-records")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	var createQuarantinedRecordsSQL string
+	switch e.dbFlavor {
+	case helpers.MySQL:
+		createQuarantinedRecordsSQL = createQuarantinedRecordsMySQL
+	case helpers.SQLite:
+		createQuarantinedRecordsSQL = createQuarantinedRecordsSQLite
+	default:
+		createQuarantinedRecordsSQL = createQuarantinedRecordsPostgres
+	}
+
+	queries := []string{
+		helpers.RebindForFlavor(createQuarantinedRecordsSQL, e.dbFlavor),
+		createQuarantinedRecordsTableNameIndex,
+	}
+	for _, query := range queries {
+		logger.Info("executing", lager.Data{"query": query})
+		_, err := e.rawSQLDB.Exec(query)
+		if err != nil {
+			logger.Error("failed-creating-quarantined-records", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *AddQuarantinedRecords) Down(logger lager.Logger) error {
+	return errors.New("not implemented")
+}
+
+const quarantinedRecordsColumnsSQL = `
+	table_name VARCHAR(255) NOT NULL,
+	guid VARCHAR(255) NOT NULL DEFAULT '',
+	reason MEDIUMTEXT,
+	quarantined_at BIGINT NOT NULL DEFAULT 0,
+	record MEDIUMTEXT NOT NULL
+);`
+
+const createQuarantinedRecordsMySQL = `CREATE TABLE quarantined_records(
+	id BIGINT AUTO_INCREMENT PRIMARY KEY,` + quarantinedRecordsColumnsSQL
+
+const createQuarantinedRecordsPostgres = `CREATE TABLE quarantined_records(
+	id BIGSERIAL PRIMARY KEY,` + quarantinedRecordsColumnsSQL
+
+const createQuarantinedRecordsSQLite = `CREATE TABLE quarantined_records(
+	id INTEGER PRIMARY KEY AUTOINCREMENT,` + quarantinedRecordsColumnsSQL
+
+const createQuarantinedRecordsTableNameIndex = `CREATE INDEX quarantined_records_table_name_idx ON quarantined_records (table_name)`
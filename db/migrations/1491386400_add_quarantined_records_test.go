@@ -0,0 +1,95 @@
+package migrations_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Add Quarantined Records", func() {
+	var (
+		mig       migration.Migration
+		migErr    error
+		fakeClock *fakeclock.FakeClock
+	)
+
+	BeforeEach(func() {
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		rawSQLDB.Exec("DROP TABLE domains;")
+		rawSQLDB.Exec("DROP TABLE tasks;")
+		rawSQLDB.Exec("DROP TABLE desired_lrps;")
+		rawSQLDB.Exec("DROP TABLE actual_lrps;")
+		rawSQLDB.Exec("DROP TABLE quarantined_records;")
+
+		mig = migrations.NewAddQuarantinedRecords()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.Migrations).To(ContainElement(mig))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(mig.Version()).To(BeEquivalentTo(1491386400))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			initialMigration := migrations.NewETCDToSQL()
+			initialMigration.SetRawSQLDB(rawSQLDB)
+			initialMigration.SetDBFlavor(flavor)
+			initialMigration.SetClock(fakeClock)
+			Expect(initialMigration.Up(logger)).To(Succeed())
+
+			mig.SetRawSQLDB(rawSQLDB)
+			mig.SetDBFlavor(flavor)
+		})
+
+		JustBeforeEach(func() {
+			migErr = mig.Up(logger)
+		})
+
+		It("does not error out", func() {
+			Expect(migErr).NotTo(HaveOccurred())
+		})
+
+		It("creates the quarantined_records table with generated ids", func() {
+			for _, guid := range []string{"guid-1", "guid-2"} {
+				_, err := rawSQLDB.Exec(
+					helpers.RebindForFlavor(
+						`INSERT INTO quarantined_records
+							(table_name, guid, reason, quarantined_at, record)
+							VALUES (?, ?, ?, ?, ?)`,
+						flavor,
+					),
+					"desired_lrps", guid, "some-reason", 1000, `{"process_guid":"`+guid+`"}`,
+				)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			var ids []int64
+			rows, err := rawSQLDB.Query("SELECT id FROM quarantined_records ORDER BY id")
+			Expect(err).NotTo(HaveOccurred())
+			defer rows.Close()
+			for rows.Next() {
+				var id int64
+				Expect(rows.Scan(&id)).To(Succeed())
+				ids = append(ids, id)
+			}
+			Expect(ids).To(HaveLen(2))
+			Expect(ids[1]).To(BeNumerically(">", ids[0]))
+		})
+	})
+
+	Describe("Down", func() {
+		It("returns a not implemented error", func() {
+			Expect(mig.Down(logger)).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,84 @@
+package migrations
+
+import (
+	"database/sql"
+	"errors"
+
+	"code.cloudfoundry.org/bbs/db/etcd"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+)
+
+func init() {
+	AppendMigration(NewAddEncryptionProgress())
+}
+
+type AddEncryptionProgress struct {
+	serializer  format.Serializer
+	storeClient etcd.StoreClient
+	clock       clock.Clock
+	rawSQLDB    *sql.DB
+	dbFlavor    string
+}
+
+func NewAddEncryptionProgress() migration.Migration {
+	return &AddEncryptionProgress{}
+}
+
+func (e *AddEncryptionProgress) String() string {
+	return "1492041600"
+}
+
+func (e *AddEncryptionProgress) Version() int64 {
+	return 1492041600
+}
+
+func (e *AddEncryptionProgress) SetStoreClient(storeClient etcd.StoreClient) {
+	e.storeClient = storeClient
+}
+
+func (e *AddEncryptionProgress) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddEncryptionProgress) SetRawSQLDB(db *sql.DB) {
+	e.rawSQLDB = db
+}
+
+func (e *AddEncryptionProgress) RequiresSQL() bool         { return true }
+func (e *AddEncryptionProgress) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddEncryptionProgress) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddEncryptionProgress) Up(logger lager.Logger) error {
+	logger = logger.Session("add-encryption-progress")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	query := helpers.RebindForFlavor(createEncryptionProgressSQL, e.dbFlavor)
+	logger.Info("executing", lager.Data{"query": query})
+	_, err := e.rawSQLDB.Exec(query)
+	if err != nil {
+		logger.Error("failed-creating-encryption-progress", err)
+		return err
+	}
+
+	return nil
+}
+
+func (e *AddEncryptionProgress) Down(logger lager.Logger) error {
+	return errors.New("not implemented")
+}
+
+const createEncryptionProgressSQL = `CREATE TABLE encryption_progress(
+	table_name VARCHAR(255) PRIMARY KEY,
+	key_label VARCHAR(255) NOT NULL,
+	last_key MEDIUMTEXT,
+	rows_done BIGINT NOT NULL DEFAULT 0,
+	old_key_rows BIGINT NOT NULL DEFAULT 0,
+	completed_at BIGINT NOT NULL DEFAULT 0,
+	updated_at BIGINT NOT NULL DEFAULT 0
+);`
@@ -0,0 +1,89 @@
+package migrations_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Add Encryption Progress", func() {
+	var (
+		mig       migration.Migration
+		migErr    error
+		fakeClock *fakeclock.FakeClock
+	)
+
+	BeforeEach(func() {
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		rawSQLDB.Exec("DROP TABLE domains;")
+		rawSQLDB.Exec("DROP TABLE tasks;")
+		rawSQLDB.Exec("DROP TABLE desired_lrps;")
+		rawSQLDB.Exec("DROP TABLE actual_lrps;")
+		rawSQLDB.Exec("DROP TABLE encryption_progress;")
+
+		mig = migrations.NewAddEncryptionProgress()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.Migrations).To(ContainElement(mig))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(mig.Version()).To(BeEquivalentTo(1492041600))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			initialMigration := migrations.NewETCDToSQL()
+			initialMigration.SetRawSQLDB(rawSQLDB)
+			initialMigration.SetDBFlavor(flavor)
+			initialMigration.SetClock(fakeClock)
+			Expect(initialMigration.Up(logger)).To(Succeed())
+
+			mig.SetRawSQLDB(rawSQLDB)
+			mig.SetDBFlavor(flavor)
+		})
+
+		JustBeforeEach(func() {
+			migErr = mig.Up(logger)
+		})
+
+		It("does not error out", func() {
+			Expect(migErr).NotTo(HaveOccurred())
+		})
+
+		It("creates the encryption_progress table with one row per table", func() {
+			insertQuery := helpers.RebindForFlavor(
+				`INSERT INTO encryption_progress (table_name, key_label, last_key, rows_done) VALUES (?, ?, ?, ?)`,
+				flavor,
+			)
+
+			_, err := rawSQLDB.Exec(insertQuery, "tasks", "new-key", `["some-guid"]`, 10)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = rawSQLDB.Exec(insertQuery, "tasks", "new-key", `["other-guid"]`, 20)
+			Expect(err).To(HaveOccurred())
+
+			var lastKey string
+			var rowsDone, completedAt int64
+			err = rawSQLDB.QueryRow("SELECT last_key, rows_done, completed_at FROM encryption_progress").Scan(&lastKey, &rowsDone, &completedAt)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lastKey).To(Equal(`["some-guid"]`))
+			Expect(rowsDone).To(BeEquivalentTo(10))
+			Expect(completedAt).To(BeZero())
+		})
+	})
+
+	Describe("Down", func() {
+		It("returns a not implemented error", func() {
+			Expect(mig.Down(logger)).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,86 @@
+package migrations
+
+import (
+	"database/sql"
+	"errors"
+
+	"code.cloudfoundry.org/bbs/db/etcd"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+)
+
+func init() {
+	AppendMigration(NewAddDesiredLRPRollouts())
+}
+
+type AddDesiredLRPRollouts struct {
+	serializer  format.Serializer
+	storeClient etcd.StoreClient
+	clock       clock.Clock
+	rawSQLDB    *sql.DB
+	dbFlavor    string
+}
+
+func NewAddDesiredLRPRollouts() migration.Migration {
+	return &AddDesiredLRPRollouts{}
+}
+
+func (e *AddDesiredLRPRollouts) String() string {
+	return "1492646400"
+}
+
+func (e *AddDesiredLRPRollouts) Version() int64 {
+	return 1492646400
+}
+
+func (e *AddDesiredLRPRollouts) SetStoreClient(storeClient etcd.StoreClient) {
+	e.storeClient = storeClient
+}
+
+func (e *AddDesiredLRPRollouts) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddDesiredLRPRollouts) SetRawSQLDB(db *sql.DB) {
+	e.rawSQLDB = db
+}
+
+func (e *AddDesiredLRPRollouts) RequiresSQL() bool         { return true }
+func (e *AddDesiredLRPRollouts) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddDesiredLRPRollouts) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddDesiredLRPRollouts) Up(logger lager.Logger) error {
+	logger = logger.Session("add-desired-lrp-rollouts")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	query := helpers.RebindForFlavor(createDesiredLRPRolloutsSQL, e.dbFlavor)
+	logger.Info("executing", lager.Data{"query": query})
+	_, err := e.rawSQLDB.Exec(query)
+	if err != nil {
+		logger.Error("failed-creating-desired-lrp-rollouts", err)
+		return err
+	}
+
+	return nil
+}
+
+func (e *AddDesiredLRPRollouts) Down(logger lager.Logger) error {
+	return errors.New("not implemented")
+}
+
+const createDesiredLRPRolloutsSQL = `CREATE TABLE desired_lrp_rollouts(
+	process_guid VARCHAR(255) PRIMARY KEY,
+	modification_tag_epoch VARCHAR(255),
+	modification_tag_index INTEGER,
+	max_in_flight INTEGER NOT NULL DEFAULT 1,
+	started_at BIGINT NOT NULL DEFAULT 0,
+	deadline BIGINT NOT NULL DEFAULT 0,
+	replaced_indices MEDIUMTEXT,
+	state VARCHAR(255) NOT NULL,
+	failure_reason VARCHAR(1024) NOT NULL DEFAULT ''
+);`
@@ -0,0 +1,89 @@
+package migrations_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Add Desired LRP Rollouts", func() {
+	var (
+		mig       migration.Migration
+		migErr    error
+		fakeClock *fakeclock.FakeClock
+	)
+
+	BeforeEach(func() {
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		rawSQLDB.Exec("DROP TABLE domains;")
+		rawSQLDB.Exec("DROP TABLE tasks;")
+		rawSQLDB.Exec("DROP TABLE desired_lrps;")
+		rawSQLDB.Exec("DROP TABLE actual_lrps;")
+		rawSQLDB.Exec("DROP TABLE desired_lrp_rollouts;")
+
+		mig = migrations.NewAddDesiredLRPRollouts()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.Migrations).To(ContainElement(mig))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(mig.Version()).To(BeEquivalentTo(1492646400))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			initialMigration := migrations.NewETCDToSQL()
+			initialMigration.SetRawSQLDB(rawSQLDB)
+			initialMigration.SetDBFlavor(flavor)
+			initialMigration.SetClock(fakeClock)
+			Expect(initialMigration.Up(logger)).To(Succeed())
+
+			mig.SetRawSQLDB(rawSQLDB)
+			mig.SetDBFlavor(flavor)
+		})
+
+		JustBeforeEach(func() {
+			migErr = mig.Up(logger)
+		})
+
+		It("does not error out", func() {
+			Expect(migErr).NotTo(HaveOccurred())
+		})
+
+		It("creates the desired_lrp_rollouts table with one row per process guid", func() {
+			insertQuery := helpers.RebindForFlavor(
+				`INSERT INTO desired_lrp_rollouts (process_guid, modification_tag_epoch, modification_tag_index, replaced_indices, state) VALUES (?, ?, ?, ?, ?)`,
+				flavor,
+			)
+
+			_, err := rawSQLDB.Exec(insertQuery, "some-guid", "some-epoch", 1, "[0,1]", "IN_PROGRESS")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = rawSQLDB.Exec(insertQuery, "some-guid", "some-epoch", 2, "[]", "IN_PROGRESS")
+			Expect(err).To(HaveOccurred())
+
+			var replacedIndices, failureReason string
+			var maxInFlight int32
+			err = rawSQLDB.QueryRow("SELECT replaced_indices, max_in_flight, failure_reason FROM desired_lrp_rollouts").Scan(&replacedIndices, &maxInFlight, &failureReason)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(replacedIndices).To(Equal("[0,1]"))
+			Expect(maxInFlight).To(BeEquivalentTo(1))
+			Expect(failureReason).To(BeEmpty())
+		})
+	})
+
+	Describe("Down", func() {
+		It("returns a not implemented error", func() {
+			Expect(mig.Down(logger)).To(HaveOccurred())
+		})
+	})
+})
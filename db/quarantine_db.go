@@ -0,0 +1,18 @@
+package db
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter . QuarantineDB
+
+// QuarantineDB inspects and restores the LRP and task rows that were moved
+// aside because they could not be decoded. It is only implemented by the SQL
+// backend.
+type QuarantineDB interface {
+	QuarantinedRecords(logger lager.Logger, tableName string) ([]*models.QuarantinedRecord, error)
+	QuarantinedRecord(logger lager.Logger, id int64) (*models.QuarantinedRecord, error)
+	RestoreQuarantinedRecord(logger lager.Logger, id int64) error
+	RestoreQuarantinedRecords(logger lager.Logger, tableName string) ([]int64, []int64, error)
+}
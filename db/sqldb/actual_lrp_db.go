@@ -22,7 +22,7 @@ func (db *SQLDB) getActualLRPS(logger lager.Logger, wheres string, whereBindinng
 			return err
 		}
 		defer rows.Close()
-		groups, err = db.scanAndCleanupActualLRPs(logger, tx, rows)
+		groups, err = db.scanAndCleanupActualLRPs(logger, tx, rows, nil)
 		return err
 	})
 
@@ -30,6 +30,11 @@ func (db *SQLDB) getActualLRPS(logger lager.Logger, wheres string, whereBindinng
 }
 
 func (db *SQLDB) ActualLRPGroups(logger lager.Logger, filter models.ActualLRPFilter) ([]*models.ActualLRPGroup, error) {
+	groups, _, err := db.ActualLRPGroupsPage(logger, filter)
+	return groups, err
+}
+
+func (db *SQLDB) ActualLRPGroupsPage(logger lager.Logger, filter models.ActualLRPFilter) ([]*models.ActualLRPGroup, string, error) {
 	logger = logger.WithData(lager.Data{"filter": filter})
 	logger.Debug("starting")
 	defer logger.Debug("complete")
@@ -42,11 +47,60 @@ func (db *SQLDB) ActualLRPGroups(logger lager.Logger, filter models.ActualLRPFil
 		values = append(values, filter.Domain)
 	}
 
+	if filter.AllowedDomains != nil {
+		where, bindings := whereDomainIn(filter.AllowedDomains)
+		wheres = append(wheres, where)
+		values = append(values, bindings...)
+	}
+
 	if filter.CellID != "" {
 		wheres = append(wheres, "cell_id = ?")
 		values = append(values, filter.CellID)
 	}
-	return db.getActualLRPS(logger, strings.Join(wheres, " AND "), values...)
+
+	if filter.AfterProcessGuid != "" {
+		wheres = append(wheres, "(process_guid > ? OR (process_guid = ? AND instance_index > ?))")
+		values = append(values, filter.AfterProcessGuid, filter.AfterProcessGuid, filter.AfterIndex)
+	}
+
+	// Each group is made of at most two rows (the instance and the evacuating
+	// LRP), so twice the page size is enough to fill a page with complete groups.
+	limit := 2 * filter.PageSize
+	cursor := &pageCursor{}
+
+	var groups []*models.ActualLRPGroup
+	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+		rows, err := db.allOrdered(logger, tx, actualLRPsTable,
+			actualLRPColumns, "process_guid, instance_index, evacuating", limit,
+			strings.Join(wheres, " AND "), values...,
+		)
+		if err != nil {
+			logger.Error("failed-query", err)
+			return err
+		}
+		defer rows.Close()
+		groups, err = db.scanAndCleanupActualLRPs(logger, tx, rows, cursor)
+		return err
+	})
+	if err != nil {
+		return groups, "", err
+	}
+
+	last, ok := cursor.pageEnd(filter.PageSize, limit)
+	if !ok {
+		return groups, "", nil
+	}
+
+	for i, group := range groups {
+		actualLRP, _ := group.Resolve()
+		if actualLRP.ProcessGuid > last.guid ||
+			(actualLRP.ProcessGuid == last.guid && actualLRP.Index > last.index) {
+			groups = groups[:i]
+			break
+		}
+	}
+
+	return groups, filter.NextPageToken(last.guid, last.index), nil
 }
 
 func (db *SQLDB) ActualLRPGroupsByProcessGuid(logger lager.Logger, processGuid string) ([]*models.ActualLRPGroup, error) {
@@ -356,7 +410,13 @@ func (db *SQLDB) CrashActualLRP(logger lager.Logger, key *models.ActualLRPKey, i
 		actualLRP.CrashReason = crashReason
 		evacuating := false
 
-		if actualLRP.ShouldRestartImmediately(models.NewDefaultRestartCalculator()) {
+		restartPolicy, err := db.fetchRestartPolicy(logger, key.ProcessGuid, tx)
+		if err != nil {
+			logger.Error("failed-to-fetch-restart-policy", err)
+			return err
+		}
+
+		if actualLRP.ShouldRestartImmediately(restartPolicy.RestartCalculator()) {
 			actualLRP.State = models.ActualLRPStateUnclaimed
 			immediateRestart = true
 		}
@@ -547,16 +607,17 @@ func (db *SQLDB) scanToActualLRP(logger lager.Logger, row RowScanner) (*models.A
 		err = db.deserializeModel(logger, netInfoData, &actualLRP.ActualLRPNetInfo)
 		if err != nil {
 			logger.Error("failed-unmarshaling-net-info-data", err)
-			return &actualLRP, evacuating, models.ErrDeserialize
+			return &actualLRP, evacuating, &invalidRecordError{guid: actualLRP.ProcessGuid, column: "net_info", err: err}
 		}
 	}
 
 	return &actualLRP, evacuating, nil
 }
 
-type actualToDelete struct {
+type actualToQuarantine struct {
 	*models.ActualLRP
 	evacuating bool
+	invalid    *invalidRecordError
 }
 
 func (db *SQLDB) fetchActualLRPForUpdate(logger lager.Logger, processGuid string, index int32, evacuating bool, tx *sql.Tx) (*models.ActualLRP, error) {
@@ -574,7 +635,7 @@ func (db *SQLDB) fetchActualLRPForUpdate(logger lager.Logger, processGuid string
 		logger.Error("failed-query", err)
 		return nil, err
 	}
-	groups, err := db.scanAndCleanupActualLRPs(logger, tx, rows)
+	groups, err := db.scanAndCleanupActualLRPs(logger, tx, rows, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -588,14 +649,15 @@ func (db *SQLDB) fetchActualLRPForUpdate(logger lager.Logger, processGuid string
 	return actualLRP, nil
 }
 
-func (db *SQLDB) scanAndCleanupActualLRPs(logger lager.Logger, q Queryable, rows *sql.Rows) ([]*models.ActualLRPGroup, error) {
+func (db *SQLDB) scanAndCleanupActualLRPs(logger lager.Logger, q Queryable, rows *sql.Rows, cursor *pageCursor) ([]*models.ActualLRPGroup, error) {
 	mapOfGroups := map[models.ActualLRPKey]*models.ActualLRPGroup{}
 	result := []*models.ActualLRPGroup{}
-	actualsToDelete := []*actualToDelete{}
+	actualsToQuarantine := []*actualToQuarantine{}
 	for rows.Next() {
 		actualLRP, evacuating, err := db.scanToActualLRP(logger, rows)
-		if err == models.ErrDeserialize {
-			actualsToDelete = append(actualsToDelete, &actualToDelete{actualLRP, evacuating})
+		if invalid, ok := err.(*invalidRecordError); ok {
+			actualsToQuarantine = append(actualsToQuarantine, &actualToQuarantine{actualLRP, evacuating, invalid})
+			cursor.record(actualLRP.ProcessGuid, actualLRP.Index)
 			continue
 		}
 
@@ -608,6 +670,8 @@ func (db *SQLDB) scanAndCleanupActualLRPs(logger lager.Logger, q Queryable, rows
 		// one for the evacuating.  When building the list of actual LRP groups (where
 		// a group is the instance and corresponding evacuating), make sure we don't add the same
 		// actual lrp twice.
+		cursor.record(actualLRP.ProcessGuid, actualLRP.Index)
+
 		if mapOfGroups[actualLRP.ActualLRPKey] == nil {
 			mapOfGroups[actualLRP.ActualLRPKey] = &models.ActualLRPGroup{}
 			result = append(result, mapOfGroups[actualLRP.ActualLRPKey])
@@ -624,13 +688,13 @@ func (db *SQLDB) scanAndCleanupActualLRPs(logger lager.Logger, q Queryable, rows
 		return nil, db.convertSQLError(rows.Err())
 	}
 
-	for _, actual := range actualsToDelete {
-		_, err := db.delete(logger, q, actualLRPsTable,
+	for _, actual := range actualsToQuarantine {
+		err := db.quarantine(logger, q, actualLRPsTable, actual.invalid,
 			"process_guid = ? AND instance_index = ? AND evacuating = ?",
 			actual.ProcessGuid, actual.Index, actual.evacuating,
 		)
 		if err != nil {
-			logger.Error("failed-cleaning-up-invalid-actual-lrp", err)
+			logger.Error("failed-quarantining-invalid-actual-lrp", err)
 		}
 	}
 
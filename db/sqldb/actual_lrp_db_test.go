@@ -351,7 +351,7 @@ var _ = Describe("ActualLRPDB", func() {
 			Expect(actualLRPGroups).To(ConsistOf(allActualLRPGroups))
 		})
 
-		It("prunes all actual lrps containing invalid data", func() {
+		It("quarantines all actual lrps containing invalid data", func() {
 			actualLRPWithInvalidData := model_helpers.NewValidActualLRP("invalid", 0)
 			_, _, err := sqlDB.StartActualLRP(logger, &actualLRPWithInvalidData.ActualLRPKey, &actualLRPWithInvalidData.ActualLRPInstanceKey, &actualLRPWithInvalidData.ActualLRPNetInfo)
 			Expect(err).NotTo(HaveOccurred())
@@ -366,6 +366,73 @@ var _ = Describe("ActualLRPDB", func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(actualLRPGroups).NotTo(ContainElement(actualLRPWithInvalidData))
+
+			records, err := sqlDB.QuarantinedRecords(logger, models.QuarantinedActualLRPsTable)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(records).To(HaveLen(1))
+			Expect(records[0].Guid).To(Equal("invalid"))
+		})
+
+		Context("when paging", func() {
+			It("returns the first page of actual lrp groups ordered by process guid and index", func() {
+				filter := models.ActualLRPFilter{PageSize: 2}
+				actualLRPGroups, err := sqlDB.ActualLRPGroups(logger, filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(actualLRPGroups).To(Equal([]*models.ActualLRPGroup{allActualLRPGroups[1], allActualLRPGroups[0]}))
+			})
+
+			It("returns the actual lrp groups after the given key", func() {
+				filter := models.ActualLRPFilter{PageSize: 2, AfterProcessGuid: "guid4", AfterIndex: 1}
+				actualLRPGroups, err := sqlDB.ActualLRPGroups(logger, filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(actualLRPGroups).To(Equal([]*models.ActualLRPGroup{allActualLRPGroups[4], allActualLRPGroups[5]}))
+			})
+
+			It("does not split the instance and evacuating actual lrps of a group", func() {
+				filter := models.ActualLRPFilter{PageSize: 1, AfterProcessGuid: "guid5", AfterIndex: 1}
+				actualLRPGroups, err := sqlDB.ActualLRPGroups(logger, filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(actualLRPGroups).To(Equal([]*models.ActualLRPGroup{allActualLRPGroups[5]}))
+			})
+
+			It("returns a token resuming after the last actual lrp group on a full page", func() {
+				filter := models.ActualLRPFilter{PageSize: 2}
+				_, nextPageToken, err := sqlDB.ActualLRPGroupsPage(logger, filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(nextPageToken).To(Equal(filter.NextPageToken("guid1", 1)))
+			})
+
+			It("returns no token once the last page has been returned", func() {
+				filter := models.ActualLRPFilter{PageSize: 2, AfterProcessGuid: "guid4", AfterIndex: 1}
+				_, nextPageToken, err := sqlDB.ActualLRPGroupsPage(logger, filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(nextPageToken).To(BeEmpty())
+			})
+
+			Context("when an actual lrp on the page cannot be deserialized", func() {
+				BeforeEach(func() {
+					queryStr := `UPDATE actual_lrps SET net_info = 'garbage' WHERE process_guid = 'guid1'`
+					if test_helpers.UsePostgres() {
+						queryStr = test_helpers.ReplaceQuestionMarks(queryStr)
+					}
+					_, err := db.Exec(queryStr)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("still returns a token resuming after it", func() {
+					filter := models.ActualLRPFilter{PageSize: 2}
+					actualLRPGroups, nextPageToken, err := sqlDB.ActualLRPGroupsPage(logger, filter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(actualLRPGroups).To(Equal([]*models.ActualLRPGroup{allActualLRPGroups[1]}))
+					Expect(nextPageToken).To(Equal(filter.NextPageToken("guid1", 1)))
+				})
+			})
 		})
 
 		Context("when filtering on domains", func() {
@@ -383,6 +450,17 @@ var _ = Describe("ActualLRPDB", func() {
 			})
 		})
 
+		Context("when restricted to some domains", func() {
+			It("fills the page with actual lrp groups in those domains", func() {
+				filter := models.ActualLRPFilter{PageSize: 2, AllowedDomains: []string{"domain2"}}
+				actualLRPGroups, nextPageToken, err := sqlDB.ActualLRPGroupsPage(logger, filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(actualLRPGroups).To(Equal([]*models.ActualLRPGroup{allActualLRPGroups[1], allActualLRPGroups[3]}))
+				Expect(nextPageToken).NotTo(BeEmpty())
+			})
+		})
+
 		Context("when filtering on cell", func() {
 			It("returns the actual lrp groups claimed by the cell", func() {
 				filter := models.ActualLRPFilter{
@@ -1217,6 +1295,25 @@ var _ = Describe("ActualLRPDB", func() {
 					})
 				})
 
+				Context("and its DesiredLRP has a restart policy that never restarts", func() {
+					BeforeEach(func() {
+						desiredLRP := model_helpers.NewValidDesiredLRP(actualLRP.ProcessGuid)
+						desiredLRP.RestartPolicy = &models.RestartPolicy{NeverRestart: true}
+						Expect(sqlDB.DesireLRP(logger, desiredLRP)).To(Succeed())
+					})
+
+					It("updates the lrp and sets its state to CRASHED", func() {
+						_, _, shouldRestart, err := sqlDB.CrashActualLRP(logger, &actualLRP.ActualLRPKey, instanceKey, "because it didn't go well")
+						Expect(err).NotTo(HaveOccurred())
+						Expect(shouldRestart).To(BeFalse())
+
+						actualLRPGroup, err := sqlDB.ActualLRPGroupByProcessGuidAndIndex(logger, actualLRP.ProcessGuid, actualLRP.Index)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(actualLRPGroup.Instance.State).To(Equal(models.ActualLRPStateCrashed))
+						Expect(actualLRPGroup.Instance.CrashCount).To(BeEquivalentTo(1))
+					})
+				})
+
 				Context("and it should NOT be restarted", func() {
 					BeforeEach(func() {
 						queryStr := `
@@ -0,0 +1,163 @@
+package sqldb
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+func (db *SQLDB) RecordAuditEvent(logger lager.Logger, event *models.AuditEvent) error {
+	logger = logger.Session("record-audit-event", event.LagerData())
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	var errorType, errorMessage string
+	if event.Error != nil {
+		errorType = event.Error.Type.String()
+		errorMessage = event.Error.Message
+	}
+
+	return db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+		_, err := db.insert(logger, tx, auditEventsTable,
+			helpers.SQLAttributes{
+				"created_at":     event.CreatedAt,
+				"route":          event.Route,
+				"actor":          event.Actor,
+				"remote_address": event.RemoteAddress,
+				"request_id":     event.RequestId,
+				"domain":         event.Domain,
+				"guid":           event.Guid,
+				"summary":        event.Summary,
+				"error_type":     errorType,
+				"error_message":  errorMessage,
+			},
+		)
+		if err != nil {
+			logger.Error("failed-inserting-audit-event", err)
+			return err
+		}
+		return nil
+	})
+}
+
+// AuditEvents returns the events matching the filter, oldest first.
+func (db *SQLDB) AuditEvents(logger lager.Logger, filter models.AuditEventFilter) ([]*models.AuditEvent, error) {
+	logger = logger.Session("audit-events", lager.Data{"filter": filter})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	wheres := []string{}
+	values := []interface{}{}
+
+	if filter.Domain != "" {
+		wheres = append(wheres, "domain = ?")
+		values = append(values, filter.Domain)
+	}
+
+	if filter.AllowedDomains != nil {
+		where, bindings := whereDomainIn(filter.AllowedDomains)
+		wheres = append(wheres, where)
+		values = append(values, bindings...)
+	}
+
+	if filter.Since > 0 {
+		wheres = append(wheres, "created_at >= ?")
+		values = append(values, filter.Since)
+	}
+
+	if filter.Until > 0 {
+		wheres = append(wheres, "created_at < ?")
+		values = append(values, filter.Until)
+	}
+
+	results := []*models.AuditEvent{}
+
+	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+		rows, err := db.allOrdered(logger, tx, auditEventsTable,
+			auditEventColumns, "created_at, id", filter.Limit,
+			strings.Join(wheres, " AND "), values...,
+		)
+		if err != nil {
+			logger.Error("failed-query", err)
+			return err
+		}
+		defer rows.Close()
+
+		results = []*models.AuditEvent{}
+		for rows.Next() {
+			event, err := db.fetchAuditEvent(logger, rows)
+			if err != nil {
+				logger.Error("failed-scan-row", err)
+				return err
+			}
+			results = append(results, event)
+		}
+
+		if rows.Err() != nil {
+			logger.Error("failed-fetching-row", rows.Err())
+			return rows.Err()
+		}
+
+		return nil
+	})
+
+	return results, err
+}
+
+// PruneAuditEvents deletes the events recorded before the given time.
+func (db *SQLDB) PruneAuditEvents(logger lager.Logger, before time.Time) error {
+	logger = logger.Session("prune-audit-events", lager.Data{"before": before})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	return db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+		result, err := db.delete(logger, tx, auditEventsTable, "created_at < ?", before.UnixNano())
+		if err != nil {
+			logger.Error("failed-deleting-audit-events", err)
+			return err
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			logger.Error("failed-getting-rows-affected", err)
+			return nil
+		}
+
+		if rowsAffected > 0 {
+			logger.Info("pruned-audit-events", lager.Data{"count": rowsAffected})
+		}
+		return nil
+	})
+}
+
+func (db *SQLDB) fetchAuditEvent(logger lager.Logger, scanner RowScanner) (*models.AuditEvent, error) {
+	var errorType, errorMessage string
+	event := &models.AuditEvent{}
+
+	err := scanner.Scan(
+		&event.Id,
+		&event.CreatedAt,
+		&event.Route,
+		&event.Actor,
+		&event.RemoteAddress,
+		&event.RequestId,
+		&event.Domain,
+		&event.Guid,
+		&event.Summary,
+		&errorType,
+		&errorMessage,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if errorType != "" {
+		event.Error = models.NewError(models.Error_Type(models.Error_Type_value[errorType]), errorMessage)
+	}
+
+	return event, nil
+}
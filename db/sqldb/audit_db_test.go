@@ -0,0 +1,112 @@
+package sqldb_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AuditDB", func() {
+	var events []*models.AuditEvent
+
+	BeforeEach(func() {
+		now := fakeClock.Now().UnixNano()
+		events = []*models.AuditEvent{
+			{
+				CreatedAt:     now - int64(2*time.Hour),
+				Route:         "DesireDesiredLRP_r2",
+				Actor:         "cloud-controller",
+				RemoteAddress: "10.0.0.1:52000",
+				RequestId:     "request-1",
+				Domain:        "cf-apps",
+				Guid:          "process-guid",
+				Summary:       "instances: 1",
+			},
+			{
+				CreatedAt: now - int64(time.Hour),
+				Route:     "DesireTask_r2",
+				Actor:     "tps",
+				RequestId: "request-2",
+				Domain:    "cf-tasks",
+				Guid:      "task-guid",
+				Error:     models.ErrResourceExists,
+			},
+			{
+				CreatedAt: now,
+				Route:     "RemoveDesiredLRP",
+				Actor:     "cloud-controller",
+				RequestId: "request-3",
+				Domain:    "cf-apps",
+				Guid:      "process-guid",
+			},
+		}
+	})
+
+	Describe("RecordAuditEvent and AuditEvents", func() {
+		BeforeEach(func() {
+			for _, event := range events {
+				Expect(sqlDB.RecordAuditEvent(logger, event)).To(Succeed())
+			}
+		})
+
+		withoutIDs := func(events []*models.AuditEvent) []*models.AuditEvent {
+			for _, event := range events {
+				Expect(event.Id).NotTo(BeZero())
+				event.Id = 0
+			}
+			return events
+		}
+
+		It("returns the recorded events, oldest first", func() {
+			recorded, err := sqlDB.AuditEvents(logger, models.AuditEventFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(withoutIDs(recorded)).To(Equal(events))
+		})
+
+		It("filters the events by domain", func() {
+			recorded, err := sqlDB.AuditEvents(logger, models.AuditEventFilter{Domain: "cf-apps"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(withoutIDs(recorded)).To(Equal([]*models.AuditEvent{events[0], events[2]}))
+		})
+
+		It("filters the events by time", func() {
+			recorded, err := sqlDB.AuditEvents(logger, models.AuditEventFilter{
+				Since: events[1].CreatedAt,
+				Until: events[2].CreatedAt,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(withoutIDs(recorded)).To(Equal([]*models.AuditEvent{events[1]}))
+		})
+
+		It("limits the number of events returned", func() {
+			recorded, err := sqlDB.AuditEvents(logger, models.AuditEventFilter{Limit: 2})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(withoutIDs(recorded)).To(Equal(events[:2]))
+		})
+
+		It("restricts the events to the allowed domains before limiting them", func() {
+			recorded, err := sqlDB.AuditEvents(logger, models.AuditEventFilter{Limit: 2, AllowedDomains: []string{"cf-apps"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(withoutIDs(recorded)).To(Equal([]*models.AuditEvent{events[0], events[2]}))
+		})
+	})
+
+	Describe("PruneAuditEvents", func() {
+		BeforeEach(func() {
+			for _, event := range events {
+				Expect(sqlDB.RecordAuditEvent(logger, event)).To(Succeed())
+			}
+		})
+
+		It("deletes the events recorded before the given time", func() {
+			Expect(sqlDB.PruneAuditEvents(logger, fakeClock.Now().Add(-30*time.Minute))).To(Succeed())
+
+			recorded, err := sqlDB.AuditEvents(logger, models.AuditEventFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recorded).To(HaveLen(1))
+			Expect(recorded[0].RequestId).To(Equal("request-3"))
+		})
+	})
+})
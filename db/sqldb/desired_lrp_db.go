@@ -6,7 +6,6 @@ import (
 	"strings"
 
 	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
-	"code.cloudfoundry.org/bbs/format"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager"
 )
@@ -17,71 +16,200 @@ func (db *SQLDB) DesireLRP(logger lager.Logger, desiredLRP *models.DesiredLRP) e
 	defer logger.Info("complete")
 
 	return db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
-		routesData, err := db.encodeRouteData(logger, desiredLRP.Routes)
-		if err != nil {
-			logger.Error("failed-encoding-route-data", err)
-			return err
-		}
+		return db.insertDesiredLRP(logger, tx, desiredLRP)
+	})
+}
 
-		runInfo := desiredLRP.DesiredLRPRunInfo(db.clock.Now())
+// ApplyDesiredLRPs makes the DesiredLRPs in the domain match desiredLRPs and
+// refreshes the domain with the given ttl, all in a single transaction.
+// DesiredLRPs whose definition is unchanged are left untouched.
+func (db *SQLDB) ApplyDesiredLRPs(logger lager.Logger, domain string, desiredLRPs []*models.DesiredLRP, ttl uint32) (*models.DesiredLRPApplyResult, error) {
+	logger = logger.Session("apply-desired-lrps", lager.Data{"domain": domain, "count": len(desiredLRPs)})
+	logger.Info("starting")
+	defer logger.Info("complete")
 
-		runInfoData, err := db.serializeModel(logger, &runInfo)
+	var result *models.DesiredLRPApplyResult
+	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+		result = &models.DesiredLRPApplyResult{}
+
+		rows, err := db.all(logger, tx, desiredLRPsTable,
+			desiredLRPColumns, helpers.LockRow,
+			"domain = ?", domain,
+		)
 		if err != nil {
-			logger.Error("failed-to-serialize-model", err)
+			logger.Error("failed-query", err)
 			return err
 		}
 
-		volumePlacement := &models.VolumePlacement{}
-		volumePlacement.DriverNames = []string{}
-		for _, mount := range desiredLRP.VolumeMounts {
-			volumePlacement.DriverNames = append(volumePlacement.DriverNames, mount.Driver)
-		}
-
-		volumePlacementData, err := db.serializeModel(logger, volumePlacement)
+		existingLRPs, err := db.fetchDesiredLRPs(logger, rows, tx, nil)
+		rows.Close()
 		if err != nil {
-			logger.Error("failed-to-serialize-model", err)
-			return err
+			logger.Error("failed-fetching-row", err)
+			return db.convertSQLError(err)
 		}
 
-		guid, err := db.guidProvider.NextGUID()
-		if err != nil {
-			logger.Error("failed-to-generate-guid", err)
-			return models.ErrGUIDGeneration
+		existing := make(map[string]*models.DesiredLRP, len(existingLRPs))
+		for _, lrp := range existingLRPs {
+			existing[lrp.ProcessGuid] = lrp
 		}
 
-		placementTagData, err := json.Marshal(desiredLRP.PlacementTags)
-		if err != nil {
-			logger.Error("failed-to-serialize-model", err)
-			return err
+		for _, desiredLRP := range desiredLRPs {
+			before, ok := existing[desiredLRP.ProcessGuid]
+			if !ok {
+				err = db.insertDesiredLRP(logger, tx, desiredLRP)
+				if err != nil {
+					return err
+				}
+				result.Created = append(result.Created, desiredLRP)
+				continue
+			}
+			delete(existing, desiredLRP.ProcessGuid)
+
+			if before.DefinitionEqual(desiredLRP) {
+				desiredLRP.ModificationTag = before.ModificationTag
+				continue
+			}
+
+			err = db.replaceDesiredLRP(logger, tx, before, desiredLRP)
+			if err != nil {
+				return err
+			}
+			result.Updated = append(result.Updated, models.DesiredLRPChange{Before: before, After: desiredLRP})
 		}
 
-		desiredLRP.ModificationTag = &models.ModificationTag{Epoch: guid, Index: 0}
-
-		_, err = db.insert(logger, tx, desiredLRPsTable,
-			helpers.SQLAttributes{
-				"process_guid":           desiredLRP.ProcessGuid,
-				"domain":                 desiredLRP.Domain,
-				"log_guid":               desiredLRP.LogGuid,
-				"annotation":             desiredLRP.Annotation,
-				"instances":              desiredLRP.Instances,
-				"memory_mb":              desiredLRP.MemoryMb,
-				"disk_mb":                desiredLRP.DiskMb,
-				"max_pids":               desiredLRP.MaxPids,
-				"rootfs":                 desiredLRP.RootFs,
-				"volume_placement":       volumePlacementData,
-				"modification_tag_epoch": desiredLRP.ModificationTag.Epoch,
-				"modification_tag_index": desiredLRP.ModificationTag.Index,
-				"routes":                 routesData,
-				"run_info":               runInfoData,
-				"placement_tags":         placementTagData,
-			},
-		)
-		if err != nil {
-			logger.Error("failed-inserting-desired", err)
-			return err
+		for _, lrp := range existingLRPs {
+			if _, ok := existing[lrp.ProcessGuid]; !ok {
+				continue
+			}
+
+			_, err = db.delete(logger, tx, desiredLRPsTable, "process_guid = ?", lrp.ProcessGuid)
+			if err != nil {
+				logger.Error("failed-deleting-from-db", err, lager.Data{"process_guid": lrp.ProcessGuid})
+				return err
+			}
+
+			err = db.deleteDesiredLRPRevisions(logger, tx, lrp.ProcessGuid)
+			if err != nil {
+				return err
+			}
+			result.Removed = append(result.Removed, lrp)
 		}
-		return nil
+
+		return db.upsertDomain(logger, tx, domain, ttl)
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (db *SQLDB) insertDesiredLRP(logger lager.Logger, tx *sql.Tx, desiredLRP *models.DesiredLRP) error {
+	attributes, err := db.desiredLRPAttributes(logger, desiredLRP)
+	if err != nil {
+		return err
+	}
+
+	guid, err := db.guidProvider.NextGUID()
+	if err != nil {
+		logger.Error("failed-to-generate-guid", err)
+		return models.ErrGUIDGeneration
+	}
+
+	desiredLRP.ModificationTag = &models.ModificationTag{Epoch: guid, Index: 0}
+
+	attributes["process_guid"] = desiredLRP.ProcessGuid
+	attributes["modification_tag_epoch"] = desiredLRP.ModificationTag.Epoch
+	attributes["modification_tag_index"] = desiredLRP.ModificationTag.Index
+
+	_, err = db.insert(logger, tx, desiredLRPsTable, attributes)
+	if err != nil {
+		logger.Error("failed-inserting-desired", err)
+		return err
+	}
+
+	return db.recordDesiredLRPRevision(logger, tx, nil, desiredLRP)
+}
+
+// replaceDesiredLRP overwrites the stored definition of before with
+// desiredLRP, keeping the epoch and bumping the index of its ModificationTag.
+func (db *SQLDB) replaceDesiredLRP(logger lager.Logger, tx *sql.Tx, before, desiredLRP *models.DesiredLRP) error {
+	attributes, err := db.desiredLRPAttributes(logger, desiredLRP)
+	if err != nil {
+		return err
+	}
+
+	tag := *before.ModificationTag
+	tag.Increment()
+	desiredLRP.ModificationTag = &tag
+
+	attributes["modification_tag_index"] = tag.Index
+
+	_, err = db.update(logger, tx, desiredLRPsTable, attributes, "process_guid = ?", desiredLRP.ProcessGuid)
+	if err != nil {
+		logger.Error("failed-updating-desired-lrp", err, lager.Data{"process_guid": desiredLRP.ProcessGuid})
+		return err
+	}
+
+	return db.recordDesiredLRPRevision(logger, tx, before, desiredLRP)
+}
+
+// desiredLRPAttributes returns the columns describing the definition of the
+// DesiredLRP, excluding its process guid and ModificationTag.
+func (db *SQLDB) desiredLRPAttributes(logger lager.Logger, desiredLRP *models.DesiredLRP) (helpers.SQLAttributes, error) {
+	routesData, err := db.encodeRouteData(logger, desiredLRP.Routes)
+	if err != nil {
+		logger.Error("failed-encoding-route-data", err)
+		return nil, err
+	}
+
+	runInfo := desiredLRP.DesiredLRPRunInfo(db.clock.Now())
+
+	runInfoData, err := db.serializeModel(logger, &runInfo)
+	if err != nil {
+		logger.Error("failed-to-serialize-model", err)
+		return nil, err
+	}
+
+	volumePlacement := &models.VolumePlacement{}
+	volumePlacement.DriverNames = []string{}
+	for _, mount := range desiredLRP.VolumeMounts {
+		volumePlacement.DriverNames = append(volumePlacement.DriverNames, mount.Driver)
+	}
+
+	volumePlacementData, err := db.serializeModel(logger, volumePlacement)
+	if err != nil {
+		logger.Error("failed-to-serialize-model", err)
+		return nil, err
+	}
+
+	placementTagData, err := json.Marshal(desiredLRP.PlacementTags)
+	if err != nil {
+		logger.Error("failed-to-serialize-model", err)
+		return nil, err
+	}
+
+	restartPolicyData, err := json.Marshal(desiredLRP.RestartPolicy)
+	if err != nil {
+		logger.Error("failed-to-serialize-model", err)
+		return nil, err
+	}
+
+	return helpers.SQLAttributes{
+		"domain":           desiredLRP.Domain,
+		"log_guid":         desiredLRP.LogGuid,
+		"annotation":       desiredLRP.Annotation,
+		"instances":        desiredLRP.Instances,
+		"memory_mb":        desiredLRP.MemoryMb,
+		"disk_mb":          desiredLRP.DiskMb,
+		"max_pids":         desiredLRP.MaxPids,
+		"rootfs":           desiredLRP.RootFs,
+		"volume_placement": volumePlacementData,
+		"routes":           routesData,
+		"run_info":         runInfoData,
+		"placement_tags":   placementTagData,
+		"restart_policy":   restartPolicyData,
+	}, nil
 }
 
 func (db *SQLDB) DesiredLRPByProcessGuid(logger lager.Logger, processGuid string) (*models.DesiredLRP, error) {
@@ -106,6 +234,11 @@ func (db *SQLDB) DesiredLRPByProcessGuid(logger lager.Logger, processGuid string
 }
 
 func (db *SQLDB) DesiredLRPs(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, error) {
+	desiredLRPs, _, err := db.DesiredLRPsPage(logger, filter)
+	return desiredLRPs, err
+}
+
+func (db *SQLDB) DesiredLRPsPage(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, string, error) {
 	logger = logger.WithData(lager.Data{"filter": filter})
 	logger.Debug("start")
 	defer logger.Debug("complete")
@@ -118,6 +251,12 @@ func (db *SQLDB) DesiredLRPs(logger lager.Logger, filter models.DesiredLRPFilter
 		values = append(values, filter.Domain)
 	}
 
+	if filter.AllowedDomains != nil {
+		where, bindings := whereDomainIn(filter.AllowedDomains)
+		wheres = append(wheres, where)
+		values = append(values, bindings...)
+	}
+
 	if len(filter.ProcessGuids) > 0 {
 		wheres = append(wheres, whereClauseForProcessGuids(filter.ProcessGuids))
 
@@ -126,11 +265,17 @@ func (db *SQLDB) DesiredLRPs(logger lager.Logger, filter models.DesiredLRPFilter
 		}
 	}
 
+	if filter.AfterProcessGuid != "" {
+		wheres = append(wheres, "process_guid > ?")
+		values = append(values, filter.AfterProcessGuid)
+	}
+
 	results := []*models.DesiredLRP{}
+	cursor := &pageCursor{}
 
 	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
-		rows, err := db.all(logger, tx, desiredLRPsTable,
-			desiredLRPColumns, helpers.NoLockRow,
+		rows, err := db.allOrdered(logger, tx, desiredLRPsTable,
+			desiredLRPColumns, "process_guid", filter.PageSize,
 			strings.Join(wheres, " AND "), values...,
 		)
 		if err != nil {
@@ -139,7 +284,7 @@ func (db *SQLDB) DesiredLRPs(logger lager.Logger, filter models.DesiredLRPFilter
 		}
 		defer rows.Close()
 
-		results, err = db.fetchDesiredLRPs(logger, rows, tx)
+		results, err = db.fetchDesiredLRPs(logger, rows, tx, cursor)
 		if err != nil {
 			logger.Error("failed-fetching-row", rows.Err())
 			return db.convertSQLError(rows.Err())
@@ -147,8 +292,16 @@ func (db *SQLDB) DesiredLRPs(logger lager.Logger, filter models.DesiredLRPFilter
 
 		return nil
 	})
+	if err != nil {
+		return results, "", err
+	}
 
-	return results, err
+	var nextPageToken string
+	if last, ok := cursor.pageEnd(filter.PageSize, filter.PageSize); ok {
+		nextPageToken = filter.NextPageToken(last.guid)
+	}
+
+	return results, nextPageToken, nil
 }
 
 func (db *SQLDB) DesiredLRPSchedulingInfos(logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, error) {
@@ -205,7 +358,7 @@ func (db *SQLDB) DesiredLRPSchedulingInfos(logger lager.Logger, filter models.De
 	return results, err
 }
 
-func (db *SQLDB) UpdateDesiredLRP(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) (*models.DesiredLRP, error) {
+func (db *SQLDB) UpdateDesiredLRP(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate, expectedTag *models.ModificationTag) (*models.DesiredLRP, error) {
 	logger = logger.WithData(lager.Data{"process_guid": processGuid})
 	logger.Info("starting")
 	defer logger.Info("complete")
@@ -213,54 +366,156 @@ func (db *SQLDB) UpdateDesiredLRP(logger lager.Logger, processGuid string, updat
 	var beforeDesiredLRP *models.DesiredLRP
 	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
 		var err error
-		row := db.one(logger, tx, desiredLRPsTable,
-			desiredLRPColumns, helpers.LockRow,
-			"process_guid = ?", processGuid,
-		)
-		beforeDesiredLRP, err = db.fetchDesiredLRP(logger, row, tx)
+		beforeDesiredLRP, err = db.lockDesiredLRP(logger, tx, processGuid, expectedTag)
+		if err != nil {
+			return err
+		}
 
+		afterDesiredLRP, err := db.applyDesiredLRPUpdate(logger, tx, beforeDesiredLRP, update)
 		if err != nil {
-			logger.Error("failed-lock-desired", err)
 			return err
 		}
 
-		updateAttributes := helpers.SQLAttributes{"modification_tag_index": beforeDesiredLRP.ModificationTag.Index + 1}
+		return db.recordDesiredLRPRevision(logger, tx, beforeDesiredLRP, afterDesiredLRP)
+	})
 
-		if update.Annotation != nil {
-			updateAttributes["annotation"] = *update.Annotation
-		}
+	return beforeDesiredLRP, err
+}
 
-		if update.Instances != nil {
-			updateAttributes["instances"] = *update.Instances
-		}
+func (db *SQLDB) UpdateDesiredLRPRunInfo(logger lager.Logger, processGuid string, runInfo *models.DesiredLRPRunInfo, resource *models.DesiredLRPResource) (*models.DesiredLRP, error) {
+	logger = logger.WithData(lager.Data{"process_guid": processGuid})
+	logger.Info("starting")
+	defer logger.Info("complete")
 
-		if update.Routes != nil {
-			encodedData, err := db.encodeRouteData(logger, update.Routes)
-			if err != nil {
-				return err
-			}
-			updateAttributes["routes"] = encodedData
+	var beforeDesiredLRP *models.DesiredLRP
+	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+		var err error
+		beforeDesiredLRP, err = db.lockDesiredLRP(logger, tx, processGuid, nil)
+		if err != nil {
+			return err
 		}
 
-		_, err = db.update(logger, tx, desiredLRPsTable, updateAttributes, `process_guid = ?`, processGuid)
+		afterDesiredLRP, err := db.applyDesiredLRPRunInfo(logger, tx, beforeDesiredLRP, runInfo, resource)
 		if err != nil {
-			logger.Error("failed-executing-query", err)
 			return err
 		}
 
-		return nil
+		return db.recordDesiredLRPRevision(logger, tx, beforeDesiredLRP, afterDesiredLRP)
 	})
 
 	return beforeDesiredLRP, err
 }
 
+// lockDesiredLRP fetches the DesiredLRP and locks its row for the rest of the
+// transaction, failing with a conflict if expectedTag is given and stale.
+func (db *SQLDB) lockDesiredLRP(logger lager.Logger, tx *sql.Tx, processGuid string, expectedTag *models.ModificationTag) (*models.DesiredLRP, error) {
+	row := db.one(logger, tx, desiredLRPsTable,
+		desiredLRPColumns, helpers.LockRow,
+		"process_guid = ?", processGuid,
+	)
+	desiredLRP, err := db.fetchDesiredLRP(logger, row, tx)
+	if err != nil {
+		logger.Error("failed-lock-desired", err)
+		return nil, err
+	}
+
+	if expectedTag != nil && !expectedTag.Equal(desiredLRP.ModificationTag) {
+		logger.Info("stale-modification-tag", lager.Data{"expected": expectedTag, "actual": desiredLRP.ModificationTag})
+		return nil, models.ErrResourceConflict
+	}
+
+	return desiredLRP, nil
+}
+
+// applyDesiredLRPUpdate writes the update over before, whose row the
+// transaction has locked, and returns the DesiredLRP as written.
+func (db *SQLDB) applyDesiredLRPUpdate(logger lager.Logger, tx *sql.Tx, before *models.DesiredLRP, update *models.DesiredLRPUpdate) (*models.DesiredLRP, error) {
+	updateAttributes := helpers.SQLAttributes{"modification_tag_index": before.ModificationTag.Index + 1}
+
+	if update.Annotation != nil {
+		updateAttributes["annotation"] = *update.Annotation
+	}
+
+	if update.Instances != nil {
+		updateAttributes["instances"] = *update.Instances
+	}
+
+	if update.Routes != nil {
+		encodedData, err := db.encodeRouteData(logger, update.Routes)
+		if err != nil {
+			return nil, err
+		}
+		updateAttributes["routes"] = encodedData
+	}
+
+	_, err := db.update(logger, tx, desiredLRPsTable, updateAttributes, `process_guid = ?`, before.ProcessGuid)
+	if err != nil {
+		logger.Error("failed-executing-query", err)
+		return nil, err
+	}
+
+	after := before.Copy()
+	schedulingInfo := after.DesiredLRPSchedulingInfo()
+	schedulingInfo.ApplyUpdate(update)
+	after.Instances = schedulingInfo.Instances
+	after.Routes = &schedulingInfo.Routes
+	after.Annotation = schedulingInfo.Annotation
+	after.ModificationTag = &schedulingInfo.ModificationTag
+
+	return after, nil
+}
+
+// applyDesiredLRPRunInfo writes the run definition and, if resource is
+// non-nil, the resources over before, whose row the transaction has locked,
+// and returns the DesiredLRP as written.
+func (db *SQLDB) applyDesiredLRPRunInfo(logger lager.Logger, tx *sql.Tx, before *models.DesiredLRP, runInfo *models.DesiredLRPRunInfo, resource *models.DesiredLRPResource) (*models.DesiredLRP, error) {
+	schedulingInfo := before.DesiredLRPSchedulingInfo()
+	schedulingInfo.ApplyRedeploy(runInfo, resource)
+
+	newRunInfo := *runInfo
+	newRunInfo.DesiredLRPKey = schedulingInfo.DesiredLRPKey
+	newRunInfo.CreatedAt = db.clock.Now().UnixNano()
+
+	runInfoData, err := db.serializeModel(logger, &newRunInfo)
+	if err != nil {
+		logger.Error("failed-to-serialize-model", err)
+		return nil, err
+	}
+
+	volumePlacementData, err := db.serializeModel(logger, schedulingInfo.VolumePlacement)
+	if err != nil {
+		logger.Error("failed-to-serialize-model", err)
+		return nil, err
+	}
+
+	_, err = db.update(logger, tx, desiredLRPsTable,
+		helpers.SQLAttributes{
+			"memory_mb":              schedulingInfo.MemoryMb,
+			"disk_mb":                schedulingInfo.DiskMb,
+			"max_pids":               schedulingInfo.MaxPids,
+			"rootfs":                 schedulingInfo.RootFs,
+			"volume_placement":       volumePlacementData,
+			"run_info":               runInfoData,
+			"modification_tag_index": schedulingInfo.ModificationTag.Index,
+		},
+		`process_guid = ?`, before.ProcessGuid,
+	)
+	if err != nil {
+		logger.Error("failed-executing-query", err)
+		return nil, err
+	}
+
+	after := models.NewDesiredLRP(schedulingInfo, newRunInfo)
+	return &after, nil
+}
+
 func (db *SQLDB) encodeRouteData(logger lager.Logger, routes *models.Routes) ([]byte, error) {
 	routeData, err := json.Marshal(routes)
 	if err != nil {
 		logger.Error("failed-marshalling-routes", err)
 		return nil, models.ErrBadRequest
 	}
-	encodedData, err := db.encoder.Encode(format.BASE64_ENCRYPTED, routeData)
+	encodedData, err := db.encoder.Encode(db.format.Encoding, routeData)
 	if err != nil {
 		logger.Error("failed-encrypting-routes", err)
 		return nil, models.ErrBadRequest
@@ -268,32 +523,37 @@ func (db *SQLDB) encodeRouteData(logger lager.Logger, routes *models.Routes) ([]
 	return encodedData, nil
 }
 
-func (db *SQLDB) RemoveDesiredLRP(logger lager.Logger, processGuid string) error {
+func (db *SQLDB) RemoveDesiredLRP(logger lager.Logger, processGuid string, expectedTag *models.ModificationTag) error {
 	logger = logger.WithData(lager.Data{"process_guid": processGuid})
 	logger.Info("starting")
 	defer logger.Info("complete")
 
 	return db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
-		err := db.lockDesiredLRPByGuidForUpdate(logger, processGuid, tx)
+		tag, err := db.lockDesiredLRPByGuidForUpdate(logger, processGuid, tx)
 		if err != nil {
 			logger.Error("failed-lock-desired", err)
 			return err
 		}
 
+		if expectedTag != nil && !expectedTag.Equal(tag) {
+			logger.Info("stale-modification-tag", lager.Data{"expected": expectedTag, "actual": tag})
+			return models.ErrResourceConflict
+		}
+
 		_, err = db.delete(logger, tx, desiredLRPsTable, "process_guid = ?", processGuid)
 		if err != nil {
 			logger.Error("failed-deleting-from-db", err)
 			return err
 		}
 
-		return nil
+		return db.deleteDesiredLRPRevisions(logger, tx, processGuid)
 	})
 }
 
 // "rows" needs to have the columns defined in the schedulingInfoColumns constant
 func (db *SQLDB) fetchDesiredLRPSchedulingInfoAndMore(logger lager.Logger, scanner RowScanner, dest ...interface{}) (*models.DesiredLRPSchedulingInfo, error) {
 	schedulingInfo := &models.DesiredLRPSchedulingInfo{}
-	var routeData, volumePlacementData, placementTagData []byte
+	var routeData, volumePlacementData, placementTagData, restartPolicyData []byte
 	values := []interface{}{
 		&schedulingInfo.ProcessGuid,
 		&schedulingInfo.Domain,
@@ -309,6 +569,7 @@ func (db *SQLDB) fetchDesiredLRPSchedulingInfoAndMore(logger lager.Logger, scann
 		&schedulingInfo.ModificationTag.Epoch,
 		&schedulingInfo.ModificationTag.Index,
 		&placementTagData,
+		&restartPolicyData,
 	}
 	values = append(values, dest...)
 
@@ -347,42 +608,79 @@ func (db *SQLDB) fetchDesiredLRPSchedulingInfoAndMore(logger lager.Logger, scann
 			return nil, err
 		}
 	}
+	if restartPolicyData != nil {
+		err = json.Unmarshal(restartPolicyData, &schedulingInfo.RestartPolicy)
+		if err != nil {
+			logger.Error("failed-parsing-restart-policy", err)
+			return nil, err
+		}
+	}
 
 	return schedulingInfo, nil
 }
 
-func (db *SQLDB) lockDesiredLRPByGuidForUpdate(logger lager.Logger, processGuid string, tx *sql.Tx) error {
+func (db *SQLDB) lockDesiredLRPByGuidForUpdate(logger lager.Logger, processGuid string, tx *sql.Tx) (models.ModificationTag, error) {
 	row := db.one(logger, tx, desiredLRPsTable,
-		helpers.ColumnList{"1"}, helpers.LockRow,
+		helpers.ColumnList{"modification_tag_epoch", "modification_tag_index"}, helpers.LockRow,
 		"process_guid = ?", processGuid,
 	)
-	var count int
-	err := row.Scan(&count)
+	var tag models.ModificationTag
+	err := row.Scan(&tag.Epoch, &tag.Index)
 	if err == sql.ErrNoRows {
-		return models.ErrResourceNotFound
+		return tag, models.ErrResourceNotFound
 	} else if err != nil {
-		return err
+		return tag, err
 	}
-	return nil
+	return tag, nil
 }
 
-func (db *SQLDB) fetchDesiredLRPs(logger lager.Logger, rows *sql.Rows, queryable Queryable) ([]*models.DesiredLRP, error) {
-	guids := []string{}
+// fetchRestartPolicy returns the restart policy of the given DesiredLRP, or
+// nil if the DesiredLRP does not exist or does not specify one.
+func (db *SQLDB) fetchRestartPolicy(logger lager.Logger, processGuid string, q Queryable) (*models.RestartPolicy, error) {
+	row := db.one(logger, q, desiredLRPsTable,
+		helpers.ColumnList{"restart_policy"}, helpers.NoLockRow,
+		"process_guid = ?", processGuid,
+	)
+	var restartPolicyData []byte
+	err := row.Scan(&restartPolicyData)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var restartPolicy *models.RestartPolicy
+	if restartPolicyData != nil {
+		err = json.Unmarshal(restartPolicyData, &restartPolicy)
+		if err != nil {
+			logger.Error("failed-parsing-restart-policy", err)
+			return nil, err
+		}
+	}
+	return restartPolicy, nil
+}
+
+func (db *SQLDB) fetchDesiredLRPs(logger lager.Logger, rows *sql.Rows, queryable Queryable, cursor *pageCursor) ([]*models.DesiredLRP, error) {
+	invalidRecords := []*invalidRecordError{}
 	lrps := []*models.DesiredLRP{}
 	for rows.Next() {
-		lrp, guid, err := db.fetchDesiredLRPInternal(logger, rows)
-		if err == models.ErrDeserialize {
-			guids = append(guids, guid)
+		lrp, err := db.fetchDesiredLRPInternal(logger, rows)
+		if invalid, ok := err.(*invalidRecordError); ok {
+			invalidRecords = append(invalidRecords, invalid)
+			cursor.record(invalid.guid, 0)
+		} else if err != nil {
+			cursor.record("", 0)
 		}
 		if err != nil {
 			logger.Error("failed-reading-row", err)
 			continue
 		}
+		cursor.record(lrp.ProcessGuid, 0)
 		lrps = append(lrps, lrp)
 	}
 
-	if len(guids) > 0 {
-		db.deleteInvalidLRPs(logger, queryable, guids...)
+	if len(invalidRecords) > 0 {
+		db.quarantineInvalidLRPs(logger, queryable, invalidRecords...)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -393,36 +691,38 @@ func (db *SQLDB) fetchDesiredLRPs(logger lager.Logger, rows *sql.Rows, queryable
 }
 
 func (db *SQLDB) fetchDesiredLRP(logger lager.Logger, scanner RowScanner, queryable Queryable) (*models.DesiredLRP, error) {
-	lrp, guid, err := db.fetchDesiredLRPInternal(logger, scanner)
-	if err == models.ErrDeserialize {
-		db.deleteInvalidLRPs(logger, queryable, guid)
+	lrp, err := db.fetchDesiredLRPInternal(logger, scanner)
+	if invalid, ok := err.(*invalidRecordError); ok {
+		db.quarantineInvalidLRPs(logger, queryable, invalid)
+		return nil, models.ErrDeserialize
 	}
 	return lrp, err
 }
 
-func (db *SQLDB) fetchDesiredLRPInternal(logger lager.Logger, scanner RowScanner) (*models.DesiredLRP, string, error) {
+func (db *SQLDB) fetchDesiredLRPInternal(logger lager.Logger, scanner RowScanner) (*models.DesiredLRP, error) {
 	var runInfoData []byte
 	schedulingInfo, err := db.fetchDesiredLRPSchedulingInfoAndMore(logger, scanner, &runInfoData)
 	if err != nil {
 		logger.Error("failed-fetching-run-info", err)
-		return nil, "", err
+		return nil, err
 	}
 
 	var runInfo models.DesiredLRPRunInfo
 	err = db.deserializeModel(logger, runInfoData, &runInfo)
 	if err != nil {
-		return nil, schedulingInfo.ProcessGuid, models.ErrDeserialize
+		return nil, &invalidRecordError{guid: schedulingInfo.ProcessGuid, column: "run_info", err: err}
 	}
 	desiredLRP := models.NewDesiredLRP(*schedulingInfo, runInfo)
-	return &desiredLRP, "", nil
+	return &desiredLRP, nil
 }
 
-func (db *SQLDB) deleteInvalidLRPs(logger lager.Logger, queryable Queryable, guids ...string) error {
-	for _, guid := range guids {
-		logger.Info("deleting-invalid-desired-lrp-from-db", lager.Data{"guid": guid})
-		_, err := db.delete(logger, queryable, desiredLRPsTable, "process_guid = ?", guid)
+// quarantineInvalidLRPs moves the DesiredLRPs aside, keeping their revisions
+// so that they can be rolled back to once restored.
+func (db *SQLDB) quarantineInvalidLRPs(logger lager.Logger, queryable Queryable, invalidRecords ...*invalidRecordError) error {
+	for _, invalid := range invalidRecords {
+		err := db.quarantine(logger, queryable, desiredLRPsTable, invalid, "process_guid = ?", invalid.guid)
 		if err != nil {
-			logger.Error("failed-deleting-invalid-row", err)
+			logger.Error("failed-quarantining-invalid-row", err)
 			return err
 		}
 	}
@@ -3,6 +3,7 @@ package sqldb_test
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
@@ -127,7 +128,7 @@ var _ = Describe("DesiredLRPDB", func() {
 			Expect(desiredLRPs).To(ConsistOf(expectedDesiredLRPs))
 		})
 
-		It("prunes all desired lrps with invalid run infos", func() {
+		It("quarantines all desired lrps with invalid run infos", func() {
 			desiredLRPWithInvalidRunInfo := model_helpers.NewValidDesiredLRP("invalid")
 			Expect(sqlDB.DesireLRP(logger, desiredLRPWithInvalidRunInfo)).To(Succeed())
 
@@ -154,6 +155,11 @@ var _ = Describe("DesiredLRPDB", func() {
 				processGuids = append(processGuids, processGuid)
 			}
 			Expect(processGuids).NotTo(ContainElement("invalid"))
+
+			records, err := sqlDB.QuarantinedRecords(logger, models.QuarantinedDesiredLRPsTable)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(records).To(HaveLen(1))
+			Expect(records[0].Guid).To(Equal("invalid"))
 		})
 
 		Context("when filtering by domain", func() {
@@ -177,6 +183,68 @@ var _ = Describe("DesiredLRPDB", func() {
 			})
 		})
 
+		Context("when restricted to some domains", func() {
+			It("fills the page with desired lrps in those domains", func() {
+				filter := models.DesiredLRPFilter{PageSize: 1, AllowedDomains: []string{"domain-2", "domain-3"}}
+				desiredLRPs, nextPageToken, err := sqlDB.DesiredLRPsPage(logger, filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(desiredLRPs).To(Equal(expectedDesiredLRPs[1:2]))
+				Expect(nextPageToken).To(Equal(filter.NextPageToken("d-2")))
+			})
+		})
+
+		Context("when paging", func() {
+			It("returns the desired lrps ordered by process guid", func() {
+				desiredLRPs, err := sqlDB.DesiredLRPs(logger, models.DesiredLRPFilter{PageSize: 2})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(desiredLRPs).To(Equal(expectedDesiredLRPs[:2]))
+			})
+
+			It("returns the desired lrps after the given process guid", func() {
+				desiredLRPs, err := sqlDB.DesiredLRPs(logger, models.DesiredLRPFilter{PageSize: 2, AfterProcessGuid: "d-2"})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(desiredLRPs).To(Equal(expectedDesiredLRPs[2:]))
+			})
+
+			It("returns a token resuming after the last desired lrp on a full page", func() {
+				filter := models.DesiredLRPFilter{PageSize: 2}
+				_, nextPageToken, err := sqlDB.DesiredLRPsPage(logger, filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(nextPageToken).To(Equal(filter.NextPageToken("d-2")))
+			})
+
+			It("returns no token once the last page has been returned", func() {
+				_, nextPageToken, err := sqlDB.DesiredLRPsPage(logger, models.DesiredLRPFilter{PageSize: 2, AfterProcessGuid: "d-2"})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(nextPageToken).To(BeEmpty())
+			})
+
+			Context("when a desired lrp on the page cannot be deserialized", func() {
+				BeforeEach(func() {
+					queryStr := `UPDATE desired_lrps SET run_info = 'garbage' WHERE process_guid = 'd-2'`
+					if test_helpers.UsePostgres() {
+						queryStr = test_helpers.ReplaceQuestionMarks(queryStr)
+					}
+					_, err := db.Exec(queryStr)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("still returns a token resuming after it", func() {
+					filter := models.DesiredLRPFilter{PageSize: 2}
+					desiredLRPs, nextPageToken, err := sqlDB.DesiredLRPsPage(logger, filter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(desiredLRPs).To(Equal(expectedDesiredLRPs[:1]))
+					Expect(nextPageToken).To(Equal(filter.NextPageToken("d-2")))
+				})
+			})
+		})
+
 		Context("when the run info is invalid", func() {
 			BeforeEach(func() {
 				queryStr := "UPDATE desired_lrps SET run_info = ? WHERE process_guid = ?"
@@ -314,7 +382,7 @@ var _ = Describe("DesiredLRPDB", func() {
 				Routes:     &routes,
 				Annotation: &annotation,
 			}
-			_, err := sqlDB.UpdateDesiredLRP(logger, expectedDesiredLRP.ProcessGuid, update)
+			_, err := sqlDB.UpdateDesiredLRP(logger, expectedDesiredLRP.ProcessGuid, update, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			desiredLRP, err := sqlDB.DesiredLRPByProcessGuid(logger, expectedDesiredLRP.ProcessGuid)
@@ -334,7 +402,7 @@ var _ = Describe("DesiredLRPDB", func() {
 				Instances: &instances,
 			}
 
-			beforeDesiredLRP, err := sqlDB.UpdateDesiredLRP(logger, expectedDesiredLRP.ProcessGuid, update)
+			beforeDesiredLRP, err := sqlDB.UpdateDesiredLRP(logger, expectedDesiredLRP.ProcessGuid, update, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(beforeDesiredLRP).To(Equal(expectedDesiredLRP))
 		})
@@ -344,7 +412,7 @@ var _ = Describe("DesiredLRPDB", func() {
 			update = &models.DesiredLRPUpdate{
 				Instances: &instances,
 			}
-			_, err := sqlDB.UpdateDesiredLRP(logger, expectedDesiredLRP.ProcessGuid, update)
+			_, err := sqlDB.UpdateDesiredLRP(logger, expectedDesiredLRP.ProcessGuid, update, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			desiredLRP, err := sqlDB.DesiredLRPByProcessGuid(logger, expectedDesiredLRP.ProcessGuid)
@@ -358,7 +426,7 @@ var _ = Describe("DesiredLRPDB", func() {
 
 		It("updates only the modification tag if update is empty", func() {
 			update = &models.DesiredLRPUpdate{}
-			_, err := sqlDB.UpdateDesiredLRP(logger, expectedDesiredLRP.ProcessGuid, update)
+			_, err := sqlDB.UpdateDesiredLRP(logger, expectedDesiredLRP.ProcessGuid, update, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			desiredLRP, err := sqlDB.DesiredLRPByProcessGuid(logger, expectedDesiredLRP.ProcessGuid)
@@ -377,15 +445,106 @@ var _ = Describe("DesiredLRPDB", func() {
 				update = &models.DesiredLRPUpdate{
 					Routes: &routes,
 				}
-				_, err := sqlDB.UpdateDesiredLRP(logger, expectedDesiredLRP.ProcessGuid, update)
+				_, err := sqlDB.UpdateDesiredLRP(logger, expectedDesiredLRP.ProcessGuid, update, nil)
 				Expect(err).To(HaveOccurred())
 				Expect(err).To(Equal(models.ErrBadRequest))
 			})
 		})
 
+		Context("when an expected modification tag is given", func() {
+			BeforeEach(func() {
+				instances := int32(20)
+				update = &models.DesiredLRPUpdate{
+					Instances: &instances,
+				}
+			})
+
+			It("updates the lrp if the tag matches", func() {
+				expectedTag := *expectedDesiredLRP.ModificationTag
+				_, err := sqlDB.UpdateDesiredLRP(logger, expectedDesiredLRP.ProcessGuid, update, &expectedTag)
+				Expect(err).NotTo(HaveOccurred())
+
+				desiredLRP, err := sqlDB.DesiredLRPByProcessGuid(logger, expectedDesiredLRP.ProcessGuid)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(desiredLRP.Instances).To(BeEquivalentTo(20))
+			})
+
+			It("returns a ResourceConflict error if the tag is stale", func() {
+				staleTag := *expectedDesiredLRP.ModificationTag
+				_, err := sqlDB.UpdateDesiredLRP(logger, expectedDesiredLRP.ProcessGuid, &models.DesiredLRPUpdate{}, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = sqlDB.UpdateDesiredLRP(logger, expectedDesiredLRP.ProcessGuid, update, &staleTag)
+				Expect(err).To(Equal(models.ErrResourceConflict))
+
+				desiredLRP, err := sqlDB.DesiredLRPByProcessGuid(logger, expectedDesiredLRP.ProcessGuid)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(desiredLRP.Instances).To(Equal(expectedDesiredLRP.Instances))
+			})
+		})
+
 		Context("when the desired lrp does not exist", func() {
 			It("returns a ResourceNotFound error", func() {
-				_, err := sqlDB.UpdateDesiredLRP(logger, "does-not-exist", update)
+				_, err := sqlDB.UpdateDesiredLRP(logger, "does-not-exist", update, nil)
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+	})
+
+	Describe("UpdateDesiredLRPRunInfo", func() {
+		var (
+			expectedDesiredLRP *models.DesiredLRP
+			runInfo            models.DesiredLRPRunInfo
+		)
+
+		BeforeEach(func() {
+			expectedDesiredLRP = model_helpers.NewValidDesiredLRP("desired-lrp-guid")
+			Expect(sqlDB.DesireLRP(logger, expectedDesiredLRP)).To(Succeed())
+
+			redeployed := model_helpers.NewValidDesiredLRP("desired-lrp-guid")
+			redeployed.EnvironmentVariables = []*models.EnvironmentVariable{{Name: "NEW", Value: "value"}}
+			redeployed.VolumeMounts = nil
+			runInfo = redeployed.DesiredLRPRunInfo(time.Unix(42, 0))
+		})
+
+		It("replaces the run info and increments the modification tag", func() {
+			_, err := sqlDB.UpdateDesiredLRPRunInfo(logger, expectedDesiredLRP.ProcessGuid, &runInfo, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			desiredLRP, err := sqlDB.DesiredLRPByProcessGuid(logger, expectedDesiredLRP.ProcessGuid)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(desiredLRP.EnvironmentVariables).To(Equal(runInfo.EnvironmentVariables))
+			Expect(desiredLRP.VolumeMounts).To(BeEmpty())
+			Expect(desiredLRP.MemoryMb).To(Equal(expectedDesiredLRP.MemoryMb))
+			Expect(desiredLRP.ModificationTag.Epoch).To(Equal(expectedDesiredLRP.ModificationTag.Epoch))
+			Expect(desiredLRP.ModificationTag.Index).To(Equal(expectedDesiredLRP.ModificationTag.Index + 1))
+
+			schedulingInfos, err := sqlDB.DesiredLRPSchedulingInfos(logger, models.DesiredLRPFilter{ProcessGuids: []string{expectedDesiredLRP.ProcessGuid}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(schedulingInfos).To(HaveLen(1))
+			Expect(schedulingInfos[0].VolumePlacement.DriverNames).To(BeEmpty())
+		})
+
+		It("replaces the resource if one is given", func() {
+			resource := models.NewDesiredLRPResource(1024, 2048, 10, "preloaded:other-stack")
+			_, err := sqlDB.UpdateDesiredLRPRunInfo(logger, expectedDesiredLRP.ProcessGuid, &runInfo, &resource)
+			Expect(err).NotTo(HaveOccurred())
+
+			desiredLRP, err := sqlDB.DesiredLRPByProcessGuid(logger, expectedDesiredLRP.ProcessGuid)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(desiredLRP.DesiredLRPResource()).To(Equal(resource))
+		})
+
+		It("returns the desired lrp from before the update", func() {
+			beforeDesiredLRP, err := sqlDB.UpdateDesiredLRPRunInfo(logger, expectedDesiredLRP.ProcessGuid, &runInfo, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(beforeDesiredLRP).To(Equal(expectedDesiredLRP))
+		})
+
+		Context("when the desired lrp does not exist", func() {
+			It("returns a ResourceNotFound error", func() {
+				_, err := sqlDB.UpdateDesiredLRPRunInfo(logger, "does-not-exist", &runInfo, nil)
 				Expect(err).To(Equal(models.ErrResourceNotFound))
 			})
 		})
@@ -401,7 +560,7 @@ var _ = Describe("DesiredLRPDB", func() {
 		})
 
 		It("removes the lrp", func() {
-			err := sqlDB.RemoveDesiredLRP(logger, expectedDesiredLRP.ProcessGuid)
+			err := sqlDB.RemoveDesiredLRP(logger, expectedDesiredLRP.ProcessGuid, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			_, err = sqlDB.DesiredLRPByProcessGuid(logger, expectedDesiredLRP.ProcessGuid)
@@ -409,11 +568,135 @@ var _ = Describe("DesiredLRPDB", func() {
 			Expect(err).To(Equal(models.ErrResourceNotFound))
 		})
 
+		Context("when an expected modification tag is given", func() {
+			It("removes the lrp if the tag matches", func() {
+				expectedTag := *expectedDesiredLRP.ModificationTag
+				err := sqlDB.RemoveDesiredLRP(logger, expectedDesiredLRP.ProcessGuid, &expectedTag)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = sqlDB.DesiredLRPByProcessGuid(logger, expectedDesiredLRP.ProcessGuid)
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+			})
+
+			It("returns a ResourceConflict error if the tag is stale", func() {
+				staleTag := models.NewModificationTag(expectedDesiredLRP.ModificationTag.Epoch, expectedDesiredLRP.ModificationTag.Index+1)
+				err := sqlDB.RemoveDesiredLRP(logger, expectedDesiredLRP.ProcessGuid, &staleTag)
+				Expect(err).To(Equal(models.ErrResourceConflict))
+
+				_, err = sqlDB.DesiredLRPByProcessGuid(logger, expectedDesiredLRP.ProcessGuid)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
 		Context("when the desired lrp does not exist", func() {
 			It("returns a ResourceNotFound error", func() {
-				err := sqlDB.RemoveDesiredLRP(logger, "does-not-exist")
+				err := sqlDB.RemoveDesiredLRP(logger, "does-not-exist", nil)
 				Expect(err).To(Equal(models.ErrResourceNotFound))
 			})
 		})
 	})
+
+	Describe("ApplyDesiredLRPs", func() {
+		var unchanged, changed, extra, otherDomain *models.DesiredLRP
+
+		BeforeEach(func() {
+			unchanged = model_helpers.NewValidDesiredLRP("unchanged-guid")
+			changed = model_helpers.NewValidDesiredLRP("changed-guid")
+			extra = model_helpers.NewValidDesiredLRP("extra-guid")
+			otherDomain = model_helpers.NewValidDesiredLRP("other-domain-guid")
+			otherDomain.Domain = "other-domain"
+
+			for _, lrp := range []*models.DesiredLRP{unchanged, changed, extra, otherDomain} {
+				Expect(sqlDB.DesireLRP(logger, lrp)).To(Succeed())
+			}
+		})
+
+		It("creates, updates and removes the DesiredLRPs of the domain", func() {
+			update := model_helpers.NewValidDesiredLRP("changed-guid")
+			update.Instances = 3
+			created := model_helpers.NewValidDesiredLRP("created-guid")
+
+			result, err := sqlDB.ApplyDesiredLRPs(logger, "some-domain", []*models.DesiredLRP{
+				model_helpers.NewValidDesiredLRP("unchanged-guid"),
+				update,
+				created,
+			}, 60)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(result.Created).To(ConsistOf(created))
+			Expect(result.Updated).To(HaveLen(1))
+			Expect(result.Updated[0].Before).To(Equal(changed))
+			Expect(result.Updated[0].After).To(Equal(update))
+			Expect(result.Removed).To(ConsistOf(extra))
+
+			lrps, err := sqlDB.DesiredLRPs(logger, models.DesiredLRPFilter{Domain: "some-domain"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lrps).To(ConsistOf(unchanged, update, created))
+
+			_, err = sqlDB.DesiredLRPByProcessGuid(logger, "other-domain-guid")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("keeps the epoch and increments the index of updated DesiredLRPs", func() {
+			update := model_helpers.NewValidDesiredLRP("changed-guid")
+			update.Annotation = "new-annotation"
+
+			_, err := sqlDB.ApplyDesiredLRPs(logger, "some-domain", []*models.DesiredLRP{unchanged, update}, 60)
+			Expect(err).NotTo(HaveOccurred())
+
+			desiredLRP, err := sqlDB.DesiredLRPByProcessGuid(logger, "changed-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(desiredLRP.Annotation).To(Equal("new-annotation"))
+			Expect(desiredLRP.ModificationTag.Epoch).To(Equal(changed.ModificationTag.Epoch))
+			Expect(desiredLRP.ModificationTag.Index).To(Equal(changed.ModificationTag.Index + 1))
+		})
+
+		It("does not touch unchanged DesiredLRPs", func() {
+			result, err := sqlDB.ApplyDesiredLRPs(logger, "some-domain", []*models.DesiredLRP{
+				model_helpers.NewValidDesiredLRP("unchanged-guid"),
+				model_helpers.NewValidDesiredLRP("changed-guid"),
+				model_helpers.NewValidDesiredLRP("extra-guid"),
+			}, 60)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Created).To(BeEmpty())
+			Expect(result.Updated).To(BeEmpty())
+			Expect(result.Removed).To(BeEmpty())
+
+			desiredLRP, err := sqlDB.DesiredLRPByProcessGuid(logger, "unchanged-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(desiredLRP.ModificationTag).To(Equal(unchanged.ModificationTag))
+		})
+
+		It("upserts the domain with the ttl", func() {
+			_, err := sqlDB.ApplyDesiredLRPs(logger, "some-domain", nil, 60)
+			Expect(err).NotTo(HaveOccurred())
+
+			domains, err := sqlDB.Domains(logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(domains).To(ConsistOf("some-domain"))
+
+			fakeClock.Increment(61 * time.Second)
+
+			domains, err = sqlDB.Domains(logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(domains).To(BeEmpty())
+		})
+
+		Context("when a DesiredLRP cannot be created", func() {
+			It("rolls back the whole apply", func() {
+				taken := model_helpers.NewValidDesiredLRP("other-domain-guid")
+
+				_, err := sqlDB.ApplyDesiredLRPs(logger, "some-domain", []*models.DesiredLRP{taken}, 60)
+				Expect(err).To(Equal(models.ErrResourceExists))
+
+				lrps, err := sqlDB.DesiredLRPs(logger, models.DesiredLRPFilter{Domain: "some-domain"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(lrps).To(ConsistOf(unchanged, changed, extra))
+
+				domains, err := sqlDB.Domains(logger)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(domains).To(BeEmpty())
+			})
+		})
+	})
 })
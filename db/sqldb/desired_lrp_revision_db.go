@@ -0,0 +1,230 @@
+package sqldb
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+// maxDesiredLRPRevisions is the number of revisions kept for each DesiredLRP.
+const maxDesiredLRPRevisions = 20
+
+// DesiredLRPRevisions returns the revisions of the DesiredLRP, oldest first.
+func (db *SQLDB) DesiredLRPRevisions(logger lager.Logger, processGuid string) ([]*models.DesiredLRPRevision, error) {
+	logger = logger.Session("desired-lrp-revisions", lager.Data{"process_guid": processGuid})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	results := []*models.DesiredLRPRevision{}
+
+	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+		rows, err := db.allOrdered(logger, tx, desiredLRPRevisionsTable,
+			desiredLRPRevisionColumns, "revision", 0,
+			"process_guid = ?", processGuid,
+		)
+		if err != nil {
+			logger.Error("failed-query", err)
+			return err
+		}
+		defer rows.Close()
+
+		results = []*models.DesiredLRPRevision{}
+		for rows.Next() {
+			revision, err := db.fetchDesiredLRPRevision(logger, rows)
+			if err != nil {
+				logger.Error("failed-reading-row", err)
+				continue
+			}
+			results = append(results, revision)
+		}
+
+		if rows.Err() != nil {
+			logger.Error("failed-fetching-row", rows.Err())
+			return db.convertSQLError(rows.Err())
+		}
+
+		return nil
+	})
+
+	return results, err
+}
+
+func (db *SQLDB) DesiredLRPRevision(logger lager.Logger, processGuid string, revision int64) (*models.DesiredLRPRevision, error) {
+	logger = logger.Session("desired-lrp-revision", lager.Data{"process_guid": processGuid, "revision": revision})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	var result *models.DesiredLRPRevision
+
+	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+		var err error
+		row := db.one(logger, tx, desiredLRPRevisionsTable,
+			desiredLRPRevisionColumns, helpers.NoLockRow,
+			"process_guid = ? AND revision = ?", processGuid, revision,
+		)
+
+		result, err = db.fetchDesiredLRPRevision(logger, row)
+		return err
+	})
+
+	return result, err
+}
+
+// RollbackDesiredLRP applies the instances, routes and annotation of the
+// revision to the DesiredLRP and, when they differ from the current ones, its
+// run definition and resources, all in one transaction recorded as a single
+// new revision. It returns the DesiredLRP before and after the rollback.
+func (db *SQLDB) RollbackDesiredLRP(logger lager.Logger, processGuid string, revision int64, expectedTag *models.ModificationTag) (*models.DesiredLRP, *models.DesiredLRP, error) {
+	logger = logger.Session("rollback-desired-lrp", lager.Data{"process_guid": processGuid, "revision": revision})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	var beforeDesiredLRP, afterDesiredLRP *models.DesiredLRP
+
+	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+		var err error
+		beforeDesiredLRP, err = db.lockDesiredLRP(logger, tx, processGuid, expectedTag)
+		if err != nil {
+			return err
+		}
+
+		row := db.one(logger, tx, desiredLRPRevisionsTable,
+			desiredLRPRevisionColumns, helpers.NoLockRow,
+			"process_guid = ? AND revision = ?", processGuid, revision,
+		)
+		target, err := db.fetchDesiredLRPRevision(logger, row)
+		if err != nil {
+			logger.Error("failed-fetching-revision", err)
+			return err
+		}
+
+		afterDesiredLRP, err = db.applyDesiredLRPUpdate(logger, tx, beforeDesiredLRP, target.DesiredLRPUpdate())
+		if err != nil {
+			return err
+		}
+
+		now := db.clock.Now()
+		runInfo, currentRunInfo := target.DesiredLrp.DesiredLRPRunInfo(now), beforeDesiredLRP.DesiredLRPRunInfo(now)
+		resource, currentResource := target.DesiredLrp.DesiredLRPResource(), beforeDesiredLRP.DesiredLRPResource()
+		if !runInfo.Equal(&currentRunInfo) || !resource.Equal(&currentResource) {
+			afterDesiredLRP, err = db.applyDesiredLRPRunInfo(logger, tx, afterDesiredLRP, &runInfo, &resource)
+			if err != nil {
+				return err
+			}
+		}
+
+		return db.recordDesiredLRPRevision(logger, tx, beforeDesiredLRP, afterDesiredLRP)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return beforeDesiredLRP, afterDesiredLRP, nil
+}
+
+// recordDesiredLRPRevision stores after, as written by the transaction, as the
+// next revision of the DesiredLRP and drops the revisions older than the last
+// maxDesiredLRPRevisions. When the DesiredLRP has no revisions yet, as is the
+// case for those desired before revisions were recorded, before is stored
+// first so that it can be rolled back to.
+func (db *SQLDB) recordDesiredLRPRevision(logger lager.Logger, tx *sql.Tx, before, after *models.DesiredLRP) error {
+	row := db.one(logger, tx, desiredLRPRevisionsTable,
+		helpers.ColumnList{"MAX(revision)"}, helpers.NoLockRow,
+		"process_guid = ?", after.ProcessGuid,
+	)
+
+	var lastRevision sql.NullInt64
+	err := row.Scan(&lastRevision)
+	if err != nil {
+		logger.Error("failed-fetching-last-revision", err)
+		return err
+	}
+	revision := lastRevision.Int64
+
+	if !lastRevision.Valid && before != nil {
+		revision++
+		err = db.insertDesiredLRPRevision(logger, tx, revision, before)
+		if err != nil {
+			return err
+		}
+	}
+
+	revision++
+	err = db.insertDesiredLRPRevision(logger, tx, revision, after)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.delete(logger, tx, desiredLRPRevisionsTable,
+		"process_guid = ? AND revision <= ?", after.ProcessGuid, revision-maxDesiredLRPRevisions,
+	)
+	if err != nil {
+		logger.Error("failed-deleting-old-revisions", err)
+		return err
+	}
+
+	return nil
+}
+
+func (db *SQLDB) insertDesiredLRPRevision(logger lager.Logger, tx *sql.Tx, revision int64, desiredLRP *models.DesiredLRP) error {
+	desiredLRPData, err := db.serializeModel(logger, desiredLRP)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.insert(logger, tx, desiredLRPRevisionsTable,
+		helpers.SQLAttributes{
+			"process_guid":           desiredLRP.ProcessGuid,
+			"revision":               revision,
+			"modification_tag_epoch": desiredLRP.ModificationTag.Epoch,
+			"modification_tag_index": desiredLRP.ModificationTag.Index,
+			"created_at":             db.clock.Now().UnixNano(),
+			"desired_lrp":            desiredLRPData,
+		},
+	)
+	if err != nil {
+		logger.Error("failed-inserting-revision", err, lager.Data{"revision": revision})
+		return err
+	}
+	return nil
+}
+
+func (db *SQLDB) deleteDesiredLRPRevisions(logger lager.Logger, q Queryable, processGuid string) error {
+	_, err := db.delete(logger, q, desiredLRPRevisionsTable, "process_guid = ?", processGuid)
+	if err != nil {
+		logger.Error("failed-deleting-revisions", err, lager.Data{"process_guid": processGuid})
+		return err
+	}
+	return nil
+}
+
+func (db *SQLDB) fetchDesiredLRPRevision(logger lager.Logger, scanner RowScanner) (*models.DesiredLRPRevision, error) {
+	var desiredLRPData []byte
+	revision := &models.DesiredLRPRevision{ModificationTag: &models.ModificationTag{}}
+
+	err := scanner.Scan(
+		&revision.ProcessGuid,
+		&revision.Revision,
+		&revision.ModificationTag.Epoch,
+		&revision.ModificationTag.Index,
+		&revision.CreatedAt,
+		&desiredLRPData,
+	)
+	if err == sql.ErrNoRows {
+		return nil, models.ErrResourceNotFound
+	} else if err != nil {
+		logger.Error("failed-scanning", err)
+		return nil, err
+	}
+
+	desiredLRP := &models.DesiredLRP{}
+	err = db.deserializeModel(logger, desiredLRPData, desiredLRP)
+	if err != nil {
+		return nil, err
+	}
+	revision.DesiredLrp = desiredLRP
+
+	return revision, nil
+}
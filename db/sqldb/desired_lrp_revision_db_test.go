@@ -0,0 +1,201 @@
+package sqldb_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DesiredLRPRevisionDB", func() {
+	var desiredLRP *models.DesiredLRP
+
+	BeforeEach(func() {
+		desiredLRP = model_helpers.NewValidDesiredLRP("the-guid")
+		Expect(sqlDB.DesireLRP(logger, desiredLRP)).To(Succeed())
+	})
+
+	currentDesiredLRP := func() *models.DesiredLRP {
+		current, err := sqlDB.DesiredLRPByProcessGuid(logger, "the-guid")
+		Expect(err).NotTo(HaveOccurred())
+		return current
+	}
+
+	Describe("DesiredLRPRevisions", func() {
+		It("records the desired lrp as its first revision", func() {
+			revisions, err := sqlDB.DesiredLRPRevisions(logger, "the-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revisions).To(HaveLen(1))
+
+			revision := revisions[0]
+			Expect(revision.ProcessGuid).To(Equal("the-guid"))
+			Expect(revision.Revision).To(BeEquivalentTo(1))
+			Expect(revision.ModificationTag).To(Equal(desiredLRP.ModificationTag))
+			Expect(revision.CreatedAt).To(Equal(fakeClock.Now().UnixNano()))
+			Expect(revision.DesiredLrp).To(Equal(currentDesiredLRP()))
+		})
+
+		It("records every update as a new revision", func() {
+			fakeClock.Increment(time.Minute)
+
+			instances := int32(7)
+			annotation := "new-annotation"
+			_, err := sqlDB.UpdateDesiredLRP(logger, "the-guid", &models.DesiredLRPUpdate{Instances: &instances, Annotation: &annotation}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			revisions, err := sqlDB.DesiredLRPRevisions(logger, "the-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revisions).To(HaveLen(2))
+			Expect(revisions[0].DesiredLrp.Instances).To(Equal(desiredLRP.Instances))
+
+			current := currentDesiredLRP()
+			Expect(revisions[1].Revision).To(BeEquivalentTo(2))
+			Expect(revisions[1].ModificationTag).To(Equal(current.ModificationTag))
+			Expect(revisions[1].CreatedAt).To(Equal(fakeClock.Now().UnixNano()))
+			Expect(revisions[1].DesiredLrp).To(Equal(current))
+		})
+
+		It("records redeploys as a new revision", func() {
+			runInfo := desiredLRP.DesiredLRPRunInfo(fakeClock.Now())
+			runInfo.Action = models.WrapAction(&models.RunAction{Path: "new-path", User: "me"})
+			_, err := sqlDB.UpdateDesiredLRPRunInfo(logger, "the-guid", &runInfo, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			revisions, err := sqlDB.DesiredLRPRevisions(logger, "the-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revisions).To(HaveLen(2))
+			Expect(revisions[1].DesiredLrp).To(Equal(currentDesiredLRP()))
+		})
+
+		It("only keeps the latest revisions", func() {
+			for i := int32(0); i < 25; i++ {
+				instances := i
+				_, err := sqlDB.UpdateDesiredLRP(logger, "the-guid", &models.DesiredLRPUpdate{Instances: &instances}, nil)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			revisions, err := sqlDB.DesiredLRPRevisions(logger, "the-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revisions).To(HaveLen(20))
+			Expect(revisions[0].Revision).To(BeEquivalentTo(7))
+			Expect(revisions[19].Revision).To(BeEquivalentTo(26))
+			Expect(revisions[19].DesiredLrp.Instances).To(BeEquivalentTo(24))
+		})
+
+		Context("when the desired lrp is removed", func() {
+			BeforeEach(func() {
+				Expect(sqlDB.RemoveDesiredLRP(logger, "the-guid", nil)).To(Succeed())
+			})
+
+			It("removes its revisions", func() {
+				revisions, err := sqlDB.DesiredLRPRevisions(logger, "the-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(revisions).To(BeEmpty())
+			})
+		})
+
+		Context("when the desired lrp was desired before revisions were recorded", func() {
+			BeforeEach(func() {
+				_, err := db.Exec("DELETE FROM desired_lrp_revisions")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("records its previous definition before the update", func() {
+				before := currentDesiredLRP()
+
+				instances := int32(7)
+				_, err := sqlDB.UpdateDesiredLRP(logger, "the-guid", &models.DesiredLRPUpdate{Instances: &instances}, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				revisions, err := sqlDB.DesiredLRPRevisions(logger, "the-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(revisions).To(HaveLen(2))
+				Expect(revisions[0].DesiredLrp).To(Equal(before))
+				Expect(revisions[1].DesiredLrp.Instances).To(BeEquivalentTo(7))
+			})
+		})
+	})
+
+	Describe("DesiredLRPRevision", func() {
+		It("returns the revision", func() {
+			revision, err := sqlDB.DesiredLRPRevision(logger, "the-guid", 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revision.Revision).To(BeEquivalentTo(1))
+			Expect(revision.DesiredLrp).To(Equal(currentDesiredLRP()))
+		})
+
+		Context("when the revision does not exist", func() {
+			It("returns a resource not found error", func() {
+				_, err := sqlDB.DesiredLRPRevision(logger, "the-guid", 2)
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+	})
+
+	Describe("RollbackDesiredLRP", func() {
+		BeforeEach(func() {
+			instances := int32(7)
+			_, err := sqlDB.UpdateDesiredLRP(logger, "the-guid", &models.DesiredLRPUpdate{Instances: &instances}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			runInfo := desiredLRP.DesiredLRPRunInfo(fakeClock.Now())
+			runInfo.Action = models.WrapAction(&models.RunAction{Path: "new-path", User: "me"})
+			resource := desiredLRP.DesiredLRPResource()
+			resource.MemoryMb = desiredLRP.MemoryMb + 1
+			_, err = sqlDB.UpdateDesiredLRPRunInfo(logger, "the-guid", &runInfo, &resource)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("restores the instances, run definition and resources of the revision", func() {
+			before := currentDesiredLRP()
+
+			rolledBackFrom, rolledBackTo, err := sqlDB.RollbackDesiredLRP(logger, "the-guid", 1, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rolledBackFrom).To(Equal(before))
+
+			current := currentDesiredLRP()
+			Expect(rolledBackTo).To(Equal(current))
+			Expect(current.Instances).To(Equal(desiredLRP.Instances))
+			Expect(current.Action).To(Equal(desiredLRP.Action))
+			Expect(current.MemoryMb).To(Equal(desiredLRP.MemoryMb))
+			Expect(current.ModificationTag.Index).To(BeNumerically(">", before.ModificationTag.Index))
+		})
+
+		It("records the rollback as a single new revision", func() {
+			_, _, err := sqlDB.RollbackDesiredLRP(logger, "the-guid", 1, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			revisions, err := sqlDB.DesiredLRPRevisions(logger, "the-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revisions).To(HaveLen(4))
+			Expect(revisions[3].DesiredLrp).To(Equal(currentDesiredLRP()))
+		})
+
+		Context("when the expected modification tag is stale", func() {
+			It("returns a conflict and leaves the desired lrp unchanged", func() {
+				before := currentDesiredLRP()
+				staleTag := models.NewModificationTag(before.ModificationTag.Epoch, before.ModificationTag.Index-1)
+
+				_, _, err := sqlDB.RollbackDesiredLRP(logger, "the-guid", 1, &staleTag)
+				Expect(err).To(Equal(models.ErrResourceConflict))
+				Expect(currentDesiredLRP()).To(Equal(before))
+			})
+		})
+
+		Context("when the revision does not exist", func() {
+			It("returns a resource not found error and leaves the desired lrp unchanged", func() {
+				before := currentDesiredLRP()
+
+				_, _, err := sqlDB.RollbackDesiredLRP(logger, "the-guid", 9, nil)
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+				Expect(currentDesiredLRP()).To(Equal(before))
+
+				revisions, err := sqlDB.DesiredLRPRevisions(logger, "the-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(revisions).To(HaveLen(3))
+			})
+		})
+	})
+})
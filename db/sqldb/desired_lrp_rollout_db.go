@@ -0,0 +1,218 @@
+package sqldb
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+func (db *SQLDB) DesiredLRPRollouts(logger lager.Logger) ([]*models.DesiredLRPRollout, error) {
+	logger = logger.Session("desired-lrp-rollouts")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	results := []*models.DesiredLRPRollout{}
+
+	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+		rows, err := db.all(logger, tx, desiredLRPRolloutsTable,
+			desiredLRPRolloutColumns, helpers.NoLockRow, "",
+		)
+		if err != nil {
+			logger.Error("failed-query", err)
+			return err
+		}
+		defer rows.Close()
+
+		results = []*models.DesiredLRPRollout{}
+		for rows.Next() {
+			rollout, err := db.fetchDesiredLRPRollout(logger, rows)
+			if err != nil {
+				logger.Error("failed-reading-row", err)
+				continue
+			}
+			results = append(results, rollout)
+		}
+
+		if rows.Err() != nil {
+			logger.Error("failed-fetching-row", rows.Err())
+			return db.convertSQLError(rows.Err())
+		}
+
+		return nil
+	})
+
+	return results, err
+}
+
+func (db *SQLDB) DesiredLRPRollout(logger lager.Logger, processGuid string) (*models.DesiredLRPRollout, error) {
+	logger = logger.Session("desired-lrp-rollout", lager.Data{"process_guid": processGuid})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	var rollout *models.DesiredLRPRollout
+
+	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+		var err error
+		row := db.one(logger, tx, desiredLRPRolloutsTable,
+			desiredLRPRolloutColumns, helpers.NoLockRow,
+			"process_guid = ?", processGuid,
+		)
+
+		rollout, err = db.fetchDesiredLRPRollout(logger, row)
+		return err
+	})
+
+	return rollout, err
+}
+
+func (db *SQLDB) UpsertDesiredLRPRollout(logger lager.Logger, rollout *models.DesiredLRPRollout) error {
+	logger = logger.Session("upsert-desired-lrp-rollout", lager.Data{"process_guid": rollout.ProcessGuid})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	attributes, err := desiredLRPRolloutAttributes(logger, rollout)
+	if err != nil {
+		return err
+	}
+
+	return db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+		_, err := db.upsert(logger, tx, desiredLRPRolloutsTable,
+			helpers.SQLAttributes{"process_guid": rollout.ProcessGuid},
+			attributes,
+		)
+		if err != nil {
+			logger.Error("failed-upserting-desired-lrp-rollout", err)
+			return err
+		}
+		return nil
+	})
+}
+
+func (db *SQLDB) UpdateDesiredLRPRollout(logger lager.Logger, rollout *models.DesiredLRPRollout) error {
+	logger = logger.Session("update-desired-lrp-rollout", lager.Data{"process_guid": rollout.ProcessGuid})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	attributes, err := desiredLRPRolloutAttributes(logger, rollout)
+	if err != nil {
+		return err
+	}
+
+	return db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+		row := db.one(logger, tx, desiredLRPRolloutsTable,
+			desiredLRPRolloutColumns, helpers.LockRow,
+			"process_guid = ?", rollout.ProcessGuid,
+		)
+
+		existing, err := db.fetchDesiredLRPRollout(logger, row)
+		if err != nil {
+			return err
+		}
+
+		if !existing.ModificationTag.Equal(rollout.ModificationTag) {
+			logger.Info("rollout-superseded", lager.Data{"modification_tag": existing.ModificationTag})
+			return models.ErrResourceNotFound
+		}
+
+		_, err = db.update(logger, tx, desiredLRPRolloutsTable,
+			attributes,
+			"process_guid = ?", rollout.ProcessGuid,
+		)
+		if err != nil {
+			logger.Error("failed-updating-desired-lrp-rollout", err)
+			return err
+		}
+		return nil
+	})
+}
+
+func (db *SQLDB) RemoveDesiredLRPRollout(logger lager.Logger, processGuid string) error {
+	logger = logger.Session("remove-desired-lrp-rollout", lager.Data{"process_guid": processGuid})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	return db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+		result, err := db.delete(logger, tx, desiredLRPRolloutsTable, "process_guid = ?", processGuid)
+		if err != nil {
+			logger.Error("failed-deleting-desired-lrp-rollout", err)
+			return err
+		}
+
+		numRows, err := result.RowsAffected()
+		if err != nil {
+			logger.Error("failed-getting-rows-affected", err)
+			return err
+		}
+		if numRows == 0 {
+			return models.ErrResourceNotFound
+		}
+
+		return nil
+	})
+}
+
+func desiredLRPRolloutAttributes(logger lager.Logger, rollout *models.DesiredLRPRollout) (helpers.SQLAttributes, error) {
+	replacedIndices := rollout.ReplacedIndices
+	if replacedIndices == nil {
+		replacedIndices = []int32{}
+	}
+
+	replacedData, err := json.Marshal(replacedIndices)
+	if err != nil {
+		logger.Error("failed-serializing-replaced-indices", err)
+		return nil, err
+	}
+
+	tag := rollout.ModificationTag
+	if tag == nil {
+		tag = &models.ModificationTag{}
+	}
+
+	return helpers.SQLAttributes{
+		"modification_tag_epoch": tag.Epoch,
+		"modification_tag_index": tag.Index,
+		"max_in_flight":          rollout.MaxInFlight,
+		"started_at":             rollout.StartedAt,
+		"deadline":               rollout.Deadline,
+		"replaced_indices":       string(replacedData),
+		"state":                  rollout.State,
+		"failure_reason":         truncateString(rollout.FailureReason, 1024),
+	}, nil
+}
+
+func (db *SQLDB) fetchDesiredLRPRollout(logger lager.Logger, scanner RowScanner) (*models.DesiredLRPRollout, error) {
+	var replacedData string
+	rollout := &models.DesiredLRPRollout{ModificationTag: &models.ModificationTag{}}
+
+	err := scanner.Scan(
+		&rollout.ProcessGuid,
+		&rollout.ModificationTag.Epoch,
+		&rollout.ModificationTag.Index,
+		&rollout.MaxInFlight,
+		&rollout.StartedAt,
+		&rollout.Deadline,
+		&replacedData,
+		&rollout.State,
+		&rollout.FailureReason,
+	)
+	if err == sql.ErrNoRows {
+		return nil, models.ErrResourceNotFound
+	} else if err != nil {
+		logger.Error("failed-scanning", err)
+		return nil, err
+	}
+
+	err = json.Unmarshal([]byte(replacedData), &rollout.ReplacedIndices)
+	if err != nil {
+		logger.Error("failed-parsing-replaced-indices", err)
+		return nil, models.ErrDeserialize
+	}
+	if len(rollout.ReplacedIndices) == 0 {
+		rollout.ReplacedIndices = nil
+	}
+
+	return rollout, nil
+}
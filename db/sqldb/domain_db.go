@@ -55,19 +55,23 @@ func (db *SQLDB) UpsertDomain(logger lager.Logger, domain string, ttl uint32) er
 	defer logger.Debug("complete")
 
 	return db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
-		expireTime := db.clock.Now().Add(time.Duration(ttl) * time.Second).UnixNano()
-		if ttl == 0 {
-			expireTime = math.MaxInt64
-		}
-
-		_, err := db.upsert(logger, tx, domainsTable,
-			helpers.SQLAttributes{"domain": domain},
-			helpers.SQLAttributes{"expire_time": expireTime},
-		)
-		if err != nil {
-			logger.Error("failed-upsert-domain", err)
-			return err
-		}
-		return nil
+		return db.upsertDomain(logger, tx, domain, ttl)
 	})
 }
+
+func (db *SQLDB) upsertDomain(logger lager.Logger, tx *sql.Tx, domain string, ttl uint32) error {
+	expireTime := db.clock.Now().Add(time.Duration(ttl) * time.Second).UnixNano()
+	if ttl == 0 {
+		expireTime = math.MaxInt64
+	}
+
+	_, err := db.upsert(logger, tx, domainsTable,
+		helpers.SQLAttributes{"domain": domain},
+		helpers.SQLAttributes{"expire_time": expireTime},
+	)
+	if err != nil {
+		logger.Error("failed-upsert-domain", err)
+		return err
+	}
+	return nil
+}
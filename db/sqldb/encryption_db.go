@@ -2,15 +2,48 @@ package sqldb
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 
 	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
 	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager"
 )
 
 const EncryptionKeyID = "encryption_key_label"
 
+// encryptedTable describes the columns of a table that are re-encrypted when
+// the active encryption key changes. The rows are identified and visited in
+// the order of their primaryKeys.
+type encryptedTable struct {
+	name           string
+	primaryKeys    helpers.ColumnList
+	encryptIfEmpty bool
+	blobColumns    []string
+}
+
+var encryptedTables = []encryptedTable{
+	{tasksTable, helpers.ColumnList{"guid"}, true, []string{"task_definition"}},
+	{desiredLRPsTable, helpers.ColumnList{"process_guid"}, true, []string{"run_info", "volume_placement", "routes"}},
+	{actualLRPsTable, helpers.ColumnList{"process_guid", "instance_index", "evacuating"}, false, []string{"net_info"}},
+	{desiredLRPRevisionsTable, helpers.ColumnList{"process_guid", "revision"}, true, []string{"desired_lrp"}},
+}
+
+// encryptionCheckpoint is the progress of re-encrypting a table with the key
+// labelled keyLabel, as stored in the encryption_progress table. oldKeyRows
+// counts the rows done that could not be decoded, and so were left encrypted
+// with another key.
+type encryptionCheckpoint struct {
+	keyLabel    string
+	lastKey     []string
+	rowsDone    int64
+	oldKeyRows  int64
+	completedAt int64
+}
+
 func (db *SQLDB) SetEncryptionKeyLabel(logger lager.Logger, label string) error {
 	logger = logger.Session("set-encrption-key-label", lager.Data{"label": label})
 	logger.Debug("starting")
@@ -30,23 +63,13 @@ func (db *SQLDB) EncryptionKeyLabel(logger lager.Logger) (string, error) {
 func (db *SQLDB) PerformEncryption(logger lager.Logger) error {
 	errCh := make(chan error)
 
-	funcs := []func(){
-		func() {
-			errCh <- db.reEncrypt(logger, tasksTable, "guid", true, "task_definition")
-		},
-		func() {
-			errCh <- db.reEncrypt(logger, desiredLRPsTable, "process_guid", true, "run_info", "volume_placement", "routes")
-		},
-		func() {
-			errCh <- db.reEncrypt(logger, actualLRPsTable, "process_guid", false, "net_info")
-		},
-	}
-
-	for _, f := range funcs {
-		go f()
+	for _, table := range encryptedTables {
+		go func(table encryptedTable) {
+			errCh <- db.reEncrypt(logger, table)
+		}(table)
 	}
 
-	for range funcs {
+	for range encryptedTables {
 		err := <-errCh
 		if err != nil {
 			return err
@@ -55,85 +78,513 @@ func (db *SQLDB) PerformEncryption(logger lager.Logger) error {
 	return nil
 }
 
-func (db *SQLDB) reEncrypt(logger lager.Logger, tableName, primaryKey string, encryptIfEmpty bool, blobColumns ...string) error {
+// EncryptionProgress returns the progress of the last re-encryption of every
+// table, along with how many of its rows are encrypted with each key. Counting
+// them decodes every row, so it is meant for operators rather than for the
+// re-encryption itself, which uses EncryptionCheckpoints.
+func (db *SQLDB) EncryptionProgress(logger lager.Logger) ([]*models.EncryptionTableProgress, error) {
+	logger = logger.Session("encryption-progress")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	results, err := db.encryptionCheckpoints(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, table := range encryptedTables {
+		results[i].KeyLabelRows, err = db.countRowsByKeyLabel(logger, table)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+// EncryptionCheckpoints returns the progress of the last re-encryption of
+// every table, as recorded after each of its batches.
+func (db *SQLDB) EncryptionCheckpoints(logger lager.Logger) ([]*models.EncryptionTableProgress, error) {
+	logger = logger.Session("encryption-checkpoints")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	return db.encryptionCheckpoints(logger)
+}
+
+func (db *SQLDB) encryptionCheckpoints(logger lager.Logger) ([]*models.EncryptionTableProgress, error) {
+	results := []*models.EncryptionTableProgress{}
+	for _, table := range encryptedTables {
+		checkpoint, err := db.fetchEncryptionCheckpoint(logger, table.name)
+		if err != nil {
+			return nil, err
+		}
+
+		progress, err := db.encryptionTableProgress(logger, table, checkpoint)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, progress)
+	}
+
+	return results, nil
+}
+
+// ReEncryptBatch re-encrypts, with the active key, the next batchSize rows of
+// the table that have not been re-encrypted since the key labelled keyLabel
+// became active, and records how far it got and how many of the rows could
+// not be decoded. The table is completed once a batch comes up short.
+func (db *SQLDB) ReEncryptBatch(logger lager.Logger, tableName, keyLabel string, batchSize int) (*models.EncryptionTableProgress, error) {
+	logger = logger.Session("re-encrypt-batch", lager.Data{"table_name": tableName, "key_label": keyLabel, "batch_size": batchSize})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	table, ok := lookupEncryptedTable(tableName)
+	if !ok {
+		return nil, models.NewError(models.Error_InvalidRequest, "unknown table "+tableName)
+	}
+
+	if batchSize <= 0 {
+		return nil, models.NewError(models.Error_InvalidRequest, "batch size must be positive")
+	}
+
+	checkpoint, err := db.fetchEncryptionCheckpoint(logger, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	if checkpoint.keyLabel != keyLabel {
+		checkpoint = &encryptionCheckpoint{keyLabel: keyLabel}
+	}
+
+	if checkpoint.completedAt == 0 {
+		keys, err := db.nextPrimaryKeys(logger, table, checkpoint.lastKey, batchSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, key := range keys {
+			values := make([]interface{}, len(key))
+			for i := range key {
+				values[i] = key[i]
+			}
+
+			undecodable, err := db.reEncryptRow(logger, table, values)
+			if err != nil {
+				return nil, err
+			}
+			if undecodable {
+				checkpoint.oldKeyRows++
+			}
+		}
+
+		checkpoint.rowsDone += int64(len(keys))
+		if len(keys) > 0 {
+			checkpoint.lastKey = keys[len(keys)-1]
+		}
+		if len(keys) < batchSize {
+			checkpoint.completedAt = db.clock.Now().UnixNano()
+		}
+
+		err = db.saveEncryptionCheckpoint(logger, tableName, checkpoint)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return db.encryptionTableProgress(logger, table, checkpoint)
+}
+
+func lookupEncryptedTable(tableName string) (encryptedTable, bool) {
+	for _, table := range encryptedTables {
+		if table.name == tableName {
+			return table, true
+		}
+	}
+	return encryptedTable{}, false
+}
+
+// reEncrypt re-encrypts the blob columns of every row of the table, one row
+// at a time.
+func (db *SQLDB) reEncrypt(logger lager.Logger, table encryptedTable) error {
 	logger = logger.WithData(
-		lager.Data{"table_name": tableName, "primary_key": primaryKey, "blob_columns": blobColumns},
+		lager.Data{"table_name": table.name, "primary_keys": table.primaryKeys, "blob_columns": table.blobColumns},
 	)
-	rows, err := db.db.Query(fmt.Sprintf("SELECT %s FROM %s", primaryKey, tableName))
+	rows, err := db.db.Query(fmt.Sprintf("SELECT %s FROM %s", strings.Join(table.primaryKeys, ", "), table.name))
 	if err != nil {
 		return err
 	}
 	defer rows.Close()
 
-	guids := []string{}
+	keys := [][]interface{}{}
 	for rows.Next() {
-		var guid string
-		err := rows.Scan(&guid)
+		values := make([]string, len(table.primaryKeys))
+		key := make([]interface{}, len(table.primaryKeys))
+		for i := range values {
+			key[i] = &values[i]
+		}
+
+		err := rows.Scan(key...)
 		if err != nil {
 			logger.Error("failed-to-scan-primary-key", err)
 			continue
 		}
-		guids = append(guids, guid)
+		for i := range values {
+			key[i] = values[i]
+		}
+		keys = append(keys, key)
 	}
 
-	where := fmt.Sprintf("%s = ?", primaryKey)
-	for _, guid := range guids {
-		err = db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
-			blobs := make([]interface{}, len(blobColumns))
+	for _, key := range keys {
+		_, err = db.reEncryptRow(logger, table, key)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-			row := db.one(logger, tx, tableName, blobColumns, helpers.LockRow, where, guid)
-			for i := range blobColumns {
-				var blob []byte
-				blobs[i] = &blob
-			}
+// reEncryptRow re-encrypts the blob columns of the row identified by key.
+// Rows that cannot be decoded are left as they are, and reported as such.
+func (db *SQLDB) reEncryptRow(logger lager.Logger, table encryptedTable, key []interface{}) (bool, error) {
+	return db.rewriteRow(logger, table, key, true)
+}
 
-			err := row.Scan(blobs...)
-			if err != nil {
-				logger.Error("failed-to-scan-blob", err)
+// rewriteRow encodes the blob columns of the row identified by key again,
+// with the active key and the encoding of the BBS. Unless force is set, rows
+// whose blob columns already use that encoding are left as they are. It
+// returns true when the row was left as it was because a blob could not be
+// decoded.
+func (db *SQLDB) rewriteRow(logger lager.Logger, table encryptedTable, key []interface{}, force bool) (bool, error) {
+	wheres := make([]string, len(table.primaryKeys))
+	for i, column := range table.primaryKeys {
+		wheres[i] = fmt.Sprintf("%s = ?", column)
+	}
+	where := strings.Join(wheres, " AND ")
+
+	var undecodable bool
+	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+		undecodable = false
+
+		blobs := make([]interface{}, len(table.blobColumns))
+
+		row := db.one(logger, tx, table.name, table.blobColumns, helpers.LockRow, where, key...)
+		for i := range table.blobColumns {
+			var blob []byte
+			blobs[i] = &blob
+		}
+
+		err := row.Scan(blobs...)
+		if err != nil {
+			logger.Error("failed-to-scan-blob", err)
+			return nil
+		}
+
+		if !force && db.alreadyEncoded(blobs) {
+			return nil
+		}
+
+		updatedColumnValues := map[string]interface{}{}
+
+		for columnIdx := range blobs {
+			// This type assertion should not fail because we set the value to be a pointer to a byte array above
+			blobPtr := blobs[columnIdx].(*[]byte)
+			blob := *blobPtr
+
+			// don't encrypt column if it doesn't contain any data, see #132626553 for more info
+			if !table.encryptIfEmpty && len(blob) == 0 {
 				return nil
 			}
 
-			updatedColumnValues := map[string]interface{}{}
-
-			for columnIdx := range blobs {
-				// This type assertion should not fail because we set the value to be a pointer to a byte array above
-				blobPtr := blobs[columnIdx].(*[]byte)
-				blob := *blobPtr
-
-				// don't encrypt column if it doesn't contain any data, see #132626553 for more info
-				if !encryptIfEmpty && len(blob) == 0 {
-					return nil
-				}
-
-				encoder := format.NewEncoder(db.cryptor)
-				payload, err := encoder.Decode(blob)
-				if err != nil {
-					logger.Error("failed-to-decode-blob", err)
-					return nil
-				}
-				encryptedPayload, err := encoder.Encode(format.BASE64_ENCRYPTED, payload)
-				if err != nil {
-					logger.Error("failed-to-encode-blob", err)
-					return err
-				}
-
-				columnName := blobColumns[columnIdx]
-				updatedColumnValues[columnName] = encryptedPayload
+			encoder := format.NewEncoder(db.cryptor)
+			payload, err := encoder.Decode(blob)
+			if err != nil {
+				logger.Error("failed-to-decode-blob", err)
+				undecodable = true
+				return nil
 			}
-			_, err = db.update(logger, tx, tableName,
-				updatedColumnValues,
-				where, guid,
-			)
+			encryptedPayload, err := encoder.Encode(db.format.Encoding, payload)
 			if err != nil {
-				logger.Error("failed-to-update-blob", err)
+				logger.Error("failed-to-encode-blob", err)
 				return err
 			}
-			return nil
-		})
 
+			columnName := table.blobColumns[columnIdx]
+			updatedColumnValues[columnName] = encryptedPayload
+		}
+		_, err = db.update(logger, tx, table.name,
+			updatedColumnValues,
+			where, key...,
+		)
 		if err != nil {
+			logger.Error("failed-to-update-blob", err)
 			return err
 		}
+		return nil
+	})
+
+	return undecodable, err
+}
+
+// nextPrimaryKeys returns the primary keys of up to limit rows of the table
+// that come after lastKey, in order.
+func (db *SQLDB) nextPrimaryKeys(logger lager.Logger, table encryptedTable, lastKey []string, limit int) ([][]string, error) {
+	wheres, bindings := afterPrimaryKey(table.primaryKeys, lastKey)
+	rows, err := db.allOrdered(logger, db.db, table.name,
+		table.primaryKeys, strings.Join(table.primaryKeys, ", "), limit,
+		wheres, bindings...,
+	)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil, db.convertSQLError(err)
 	}
-	return nil
+	defer rows.Close()
+
+	keys := [][]string{}
+	for rows.Next() {
+		key := make([]string, len(table.primaryKeys))
+		pointers := make([]interface{}, len(key))
+		for i := range key {
+			pointers[i] = &key[i]
+		}
+
+		err := rows.Scan(pointers...)
+		if err != nil {
+			logger.Error("failed-to-scan-primary-key", err)
+			return nil, db.convertSQLError(err)
+		}
+		keys = append(keys, key)
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-fetching-row", rows.Err())
+		return nil, db.convertSQLError(rows.Err())
+	}
+
+	return keys, nil
+}
+
+// afterPrimaryKey returns the condition selecting the rows whose primary
+// keys sort after lastKey, or no condition when lastKey is empty.
+func afterPrimaryKey(primaryKeys helpers.ColumnList, lastKey []string) (string, []interface{}) {
+	if len(lastKey) != len(primaryKeys) {
+		return "", nil
+	}
+
+	clauses := []string{}
+	bindings := []interface{}{}
+	for i := range primaryKeys {
+		conditions := []string{}
+		for j := 0; j < i; j++ {
+			conditions = append(conditions, primaryKeys[j]+" = ?")
+			bindings = append(bindings, lastKey[j])
+		}
+		conditions = append(conditions, primaryKeys[i]+" > ?")
+		bindings = append(bindings, lastKey[i])
+		clauses = append(clauses, "("+strings.Join(conditions, " AND ")+")")
+	}
+
+	return strings.Join(clauses, " OR "), bindings
+}
+
+func (db *SQLDB) encryptionTableProgress(logger lager.Logger, table encryptedTable, checkpoint *encryptionCheckpoint) (*models.EncryptionTableProgress, error) {
+	progress := &models.EncryptionTableProgress{
+		TableName:  table.name,
+		KeyLabel:   checkpoint.keyLabel,
+		RowsDone:   checkpoint.rowsDone,
+		OldKeyRows: checkpoint.oldKeyRows,
+		Completed:  checkpoint.completedAt != 0,
+	}
+
+	if progress.Completed {
+		return progress, nil
+	}
+
+	wheres, bindings := afterPrimaryKey(table.primaryKeys, checkpoint.lastKey)
+	query := fmt.Sprintf("SELECT COUNT(*) FROM %s", table.name)
+	if wheres != "" {
+		query += " WHERE " + wheres
+	}
+
+	err := db.db.QueryRow(db.helper.Rebind(query), bindings...).Scan(&progress.RowsRemaining)
+	if err != nil {
+		logger.Error("failed-counting-remaining-rows", err, lager.Data{"table_name": table.name})
+		return nil, db.convertSQLError(err)
+	}
+
+	return progress, nil
+}
+
+// countRowsByKeyLabel returns how many rows of the table have a blob column
+// encrypted with each key, sorted by key label. Unencrypted rows are counted
+// under an empty label and empty columns are not counted.
+func (db *SQLDB) countRowsByKeyLabel(logger lager.Logger, table encryptedTable) ([]*models.EncryptionKeyRows, error) {
+	rows, err := db.db.Query(fmt.Sprintf("SELECT %s FROM %s", strings.Join(table.blobColumns, ", "), table.name))
+	if err != nil {
+		logger.Error("failed-query", err, lager.Data{"table_name": table.name})
+		return nil, db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	counts := map[string]int64{}
+	for rows.Next() {
+		blobs := make([][]byte, len(table.blobColumns))
+		pointers := make([]interface{}, len(blobs))
+		for i := range blobs {
+			pointers[i] = &blobs[i]
+		}
+
+		err := rows.Scan(pointers...)
+		if err != nil {
+			logger.Error("failed-to-scan-blob", err, lager.Data{"table_name": table.name})
+			return nil, db.convertSQLError(err)
+		}
+
+		for label := range blobKeyLabels(logger, table.name, blobs) {
+			counts[label]++
+		}
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-fetching-row", rows.Err())
+		return nil, db.convertSQLError(rows.Err())
+	}
+
+	return sortedKeyLabelRows(counts), nil
+}
+
+// QuarantinedRowsByKeyLabel returns how many quarantined rows have a blob
+// column encrypted with each key, sorted by key label, counted the same way
+// as the rows of the tables they were quarantined from.
+func (db *SQLDB) QuarantinedRowsByKeyLabel(logger lager.Logger) ([]*models.EncryptionKeyRows, error) {
+	logger = logger.Session("quarantined-rows-by-key-label")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	records, err := db.QuarantinedRecords(logger, "")
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[string]int64{}
+	for _, record := range records {
+		table, ok := lookupEncryptedTable(record.TableName)
+		if !ok {
+			continue
+		}
+
+		columns := map[string]*string{}
+		err := json.Unmarshal([]byte(record.Record), &columns)
+		if err != nil {
+			logger.Error("failed-parsing-record", err, lager.Data{"id": record.Id})
+			return nil, models.NewError(models.Error_InvalidRecord, err.Error())
+		}
+
+		blobs := make([][]byte, 0, len(table.blobColumns))
+		for _, column := range table.blobColumns {
+			if value := columns[column]; value != nil {
+				blobs = append(blobs, []byte(*value))
+			}
+		}
+
+		for label := range blobKeyLabels(logger, table.name, blobs) {
+			counts[label]++
+		}
+	}
+
+	return sortedKeyLabelRows(counts), nil
+}
+
+// blobKeyLabels returns the labels of the keys the non-empty blobs of a row
+// are encrypted with, with an empty label for unencrypted blobs.
+func blobKeyLabels(logger lager.Logger, tableName string, blobs [][]byte) map[string]struct{} {
+	labels := map[string]struct{}{}
+	for _, blob := range blobs {
+		if len(blob) == 0 {
+			continue
+		}
+
+		label, err := format.EncryptionKeyLabel(blob)
+		if err != nil {
+			logger.Error("failed-to-read-key-label", err, lager.Data{"table_name": tableName})
+			continue
+		}
+		labels[label] = struct{}{}
+	}
+	return labels
+}
+
+func sortedKeyLabelRows(counts map[string]int64) []*models.EncryptionKeyRows {
+	labels := make([]string, 0, len(counts))
+	for label := range counts {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+
+	keyLabelRows := []*models.EncryptionKeyRows{}
+	for _, label := range labels {
+		keyLabelRows = append(keyLabelRows, &models.EncryptionKeyRows{KeyLabel: label, Rows: counts[label]})
+	}
+	return keyLabelRows
+}
+
+func (db *SQLDB) fetchEncryptionCheckpoint(logger lager.Logger, tableName string) (*encryptionCheckpoint, error) {
+	var lastKey sql.NullString
+	checkpoint := &encryptionCheckpoint{}
+
+	row := db.one(logger, db.db, encryptionProgressTable,
+		helpers.ColumnList{"key_label", "last_key", "rows_done", "old_key_rows", "completed_at"}, helpers.NoLockRow,
+		"table_name = ?", tableName,
+	)
+
+	err := row.Scan(&checkpoint.keyLabel, &lastKey, &checkpoint.rowsDone, &checkpoint.oldKeyRows, &checkpoint.completedAt)
+	if err == sql.ErrNoRows {
+		return &encryptionCheckpoint{}, nil
+	}
+	if err != nil {
+		logger.Error("failed-fetching-encryption-progress", err, lager.Data{"table_name": tableName})
+		return nil, db.convertSQLError(err)
+	}
+
+	if lastKey.Valid && lastKey.String != "" {
+		err = json.Unmarshal([]byte(lastKey.String), &checkpoint.lastKey)
+		if err != nil {
+			logger.Error("failed-parsing-last-key", err, lager.Data{"table_name": tableName})
+			return nil, models.NewError(models.Error_InvalidRecord, err.Error())
+		}
+	}
+
+	return checkpoint, nil
+}
+
+func (db *SQLDB) saveEncryptionCheckpoint(logger lager.Logger, tableName string, checkpoint *encryptionCheckpoint) error {
+	var lastKey interface{}
+	if len(checkpoint.lastKey) > 0 {
+		data, err := json.Marshal(checkpoint.lastKey)
+		if err != nil {
+			logger.Error("failed-serializing-last-key", err)
+			return err
+		}
+		lastKey = string(data)
+	}
+
+	return db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+		_, err := db.upsert(logger, tx, encryptionProgressTable,
+			helpers.SQLAttributes{"table_name": tableName},
+			helpers.SQLAttributes{
+				"key_label":    checkpoint.keyLabel,
+				"last_key":     lastKey,
+				"rows_done":    checkpoint.rowsDone,
+				"old_key_rows": checkpoint.oldKeyRows,
+				"completed_at": checkpoint.completedAt,
+				"updated_at":   db.clock.Now().UnixNano(),
+			},
+		)
+		if err != nil {
+			logger.Error("failed-saving-encryption-progress", err, lager.Data{"table_name": tableName})
+			return err
+		}
+		return nil
+	})
 }
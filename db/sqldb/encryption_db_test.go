@@ -317,4 +317,261 @@ var _ = Describe("Encryption", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 	})
+
+	Describe("resumable encryption", func() {
+		var (
+			encryptingDB *sqldb.SQLDB
+			oldEncoder   format.Encoder
+		)
+
+		insertTask := func(guid string) {
+			encoded, err := oldEncoder.Encode(format.BASE64_ENCRYPTED, []byte("task-definition-"+guid))
+			Expect(err).NotTo(HaveOccurred())
+
+			queryStr := "INSERT INTO tasks (guid, domain, task_definition) VALUES (?, ?, ?)"
+			if test_helpers.UsePostgres() {
+				queryStr = test_helpers.ReplaceQuestionMarks(queryStr)
+			}
+			_, err = db.Exec(queryStr, guid, "fake-domain", encoded)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		insertActualLRP := func(processGuid string, index int, netInfo string) {
+			encoded, err := oldEncoder.Encode(format.BASE64_ENCRYPTED, []byte(netInfo))
+			Expect(err).NotTo(HaveOccurred())
+
+			queryStr := `
+				INSERT INTO actual_lrps
+					(process_guid, domain, net_info, instance_index, modification_tag_epoch, state)
+				VALUES (?, ?, ?, ?, ?, ?)`
+			if test_helpers.UsePostgres() {
+				queryStr = test_helpers.ReplaceQuestionMarks(queryStr)
+			}
+			_, err = db.Exec(queryStr, processGuid, "fake-domain", encoded, index, 10, "yo")
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		taskDefinition := func(guid string) string {
+			var result []byte
+			queryStr := "SELECT task_definition FROM tasks WHERE guid = ?"
+			if test_helpers.UsePostgres() {
+				queryStr = test_helpers.ReplaceQuestionMarks(queryStr)
+			}
+			Expect(db.QueryRow(queryStr, guid).Scan(&result)).To(Succeed())
+
+			label, err := format.EncryptionKeyLabel(result)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(label).To(Equal("new"))
+
+			decoded, err := format.NewEncoder(makeCryptor("new")).Decode(result)
+			Expect(err).NotTo(HaveOccurred())
+			return string(decoded)
+		}
+
+		BeforeEach(func() {
+			oldEncoder = format.NewEncoder(makeCryptor("old"))
+			insertTask("task-1")
+			insertTask("task-2")
+			insertTask("task-3")
+
+			encryptingDB = sqldb.NewSQLDB(db, 5, 5, format.ENCRYPTED_PROTO, makeCryptor("new", "old"), fakeGUIDProvider, fakeClock, dbFlavor)
+		})
+
+		Describe("ReEncryptBatch", func() {
+			It("re-encrypts the table a batch at a time", func() {
+				progress, err := encryptingDB.ReEncryptBatch(logger, "tasks", "new", 2)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(progress.TableName).To(Equal("tasks"))
+				Expect(progress.KeyLabel).To(Equal("new"))
+				Expect(progress.RowsDone).To(BeEquivalentTo(2))
+				Expect(progress.RowsRemaining).To(BeEquivalentTo(1))
+				Expect(progress.Completed).To(BeFalse())
+
+				Expect(taskDefinition("task-1")).To(Equal("task-definition-task-1"))
+				Expect(taskDefinition("task-2")).To(Equal("task-definition-task-2"))
+
+				progress, err = encryptingDB.ReEncryptBatch(logger, "tasks", "new", 2)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(progress.RowsDone).To(BeEquivalentTo(3))
+				Expect(progress.RowsRemaining).To(BeZero())
+				Expect(progress.Completed).To(BeTrue())
+
+				Expect(taskDefinition("task-3")).To(Equal("task-definition-task-3"))
+			})
+
+			It("resumes from the last checkpoint", func() {
+				_, err := encryptingDB.ReEncryptBatch(logger, "tasks", "new", 2)
+				Expect(err).NotTo(HaveOccurred())
+
+				restartedDB := sqldb.NewSQLDB(db, 5, 5, format.ENCRYPTED_PROTO, makeCryptor("new", "old"), fakeGUIDProvider, fakeClock, dbFlavor)
+				progress, err := restartedDB.ReEncryptBatch(logger, "tasks", "new", 1)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(progress.RowsDone).To(BeEquivalentTo(3))
+				Expect(progress.RowsRemaining).To(BeZero())
+
+				Expect(taskDefinition("task-3")).To(Equal("task-definition-task-3"))
+			})
+
+			It("does nothing more once the table is completed", func() {
+				progress, err := encryptingDB.ReEncryptBatch(logger, "tasks", "new", 10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(progress.Completed).To(BeTrue())
+
+				progress, err = encryptingDB.ReEncryptBatch(logger, "tasks", "new", 10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(progress.RowsDone).To(BeEquivalentTo(3))
+				Expect(progress.Completed).To(BeTrue())
+			})
+
+			It("starts over when the key changes", func() {
+				_, err := encryptingDB.ReEncryptBatch(logger, "tasks", "new", 10)
+				Expect(err).NotTo(HaveOccurred())
+
+				progress, err := encryptingDB.ReEncryptBatch(logger, "tasks", "newer", 2)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(progress.KeyLabel).To(Equal("newer"))
+				Expect(progress.RowsDone).To(BeEquivalentTo(2))
+				Expect(progress.Completed).To(BeFalse())
+			})
+
+			It("re-encrypts every instance of an actual LRP with its own net info", func() {
+				insertActualLRP("some-process-guid", 0, "net-info-0")
+				insertActualLRP("some-process-guid", 1, "net-info-1")
+
+				progress, err := encryptingDB.ReEncryptBatch(logger, "actual_lrps", "new", 1)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(progress.RowsRemaining).To(BeEquivalentTo(1))
+
+				progress, err = encryptingDB.ReEncryptBatch(logger, "actual_lrps", "new", 1)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(progress.RowsDone).To(BeEquivalentTo(2))
+				Expect(progress.RowsRemaining).To(BeZero())
+
+				encoder := format.NewEncoder(makeCryptor("new"))
+				for index, expected := range []string{"net-info-0", "net-info-1"} {
+					var netInfo []byte
+					queryStr := "SELECT net_info FROM actual_lrps WHERE process_guid = ? AND instance_index = ?"
+					if test_helpers.UsePostgres() {
+						queryStr = test_helpers.ReplaceQuestionMarks(queryStr)
+					}
+					Expect(db.QueryRow(queryStr, "some-process-guid", index).Scan(&netInfo)).To(Succeed())
+					decoded, err := encoder.Decode(netInfo)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(decoded)).To(Equal(expected))
+				}
+			})
+
+			It("rejects unknown tables", func() {
+				_, err := encryptingDB.ReEncryptBatch(logger, "domains", "new", 10)
+				Expect(models.ConvertError(err).Type).To(Equal(models.Error_InvalidRequest))
+			})
+
+			Context("when rows cannot be decoded", func() {
+				var missingKeyDB *sqldb.SQLDB
+
+				BeforeEach(func() {
+					missingKeyDB = sqldb.NewSQLDB(db, 5, 5, format.ENCRYPTED_PROTO, makeCryptor("new"), fakeGUIDProvider, fakeClock, dbFlavor)
+				})
+
+				It("counts them as left under another key, across batches", func() {
+					progress, err := missingKeyDB.ReEncryptBatch(logger, "tasks", "new", 2)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(progress.OldKeyRows).To(BeEquivalentTo(2))
+
+					progress, err = missingKeyDB.ReEncryptBatch(logger, "tasks", "new", 2)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(progress.Completed).To(BeTrue())
+					Expect(progress.OldKeyRows).To(BeEquivalentTo(3))
+
+					checkpoints, err := encryptingDB.EncryptionCheckpoints(logger)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(checkpoints[0].OldKeyRows).To(BeEquivalentTo(3))
+				})
+			})
+		})
+
+		Describe("EncryptionCheckpoints", func() {
+			It("returns the progress of every table without counting its rows by key", func() {
+				_, err := encryptingDB.ReEncryptBatch(logger, "tasks", "new", 2)
+				Expect(err).NotTo(HaveOccurred())
+
+				checkpoints, err := encryptingDB.EncryptionCheckpoints(logger)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(checkpoints).To(HaveLen(4))
+
+				tasks := checkpoints[0]
+				Expect(tasks.TableName).To(Equal("tasks"))
+				Expect(tasks.KeyLabel).To(Equal("new"))
+				Expect(tasks.RowsDone).To(BeEquivalentTo(2))
+				Expect(tasks.RowsRemaining).To(BeEquivalentTo(1))
+				Expect(tasks.OldKeyRows).To(BeZero())
+				Expect(tasks.KeyLabelRows).To(BeEmpty())
+			})
+		})
+
+		Describe("EncryptionProgress", func() {
+			It("returns every table with the rows left and the keys they are encrypted with", func() {
+				progress, err := encryptingDB.EncryptionProgress(logger)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(progress).To(HaveLen(4))
+
+				tableNames := []string{}
+				for _, table := range progress {
+					tableNames = append(tableNames, table.TableName)
+				}
+				Expect(tableNames).To(Equal([]string{"tasks", "desired_lrps", "actual_lrps", "desired_lrp_revisions"}))
+
+				tasks := progress[0]
+				Expect(tasks.Completed).To(BeFalse())
+				Expect(tasks.RowsDone).To(BeZero())
+				Expect(tasks.RowsRemaining).To(BeEquivalentTo(3))
+				Expect(tasks.KeyLabelRows).To(Equal([]*models.EncryptionKeyRows{{KeyLabel: "old", Rows: 3}}))
+			})
+
+			It("reflects the batches done so far", func() {
+				_, err := encryptingDB.ReEncryptBatch(logger, "tasks", "new", 2)
+				Expect(err).NotTo(HaveOccurred())
+
+				progress, err := encryptingDB.EncryptionProgress(logger)
+				Expect(err).NotTo(HaveOccurred())
+
+				tasks := progress[0]
+				Expect(tasks.KeyLabel).To(Equal("new"))
+				Expect(tasks.RowsDone).To(BeEquivalentTo(2))
+				Expect(tasks.RowsRemaining).To(BeEquivalentTo(1))
+				Expect(tasks.RowsUnderKeyLabel("old")).To(BeEquivalentTo(1))
+				Expect(tasks.RowsUnderKeyLabel("new")).To(BeEquivalentTo(2))
+			})
+		})
+
+		Describe("QuarantinedRowsByKeyLabel", func() {
+			It("returns no rows when nothing is quarantined", func() {
+				keyLabelRows, err := encryptingDB.QuarantinedRowsByKeyLabel(logger)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(keyLabelRows).To(BeEmpty())
+			})
+
+			Context("when rows encrypted with a missing key are quarantined", func() {
+				BeforeEach(func() {
+					missingKeyDB := sqldb.NewSQLDB(db, 5, 5, format.ENCRYPTED_PROTO, makeCryptor("new"), fakeGUIDProvider, fakeClock, dbFlavor)
+					_, err := missingKeyDB.TaskByGuid(logger, "task-1")
+					Expect(err).To(Equal(models.ErrDeserialize))
+					_, err = missingKeyDB.TaskByGuid(logger, "task-2")
+					Expect(err).To(Equal(models.ErrDeserialize))
+				})
+
+				It("counts them under the key they are encrypted with", func() {
+					keyLabelRows, err := encryptingDB.QuarantinedRowsByKeyLabel(logger)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(keyLabelRows).To(Equal([]*models.EncryptionKeyRows{{KeyLabel: "old", Rows: 2}}))
+				})
+
+				It("does not count them among the rows of their table", func() {
+					progress, err := encryptingDB.EncryptionProgress(logger)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(progress[0].RowsUnderKeyLabel("old")).To(BeEquivalentTo(1))
+				})
+			})
+		})
+	})
 })
@@ -24,7 +24,8 @@ func (h *sqlHelper) All(
 		query += "WHERE " + wheres
 	}
 
-	if lockRow {
+	// sqlite has no row locks, its write transactions are serialized instead
+	if lockRow && h.flavor != SQLite {
 		query += "\nFOR UPDATE"
 	}
 
@@ -0,0 +1,35 @@
+package helpers
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+)
+
+// SELECT <columns> FROM <table> WHERE ... ORDER BY <orderBy> [LIMIT <limit>]
+func (h *sqlHelper) AllOrdered(
+	logger lager.Logger,
+	q Queryable,
+	table string,
+	columns ColumnList,
+	orderBy string,
+	limit int,
+	wheres string,
+	whereBindings ...interface{},
+) (*sql.Rows, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s\n", strings.Join(columns, ", "), table)
+
+	if len(wheres) > 0 {
+		query += "WHERE " + wheres
+	}
+
+	query += "\nORDER BY " + orderBy
+
+	if limit > 0 {
+		query += fmt.Sprintf("\nLIMIT %d", limit)
+	}
+
+	return q.Query(h.Rebind(query), whereBindings...)
+}
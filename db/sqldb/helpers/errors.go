@@ -3,9 +3,11 @@ package helpers
 import (
 	"database/sql"
 	"errors"
+	"strings"
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/lib/pq"
+	"github.com/mattn/go-sqlite3"
 )
 
 var (
@@ -24,6 +26,8 @@ func (h *sqlHelper) ConvertSQLError(err error) error {
 			return h.convertMySQLError(err.(*mysql.MySQLError))
 		case *pq.Error:
 			return h.convertPostgresError(err.(*pq.Error))
+		case sqlite3.Error:
+			return h.convertSQLiteError(err.(sqlite3.Error))
 		}
 
 		if err == sql.ErrNoRows {
@@ -63,3 +67,25 @@ func (h *sqlHelper) convertPostgresError(err *pq.Error) error {
 		return ErrUnknownError
 	}
 }
+
+func (h *sqlHelper) convertSQLiteError(err sqlite3.Error) error {
+	switch err.ExtendedCode {
+	case sqlite3.ErrConstraintPrimaryKey, sqlite3.ErrConstraintUnique:
+		return ErrResourceExists
+	}
+
+	switch err.Code {
+	case sqlite3.ErrBusy, sqlite3.ErrLocked:
+		// another connection holds the write lock, retry like a deadlock
+		return ErrDeadlock
+	case sqlite3.ErrTooBig:
+		return ErrBadRequest
+	case sqlite3.ErrError:
+		if strings.HasPrefix(err.Error(), "no such table") {
+			return ErrUnrecoverableError
+		}
+		return ErrUnknownError
+	default:
+		return ErrUnknownError
+	}
+}
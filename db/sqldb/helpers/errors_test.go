@@ -0,0 +1,105 @@
+package helpers_test
+
+import (
+	"database/sql"
+	"errors"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+	"github.com/mattn/go-sqlite3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ConvertSQLError", func() {
+	DescribeTable("mysql errors",
+		func(number uint16, expected error) {
+			helper := helpers.NewSQLHelper(helpers.MySQL)
+			err := &mysql.MySQLError{Number: number, Message: "boom"}
+			Expect(helper.ConvertSQLError(err)).To(Equal(expected))
+		},
+		Entry("a duplicate entry", uint16(1062), helpers.ErrResourceExists),
+		Entry("a deadlock", uint16(1213), helpers.ErrDeadlock),
+		Entry("data too long", uint16(1406), helpers.ErrBadRequest),
+		Entry("a missing table", uint16(1146), helpers.ErrUnrecoverableError),
+		Entry("any other error", uint16(1045), helpers.ErrUnknownError),
+	)
+
+	DescribeTable("postgres errors",
+		func(code string, expected error) {
+			helper := helpers.NewSQLHelper(helpers.Postgres)
+			err := &pq.Error{Code: pq.ErrorCode(code), Message: "boom"}
+			Expect(helper.ConvertSQLError(err)).To(Equal(expected))
+		},
+		Entry("a value too long", "22001", helpers.ErrBadRequest),
+		Entry("a unique violation", "23505", helpers.ErrResourceExists),
+		Entry("a missing table", "42P01", helpers.ErrUnrecoverableError),
+		Entry("any other error", "28000", helpers.ErrUnknownError),
+	)
+
+	DescribeTable("sqlite errors",
+		func(err sqlite3.Error, expected error) {
+			helper := helpers.NewSQLHelper(helpers.SQLite)
+			Expect(helper.ConvertSQLError(err)).To(Equal(expected))
+		},
+		Entry("a primary key violation", sqlite3.Error{Code: sqlite3.ErrConstraint, ExtendedCode: sqlite3.ErrConstraintPrimaryKey}, helpers.ErrResourceExists),
+		Entry("a unique violation", sqlite3.Error{Code: sqlite3.ErrConstraint, ExtendedCode: sqlite3.ErrConstraintUnique}, helpers.ErrResourceExists),
+		Entry("a not null violation", sqlite3.Error{Code: sqlite3.ErrConstraint, ExtendedCode: sqlite3.ErrConstraintNotNull}, helpers.ErrUnknownError),
+		Entry("a busy database", sqlite3.Error{Code: sqlite3.ErrBusy}, helpers.ErrDeadlock),
+		Entry("a locked table", sqlite3.Error{Code: sqlite3.ErrLocked}, helpers.ErrDeadlock),
+		Entry("a value too big", sqlite3.Error{Code: sqlite3.ErrTooBig}, helpers.ErrBadRequest),
+		Entry("any other SQL error", sqlite3.Error{Code: sqlite3.ErrError}, helpers.ErrUnknownError),
+		Entry("any other error", sqlite3.Error{Code: sqlite3.ErrIoErr}, helpers.ErrUnknownError),
+	)
+
+	Context("with errors returned by sqlite", func() {
+		var (
+			db     *sql.DB
+			helper helpers.SQLHelper
+		)
+
+		BeforeEach(func() {
+			var err error
+			db, err = sql.Open("sqlite3", ":memory:")
+			Expect(err).NotTo(HaveOccurred())
+			// every connection to :memory: opens a database of its own
+			db.SetMaxOpenConns(1)
+
+			_, err = db.Exec(`CREATE TABLE domains(domain VARCHAR(255) PRIMARY KEY)`)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = db.Exec(`INSERT INTO domains(domain) VALUES ('the-domain')`)
+			Expect(err).NotTo(HaveOccurred())
+
+			helper = helpers.NewSQLHelper(helpers.SQLite)
+		})
+
+		AfterEach(func() {
+			Expect(db.Close()).To(Succeed())
+		})
+
+		It("converts inserting an existing row to ErrResourceExists", func() {
+			_, err := db.Exec(`INSERT INTO domains(domain) VALUES ('the-domain')`)
+			Expect(err).To(HaveOccurred())
+			Expect(helper.ConvertSQLError(err)).To(Equal(helpers.ErrResourceExists))
+		})
+
+		It("converts querying a missing table to ErrUnrecoverableError", func() {
+			_, err := db.Exec(`DELETE FROM tasks`)
+			Expect(err).To(HaveOccurred())
+			Expect(helper.ConvertSQLError(err)).To(Equal(helpers.ErrUnrecoverableError))
+		})
+	})
+
+	It("converts sql.ErrNoRows to ErrResourceNotFound", func() {
+		helper := helpers.NewSQLHelper(helpers.SQLite)
+		Expect(helper.ConvertSQLError(sql.ErrNoRows)).To(Equal(helpers.ErrResourceNotFound))
+	})
+
+	It("passes other errors through", func() {
+		helper := helpers.NewSQLHelper(helpers.SQLite)
+		err := errors.New("boom")
+		Expect(helper.ConvertSQLError(err)).To(Equal(err))
+	})
+})
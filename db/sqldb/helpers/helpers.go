@@ -11,6 +11,7 @@ import (
 const (
 	MySQL    = "mysql"
 	Postgres = "postgres"
+	SQLite   = "sqlite3"
 
 	LockRow   RowLock = true
 	NoLockRow RowLock = false
@@ -22,6 +23,7 @@ type SQLHelper interface {
 	Transact(logger lager.Logger, db *sql.DB, f func(logger lager.Logger, tx *sql.Tx) error) error
 	One(logger lager.Logger, q Queryable, table string, columns ColumnList, lockRow RowLock, wheres string, whereBindings ...interface{}) *sql.Row
 	All(logger lager.Logger, q Queryable, table string, columns ColumnList, lockRow RowLock, wheres string, whereBindings ...interface{}) (*sql.Rows, error)
+	AllOrdered(logger lager.Logger, q Queryable, table string, columns ColumnList, orderBy string, limit int, wheres string, whereBindings ...interface{}) (*sql.Rows, error)
 	Upsert(logger lager.Logger, q Queryable, table string, keyAttributes, updateAttributes SQLAttributes) (sql.Result, error)
 	Insert(logger lager.Logger, q Queryable, table string, attributes SQLAttributes) (sql.Result, error)
 	Update(logger lager.Logger, q Queryable, table string, updates SQLAttributes, wheres string, whereBindings ...interface{}) (sql.Result, error)
@@ -55,7 +57,7 @@ func (h *sqlHelper) Rebind(query string) string {
 }
 
 func RebindForFlavor(query, flavor string) string {
-	if flavor == MySQL {
+	if flavor == MySQL || flavor == SQLite {
 		return query
 	}
 	if flavor != Postgres {
@@ -16,6 +16,8 @@ const (
 
 // mysql: SET SESSION TRANSACTION ISOLATION LEVEL level;
 // postgres: SET SESSION CHARACTERISTICS AS TRANSACTION ISOLATION LEVEL level;
+// sqlite: PRAGMA read_uncommitted = true|false; as its transactions are
+// otherwise always serializable.
 func (h *sqlHelper) SetIsolationLevel(logger lager.Logger, db *sql.DB, level string) error {
 	logger = logger.Session("set-isolation-level", lager.Data{"level": level})
 	logger.Info("starting")
@@ -26,6 +28,15 @@ func (h *sqlHelper) SetIsolationLevel(logger lager.Logger, db *sql.DB, level str
 		query = fmt.Sprintf("SET SESSION TRANSACTION ISOLATION LEVEL %s", level)
 	} else if h.flavor == Postgres {
 		query = fmt.Sprintf("SET SESSION CHARACTERISTICS AS TRANSACTION ISOLATION LEVEL %s", level)
+	} else if h.flavor == SQLite {
+		switch level {
+		case IsolationLevelReadUncommitted:
+			query = "PRAGMA read_uncommitted = true"
+		case IsolationLevelReadCommitted, IsolationLevelRepeatableRead, IsolationLevelSerializable:
+			query = "PRAGMA read_uncommitted = false"
+		default:
+			return fmt.Errorf("invalid isolation level %q", level)
+		}
 	}
 
 	_, err := db.Exec(query)
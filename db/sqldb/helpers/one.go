@@ -26,7 +26,8 @@ func (h *sqlHelper) One(
 
 	query += "\nLIMIT 1"
 
-	if lockRow {
+	// sqlite has no row locks, its write transactions are serialized instead
+	if lockRow && h.flavor != SQLite {
 		query += "\nFOR UPDATE"
 	}
 
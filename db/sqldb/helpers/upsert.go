@@ -100,6 +100,24 @@ func (h *sqlHelper) Upsert(
 			insertBindings,
 			strings.Join(updateBindings, ", "),
 		)
+	case SQLite:
+		bindingValues = append(bindingValues, keyBindingValues...)
+		bindingValues = append(bindingValues, nonKeyBindingValues...)
+		bindingValues = append(bindingValues, nonKeyBindingValues...)
+
+		query = fmt.Sprintf(`
+				INSERT INTO %s
+					(%s)
+				VALUES (%s)
+				ON CONFLICT (%s) DO UPDATE SET
+					%s
+			`,
+			table,
+			strings.Join(columns, ", "),
+			insertBindings,
+			strings.Join(keyNames, ", "),
+			strings.Join(updateBindings, ", "),
+		)
 	default:
 		// totally shouldn't happen
 		panic("database flavor not implemented: " + h.flavor)
@@ -2,6 +2,7 @@ package sqldb_test
 
 import (
 	"database/sql"
+	"fmt"
 	"strings"
 
 	"code.cloudfoundry.org/bbs/db/sqldb"
@@ -21,7 +22,12 @@ var _ = Describe("Isolation Level", func() {
 	BeforeEach(func() {
 		var err error
 		// We need a different db session to prevent test pollution
-		dbSession, err = sql.Open(dbDriverName, dbBaseConnectionString)
+		connectionString := dbBaseConnectionString
+		if test_helpers.UseSQLite() {
+			// sqlite has no server to connect to, only the database file
+			connectionString = fmt.Sprintf("%sdiego_%d", dbBaseConnectionString, GinkgoParallelNode())
+		}
+		dbSession, err = sql.Open(dbDriverName, connectionString)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(dbSession.Ping()).NotTo(HaveOccurred())
 
@@ -41,7 +47,15 @@ var _ = Describe("Isolation Level", func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			var isolationLevel, isolationVariable string
-			if test_helpers.UsePostgres() {
+			if test_helpers.UseSQLite() {
+				// sqlite only tells apart reading uncommitted data, the other
+				// levels are all serializable
+				var readUncommitted bool
+				row := dbSession.QueryRow("PRAGMA read_uncommitted")
+				err := row.Scan(&readUncommitted)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(readUncommitted).To(Equal(level == helpers.IsolationLevelReadUncommitted))
+			} else if test_helpers.UsePostgres() {
 				expectedLevel := strings.ToLower(level)
 				row := dbSession.QueryRow("SHOW TRANSACTION ISOLATION LEVEL")
 				err := row.Scan(&isolationLevel)
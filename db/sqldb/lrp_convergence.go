@@ -57,6 +57,7 @@ func (db *SQLDB) ConvergeLRPs(logger lager.Logger, cellSet models.CellSet) ([]*a
 	}
 
 	db.emitDomainMetrics(logger, domainSet)
+	db.emitQuarantinedRecordsMetric(logger)
 
 	converge := newConvergence(db)
 	converge.staleUnclaimedActualLRPs(logger, now)
@@ -126,7 +127,6 @@ func (c *convergence) staleUnclaimedActualLRPs(logger lager.Logger, now time.Tim
 // and transitions them to UNCLAIMED.
 func (c *convergence) crashedActualLRPs(logger lager.Logger, now time.Time) {
 	logger = logger.Session("crashed-actual-lrps")
-	restartCalculator := models.NewDefaultRestartCalculator()
 
 	rows, err := c.selectCrashedLRPs(logger, c.db)
 	if err != nil {
@@ -153,7 +153,7 @@ func (c *convergence) crashedActualLRPs(logger lager.Logger, now time.Time) {
 		actual.ActualLRPKey = models.NewActualLRPKey(schedulingInfo.ProcessGuid, int32(index), schedulingInfo.Domain)
 		actual.State = models.ActualLRPStateCrashed
 
-		if actual.ShouldRestartCrash(now, restartCalculator) {
+		if actual.ShouldRestartCrash(now, schedulingInfo.RestartPolicy.RestartCalculator()) {
 			lrps = append(lrps, crashedActualLRP{
 				lrpKey:         actual.ActualLRPKey,
 				schedulingInfo: schedulingInfo,
@@ -309,6 +309,21 @@ var _ = Describe("LRPConvergence", func() {
 			Consistently(convergenceLogger).ShouldNot(gbytes.Say("failed-.*"))
 		})
 
+		It("emits the number of quarantined records", func() {
+			queryStr := `UPDATE desired_lrps SET run_info = 'garbage' WHERE process_guid = ?`
+			if test_helpers.UsePostgres() {
+				queryStr = test_helpers.ReplaceQuestionMarks(queryStr)
+			}
+			_, err := db.Exec(queryStr, "desired-with-missing-all-actuals-"+freshDomain)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = sqlDB.DesiredLRPByProcessGuid(logger, "desired-with-missing-all-actuals-"+freshDomain)
+			Expect(err).To(Equal(models.ErrDeserialize))
+
+			sqlDB.ConvergeLRPs(logger, cellSet)
+			Expect(sender.GetValue("QuarantinedRecords").Value).To(Equal(float64(1)))
+			Expect(sender.GetCounter("RecordsQuarantined")).To(BeEquivalentTo(1))
+		})
+
 		It("emits missing LRP metrics", func() {
 			sqlDB.ConvergeLRPs(logger, cellSet)
 			Expect(sender.GetValue("LRPsMissing").Value).To(Equal(float64(17)))
@@ -661,6 +676,45 @@ var _ = Describe("LRPConvergence", func() {
 		Expect(beforeActuals).To(Equal(afterActuals))
 	})
 
+	Context("when a crashed actual LRP's desired LRP has a restart policy", func() {
+		var processGuid string
+
+		BeforeEach(func() {
+			processGuid = "desired-with-restart-policy" + "-" + freshDomain
+			desiredLRP := model_helpers.NewValidDesiredLRP(processGuid)
+			desiredLRP.Domain = freshDomain
+			desiredLRP.Instances = 1
+			desiredLRP.RestartPolicy = &models.RestartPolicy{NeverRestart: true}
+			Expect(sqlDB.DesireLRP(logger, desiredLRP)).To(Succeed())
+
+			key := models.NewActualLRPKey(processGuid, 0, freshDomain)
+			instanceKey := models.NewActualLRPInstanceKey("crashed-with-restart-policy", "existing-cell")
+			_, err := sqlDB.CreateUnclaimedActualLRP(logger, &key)
+			Expect(err).NotTo(HaveOccurred())
+			_, _, err = sqlDB.ClaimActualLRP(logger, processGuid, 0, &instanceKey)
+			Expect(err).NotTo(HaveOccurred())
+			netInfo := models.NewActualLRPNetInfo("some-address", models.NewPortMapping(2222, 4444))
+			_, _, err = sqlDB.StartActualLRP(logger, &key, &instanceKey, &netInfo)
+			Expect(err).NotTo(HaveOccurred())
+			_, _, _, err = sqlDB.CrashActualLRP(logger, &key, &instanceKey, "because it failed")
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeClock.Increment(time.Hour)
+		})
+
+		It("honors the restart policy instead of the default", func() {
+			startRequests, _, _ := sqlDB.ConvergeLRPs(logger, cellSet)
+
+			for _, startRequest := range startRequests {
+				Expect(startRequest.ProcessGuid).NotTo(Equal(processGuid))
+			}
+
+			actualLRPGroup, err := sqlDB.ActualLRPGroupByProcessGuidAndIndex(logger, processGuid, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actualLRPGroup.Instance.State).To(Equal(models.ActualLRPStateCrashed))
+		})
+	})
+
 	Context("when the cell set is empty", func() {
 		BeforeEach(func() {
 			cellSet = models.NewCellSetFromList([]*models.CellPresence{})
@@ -0,0 +1,55 @@
+package sqldb
+
+// pageCursor records the rows scanned for a page, including rows that are
+// skipped because they fail to deserialize and are quarantined, so that the
+// next page resumes after the last row scanned rather than pagination ending
+// as soon as a page comes back short.
+type pageCursor struct {
+	scanned int
+	keys    []pageKey
+}
+
+type pageKey struct {
+	guid  string
+	index int32
+}
+
+// record counts a scanned row and remembers its sort key. Rows sharing the
+// key of the previous row, such as an evacuating actual LRP following its
+// instance, add no new key. A nil cursor records nothing.
+func (c *pageCursor) record(guid string, index int32) {
+	if c == nil {
+		return
+	}
+
+	c.scanned++
+	if guid == "" {
+		return
+	}
+
+	key := pageKey{guid: guid, index: index}
+	if len(c.keys) > 0 && c.keys[len(c.keys)-1] == key {
+		return
+	}
+	c.keys = append(c.keys, key)
+}
+
+// pageEnd returns the key of the last record on a page of pageSize records
+// read by a query with the given row limit, and false when the query ran out
+// of rows before filling the page.
+func (c *pageCursor) pageEnd(pageSize, limit int) (pageKey, bool) {
+	if pageSize <= 0 {
+		return pageKey{}, false
+	}
+
+	// A later key proves that every row of the key ending the page was read.
+	if len(c.keys) > pageSize {
+		return c.keys[pageSize-1], true
+	}
+
+	if c.scanned >= limit && len(c.keys) > 0 {
+		return c.keys[len(c.keys)-1], true
+	}
+
+	return pageKey{}, false
+}
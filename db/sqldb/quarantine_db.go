@@ -0,0 +1,433 @@
+package sqldb
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/runtimeschema/metric"
+)
+
+const (
+	recordsQuarantinedCounter = metric.Counter("RecordsQuarantined")
+	quarantinedRecordsMetric  = metric.Metric("QuarantinedRecords")
+)
+
+// invalidRecordError is returned in place of models.ErrDeserialize when a
+// column of an LRP or task row cannot be decoded, so that the row can be
+// quarantined along with the reason.
+type invalidRecordError struct {
+	guid   string
+	column string
+	err    error
+}
+
+func (e *invalidRecordError) Error() string {
+	return fmt.Sprintf("failed to deserialize %s: %s", e.column, e.err.Error())
+}
+
+// QuarantinedRecords returns the quarantined rows of tableName, or of every
+// table when it is empty, oldest first.
+func (db *SQLDB) QuarantinedRecords(logger lager.Logger, tableName string) ([]*models.QuarantinedRecord, error) {
+	logger = logger.Session("quarantined-records", lager.Data{"table_name": tableName})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	wheres := ""
+	values := []interface{}{}
+	if tableName != "" {
+		wheres = "table_name = ?"
+		values = append(values, tableName)
+	}
+
+	results := []*models.QuarantinedRecord{}
+
+	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+		rows, err := db.allOrdered(logger, tx, quarantinedRecordsTable,
+			quarantinedRecordColumns, "id", 0,
+			wheres, values...,
+		)
+		if err != nil {
+			logger.Error("failed-query", err)
+			return err
+		}
+		defer rows.Close()
+
+		results = []*models.QuarantinedRecord{}
+		for rows.Next() {
+			record, err := db.fetchQuarantinedRecord(logger, rows)
+			if err != nil {
+				logger.Error("failed-scan-row", err)
+				return err
+			}
+			results = append(results, record)
+		}
+
+		if rows.Err() != nil {
+			logger.Error("failed-fetching-row", rows.Err())
+			return rows.Err()
+		}
+
+		return nil
+	})
+
+	return results, err
+}
+
+func (db *SQLDB) QuarantinedRecord(logger lager.Logger, id int64) (*models.QuarantinedRecord, error) {
+	logger = logger.Session("quarantined-record", lager.Data{"id": id})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	var result *models.QuarantinedRecord
+
+	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+		var err error
+		row := db.one(logger, tx, quarantinedRecordsTable,
+			quarantinedRecordColumns, helpers.NoLockRow,
+			"id = ?", id,
+		)
+
+		result, err = db.fetchQuarantinedRecord(logger, row)
+		return err
+	})
+
+	return result, err
+}
+
+// RestoreQuarantinedRecord inserts the quarantined row back into its table, as
+// it was when it was quarantined, and drops it from quarantine. A row that
+// still cannot be decoded is quarantined again the next time it is read.
+func (db *SQLDB) RestoreQuarantinedRecord(logger lager.Logger, id int64) error {
+	logger = logger.Session("restore-quarantined-record", lager.Data{"id": id})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	return db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+		return db.restoreQuarantinedRecord(logger, tx, id)
+	})
+}
+
+// RestoreQuarantinedRecords restores the quarantined rows of tableName, or of
+// every table when it is empty, oldest first. Each row is restored in its own
+// transaction, so that a row which cannot be restored, for instance because
+// an LRP or task with the same key has been created since, stays in
+// quarantine without holding back the others.
+func (db *SQLDB) RestoreQuarantinedRecords(logger lager.Logger, tableName string) ([]int64, []int64, error) {
+	logger = logger.Session("restore-quarantined-records", lager.Data{"table_name": tableName})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	records, err := db.QuarantinedRecords(logger, tableName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	restored := []int64{}
+	failed := []int64{}
+	for _, record := range records {
+		id := record.Id
+		err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+			return db.restoreQuarantinedRecord(logger, tx, id)
+		})
+		if err == models.ErrResourceNotFound {
+			// restored or dropped concurrently
+			continue
+		}
+		if err != nil {
+			logger.Error("failed-restoring-quarantined-record", err, lager.Data{"id": id})
+			failed = append(failed, id)
+			continue
+		}
+		restored = append(restored, id)
+	}
+
+	logger.Info("restored", lager.Data{"restored": len(restored), "failed": len(failed)})
+	return restored, failed, nil
+}
+
+func (db *SQLDB) restoreQuarantinedRecord(logger lager.Logger, tx *sql.Tx, id int64) error {
+	row := db.one(logger, tx, quarantinedRecordsTable,
+		quarantinedRecordColumns, helpers.LockRow,
+		"id = ?", id,
+	)
+
+	record, err := db.fetchQuarantinedRecord(logger, row)
+	if err != nil {
+		return err
+	}
+
+	switch record.TableName {
+	case desiredLRPsTable, actualLRPsTable, tasksTable:
+	default:
+		err = models.NewError(models.Error_InvalidRecord, "unknown table "+record.TableName)
+		logger.Error("unknown-table", err)
+		return err
+	}
+
+	columns := map[string]*string{}
+	err = json.Unmarshal([]byte(record.Record), &columns)
+	if err != nil {
+		logger.Error("failed-parsing-record", err)
+		return models.NewError(models.Error_InvalidRecord, err.Error())
+	}
+
+	attributes := helpers.SQLAttributes{}
+	for column, value := range columns {
+		if value == nil {
+			attributes[column] = nil
+		} else {
+			attributes[column] = *value
+		}
+	}
+
+	_, err = db.insert(logger, tx, record.TableName, attributes)
+	if err != nil {
+		logger.Error("failed-inserting-record", err)
+		return err
+	}
+
+	_, err = db.delete(logger, tx, quarantinedRecordsTable, "id = ?", id)
+	if err != nil {
+		logger.Error("failed-deleting-quarantined-record", err)
+		return err
+	}
+
+	logger.Info("restored", lager.Data{"id": id, "table_name": record.TableName, "guid": record.Guid})
+	return nil
+}
+
+// quarantinedRows are the rows of table matching wheres, moved aside because
+// the row of guid could not be decoded.
+type quarantinedRows struct {
+	table         string
+	invalid       *invalidRecordError
+	wheres        string
+	whereBindings []interface{}
+	count         int
+}
+
+// quarantineTracker holds the rows moved inside a transaction until the
+// transaction ends, so that they are only reported once the move commits.
+type quarantineTracker struct {
+	lock    sync.Mutex
+	pending map[*sql.Tx][]*quarantinedRows
+}
+
+func newQuarantineTracker() *quarantineTracker {
+	return &quarantineTracker{pending: map[*sql.Tx][]*quarantinedRows{}}
+}
+
+func (t *quarantineTracker) add(tx *sql.Tx, rows *quarantinedRows) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.pending[tx] = append(t.pending[tx], rows)
+}
+
+func (t *quarantineTracker) take(tx *sql.Tx) []*quarantinedRows {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	rows := t.pending[tx]
+	delete(t.pending, tx)
+	return rows
+}
+
+// quarantine moves the rows of table matching wheres to the quarantined
+// records table, along with the reason they could not be decoded. Every
+// column is kept as text so that the rows can be restored as they were. The
+// rows are copied and deleted in a single transaction, so that they are
+// neither lost nor duplicated if the BBS stops halfway.
+//
+// Within a caller's transaction the rows are moved as part of it, since
+// starting another one would wait on the rows it has locked. transact reports
+// them once that transaction commits, or moves them again in a transaction of
+// their own once it has rolled back, as it does when the caller fails on the
+// row it could not decode.
+func (db *SQLDB) quarantine(logger lager.Logger, q Queryable, table string, invalid *invalidRecordError, wheres string, whereBindings ...interface{}) error {
+	rows := &quarantinedRows{
+		table:         table,
+		invalid:       invalid,
+		wheres:        wheres,
+		whereBindings: whereBindings,
+	}
+
+	if tx, ok := q.(*sql.Tx); ok {
+		err := db.moveToQuarantine(logger, tx, rows)
+		if err != nil {
+			return err
+		}
+		db.quarantines.add(tx, rows)
+		return nil
+	}
+
+	return db.quarantineAlone(logger, rows)
+}
+
+// quarantineAlone moves the rows in a transaction of their own and reports
+// them once it commits.
+func (db *SQLDB) quarantineAlone(logger lager.Logger, rows *quarantinedRows) error {
+	err := db.helper.Transact(logger, db.db, func(logger lager.Logger, tx *sql.Tx) error {
+		return db.moveToQuarantine(logger, tx, rows)
+	})
+	if err != nil {
+		return db.convertSQLError(err)
+	}
+
+	db.reportQuarantined(logger, rows)
+	return nil
+}
+
+// settleQuarantines handles the rows moved inside a transaction once it has
+// ended with err.
+func (db *SQLDB) settleQuarantines(logger lager.Logger, pending []*quarantinedRows, err error) {
+	for _, rows := range pending {
+		if err == nil {
+			db.reportQuarantined(logger, rows)
+			continue
+		}
+
+		// the move was rolled back along with the transaction
+		quarantineErr := db.quarantineAlone(logger, rows)
+		if quarantineErr != nil {
+			logger.Error("failed-quarantining-invalid-row", quarantineErr, lager.Data{"table_name": rows.table, "guid": rows.invalid.guid})
+		}
+	}
+}
+
+func (db *SQLDB) moveToQuarantine(logger lager.Logger, tx *sql.Tx, quarantined *quarantinedRows) error {
+	logger = logger.Session("quarantine", lager.Data{"table_name": quarantined.table, "guid": quarantined.invalid.guid})
+
+	rows, err := db.all(logger, tx, quarantined.table, helpers.ColumnList{"*"}, helpers.LockRow, quarantined.wheres, quarantined.whereBindings...)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return err
+	}
+
+	records, err := scanRawRecords(rows)
+	if err != nil {
+		logger.Error("failed-reading-rows", err)
+		return err
+	}
+
+	now := db.clock.Now().UnixNano()
+	for _, record := range records {
+		_, err = db.insert(logger, tx, quarantinedRecordsTable,
+			helpers.SQLAttributes{
+				"table_name":     quarantined.table,
+				"guid":           quarantined.invalid.guid,
+				"reason":         quarantined.invalid.Error(),
+				"quarantined_at": now,
+				"record":         record,
+			},
+		)
+		if err != nil {
+			logger.Error("failed-inserting-quarantined-record", err)
+			return err
+		}
+	}
+
+	_, err = db.delete(logger, tx, quarantined.table, quarantined.wheres, quarantined.whereBindings...)
+	if err != nil {
+		logger.Error("failed-deleting-invalid-row", err)
+		return err
+	}
+
+	quarantined.count = len(records)
+	return nil
+}
+
+func (db *SQLDB) reportQuarantined(logger lager.Logger, rows *quarantinedRows) {
+	if rows.count == 0 {
+		return
+	}
+
+	logger.Session("quarantine", lager.Data{"table_name": rows.table, "guid": rows.invalid.guid}).
+		Info("quarantined-invalid-row", lager.Data{"reason": rows.invalid.Error(), "count": rows.count})
+	recordsQuarantinedCounter.Add(uint64(rows.count))
+}
+
+// scanRawRecords reads and closes rows, returning every row as a JSON object
+// of its columns, with NULL columns as null.
+func scanRawRecords(rows *sql.Rows) ([]string, error) {
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	records := []string{}
+	for rows.Next() {
+		values := make([]sql.NullString, len(columns))
+		pointers := make([]interface{}, len(columns))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+
+		err = rows.Scan(pointers...)
+		if err != nil {
+			return nil, err
+		}
+
+		record := map[string]*string{}
+		for i, column := range columns {
+			if values[i].Valid {
+				value := values[i].String
+				record[column] = &value
+			} else {
+				record[column] = nil
+			}
+		}
+
+		data, err := json.Marshal(record)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, string(data))
+	}
+
+	return records, rows.Err()
+}
+
+func (db *SQLDB) emitQuarantinedRecordsMetric(logger lager.Logger) {
+	var count int
+	query := fmt.Sprintf("SELECT COUNT(*) FROM %s", quarantinedRecordsTable)
+	err := db.db.QueryRow(query).Scan(&count)
+	if err != nil {
+		logger.Error("failed-counting-quarantined-records", err)
+		return
+	}
+
+	err = quarantinedRecordsMetric.Send(count)
+	if err != nil {
+		logger.Error("failed-sending-quarantined-records-metric", err)
+	}
+}
+
+func (db *SQLDB) fetchQuarantinedRecord(logger lager.Logger, scanner RowScanner) (*models.QuarantinedRecord, error) {
+	var reason sql.NullString
+	record := &models.QuarantinedRecord{}
+
+	err := scanner.Scan(
+		&record.Id,
+		&record.TableName,
+		&record.Guid,
+		&reason,
+		&record.QuarantinedAt,
+		&record.Record,
+	)
+	if err == sql.ErrNoRows {
+		return nil, models.ErrResourceNotFound
+	}
+	if err != nil {
+		logger.Error("failed-scanning-row", err)
+		return nil, err
+	}
+
+	record.Reason = reason.String
+	return record, nil
+}
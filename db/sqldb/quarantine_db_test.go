@@ -0,0 +1,178 @@
+package sqldb_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"code.cloudfoundry.org/bbs/test_helpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("QuarantineDB", func() {
+	var desiredLRP *models.DesiredLRP
+
+	BeforeEach(func() {
+		desiredLRP = model_helpers.NewValidDesiredLRP("the-guid")
+		Expect(sqlDB.DesireLRP(logger, desiredLRP)).To(Succeed())
+
+		queryStr := `UPDATE desired_lrps SET run_info = 'garbage' WHERE process_guid = ?`
+		if test_helpers.UsePostgres() {
+			queryStr = test_helpers.ReplaceQuestionMarks(queryStr)
+		}
+		_, err := db.Exec(queryStr, "the-guid")
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = sqlDB.DesiredLRPByProcessGuid(logger, "the-guid")
+		Expect(err).To(Equal(models.ErrDeserialize))
+	})
+
+	Describe("QuarantinedRecords", func() {
+		It("returns the rows moved aside and why", func() {
+			records, err := sqlDB.QuarantinedRecords(logger, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(records).To(HaveLen(1))
+
+			record := records[0]
+			Expect(record.Id).To(BeNumerically(">", 0))
+			Expect(record.TableName).To(Equal(models.QuarantinedDesiredLRPsTable))
+			Expect(record.Guid).To(Equal("the-guid"))
+			Expect(record.Reason).To(ContainSubstring("failed to deserialize run_info"))
+			Expect(record.QuarantinedAt).To(Equal(fakeClock.Now().UnixNano()))
+			Expect(record.Record).To(ContainSubstring(`"process_guid":"the-guid"`))
+			Expect(record.Record).To(ContainSubstring(`"run_info":"garbage"`))
+		})
+
+		It("no longer serves the row", func() {
+			_, err := sqlDB.DesiredLRPByProcessGuid(logger, "the-guid")
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+		})
+
+		Context("when filtering by table", func() {
+			BeforeEach(func() {
+				insertTask(db, serializer, model_helpers.NewValidTask("the-task-guid"), true)
+				_, err := sqlDB.TaskByGuid(logger, "the-task-guid")
+				Expect(err).To(Equal(models.ErrDeserialize))
+			})
+
+			It("only returns the rows of that table", func() {
+				records, err := sqlDB.QuarantinedRecords(logger, models.QuarantinedTasksTable)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(records).To(HaveLen(1))
+				Expect(records[0].Guid).To(Equal("the-task-guid"))
+				Expect(records[0].Reason).To(ContainSubstring("failed to deserialize task_definition"))
+
+				records, err = sqlDB.QuarantinedRecords(logger, "")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(records).To(HaveLen(2))
+			})
+		})
+	})
+
+	Describe("QuarantinedRecord", func() {
+		It("returns the record with the id", func() {
+			records, err := sqlDB.QuarantinedRecords(logger, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			record, err := sqlDB.QuarantinedRecord(logger, records[0].Id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(record).To(Equal(records[0]))
+		})
+
+		It("returns a ResourceNotFound when there is no such record", func() {
+			_, err := sqlDB.QuarantinedRecord(logger, 9999)
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+		})
+	})
+
+	Describe("RestoreQuarantinedRecord", func() {
+		var id int64
+
+		BeforeEach(func() {
+			records, err := sqlDB.QuarantinedRecords(logger, "")
+			Expect(err).NotTo(HaveOccurred())
+			id = records[0].Id
+		})
+
+		It("puts the row back as it was and drops it from quarantine", func() {
+			Expect(sqlDB.RestoreQuarantinedRecord(logger, id)).To(Succeed())
+
+			schedulingInfos, err := sqlDB.DesiredLRPSchedulingInfos(logger, models.DesiredLRPFilter{ProcessGuids: []string{"the-guid"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(schedulingInfos).To(HaveLen(1))
+			expectedSchedulingInfo := desiredLRP.DesiredLRPSchedulingInfo()
+			Expect(schedulingInfos[0]).To(Equal(&expectedSchedulingInfo))
+
+			var runInfo string
+			queryStr := `SELECT run_info FROM desired_lrps WHERE process_guid = ?`
+			if test_helpers.UsePostgres() {
+				queryStr = test_helpers.ReplaceQuestionMarks(queryStr)
+			}
+			Expect(db.QueryRow(queryStr, "the-guid").Scan(&runInfo)).To(Succeed())
+			Expect(runInfo).To(Equal("garbage"))
+
+			_, err = sqlDB.QuarantinedRecord(logger, id)
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+		})
+
+		Context("when the row has been replaced", func() {
+			BeforeEach(func() {
+				Expect(sqlDB.DesireLRP(logger, model_helpers.NewValidDesiredLRP("the-guid"))).To(Succeed())
+			})
+
+			It("returns a ResourceExists and keeps the record", func() {
+				Expect(sqlDB.RestoreQuarantinedRecord(logger, id)).To(Equal(models.ErrResourceExists))
+
+				_, err := sqlDB.QuarantinedRecord(logger, id)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		It("returns a ResourceNotFound when there is no such record", func() {
+			Expect(sqlDB.RestoreQuarantinedRecord(logger, 9999)).To(Equal(models.ErrResourceNotFound))
+		})
+	})
+
+	Describe("RestoreQuarantinedRecords", func() {
+		BeforeEach(func() {
+			insertTask(db, serializer, model_helpers.NewValidTask("the-task-guid"), true)
+			_, err := sqlDB.TaskByGuid(logger, "the-task-guid")
+			Expect(err).To(Equal(models.ErrDeserialize))
+		})
+
+		It("restores the records of the table only", func() {
+			records, err := sqlDB.QuarantinedRecords(logger, models.QuarantinedTasksTable)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(records).To(HaveLen(1))
+
+			restored, failed, err := sqlDB.RestoreQuarantinedRecords(logger, models.QuarantinedTasksTable)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(restored).To(Equal([]int64{records[0].Id}))
+			Expect(failed).To(BeEmpty())
+
+			records, err = sqlDB.QuarantinedRecords(logger, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(records).To(HaveLen(1))
+			Expect(records[0].Guid).To(Equal("the-guid"))
+		})
+
+		Context("when a row has been replaced", func() {
+			BeforeEach(func() {
+				Expect(sqlDB.DesireLRP(logger, model_helpers.NewValidDesiredLRP("the-guid"))).To(Succeed())
+			})
+
+			It("restores the other records and keeps that one", func() {
+				records, err := sqlDB.QuarantinedRecords(logger, "")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(records).To(HaveLen(2))
+
+				restored, failed, err := sqlDB.RestoreQuarantinedRecords(logger, "")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(failed).To(Equal([]int64{records[0].Id}))
+				Expect(restored).To(Equal([]int64{records[1].Id}))
+
+				_, err = sqlDB.QuarantinedRecord(logger, records[0].Id)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+})
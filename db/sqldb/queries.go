@@ -12,10 +12,15 @@ import (
 )
 
 const (
-	tasksTable       = "tasks"
-	desiredLRPsTable = "desired_lrps"
-	actualLRPsTable  = "actual_lrps"
-	domainsTable     = "domains"
+	tasksTable               = "tasks"
+	desiredLRPsTable         = "desired_lrps"
+	actualLRPsTable          = "actual_lrps"
+	domainsTable             = "domains"
+	auditEventsTable         = "audit_events"
+	desiredLRPRevisionsTable = "desired_lrp_revisions"
+	quarantinedRecordsTable  = "quarantined_records"
+	encryptionProgressTable  = "encryption_progress"
+	desiredLRPRolloutsTable  = "desired_lrp_rollouts"
 )
 
 var (
@@ -34,6 +39,7 @@ var (
 		desiredLRPsTable + ".modification_tag_epoch",
 		desiredLRPsTable + ".modification_tag_index",
 		desiredLRPsTable + ".placement_tags",
+		desiredLRPsTable + ".restart_policy",
 	}
 
 	desiredLRPColumns = append(schedulingInfoColumns,
@@ -52,6 +58,8 @@ var (
 		tasksTable + ".failed",
 		tasksTable + ".failure_reason",
 		tasksTable + ".task_definition",
+		tasksTable + ".retry_count",
+		tasksTable + ".failure_history",
 	}
 
 	actualLRPColumns = helpers.ColumnList{
@@ -74,6 +82,50 @@ var (
 	domainColumns = helpers.ColumnList{
 		domainsTable + ".domain",
 	}
+
+	auditEventColumns = helpers.ColumnList{
+		auditEventsTable + ".id",
+		auditEventsTable + ".created_at",
+		auditEventsTable + ".route",
+		auditEventsTable + ".actor",
+		auditEventsTable + ".remote_address",
+		auditEventsTable + ".request_id",
+		auditEventsTable + ".domain",
+		auditEventsTable + ".guid",
+		auditEventsTable + ".summary",
+		auditEventsTable + ".error_type",
+		auditEventsTable + ".error_message",
+	}
+
+	desiredLRPRevisionColumns = helpers.ColumnList{
+		desiredLRPRevisionsTable + ".process_guid",
+		desiredLRPRevisionsTable + ".revision",
+		desiredLRPRevisionsTable + ".modification_tag_epoch",
+		desiredLRPRevisionsTable + ".modification_tag_index",
+		desiredLRPRevisionsTable + ".created_at",
+		desiredLRPRevisionsTable + ".desired_lrp",
+	}
+
+	quarantinedRecordColumns = helpers.ColumnList{
+		quarantinedRecordsTable + ".id",
+		quarantinedRecordsTable + ".table_name",
+		quarantinedRecordsTable + ".guid",
+		quarantinedRecordsTable + ".reason",
+		quarantinedRecordsTable + ".quarantined_at",
+		quarantinedRecordsTable + ".record",
+	}
+
+	desiredLRPRolloutColumns = helpers.ColumnList{
+		desiredLRPRolloutsTable + ".process_guid",
+		desiredLRPRolloutsTable + ".modification_tag_epoch",
+		desiredLRPRolloutsTable + ".modification_tag_index",
+		desiredLRPRolloutsTable + ".max_in_flight",
+		desiredLRPRolloutsTable + ".started_at",
+		desiredLRPRolloutsTable + ".deadline",
+		desiredLRPRolloutsTable + ".replaced_indices",
+		desiredLRPRolloutsTable + ".state",
+		desiredLRPRolloutsTable + ".failure_reason",
+	}
 )
 
 func (db *SQLDB) CreateConfigurationsTable(logger lager.Logger) error {
@@ -102,7 +154,7 @@ func (db *SQLDB) selectLRPInstanceCounts(logger lager.Logger, q Queryable) (*sql
 	switch db.flavor {
 	case helpers.Postgres:
 		columns = append(columns, "STRING_AGG(actual_lrps.instance_index::text, ',') AS existing_indices")
-	case helpers.MySQL:
+	case helpers.MySQL, helpers.SQLite:
 		columns = append(columns, "GROUP_CONCAT(actual_lrps.instance_index) AS existing_indices")
 	default:
 		// totally shouldn't happen
@@ -232,6 +284,17 @@ func (db *SQLDB) countActualLRPsByState(logger lager.Logger, q Queryable) (claim
 			FROM actual_lrps
 			WHERE evacuating = ?
 		`
+	case helpers.SQLite:
+		query = `
+			SELECT
+				COUNT(CASE WHEN actual_lrps.state = ? THEN 1 END) AS claimed_instances,
+				COUNT(CASE WHEN actual_lrps.state = ? THEN 1 END) AS unclaimed_instances,
+				COUNT(CASE WHEN actual_lrps.state = ? THEN 1 END) AS running_instances,
+				COUNT(CASE WHEN actual_lrps.state = ? THEN 1 END) AS crashed_instances,
+				COUNT(DISTINCT CASE WHEN state = ? THEN process_guid END) AS crashing_desireds
+			FROM actual_lrps
+			WHERE evacuating = ?
+		`
 	default:
 		// totally shouldn't happen
 		panic("database flavor not implemented: " + db.flavor)
@@ -266,6 +329,15 @@ func (db *SQLDB) countTasksByState(logger lager.Logger, q Queryable) (pendingCou
 				COUNT(IF(state = ?, 1, NULL)) AS resolving_tasks
 			FROM tasks
 		`
+	case helpers.SQLite:
+		query = `
+			SELECT
+				COUNT(CASE WHEN state = ? THEN 1 END) AS pending_tasks,
+				COUNT(CASE WHEN state = ? THEN 1 END) AS running_tasks,
+				COUNT(CASE WHEN state = ? THEN 1 END) AS completed_tasks,
+				COUNT(CASE WHEN state = ? THEN 1 END) AS resolving_tasks
+			FROM tasks
+		`
 	default:
 		// totally shouldn't happen
 		panic("database flavor not implemented: " + db.flavor)
@@ -293,6 +365,13 @@ func (db *SQLDB) all(logger lager.Logger, q helpers.Queryable, table string,
 	return db.helper.All(logger, q, table, columns, lockRow, wheres, whereBindings...)
 }
 
+func (db *SQLDB) allOrdered(logger lager.Logger, q helpers.Queryable, table string,
+	columns helpers.ColumnList, orderBy string, limit int,
+	wheres string, whereBindings ...interface{},
+) (*sql.Rows, error) {
+	return db.helper.AllOrdered(logger, q, table, columns, orderBy, limit, wheres, whereBindings...)
+}
+
 func (db *SQLDB) upsert(logger lager.Logger, q helpers.Queryable, table string, keyAttributes, updateAttributes helpers.SQLAttributes) (sql.Result, error) {
 	return db.helper.Upsert(logger, q, table, keyAttributes, updateAttributes)
 }
@@ -308,3 +387,17 @@ func (db *SQLDB) update(logger lager.Logger, q helpers.Queryable, table string,
 func (db *SQLDB) delete(logger lager.Logger, q helpers.Queryable, table string, wheres string, whereBindings ...interface{}) (sql.Result, error) {
 	return db.helper.Delete(logger, q, table, wheres, whereBindings...)
 }
+
+// whereDomainIn returns the where clause and its bindings restricting a
+// listing to allowedDomains. An empty list matches no rows.
+func whereDomainIn(allowedDomains []string) (string, []interface{}) {
+	if len(allowedDomains) == 0 {
+		return "1 = 0", nil
+	}
+
+	bindings := make([]interface{}, 0, len(allowedDomains))
+	for _, domain := range allowedDomains {
+		bindings = append(bindings, domain)
+	}
+	return fmt.Sprintf("domain IN (%s)", helpers.QuestionMarks(len(allowedDomains))), bindings
+}
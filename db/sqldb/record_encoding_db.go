@@ -0,0 +1,102 @@
+package sqldb
+
+import (
+	"bytes"
+
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/lager"
+)
+
+const (
+	RecordEncodingID = "record_encoding"
+
+	reEncodingBatchSize = 100
+)
+
+func (db *SQLDB) SetRecordEncoding(logger lager.Logger, encoding string) error {
+	logger = logger.Session("set-record-encoding", lager.Data{"encoding": encoding})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	return db.setConfigurationValue(logger, RecordEncodingID, encoding)
+}
+
+func (db *SQLDB) RecordEncoding(logger lager.Logger) (string, error) {
+	logger = logger.Session("record-encoding")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	return db.getConfigurationValue(logger, RecordEncodingID)
+}
+
+// PerformReEncoding rewrites the blob columns of every row that does not use
+// the encoding of the BBS yet, e.g. to compress them once compression is
+// turned on.
+func (db *SQLDB) PerformReEncoding(logger lager.Logger) error {
+	logger = logger.Session("perform-re-encoding", lager.Data{"encoding": string(db.format.Encoding[:])})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	errCh := make(chan error)
+
+	for _, table := range encryptedTables {
+		go func(table encryptedTable) {
+			errCh <- db.reEncode(logger, table)
+		}(table)
+	}
+
+	var err error
+	for range encryptedTables {
+		tableErr := <-errCh
+		if tableErr != nil && err == nil {
+			err = tableErr
+		}
+	}
+	return err
+}
+
+// reEncode rewrites the rows of the table that do not use the encoding of
+// the BBS yet, one batch of rows at a time.
+func (db *SQLDB) reEncode(logger lager.Logger, table encryptedTable) error {
+	logger = logger.WithData(lager.Data{"table_name": table.name})
+
+	var lastKey []string
+	for {
+		keys, err := db.nextPrimaryKeys(logger, table, lastKey, reEncodingBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, key := range keys {
+			values := make([]interface{}, len(key))
+			for i := range key {
+				values[i] = key[i]
+			}
+
+			_, err = db.rewriteRow(logger, table, values, false)
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(keys) < reEncodingBatchSize {
+			return nil
+		}
+		lastKey = keys[len(keys)-1]
+	}
+}
+
+// alreadyEncoded reports whether every non-empty blob uses the encoding of
+// the BBS.
+func (db *SQLDB) alreadyEncoded(blobs []interface{}) bool {
+	for _, blobPtr := range blobs {
+		blob := *blobPtr.(*[]byte)
+		if len(blob) == 0 {
+			continue
+		}
+		if len(blob) < format.EncodingOffset || !bytes.Equal(blob[:format.EncodingOffset], db.format.Encoding[:]) {
+			return false
+		}
+	}
+	return true
+}
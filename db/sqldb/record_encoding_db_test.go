@@ -0,0 +1,81 @@
+package sqldb_test
+
+import (
+	"code.cloudfoundry.org/bbs/db/sqldb"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"code.cloudfoundry.org/bbs/test_helpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RecordEncodingDB", func() {
+	Describe("RecordEncoding", func() {
+		It("returns a ResourceNotFound until the encoding is set", func() {
+			_, err := sqlDB.RecordEncoding(logger)
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+		})
+
+		It("returns the encoding last set", func() {
+			Expect(sqlDB.SetRecordEncoding(logger, "03")).To(Succeed())
+
+			encoding, err := sqlDB.RecordEncoding(logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(encoding).To(Equal("03"))
+		})
+	})
+
+	Describe("PerformReEncoding", func() {
+		var (
+			compressingDB *sqldb.SQLDB
+			desiredLRP    *models.DesiredLRP
+		)
+
+		runInfo := func(processGuid string) []byte {
+			var result []byte
+			queryStr := "SELECT run_info FROM desired_lrps WHERE process_guid = ?"
+			if test_helpers.UsePostgres() {
+				queryStr = test_helpers.ReplaceQuestionMarks(queryStr)
+			}
+			Expect(db.QueryRow(queryStr, processGuid).Scan(&result)).To(Succeed())
+			return result
+		}
+
+		BeforeEach(func() {
+			desiredLRP = model_helpers.NewValidDesiredLRP("the-guid")
+			Expect(sqlDB.DesireLRP(logger, desiredLRP)).To(Succeed())
+			Expect(runInfo("the-guid")[:2]).To(Equal(format.BASE64_ENCRYPTED[:]))
+
+			compressingDB = sqldb.NewSQLDB(db, 5, 5, format.COMPRESSED_ENCRYPTED_PROTO, cryptor, fakeGUIDProvider, fakeClock, dbFlavor)
+		})
+
+		It("rewrites the records with the encoding of the BBS", func() {
+			uncompressed := runInfo("the-guid")
+			Expect(compressingDB.PerformReEncoding(logger)).To(Succeed())
+
+			compressed := runInfo("the-guid")
+			Expect(compressed[:2]).To(Equal(format.BASE64_ENCRYPTED_COMPRESSED[:]))
+			Expect(len(compressed)).To(BeNumerically("<", len(uncompressed)))
+
+			for _, reader := range []*sqldb.SQLDB{sqlDB, compressingDB} {
+				lrp, err := reader.DesiredLRPByProcessGuid(logger, "the-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(lrp).To(Equal(desiredLRP))
+			}
+		})
+
+		It("leaves the records already using the encoding alone", func() {
+			Expect(compressingDB.PerformReEncoding(logger)).To(Succeed())
+			compressed := runInfo("the-guid")
+
+			Expect(compressingDB.PerformReEncoding(logger)).To(Succeed())
+			Expect(runInfo("the-guid")).To(Equal(compressed))
+		})
+
+		It("writes new records with the encoding of the BBS", func() {
+			Expect(compressingDB.DesireLRP(logger, model_helpers.NewValidDesiredLRP("other-guid"))).To(Succeed())
+			Expect(runInfo("other-guid")[:2]).To(Equal(format.BASE64_ENCRYPTED_COMPRESSED[:]))
+		})
+	})
+})
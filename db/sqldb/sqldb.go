@@ -24,6 +24,7 @@ type SQLDB struct {
 	encoder                format.Encoder
 	flavor                 string
 	helper                 helpers.SQLHelper
+	quarantines            *quarantineTracker
 }
 
 type RowScanner interface {
@@ -60,11 +61,18 @@ func NewSQLDB(
 		encoder:                format.NewEncoder(cryptor),
 		flavor:                 flavor,
 		helper:                 helper,
+		quarantines:            newQuarantineTracker(),
 	}
 }
 
 func (db *SQLDB) transact(logger lager.Logger, f func(logger lager.Logger, tx *sql.Tx) error) error {
-	err := db.helper.Transact(logger, db.db, f)
+	var quarantined []*quarantinedRows
+	err := db.helper.Transact(logger, db.db, func(logger lager.Logger, tx *sql.Tx) error {
+		// only the rows moved by the last attempt are left to settle
+		defer func() { quarantined = db.quarantines.take(tx) }()
+		return f(logger, tx)
+	})
+	db.settleQuarantines(logger, quarantined, err)
 	if err != nil {
 		return db.convertSQLError(err)
 	}
@@ -4,7 +4,9 @@ import (
 	"crypto/rand"
 	"database/sql"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"strings"
 	"time"
 
 	thepackagedb "code.cloudfoundry.org/bbs/db"
@@ -38,6 +40,7 @@ var (
 	migrationProcess                     ifrit.Process
 	dbDriverName, dbBaseConnectionString string
 	dbFlavor                             string
+	sqliteDir                            string
 )
 
 func TestSql(t *testing.T) {
@@ -60,21 +63,30 @@ var _ = BeforeSuite(func() {
 		dbDriverName = "mysql"
 		dbBaseConnectionString = "diego:diego_password@/"
 		dbFlavor = helpers.MySQL
+	} else if test_helpers.UseSQLite() {
+		sqliteDir, err = ioutil.TempDir("", "sqldb")
+		Expect(err).NotTo(HaveOccurred())
+		dbDriverName = "sqlite3"
+		dbBaseConnectionString = sqliteDir + "/"
+		dbFlavor = helpers.SQLite
 	} else {
 		panic("Unsupported driver")
 	}
 
-	// mysql must be set up on localhost as described in the CONTRIBUTING.md doc
-	// in diego-release.
-	db, err = sql.Open(dbDriverName, dbBaseConnectionString)
-	Expect(err).NotTo(HaveOccurred())
-	Expect(db.Ping()).NotTo(HaveOccurred())
+	// sqlite creates the database file when it is first opened
+	if !test_helpers.UseSQLite() {
+		// mysql must be set up on localhost as described in the CONTRIBUTING.md doc
+		// in diego-release.
+		db, err = sql.Open(dbDriverName, dbBaseConnectionString)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(db.Ping()).NotTo(HaveOccurred())
 
-	// Ensure that if another test failed to clean up we can still proceed
-	db.Exec(fmt.Sprintf("DROP DATABASE diego_%d", GinkgoParallelNode()))
+		// Ensure that if another test failed to clean up we can still proceed
+		db.Exec(fmt.Sprintf("DROP DATABASE diego_%d", GinkgoParallelNode()))
 
-	_, err = db.Exec(fmt.Sprintf("CREATE DATABASE diego_%d", GinkgoParallelNode()))
-	Expect(err).NotTo(HaveOccurred())
+		_, err = db.Exec(fmt.Sprintf("CREATE DATABASE diego_%d", GinkgoParallelNode()))
+		Expect(err).NotTo(HaveOccurred())
+	}
 
 	db, err = sql.Open(dbDriverName, fmt.Sprintf("%sdiego_%d", dbBaseConnectionString, GinkgoParallelNode()))
 	Expect(err).NotTo(HaveOccurred())
@@ -134,6 +146,11 @@ var _ = AfterSuite(func() {
 	}
 
 	Expect(db.Close()).NotTo(HaveOccurred())
+	if test_helpers.UseSQLite() {
+		Expect(os.RemoveAll(sqliteDir)).To(Succeed())
+		return
+	}
+
 	db, err := sql.Open(dbDriverName, dbBaseConnectionString)
 	Expect(err).NotTo(HaveOccurred())
 	Expect(db.Ping()).NotTo(HaveOccurred())
@@ -144,6 +161,13 @@ var _ = AfterSuite(func() {
 
 func truncateTables(db *sql.DB) {
 	for _, query := range truncateTablesSQL {
+		if test_helpers.UseSQLite() {
+			// sqlite has no TRUNCATE, and reports the deleted rows as affected
+			_, err := db.Exec(strings.Replace(query, "TRUNCATE TABLE", "DELETE FROM", 1))
+			Expect(err).NotTo(HaveOccurred())
+			continue
+		}
+
 		result, err := db.Exec(query)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(result.RowsAffected()).To(BeEquivalentTo(0))
@@ -156,6 +180,11 @@ var truncateTablesSQL = []string{
 	"TRUNCATE TABLE desired_lrps",
 	"TRUNCATE TABLE actual_lrps",
 	"TRUNCATE TABLE configurations",
+	"TRUNCATE TABLE audit_events",
+	"TRUNCATE TABLE desired_lrp_revisions",
+	"TRUNCATE TABLE quarantined_records",
+	"TRUNCATE TABLE encryption_progress",
+	"TRUNCATE TABLE desired_lrp_rollouts",
 }
 
 func randStr(strSize int) string {
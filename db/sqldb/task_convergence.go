@@ -1,6 +1,7 @@
 package sqldb
 
 import (
+	"database/sql"
 	"fmt"
 	"math"
 	"time"
@@ -25,7 +26,7 @@ const (
 	resolvingTasks = metric.Metric("TasksResolving")
 )
 
-func (db *SQLDB) ConvergeTasks(logger lager.Logger, cellSet models.CellSet, kickTasksDuration, expirePendingTaskDuration, expireCompletedTaskDuration time.Duration) ([]*auctioneer.TaskStartRequest, []*models.Task) {
+func (db *SQLDB) ConvergeTasks(logger lager.Logger, cellSet models.CellSet, kickTasksDuration, expirePendingTaskDuration, expireCompletedTaskDuration time.Duration) ([]*auctioneer.TaskStartRequest, []*models.Task, []models.Event) {
 	logger.Info("starting")
 	defer logger.Info("completed")
 
@@ -40,23 +41,32 @@ func (db *SQLDB) ConvergeTasks(logger lager.Logger, cellSet models.CellSet, kick
 	}()
 
 	var tasksPruned, tasksKicked uint64
+	events := []models.Event{}
 
-	rowsAffected := db.failExpiredPendingTasks(logger, expirePendingTaskDuration)
-	tasksKicked += uint64(rowsAffected)
+	failedEvents, failedFetches := db.failExpiredPendingTasks(logger, expirePendingTaskDuration)
+	events = append(events, failedEvents...)
+	tasksPruned += failedFetches
+	tasksKicked += uint64(len(failedEvents))
 
 	tasksToAuction, failedFetches := db.getTaskStartRequestsForKickablePendingTasks(logger, kickTasksDuration, expirePendingTaskDuration)
 	tasksPruned += failedFetches
 	tasksKicked += uint64(len(tasksToAuction))
 
-	rowsAffected = db.failTasksWithDisappearedCells(logger, cellSet)
-	tasksKicked += uint64(rowsAffected)
+	retriedTasks, failedEvents, failedFetches := db.failTasksWithDisappearedCells(logger, cellSet)
+	events = append(events, failedEvents...)
+	tasksToAuction = append(tasksToAuction, retriedTasks...)
+	tasksPruned += failedFetches
+	tasksKicked += uint64(len(failedEvents))
 
 	// do this first so that we now have "Completed" tasks before cleaning up
 	// or re-sending the completion callback
-	db.demoteKickableResolvingTasks(logger, kickTasksDuration)
+	demotedEvents, failedFetches := db.demoteKickableResolvingTasks(logger, kickTasksDuration)
+	events = append(events, demotedEvents...)
+	tasksPruned += failedFetches
 
-	rowsAffected = db.deleteExpiredCompletedTasks(logger, expireCompletedTaskDuration)
-	tasksPruned += uint64(rowsAffected)
+	removedEvents, failedFetches := db.deleteExpiredCompletedTasks(logger, expireCompletedTaskDuration)
+	events = append(events, removedEvents...)
+	tasksPruned += failedFetches + uint64(len(removedEvents))
 
 	tasksToComplete, failedFetches := db.getKickableCompleteTasksForCompletion(logger, kickTasksDuration)
 	tasksPruned += failedFetches
@@ -69,15 +79,16 @@ func (db *SQLDB) ConvergeTasks(logger lager.Logger, cellSet models.CellSet, kick
 	tasksKickedCounter.Add(tasksKicked)
 	tasksPrunedCounter.Add(tasksPruned)
 
-	return tasksToAuction, tasksToComplete
+	return tasksToAuction, tasksToComplete, events
 }
 
-func (db *SQLDB) failExpiredPendingTasks(logger lager.Logger, expirePendingTaskDuration time.Duration) int64 {
+func (db *SQLDB) failExpiredPendingTasks(logger lager.Logger, expirePendingTaskDuration time.Duration) ([]models.Event, uint64) {
 	logger = logger.Session("fail-expired-pending-tasks")
 
 	now := db.clock.Now()
+	expireBefore := now.Add(-expirePendingTaskDuration).UnixNano()
 
-	result, err := db.update(logger, db.db, tasksTable,
+	return db.updateTasks(logger,
 		helpers.SQLAttributes{
 			"failed":             true,
 			"failure_reason":     "not started within time limit",
@@ -86,27 +97,32 @@ func (db *SQLDB) failExpiredPendingTasks(logger lager.Logger, expirePendingTaskD
 			"first_completed_at": now.UnixNano(),
 			"updated_at":         now.UnixNano(),
 		},
-		"state = ? AND created_at < ?", models.Task_Pending, now.Add(-expirePendingTaskDuration).UnixNano())
-	if err != nil {
-		logger.Error("failed-query", err)
-		return 0
-	}
-
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		logger.Error("failed-rows-affected", err)
-		return 0
-	}
-	return rowsAffected
+		func(task *models.Task) {
+			task.Failed = true
+			task.FailureReason = "not started within time limit"
+			task.Result = ""
+			task.State = models.Task_Completed
+			task.FirstCompletedAt = now.UnixNano()
+			task.UpdatedAt = now.UnixNano()
+		},
+		func(task *models.Task) bool {
+			return !pendingTaskUnexpired(task, expireBefore)
+		},
+		// a pending task's updated_at is its creation time, or the time it was
+		// last returned to pending by a retry
+		"state = ? AND updated_at < ?", models.Task_Pending, expireBefore,
+	)
 }
 
 func (db *SQLDB) getTaskStartRequestsForKickablePendingTasks(logger lager.Logger, kickTasksDuration, expirePendingTaskDuration time.Duration) ([]*auctioneer.TaskStartRequest, uint64) {
 	logger = logger.Session("get-task-start-requests-for-kickable-pending-tasks")
 
+	now := db.clock.Now()
+	expireBefore := now.Add(-expirePendingTaskDuration).UnixNano()
 	rows, err := db.all(logger, db.db, tasksTable,
 		taskColumns, helpers.NoLockRow,
-		"state = ? AND updated_at < ? AND created_at > ?",
-		models.Task_Pending, db.clock.Now().Add(-kickTasksDuration).UnixNano(), db.clock.Now().Add(-expirePendingTaskDuration).UnixNano(),
+		"state = ? AND updated_at < ?",
+		models.Task_Pending, now.Add(-kickTasksDuration).UnixNano(),
 	)
 
 	if err != nil {
@@ -119,6 +135,9 @@ func (db *SQLDB) getTaskStartRequestsForKickablePendingTasks(logger lager.Logger
 	tasksToAuction := []*auctioneer.TaskStartRequest{}
 	tasks, invalidTasksCount, err := db.fetchTasks(logger, rows, db.db, false)
 	for _, task := range tasks {
+		if task.InRetryBackoff(now.UnixNano()) || !pendingTaskUnexpired(task, expireBefore) {
+			continue
+		}
 		taskStartRequest := auctioneer.NewTaskStartRequestFromModel(task.TaskGuid, task.Domain, task.TaskDefinition)
 		tasksToAuction = append(tasksToAuction, &taskStartRequest)
 	}
@@ -130,7 +149,17 @@ func (db *SQLDB) getTaskStartRequestsForKickablePendingTasks(logger lager.Logger
 	return tasksToAuction, uint64(invalidTasksCount)
 }
 
-func (db *SQLDB) failTasksWithDisappearedCells(logger lager.Logger, cellSet models.CellSet) int64 {
+// pendingTaskUnexpired reports whether the pending task became pending, or
+// finished the backoff of its last retry, after expireBefore, so that the time
+// a task waits out a retry backoff does not count towards its expiry.
+func pendingTaskUnexpired(task *models.Task, expireBefore int64) bool {
+	return task.UpdatedAt > expireBefore || task.InRetryBackoff(expireBefore)
+}
+
+// failTasksWithDisappearedCells fails the running tasks whose cells are gone.
+// Tasks whose retry policy allows it are returned to pending instead, and
+// start requests are returned for those that are not backing off.
+func (db *SQLDB) failTasksWithDisappearedCells(logger lager.Logger, cellSet models.CellSet) ([]*auctioneer.TaskStartRequest, []models.Event, uint64) {
 	logger = logger.Session("fail-tasks-with-disappeared-cells")
 
 	values := make([]interface{}, 0, 1+len(cellSet))
@@ -145,60 +174,134 @@ func (db *SQLDB) failTasksWithDisappearedCells(logger lager.Logger, cellSet mode
 		wheres += fmt.Sprintf(" AND cell_id NOT IN (%s)", helpers.QuestionMarks(len(cellSet)))
 	}
 	now := db.clock.Now().UnixNano()
+	const failureReason = "cell disappeared before completion"
 
-	result, err := db.update(logger, db.db, tasksTable,
-		helpers.SQLAttributes{
-			"failed":             true,
-			"failure_reason":     "cell disappeared before completion",
-			"result":             "",
-			"state":              models.Task_Completed,
-			"first_completed_at": now,
-			"updated_at":         now,
-		},
-		wheres, values...,
-	)
-	if err != nil {
-		logger.Error("failed-updating-tasks", err)
-		return 0
-	}
+	tasksToAuction := []*auctioneer.TaskStartRequest{}
+	events := []models.Event{}
+	var failedFetches int
+
+	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+		var tasks []*models.Task
+		var err error
+		tasks, failedFetches, err = db.lockTasks(logger, tx, wheres, values...)
+		if err != nil || len(tasks) == 0 {
+			return err
+		}
+
+		tasksToAuction = tasksToAuction[:0]
+		events = events[:0]
+		failedTasks := []models.TaskChange{}
+		for _, task := range tasks {
+			before := task.Copy()
+			retried, err := db.failTaskAttempt(logger, task, models.TaskFailureClass_CellDisappeared, failureReason, tx)
+			if err != nil {
+				return err
+			}
+
+			if !retried {
+				failedTasks = append(failedTasks, models.TaskChange{Before: before, After: task})
+				continue
+			}
+
+			events = append(events, models.NewTaskChangedEvent(before, task))
+			if !task.InRetryBackoff(now) {
+				taskStartRequest := auctioneer.NewTaskStartRequestFromModel(task.TaskGuid, task.Domain, task.TaskDefinition)
+				tasksToAuction = append(tasksToAuction, &taskStartRequest)
+			}
+		}
+
+		if len(failedTasks) == 0 {
+			return nil
+		}
 
-	rowsAffected, err := result.RowsAffected()
+		guids := make([]interface{}, 0, len(failedTasks))
+		for _, change := range failedTasks {
+			guids = append(guids, change.After.TaskGuid)
+		}
+
+		_, err = db.update(logger, tx, tasksTable,
+			helpers.SQLAttributes{
+				"failed":             true,
+				"failure_reason":     failureReason,
+				"result":             "",
+				"state":              models.Task_Completed,
+				"first_completed_at": now,
+				"updated_at":         now,
+			},
+			fmt.Sprintf("guid IN (%s)", helpers.QuestionMarks(len(guids))), guids...,
+		)
+		if err != nil {
+			logger.Error("failed-updating-tasks", err)
+			return err
+		}
+
+		for _, change := range failedTasks {
+			after := change.After
+			after.Failed = true
+			after.FailureReason = failureReason
+			after.Result = ""
+			after.State = models.Task_Completed
+			after.FirstCompletedAt = now
+			after.UpdatedAt = now
+			events = append(events, models.NewTaskChangedEvent(change.Before, after))
+		}
+		return nil
+	})
 	if err != nil {
-		logger.Error("failed-rows-affected", err)
-		return 0
+		return []*auctioneer.TaskStartRequest{}, []models.Event{}, uint64(failedFetches)
 	}
 
-	return rowsAffected
+	return tasksToAuction, events, uint64(failedFetches)
 }
 
-func (db *SQLDB) demoteKickableResolvingTasks(logger lager.Logger, kickTasksDuration time.Duration) {
+func (db *SQLDB) demoteKickableResolvingTasks(logger lager.Logger, kickTasksDuration time.Duration) ([]models.Event, uint64) {
 	logger = logger.Session("demote-kickable-resolving-tasks")
-	_, err := db.update(logger, db.db, tasksTable,
+
+	return db.updateTasks(logger,
 		helpers.SQLAttributes{"state": models.Task_Completed},
+		func(task *models.Task) {
+			task.State = models.Task_Completed
+		},
+		nil,
 		"state = ? AND updated_at < ?",
 		models.Task_Resolving, db.clock.Now().Add(-kickTasksDuration).UnixNano(),
 	)
-	if err != nil {
-		logger.Error("failed-updating-tasks", err)
-	}
 }
 
-func (db *SQLDB) deleteExpiredCompletedTasks(logger lager.Logger, expireCompletedTaskDuration time.Duration) int64 {
+func (db *SQLDB) deleteExpiredCompletedTasks(logger lager.Logger, expireCompletedTaskDuration time.Duration) ([]models.Event, uint64) {
 	logger = logger.Session("delete-expired-completed-tasks")
 
-	result, err := db.delete(logger, db.db, tasksTable, "state = ? AND first_completed_at < ?", models.Task_Completed, db.clock.Now().Add(-expireCompletedTaskDuration).UnixNano())
-	if err != nil {
-		logger.Error("failed-query", err)
-		return 0
-	}
+	events := []models.Event{}
+	var failedFetches int
+
+	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+		var tasks []*models.Task
+		var err error
+		tasks, failedFetches, err = db.lockTasks(logger, tx,
+			"state = ? AND first_completed_at < ?",
+			models.Task_Completed, db.clock.Now().Add(-expireCompletedTaskDuration).UnixNano(),
+		)
+		if err != nil || len(tasks) == 0 {
+			return err
+		}
 
-	rowsAffected, err := result.RowsAffected()
+		_, err = db.delete(logger, tx, tasksTable, guidsInClause(tasks), taskGuids(tasks)...)
+		if err != nil {
+			logger.Error("failed-deleting-tasks", err)
+			return err
+		}
+
+		events = events[:0]
+		for _, task := range tasks {
+			events = append(events, models.NewTaskRemovedEvent(task))
+		}
+		return nil
+	})
 	if err != nil {
-		logger.Error("failed-rows-affected", err)
-		return 0
+		return []models.Event{}, uint64(failedFetches)
 	}
 
-	return rowsAffected
+	return events, uint64(failedFetches)
 }
 
 func (db *SQLDB) getKickableCompleteTasksForCompletion(logger lager.Logger, kickTasksDuration time.Duration) ([]*models.Task, uint64) {
@@ -226,6 +329,94 @@ func (db *SQLDB) getKickableCompleteTasksForCompletion(logger lager.Logger, kick
 	return tasksToComplete, uint64(failedFetches)
 }
 
+// updateTasks locks the tasks matching the given query, applies the
+// attributes to them and returns a TaskChangedEvent for each updated task,
+// along with the number of tasks that could not be fetched. applyToModel
+// should make the same change to a task model that the attributes make to
+// its row. If include is not nil, only the tasks it accepts are updated.
+func (db *SQLDB) updateTasks(
+	logger lager.Logger,
+	attributes helpers.SQLAttributes,
+	applyToModel func(task *models.Task),
+	include func(task *models.Task) bool,
+	wheres string, whereBindings ...interface{},
+) ([]models.Event, uint64) {
+	events := []models.Event{}
+	var failedFetches int
+
+	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+		var tasks []*models.Task
+		var err error
+		tasks, failedFetches, err = db.lockTasks(logger, tx, wheres, whereBindings...)
+		if err != nil {
+			return err
+		}
+
+		if include != nil {
+			included := tasks[:0]
+			for _, task := range tasks {
+				if include(task) {
+					included = append(included, task)
+				}
+			}
+			tasks = included
+		}
+		if len(tasks) == 0 {
+			return nil
+		}
+
+		_, err = db.update(logger, tx, tasksTable, attributes, guidsInClause(tasks), taskGuids(tasks)...)
+		if err != nil {
+			logger.Error("failed-updating-tasks", err)
+			return err
+		}
+
+		events = events[:0]
+		for _, task := range tasks {
+			after := task.Copy()
+			applyToModel(after)
+			events = append(events, models.NewTaskChangedEvent(task, after))
+		}
+		return nil
+	})
+	if err != nil {
+		return []models.Event{}, uint64(failedFetches)
+	}
+
+	return events, uint64(failedFetches)
+}
+
+func (db *SQLDB) lockTasks(logger lager.Logger, tx *sql.Tx, wheres string, whereBindings ...interface{}) ([]*models.Task, int, error) {
+	rows, err := db.all(logger, tx, tasksTable,
+		taskColumns, helpers.LockRow,
+		wheres, whereBindings...,
+	)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	tasks, failedFetches, err := db.fetchTasks(logger, rows, tx, false)
+	if err != nil {
+		logger.Error("failed-fetching-some-tasks", err)
+	}
+
+	return tasks, failedFetches, nil
+}
+
+func guidsInClause(tasks []*models.Task) string {
+	return fmt.Sprintf("guid IN (%s)", helpers.QuestionMarks(len(tasks)))
+}
+
+func taskGuids(tasks []*models.Task) []interface{} {
+	guids := make([]interface{}, 0, len(tasks))
+	for _, task := range tasks {
+		guids = append(guids, task.TaskGuid)
+	}
+	return guids
+}
+
 func sendTaskMetrics(logger lager.Logger, pendingCount, runningCount, completedCount, resolvingCount int) {
 	err := pendingTasks.Send(pendingCount)
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/auctioneer"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
 	"github.com/cloudfoundry/dropsonde/metric_sender/fake"
@@ -38,6 +39,7 @@ var _ = Describe("Convergence of Tasks", func() {
 			domain          string
 			tasksToAuction  []*auctioneer.TaskStartRequest
 			tasksToComplete []*models.Task
+			taskEvents      []models.Event
 			cellSet         models.CellSet
 
 			taskDef *models.TaskDefinition
@@ -52,107 +54,107 @@ var _ = Describe("Convergence of Tasks", func() {
 			taskDef = model_helpers.NewValidTaskDefinition()
 
 			fakeClock.IncrementBySeconds(-expirePendingTaskDurationInSeconds)
-			err = sqlDB.DesireTask(logger, taskDef, "pending-expired-task", domain)
+			_, err = sqlDB.DesireTask(logger, taskDef, "pending-expired-task", domain)
 			Expect(err).NotTo(HaveOccurred())
 			fakeClock.IncrementBySeconds(expirePendingTaskDurationInSeconds)
 
 			fakeClock.IncrementBySeconds(-kickTasksDurationInSeconds)
-			err = sqlDB.DesireTask(logger, taskDef, "pending-kickable-task", domain)
+			_, err = sqlDB.DesireTask(logger, taskDef, "pending-kickable-task", domain)
 			Expect(err).NotTo(HaveOccurred())
 			fakeClock.IncrementBySeconds(kickTasksDurationInSeconds)
 
 			fakeClock.IncrementBySeconds(-kickTasksDurationInSeconds)
-			err = sqlDB.DesireTask(logger, taskDef, "pending-kickable-invalid-task", domain)
+			_, err = sqlDB.DesireTask(logger, taskDef, "pending-kickable-invalid-task", domain)
 			Expect(err).NotTo(HaveOccurred())
 			_, err = db.Exec("UPDATE tasks SET task_definition = 'garbage' WHERE guid = 'pending-kickable-invalid-task'")
 			Expect(err).NotTo(HaveOccurred())
 			fakeClock.IncrementBySeconds(kickTasksDurationInSeconds)
 
-			err = sqlDB.DesireTask(logger, taskDef, "pending-task", domain)
+			_, err = sqlDB.DesireTask(logger, taskDef, "pending-task", domain)
 			Expect(err).NotTo(HaveOccurred())
 
-			err = sqlDB.DesireTask(logger, taskDef, "running-task-no-cell", domain)
+			_, err = sqlDB.DesireTask(logger, taskDef, "running-task-no-cell", domain)
 			Expect(err).NotTo(HaveOccurred())
-			_, err = sqlDB.StartTask(logger, "running-task-no-cell", "non-existant-cell")
+			_, _, _, err = sqlDB.StartTask(logger, "running-task-no-cell", "non-existant-cell")
 			Expect(err).NotTo(HaveOccurred())
 
-			err = sqlDB.DesireTask(logger, taskDef, "running-task", domain)
+			_, err = sqlDB.DesireTask(logger, taskDef, "running-task", domain)
 			Expect(err).NotTo(HaveOccurred())
-			_, err = sqlDB.StartTask(logger, "running-task", "existing-cell")
+			_, _, _, err = sqlDB.StartTask(logger, "running-task", "existing-cell")
 			Expect(err).NotTo(HaveOccurred())
 
 			fakeClock.Increment(-expireCompletedTaskDuration)
-			err = sqlDB.DesireTask(logger, taskDef, "completed-expired-task", domain)
+			_, err = sqlDB.DesireTask(logger, taskDef, "completed-expired-task", domain)
 			Expect(err).NotTo(HaveOccurred())
-			_, err = sqlDB.StartTask(logger, "completed-expired-task", "existing-cell")
+			_, _, _, err = sqlDB.StartTask(logger, "completed-expired-task", "existing-cell")
 			Expect(err).NotTo(HaveOccurred())
-			_, err = sqlDB.CompleteTask(logger, "completed-expired-task", "existing-cell", false, "", "")
+			_, _, err = sqlDB.CompleteTask(logger, "completed-expired-task", "existing-cell", false, "", "")
 			Expect(err).NotTo(HaveOccurred())
 			fakeClock.Increment(expireCompletedTaskDuration)
 
 			fakeClock.IncrementBySeconds(-kickTasksDurationInSeconds)
-			err = sqlDB.DesireTask(logger, taskDef, "completed-kickable-task", domain)
+			_, err = sqlDB.DesireTask(logger, taskDef, "completed-kickable-task", domain)
 			Expect(err).NotTo(HaveOccurred())
-			_, err = sqlDB.StartTask(logger, "completed-kickable-task", "existing-cell")
+			_, _, _, err = sqlDB.StartTask(logger, "completed-kickable-task", "existing-cell")
 			Expect(err).NotTo(HaveOccurred())
-			_, err = sqlDB.CompleteTask(logger, "completed-kickable-task", "existing-cell", false, "", "")
+			_, _, err = sqlDB.CompleteTask(logger, "completed-kickable-task", "existing-cell", false, "", "")
 			Expect(err).NotTo(HaveOccurred())
 			fakeClock.IncrementBySeconds(kickTasksDurationInSeconds)
 
 			fakeClock.IncrementBySeconds(-kickTasksDurationInSeconds)
-			err = sqlDB.DesireTask(logger, taskDef, "completed-kickable-invalid-task", domain)
+			_, err = sqlDB.DesireTask(logger, taskDef, "completed-kickable-invalid-task", domain)
 			Expect(err).NotTo(HaveOccurred())
-			_, err = sqlDB.StartTask(logger, "completed-kickable-invalid-task", "existing-cell")
+			_, _, _, err = sqlDB.StartTask(logger, "completed-kickable-invalid-task", "existing-cell")
 			Expect(err).NotTo(HaveOccurred())
-			_, err = sqlDB.CompleteTask(logger, "completed-kickable-invalid-task", "existing-cell", false, "", "")
+			_, _, err = sqlDB.CompleteTask(logger, "completed-kickable-invalid-task", "existing-cell", false, "", "")
 			Expect(err).NotTo(HaveOccurred())
 			_, err = db.Exec("UPDATE tasks SET task_definition = 'garbage' WHERE guid = 'completed-kickable-invalid-task'")
 			Expect(err).NotTo(HaveOccurred())
 			fakeClock.IncrementBySeconds(kickTasksDurationInSeconds)
 
-			err = sqlDB.DesireTask(logger, taskDef, "completed-task", domain)
+			_, err = sqlDB.DesireTask(logger, taskDef, "completed-task", domain)
 			Expect(err).NotTo(HaveOccurred())
-			_, err = sqlDB.StartTask(logger, "completed-task", "existing-cell")
+			_, _, _, err = sqlDB.StartTask(logger, "completed-task", "existing-cell")
 			Expect(err).NotTo(HaveOccurred())
-			_, err = sqlDB.CompleteTask(logger, "completed-task", "existing-cell", false, "", "")
+			_, _, err = sqlDB.CompleteTask(logger, "completed-task", "existing-cell", false, "", "")
 			Expect(err).NotTo(HaveOccurred())
 
 			fakeClock.Increment(-expireCompletedTaskDuration)
-			err = sqlDB.DesireTask(logger, taskDef, "resolving-expired-task", domain)
+			_, err = sqlDB.DesireTask(logger, taskDef, "resolving-expired-task", domain)
 			Expect(err).NotTo(HaveOccurred())
-			_, err = sqlDB.StartTask(logger, "resolving-expired-task", "existing-cell")
+			_, _, _, err = sqlDB.StartTask(logger, "resolving-expired-task", "existing-cell")
 			Expect(err).NotTo(HaveOccurred())
-			_, err = sqlDB.CompleteTask(logger, "resolving-expired-task", "existing-cell", false, "", "")
+			_, _, err = sqlDB.CompleteTask(logger, "resolving-expired-task", "existing-cell", false, "", "")
 			Expect(err).NotTo(HaveOccurred())
-			err = sqlDB.ResolvingTask(logger, "resolving-expired-task")
+			_, _, err = sqlDB.ResolvingTask(logger, "resolving-expired-task")
 			Expect(err).NotTo(HaveOccurred())
 			fakeClock.Increment(expireCompletedTaskDuration)
 
 			fakeClock.IncrementBySeconds(-kickTasksDurationInSeconds)
-			err = sqlDB.DesireTask(logger, taskDef, "resolving-kickable-task", domain)
+			_, err = sqlDB.DesireTask(logger, taskDef, "resolving-kickable-task", domain)
 			Expect(err).NotTo(HaveOccurred())
-			_, err = sqlDB.StartTask(logger, "resolving-kickable-task", "existing-cell")
+			_, _, _, err = sqlDB.StartTask(logger, "resolving-kickable-task", "existing-cell")
 			Expect(err).NotTo(HaveOccurred())
-			_, err = sqlDB.CompleteTask(logger, "resolving-kickable-task", "existing-cell", false, "", "")
+			_, _, err = sqlDB.CompleteTask(logger, "resolving-kickable-task", "existing-cell", false, "", "")
 			Expect(err).NotTo(HaveOccurred())
-			err = sqlDB.ResolvingTask(logger, "resolving-kickable-task")
+			_, _, err = sqlDB.ResolvingTask(logger, "resolving-kickable-task")
 			Expect(err).NotTo(HaveOccurred())
 			fakeClock.IncrementBySeconds(kickTasksDurationInSeconds)
 
-			err = sqlDB.DesireTask(logger, taskDef, "resolving-task", domain)
+			_, err = sqlDB.DesireTask(logger, taskDef, "resolving-task", domain)
 			Expect(err).NotTo(HaveOccurred())
-			_, err = sqlDB.StartTask(logger, "resolving-task", "existing-cell")
+			_, _, _, err = sqlDB.StartTask(logger, "resolving-task", "existing-cell")
 			Expect(err).NotTo(HaveOccurred())
-			_, err = sqlDB.CompleteTask(logger, "resolving-task", "existing-cell", false, "", "")
+			_, _, err = sqlDB.CompleteTask(logger, "resolving-task", "existing-cell", false, "", "")
 			Expect(err).NotTo(HaveOccurred())
-			err = sqlDB.ResolvingTask(logger, "resolving-task")
+			_, _, err = sqlDB.ResolvingTask(logger, "resolving-task")
 			Expect(err).NotTo(HaveOccurred())
 
 			fakeClock.IncrementBySeconds(1)
 		})
 
 		JustBeforeEach(func() {
-			tasksToAuction, tasksToComplete = sqlDB.ConvergeTasks(logger, cellSet, kickTasksDuration, expirePendingTaskDuration, expireCompletedTaskDuration)
+			tasksToAuction, tasksToComplete, taskEvents = sqlDB.ConvergeTasks(logger, cellSet, kickTasksDuration, expirePendingTaskDuration, expireCompletedTaskDuration)
 		})
 
 		It("bumps the convergence counter", func() {
@@ -190,6 +192,15 @@ var _ = Describe("Convergence of Tasks", func() {
 				Expect(tasksToAuction).NotTo(ContainElement(&taskRequest))
 			})
 
+			It("emits a TaskChangedEvent for expired tasks", func() {
+				event := findTaskEvent(taskEvents, models.EventTypeTaskChanged, "pending-expired-task")
+				Expect(event).NotTo(BeNil())
+				changedEvent := event.(*models.TaskChangedEvent)
+				Expect(changedEvent.Before.State).To(Equal(models.Task_Pending))
+				Expect(changedEvent.After.State).To(Equal(models.Task_Completed))
+				Expect(changedEvent.After.Failed).To(BeTrue())
+			})
+
 			It("returns tasks that should be kicked for auctioning", func() {
 				task, err := sqlDB.TaskByGuid(logger, "pending-kickable-task")
 				Expect(err).NotTo(HaveOccurred())
@@ -200,9 +211,14 @@ var _ = Describe("Convergence of Tasks", func() {
 				Expect(tasksToAuction).To(ContainElement(&taskRequest))
 			})
 
-			It("delete tasks that should be kicked if they're invalid", func() {
+			It("quarantines tasks that should be kicked if they're invalid", func() {
 				_, err := sqlDB.TaskByGuid(logger, "pending-kickable-invalid-task")
 				Expect(err).To(Equal(models.ErrResourceNotFound))
+
+				records, err := sqlDB.QuarantinedRecords(logger, models.QuarantinedTasksTable)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(records).To(HaveLen(1))
+				Expect(records[0].Guid).To(Equal("pending-kickable-invalid-task"))
 			})
 
 			It("doesn't do anything with unexpired tasks that should not be kicked", func() {
@@ -214,6 +230,54 @@ var _ = Describe("Convergence of Tasks", func() {
 				Expect(task.FailureReason).NotTo(Equal("not started within time limit"))
 				Expect(task.Failed).NotTo(BeTrue())
 			})
+
+			Context("when retried tasks have a backoff longer than the pending expiry", func() {
+				var backoffTaskDef *models.TaskDefinition
+
+				retriedAgo := func(guid string, seconds int64) {
+					_, err := sqlDB.DesireTask(logger, backoffTaskDef, guid, domain)
+					Expect(err).NotTo(HaveOccurred())
+					updatedAt := fakeClock.Now().Add(-time.Duration(seconds) * time.Second).UnixNano()
+					_, err = db.Exec(helpers.RebindForFlavor("UPDATE tasks SET retry_count = 1, updated_at = ? WHERE guid = ?", dbDriverName), updatedAt, guid)
+					Expect(err).NotTo(HaveOccurred())
+				}
+
+				BeforeEach(func() {
+					backoffTaskDef = model_helpers.NewValidTaskDefinition()
+					backoffTaskDef.RetryPolicy = &models.TaskRetryPolicy{MaxAttempts: 2, BackoffMs: 60000}
+
+					retriedAgo("pending-backing-off-task", 40)
+					retriedAgo("pending-backed-off-task", 75)
+					retriedAgo("pending-backed-off-expired-task", 100)
+				})
+
+				It("does not expire a task still waiting out its backoff", func() {
+					task, err := sqlDB.TaskByGuid(logger, "pending-backing-off-task")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(task.State).To(Equal(models.Task_Pending))
+					Expect(task.Failed).To(BeFalse())
+
+					taskRequest := auctioneer.NewTaskStartRequestFromModel("pending-backing-off-task", domain, backoffTaskDef)
+					Expect(tasksToAuction).NotTo(ContainElement(&taskRequest))
+				})
+
+				It("kicks a task whose backoff ended within the pending expiry", func() {
+					task, err := sqlDB.TaskByGuid(logger, "pending-backed-off-task")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(task.State).To(Equal(models.Task_Pending))
+
+					taskRequest := auctioneer.NewTaskStartRequestFromModel("pending-backed-off-task", domain, backoffTaskDef)
+					Expect(tasksToAuction).To(ContainElement(&taskRequest))
+				})
+
+				It("expires a task whose backoff ended before the pending expiry", func() {
+					task, err := sqlDB.TaskByGuid(logger, "pending-backed-off-expired-task")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(task.State).To(Equal(models.Task_Completed))
+					Expect(task.Failed).To(BeTrue())
+					Expect(task.FailureReason).To(Equal("not started within time limit"))
+				})
+			})
 		})
 
 		Context("running tasks", func() {
@@ -238,6 +302,61 @@ var _ = Describe("Convergence of Tasks", func() {
 				Expect(task.Failed).NotTo(BeTrue())
 				Expect(task.State).To(Equal(models.Task_Running))
 			})
+
+			Context("when the task has a retry policy", func() {
+				var retriedTaskDef, backoffTaskDef *models.TaskDefinition
+
+				BeforeEach(func() {
+					retriedTaskDef = model_helpers.NewValidTaskDefinition()
+					retriedTaskDef.RetryPolicy = &models.TaskRetryPolicy{MaxAttempts: 2}
+					_, err := sqlDB.DesireTask(logger, retriedTaskDef, "running-retried-task-no-cell", domain)
+					Expect(err).NotTo(HaveOccurred())
+					_, _, _, err = sqlDB.StartTask(logger, "running-retried-task-no-cell", "non-existant-cell")
+					Expect(err).NotTo(HaveOccurred())
+
+					backoffTaskDef = model_helpers.NewValidTaskDefinition()
+					backoffTaskDef.RetryPolicy = &models.TaskRetryPolicy{MaxAttempts: 2, BackoffMs: 60000}
+					_, err = sqlDB.DesireTask(logger, backoffTaskDef, "running-backoff-task-no-cell", domain)
+					Expect(err).NotTo(HaveOccurred())
+					_, _, _, err = sqlDB.StartTask(logger, "running-backoff-task-no-cell", "non-existant-cell")
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("returns the task to pending and records the failure", func() {
+					task, err := sqlDB.TaskByGuid(logger, "running-retried-task-no-cell")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(task.State).To(Equal(models.Task_Pending))
+					Expect(task.Failed).To(BeFalse())
+					Expect(task.CellId).To(Equal(""))
+					Expect(task.RetryCount).To(BeEquivalentTo(1))
+					Expect(task.FailureHistory).To(HaveLen(1))
+					Expect(task.FailureHistory[0].FailureClass).To(Equal(models.TaskFailureClass_CellDisappeared))
+					Expect(task.FailureHistory[0].CellId).To(Equal("non-existant-cell"))
+				})
+
+				It("returns the retried task for auctioning", func() {
+					taskRequest := auctioneer.NewTaskStartRequestFromModel("running-retried-task-no-cell", domain, retriedTaskDef)
+					Expect(tasksToAuction).To(ContainElement(&taskRequest))
+				})
+
+				It("emits a TaskChangedEvent for the retried task", func() {
+					event := findTaskEvent(taskEvents, models.EventTypeTaskChanged, "running-retried-task-no-cell")
+					Expect(event).NotTo(BeNil())
+					changedEvent := event.(*models.TaskChangedEvent)
+					Expect(changedEvent.Before.State).To(Equal(models.Task_Running))
+					Expect(changedEvent.After.State).To(Equal(models.Task_Pending))
+					Expect(changedEvent.After.RetryCount).To(BeEquivalentTo(1))
+				})
+
+				It("does not auction a retried task until its backoff has elapsed", func() {
+					task, err := sqlDB.TaskByGuid(logger, "running-backoff-task-no-cell")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(task.State).To(Equal(models.Task_Pending))
+
+					taskRequest := auctioneer.NewTaskStartRequestFromModel("running-backoff-task-no-cell", domain, backoffTaskDef)
+					Expect(tasksToAuction).NotTo(ContainElement(&taskRequest))
+				})
+			})
 		})
 
 		Context("completed tasks", func() {
@@ -246,6 +365,12 @@ var _ = Describe("Convergence of Tasks", func() {
 				Expect(err).To(Equal(models.ErrResourceNotFound))
 			})
 
+			It("emits a TaskRemovedEvent for expired tasks", func() {
+				event := findTaskEvent(taskEvents, models.EventTypeTaskRemoved, "completed-expired-task")
+				Expect(event).NotTo(BeNil())
+				Expect(event.(*models.TaskRemovedEvent).Task.State).To(Equal(models.Task_Completed))
+			})
+
 			It("returns tasks that should be kicked for completion", func() {
 				task, err := sqlDB.TaskByGuid(logger, "completed-kickable-task")
 				Expect(err).NotTo(HaveOccurred())
@@ -258,9 +383,14 @@ var _ = Describe("Convergence of Tasks", func() {
 				Expect(tasksToComplete).NotTo(ContainElement(task))
 			})
 
-			It("delete tasks that should be kicked if they're invalid", func() {
+			It("quarantines tasks that should be kicked if they're invalid", func() {
 				_, err := sqlDB.TaskByGuid(logger, "completed-kickable-invalid-task")
 				Expect(err).To(Equal(models.ErrResourceNotFound))
+
+				records, err := sqlDB.QuarantinedRecords(logger, models.QuarantinedTasksTable)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(records).To(HaveLen(1))
+				Expect(records[0].Guid).To(Equal("completed-kickable-invalid-task"))
 			})
 		})
 
@@ -276,6 +406,14 @@ var _ = Describe("Convergence of Tasks", func() {
 				Expect(task.State).To(Equal(models.Task_Completed))
 			})
 
+			It("emits a TaskChangedEvent for tasks transitioned back to completed", func() {
+				event := findTaskEvent(taskEvents, models.EventTypeTaskChanged, "resolving-kickable-task")
+				Expect(event).NotTo(BeNil())
+				changedEvent := event.(*models.TaskChangedEvent)
+				Expect(changedEvent.Before.State).To(Equal(models.Task_Resolving))
+				Expect(changedEvent.After.State).To(Equal(models.Task_Completed))
+			})
+
 			It("returns tasks that should be kicked for completion", func() {
 				task, err := sqlDB.TaskByGuid(logger, "resolving-kickable-task")
 				Expect(err).NotTo(HaveOccurred())
@@ -305,3 +443,12 @@ var _ = Describe("Convergence of Tasks", func() {
 		})
 	})
 })
+
+func findTaskEvent(taskEvents []models.Event, eventType, taskGuid string) models.Event {
+	for _, event := range taskEvents {
+		if event.EventType() == eventType && event.Key() == taskGuid {
+			return event
+		}
+	}
+	return nil
+}
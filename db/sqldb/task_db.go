@@ -2,6 +2,7 @@ package sqldb
 
 import (
 	"database/sql"
+	"encoding/json"
 	"strings"
 
 	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
@@ -9,7 +10,7 @@ import (
 	"code.cloudfoundry.org/lager"
 )
 
-func (db *SQLDB) DesireTask(logger lager.Logger, taskDef *models.TaskDefinition, taskGuid, domain string) error {
+func (db *SQLDB) DesireTask(logger lager.Logger, taskDef *models.TaskDefinition, taskGuid, domain string) (*models.Task, error) {
 	logger = logger.Session("desire-task", lager.Data{"task_guid": taskGuid})
 	logger.Info("starting")
 	defer logger.Info("complete")
@@ -17,10 +18,11 @@ func (db *SQLDB) DesireTask(logger lager.Logger, taskDef *models.TaskDefinition,
 	taskDefData, err := db.serializeModel(logger, taskDef)
 	if err != nil {
 		logger.Error("failed-serializing-task-definition", err)
-		return err
+		return nil, err
 	}
 
-	return db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+	var task *models.Task
+	err = db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
 		now := db.clock.Now().UnixNano()
 
 		_, err = db.insert(logger, tx, tasksTable,
@@ -39,11 +41,27 @@ func (db *SQLDB) DesireTask(logger lager.Logger, taskDef *models.TaskDefinition,
 			return err
 		}
 
+		task = &models.Task{
+			TaskDefinition: taskDef,
+			TaskGuid:       taskGuid,
+			Domain:         domain,
+			State:          models.Task_Pending,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		}
+
 		return nil
 	})
+
+	return task, err
 }
 
 func (db *SQLDB) Tasks(logger lager.Logger, filter models.TaskFilter) ([]*models.Task, error) {
+	tasks, _, err := db.TasksPage(logger, filter)
+	return tasks, err
+}
+
+func (db *SQLDB) TasksPage(logger lager.Logger, filter models.TaskFilter) ([]*models.Task, string, error) {
 	logger = logger.Session("tasks", lager.Data{"filter": filter})
 	logger.Debug("starting")
 	defer logger.Debug("complete")
@@ -56,16 +74,27 @@ func (db *SQLDB) Tasks(logger lager.Logger, filter models.TaskFilter) ([]*models
 		values = append(values, filter.Domain)
 	}
 
+	if filter.AllowedDomains != nil {
+		where, bindings := whereDomainIn(filter.AllowedDomains)
+		wheres = append(wheres, where)
+		values = append(values, bindings...)
+	}
+
 	if filter.CellID != "" {
 		wheres = append(wheres, "cell_id = ?")
 		values = append(values, filter.CellID)
 	}
 
+	if filter.AfterTaskGuid != "" {
+		wheres = append(wheres, "guid > ?")
+		values = append(values, filter.AfterTaskGuid)
+	}
+
 	results := []*models.Task{}
 
 	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
-		rows, err := db.all(logger, tx, tasksTable,
-			taskColumns, helpers.NoLockRow,
+		rows, err := db.allOrdered(logger, tx, tasksTable,
+			taskColumns, "guid", filter.PageSize,
 			strings.Join(wheres, " AND "), values...,
 		)
 		if err != nil {
@@ -82,8 +111,19 @@ func (db *SQLDB) Tasks(logger lager.Logger, filter models.TaskFilter) ([]*models
 
 		return nil
 	})
+	if err != nil {
+		return results, "", err
+	}
+
+	// Listing fails on the first task that cannot be deserialized, so every
+	// row scanned is in results and the page is full only when the query
+	// reached its limit.
+	var nextPageToken string
+	if filter.PageSize > 0 && len(results) == filter.PageSize {
+		nextPageToken = filter.NextPageToken(results[len(results)-1].TaskGuid)
+	}
 
-	return results, err
+	return results, nextPageToken, nil
 }
 
 func (db *SQLDB) TaskByGuid(logger lager.Logger, taskGuid string) (*models.Task, error) {
@@ -107,24 +147,28 @@ func (db *SQLDB) TaskByGuid(logger lager.Logger, taskGuid string) (*models.Task,
 	return task, err
 }
 
-func (db *SQLDB) StartTask(logger lager.Logger, taskGuid, cellId string) (bool, error) {
+func (db *SQLDB) StartTask(logger lager.Logger, taskGuid, cellId string) (*models.Task, *models.Task, bool, error) {
 	logger = logger.Session("start-task", lager.Data{"task_guid": taskGuid, "cell_id": cellId})
 
 	var started bool
+	var beforeTask, afterTask *models.Task
 
 	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
-		task, err := db.fetchTaskForUpdate(logger, taskGuid, tx)
+		var err error
+		afterTask, err = db.fetchTaskForUpdate(logger, taskGuid, tx)
 		if err != nil {
 			logger.Error("failed-locking-task", err)
 			return err
 		}
 
-		if task.State == models.Task_Running && task.CellId == cellId {
+		beforeTask = afterTask.Copy()
+
+		if afterTask.State == models.Task_Running && afterTask.CellId == cellId {
 			logger.Debug("task-already-running-on-cell")
 			return nil
 		}
 
-		if err = task.ValidateTransitionTo(models.Task_Running); err != nil {
+		if err = afterTask.ValidateTransitionTo(models.Task_Running); err != nil {
 			logger.Error("failed-to-transition-task-to-running", err)
 			return err
 		}
@@ -144,117 +188,143 @@ func (db *SQLDB) StartTask(logger lager.Logger, taskGuid, cellId string) (bool,
 			return err
 		}
 
+		afterTask.State = models.Task_Running
+		afterTask.UpdatedAt = now
+		afterTask.CellId = cellId
+
 		started = true
 		return nil
 	})
 
-	return started, err
+	return beforeTask, afterTask, started, err
 }
 
-func (db *SQLDB) CancelTask(logger lager.Logger, taskGuid string) (*models.Task, string, error) {
+func (db *SQLDB) CancelTask(logger lager.Logger, taskGuid string) (*models.Task, *models.Task, string, error) {
 	logger = logger.Session("cancel-task", lager.Data{"task_guid": taskGuid})
 	logger.Info("starting")
 	defer logger.Info("complete")
 
-	var task *models.Task
+	var beforeTask, afterTask *models.Task
 	var cellID string
 
 	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
 		var err error
-		task, err = db.fetchTaskForUpdate(logger, taskGuid, tx)
+		afterTask, err = db.fetchTaskForUpdate(logger, taskGuid, tx)
 		if err != nil {
 			logger.Error("failed-locking-task", err)
 			return err
 		}
 
-		cellID = task.CellId
+		beforeTask = afterTask.Copy()
+		cellID = afterTask.CellId
 
-		if err = task.ValidateTransitionTo(models.Task_Completed); err != nil {
-			if task.State != models.Task_Pending {
+		if err = afterTask.ValidateTransitionTo(models.Task_Completed); err != nil {
+			if afterTask.State != models.Task_Pending {
 				logger.Error("failed-to-transition-task-to-completed", err)
 				return err
 			}
 		}
-		return db.completeTask(logger, task, true, "task was cancelled", "", tx)
+		return db.completeTask(logger, afterTask, true, "task was cancelled", "", tx)
 	})
 
-	return task, cellID, err
+	return beforeTask, afterTask, cellID, err
 }
 
-func (db *SQLDB) CompleteTask(logger lager.Logger, taskGuid, cellID string, failed bool, failureReason, taskResult string) (*models.Task, error) {
+func (db *SQLDB) CompleteTask(logger lager.Logger, taskGuid, cellID string, failed bool, failureReason, taskResult string) (*models.Task, *models.Task, error) {
 	logger = logger.Session("complete-task", lager.Data{"task_guid": taskGuid, "cell_id": cellID})
 	logger.Info("starting")
 	defer logger.Info("complete")
 
-	var task *models.Task
+	var beforeTask, afterTask *models.Task
 
 	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
 		var err error
-		task, err = db.fetchTaskForUpdate(logger, taskGuid, tx)
+		afterTask, err = db.fetchTaskForUpdate(logger, taskGuid, tx)
 		if err != nil {
 			logger.Error("failed-locking-task", err)
 			return err
 		}
 
-		if task.CellId != cellID && task.State == models.Task_Running {
+		beforeTask = afterTask.Copy()
+
+		if afterTask.CellId != cellID && afterTask.State == models.Task_Running {
 			logger.Error("failed-task-already-running-on-different-cell", err)
-			return models.NewRunningOnDifferentCellError(cellID, task.CellId)
+			return models.NewRunningOnDifferentCellError(cellID, afterTask.CellId)
 		}
 
-		if err = task.ValidateTransitionTo(models.Task_Completed); err != nil {
+		if err = afterTask.ValidateTransitionTo(models.Task_Completed); err != nil {
 			logger.Error("failed-to-transition-task-to-completed", err)
 			return err
 		}
 
-		return db.completeTask(logger, task, failed, failureReason, taskResult, tx)
+		if failed {
+			retried, err := db.failTaskAttempt(logger, afterTask, models.TaskFailureClass_ExitFailure, failureReason, tx)
+			if err != nil || retried {
+				return err
+			}
+		}
+
+		return db.completeTask(logger, afterTask, failed, failureReason, taskResult, tx)
 	})
 
-	return task, err
+	return beforeTask, afterTask, err
 }
 
-func (db *SQLDB) FailTask(logger lager.Logger, taskGuid, failureReason string) (*models.Task, error) {
+func (db *SQLDB) FailTask(logger lager.Logger, taskGuid, failureReason string) (*models.Task, *models.Task, error) {
 	logger = logger.Session("fail-task", lager.Data{"task_guid": taskGuid})
 	logger.Info("starting")
 	defer logger.Info("complete")
 
-	var task *models.Task
+	var beforeTask, afterTask *models.Task
 
 	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
 		var err error
-		task, err = db.fetchTaskForUpdate(logger, taskGuid, tx)
+		afterTask, err = db.fetchTaskForUpdate(logger, taskGuid, tx)
 		if err != nil {
 			logger.Error("failed-locking-task", err)
 			return err
 		}
 
-		if err = task.ValidateTransitionTo(models.Task_Completed); err != nil {
-			if task.State != models.Task_Pending {
+		beforeTask = afterTask.Copy()
+
+		if err = afterTask.ValidateTransitionTo(models.Task_Completed); err != nil {
+			if afterTask.State != models.Task_Pending {
 				logger.Error("failed-to-transition-task-to-completed", err)
 				return err
 			}
 		}
 
-		return db.completeTask(logger, task, true, failureReason, "", tx)
+		retried, err := db.failTaskAttempt(logger, afterTask, models.TaskFailureClass_PlacementFailure, failureReason, tx)
+		if err != nil || retried {
+			return err
+		}
+
+		return db.completeTask(logger, afterTask, true, failureReason, "", tx)
 	})
 
-	return task, err
+	return beforeTask, afterTask, err
 }
 
 // The stager calls this when it wants to claim a completed task.  This ensures that only one
 // stager ever attempts to handle a completed task
-func (db *SQLDB) ResolvingTask(logger lager.Logger, taskGuid string) error {
+func (db *SQLDB) ResolvingTask(logger lager.Logger, taskGuid string) (*models.Task, *models.Task, error) {
 	logger = logger.WithData(lager.Data{"task_guid": taskGuid})
 	logger.Info("starting")
 	defer logger.Info("complete")
 
-	return db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
-		task, err := db.fetchTaskForUpdate(logger, taskGuid, tx)
+	var beforeTask, afterTask *models.Task
+
+	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+		var err error
+		afterTask, err = db.fetchTaskForUpdate(logger, taskGuid, tx)
 		if err != nil {
 			logger.Error("failed-locking-task", err)
 			return err
 		}
 
-		if err = task.ValidateTransitionTo(models.Task_Resolving); err != nil {
+		beforeTask = afterTask.Copy()
+
+		if err = afterTask.ValidateTransitionTo(models.Task_Resolving); err != nil {
 			logger.Error("invalid-state-transition", err)
 			return err
 		}
@@ -272,17 +342,25 @@ func (db *SQLDB) ResolvingTask(logger lager.Logger, taskGuid string) error {
 			return err
 		}
 
+		afterTask.State = models.Task_Resolving
+		afterTask.UpdatedAt = now
+
 		return nil
 	})
+
+	return beforeTask, afterTask, err
 }
 
-func (db *SQLDB) DeleteTask(logger lager.Logger, taskGuid string) error {
+func (db *SQLDB) DeleteTask(logger lager.Logger, taskGuid string) (*models.Task, error) {
 	logger = logger.Session("delete-task", lager.Data{"task_guid": taskGuid})
 	logger.Info("starting")
 	defer logger.Info("complete")
 
-	return db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
-		task, err := db.fetchTaskForUpdate(logger, taskGuid, tx)
+	var task *models.Task
+
+	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+		var err error
+		task, err = db.fetchTaskForUpdate(logger, taskGuid, tx)
 		if err != nil {
 			logger.Error("failed-locking-task", err)
 			return err
@@ -302,6 +380,8 @@ func (db *SQLDB) DeleteTask(logger lager.Logger, taskGuid string) error {
 
 		return nil
 	})
+
+	return task, err
 }
 
 func (db *SQLDB) completeTask(logger lager.Logger, task *models.Task, failed bool, failureReason, result string, tx *sql.Tx) error {
@@ -334,6 +414,41 @@ func (db *SQLDB) completeTask(logger lager.Logger, task *models.Task, failed boo
 	return nil
 }
 
+// failTaskAttempt records a failed attempt of the task and, if its retry
+// policy allows it, returns the task to Pending. When it returns false the
+// caller is responsible for completing the task.
+func (db *SQLDB) failTaskAttempt(logger lager.Logger, task *models.Task, class models.TaskFailureClass, failureReason string, tx *sql.Tx) (bool, error) {
+	if task.GetRetryPolicy() == nil {
+		return false, nil
+	}
+
+	now := db.clock.Now().UnixNano()
+	retried := task.FailAttempt(class, failureReason, now)
+
+	failureHistoryData, err := json.Marshal(task.FailureHistory)
+	if err != nil {
+		logger.Error("failed-to-serialize-failure-history", err)
+		return false, err
+	}
+
+	attributes := helpers.SQLAttributes{"failure_history": failureHistoryData}
+	if retried {
+		logger.Info("retrying-task", lager.Data{"task_guid": task.TaskGuid, "retry_count": task.RetryCount, "failure_class": class})
+		attributes["state"] = models.Task_Pending
+		attributes["cell_id"] = ""
+		attributes["retry_count"] = task.RetryCount
+		attributes["updated_at"] = now
+	}
+
+	_, err = db.update(logger, tx, tasksTable, attributes, "guid = ?", task.TaskGuid)
+	if err != nil {
+		logger.Error("failed-updating-tasks", err)
+		return false, err
+	}
+
+	return retried, nil
+}
+
 func (db *SQLDB) fetchTaskForUpdate(logger lager.Logger, taskGuid string, queryable Queryable) (*models.Task, error) {
 	row := db.one(logger, queryable, tasksTable,
 		taskColumns, helpers.LockRow,
@@ -344,15 +459,15 @@ func (db *SQLDB) fetchTaskForUpdate(logger lager.Logger, taskGuid string, querya
 
 func (db *SQLDB) fetchTasks(logger lager.Logger, rows *sql.Rows, queryable Queryable, abortOnError bool) ([]*models.Task, int, error) {
 	tasks := []*models.Task{}
-	invalidGuids := []string{}
+	invalidRecords := []*invalidRecordError{}
 	var err error
 	for rows.Next() {
 		var task *models.Task
-		var guid string
 
-		task, guid, err = db.fetchTaskInternal(logger, rows)
-		if err == models.ErrDeserialize {
-			invalidGuids = append(invalidGuids, guid)
+		task, err = db.fetchTaskInternal(logger, rows)
+		if invalid, ok := err.(*invalidRecordError); ok {
+			invalidRecords = append(invalidRecords, invalid)
+			err = models.ErrDeserialize
 			if abortOnError {
 				break
 			}
@@ -367,28 +482,30 @@ func (db *SQLDB) fetchTasks(logger lager.Logger, rows *sql.Rows, queryable Query
 
 	rows.Close()
 
-	if len(invalidGuids) > 0 {
-		db.deleteInvalidTasks(logger, queryable, invalidGuids...)
+	if len(invalidRecords) > 0 {
+		db.quarantineInvalidTasks(logger, queryable, invalidRecords...)
 	}
 
-	return tasks, len(invalidGuids), err
+	return tasks, len(invalidRecords), err
 }
 
 func (db *SQLDB) fetchTask(logger lager.Logger, scanner RowScanner, queryable Queryable) (*models.Task, error) {
-	task, guid, err := db.fetchTaskInternal(logger, scanner)
-	if err == models.ErrDeserialize {
-		db.deleteInvalidTasks(logger, queryable, guid)
+	task, err := db.fetchTaskInternal(logger, scanner)
+	if invalid, ok := err.(*invalidRecordError); ok {
+		db.quarantineInvalidTasks(logger, queryable, invalid)
+		return nil, models.ErrDeserialize
 	}
 	return task, err
 }
 
-func (db *SQLDB) fetchTaskInternal(logger lager.Logger, scanner RowScanner) (*models.Task, string, error) {
+func (db *SQLDB) fetchTaskInternal(logger lager.Logger, scanner RowScanner) (*models.Task, error) {
 	var guid, domain, cellID, failureReason string
 	var result sql.NullString
 	var createdAt, updatedAt, firstCompletedAt int64
 	var state int32
 	var failed bool
-	var taskDefData []byte
+	var retryCount int32
+	var taskDefData, failureHistoryData []byte
 
 	err := scanner.Scan(
 		&guid,
@@ -402,21 +519,32 @@ func (db *SQLDB) fetchTaskInternal(logger lager.Logger, scanner RowScanner) (*mo
 		&failed,
 		&failureReason,
 		&taskDefData,
+		&retryCount,
+		&failureHistoryData,
 	)
 
 	if err == sql.ErrNoRows {
-		return nil, "", models.ErrResourceNotFound
+		return nil, models.ErrResourceNotFound
 	}
 
 	if err != nil {
 		logger.Error("failed-scanning-row", err)
-		return nil, "", err
+		return nil, err
 	}
 
 	var taskDef models.TaskDefinition
 	err = db.deserializeModel(logger, taskDefData, &taskDef)
 	if err != nil {
-		return nil, guid, models.ErrDeserialize
+		return nil, &invalidRecordError{guid: guid, column: "task_definition", err: err}
+	}
+
+	var failureHistory []*models.TaskAttemptFailure
+	if failureHistoryData != nil {
+		err = json.Unmarshal(failureHistoryData, &failureHistory)
+		if err != nil {
+			logger.Error("failed-parsing-failure-history", err)
+			return nil, &invalidRecordError{guid: guid, column: "failure_history", err: err}
+		}
 	}
 
 	task := &models.Task{
@@ -431,16 +559,17 @@ func (db *SQLDB) fetchTaskInternal(logger lager.Logger, scanner RowScanner) (*mo
 		Failed:           failed,
 		FailureReason:    failureReason,
 		TaskDefinition:   &taskDef,
+		RetryCount:       retryCount,
+		FailureHistory:   failureHistory,
 	}
-	return task, guid, nil
+	return task, nil
 }
 
-func (db *SQLDB) deleteInvalidTasks(logger lager.Logger, queryable Queryable, guids ...string) error {
-	for _, guid := range guids {
-		logger.Info("deleting-invalid-task-from-db", lager.Data{"guid": guid})
-		_, err := db.delete(logger, queryable, tasksTable, "guid = ?", guid)
+func (db *SQLDB) quarantineInvalidTasks(logger lager.Logger, queryable Queryable, invalidRecords ...*invalidRecordError) error {
+	for _, invalid := range invalidRecords {
+		err := db.quarantine(logger, queryable, tasksTable, invalid, "guid = ?", invalid.guid)
 		if err != nil {
-			logger.Error("failed-deleting-task", err)
+			logger.Error("failed-quarantining-task", err)
 			return err
 		}
 	}
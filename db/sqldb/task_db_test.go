@@ -16,13 +16,13 @@ var _ = Describe("TaskDB", func() {
 	Describe("DesireTask", func() {
 		var (
 			errDesire            error
-			task                 *models.Task
+			task, desiredTask    *models.Task
 			taskDef              *models.TaskDefinition
 			taskGuid, taskDomain string
 		)
 
 		JustBeforeEach(func() {
-			errDesire = sqlDB.DesireTask(logger, taskDef, taskGuid, taskDomain)
+			desiredTask, errDesire = sqlDB.DesireTask(logger, taskDef, taskGuid, taskDomain)
 		})
 
 		BeforeEach(func() {
@@ -83,12 +83,20 @@ var _ = Describe("TaskDB", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(actualTaskDef).To(Equal(*taskDef))
 			})
+
+			It("returns the desired task", func() {
+				Expect(errDesire).NotTo(HaveOccurred())
+
+				persistedTask, err := sqlDB.TaskByGuid(logger, taskGuid)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(desiredTask).To(Equal(persistedTask))
+			})
 		})
 
 		Context("when a task is already present with the desired task guid", func() {
 			BeforeEach(func() {
 				otherDomain := "my-other-domain"
-				err := sqlDB.DesireTask(logger, taskDef, taskGuid, otherDomain)
+				_, err := sqlDB.DesireTask(logger, taskDef, taskGuid, otherDomain)
 				Expect(err).NotTo(HaveOccurred())
 			})
 
@@ -155,6 +163,53 @@ var _ = Describe("TaskDB", func() {
 				Expect(tasks).To(HaveLen(1))
 				Expect(tasks[0]).To(Equal(expectedTasks[2]))
 			})
+
+			It("can restrict the tasks to the allowed domains before paging", func() {
+				filter := models.TaskFilter{PageSize: 2, AllowedDomains: []string{"domain-2"}}
+				tasks, nextPageToken, err := sqlDB.TasksPage(logger, filter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tasks).To(Equal(expectedTasks[1:]))
+				Expect(nextPageToken).To(Equal(filter.NextPageToken("c-guid")))
+			})
+
+			It("returns no tasks when no domains are allowed", func() {
+				tasks, err := sqlDB.Tasks(logger, models.TaskFilter{AllowedDomains: []string{}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tasks).To(BeEmpty())
+			})
+
+			It("returns the tasks ordered by guid", func() {
+				tasks, err := sqlDB.Tasks(logger, models.TaskFilter{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tasks).To(Equal(expectedTasks))
+			})
+
+			It("can limit the number of tasks returned", func() {
+				tasks, err := sqlDB.Tasks(logger, models.TaskFilter{PageSize: 2})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tasks).To(Equal(expectedTasks[:2]))
+			})
+
+			It("can return the tasks after a given guid", func() {
+				tasks, err := sqlDB.Tasks(logger, models.TaskFilter{PageSize: 2, AfterTaskGuid: "a-guid"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tasks).To(Equal(expectedTasks[1:]))
+			})
+
+			It("returns a token resuming after the last task on a full page", func() {
+				filter := models.TaskFilter{PageSize: 2}
+				tasks, nextPageToken, err := sqlDB.TasksPage(logger, filter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tasks).To(Equal(expectedTasks[:2]))
+				Expect(nextPageToken).To(Equal(filter.NextPageToken("b-guid")))
+			})
+
+			It("returns no token once the last page has been returned", func() {
+				tasks, nextPageToken, err := sqlDB.TasksPage(logger, models.TaskFilter{PageSize: 2, AfterTaskGuid: "b-guid"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tasks).To(Equal(expectedTasks[2:]))
+				Expect(nextPageToken).To(BeEmpty())
+			})
 		})
 
 		Context("when there are no tasks", func() {
@@ -176,6 +231,20 @@ var _ = Describe("TaskDB", func() {
 				_, err := sqlDB.Tasks(logger, models.TaskFilter{})
 				Expect(err).To(HaveOccurred())
 			})
+
+			It("quarantines the task", func() {
+				_, err := sqlDB.Tasks(logger, models.TaskFilter{})
+				Expect(err).To(Equal(models.ErrDeserialize))
+
+				records, err := sqlDB.QuarantinedRecords(logger, models.QuarantinedTasksTable)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(records).To(HaveLen(1))
+				Expect(records[0].Guid).To(Equal("a-guid"))
+
+				tasks, err := sqlDB.Tasks(logger, models.TaskFilter{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tasks).To(BeEmpty())
+			})
 		})
 	})
 
@@ -212,6 +281,20 @@ var _ = Describe("TaskDB", func() {
 				_, err := sqlDB.TaskByGuid(logger, "a-guid")
 				Expect(err).To(Equal(models.ErrDeserialize))
 			})
+
+			It("quarantines the task", func() {
+				_, err := sqlDB.TaskByGuid(logger, "a-guid")
+				Expect(err).To(Equal(models.ErrDeserialize))
+
+				records, err := sqlDB.QuarantinedRecords(logger, models.QuarantinedTasksTable)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(records).To(HaveLen(1))
+				Expect(records[0].Guid).To(Equal("a-guid"))
+				Expect(records[0].Reason).To(ContainSubstring("failed to deserialize task_definition"))
+
+				_, err = sqlDB.TaskByGuid(logger, "a-guid")
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+			})
 		})
 	})
 
@@ -222,7 +305,7 @@ var _ = Describe("TaskDB", func() {
 
 		BeforeEach(func() {
 			expectedTask = model_helpers.NewValidTask("task-guid")
-			err := sqlDB.DesireTask(logger, expectedTask.TaskDefinition, expectedTask.TaskGuid, expectedTask.Domain)
+			_, err := sqlDB.DesireTask(logger, expectedTask.TaskDefinition, expectedTask.TaskGuid, expectedTask.Domain)
 			Expect(err).NotTo(HaveOccurred())
 		})
 
@@ -237,12 +320,14 @@ var _ = Describe("TaskDB", func() {
 
 			expectedTask.CellId = "expectedCellId"
 
-			started, err := sqlDB.StartTask(logger, expectedTask.TaskGuid, expectedTask.CellId)
+			before, after, started, err := sqlDB.StartTask(logger, expectedTask.TaskGuid, expectedTask.CellId)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(started).To(BeTrue())
 
 			task, err := sqlDB.TaskByGuid(logger, expectedTask.TaskGuid)
 			Expect(err).NotTo(HaveOccurred())
+			Expect(before).To(Equal(beforeTask))
+			Expect(after).To(Equal(task))
 
 			Expect(task.TaskGuid).To(Equal(expectedTask.TaskGuid))
 			Expect(task.State).To(Equal(models.Task_Running))
@@ -253,7 +338,7 @@ var _ = Describe("TaskDB", func() {
 
 		Context("when the cell id is toooooo long", func() {
 			It("returns a BadRequest error", func() {
-				started, err := sqlDB.StartTask(logger, expectedTask.TaskGuid, randStr(256))
+				_, _, started, err := sqlDB.StartTask(logger, expectedTask.TaskGuid, randStr(256))
 				Expect(err).To(Equal(models.ErrBadRequest))
 				Expect(started).To(BeFalse())
 			})
@@ -261,7 +346,7 @@ var _ = Describe("TaskDB", func() {
 
 		Context("When starting a Task that is already started", func() {
 			BeforeEach(func() {
-				started, err := sqlDB.StartTask(logger, expectedTask.TaskGuid, "cell-id")
+				_, _, started, err := sqlDB.StartTask(logger, expectedTask.TaskGuid, "cell-id")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(started).To(BeTrue())
 			})
@@ -270,7 +355,7 @@ var _ = Describe("TaskDB", func() {
 				It("returns shouldStart as false", func() {
 					fakeClock.IncrementBySeconds(1)
 
-					changed, err := sqlDB.StartTask(logger, expectedTask.TaskGuid, "cell-id")
+					_, _, changed, err := sqlDB.StartTask(logger, expectedTask.TaskGuid, "cell-id")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(changed).To(BeFalse())
 
@@ -284,7 +369,7 @@ var _ = Describe("TaskDB", func() {
 				It("returns an error", func() {
 					fakeClock.IncrementBySeconds(1)
 
-					_, err := sqlDB.StartTask(logger, expectedTask.TaskGuid, "some-other-cell")
+					_, _, _, err := sqlDB.StartTask(logger, expectedTask.TaskGuid, "some-other-cell")
 					modelErr := models.ConvertError(err)
 					Expect(modelErr).NotTo(BeNil())
 					Expect(modelErr.Type).To(Equal(models.Error_InvalidStateTransition))
@@ -296,9 +381,29 @@ var _ = Describe("TaskDB", func() {
 			})
 		})
 
+		Context("when the task has invalid data", func() {
+			BeforeEach(func() {
+				insertTask(db, serializer, model_helpers.NewValidTask("invalid-task-guid"), true)
+			})
+
+			It("quarantines the task it locked", func() {
+				_, _, started, err := sqlDB.StartTask(logger, "invalid-task-guid", "cell-id")
+				Expect(err).To(Equal(models.ErrDeserialize))
+				Expect(started).To(BeFalse())
+
+				records, err := sqlDB.QuarantinedRecords(logger, models.QuarantinedTasksTable)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(records).To(HaveLen(1))
+				Expect(records[0].Guid).To(Equal("invalid-task-guid"))
+
+				_, err = sqlDB.TaskByGuid(logger, "invalid-task-guid")
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+
 		Context("when the task does not exist", func() {
 			It("returns an error", func() {
-				started, err := sqlDB.StartTask(logger, "invalid-guid", "cell-id")
+				_, _, started, err := sqlDB.StartTask(logger, "invalid-guid", "cell-id")
 				Expect(err).To(Equal(models.ErrResourceNotFound))
 				Expect(started).To(BeFalse())
 			})
@@ -313,7 +418,7 @@ var _ = Describe("TaskDB", func() {
 			})
 
 			It("returns an invalid state transition", func() {
-				started, err := sqlDB.StartTask(logger, "task-other-guid", "completed-guid")
+				_, _, started, err := sqlDB.StartTask(logger, "task-other-guid", "completed-guid")
 				modelErr := models.ConvertError(err)
 				Expect(modelErr).NotTo(BeNil())
 				Expect(modelErr.Type).To(Equal(models.Error_InvalidStateTransition))
@@ -340,7 +445,7 @@ var _ = Describe("TaskDB", func() {
 
 		Context("when the task is pending", func() {
 			BeforeEach(func() {
-				err := sqlDB.DesireTask(logger, taskDefinition, taskGuid, taskDomain)
+				_, err := sqlDB.DesireTask(logger, taskDefinition, taskGuid, taskDomain)
 				Expect(err).NotTo(HaveOccurred())
 			})
 
@@ -348,7 +453,7 @@ var _ = Describe("TaskDB", func() {
 				fakeClock.Increment(time.Second)
 				now := fakeClock.Now().UnixNano()
 
-				task, cellID, err := sqlDB.CancelTask(logger, taskGuid)
+				_, task, cellID, err := sqlDB.CancelTask(logger, taskGuid)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(task.State).To(Equal(models.Task_Completed))
@@ -366,7 +471,7 @@ var _ = Describe("TaskDB", func() {
 
 				BeforeEach(func() {
 					anotherTaskGuid := "the-other-task-guid"
-					err := sqlDB.DesireTask(logger, taskDefinition, anotherTaskGuid, taskDomain)
+					_, err := sqlDB.DesireTask(logger, taskDefinition, anotherTaskGuid, taskDomain)
 					Expect(err).NotTo(HaveOccurred())
 
 					anotherTask, err = sqlDB.TaskByGuid(logger, anotherTaskGuid)
@@ -377,7 +482,7 @@ var _ = Describe("TaskDB", func() {
 					fakeClock.Increment(time.Second)
 					now := fakeClock.Now().UnixNano()
 
-					task, _, err := sqlDB.CancelTask(logger, taskGuid)
+					_, task, _, err := sqlDB.CancelTask(logger, taskGuid)
 					Expect(err).NotTo(HaveOccurred())
 
 					Expect(task.State).To(Equal(models.Task_Completed))
@@ -392,10 +497,10 @@ var _ = Describe("TaskDB", func() {
 
 		Context("when the task is running", func() {
 			BeforeEach(func() {
-				err := sqlDB.DesireTask(logger, taskDefinition, taskGuid, taskDomain)
+				_, err := sqlDB.DesireTask(logger, taskDefinition, taskGuid, taskDomain)
 				Expect(err).NotTo(HaveOccurred())
 
-				started, err := sqlDB.StartTask(logger, taskGuid, "the-cell")
+				_, _, started, err := sqlDB.StartTask(logger, taskGuid, "the-cell")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(started).To(BeTrue())
 			})
@@ -404,7 +509,7 @@ var _ = Describe("TaskDB", func() {
 				fakeClock.Increment(time.Second)
 				now := fakeClock.Now().UnixNano()
 
-				task, cellID, err := sqlDB.CancelTask(logger, taskGuid)
+				_, task, cellID, err := sqlDB.CancelTask(logger, taskGuid)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(task.State).To(Equal(models.Task_Completed))
@@ -422,10 +527,10 @@ var _ = Describe("TaskDB", func() {
 			var beforeTask *models.Task
 
 			BeforeEach(func() {
-				err := sqlDB.DesireTask(logger, taskDefinition, taskGuid, taskDomain)
+				_, err := sqlDB.DesireTask(logger, taskDefinition, taskGuid, taskDomain)
 				Expect(err).NotTo(HaveOccurred())
 
-				_, _, err = sqlDB.CancelTask(logger, taskGuid)
+				_, _, _, err = sqlDB.CancelTask(logger, taskGuid)
 				Expect(err).NotTo(HaveOccurred())
 
 				beforeTask, err = sqlDB.TaskByGuid(logger, taskGuid)
@@ -433,7 +538,7 @@ var _ = Describe("TaskDB", func() {
 			})
 
 			It("returns an InvalidStateTransition error", func() {
-				_, _, err := sqlDB.CancelTask(logger, taskGuid)
+				_, _, _, err := sqlDB.CancelTask(logger, taskGuid)
 				modelErr := models.ConvertError(err)
 				Expect(modelErr).NotTo(BeNil())
 				Expect(modelErr.Type).To(Equal(models.Error_InvalidStateTransition))
@@ -454,7 +559,7 @@ var _ = Describe("TaskDB", func() {
 			})
 
 			It("returns an InvalidStateTransition error", func() {
-				_, _, err := sqlDB.CancelTask(logger, taskGuid)
+				_, _, _, err := sqlDB.CancelTask(logger, taskGuid)
 				modelErr := models.ConvertError(err)
 				Expect(modelErr).NotTo(BeNil())
 				Expect(modelErr.Type).To(Equal(models.Error_InvalidStateTransition))
@@ -467,7 +572,7 @@ var _ = Describe("TaskDB", func() {
 
 		Context("when the task does not exist", func() {
 			It("returns an InvalidStateTransition error", func() {
-				_, _, err := sqlDB.CancelTask(logger, taskGuid)
+				_, _, _, err := sqlDB.CancelTask(logger, taskGuid)
 				Expect(err).To(Equal(models.ErrResourceNotFound))
 			})
 		})
@@ -496,10 +601,10 @@ var _ = Describe("TaskDB", func() {
 
 			Context("when the task is running", func() {
 				BeforeEach(func() {
-					err := sqlDB.DesireTask(logger, taskDefinition, taskGuid, taskDomain)
+					_, err := sqlDB.DesireTask(logger, taskDefinition, taskGuid, taskDomain)
 					Expect(err).NotTo(HaveOccurred())
 
-					started, err := sqlDB.StartTask(logger, taskGuid, cellID)
+					_, _, started, err := sqlDB.StartTask(logger, taskGuid, cellID)
 					Expect(err).NotTo(HaveOccurred())
 					Expect(started).To(BeTrue())
 				})
@@ -510,7 +615,7 @@ var _ = Describe("TaskDB", func() {
 						nowTruncateMicroseconds := fakeClock.Now()
 						now := fakeClock.Now()
 
-						task, err := sqlDB.CompleteTask(logger, taskGuid, cellID, true, "it blew up", "i am the result")
+						_, task, err := sqlDB.CompleteTask(logger, taskGuid, cellID, true, "it blew up", "i am the result")
 						Expect(err).NotTo(HaveOccurred())
 
 						Expect(task.State).To(Equal(models.Task_Completed))
@@ -535,7 +640,7 @@ var _ = Describe("TaskDB", func() {
 
 					Context("with an invalid failure reason", func() {
 						It("returns an error and does not update the record", func() {
-							_, err := sqlDB.CompleteTask(logger, taskGuid, cellID, true, randStr(256), "i am the result")
+							_, _, err := sqlDB.CompleteTask(logger, taskGuid, cellID, true, randStr(256), "i am the result")
 							Expect(err).To(Equal(models.ErrBadRequest))
 						})
 					})
@@ -545,10 +650,10 @@ var _ = Describe("TaskDB", func() {
 
 						BeforeEach(func() {
 							anotherTaskGuid := "another-task-guid"
-							err := sqlDB.DesireTask(logger, taskDefinition, anotherTaskGuid, taskDomain)
+							_, err := sqlDB.DesireTask(logger, taskDefinition, anotherTaskGuid, taskDomain)
 							Expect(err).NotTo(HaveOccurred())
 
-							started, err := sqlDB.StartTask(logger, anotherTaskGuid, cellID)
+							_, _, started, err := sqlDB.StartTask(logger, anotherTaskGuid, cellID)
 							Expect(err).NotTo(HaveOccurred())
 							Expect(started).To(BeTrue())
 
@@ -557,7 +662,7 @@ var _ = Describe("TaskDB", func() {
 						})
 
 						It("only updates the task with the corresponding guid", func() {
-							_, err := sqlDB.CompleteTask(logger, taskGuid, cellID, true, "it blew up", "i am the result")
+							_, _, err := sqlDB.CompleteTask(logger, taskGuid, cellID, true, "it blew up", "i am the result")
 							Expect(err).NotTo(HaveOccurred())
 
 							task, err := sqlDB.TaskByGuid(logger, anotherTask.TaskGuid)
@@ -569,7 +674,7 @@ var _ = Describe("TaskDB", func() {
 
 				Context("on a different cell", func() {
 					It("errors and does not change the task", func() {
-						_, err := sqlDB.CompleteTask(logger, taskGuid, "a-different-cell", true, "it blue up", "i am the result")
+						_, _, err := sqlDB.CompleteTask(logger, taskGuid, "a-different-cell", true, "it blue up", "i am the result")
 						modelErr := models.ConvertError(err)
 						Expect(modelErr).NotTo(BeNil())
 						Expect(modelErr.Type).To(Equal(models.Error_RunningOnDifferentCell))
@@ -582,6 +687,73 @@ var _ = Describe("TaskDB", func() {
 				})
 			})
 
+			Context("when the task is running and has a retry policy", func() {
+				BeforeEach(func() {
+					taskDefinition.RetryPolicy = &models.TaskRetryPolicy{MaxAttempts: 2}
+					_, err := sqlDB.DesireTask(logger, taskDefinition, taskGuid, taskDomain)
+					Expect(err).NotTo(HaveOccurred())
+
+					_, _, started, err := sqlDB.StartTask(logger, taskGuid, cellID)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(started).To(BeTrue())
+				})
+
+				It("returns the failed task to pending and records the failure", func() {
+					fakeClock.Increment(time.Second)
+					now := fakeClock.Now().UnixNano()
+
+					_, task, err := sqlDB.CompleteTask(logger, taskGuid, cellID, true, "it blew up", "i am the result")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(task.State).To(Equal(models.Task_Pending))
+
+					task, err = sqlDB.TaskByGuid(logger, taskGuid)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(task.State).To(Equal(models.Task_Pending))
+					Expect(task.CellId).To(Equal(""))
+					Expect(task.UpdatedAt).To(Equal(now))
+					Expect(task.RetryCount).To(BeEquivalentTo(1))
+					Expect(task.FailureHistory).To(ConsistOf(&models.TaskAttemptFailure{
+						Attempt:       1,
+						FailureClass:  models.TaskFailureClass_ExitFailure,
+						FailureReason: "it blew up",
+						CellId:        cellID,
+						FailedAt:      now,
+					}))
+				})
+
+				It("completes the task once it succeeds", func() {
+					_, task, err := sqlDB.CompleteTask(logger, taskGuid, cellID, false, "", "i am the result")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(task.State).To(Equal(models.Task_Completed))
+					Expect(task.RetryCount).To(BeEquivalentTo(0))
+				})
+
+				Context("when the task has exhausted its attempts", func() {
+					BeforeEach(func() {
+						_, _, err := sqlDB.CompleteTask(logger, taskGuid, cellID, true, "it blew up", "")
+						Expect(err).NotTo(HaveOccurred())
+
+						_, _, started, err := sqlDB.StartTask(logger, taskGuid, cellID)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(started).To(BeTrue())
+					})
+
+					It("completes the task as failed and keeps the failure history", func() {
+						_, task, err := sqlDB.CompleteTask(logger, taskGuid, cellID, true, "it blew up again", "")
+						Expect(err).NotTo(HaveOccurred())
+						Expect(task.State).To(Equal(models.Task_Completed))
+						Expect(task.Failed).To(BeTrue())
+
+						task, err = sqlDB.TaskByGuid(logger, taskGuid)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(task.State).To(Equal(models.Task_Completed))
+						Expect(task.RetryCount).To(BeEquivalentTo(1))
+						Expect(task.FailureHistory).To(HaveLen(2))
+						Expect(task.FailureHistory[1].FailureReason).To(Equal("it blew up again"))
+					})
+				})
+			})
+
 			Context("when the task is not running", func() {
 				BeforeEach(func() {
 					task := model_helpers.NewValidTask(taskGuid)
@@ -591,7 +763,7 @@ var _ = Describe("TaskDB", func() {
 				})
 
 				It("errors and does not change the task", func() {
-					_, err := sqlDB.CompleteTask(logger, taskGuid, cellID, true, "it blue up", "i am the result")
+					_, _, err := sqlDB.CompleteTask(logger, taskGuid, cellID, true, "it blue up", "i am the result")
 					modelErr := models.ConvertError(err)
 					Expect(modelErr).NotTo(BeNil())
 					Expect(modelErr.Type).To(Equal(models.Error_InvalidStateTransition))
@@ -605,7 +777,7 @@ var _ = Describe("TaskDB", func() {
 
 		Context("when the task does not exist", func() {
 			It("errors", func() {
-				_, err := sqlDB.CompleteTask(logger, "task-not-here", "a-different-cell", true, "it blue up", "i am the result")
+				_, _, err := sqlDB.CompleteTask(logger, "task-not-here", "a-different-cell", true, "it blue up", "i am the result")
 				Expect(err).To(Equal(models.ErrResourceNotFound))
 			})
 		})
@@ -624,7 +796,7 @@ var _ = Describe("TaskDB", func() {
 				taskDefinition = model_helpers.NewValidTaskDefinition()
 				failureReason = "I failed."
 
-				err := sqlDB.DesireTask(logger, taskDefinition, taskGuid, taskDomain)
+				_, err := sqlDB.DesireTask(logger, taskDefinition, taskGuid, taskDomain)
 				Expect(err).NotTo(HaveOccurred())
 			})
 
@@ -634,7 +806,7 @@ var _ = Describe("TaskDB", func() {
 					nowTruncateMicroseconds := fakeClock.Now()
 					now := fakeClock.Now()
 
-					task, err := sqlDB.FailTask(logger, taskGuid, failureReason)
+					_, task, err := sqlDB.FailTask(logger, taskGuid, failureReason)
 					Expect(err).NotTo(HaveOccurred())
 
 					Expect(task.State).To(Equal(models.Task_Completed))
@@ -661,7 +833,7 @@ var _ = Describe("TaskDB", func() {
 					var anotherTask *models.Task
 					BeforeEach(func() {
 						anotherTaskGuid := "another-task-guid"
-						err := sqlDB.DesireTask(logger, taskDefinition, anotherTaskGuid, taskDomain)
+						_, err := sqlDB.DesireTask(logger, taskDefinition, anotherTaskGuid, taskDomain)
 						Expect(err).NotTo(HaveOccurred())
 
 						anotherTask, err = sqlDB.TaskByGuid(logger, anotherTaskGuid)
@@ -669,7 +841,7 @@ var _ = Describe("TaskDB", func() {
 					})
 
 					It("updates only the task with the corresponding guid", func() {
-						_, err := sqlDB.FailTask(logger, taskGuid, failureReason)
+						_, _, err := sqlDB.FailTask(logger, taskGuid, failureReason)
 						Expect(err).NotTo(HaveOccurred())
 
 						task, err := sqlDB.TaskByGuid(logger, anotherTask.TaskGuid)
@@ -680,7 +852,7 @@ var _ = Describe("TaskDB", func() {
 
 				Context("with an invalid failure reason", func() {
 					It("returns an error and does not update the record", func() {
-						_, err := sqlDB.FailTask(logger, taskGuid, randStr(256))
+						_, _, err := sqlDB.FailTask(logger, taskGuid, randStr(256))
 						Expect(err).To(Equal(models.ErrBadRequest))
 					})
 				})
@@ -689,7 +861,7 @@ var _ = Describe("TaskDB", func() {
 			Context("when the task is running", func() {
 				BeforeEach(func() {
 					cellID = "the-cell-id"
-					started, err := sqlDB.StartTask(logger, taskGuid, cellID)
+					_, _, started, err := sqlDB.StartTask(logger, taskGuid, cellID)
 					Expect(err).NotTo(HaveOccurred())
 					Expect(started).To(BeTrue())
 				})
@@ -701,7 +873,7 @@ var _ = Describe("TaskDB", func() {
 
 					failureReason := "I failed."
 
-					task, err := sqlDB.FailTask(logger, taskGuid, failureReason)
+					_, task, err := sqlDB.FailTask(logger, taskGuid, failureReason)
 					Expect(err).NotTo(HaveOccurred())
 
 					Expect(task.State).To(Equal(models.Task_Completed))
@@ -725,16 +897,57 @@ var _ = Describe("TaskDB", func() {
 				})
 			})
 
+			Context("when the task has a retry policy", func() {
+				var retriedTaskGuid string
+
+				BeforeEach(func() {
+					retriedTaskGuid = "retried-task-guid"
+					taskDefinition.RetryPolicy = &models.TaskRetryPolicy{
+						MaxAttempts:       3,
+						RetryableFailures: []models.TaskFailureClass{models.TaskFailureClass_PlacementFailure},
+					}
+					_, err := sqlDB.DesireTask(logger, taskDefinition, retriedTaskGuid, taskDomain)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("leaves the task pending and records the placement failure", func() {
+					_, task, err := sqlDB.FailTask(logger, retriedTaskGuid, failureReason)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(task.State).To(Equal(models.Task_Pending))
+
+					task, err = sqlDB.TaskByGuid(logger, retriedTaskGuid)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(task.State).To(Equal(models.Task_Pending))
+					Expect(task.Failed).To(BeFalse())
+					Expect(task.RetryCount).To(BeEquivalentTo(1))
+					Expect(task.FailureHistory).To(HaveLen(1))
+					Expect(task.FailureHistory[0].FailureClass).To(Equal(models.TaskFailureClass_PlacementFailure))
+					Expect(task.FailureHistory[0].FailureReason).To(Equal(failureReason))
+				})
+
+				It("does not retry failures that are not retryable", func() {
+					_, _, started, err := sqlDB.StartTask(logger, retriedTaskGuid, "the-cell-id")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(started).To(BeTrue())
+
+					_, task, err := sqlDB.CompleteTask(logger, retriedTaskGuid, "the-cell-id", true, "exited", "")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(task.State).To(Equal(models.Task_Completed))
+					Expect(task.FailureHistory).To(HaveLen(1))
+					Expect(task.FailureHistory[0].FailureClass).To(Equal(models.TaskFailureClass_ExitFailure))
+				})
+			})
+
 			Context("when the task is completed", func() {
 				var beforeTask *models.Task
 
 				BeforeEach(func() {
 					cellID = "the-cell-id"
-					started, err := sqlDB.StartTask(logger, taskGuid, cellID)
+					_, _, started, err := sqlDB.StartTask(logger, taskGuid, cellID)
 					Expect(err).NotTo(HaveOccurred())
 					Expect(started).To(BeTrue())
 
-					_, err = sqlDB.CompleteTask(logger, taskGuid, cellID, false, "", "I am the result.")
+					_, _, err = sqlDB.CompleteTask(logger, taskGuid, cellID, false, "", "I am the result.")
 					Expect(err).NotTo(HaveOccurred())
 
 					beforeTask, err = sqlDB.TaskByGuid(logger, taskGuid)
@@ -742,7 +955,7 @@ var _ = Describe("TaskDB", func() {
 				})
 
 				It("returns an InvalidStateTransition error", func() {
-					_, err := sqlDB.FailTask(logger, taskGuid, failureReason)
+					_, _, err := sqlDB.FailTask(logger, taskGuid, failureReason)
 					modelErr := models.ConvertError(err)
 					Expect(modelErr).NotTo(BeNil())
 					Expect(modelErr.Type).To(Equal(models.Error_InvalidStateTransition))
@@ -769,7 +982,7 @@ var _ = Describe("TaskDB", func() {
 				})
 
 				It("returns an InvalidStateTransition error", func() {
-					_, err := sqlDB.FailTask(logger, taskGuid, failureReason)
+					_, _, err := sqlDB.FailTask(logger, taskGuid, failureReason)
 					modelErr := models.ConvertError(err)
 					Expect(modelErr).NotTo(BeNil())
 					Expect(modelErr.Type).To(Equal(models.Error_InvalidStateTransition))
@@ -783,7 +996,7 @@ var _ = Describe("TaskDB", func() {
 
 		Context("when the task does not exist", func() {
 			It("returns an ResourceNotFound error", func() {
-				_, err := sqlDB.FailTask(logger, "", "")
+				_, _, err := sqlDB.FailTask(logger, "", "")
 				Expect(err).To(Equal(models.ErrResourceNotFound))
 			})
 		})
@@ -807,17 +1020,17 @@ var _ = Describe("TaskDB", func() {
 				cellID = "the-cell-id"
 				taskDefinition = model_helpers.NewValidTaskDefinition()
 
-				err := sqlDB.DesireTask(logger, taskDefinition, taskGuid, taskDomain)
+				_, err := sqlDB.DesireTask(logger, taskDefinition, taskGuid, taskDomain)
 				Expect(err).NotTo(HaveOccurred())
 
-				started, err := sqlDB.StartTask(logger, taskGuid, cellID)
+				_, _, started, err := sqlDB.StartTask(logger, taskGuid, cellID)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(started).To(BeTrue())
 			})
 
 			Context("when the task is completed", func() {
 				BeforeEach(func() {
-					_, err := sqlDB.CompleteTask(logger, taskGuid, cellID, false, "", "some-result")
+					_, _, err := sqlDB.CompleteTask(logger, taskGuid, cellID, false, "", "some-result")
 					Expect(err).NotTo(HaveOccurred())
 				})
 
@@ -825,12 +1038,14 @@ var _ = Describe("TaskDB", func() {
 					fakeClock.Increment(time.Second)
 					nowTruncateMicroseconds := fakeClock.Now()
 
-					err := sqlDB.ResolvingTask(logger, taskGuid)
+					before, after, err := sqlDB.ResolvingTask(logger, taskGuid)
 					Expect(err).NotTo(HaveOccurred())
 
 					task, err := sqlDB.TaskByGuid(logger, taskGuid)
 					Expect(err).NotTo(HaveOccurred())
 
+					Expect(before.State).To(Equal(models.Task_Completed))
+					Expect(after).To(Equal(task))
 					Expect(task.State).To(Equal(models.Task_Resolving))
 					Expect(task.UpdatedAt).To(Equal(nowTruncateMicroseconds.UnixNano()))
 				})
@@ -840,14 +1055,14 @@ var _ = Describe("TaskDB", func() {
 
 					BeforeEach(func() {
 						anotherTaskGuid := "another-guid"
-						err := sqlDB.DesireTask(logger, taskDefinition, anotherTaskGuid, taskDomain)
+						_, err := sqlDB.DesireTask(logger, taskDefinition, anotherTaskGuid, taskDomain)
 						Expect(err).NotTo(HaveOccurred())
 
-						started, err := sqlDB.StartTask(logger, anotherTaskGuid, cellID)
+						_, _, started, err := sqlDB.StartTask(logger, anotherTaskGuid, cellID)
 						Expect(err).NotTo(HaveOccurred())
 						Expect(started).To(BeTrue())
 
-						_, err = sqlDB.CompleteTask(logger, anotherTaskGuid, cellID, false, "", "some-result")
+						_, _, err = sqlDB.CompleteTask(logger, anotherTaskGuid, cellID, false, "", "some-result")
 						Expect(err).NotTo(HaveOccurred())
 
 						anotherTask, err = sqlDB.TaskByGuid(logger, anotherTaskGuid)
@@ -855,7 +1070,7 @@ var _ = Describe("TaskDB", func() {
 					})
 
 					It("should only update the task with the corresponding guid", func() {
-						err := sqlDB.ResolvingTask(logger, taskGuid)
+						_, _, err := sqlDB.ResolvingTask(logger, taskGuid)
 						Expect(err).NotTo(HaveOccurred())
 
 						task, err := sqlDB.TaskByGuid(logger, anotherTask.TaskGuid)
@@ -876,7 +1091,7 @@ var _ = Describe("TaskDB", func() {
 				})
 
 				It("errors and does not change the task", func() {
-					err := sqlDB.ResolvingTask(logger, taskGuid)
+					_, _, err := sqlDB.ResolvingTask(logger, taskGuid)
 					modelErr := models.ConvertError(err)
 					Expect(modelErr).NotTo(BeNil())
 					Expect(modelErr.Type).To(Equal(models.Error_InvalidStateTransition))
@@ -891,10 +1106,10 @@ var _ = Describe("TaskDB", func() {
 				var taskBefore *models.Task
 
 				BeforeEach(func() {
-					_, err := sqlDB.CompleteTask(logger, taskGuid, cellID, false, "", "some-result")
+					_, _, err := sqlDB.CompleteTask(logger, taskGuid, cellID, false, "", "some-result")
 					Expect(err).NotTo(HaveOccurred())
 
-					err = sqlDB.ResolvingTask(logger, taskGuid)
+					_, _, err = sqlDB.ResolvingTask(logger, taskGuid)
 					Expect(err).NotTo(HaveOccurred())
 
 					taskBefore, err = sqlDB.TaskByGuid(logger, taskGuid)
@@ -902,7 +1117,7 @@ var _ = Describe("TaskDB", func() {
 				})
 
 				It("errors and does not change the task", func() {
-					err := sqlDB.ResolvingTask(logger, taskGuid)
+					_, _, err := sqlDB.ResolvingTask(logger, taskGuid)
 					modelErr := models.ConvertError(err)
 					Expect(modelErr).NotTo(BeNil())
 					Expect(modelErr.Type).To(Equal(models.Error_InvalidStateTransition))
@@ -916,7 +1131,7 @@ var _ = Describe("TaskDB", func() {
 
 		Context("when the task does not exist", func() {
 			It("returns a ResourceNotFound error", func() {
-				err := sqlDB.ResolvingTask(logger, taskGuid)
+				_, _, err := sqlDB.ResolvingTask(logger, taskGuid)
 				Expect(err).To(Equal(models.ErrResourceNotFound))
 			})
 		})
@@ -940,26 +1155,30 @@ var _ = Describe("TaskDB", func() {
 				cellID = "the-cell-id"
 				taskDefinition = model_helpers.NewValidTaskDefinition()
 
-				err := sqlDB.DesireTask(logger, taskDefinition, taskGuid, taskDomain)
+				_, err := sqlDB.DesireTask(logger, taskDefinition, taskGuid, taskDomain)
 				Expect(err).NotTo(HaveOccurred())
 
-				started, err := sqlDB.StartTask(logger, taskGuid, cellID)
+				_, _, started, err := sqlDB.StartTask(logger, taskGuid, cellID)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(started).To(BeTrue())
 
-				_, err = sqlDB.CompleteTask(logger, taskGuid, cellID, false, "", "some-result")
+				_, _, err = sqlDB.CompleteTask(logger, taskGuid, cellID, false, "", "some-result")
 				Expect(err).NotTo(HaveOccurred())
 			})
 
 			Context("and the task is resolving", func() {
 				BeforeEach(func() {
-					err := sqlDB.ResolvingTask(logger, taskGuid)
+					_, _, err := sqlDB.ResolvingTask(logger, taskGuid)
 					Expect(err).NotTo(HaveOccurred())
 				})
 
 				It("removes the task from the database", func() {
-					err := sqlDB.DeleteTask(logger, taskGuid)
+					expectedTask, err := sqlDB.TaskByGuid(logger, taskGuid)
+					Expect(err).NotTo(HaveOccurred())
+
+					task, err := sqlDB.DeleteTask(logger, taskGuid)
 					Expect(err).NotTo(HaveOccurred())
+					Expect(task).To(Equal(expectedTask))
 
 					_, err = sqlDB.TaskByGuid(logger, taskGuid)
 					Expect(err).To(Equal(models.ErrResourceNotFound))
@@ -971,17 +1190,17 @@ var _ = Describe("TaskDB", func() {
 					BeforeEach(func() {
 						anotherTaskGuid := "another-guid"
 
-						err := sqlDB.DesireTask(logger, taskDefinition, anotherTaskGuid, taskDomain)
+						_, err := sqlDB.DesireTask(logger, taskDefinition, anotherTaskGuid, taskDomain)
 						Expect(err).NotTo(HaveOccurred())
 
-						started, err := sqlDB.StartTask(logger, anotherTaskGuid, cellID)
+						_, _, started, err := sqlDB.StartTask(logger, anotherTaskGuid, cellID)
 						Expect(err).NotTo(HaveOccurred())
 						Expect(started).To(BeTrue())
 
-						_, err = sqlDB.CompleteTask(logger, anotherTaskGuid, cellID, false, "", "some-result")
+						_, _, err = sqlDB.CompleteTask(logger, anotherTaskGuid, cellID, false, "", "some-result")
 						Expect(err).NotTo(HaveOccurred())
 
-						err = sqlDB.ResolvingTask(logger, anotherTaskGuid)
+						_, _, err = sqlDB.ResolvingTask(logger, anotherTaskGuid)
 						Expect(err).NotTo(HaveOccurred())
 
 						anotherTask, err = sqlDB.TaskByGuid(logger, anotherTaskGuid)
@@ -989,7 +1208,7 @@ var _ = Describe("TaskDB", func() {
 					})
 
 					It("only removes the task with the corresponding guid", func() {
-						err := sqlDB.DeleteTask(logger, taskGuid)
+						_, err := sqlDB.DeleteTask(logger, taskGuid)
 						Expect(err).NotTo(HaveOccurred())
 
 						task, err := sqlDB.TaskByGuid(logger, anotherTask.TaskGuid)
@@ -1001,7 +1220,7 @@ var _ = Describe("TaskDB", func() {
 
 			Context("and the task is not resolving", func() {
 				It("returns an error", func() {
-					err := sqlDB.DeleteTask(logger, taskGuid)
+					_, err := sqlDB.DeleteTask(logger, taskGuid)
 					expectedErr := models.NewTaskTransitionError(models.Task_Completed, models.Task_Resolving)
 					Expect(err).To(Equal(expectedErr))
 				})
@@ -1010,7 +1229,7 @@ var _ = Describe("TaskDB", func() {
 
 		Context("when the task does not exist", func() {
 			It("returns a ResourceNotFound error", func() {
-				err := sqlDB.DeleteTask(logger, taskGuid)
+				_, err := sqlDB.DeleteTask(logger, taskGuid)
 				Expect(err).To(Equal(models.ErrResourceNotFound))
 			})
 		})
@@ -1,46 +1,51 @@
 package encryption
 
-import "errors"
+import "net/http"
 
 type EncryptionConfig struct {
-	ActiveKeyLabel string            `json:"active_key_label"`
-	EncryptionKeys map[string]string `json:"encryption_keys"`
+	ActiveKeyLabel         string            `json:"active_key_label"`
+	EncryptionKeys         map[string]string `json:"encryption_keys"`
+	EncryptionKeyDirectory string            `json:"encryption_key_directory,omitempty"`
+	EncryptionKeyEnv       map[string]string `json:"encryption_key_env,omitempty"`
+	EncryptionKMS          KMSConfig         `json:"encryption_kms"`
+}
+
+// KMSConfig configures keys wrapped by a master key held in a KMS. WrappedKeys
+// maps the key labels to the base64 encoded wrapped data keys.
+type KMSConfig struct {
+	URL         string            `json:"url,omitempty"`
+	MasterKeyID string            `json:"master_key_id,omitempty"`
+	WrappedKeys map[string]string `json:"wrapped_keys,omitempty"`
 }
 
 func DefaultEncryptionConfig() EncryptionConfig {
 	return EncryptionConfig{}
 }
 
-func (ef *EncryptionConfig) Parse() (Key, []Key, error) {
-	if len(ef.EncryptionKeys) == 0 {
-		return nil, nil, errors.New("Must have at least one encryption key set")
-	}
+// KeyProviders returns a provider for each source of keys configured, using
+// client to reach the KMS.
+func (ef *EncryptionConfig) KeyProviders(client *http.Client) []KeyProvider {
+	providers := []KeyProvider{}
 
-	if len(ef.ActiveKeyLabel) == 0 {
-		return nil, nil, errors.New("Must select an active encryption key")
+	if len(ef.EncryptionKeys) > 0 {
+		providers = append(providers, NewPhraseKeyProvider(ef.EncryptionKeys))
 	}
 
-	var encryptionKey Key
-
-	labelsToKeys := map[string]Key{}
-
-	for label, phrase := range ef.EncryptionKeys {
-		key, err := NewKey(label, phrase)
-		if err != nil {
-			return nil, nil, err
-		}
-		labelsToKeys[label] = key
+	if ef.EncryptionKeyDirectory != "" {
+		providers = append(providers, NewFileKeyProvider(ef.EncryptionKeyDirectory))
 	}
 
-	encryptionKey, ok := labelsToKeys[ef.ActiveKeyLabel]
-	if !ok {
-		return nil, nil, errors.New("The selected active key must be listed on the encryption keys flag")
+	if len(ef.EncryptionKeyEnv) > 0 {
+		providers = append(providers, NewEnvKeyProvider(ef.EncryptionKeyEnv))
 	}
 
-	keys := []Key{}
-	for _, v := range labelsToKeys {
-		keys = append(keys, v)
+	if len(ef.EncryptionKMS.WrappedKeys) > 0 {
+		providers = append(providers, NewKMSKeyProvider(client, ef.EncryptionKMS.URL, ef.EncryptionKMS.MasterKeyID, ef.EncryptionKMS.WrappedKeys))
 	}
 
-	return encryptionKey, keys, nil
+	return providers
+}
+
+func (ef *EncryptionConfig) Parse() (Key, []Key, error) {
+	return LoadKeys(ef.ActiveKeyLabel, ef.KeyProviders(http.DefaultClient)...)
 }
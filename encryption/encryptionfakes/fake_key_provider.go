@@ -0,0 +1,68 @@
+// This file was generated by counterfeiter
+package encryptionfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/bbs/encryption"
+)
+
+type FakeKeyProvider struct {
+	KeysStub        func() ([]encryption.Key, error)
+	keysMutex       sync.RWMutex
+	keysArgsForCall []struct{}
+	keysReturns     struct {
+		result1 []encryption.Key
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeKeyProvider) Keys() ([]encryption.Key, error) {
+	fake.keysMutex.Lock()
+	fake.keysArgsForCall = append(fake.keysArgsForCall, struct{}{})
+	fake.recordInvocation("Keys", []interface{}{})
+	fake.keysMutex.Unlock()
+	if fake.KeysStub != nil {
+		return fake.KeysStub()
+	} else {
+		return fake.keysReturns.result1, fake.keysReturns.result2
+	}
+}
+
+func (fake *FakeKeyProvider) KeysCallCount() int {
+	fake.keysMutex.RLock()
+	defer fake.keysMutex.RUnlock()
+	return len(fake.keysArgsForCall)
+}
+
+func (fake *FakeKeyProvider) KeysReturns(result1 []encryption.Key, result2 error) {
+	fake.KeysStub = nil
+	fake.keysReturns = struct {
+		result1 []encryption.Key
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeKeyProvider) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.keysMutex.RLock()
+	defer fake.keysMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeKeyProvider) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ encryption.KeyProvider = new(FakeKeyProvider)
@@ -0,0 +1,103 @@
+// This file was generated by counterfeiter
+package encryptionfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/bbs/encryption"
+)
+
+type FakeReloadableKeyProvider struct {
+	KeysStub        func() ([]encryption.Key, error)
+	keysMutex       sync.RWMutex
+	keysArgsForCall []struct{}
+	keysReturns     struct {
+		result1 []encryption.Key
+		result2 error
+	}
+	ChangedStub        func() (bool, error)
+	changedMutex       sync.RWMutex
+	changedArgsForCall []struct{}
+	changedReturns     struct {
+		result1 bool
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeReloadableKeyProvider) Keys() ([]encryption.Key, error) {
+	fake.keysMutex.Lock()
+	fake.keysArgsForCall = append(fake.keysArgsForCall, struct{}{})
+	fake.recordInvocation("Keys", []interface{}{})
+	fake.keysMutex.Unlock()
+	if fake.KeysStub != nil {
+		return fake.KeysStub()
+	} else {
+		return fake.keysReturns.result1, fake.keysReturns.result2
+	}
+}
+
+func (fake *FakeReloadableKeyProvider) KeysCallCount() int {
+	fake.keysMutex.RLock()
+	defer fake.keysMutex.RUnlock()
+	return len(fake.keysArgsForCall)
+}
+
+func (fake *FakeReloadableKeyProvider) KeysReturns(result1 []encryption.Key, result2 error) {
+	fake.KeysStub = nil
+	fake.keysReturns = struct {
+		result1 []encryption.Key
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReloadableKeyProvider) Changed() (bool, error) {
+	fake.changedMutex.Lock()
+	fake.changedArgsForCall = append(fake.changedArgsForCall, struct{}{})
+	fake.recordInvocation("Changed", []interface{}{})
+	fake.changedMutex.Unlock()
+	if fake.ChangedStub != nil {
+		return fake.ChangedStub()
+	} else {
+		return fake.changedReturns.result1, fake.changedReturns.result2
+	}
+}
+
+func (fake *FakeReloadableKeyProvider) ChangedCallCount() int {
+	fake.changedMutex.RLock()
+	defer fake.changedMutex.RUnlock()
+	return len(fake.changedArgsForCall)
+}
+
+func (fake *FakeReloadableKeyProvider) ChangedReturns(result1 bool, result2 error) {
+	fake.ChangedStub = nil
+	fake.changedReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReloadableKeyProvider) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.keysMutex.RLock()
+	defer fake.keysMutex.RUnlock()
+	fake.changedMutex.RLock()
+	defer fake.changedMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeReloadableKeyProvider) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ encryption.ReloadableKeyProvider = new(FakeReloadableKeyProvider)
@@ -20,6 +20,13 @@ type key struct {
 }
 
 func NewKey(label, phrase string) (Key, error) {
+	hash := sha256.Sum256([]byte(phrase))
+	return NewDataKey(label, hash[:])
+}
+
+// NewDataKey returns a key using data, which must be 16, 24 or 32 bytes long,
+// as its AES key, instead of deriving it from a phrase.
+func NewDataKey(label string, data []byte) (Key, error) {
 	if label == "" {
 		return nil, errors.New("A key label is required")
 	}
@@ -28,8 +35,7 @@ func NewKey(label, phrase string) (Key, error) {
 		return nil, errors.New("Key label is longer than 127 bytes")
 	}
 
-	hash := sha256.Sum256([]byte(phrase))
-	block, err := aes.NewCipher(hash[:])
+	block, err := aes.NewCipher(data)
 	if err != nil {
 		return nil, err
 	}
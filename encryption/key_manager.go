@@ -1,6 +1,10 @@
 package encryption
 
-import "fmt"
+import (
+	"bytes"
+	"fmt"
+	"sync"
+)
 
 type keyManager struct {
 	encryptionKey  Key
@@ -37,3 +41,164 @@ func (m *keyManager) EncryptionKey() Key {
 func (m *keyManager) DecryptionKey(label string) Key {
 	return m.decryptionKeys[label]
 }
+
+// ReloadingKeyManager is a KeyManager whose keys come from KeyProviders, and
+// are read again from the reloadable ones when they change.
+type ReloadingKeyManager struct {
+	activeLabel string
+	providers   []KeyProvider
+
+	lock         sync.RWMutex
+	manager      KeyManager
+	providerKeys [][]Key
+
+	// rejected marks the providers whose new keys were refused, so that they
+	// are read again, and the error reported, until they are fixed.
+	rejected []bool
+}
+
+// NewReloadingKeyManager returns a key manager encrypting with the key
+// labelled activeLabel, which the providers must supply.
+func NewReloadingKeyManager(activeLabel string, providers ...KeyProvider) (*ReloadingKeyManager, error) {
+	m := &ReloadingKeyManager{
+		activeLabel:  activeLabel,
+		providers:    providers,
+		providerKeys: make([][]Key, len(providers)),
+		rejected:     make([]bool, len(providers)),
+	}
+
+	for i, provider := range providers {
+		keys, err := provider.Keys()
+		if err != nil {
+			return nil, err
+		}
+		m.providerKeys[i] = keys
+	}
+
+	manager, err := m.newKeyManager(m.providerKeys)
+	if err != nil {
+		return nil, err
+	}
+	m.manager = manager
+
+	return m, nil
+}
+
+func (m *ReloadingKeyManager) EncryptionKey() Key {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.manager.EncryptionKey()
+}
+
+func (m *ReloadingKeyManager) DecryptionKey(label string) Key {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.manager.DecryptionKey(label)
+}
+
+// Reload reads the keys again from the reloadable providers that have
+// changed, and reports whether any did. The current keys are kept if reading
+// fails, if the active key would no longer be supplied, or if a label would
+// now stand for a different key, as the records encrypted with it could then
+// no longer be read. Refused keys are read again on every later call, which
+// keeps failing until the providers supply acceptable keys.
+func (m *ReloadingKeyManager) Reload() (bool, error) {
+	providerKeys := make([][]Key, len(m.providers))
+	copy(providerKeys, m.providerKeys)
+
+	read := []int{}
+	for i, provider := range m.providers {
+		reloadable, ok := provider.(ReloadableKeyProvider)
+		if !ok {
+			continue
+		}
+
+		providerChanged := m.rejected[i]
+		if !providerChanged {
+			var err error
+			providerChanged, err = reloadable.Changed()
+			if err != nil {
+				return false, err
+			}
+		}
+		if !providerChanged {
+			continue
+		}
+
+		keys, err := reloadable.Keys()
+		if err != nil {
+			return false, err
+		}
+		providerKeys[i] = keys
+		read = append(read, i)
+	}
+
+	if len(read) == 0 {
+		return false, nil
+	}
+
+	manager, err := m.newKeyManager(providerKeys)
+	if err == nil {
+		err = m.checkKeys(providerKeys)
+	}
+	if err != nil {
+		for _, i := range read {
+			m.rejected[i] = true
+		}
+		return false, err
+	}
+
+	m.lock.Lock()
+	m.manager = manager
+	m.providerKeys = providerKeys
+	m.lock.Unlock()
+
+	for i := range m.rejected {
+		m.rejected[i] = false
+	}
+
+	return true, nil
+}
+
+// checkKeys fails if a label of providerKeys stands for a different key than
+// it does now.
+func (m *ReloadingKeyManager) checkKeys(providerKeys [][]Key) error {
+	for _, keys := range providerKeys {
+		for _, key := range keys {
+			existingKey := m.DecryptionKey(key.Label())
+			if existingKey != nil && !sameKey(existingKey, key) {
+				return fmt.Errorf("Key %q has changed", key.Label())
+			}
+		}
+	}
+	return nil
+}
+
+func (m *ReloadingKeyManager) newKeyManager(providerKeys [][]Key) (KeyManager, error) {
+	keys := []Key{}
+	for _, k := range providerKeys {
+		keys = append(keys, k...)
+	}
+
+	encryptionKey, decryptionKeys, err := selectKeys(m.activeLabel, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewKeyManager(encryptionKey, decryptionKeys)
+}
+
+// sameKey reports whether the keys encrypt alike, and so are the same.
+func sameKey(a, b Key) bool {
+	if a.Block().BlockSize() != b.Block().BlockSize() {
+		return false
+	}
+
+	plaintext := make([]byte, a.Block().BlockSize())
+	aCiphertext := make([]byte, len(plaintext))
+	bCiphertext := make([]byte, len(plaintext))
+	a.Block().Encrypt(aCiphertext, plaintext)
+	b.Block().Encrypt(bCiphertext, plaintext)
+
+	return bytes.Equal(aCiphertext, bCiphertext)
+}
@@ -1,7 +1,10 @@
 package encryption_test
 
 import (
+	"errors"
+
 	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/encryption/encryptionfakes"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -108,3 +111,120 @@ var _ = Describe("KeyManager", func() {
 		})
 	})
 })
+
+var _ = Describe("ReloadingKeyManager", func() {
+	var (
+		staticProvider     encryption.KeyProvider
+		reloadableProvider *encryptionfakes.FakeReloadableKeyProvider
+		manager            *encryption.ReloadingKeyManager
+		activeKey, oldKey  encryption.Key
+	)
+
+	BeforeEach(func() {
+		var err error
+		activeKey, err = encryption.NewKey("active", "active phrase")
+		Expect(err).NotTo(HaveOccurred())
+		oldKey, err = encryption.NewKey("old", "old phrase")
+		Expect(err).NotTo(HaveOccurred())
+
+		staticProvider = encryption.NewPhraseKeyProvider(map[string]string{"active": "active phrase"})
+		reloadableProvider = new(encryptionfakes.FakeReloadableKeyProvider)
+		reloadableProvider.KeysReturns([]encryption.Key{oldKey}, nil)
+
+		manager, err = encryption.NewReloadingKeyManager("active", staticProvider, reloadableProvider)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("serves the keys of the providers", func() {
+		Expect(manager.EncryptionKey()).To(Equal(activeKey))
+		Expect(manager.DecryptionKey("active")).To(Equal(activeKey))
+		Expect(manager.DecryptionKey("old")).To(Equal(oldKey))
+	})
+
+	It("fails if the active key is not supplied", func() {
+		_, err := encryption.NewReloadingKeyManager("missing", staticProvider)
+		Expect(err).To(MatchError("The selected active key must be listed on the encryption keys flag"))
+	})
+
+	Describe("Reload", func() {
+		var newKey encryption.Key
+
+		BeforeEach(func() {
+			var err error
+			newKey, err = encryption.NewKey("new", "new phrase")
+			Expect(err).NotTo(HaveOccurred())
+			reloadableProvider.KeysReturns([]encryption.Key{newKey}, nil)
+		})
+
+		It("does nothing while the providers are unchanged", func() {
+			Expect(manager.Reload()).To(BeFalse())
+			Expect(reloadableProvider.KeysCallCount()).To(Equal(1))
+			Expect(manager.DecryptionKey("old")).To(Equal(oldKey))
+		})
+
+		Context("when a provider has changed", func() {
+			BeforeEach(func() {
+				reloadableProvider.ChangedReturns(true, nil)
+			})
+
+			It("serves its new keys", func() {
+				Expect(manager.Reload()).To(BeTrue())
+				Expect(manager.EncryptionKey()).To(Equal(activeKey))
+				Expect(manager.DecryptionKey("new")).To(Equal(newKey))
+				Expect(manager.DecryptionKey("old")).To(BeNil())
+			})
+
+			It("keeps the keys when a label would be supplied twice", func() {
+				reloadableProvider.KeysReturns([]encryption.Key{newKey, activeKey}, nil)
+				_, err := manager.Reload()
+				Expect(err).To(MatchError(`Multiple keys with the same label: "active"`))
+				Expect(manager.DecryptionKey("old")).To(Equal(oldKey))
+			})
+
+			It("keeps the keys when a label stands for a different key", func() {
+				changedKey, err := encryption.NewKey("old", "changed phrase")
+				Expect(err).NotTo(HaveOccurred())
+				reloadableProvider.KeysReturns([]encryption.Key{changedKey}, nil)
+
+				_, err = manager.Reload()
+				Expect(err).To(MatchError(`Key "old" has changed`))
+				Expect(manager.DecryptionKey("old")).To(Equal(oldKey))
+			})
+
+			Context("when the new keys have been refused", func() {
+				BeforeEach(func() {
+					changedKey, err := encryption.NewKey("old", "changed phrase")
+					Expect(err).NotTo(HaveOccurred())
+					reloadableProvider.KeysReturns([]encryption.Key{changedKey}, nil)
+
+					_, err = manager.Reload()
+					Expect(err).To(MatchError(`Key "old" has changed`))
+					reloadableProvider.ChangedReturns(false, nil)
+				})
+
+				It("keeps reporting the error although the provider has not changed since", func() {
+					_, err := manager.Reload()
+					Expect(err).To(MatchError(`Key "old" has changed`))
+					Expect(reloadableProvider.KeysCallCount()).To(Equal(3))
+					Expect(manager.DecryptionKey("old")).To(Equal(oldKey))
+				})
+
+				It("serves the keys once the provider supplies acceptable ones", func() {
+					reloadableProvider.KeysReturns([]encryption.Key{newKey}, nil)
+					Expect(manager.Reload()).To(BeTrue())
+					Expect(manager.DecryptionKey("new")).To(Equal(newKey))
+
+					Expect(manager.Reload()).To(BeFalse())
+					Expect(reloadableProvider.KeysCallCount()).To(Equal(3))
+				})
+			})
+
+			It("keeps the keys when the provider fails", func() {
+				reloadableProvider.KeysReturns(nil, errors.New("boom"))
+				_, err := manager.Reload()
+				Expect(err).To(MatchError("boom"))
+				Expect(manager.DecryptionKey("old")).To(Equal(oldKey))
+			})
+		})
+	})
+})
@@ -0,0 +1,207 @@
+package encryption
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+//go:generate counterfeiter . KeyProvider
+
+// KeyProvider supplies encryption keys from a source other than the BBS
+// configuration file, such as files or a KMS.
+type KeyProvider interface {
+	Keys() ([]Key, error)
+}
+
+//go:generate counterfeiter . ReloadableKeyProvider
+
+// ReloadableKeyProvider is a KeyProvider whose keys can change while the BBS
+// is running. Changed reports whether they have since the last call to Keys.
+type ReloadableKeyProvider interface {
+	KeyProvider
+	Changed() (bool, error)
+}
+
+// LoadKeys returns the key labelled activeLabel and every key supplied by the
+// providers, which must not supply two keys with the same label.
+func LoadKeys(activeLabel string, providers ...KeyProvider) (Key, []Key, error) {
+	keys := []Key{}
+	for _, provider := range providers {
+		providerKeys, err := provider.Keys()
+		if err != nil {
+			return nil, nil, err
+		}
+		keys = append(keys, providerKeys...)
+	}
+
+	return selectKeys(activeLabel, keys)
+}
+
+func selectKeys(activeLabel string, keys []Key) (Key, []Key, error) {
+	if len(keys) == 0 {
+		return nil, nil, errors.New("Must have at least one encryption key set")
+	}
+
+	if len(activeLabel) == 0 {
+		return nil, nil, errors.New("Must select an active encryption key")
+	}
+
+	var activeKey Key
+	labels := map[string]bool{}
+	for _, key := range keys {
+		if labels[key.Label()] {
+			return nil, nil, fmt.Errorf("Multiple keys with the same label: %q", key.Label())
+		}
+		labels[key.Label()] = true
+
+		if key.Label() == activeLabel {
+			activeKey = key
+		}
+	}
+
+	if activeKey == nil {
+		return nil, nil, errors.New("The selected active key must be listed on the encryption keys flag")
+	}
+
+	return activeKey, keys, nil
+}
+
+type phraseKeyProvider struct {
+	phrases map[string]string
+}
+
+// NewPhraseKeyProvider returns a provider deriving a key from each of the
+// phrases, by label.
+func NewPhraseKeyProvider(phrases map[string]string) KeyProvider {
+	return &phraseKeyProvider{phrases: phrases}
+}
+
+func (p *phraseKeyProvider) Keys() ([]Key, error) {
+	keys := []Key{}
+	for _, label := range sortedLabels(p.phrases) {
+		key, err := NewKey(label, p.phrases[label])
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+type envKeyProvider struct {
+	variables map[string]string
+}
+
+// NewEnvKeyProvider returns a provider deriving each key from the phrase in
+// an environment variable. variables maps the key labels to the names of the
+// variables.
+func NewEnvKeyProvider(variables map[string]string) KeyProvider {
+	return &envKeyProvider{variables: variables}
+}
+
+func (p *envKeyProvider) Keys() ([]Key, error) {
+	keys := []Key{}
+	for _, label := range sortedLabels(p.variables) {
+		phrase, ok := os.LookupEnv(p.variables[label])
+		if !ok {
+			return nil, fmt.Errorf("Environment variable %s of key %q is not set", p.variables[label], label)
+		}
+
+		key, err := NewKey(label, phrase)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+type fileKeyProvider struct {
+	directory string
+	lastState string
+}
+
+// NewFileKeyProvider returns a provider deriving a key from every file in
+// directory. The name of the file is the label of the key and its content,
+// without surrounding whitespace, the phrase. Hidden files are skipped. The
+// keys are read again whenever a file is added, removed or modified.
+func NewFileKeyProvider(directory string) ReloadableKeyProvider {
+	return &fileKeyProvider{directory: directory}
+}
+
+func (p *fileKeyProvider) Keys() ([]Key, error) {
+	files, state, err := p.list()
+	if err != nil {
+		return nil, err
+	}
+
+	keys := []Key{}
+	for _, file := range files {
+		data, err := ioutil.ReadFile(filepath.Join(p.directory, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := NewKey(file.Name(), strings.TrimSpace(string(data)))
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	p.lastState = state
+	return keys, nil
+}
+
+func (p *fileKeyProvider) Changed() (bool, error) {
+	_, state, err := p.list()
+	if err != nil {
+		return false, err
+	}
+	return state != p.lastState, nil
+}
+
+// list returns the key files of the directory, and a description of their
+// names, sizes and modification times that changes along with them.
+func (p *fileKeyProvider) list() ([]os.FileInfo, string, error) {
+	entries, err := ioutil.ReadDir(p.directory)
+	if err != nil {
+		return nil, "", err
+	}
+
+	files := []os.FileInfo{}
+	state := []string{}
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		// follow symlinks, such as those of mounted Kubernetes secrets
+		info, err := os.Stat(filepath.Join(p.directory, entry.Name()))
+		if err != nil {
+			return nil, "", err
+		}
+		if !info.Mode().IsRegular() {
+			continue
+		}
+
+		files = append(files, entry)
+		state = append(state, fmt.Sprintf("%s:%d:%d", entry.Name(), info.Size(), info.ModTime().UnixNano()))
+	}
+
+	return files, strings.Join(state, ","), nil
+}
+
+func sortedLabels(keys map[string]string) []string {
+	labels := make([]string, 0, len(keys))
+	for label := range keys {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+	return labels
+}
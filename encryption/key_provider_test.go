@@ -0,0 +1,186 @@
+package encryption_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/encryption/encryptionfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func keyLabels(keys []encryption.Key) []string {
+	labels := []string{}
+	for _, key := range keys {
+		labels = append(labels, key.Label())
+	}
+	return labels
+}
+
+var _ = Describe("KeyProviders", func() {
+	Describe("LoadKeys", func() {
+		var providers []encryption.KeyProvider
+
+		BeforeEach(func() {
+			providers = []encryption.KeyProvider{
+				encryption.NewPhraseKeyProvider(map[string]string{"label": "phrase"}),
+				encryption.NewPhraseKeyProvider(map[string]string{"other-label": "other phrase"}),
+			}
+		})
+
+		It("returns the active key and the keys of every provider", func() {
+			activeKey, keys, err := encryption.LoadKeys("other-label", providers...)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(activeKey.Label()).To(Equal("other-label"))
+			Expect(keyLabels(keys)).To(Equal([]string{"label", "other-label"}))
+		})
+
+		It("ensures there's at least one key", func() {
+			_, _, err := encryption.LoadKeys("label")
+			Expect(err).To(MatchError("Must have at least one encryption key set"))
+		})
+
+		It("ensures there's a selected active key", func() {
+			_, _, err := encryption.LoadKeys("", providers...)
+			Expect(err).To(MatchError("Must select an active encryption key"))
+		})
+
+		It("fails if the active key is not supplied", func() {
+			_, _, err := encryption.LoadKeys("missing", providers...)
+			Expect(err).To(MatchError("The selected active key must be listed on the encryption keys flag"))
+		})
+
+		It("fails if two providers supply the same label", func() {
+			providers = append(providers, encryption.NewPhraseKeyProvider(map[string]string{"label": "another phrase"}))
+			_, _, err := encryption.LoadKeys("label", providers...)
+			Expect(err).To(MatchError(`Multiple keys with the same label: "label"`))
+		})
+
+		It("fails if a provider fails", func() {
+			provider := new(encryptionfakes.FakeKeyProvider)
+			provider.KeysReturns(nil, errors.New("boom"))
+			providers = append(providers, provider)
+
+			_, _, err := encryption.LoadKeys("label", providers...)
+			Expect(err).To(MatchError("boom"))
+		})
+	})
+
+	Describe("PhraseKeyProvider", func() {
+		It("derives the keys from the phrases", func() {
+			keys, err := encryption.NewPhraseKeyProvider(map[string]string{"label": "phrase"}).Keys()
+			Expect(err).NotTo(HaveOccurred())
+
+			expectedKey, err := encryption.NewKey("label", "phrase")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(keys).To(Equal([]encryption.Key{expectedKey}))
+		})
+	})
+
+	Describe("EnvKeyProvider", func() {
+		BeforeEach(func() {
+			os.Setenv("BBS_TEST_ENCRYPTION_KEY", "phrase")
+		})
+
+		AfterEach(func() {
+			os.Unsetenv("BBS_TEST_ENCRYPTION_KEY")
+		})
+
+		It("derives the keys from the environment variables", func() {
+			keys, err := encryption.NewEnvKeyProvider(map[string]string{"label": "BBS_TEST_ENCRYPTION_KEY"}).Keys()
+			Expect(err).NotTo(HaveOccurred())
+
+			expectedKey, err := encryption.NewKey("label", "phrase")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(keys).To(Equal([]encryption.Key{expectedKey}))
+		})
+
+		It("fails if a variable is not set", func() {
+			_, err := encryption.NewEnvKeyProvider(map[string]string{"label": "BBS_TEST_MISSING_KEY"}).Keys()
+			Expect(err).To(MatchError(`Environment variable BBS_TEST_MISSING_KEY of key "label" is not set`))
+		})
+	})
+
+	Describe("FileKeyProvider", func() {
+		var (
+			directory string
+			provider  encryption.ReloadableKeyProvider
+		)
+
+		writeKey := func(name, content string) {
+			Expect(ioutil.WriteFile(filepath.Join(directory, name), []byte(content), 0600)).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			var err error
+			directory, err = ioutil.TempDir("", "keys")
+			Expect(err).NotTo(HaveOccurred())
+
+			writeKey("label", "phrase\n")
+			writeKey(".hidden", "ignored")
+			Expect(os.Mkdir(filepath.Join(directory, "subdirectory"), 0700)).To(Succeed())
+
+			provider = encryption.NewFileKeyProvider(directory)
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(directory)
+		})
+
+		It("derives a key from each file, labelled by its name", func() {
+			keys, err := provider.Keys()
+			Expect(err).NotTo(HaveOccurred())
+
+			expectedKey, err := encryption.NewKey("label", "phrase")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(keys).To(Equal([]encryption.Key{expectedKey}))
+		})
+
+		It("fails if the directory does not exist", func() {
+			_, err := encryption.NewFileKeyProvider(filepath.Join(directory, "missing")).Keys()
+			Expect(err).To(HaveOccurred())
+		})
+
+		Describe("Changed", func() {
+			BeforeEach(func() {
+				_, err := provider.Keys()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("is false while the files are unchanged", func() {
+				Expect(provider.Changed()).To(BeFalse())
+			})
+
+			It("is true when a file is added", func() {
+				writeKey("other-label", "other phrase")
+				Expect(provider.Changed()).To(BeTrue())
+
+				keys, err := provider.Keys()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(keyLabels(keys)).To(Equal([]string{"label", "other-label"}))
+				Expect(provider.Changed()).To(BeFalse())
+			})
+
+			It("is true when a file is removed", func() {
+				Expect(os.Remove(filepath.Join(directory, "label"))).To(Succeed())
+				Expect(provider.Changed()).To(BeTrue())
+			})
+
+			It("is true when a file is modified", func() {
+				later := time.Now().Add(time.Minute)
+				Expect(os.Chtimes(filepath.Join(directory, "label"), later, later)).To(Succeed())
+				Expect(provider.Changed()).To(BeTrue())
+			})
+
+			It("ignores hidden files", func() {
+				writeKey(".other", "ignored")
+				Expect(provider.Changed()).To(BeFalse())
+			})
+		})
+	})
+})
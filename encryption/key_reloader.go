@@ -0,0 +1,54 @@
+package encryption
+
+import (
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+)
+
+type KeyReloader struct {
+	logger     lager.Logger
+	keyManager *ReloadingKeyManager
+	clock      clock.Clock
+	interval   time.Duration
+}
+
+// NewKeyReloader returns a runner reloading the keys of keyManager every
+// interval.
+func NewKeyReloader(logger lager.Logger, keyManager *ReloadingKeyManager, clock clock.Clock, interval time.Duration) *KeyReloader {
+	return &KeyReloader{
+		logger:     logger,
+		keyManager: keyManager,
+		clock:      clock,
+		interval:   interval,
+	}
+}
+
+func (r *KeyReloader) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	logger := r.logger.Session("key-reloader")
+	logger.Info("starting")
+	defer logger.Info("exited")
+
+	ticker := r.clock.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	close(ready)
+
+	for {
+		select {
+		case <-signals:
+			return nil
+		case <-ticker.C():
+			reloaded, err := r.keyManager.Reload()
+			if err != nil {
+				logger.Error("failed-to-reload-keys", err)
+				continue
+			}
+			if reloaded {
+				logger.Info("reloaded-keys")
+			}
+		}
+	}
+}
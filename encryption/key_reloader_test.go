@@ -0,0 +1,75 @@
+package encryption_test
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/encryption/encryptionfakes"
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/tedsuo/ifrit"
+)
+
+var _ = Describe("KeyReloader", func() {
+	var (
+		logger    *lagertest.TestLogger
+		fakeClock *fakeclock.FakeClock
+		provider  *encryptionfakes.FakeReloadableKeyProvider
+		manager   *encryption.ReloadingKeyManager
+		process   ifrit.Process
+		activeKey encryption.Key
+		interval  time.Duration
+	)
+
+	BeforeEach(func() {
+		var err error
+		logger = lagertest.NewTestLogger("test")
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		interval = 10 * time.Second
+
+		activeKey, err = encryption.NewKey("active", "phrase")
+		Expect(err).NotTo(HaveOccurred())
+		provider = new(encryptionfakes.FakeReloadableKeyProvider)
+		provider.KeysReturns([]encryption.Key{activeKey}, nil)
+
+		manager, err = encryption.NewReloadingKeyManager("active", provider)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	JustBeforeEach(func() {
+		process = ifrit.Background(encryption.NewKeyReloader(logger, manager, fakeClock, interval))
+		Eventually(process.Ready()).Should(BeClosed())
+	})
+
+	AfterEach(func() {
+		process.Signal(os.Interrupt)
+		Eventually(process.Wait()).Should(Receive(BeNil()))
+	})
+
+	It("reloads the keys every interval", func() {
+		newKey, err := encryption.NewKey("new", "new phrase")
+		Expect(err).NotTo(HaveOccurred())
+		provider.KeysReturns([]encryption.Key{activeKey, newKey}, nil)
+		provider.ChangedReturns(true, nil)
+
+		fakeClock.WaitForWatcherAndIncrement(interval)
+
+		Eventually(func() encryption.Key { return manager.DecryptionKey("new") }).Should(Equal(newKey))
+		Eventually(logger.Buffer()).Should(gbytes.Say("reloaded-keys"))
+	})
+
+	It("keeps running when reloading fails", func() {
+		provider.ChangedReturns(false, errors.New("boom"))
+
+		fakeClock.WaitForWatcherAndIncrement(interval)
+		Eventually(logger.Buffer()).Should(gbytes.Say("failed-to-reload-keys"))
+
+		fakeClock.WaitForWatcherAndIncrement(interval)
+		Eventually(provider.ChangedCallCount).Should(Equal(2))
+	})
+})
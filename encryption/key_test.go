@@ -58,4 +58,27 @@ var _ = Describe("Key", func() {
 			})
 		})
 	})
+
+	Describe("NewDataKey", func() {
+		It("uses the data as the aes key", func() {
+			data := []byte("12345678901234567890123456789012")
+			key, err := encryption.NewDataKey("label", data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(key.Label()).To(Equal("label"))
+
+			block, err := aes.NewCipher(data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(key.Block()).To(Equal(block))
+		})
+
+		It("rejects data that is not a valid aes key", func() {
+			_, err := encryption.NewDataKey("label", []byte("too short"))
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("requires a key label", func() {
+			_, err := encryption.NewDataKey("", []byte("12345678901234567890123456789012"))
+			Expect(err).To(MatchError("A key label is required"))
+		})
+	})
 })
@@ -0,0 +1,89 @@
+package encryption
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// KMSDecryptRequest is the body POSTed to the /v1/decrypt endpoint of a KMS to
+// unwrap a data key with a master key.
+type KMSDecryptRequest struct {
+	KeyID      string `json:"key_id"`
+	Ciphertext string `json:"ciphertext"`
+}
+
+// KMSDecryptResponse is the body returned by the /v1/decrypt endpoint of a KMS.
+// Plaintext is the base64 encoded data key.
+type KMSDecryptResponse struct {
+	Plaintext string `json:"plaintext"`
+}
+
+type kmsKeyProvider struct {
+	client      *http.Client
+	url         string
+	masterKeyID string
+	wrappedKeys map[string]string
+}
+
+// NewKMSKeyProvider returns a provider of envelope encrypted keys: every key
+// is a data key wrapped by the master key masterKeyID, which never leaves the
+// KMS at url. wrappedKeys maps the key labels to the wrapped data keys, as
+// returned base64 encoded by the KMS, and the provider asks the KMS to unwrap
+// them.
+func NewKMSKeyProvider(client *http.Client, url, masterKeyID string, wrappedKeys map[string]string) KeyProvider {
+	return &kmsKeyProvider{
+		client:      client,
+		url:         strings.TrimRight(url, "/"),
+		masterKeyID: masterKeyID,
+		wrappedKeys: wrappedKeys,
+	}
+}
+
+func (p *kmsKeyProvider) Keys() ([]Key, error) {
+	keys := []Key{}
+	for _, label := range sortedLabels(p.wrappedKeys) {
+		data, err := p.unwrap(p.wrappedKeys[label])
+		if err != nil {
+			return nil, fmt.Errorf("Failed to unwrap key %q: %s", label, err.Error())
+		}
+
+		key, err := NewDataKey(label, data)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func (p *kmsKeyProvider) unwrap(ciphertext string) ([]byte, error) {
+	body, err := json.Marshal(KMSDecryptRequest{
+		KeyID:      p.masterKeyID,
+		Ciphertext: ciphertext,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.client.Post(p.url+"/v1/decrypt", "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("KMS responded with status %d", resp.StatusCode)
+	}
+
+	var decrypted KMSDecryptResponse
+	err = json.NewDecoder(resp.Body).Decode(&decrypted)
+	if err != nil {
+		return nil, err
+	}
+
+	return base64.StdEncoding.DecodeString(decrypted.Plaintext)
+}
@@ -0,0 +1,81 @@
+package encryption_test
+
+import (
+	"encoding/base64"
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/encryption"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("KMSKeyProvider", func() {
+	var (
+		kms      *ghttp.Server
+		provider encryption.KeyProvider
+		dataKey  []byte
+	)
+
+	BeforeEach(func() {
+		kms = ghttp.NewServer()
+		dataKey = []byte("12345678901234567890123456789012")
+
+		provider = encryption.NewKMSKeyProvider(http.DefaultClient, kms.URL()+"/", "master-key", map[string]string{
+			"label": "d3JhcHBlZA==",
+		})
+	})
+
+	AfterEach(func() {
+		kms.Close()
+	})
+
+	Context("when the KMS unwraps the data keys", func() {
+		BeforeEach(func() {
+			kms.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("POST", "/v1/decrypt"),
+				ghttp.VerifyJSONRepresenting(encryption.KMSDecryptRequest{
+					KeyID:      "master-key",
+					Ciphertext: "d3JhcHBlZA==",
+				}),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, encryption.KMSDecryptResponse{
+					Plaintext: base64.StdEncoding.EncodeToString(dataKey),
+				}),
+			))
+		})
+
+		It("uses them as the keys", func() {
+			keys, err := provider.Keys()
+			Expect(err).NotTo(HaveOccurred())
+
+			expectedKey, err := encryption.NewDataKey("label", dataKey)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(keys).To(Equal([]encryption.Key{expectedKey}))
+		})
+	})
+
+	Context("when the KMS fails", func() {
+		BeforeEach(func() {
+			kms.AppendHandlers(ghttp.RespondWith(http.StatusForbidden, nil))
+		})
+
+		It("returns an error", func() {
+			_, err := provider.Keys()
+			Expect(err).To(MatchError(`Failed to unwrap key "label": KMS responded with status 403`))
+		})
+	})
+
+	Context("when the data key is not a valid aes key", func() {
+		BeforeEach(func() {
+			kms.AppendHandlers(ghttp.RespondWithJSONEncoded(http.StatusOK, encryption.KMSDecryptResponse{
+				Plaintext: base64.StdEncoding.EncodeToString([]byte("too short")),
+			}))
+		})
+
+		It("returns an error", func() {
+			_, err := provider.Keys()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
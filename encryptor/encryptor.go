@@ -3,6 +3,7 @@ package encryptor
 import (
 	"errors"
 	"os"
+	"time"
 
 	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/encryption"
@@ -14,29 +15,48 @@ import (
 
 const (
 	encryptionDuration = metric.Duration("EncryptionDuration")
+	oldKeyRows         = metric.Metric("EncryptionOldKeyRows")
+
+	rowsDoneMetricPrefix      = "EncryptionRowsDone."
+	rowsRemainingMetricPrefix = "EncryptionRowsRemaining."
 )
 
+var errInterrupted = errors.New("encryption interrupted")
+
 type Encryptor struct {
-	logger     lager.Logger
-	db         db.EncryptionDB
-	keyManager encryption.KeyManager
-	cryptor    encryption.Cryptor
-	clock      clock.Clock
+	logger        lager.Logger
+	db            db.EncryptionDB
+	progressDB    db.EncryptionProgressDB
+	keyManager    encryption.KeyManager
+	cryptor       encryption.Cryptor
+	clock         clock.Clock
+	batchSize     int
+	batchInterval time.Duration
 }
 
+// New returns an Encryptor re-encrypting the records with the active key
+// when it changes. When progressDB is not nil the records are re-encrypted
+// batchSize rows at a time, pausing batchInterval between batches, and an
+// interrupted pass resumes where it stopped.
 func New(
 	logger lager.Logger,
 	db db.EncryptionDB,
+	progressDB db.EncryptionProgressDB,
 	keyManager encryption.KeyManager,
 	cryptor encryption.Cryptor,
 	clock clock.Clock,
+	batchSize int,
+	batchInterval time.Duration,
 ) Encryptor {
 	return Encryptor{
-		logger:     logger,
-		db:         db,
-		keyManager: keyManager,
-		cryptor:    cryptor,
-		clock:      clock,
+		logger:        logger,
+		db:            db,
+		progressDB:    progressDB,
+		keyManager:    keyManager,
+		cryptor:       cryptor,
+		clock:         clock,
+		batchSize:     batchSize,
+		batchInterval: batchInterval,
 	}
 }
 
@@ -69,11 +89,23 @@ func (m Encryptor) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
 
 		encryptionStart := m.clock.Now()
 		logger.Info("encryption-started")
-		err := m.db.PerformEncryption(logger)
+		var rowsLeft int64
+		if m.progressDB != nil {
+			rowsLeft, err = m.performResumableEncryption(logger, signals)
+		} else {
+			err = m.db.PerformEncryption(logger)
+		}
+		if err == errInterrupted {
+			logger.Info("encryption-interrupted")
+			return nil
+		}
 		if err != nil {
 			logger.Error("encryption-failed", err)
 		} else {
 			m.db.SetEncryptionKeyLabel(logger, m.keyManager.EncryptionKey().Label())
+			if m.progressDB != nil {
+				m.emitOldKeyRows(logger, rowsLeft)
+			}
 		}
 
 		totalTime := m.clock.Since(encryptionStart)
@@ -87,3 +119,85 @@ func (m Encryptor) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
 	<-signals
 	return nil
 }
+
+// performResumableEncryption re-encrypts the tables that have not been
+// completed with the active key, one batch at a time, and returns how many
+// rows were left encrypted with another key because they could not be
+// decoded. It returns errInterrupted if the encryptor is signalled in between
+// two batches.
+func (m Encryptor) performResumableEncryption(logger lager.Logger, signals <-chan os.Signal) (int64, error) {
+	keyLabel := m.keyManager.EncryptionKey().Label()
+
+	tables, err := m.progressDB.EncryptionCheckpoints(logger)
+	if err != nil {
+		return 0, err
+	}
+
+	var rowsLeft int64
+	for _, table := range tables {
+		if table.Completed && table.KeyLabel == keyLabel {
+			rowsLeft += table.OldKeyRows
+			continue
+		}
+
+		if table.KeyLabel == keyLabel && table.RowsDone > 0 {
+			logger.Info("resuming-table", lager.Data{"table_name": table.TableName, "rows_done": table.RowsDone})
+		}
+
+		for {
+			progress, err := m.progressDB.ReEncryptBatch(logger, table.TableName, keyLabel, m.batchSize)
+			if err != nil {
+				return 0, err
+			}
+
+			m.emitProgress(logger, progress)
+			if progress.Completed {
+				rowsLeft += progress.OldKeyRows
+				break
+			}
+
+			select {
+			case <-signals:
+				return 0, errInterrupted
+			default:
+			}
+
+			if m.batchInterval > 0 {
+				timer := m.clock.NewTimer(m.batchInterval)
+				select {
+				case <-signals:
+					timer.Stop()
+					return 0, errInterrupted
+				case <-timer.C():
+				}
+			}
+		}
+	}
+
+	return rowsLeft, nil
+}
+
+func (m Encryptor) emitProgress(logger lager.Logger, progress *models.EncryptionTableProgress) {
+	err := metric.Metric(rowsDoneMetricPrefix + progress.TableName).Send(int(progress.RowsDone))
+	if err != nil {
+		logger.Error("failed-to-send-rows-done-metric", err)
+	}
+
+	err = metric.Metric(rowsRemainingMetricPrefix + progress.TableName).Send(int(progress.RowsRemaining))
+	if err != nil {
+		logger.Error("failed-to-send-rows-remaining-metric", err)
+	}
+}
+
+// emitOldKeyRows reports how many rows the pass left encrypted with another
+// key, which should be none unless some of them could not be decoded.
+func (m Encryptor) emitOldKeyRows(logger lager.Logger, rows int64) {
+	if rows > 0 {
+		logger.Info("rows-not-encrypted-with-active-key", lager.Data{"rows": rows})
+	}
+
+	err := oldKeyRows.Send(int(rows))
+	if err != nil {
+		logger.Error("failed-to-send-old-key-rows-metric", err)
+	}
+}
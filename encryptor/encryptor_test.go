@@ -3,12 +3,17 @@ package encryptor_test
 import (
 	"crypto/rand"
 	"errors"
+	"os"
+	"time"
 
+	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/db/dbfakes"
 	"code.cloudfoundry.org/bbs/encryption"
 	"code.cloudfoundry.org/bbs/encryptor"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/cloudfoundry/dropsonde/metric_sender/fake"
 	"github.com/cloudfoundry/dropsonde/metrics"
@@ -27,7 +32,11 @@ var _ = Describe("Encryptor", func() {
 		cryptor    encryption.Cryptor
 		keyManager encryption.KeyManager
 
-		fakeDB *dbfakes.FakeEncryptionDB
+		fakeDB         *dbfakes.FakeEncryptionDB
+		fakeProgressDB *dbfakes.FakeEncryptionProgressDB
+		progressDB     db.EncryptionProgressDB
+		encryptorClock clock.Clock
+		batchInterval  time.Duration
 
 		sender *fake.FakeMetricSender
 	)
@@ -37,6 +46,10 @@ var _ = Describe("Encryptor", func() {
 		metrics.Initialize(sender, nil)
 
 		fakeDB = new(dbfakes.FakeEncryptionDB)
+		fakeProgressDB = new(dbfakes.FakeEncryptionProgressDB)
+		progressDB = nil
+		encryptorClock = clock.NewClock()
+		batchInterval = 0
 
 		logger = lagertest.NewTestLogger("test")
 
@@ -51,7 +64,7 @@ var _ = Describe("Encryptor", func() {
 	})
 
 	JustBeforeEach(func() {
-		runner = encryptor.New(logger, fakeDB, keyManager, cryptor, clock.NewClock())
+		runner = encryptor.New(logger, fakeDB, progressDB, keyManager, cryptor, encryptorClock, 2, batchInterval)
 		encryptorProcess = ifrit.Background(runner)
 	})
 
@@ -162,4 +175,169 @@ var _ = Describe("Encryptor", func() {
 			Expect(newLabel).To(Equal("label"))
 		})
 	})
+
+	Context("when the records can be re-encrypted in batches", func() {
+		var progress map[string]*models.EncryptionTableProgress
+
+		BeforeEach(func() {
+			progressDB = fakeProgressDB
+			fakeDB.EncryptionKeyLabelReturns("old-key", nil)
+
+			progress = map[string]*models.EncryptionTableProgress{
+				"tasks":        {TableName: "tasks", KeyLabel: "old-key", Completed: true},
+				"desired_lrps": {TableName: "desired_lrps", KeyLabel: "old-key", Completed: true},
+			}
+			fakeProgressDB.EncryptionCheckpointsStub = func(lager.Logger) ([]*models.EncryptionTableProgress, error) {
+				return []*models.EncryptionTableProgress{progress["tasks"], progress["desired_lrps"]}, nil
+			}
+			fakeProgressDB.ReEncryptBatchStub = func(_ lager.Logger, tableName, keyLabel string, batchSize int) (*models.EncryptionTableProgress, error) {
+				table := progress[tableName]
+				if table.KeyLabel != keyLabel {
+					table = &models.EncryptionTableProgress{TableName: tableName, KeyLabel: keyLabel, RowsRemaining: 3}
+				}
+				done := int64(batchSize)
+				if done > table.RowsRemaining {
+					done = table.RowsRemaining
+				}
+				progress[tableName] = &models.EncryptionTableProgress{
+					TableName:     tableName,
+					KeyLabel:      keyLabel,
+					RowsDone:      table.RowsDone + done,
+					RowsRemaining: table.RowsRemaining - done,
+					Completed:     done < int64(batchSize),
+				}
+				return progress[tableName], nil
+			}
+		})
+
+		It("re-encrypts every table in batches instead of all at once", func() {
+			Eventually(logger.LogMessages).Should(ContainElement("test.encryptor.encryption-finished"))
+			Expect(fakeDB.PerformEncryptionCallCount()).To(Equal(0))
+
+			Expect(fakeProgressDB.ReEncryptBatchCallCount()).To(Equal(4))
+			for i, tableName := range []string{"tasks", "tasks", "desired_lrps", "desired_lrps"} {
+				_, actualTableName, keyLabel, batchSize := fakeProgressDB.ReEncryptBatchArgsForCall(i)
+				Expect(actualTableName).To(Equal(tableName))
+				Expect(keyLabel).To(Equal("label"))
+				Expect(batchSize).To(Equal(2))
+			}
+
+			Eventually(fakeDB.SetEncryptionKeyLabelCallCount).Should(Equal(1))
+			_, newLabel := fakeDB.SetEncryptionKeyLabelArgsForCall(0)
+			Expect(newLabel).To(Equal("label"))
+		})
+
+		It("does not count the rows of every table by key", func() {
+			Eventually(logger.LogMessages).Should(ContainElement("test.encryptor.encryption-finished"))
+			Expect(fakeProgressDB.EncryptionProgressCallCount()).To(Equal(0))
+		})
+
+		It("emits that no rows are left under another key", func() {
+			Eventually(func() float64 {
+				return sender.GetValue("EncryptionOldKeyRows").Value
+			}).Should(Equal(float64(0)))
+			Expect(logger.LogMessages()).NotTo(ContainElement("test.encryptor.rows-not-encrypted-with-active-key"))
+		})
+
+		It("emits the progress of every table", func() {
+			Eventually(logger.LogMessages).Should(ContainElement("test.encryptor.encryption-finished"))
+
+			Expect(sender.GetValue("EncryptionRowsDone.tasks").Value).To(Equal(float64(3)))
+			Expect(sender.GetValue("EncryptionRowsRemaining.tasks").Value).To(Equal(float64(0)))
+			Expect(sender.GetValue("EncryptionRowsDone.desired_lrps").Value).To(Equal(float64(3)))
+		})
+
+		Context("when some rows are left under another key", func() {
+			BeforeEach(func() {
+				reEncryptBatch := fakeProgressDB.ReEncryptBatchStub
+				fakeProgressDB.ReEncryptBatchStub = func(logger lager.Logger, tableName, keyLabel string, batchSize int) (*models.EncryptionTableProgress, error) {
+					progress, err := reEncryptBatch(logger, tableName, keyLabel, batchSize)
+					progress.OldKeyRows = 1
+					return progress, err
+				}
+			})
+
+			It("emits the number of those rows", func() {
+				Eventually(func() float64 {
+					return sender.GetValue("EncryptionOldKeyRows").Value
+				}).Should(Equal(float64(2)))
+				Expect(logger.LogMessages()).To(ContainElement("test.encryptor.rows-not-encrypted-with-active-key"))
+			})
+		})
+
+		Context("when a table was already completed with the active key", func() {
+			BeforeEach(func() {
+				progress["tasks"] = &models.EncryptionTableProgress{TableName: "tasks", KeyLabel: "label", RowsDone: 3, Completed: true}
+			})
+
+			It("skips it", func() {
+				Eventually(logger.LogMessages).Should(ContainElement("test.encryptor.encryption-finished"))
+
+				Expect(fakeProgressDB.ReEncryptBatchCallCount()).To(Equal(2))
+				_, tableName, _, _ := fakeProgressDB.ReEncryptBatchArgsForCall(0)
+				Expect(tableName).To(Equal("desired_lrps"))
+			})
+
+			Context("when it left rows under another key", func() {
+				BeforeEach(func() {
+					progress["tasks"].OldKeyRows = 2
+				})
+
+				It("still counts them", func() {
+					Eventually(func() float64 {
+						return sender.GetValue("EncryptionOldKeyRows").Value
+					}).Should(Equal(float64(2)))
+				})
+			})
+		})
+
+		Context("when re-encrypting a batch fails", func() {
+			BeforeEach(func() {
+				fakeProgressDB.ReEncryptBatchStub = nil
+				fakeProgressDB.ReEncryptBatchReturns(nil, errors.New("boom"))
+			})
+
+			It("logs the error and does not change the key in the db", func() {
+				Eventually(logger.LogMessages).Should(ContainElement("test.encryptor.encryption-failed"))
+				Consistently(fakeDB.SetEncryptionKeyLabelCallCount).Should(Equal(0))
+			})
+		})
+
+		Context("when there is an interval between batches", func() {
+			var fakeClock *fakeclock.FakeClock
+
+			BeforeEach(func() {
+				fakeClock = fakeclock.NewFakeClock(time.Now())
+				encryptorClock = fakeClock
+				batchInterval = time.Second
+			})
+
+			It("waits for it before the next batch", func() {
+				Eventually(fakeProgressDB.ReEncryptBatchCallCount).Should(Equal(1))
+				Consistently(fakeProgressDB.ReEncryptBatchCallCount).Should(Equal(1))
+
+				fakeClock.WaitForWatcherAndIncrement(time.Second)
+				Eventually(fakeProgressDB.ReEncryptBatchCallCount).Should(Equal(3))
+				Consistently(fakeProgressDB.ReEncryptBatchCallCount).Should(Equal(3))
+			})
+
+			It("stops without changing the key when signalled in between", func() {
+				Eventually(fakeProgressDB.ReEncryptBatchCallCount).Should(Equal(1))
+				encryptorProcess.Signal(os.Interrupt)
+				Eventually(encryptorProcess.Wait()).Should(Receive(BeNil()))
+
+				Expect(fakeProgressDB.ReEncryptBatchCallCount()).To(Equal(1))
+				Expect(fakeDB.SetEncryptionKeyLabelCallCount()).To(Equal(0))
+				Expect(logger.LogMessages()).To(ContainElement("test.encryptor.encryption-interrupted"))
+			})
+
+			It("stops the timer of the interval when signalled in between", func() {
+				Eventually(fakeClock.WatcherCount).Should(Equal(1))
+				encryptorProcess.Signal(os.Interrupt)
+				Eventually(encryptorProcess.Wait()).Should(Receive(BeNil()))
+
+				Expect(fakeClock.WatcherCount()).To(Equal(0))
+			})
+		})
+	})
 })
@@ -0,0 +1,82 @@
+package encryptor
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/runtimeschema/metric"
+)
+
+const reEncodingDuration = metric.Duration("ReEncodingDuration")
+
+type RecordEncoder struct {
+	logger   lager.Logger
+	db       db.RecordEncodingDB
+	encoding format.Encoding
+	clock    clock.Clock
+}
+
+// NewRecordEncoder returns a RecordEncoder rewriting the records with
+// encoding when the database records another one, e.g. when compression is
+// turned on or off.
+func NewRecordEncoder(logger lager.Logger, db db.RecordEncodingDB, encoding format.Encoding, clock clock.Clock) RecordEncoder {
+	return RecordEncoder{
+		logger:   logger,
+		db:       db,
+		encoding: encoding,
+		clock:    clock,
+	}
+}
+
+func (m RecordEncoder) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	logger := m.logger.Session("record-encoder")
+	logger.Info("starting")
+	defer logger.Info("exited")
+
+	desiredEncoding := string(m.encoding[:])
+
+	// records written before the encoding was recorded are not compressed
+	currentEncoding := string(format.BASE64_ENCRYPTED[:])
+	encoding, err := m.db.RecordEncoding(logger)
+	if err == nil {
+		currentEncoding = encoding
+	} else if models.ConvertError(err) != models.ErrResourceNotFound {
+		logger.Error("failed-to-fetch-record-encoding", err)
+		return err
+	}
+
+	close(ready)
+
+	if currentEncoding != desiredEncoding {
+		logger := logger.WithData(lager.Data{
+			"desired-encoding":  desiredEncoding,
+			"existing-encoding": currentEncoding,
+		})
+
+		reEncodingStart := m.clock.Now()
+		logger.Info("re-encoding-started")
+		err = m.db.PerformReEncoding(logger)
+		if err != nil {
+			logger.Error("re-encoding-failed", err)
+		} else {
+			err = m.db.SetRecordEncoding(logger, desiredEncoding)
+			if err != nil {
+				logger.Error("failed-to-set-record-encoding", err)
+			}
+		}
+
+		totalTime := m.clock.Since(reEncodingStart)
+		logger.Info("re-encoding-finished", lager.Data{"total_time": totalTime})
+		err = reEncodingDuration.Send(totalTime)
+		if err != nil {
+			logger.Error("failed-to-send-re-encoding-duration-metrics", err)
+		}
+	}
+
+	<-signals
+	return nil
+}
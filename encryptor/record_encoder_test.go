@@ -0,0 +1,93 @@
+package encryptor_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/encryptor"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/cloudfoundry/dropsonde/metric_sender/fake"
+	"github.com/cloudfoundry/dropsonde/metrics"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tedsuo/ifrit"
+	"github.com/tedsuo/ifrit/ginkgomon"
+)
+
+var _ = Describe("RecordEncoder", func() {
+	var (
+		process ifrit.Process
+		logger  *lagertest.TestLogger
+		fakeDB  *dbfakes.FakeRecordEncodingDB
+		sender  *fake.FakeMetricSender
+	)
+
+	BeforeEach(func() {
+		sender = fake.NewFakeMetricSender()
+		metrics.Initialize(sender, nil)
+
+		logger = lagertest.NewTestLogger("test")
+		fakeDB = new(dbfakes.FakeRecordEncodingDB)
+		fakeDB.RecordEncodingReturns("", models.ErrResourceNotFound)
+	})
+
+	JustBeforeEach(func() {
+		runner := encryptor.NewRecordEncoder(logger, fakeDB, format.BASE64_ENCRYPTED_COMPRESSED, clock.NewClock())
+		process = ifrit.Background(runner)
+	})
+
+	AfterEach(func() {
+		ginkgomon.Kill(process)
+	})
+
+	Context("when the records use another encoding", func() {
+		It("rewrites them and records the new encoding", func() {
+			Eventually(process.Ready()).Should(BeClosed())
+			Eventually(fakeDB.SetRecordEncodingCallCount).Should(Equal(1))
+			Expect(fakeDB.PerformReEncodingCallCount()).To(Equal(1))
+
+			_, encoding := fakeDB.SetRecordEncodingArgsForCall(0)
+			Expect(encoding).To(Equal("03"))
+		})
+
+		It("reports the duration that it took to rewrite them", func() {
+			Eventually(logger.LogMessages).Should(ContainElement("test.record-encoder.re-encoding-finished"))
+			Expect(sender.GetValue("ReEncodingDuration").Value).NotTo(BeZero())
+		})
+
+		Context("when rewriting fails", func() {
+			BeforeEach(func() {
+				fakeDB.PerformReEncodingReturns(errors.New("boom"))
+			})
+
+			It("does not record the new encoding", func() {
+				Eventually(logger.LogMessages).Should(ContainElement("test.record-encoder.re-encoding-failed"))
+				Expect(fakeDB.SetRecordEncodingCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when the records already use the encoding", func() {
+		BeforeEach(func() {
+			fakeDB.RecordEncodingReturns("03", nil)
+		})
+
+		It("leaves them alone", func() {
+			Eventually(process.Ready()).Should(BeClosed())
+			Consistently(fakeDB.PerformReEncodingCallCount).Should(Equal(0))
+		})
+	})
+
+	Context("when fetching the encoding fails", func() {
+		BeforeEach(func() {
+			fakeDB.RecordEncodingReturns("", errors.New("boom"))
+		})
+
+		It("exits with the error", func() {
+			Eventually(process.Wait()).Should(Receive(MatchError("boom")))
+		})
+	})
+})
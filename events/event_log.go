@@ -0,0 +1,113 @@
+package events
+
+import (
+	"errors"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/models"
+)
+
+var ErrEventsEvicted = errors.New("requested events have been evicted from the log")
+
+// SequencedEvent is an event together with its position in the global event
+// sequence.
+type SequencedEvent struct {
+	ID    uint64
+	Event models.Event
+}
+
+//go:generate counterfeiter -o eventfakes/fake_event_log.go . EventLog
+
+// EventLog assigns every emitted event a monotonically increasing ID and
+// retains recent events so that subscribers can resume after reconnecting.
+type EventLog interface {
+	// Append assigns the next ID to the event, records it and hands the result
+	// to deliver. Deliveries happen while the log is locked, so subscribers of
+	// several hubs sharing a log see events in ID order.
+	Append(event models.Event, deliver func(SequencedEvent))
+
+	// Since returns the retained events matching filter with IDs greater than
+	// id. It returns ErrEventsEvicted if events following id are no longer
+	// retained or id was not issued by this log.
+	Since(id uint64, filter models.EventFilter) ([]SequencedEvent, error)
+
+	// LastID returns the ID of the latest event, or the ID preceding the first
+	// one if none has been appended yet. Resuming from it misses no event
+	// appended afterwards.
+	LastID() uint64
+}
+
+type eventLog struct {
+	events   []SequencedEvent
+	capacity int
+	start    int
+	nextID   uint64
+	firstID  uint64
+	lock     sync.Mutex
+}
+
+// NewEventLog returns an in-memory EventLog retaining up to capacity events.
+// IDs start at firstID; seeding it from the clock keeps IDs issued before a
+// restart from being mistaken for IDs issued after it. The events are not
+// shared with other BBS instances and are lost when this one stops.
+func NewEventLog(capacity int, firstID uint64) EventLog {
+	return &eventLog{
+		events:   make([]SequencedEvent, 0, capacity),
+		capacity: capacity,
+		nextID:   firstID,
+		firstID:  firstID,
+	}
+}
+
+func (l *eventLog) Append(event models.Event, deliver func(SequencedEvent)) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	sequenced := SequencedEvent{ID: l.nextID, Event: event}
+	l.nextID++
+
+	if l.capacity > 0 {
+		if len(l.events) < l.capacity {
+			l.events = append(l.events, sequenced)
+		} else {
+			l.events[l.start] = sequenced
+			l.start = (l.start + 1) % l.capacity
+		}
+	}
+
+	deliver(sequenced)
+}
+
+func (l *eventLog) Since(id uint64, filter models.EventFilter) ([]SequencedEvent, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if id+1 == l.nextID {
+		return []SequencedEvent{}, nil
+	}
+
+	if id+1 < l.firstID || id >= l.nextID {
+		return nil, ErrEventsEvicted
+	}
+
+	if len(l.events) == 0 || l.events[l.start].ID > id+1 {
+		return nil, ErrEventsEvicted
+	}
+
+	events := []SequencedEvent{}
+	for i := 0; i < len(l.events); i++ {
+		event := l.events[(l.start+i)%len(l.events)]
+		if event.ID > id && filter.Matches(event.Event) {
+			events = append(events, event)
+		}
+	}
+
+	return events, nil
+}
+
+func (l *eventLog) LastID() uint64 {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return l.nextID - 1
+}
@@ -0,0 +1,125 @@
+package events_test
+
+import (
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/events/eventfakes"
+	"code.cloudfoundry.org/bbs/models"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EventLog", func() {
+	var (
+		eventLog  events.EventLog
+		delivered []events.SequencedEvent
+	)
+
+	deliver := func(event events.SequencedEvent) {
+		delivered = append(delivered, event)
+	}
+
+	BeforeEach(func() {
+		delivered = nil
+		eventLog = events.NewEventLog(3, 100)
+	})
+
+	Describe("Append", func() {
+		It("delivers events with increasing IDs starting at the first ID", func() {
+			eventLog.Append(eventfakes.FakeEvent{Token: "A"}, deliver)
+			eventLog.Append(eventfakes.FakeEvent{Token: "B"}, deliver)
+
+			Expect(delivered).To(Equal([]events.SequencedEvent{
+				{ID: 100, Event: eventfakes.FakeEvent{Token: "A"}},
+				{ID: 101, Event: eventfakes.FakeEvent{Token: "B"}},
+			}))
+		})
+	})
+
+	Describe("Since", func() {
+		BeforeEach(func() {
+			for _, token := range []string{"A", "B", "C", "D"} {
+				eventLog.Append(eventfakes.FakeEvent{Token: token}, deliver)
+			}
+		})
+
+		It("returns the retained events after the ID", func() {
+			replay, err := eventLog.Since(101, models.EventFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(replay).To(Equal([]events.SequencedEvent{
+				{ID: 102, Event: eventfakes.FakeEvent{Token: "C"}},
+				{ID: 103, Event: eventfakes.FakeEvent{Token: "D"}},
+			}))
+		})
+
+		It("returns no events when the ID is the latest", func() {
+			replay, err := eventLog.Since(103, models.EventFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(replay).To(BeEmpty())
+		})
+
+		It("only returns events matching the filter", func() {
+			replay, err := eventLog.Since(101, models.EventFilter{EventTypes: []string{"other"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(replay).To(BeEmpty())
+		})
+
+		It("returns all retained events when the oldest follows the ID", func() {
+			replay, err := eventLog.Since(100, models.EventFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(replay).To(HaveLen(3))
+		})
+
+		Context("when events following the ID have been evicted", func() {
+			BeforeEach(func() {
+				eventLog.Append(eventfakes.FakeEvent{Token: "E"}, deliver)
+			})
+
+			It("returns ErrEventsEvicted", func() {
+				_, err := eventLog.Since(100, models.EventFilter{})
+				Expect(err).To(Equal(events.ErrEventsEvicted))
+			})
+		})
+
+		Context("when the ID precedes the first ID of the log", func() {
+			It("returns ErrEventsEvicted", func() {
+				_, err := eventLog.Since(50, models.EventFilter{})
+				Expect(err).To(Equal(events.ErrEventsEvicted))
+			})
+		})
+
+		Context("when the ID has not been issued yet", func() {
+			It("returns ErrEventsEvicted", func() {
+				_, err := eventLog.Since(104, models.EventFilter{})
+				Expect(err).To(Equal(events.ErrEventsEvicted))
+			})
+		})
+	})
+
+	Describe("LastID", func() {
+		It("returns the ID preceding the first one before any event is appended", func() {
+			Expect(eventLog.LastID()).To(BeEquivalentTo(99))
+
+			replay, err := eventLog.Since(eventLog.LastID(), models.EventFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(replay).To(BeEmpty())
+		})
+
+		It("returns the ID of the latest event", func() {
+			eventLog.Append(eventfakes.FakeEvent{Token: "A"}, deliver)
+			eventLog.Append(eventfakes.FakeEvent{Token: "B"}, deliver)
+			Expect(eventLog.LastID()).To(BeEquivalentTo(101))
+		})
+
+		It("resumes from before the first event", func() {
+			lastID := eventLog.LastID()
+			eventLog.Append(eventfakes.FakeEvent{Token: "A"}, deliver)
+
+			replay, err := eventLog.Since(lastID, models.EventFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(replay).To(Equal([]events.SequencedEvent{
+				{ID: 100, Event: eventfakes.FakeEvent{Token: "A"}},
+			}))
+		})
+	})
+})
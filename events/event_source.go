@@ -2,6 +2,7 @@ package events
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -55,7 +56,7 @@ func (e closeError) Error() string {
 	return fmt.Sprintf("error closing raw source: %s", e.err.Error())
 }
 
-func NewEventFromModelEvent(eventID int, event models.Event) (sse.Event, error) {
+func NewEventFromModelEvent(eventID uint64, event models.Event) (sse.Event, error) {
 	payload, err := proto.Marshal(event)
 	if err != nil {
 		return sse.Event{}, err
@@ -63,12 +64,51 @@ func NewEventFromModelEvent(eventID int, event models.Event) (sse.Event, error)
 
 	encodedPayload := base64.StdEncoding.EncodeToString(payload)
 	return sse.Event{
-		ID:   strconv.Itoa(eventID),
+		ID:   strconv.FormatUint(eventID, 10),
 		Name: string(event.EventType()),
 		Data: []byte(encodedPayload),
 	}, nil
 }
 
+// NewJSONEventFromModelEvent encodes an event for clients streaming events as
+// JSON rather than protobuf.
+func NewJSONEventFromModelEvent(eventID uint64, event models.Event) (sse.Event, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return sse.Event{}, err
+	}
+
+	return sse.Event{
+		ID:   strconv.FormatUint(eventID, 10),
+		Name: string(event.EventType()),
+		Data: payload,
+	}, nil
+}
+
+// NewEnvelopeFromModelEvent wraps an event for delivery over a gRPC event
+// stream.
+func NewEnvelopeFromModelEvent(eventID uint64, event models.Event) (*models.EventEnvelope, error) {
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.EventEnvelope{
+		Id:      eventID,
+		Type:    event.EventType(),
+		Payload: payload,
+	}, nil
+}
+
+// NewEventFromEnvelope decodes an event received over a gRPC event stream.
+func NewEventFromEnvelope(envelope *models.EventEnvelope) (models.Event, error) {
+	if len(envelope.Payload) == 0 {
+		return nil, NewInvalidPayloadError(envelope.Type, ErrNoData)
+	}
+
+	return decodeEvent(envelope.Type, envelope.Payload)
+}
+
 //go:generate counterfeiter -o eventfakes/fake_event_source.go . EventSource
 
 // EventSource provides sequential access to a stream of events.
@@ -138,12 +178,16 @@ func parseRawEvent(rawEvent sse.Event) (models.Event, error) {
 		return nil, NewInvalidPayloadError(rawEvent.Name, err)
 	}
 
-	switch rawEvent.Name {
+	return decodeEvent(rawEvent.Name, data)
+}
+
+func decodeEvent(eventType string, data []byte) (models.Event, error) {
+	switch eventType {
 	case models.EventTypeDesiredLRPCreated:
 		event := new(models.DesiredLRPCreatedEvent)
 		err := proto.Unmarshal(data, event)
 		if err != nil {
-			return nil, NewInvalidPayloadError(rawEvent.Name, err)
+			return nil, NewInvalidPayloadError(eventType, err)
 		}
 
 		return event, nil
@@ -152,7 +196,7 @@ func parseRawEvent(rawEvent sse.Event) (models.Event, error) {
 		event := new(models.DesiredLRPChangedEvent)
 		err := proto.Unmarshal(data, event)
 		if err != nil {
-			return nil, NewInvalidPayloadError(rawEvent.Name, err)
+			return nil, NewInvalidPayloadError(eventType, err)
 		}
 
 		return event, nil
@@ -161,7 +205,7 @@ func parseRawEvent(rawEvent sse.Event) (models.Event, error) {
 		event := new(models.DesiredLRPRemovedEvent)
 		err := proto.Unmarshal(data, event)
 		if err != nil {
-			return nil, NewInvalidPayloadError(rawEvent.Name, err)
+			return nil, NewInvalidPayloadError(eventType, err)
 		}
 
 		return event, nil
@@ -170,7 +214,7 @@ func parseRawEvent(rawEvent sse.Event) (models.Event, error) {
 		event := new(models.ActualLRPCreatedEvent)
 		err := proto.Unmarshal(data, event)
 		if err != nil {
-			return nil, NewInvalidPayloadError(rawEvent.Name, err)
+			return nil, NewInvalidPayloadError(eventType, err)
 		}
 
 		return event, nil
@@ -179,7 +223,7 @@ func parseRawEvent(rawEvent sse.Event) (models.Event, error) {
 		event := new(models.ActualLRPChangedEvent)
 		err := proto.Unmarshal(data, event)
 		if err != nil {
-			return nil, NewInvalidPayloadError(rawEvent.Name, err)
+			return nil, NewInvalidPayloadError(eventType, err)
 		}
 
 		return event, nil
@@ -188,7 +232,7 @@ func parseRawEvent(rawEvent sse.Event) (models.Event, error) {
 		event := new(models.ActualLRPRemovedEvent)
 		err := proto.Unmarshal(data, event)
 		if err != nil {
-			return nil, NewInvalidPayloadError(rawEvent.Name, err)
+			return nil, NewInvalidPayloadError(eventType, err)
 		}
 
 		return event, nil
@@ -197,7 +241,43 @@ func parseRawEvent(rawEvent sse.Event) (models.Event, error) {
 		event := new(models.ActualLRPCrashedEvent)
 		err := proto.Unmarshal(data, event)
 		if err != nil {
-			return nil, NewInvalidPayloadError(rawEvent.Name, err)
+			return nil, NewInvalidPayloadError(eventType, err)
+		}
+
+		return event, nil
+
+	case models.EventTypeTaskCreated:
+		event := new(models.TaskCreatedEvent)
+		err := proto.Unmarshal(data, event)
+		if err != nil {
+			return nil, NewInvalidPayloadError(eventType, err)
+		}
+
+		return event, nil
+
+	case models.EventTypeTaskChanged:
+		event := new(models.TaskChangedEvent)
+		err := proto.Unmarshal(data, event)
+		if err != nil {
+			return nil, NewInvalidPayloadError(eventType, err)
+		}
+
+		return event, nil
+
+	case models.EventTypeTaskRemoved:
+		event := new(models.TaskRemovedEvent)
+		err := proto.Unmarshal(data, event)
+		if err != nil {
+			return nil, NewInvalidPayloadError(eventType, err)
+		}
+
+		return event, nil
+
+	case models.EventTypeResyncRequired:
+		event := new(models.ResyncRequiredEvent)
+		err := proto.Unmarshal(data, event)
+		if err != nil {
+			return nil, NewInvalidPayloadError(eventType, err)
 		}
 
 		return event, nil
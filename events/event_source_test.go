@@ -253,6 +253,141 @@ var _ = Describe("EventSource", func() {
 			})
 		})
 
+		Describe("Task Events", func() {
+			var task *models.Task
+
+			BeforeEach(func() {
+				task = &models.Task{
+					TaskGuid: "some-guid",
+					Domain:   "some-domain",
+					State:    models.Task_Pending,
+					TaskDefinition: &models.TaskDefinition{
+						RootFs: "some-rootfs",
+						Action: models.WrapAction(&models.RunAction{
+							Path: "true",
+							User: "theuser",
+						}),
+					},
+				}
+			})
+
+			Context("when receiving a TaskCreatedEvent", func() {
+				var expectedEvent *models.TaskCreatedEvent
+
+				BeforeEach(func() {
+					expectedEvent = models.NewTaskCreatedEvent(task)
+					payload, err := proto.Marshal(expectedEvent)
+					Expect(err).NotTo(HaveOccurred())
+					payload = []byte(base64.StdEncoding.EncodeToString(payload))
+
+					fakeRawEventSource.NextReturns(
+						sse.Event{
+							ID:   "sup",
+							Name: string(expectedEvent.EventType()),
+							Data: payload,
+						},
+						nil,
+					)
+				})
+
+				It("returns the event", func() {
+					event, err := eventSource.Next()
+					Expect(err).NotTo(HaveOccurred())
+
+					taskCreatedEvent, ok := event.(*models.TaskCreatedEvent)
+					Expect(ok).To(BeTrue())
+					Expect(taskCreatedEvent).To(Equal(expectedEvent))
+				})
+			})
+
+			Context("when receiving a TaskChangedEvent", func() {
+				var expectedEvent *models.TaskChangedEvent
+
+				BeforeEach(func() {
+					expectedEvent = models.NewTaskChangedEvent(task, task)
+					payload, err := proto.Marshal(expectedEvent)
+					Expect(err).NotTo(HaveOccurred())
+					payload = []byte(base64.StdEncoding.EncodeToString(payload))
+
+					fakeRawEventSource.NextReturns(
+						sse.Event{
+							ID:   "sup",
+							Name: string(expectedEvent.EventType()),
+							Data: payload,
+						},
+						nil,
+					)
+				})
+
+				It("returns the event", func() {
+					event, err := eventSource.Next()
+					Expect(err).NotTo(HaveOccurred())
+
+					taskChangedEvent, ok := event.(*models.TaskChangedEvent)
+					Expect(ok).To(BeTrue())
+					Expect(taskChangedEvent).To(Equal(expectedEvent))
+				})
+			})
+
+			Context("when receiving a TaskRemovedEvent", func() {
+				var expectedEvent *models.TaskRemovedEvent
+
+				BeforeEach(func() {
+					expectedEvent = models.NewTaskRemovedEvent(task)
+					payload, err := proto.Marshal(expectedEvent)
+					Expect(err).NotTo(HaveOccurred())
+					payload = []byte(base64.StdEncoding.EncodeToString(payload))
+
+					fakeRawEventSource.NextReturns(
+						sse.Event{
+							ID:   "sup",
+							Name: string(expectedEvent.EventType()),
+							Data: payload,
+						},
+						nil,
+					)
+				})
+
+				It("returns the event", func() {
+					event, err := eventSource.Next()
+					Expect(err).NotTo(HaveOccurred())
+
+					taskRemovedEvent, ok := event.(*models.TaskRemovedEvent)
+					Expect(ok).To(BeTrue())
+					Expect(taskRemovedEvent).To(Equal(expectedEvent))
+				})
+			})
+
+			Context("when receiving a ResyncRequiredEvent", func() {
+				var expectedEvent *models.ResyncRequiredEvent
+
+				BeforeEach(func() {
+					expectedEvent = models.NewResyncRequiredEvent(42)
+					payload, err := proto.Marshal(expectedEvent)
+					Expect(err).NotTo(HaveOccurred())
+					payload = []byte(base64.StdEncoding.EncodeToString(payload))
+
+					fakeRawEventSource.NextReturns(
+						sse.Event{
+							ID:   "42",
+							Name: string(expectedEvent.EventType()),
+							Data: payload,
+						},
+						nil,
+					)
+				})
+
+				It("returns the event", func() {
+					event, err := eventSource.Next()
+					Expect(err).NotTo(HaveOccurred())
+
+					resyncRequiredEvent, ok := event.(*models.ResyncRequiredEvent)
+					Expect(ok).To(BeTrue())
+					Expect(resyncRequiredEvent).To(Equal(expectedEvent))
+				})
+			})
+		})
+
 		Context("when receiving an unrecognized event", func() {
 			BeforeEach(func() {
 				payload := []byte(base64.StdEncoding.EncodeToString([]byte("garbage")))
@@ -401,4 +536,33 @@ var _ = Describe("EventSource", func() {
 			})
 		})
 	})
+
+	Describe("event envelopes", func() {
+		It("round-trips events through an envelope", func() {
+			event := models.NewTaskRemovedEvent(&models.Task{TaskGuid: "some-guid", Domain: "some-domain"})
+
+			envelope, err := events.NewEnvelopeFromModelEvent(42, event)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(envelope.Id).To(BeEquivalentTo(42))
+			Expect(envelope.Type).To(Equal(models.EventTypeTaskRemoved))
+
+			decoded, err := events.NewEventFromEnvelope(envelope)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decoded).To(Equal(event))
+		})
+
+		Context("when the envelope has no payload", func() {
+			It("returns an invalid payload error", func() {
+				_, err := events.NewEventFromEnvelope(&models.EventEnvelope{Type: models.EventTypeTaskRemoved})
+				Expect(err).To(Equal(events.NewInvalidPayloadError(models.EventTypeTaskRemoved, events.ErrNoData)))
+			})
+		})
+
+		Context("when the envelope has an unrecognized type", func() {
+			It("returns an unrecognized event error", func() {
+				_, err := events.NewEventFromEnvelope(&models.EventEnvelope{Type: "unrecognized", Payload: []byte("data")})
+				Expect(err).To(Equal(events.ErrUnrecognizedEventType))
+			})
+		})
+	})
 })
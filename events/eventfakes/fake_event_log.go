@@ -0,0 +1,147 @@
+// This file was generated by counterfeiter
+package eventfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/models"
+)
+
+type FakeEventLog struct {
+	AppendStub        func(event models.Event, deliver func(events.SequencedEvent))
+	appendMutex       sync.RWMutex
+	appendArgsForCall []struct {
+		event   models.Event
+		deliver func(events.SequencedEvent)
+	}
+	SinceStub        func(id uint64, filter models.EventFilter) ([]events.SequencedEvent, error)
+	sinceMutex       sync.RWMutex
+	sinceArgsForCall []struct {
+		id     uint64
+		filter models.EventFilter
+	}
+	sinceReturns struct {
+		result1 []events.SequencedEvent
+		result2 error
+	}
+	LastIDStub        func() uint64
+	lastIDMutex       sync.RWMutex
+	lastIDArgsForCall []struct{}
+	lastIDReturns     struct {
+		result1 uint64
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeEventLog) Append(event models.Event, deliver func(events.SequencedEvent)) {
+	fake.appendMutex.Lock()
+	fake.appendArgsForCall = append(fake.appendArgsForCall, struct {
+		event   models.Event
+		deliver func(events.SequencedEvent)
+	}{event, deliver})
+	fake.recordInvocation("Append", []interface{}{event, deliver})
+	fake.appendMutex.Unlock()
+	if fake.AppendStub != nil {
+		fake.AppendStub(event, deliver)
+	}
+}
+
+func (fake *FakeEventLog) AppendCallCount() int {
+	fake.appendMutex.RLock()
+	defer fake.appendMutex.RUnlock()
+	return len(fake.appendArgsForCall)
+}
+
+func (fake *FakeEventLog) AppendArgsForCall(i int) (models.Event, func(events.SequencedEvent)) {
+	fake.appendMutex.RLock()
+	defer fake.appendMutex.RUnlock()
+	return fake.appendArgsForCall[i].event, fake.appendArgsForCall[i].deliver
+}
+
+func (fake *FakeEventLog) Since(id uint64, filter models.EventFilter) ([]events.SequencedEvent, error) {
+	fake.sinceMutex.Lock()
+	fake.sinceArgsForCall = append(fake.sinceArgsForCall, struct {
+		id     uint64
+		filter models.EventFilter
+	}{id, filter})
+	fake.recordInvocation("Since", []interface{}{id, filter})
+	fake.sinceMutex.Unlock()
+	if fake.SinceStub != nil {
+		return fake.SinceStub(id, filter)
+	} else {
+		return fake.sinceReturns.result1, fake.sinceReturns.result2
+	}
+}
+
+func (fake *FakeEventLog) SinceCallCount() int {
+	fake.sinceMutex.RLock()
+	defer fake.sinceMutex.RUnlock()
+	return len(fake.sinceArgsForCall)
+}
+
+func (fake *FakeEventLog) SinceArgsForCall(i int) (uint64, models.EventFilter) {
+	fake.sinceMutex.RLock()
+	defer fake.sinceMutex.RUnlock()
+	return fake.sinceArgsForCall[i].id, fake.sinceArgsForCall[i].filter
+}
+
+func (fake *FakeEventLog) SinceReturns(result1 []events.SequencedEvent, result2 error) {
+	fake.SinceStub = nil
+	fake.sinceReturns = struct {
+		result1 []events.SequencedEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEventLog) LastID() uint64 {
+	fake.lastIDMutex.Lock()
+	fake.lastIDArgsForCall = append(fake.lastIDArgsForCall, struct{}{})
+	fake.recordInvocation("LastID", []interface{}{})
+	fake.lastIDMutex.Unlock()
+	if fake.LastIDStub != nil {
+		return fake.LastIDStub()
+	} else {
+		return fake.lastIDReturns.result1
+	}
+}
+
+func (fake *FakeEventLog) LastIDCallCount() int {
+	fake.lastIDMutex.RLock()
+	defer fake.lastIDMutex.RUnlock()
+	return len(fake.lastIDArgsForCall)
+}
+
+func (fake *FakeEventLog) LastIDReturns(result1 uint64) {
+	fake.LastIDStub = nil
+	fake.lastIDReturns = struct {
+		result1 uint64
+	}{result1}
+}
+
+func (fake *FakeEventLog) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.appendMutex.RLock()
+	defer fake.appendMutex.RUnlock()
+	fake.sinceMutex.RLock()
+	defer fake.sinceMutex.RUnlock()
+	fake.lastIDMutex.RLock()
+	defer fake.lastIDMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeEventLog) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ events.EventLog = new(FakeEventLog)
@@ -16,6 +16,15 @@ type FakeHub struct {
 		result1 events.EventSource
 		result2 error
 	}
+	SubscribeWithFilterStub        func(filter models.EventFilter) (events.EventSource, error)
+	subscribeWithFilterMutex       sync.RWMutex
+	subscribeWithFilterArgsForCall []struct {
+		filter models.EventFilter
+	}
+	subscribeWithFilterReturns struct {
+		result1 events.EventSource
+		result2 error
+	}
 	EmitStub        func(models.Event)
 	emitMutex       sync.RWMutex
 	emitArgsForCall []struct {
@@ -65,6 +74,40 @@ func (fake *FakeHub) SubscribeReturns(result1 events.EventSource, result2 error)
 	}{result1, result2}
 }
 
+func (fake *FakeHub) SubscribeWithFilter(filter models.EventFilter) (events.EventSource, error) {
+	fake.subscribeWithFilterMutex.Lock()
+	fake.subscribeWithFilterArgsForCall = append(fake.subscribeWithFilterArgsForCall, struct {
+		filter models.EventFilter
+	}{filter})
+	fake.recordInvocation("SubscribeWithFilter", []interface{}{filter})
+	fake.subscribeWithFilterMutex.Unlock()
+	if fake.SubscribeWithFilterStub != nil {
+		return fake.SubscribeWithFilterStub(filter)
+	} else {
+		return fake.subscribeWithFilterReturns.result1, fake.subscribeWithFilterReturns.result2
+	}
+}
+
+func (fake *FakeHub) SubscribeWithFilterCallCount() int {
+	fake.subscribeWithFilterMutex.RLock()
+	defer fake.subscribeWithFilterMutex.RUnlock()
+	return len(fake.subscribeWithFilterArgsForCall)
+}
+
+func (fake *FakeHub) SubscribeWithFilterArgsForCall(i int) models.EventFilter {
+	fake.subscribeWithFilterMutex.RLock()
+	defer fake.subscribeWithFilterMutex.RUnlock()
+	return fake.subscribeWithFilterArgsForCall[i].filter
+}
+
+func (fake *FakeHub) SubscribeWithFilterReturns(result1 events.EventSource, result2 error) {
+	fake.SubscribeWithFilterStub = nil
+	fake.subscribeWithFilterReturns = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeHub) Emit(arg1 models.Event) {
 	fake.emitMutex.Lock()
 	fake.emitArgsForCall = append(fake.emitArgsForCall, struct {
@@ -159,6 +202,8 @@ func (fake *FakeHub) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.subscribeMutex.RLock()
 	defer fake.subscribeMutex.RUnlock()
+	fake.subscribeWithFilterMutex.RLock()
+	defer fake.subscribeWithFilterMutex.RUnlock()
 	fake.emitMutex.RLock()
 	defer fake.emitMutex.RUnlock()
 	fake.closeMutex.RLock()
@@ -16,10 +16,12 @@ var ErrSlowConsumer = errors.New("slow consumer")
 
 var ErrSubscribedToClosedHub = errors.New("subscribed to closed hub")
 var ErrHubAlreadyClosed = errors.New("hub already closed")
+var ErrUnsupportedHub = errors.New("unsupported hub")
 
 //go:generate counterfeiter -o eventfakes/fake_hub.go . Hub
 type Hub interface {
 	Subscribe() (EventSource, error)
+	SubscribeWithFilter(filter models.EventFilter) (EventSource, error)
 	Emit(models.Event)
 	Close() error
 
@@ -27,17 +29,34 @@ type Hub interface {
 	UnregisterCallback()
 }
 
+// SequencedEventSource is an EventSource that also exposes the ID each event
+// was assigned by the EventLog of the hub it was emitted on.
+type SequencedEventSource interface {
+	EventSource
+	NextSequenced() (SequencedEvent, error)
+}
+
 type hub struct {
 	subscribers map[*hubSource]struct{}
 	closed      bool
 	lock        sync.Mutex
+	log         EventLog
 
 	cb func(count int)
 }
 
+// NewHub returns a hub that numbers its own events from zero and retains none
+// of them for replay.
 func NewHub() Hub {
+	return NewHubWithLog(NewEventLog(0, 0))
+}
+
+// NewHubWithLog returns a hub that records every emitted event in log. Hubs
+// sharing a log share a single event sequence.
+func NewHubWithLog(log EventLog) Hub {
 	return &hub{
 		subscribers: make(map[*hubSource]struct{}),
+		log:         log,
 	}
 }
 
@@ -58,15 +77,57 @@ func (hub *hub) UnregisterCallback() {
 }
 
 func (hub *hub) Subscribe() (EventSource, error) {
+	return hub.SubscribeWithFilter(models.EventFilter{})
+}
+
+func (hub *hub) SubscribeWithFilter(filter models.EventFilter) (EventSource, error) {
+	sub := newSource(MAX_PENDING_SUBSCRIBER_EVENTS, filter, hub.subscriberClosed)
+	err := hub.addSubscriber(sub)
+	if err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+// SubscribeToHubs returns a single source receiving the events matching filter
+// from all of the given hubs. When the hubs share an EventLog the source
+// receives their events in ID order.
+func SubscribeToHubs(filter models.EventFilter, hubs ...Hub) (SequencedEventSource, error) {
+	subscribedHubs := make([]*hub, 0, len(hubs))
+	for _, h := range hubs {
+		concreteHub, ok := h.(*hub)
+		if !ok {
+			return nil, ErrUnsupportedHub
+		}
+		subscribedHubs = append(subscribedHubs, concreteHub)
+	}
+
+	sub := newSource(MAX_PENDING_SUBSCRIBER_EVENTS, filter, func(source *hubSource) {
+		for _, h := range subscribedHubs {
+			h.subscriberClosed(source)
+		}
+	})
+
+	for _, h := range subscribedHubs {
+		err := h.addSubscriber(sub)
+		if err != nil {
+			_ = sub.Close()
+			return nil, err
+		}
+	}
+
+	return sub, nil
+}
+
+func (hub *hub) addSubscriber(sub *hubSource) error {
 	hub.lock.Lock()
 
 	if hub.closed {
 		hub.lock.Unlock()
 
-		return nil, ErrSubscribedToClosedHub
+		return ErrSubscribedToClosedHub
 	}
 
-	sub := newSource(MAX_PENDING_SUBSCRIBER_EVENTS, hub.subscriberClosed)
 	hub.subscribers[sub] = struct{}{}
 	cb := hub.cb
 	size := len(hub.subscribers)
@@ -75,19 +136,25 @@ func (hub *hub) Subscribe() (EventSource, error) {
 	if cb != nil {
 		cb(size)
 	}
-	return sub, nil
+	return nil
 }
 
 func (hub *hub) Emit(event models.Event) {
 	hub.lock.Lock()
 	size := len(hub.subscribers)
 
-	for sub, _ := range hub.subscribers {
-		err := sub.send(event)
-		if err != nil {
-			delete(hub.subscribers, sub)
+	hub.log.Append(event, func(sequenced SequencedEvent) {
+		for sub, _ := range hub.subscribers {
+			if !sub.filter.Matches(event) {
+				continue
+			}
+
+			err := sub.send(sequenced)
+			if err != nil {
+				delete(hub.subscribers, sub)
+			}
 		}
-	}
+	})
 
 	var cb func(int)
 	if len(hub.subscribers) != size {
@@ -137,23 +204,33 @@ func (hub *hub) subscriberClosed(source *hubSource) {
 }
 
 type hubSource struct {
-	events        chan models.Event
+	events        chan SequencedEvent
+	filter        models.EventFilter
 	closeCallback func(*hubSource)
 	closed        bool
 	lock          sync.Mutex
 }
 
-func newSource(maxPendingEvents int, closeCallback func(*hubSource)) *hubSource {
+func newSource(maxPendingEvents int, filter models.EventFilter, closeCallback func(*hubSource)) *hubSource {
 	return &hubSource{
-		events:        make(chan models.Event, maxPendingEvents),
+		events:        make(chan SequencedEvent, maxPendingEvents),
+		filter:        filter,
 		closeCallback: closeCallback,
 	}
 }
 
 func (source *hubSource) Next() (models.Event, error) {
+	event, err := source.NextSequenced()
+	if err != nil {
+		return nil, err
+	}
+	return event.Event, nil
+}
+
+func (source *hubSource) NextSequenced() (SequencedEvent, error) {
 	event, ok := <-source.events
 	if !ok {
-		return nil, ErrReadFromClosedSource
+		return SequencedEvent{}, ErrReadFromClosedSource
 	}
 	return event, nil
 }
@@ -171,7 +248,7 @@ func (source *hubSource) Close() error {
 	return nil
 }
 
-func (source *hubSource) send(event models.Event) error {
+func (source *hubSource) send(event SequencedEvent) error {
 	source.lock.Lock()
 
 	if source.closed {
@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/bbs/events"
 	"code.cloudfoundry.org/bbs/events/eventfakes"
+	"code.cloudfoundry.org/bbs/models"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -99,6 +100,120 @@ var _ = Describe("Hub", func() {
 		Expect(source2.Next()).To(Equal(eventfakes.FakeEvent{Token: "2"}))
 	})
 
+	Describe("SubscribeWithFilter", func() {
+		It("only delivers events matching the filter", func() {
+			filtered, err := hub.SubscribeWithFilter(models.EventFilter{EventTypes: []string{"other"}})
+			Expect(err).NotTo(HaveOccurred())
+			unfiltered, err := hub.Subscribe()
+			Expect(err).NotTo(HaveOccurred())
+
+			hub.Emit(eventfakes.FakeEvent{Token: "1"})
+			hub.Emit(otherEvent{eventfakes.FakeEvent{Token: "2"}})
+
+			Expect(unfiltered.Next()).To(Equal(eventfakes.FakeEvent{Token: "1"}))
+			Expect(unfiltered.Next()).To(Equal(otherEvent{eventfakes.FakeEvent{Token: "2"}}))
+			Expect(filtered.Next()).To(Equal(otherEvent{eventfakes.FakeEvent{Token: "2"}}))
+		})
+
+		It("does not count filtered events against slow consumers", func() {
+			filtered, err := hub.SubscribeWithFilter(models.EventFilter{EventTypes: []string{"other"}})
+			Expect(err).NotTo(HaveOccurred())
+
+			for eventToken := 0; eventToken < events.MAX_PENDING_SUBSCRIBER_EVENTS+1; eventToken++ {
+				hub.Emit(eventfakes.FakeEvent{Token: strconv.Itoa(eventToken)})
+			}
+
+			hub.Emit(otherEvent{eventfakes.FakeEvent{Token: "A"}})
+			Expect(filtered.Next()).To(Equal(otherEvent{eventfakes.FakeEvent{Token: "A"}}))
+		})
+
+		Context("when the hub is closed", func() {
+			BeforeEach(func() {
+				err := hub.Close()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns an error", func() {
+				_, err := hub.SubscribeWithFilter(models.EventFilter{})
+				Expect(err).To(Equal(events.ErrSubscribedToClosedHub))
+			})
+		})
+	})
+
+	Describe("SubscribeToHubs", func() {
+		var (
+			eventLog  events.EventLog
+			otherHub  events.Hub
+			sharedHub events.Hub
+		)
+
+		BeforeEach(func() {
+			eventLog = events.NewEventLog(10, 5)
+			sharedHub = events.NewHubWithLog(eventLog)
+			otherHub = events.NewHubWithLog(eventLog)
+		})
+
+		It("delivers the events of all hubs in sequence order", func() {
+			source, err := events.SubscribeToHubs(models.EventFilter{}, sharedHub, otherHub)
+			Expect(err).NotTo(HaveOccurred())
+
+			sharedHub.Emit(eventfakes.FakeEvent{Token: "A"})
+			otherHub.Emit(eventfakes.FakeEvent{Token: "B"})
+			sharedHub.Emit(eventfakes.FakeEvent{Token: "C"})
+
+			Expect(source.NextSequenced()).To(Equal(events.SequencedEvent{ID: 5, Event: eventfakes.FakeEvent{Token: "A"}}))
+			Expect(source.NextSequenced()).To(Equal(events.SequencedEvent{ID: 6, Event: eventfakes.FakeEvent{Token: "B"}}))
+			Expect(source.NextSequenced()).To(Equal(events.SequencedEvent{ID: 7, Event: eventfakes.FakeEvent{Token: "C"}}))
+		})
+
+		It("records the emitted events in the log", func() {
+			sharedHub.Emit(eventfakes.FakeEvent{Token: "A"})
+			otherHub.Emit(eventfakes.FakeEvent{Token: "B"})
+
+			Expect(eventLog.Since(5, models.EventFilter{})).To(Equal([]events.SequencedEvent{
+				{ID: 6, Event: eventfakes.FakeEvent{Token: "B"}},
+			}))
+		})
+
+		It("unsubscribes from every hub when the source is closed", func() {
+			counts := make(chan int, 10)
+			otherHub.RegisterCallback(func(count int) {
+				counts <- count
+			})
+			Eventually(counts).Should(Receive(Equal(0)))
+
+			source, err := events.SubscribeToHubs(models.EventFilter{}, sharedHub, otherHub)
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(counts).Should(Receive(Equal(1)))
+
+			err = sharedHub.Close()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = source.Next()
+			Expect(err).To(Equal(events.ErrReadFromClosedSource))
+			Eventually(counts).Should(Receive(Equal(0)))
+		})
+
+		Context("when one of the hubs is closed", func() {
+			BeforeEach(func() {
+				err := otherHub.Close()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns an error", func() {
+				_, err := events.SubscribeToHubs(models.EventFilter{}, sharedHub, otherHub)
+				Expect(err).To(Equal(events.ErrSubscribedToClosedHub))
+			})
+		})
+
+		Context("when given a hub it did not create", func() {
+			It("returns an error", func() {
+				_, err := events.SubscribeToHubs(models.EventFilter{}, sharedHub, &eventfakes.FakeHub{})
+				Expect(err).To(Equal(events.ErrUnsupportedHub))
+			})
+		})
+	})
+
 	It("closes slow consumers after MAX_PENDING_SUBSCRIBER_EVENTS missed events", func() {
 		slowConsumer, err := hub.Subscribe()
 		Expect(err).NotTo(HaveOccurred())
@@ -227,3 +342,7 @@ var _ = Describe("Hub", func() {
 		})
 	})
 })
+
+type otherEvent struct{ eventfakes.FakeEvent }
+
+func (otherEvent) EventType() string { return "other" }
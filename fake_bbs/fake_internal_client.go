@@ -23,6 +23,16 @@ type FakeInternalClient struct {
 	desireTaskReturns struct {
 		result1 error
 	}
+	DesireTasksStub        func(logger lager.Logger, requests []*models.DesireTaskRequest) ([]*models.TaskLifecycleResult, error)
+	desireTasksMutex       sync.RWMutex
+	desireTasksArgsForCall []struct {
+		logger   lager.Logger
+		requests []*models.DesireTaskRequest
+	}
+	desireTasksReturns struct {
+		result1 []*models.TaskLifecycleResult
+		result2 error
+	}
 	TasksStub        func(logger lager.Logger) ([]*models.Task, error)
 	tasksMutex       sync.RWMutex
 	tasksArgsForCall []struct {
@@ -62,6 +72,15 @@ type FakeInternalClient struct {
 		result1 []*models.Task
 		result2 error
 	}
+	TaskPagesStub        func(logger lager.Logger, filter models.TaskFilter) bbs.TaskIterator
+	taskPagesMutex       sync.RWMutex
+	taskPagesArgsForCall []struct {
+		logger lager.Logger
+		filter models.TaskFilter
+	}
+	taskPagesReturns struct {
+		result1 bbs.TaskIterator
+	}
 	TaskByGuidStub        func(logger lager.Logger, guid string) (*models.Task, error)
 	taskByGuidMutex       sync.RWMutex
 	taskByGuidArgsForCall []struct {
@@ -81,6 +100,16 @@ type FakeInternalClient struct {
 	cancelTaskReturns struct {
 		result1 error
 	}
+	CancelTasksStub        func(logger lager.Logger, taskGuids []string) ([]*models.TaskLifecycleResult, error)
+	cancelTasksMutex       sync.RWMutex
+	cancelTasksArgsForCall []struct {
+		logger    lager.Logger
+		taskGuids []string
+	}
+	cancelTasksReturns struct {
+		result1 []*models.TaskLifecycleResult
+		result2 error
+	}
 	ResolvingTaskStub        func(logger lager.Logger, taskGuid string) error
 	resolvingTaskMutex       sync.RWMutex
 	resolvingTaskArgsForCall []struct {
@@ -99,6 +128,16 @@ type FakeInternalClient struct {
 	deleteTaskReturns struct {
 		result1 error
 	}
+	DeleteTasksStub        func(logger lager.Logger, taskGuids []string) ([]*models.TaskLifecycleResult, error)
+	deleteTasksMutex       sync.RWMutex
+	deleteTasksArgsForCall []struct {
+		logger    lager.Logger
+		taskGuids []string
+	}
+	deleteTasksReturns struct {
+		result1 []*models.TaskLifecycleResult
+		result2 error
+	}
 	DomainsStub        func(logger lager.Logger) ([]string, error)
 	domainsMutex       sync.RWMutex
 	domainsArgsForCall []struct {
@@ -128,6 +167,15 @@ type FakeInternalClient struct {
 		result1 []*models.ActualLRPGroup
 		result2 error
 	}
+	ActualLRPGroupPagesStub        func(lager.Logger, models.ActualLRPFilter) bbs.ActualLRPGroupIterator
+	actualLRPGroupPagesMutex       sync.RWMutex
+	actualLRPGroupPagesArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 models.ActualLRPFilter
+	}
+	actualLRPGroupPagesReturns struct {
+		result1 bbs.ActualLRPGroupIterator
+	}
 	ActualLRPGroupsByProcessGuidStub        func(logger lager.Logger, processGuid string) ([]*models.ActualLRPGroup, error)
 	actualLRPGroupsByProcessGuidMutex       sync.RWMutex
 	actualLRPGroupsByProcessGuidArgsForCall []struct {
@@ -168,6 +216,15 @@ type FakeInternalClient struct {
 		result1 []*models.DesiredLRP
 		result2 error
 	}
+	DesiredLRPPagesStub        func(lager.Logger, models.DesiredLRPFilter) bbs.DesiredLRPIterator
+	desiredLRPPagesMutex       sync.RWMutex
+	desiredLRPPagesArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 models.DesiredLRPFilter
+	}
+	desiredLRPPagesReturns struct {
+		result1 bbs.DesiredLRPIterator
+	}
 	DesiredLRPByProcessGuidStub        func(logger lager.Logger, processGuid string) (*models.DesiredLRP, error)
 	desiredLRPByProcessGuidMutex       sync.RWMutex
 	desiredLRPByProcessGuidArgsForCall []struct {
@@ -197,6 +254,16 @@ type FakeInternalClient struct {
 	desireLRPReturns struct {
 		result1 error
 	}
+	DesireLRPsStub        func(lager.Logger, []*models.DesiredLRP) ([]*models.DesiredLRPLifecycleResult, error)
+	desireLRPsMutex       sync.RWMutex
+	desireLRPsArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 []*models.DesiredLRP
+	}
+	desireLRPsReturns struct {
+		result1 []*models.DesiredLRPLifecycleResult
+		result2 error
+	}
 	UpdateDesiredLRPStub        func(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) error
 	updateDesiredLRPMutex       sync.RWMutex
 	updateDesiredLRPArgsForCall []struct {
@@ -207,6 +274,17 @@ type FakeInternalClient struct {
 	updateDesiredLRPReturns struct {
 		result1 error
 	}
+	UpdateDesiredLRPWithModificationTagStub        func(logger lager.Logger, processGuid string, expectedTag models.ModificationTag, update *models.DesiredLRPUpdate) error
+	updateDesiredLRPWithModificationTagMutex       sync.RWMutex
+	updateDesiredLRPWithModificationTagArgsForCall []struct {
+		logger      lager.Logger
+		processGuid string
+		expectedTag models.ModificationTag
+		update      *models.DesiredLRPUpdate
+	}
+	updateDesiredLRPWithModificationTagReturns struct {
+		result1 error
+	}
 	RemoveDesiredLRPStub        func(logger lager.Logger, processGuid string) error
 	removeDesiredLRPMutex       sync.RWMutex
 	removeDesiredLRPArgsForCall []struct {
@@ -216,6 +294,91 @@ type FakeInternalClient struct {
 	removeDesiredLRPReturns struct {
 		result1 error
 	}
+	RemoveDesiredLRPWithModificationTagStub        func(logger lager.Logger, processGuid string, expectedTag models.ModificationTag) error
+	removeDesiredLRPWithModificationTagMutex       sync.RWMutex
+	removeDesiredLRPWithModificationTagArgsForCall []struct {
+		logger      lager.Logger
+		processGuid string
+		expectedTag models.ModificationTag
+	}
+	removeDesiredLRPWithModificationTagReturns struct {
+		result1 error
+	}
+	RemoveDesiredLRPsStub        func(logger lager.Logger, processGuids []string) ([]*models.DesiredLRPLifecycleResult, error)
+	removeDesiredLRPsMutex       sync.RWMutex
+	removeDesiredLRPsArgsForCall []struct {
+		logger       lager.Logger
+		processGuids []string
+	}
+	removeDesiredLRPsReturns struct {
+		result1 []*models.DesiredLRPLifecycleResult
+		result2 error
+	}
+	ApplyDesiredLRPsStub        func(logger lager.Logger, domain string, desiredLRPs []*models.DesiredLRP, ttl time.Duration) (*models.ApplyDesiredLRPsResponse, error)
+	applyDesiredLRPsMutex       sync.RWMutex
+	applyDesiredLRPsArgsForCall []struct {
+		logger      lager.Logger
+		domain      string
+		desiredLRPs []*models.DesiredLRP
+		ttl         time.Duration
+	}
+	applyDesiredLRPsReturns struct {
+		result1 *models.ApplyDesiredLRPsResponse
+		result2 error
+	}
+	RedeployDesiredLRPStub        func(logger lager.Logger, processGuid string, runInfo *models.DesiredLRPRunInfo, resource *models.DesiredLRPResource, maxInFlight int32) error
+	redeployDesiredLRPMutex       sync.RWMutex
+	redeployDesiredLRPArgsForCall []struct {
+		logger      lager.Logger
+		processGuid string
+		runInfo     *models.DesiredLRPRunInfo
+		resource    *models.DesiredLRPResource
+		maxInFlight int32
+	}
+	redeployDesiredLRPReturns struct {
+		result1 error
+	}
+	DesiredLRPRolloutStub        func(logger lager.Logger, processGuid string) (*models.DesiredLRPRollout, error)
+	desiredLRPRolloutMutex       sync.RWMutex
+	desiredLRPRolloutArgsForCall []struct {
+		logger      lager.Logger
+		processGuid string
+	}
+	desiredLRPRolloutReturns struct {
+		result1 *models.DesiredLRPRollout
+		result2 error
+	}
+	DesiredLRPRevisionsStub        func(logger lager.Logger, processGuid string) ([]*models.DesiredLRPRevision, error)
+	desiredLRPRevisionsMutex       sync.RWMutex
+	desiredLRPRevisionsArgsForCall []struct {
+		logger      lager.Logger
+		processGuid string
+	}
+	desiredLRPRevisionsReturns struct {
+		result1 []*models.DesiredLRPRevision
+		result2 error
+	}
+	DesiredLRPRevisionStub        func(logger lager.Logger, processGuid string, revision int64) (*models.DesiredLRPRevision, error)
+	desiredLRPRevisionMutex       sync.RWMutex
+	desiredLRPRevisionArgsForCall []struct {
+		logger      lager.Logger
+		processGuid string
+		revision    int64
+	}
+	desiredLRPRevisionReturns struct {
+		result1 *models.DesiredLRPRevision
+		result2 error
+	}
+	RollbackDesiredLRPStub        func(logger lager.Logger, processGuid string, revision int64) error
+	rollbackDesiredLRPMutex       sync.RWMutex
+	rollbackDesiredLRPArgsForCall []struct {
+		logger      lager.Logger
+		processGuid string
+		revision    int64
+	}
+	rollbackDesiredLRPReturns struct {
+		result1 error
+	}
 	SubscribeToEventsStub        func(logger lager.Logger) (events.EventSource, error)
 	subscribeToEventsMutex       sync.RWMutex
 	subscribeToEventsArgsForCall []struct {
@@ -225,6 +388,35 @@ type FakeInternalClient struct {
 		result1 events.EventSource
 		result2 error
 	}
+	SubscribeToEventsWithFilterStub        func(logger lager.Logger, filter models.EventFilter) (events.EventSource, error)
+	subscribeToEventsWithFilterMutex       sync.RWMutex
+	subscribeToEventsWithFilterArgsForCall []struct {
+		logger lager.Logger
+		filter models.EventFilter
+	}
+	subscribeToEventsWithFilterReturns struct {
+		result1 events.EventSource
+		result2 error
+	}
+	SubscribeToTaskEventsStub        func(logger lager.Logger) (events.EventSource, error)
+	subscribeToTaskEventsMutex       sync.RWMutex
+	subscribeToTaskEventsArgsForCall []struct {
+		logger lager.Logger
+	}
+	subscribeToTaskEventsReturns struct {
+		result1 events.EventSource
+		result2 error
+	}
+	SubscribeToTaskEventsWithFilterStub        func(logger lager.Logger, filter models.EventFilter) (events.EventSource, error)
+	subscribeToTaskEventsWithFilterMutex       sync.RWMutex
+	subscribeToTaskEventsWithFilterArgsForCall []struct {
+		logger lager.Logger
+		filter models.EventFilter
+	}
+	subscribeToTaskEventsWithFilterReturns struct {
+		result1 events.EventSource
+		result2 error
+	}
 	PingStub        func(logger lager.Logger) bool
 	pingMutex       sync.RWMutex
 	pingArgsForCall []struct {
@@ -242,6 +434,77 @@ type FakeInternalClient struct {
 		result1 []*models.CellPresence
 		result2 error
 	}
+	AuditEventsStub        func(logger lager.Logger, filter models.AuditEventFilter) ([]*models.AuditEvent, error)
+	auditEventsMutex       sync.RWMutex
+	auditEventsArgsForCall []struct {
+		logger lager.Logger
+		filter models.AuditEventFilter
+	}
+	auditEventsReturns struct {
+		result1 []*models.AuditEvent
+		result2 error
+	}
+	QuarantinedRecordsStub        func(logger lager.Logger, tableName string) ([]*models.QuarantinedRecord, error)
+	quarantinedRecordsMutex       sync.RWMutex
+	quarantinedRecordsArgsForCall []struct {
+		logger    lager.Logger
+		tableName string
+	}
+	quarantinedRecordsReturns struct {
+		result1 []*models.QuarantinedRecord
+		result2 error
+	}
+	QuarantinedRecordStub        func(logger lager.Logger, id int64) (*models.QuarantinedRecord, error)
+	quarantinedRecordMutex       sync.RWMutex
+	quarantinedRecordArgsForCall []struct {
+		logger lager.Logger
+		id     int64
+	}
+	quarantinedRecordReturns struct {
+		result1 *models.QuarantinedRecord
+		result2 error
+	}
+	RestoreQuarantinedRecordStub        func(logger lager.Logger, id int64) error
+	restoreQuarantinedRecordMutex       sync.RWMutex
+	restoreQuarantinedRecordArgsForCall []struct {
+		logger lager.Logger
+		id     int64
+	}
+	restoreQuarantinedRecordReturns struct {
+		result1 error
+	}
+	RestoreQuarantinedRecordsStub        func(logger lager.Logger, tableName string) ([]int64, []int64, error)
+	restoreQuarantinedRecordsMutex       sync.RWMutex
+	restoreQuarantinedRecordsArgsForCall []struct {
+		logger    lager.Logger
+		tableName string
+	}
+	restoreQuarantinedRecordsReturns struct {
+		result1 []int64
+		result2 []int64
+		result3 error
+	}
+	EncryptionProgressStub        func(logger lager.Logger) (string, []*models.EncryptionTableProgress, error)
+	encryptionProgressMutex       sync.RWMutex
+	encryptionProgressArgsForCall []struct {
+		logger lager.Logger
+	}
+	encryptionProgressReturns struct {
+		result1 string
+		result2 []*models.EncryptionTableProgress
+		result3 error
+	}
+	VerifyEncryptionKeyRetiredStub        func(logger lager.Logger, keyLabel string) (bool, int64, error)
+	verifyEncryptionKeyRetiredMutex       sync.RWMutex
+	verifyEncryptionKeyRetiredArgsForCall []struct {
+		logger   lager.Logger
+		keyLabel string
+	}
+	verifyEncryptionKeyRetiredReturns struct {
+		result1 bool
+		result2 int64
+		result3 error
+	}
 	ClaimActualLRPStub        func(logger lager.Logger, processGuid string, index int, instanceKey *models.ActualLRPInstanceKey) error
 	claimActualLRPMutex       sync.RWMutex
 	claimActualLRPArgsForCall []struct {
@@ -427,6 +690,46 @@ func (fake *FakeInternalClient) DesireTaskReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeInternalClient) DesireTasks(logger lager.Logger, requests []*models.DesireTaskRequest) ([]*models.TaskLifecycleResult, error) {
+	var requestsCopy []*models.DesireTaskRequest
+	if requests != nil {
+		requestsCopy = make([]*models.DesireTaskRequest, len(requests))
+		copy(requestsCopy, requests)
+	}
+	fake.desireTasksMutex.Lock()
+	fake.desireTasksArgsForCall = append(fake.desireTasksArgsForCall, struct {
+		logger   lager.Logger
+		requests []*models.DesireTaskRequest
+	}{logger, requestsCopy})
+	fake.recordInvocation("DesireTasks", []interface{}{logger, requestsCopy})
+	fake.desireTasksMutex.Unlock()
+	if fake.DesireTasksStub != nil {
+		return fake.DesireTasksStub(logger, requests)
+	} else {
+		return fake.desireTasksReturns.result1, fake.desireTasksReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) DesireTasksCallCount() int {
+	fake.desireTasksMutex.RLock()
+	defer fake.desireTasksMutex.RUnlock()
+	return len(fake.desireTasksArgsForCall)
+}
+
+func (fake *FakeInternalClient) DesireTasksArgsForCall(i int) (lager.Logger, []*models.DesireTaskRequest) {
+	fake.desireTasksMutex.RLock()
+	defer fake.desireTasksMutex.RUnlock()
+	return fake.desireTasksArgsForCall[i].logger, fake.desireTasksArgsForCall[i].requests
+}
+
+func (fake *FakeInternalClient) DesireTasksReturns(result1 []*models.TaskLifecycleResult, result2 error) {
+	fake.DesireTasksStub = nil
+	fake.desireTasksReturns = struct {
+		result1 []*models.TaskLifecycleResult
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) Tasks(logger lager.Logger) ([]*models.Task, error) {
 	fake.tasksMutex.Lock()
 	fake.tasksArgsForCall = append(fake.tasksArgsForCall, struct {
@@ -566,6 +869,40 @@ func (fake *FakeInternalClient) TasksByCellIDReturns(result1 []*models.Task, res
 	}{result1, result2}
 }
 
+func (fake *FakeInternalClient) TaskPages(logger lager.Logger, filter models.TaskFilter) bbs.TaskIterator {
+	fake.taskPagesMutex.Lock()
+	fake.taskPagesArgsForCall = append(fake.taskPagesArgsForCall, struct {
+		logger lager.Logger
+		filter models.TaskFilter
+	}{logger, filter})
+	fake.recordInvocation("TaskPages", []interface{}{logger, filter})
+	fake.taskPagesMutex.Unlock()
+	if fake.TaskPagesStub != nil {
+		return fake.TaskPagesStub(logger, filter)
+	} else {
+		return fake.taskPagesReturns.result1
+	}
+}
+
+func (fake *FakeInternalClient) TaskPagesCallCount() int {
+	fake.taskPagesMutex.RLock()
+	defer fake.taskPagesMutex.RUnlock()
+	return len(fake.taskPagesArgsForCall)
+}
+
+func (fake *FakeInternalClient) TaskPagesArgsForCall(i int) (lager.Logger, models.TaskFilter) {
+	fake.taskPagesMutex.RLock()
+	defer fake.taskPagesMutex.RUnlock()
+	return fake.taskPagesArgsForCall[i].logger, fake.taskPagesArgsForCall[i].filter
+}
+
+func (fake *FakeInternalClient) TaskPagesReturns(result1 bbs.TaskIterator) {
+	fake.TaskPagesStub = nil
+	fake.taskPagesReturns = struct {
+		result1 bbs.TaskIterator
+	}{result1}
+}
+
 func (fake *FakeInternalClient) TaskByGuid(logger lager.Logger, guid string) (*models.Task, error) {
 	fake.taskByGuidMutex.Lock()
 	fake.taskByGuidArgsForCall = append(fake.taskByGuidArgsForCall, struct {
@@ -635,6 +972,46 @@ func (fake *FakeInternalClient) CancelTaskReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeInternalClient) CancelTasks(logger lager.Logger, taskGuids []string) ([]*models.TaskLifecycleResult, error) {
+	var taskGuidsCopy []string
+	if taskGuids != nil {
+		taskGuidsCopy = make([]string, len(taskGuids))
+		copy(taskGuidsCopy, taskGuids)
+	}
+	fake.cancelTasksMutex.Lock()
+	fake.cancelTasksArgsForCall = append(fake.cancelTasksArgsForCall, struct {
+		logger    lager.Logger
+		taskGuids []string
+	}{logger, taskGuidsCopy})
+	fake.recordInvocation("CancelTasks", []interface{}{logger, taskGuidsCopy})
+	fake.cancelTasksMutex.Unlock()
+	if fake.CancelTasksStub != nil {
+		return fake.CancelTasksStub(logger, taskGuids)
+	} else {
+		return fake.cancelTasksReturns.result1, fake.cancelTasksReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) CancelTasksCallCount() int {
+	fake.cancelTasksMutex.RLock()
+	defer fake.cancelTasksMutex.RUnlock()
+	return len(fake.cancelTasksArgsForCall)
+}
+
+func (fake *FakeInternalClient) CancelTasksArgsForCall(i int) (lager.Logger, []string) {
+	fake.cancelTasksMutex.RLock()
+	defer fake.cancelTasksMutex.RUnlock()
+	return fake.cancelTasksArgsForCall[i].logger, fake.cancelTasksArgsForCall[i].taskGuids
+}
+
+func (fake *FakeInternalClient) CancelTasksReturns(result1 []*models.TaskLifecycleResult, result2 error) {
+	fake.CancelTasksStub = nil
+	fake.cancelTasksReturns = struct {
+		result1 []*models.TaskLifecycleResult
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) ResolvingTask(logger lager.Logger, taskGuid string) error {
 	fake.resolvingTaskMutex.Lock()
 	fake.resolvingTaskArgsForCall = append(fake.resolvingTaskArgsForCall, struct {
@@ -703,6 +1080,46 @@ func (fake *FakeInternalClient) DeleteTaskReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeInternalClient) DeleteTasks(logger lager.Logger, taskGuids []string) ([]*models.TaskLifecycleResult, error) {
+	var taskGuidsCopy []string
+	if taskGuids != nil {
+		taskGuidsCopy = make([]string, len(taskGuids))
+		copy(taskGuidsCopy, taskGuids)
+	}
+	fake.deleteTasksMutex.Lock()
+	fake.deleteTasksArgsForCall = append(fake.deleteTasksArgsForCall, struct {
+		logger    lager.Logger
+		taskGuids []string
+	}{logger, taskGuidsCopy})
+	fake.recordInvocation("DeleteTasks", []interface{}{logger, taskGuidsCopy})
+	fake.deleteTasksMutex.Unlock()
+	if fake.DeleteTasksStub != nil {
+		return fake.DeleteTasksStub(logger, taskGuids)
+	} else {
+		return fake.deleteTasksReturns.result1, fake.deleteTasksReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) DeleteTasksCallCount() int {
+	fake.deleteTasksMutex.RLock()
+	defer fake.deleteTasksMutex.RUnlock()
+	return len(fake.deleteTasksArgsForCall)
+}
+
+func (fake *FakeInternalClient) DeleteTasksArgsForCall(i int) (lager.Logger, []string) {
+	fake.deleteTasksMutex.RLock()
+	defer fake.deleteTasksMutex.RUnlock()
+	return fake.deleteTasksArgsForCall[i].logger, fake.deleteTasksArgsForCall[i].taskGuids
+}
+
+func (fake *FakeInternalClient) DeleteTasksReturns(result1 []*models.TaskLifecycleResult, result2 error) {
+	fake.DeleteTasksStub = nil
+	fake.deleteTasksReturns = struct {
+		result1 []*models.TaskLifecycleResult
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) Domains(logger lager.Logger) ([]string, error) {
 	fake.domainsMutex.Lock()
 	fake.domainsArgsForCall = append(fake.domainsArgsForCall, struct {
@@ -807,6 +1224,40 @@ func (fake *FakeInternalClient) ActualLRPGroupsReturns(result1 []*models.ActualL
 	}{result1, result2}
 }
 
+func (fake *FakeInternalClient) ActualLRPGroupPages(arg1 lager.Logger, arg2 models.ActualLRPFilter) bbs.ActualLRPGroupIterator {
+	fake.actualLRPGroupPagesMutex.Lock()
+	fake.actualLRPGroupPagesArgsForCall = append(fake.actualLRPGroupPagesArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 models.ActualLRPFilter
+	}{arg1, arg2})
+	fake.recordInvocation("ActualLRPGroupPages", []interface{}{arg1, arg2})
+	fake.actualLRPGroupPagesMutex.Unlock()
+	if fake.ActualLRPGroupPagesStub != nil {
+		return fake.ActualLRPGroupPagesStub(arg1, arg2)
+	} else {
+		return fake.actualLRPGroupPagesReturns.result1
+	}
+}
+
+func (fake *FakeInternalClient) ActualLRPGroupPagesCallCount() int {
+	fake.actualLRPGroupPagesMutex.RLock()
+	defer fake.actualLRPGroupPagesMutex.RUnlock()
+	return len(fake.actualLRPGroupPagesArgsForCall)
+}
+
+func (fake *FakeInternalClient) ActualLRPGroupPagesArgsForCall(i int) (lager.Logger, models.ActualLRPFilter) {
+	fake.actualLRPGroupPagesMutex.RLock()
+	defer fake.actualLRPGroupPagesMutex.RUnlock()
+	return fake.actualLRPGroupPagesArgsForCall[i].arg1, fake.actualLRPGroupPagesArgsForCall[i].arg2
+}
+
+func (fake *FakeInternalClient) ActualLRPGroupPagesReturns(result1 bbs.ActualLRPGroupIterator) {
+	fake.ActualLRPGroupPagesStub = nil
+	fake.actualLRPGroupPagesReturns = struct {
+		result1 bbs.ActualLRPGroupIterator
+	}{result1}
+}
+
 func (fake *FakeInternalClient) ActualLRPGroupsByProcessGuid(logger lager.Logger, processGuid string) ([]*models.ActualLRPGroup, error) {
 	fake.actualLRPGroupsByProcessGuidMutex.Lock()
 	fake.actualLRPGroupsByProcessGuidArgsForCall = append(fake.actualLRPGroupsByProcessGuidArgsForCall, struct {
@@ -947,6 +1398,40 @@ func (fake *FakeInternalClient) DesiredLRPsReturns(result1 []*models.DesiredLRP,
 	}{result1, result2}
 }
 
+func (fake *FakeInternalClient) DesiredLRPPages(arg1 lager.Logger, arg2 models.DesiredLRPFilter) bbs.DesiredLRPIterator {
+	fake.desiredLRPPagesMutex.Lock()
+	fake.desiredLRPPagesArgsForCall = append(fake.desiredLRPPagesArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 models.DesiredLRPFilter
+	}{arg1, arg2})
+	fake.recordInvocation("DesiredLRPPages", []interface{}{arg1, arg2})
+	fake.desiredLRPPagesMutex.Unlock()
+	if fake.DesiredLRPPagesStub != nil {
+		return fake.DesiredLRPPagesStub(arg1, arg2)
+	} else {
+		return fake.desiredLRPPagesReturns.result1
+	}
+}
+
+func (fake *FakeInternalClient) DesiredLRPPagesCallCount() int {
+	fake.desiredLRPPagesMutex.RLock()
+	defer fake.desiredLRPPagesMutex.RUnlock()
+	return len(fake.desiredLRPPagesArgsForCall)
+}
+
+func (fake *FakeInternalClient) DesiredLRPPagesArgsForCall(i int) (lager.Logger, models.DesiredLRPFilter) {
+	fake.desiredLRPPagesMutex.RLock()
+	defer fake.desiredLRPPagesMutex.RUnlock()
+	return fake.desiredLRPPagesArgsForCall[i].arg1, fake.desiredLRPPagesArgsForCall[i].arg2
+}
+
+func (fake *FakeInternalClient) DesiredLRPPagesReturns(result1 bbs.DesiredLRPIterator) {
+	fake.DesiredLRPPagesStub = nil
+	fake.desiredLRPPagesReturns = struct {
+		result1 bbs.DesiredLRPIterator
+	}{result1}
+}
+
 func (fake *FakeInternalClient) DesiredLRPByProcessGuid(logger lager.Logger, processGuid string) (*models.DesiredLRP, error) {
 	fake.desiredLRPByProcessGuidMutex.Lock()
 	fake.desiredLRPByProcessGuidArgsForCall = append(fake.desiredLRPByProcessGuidArgsForCall, struct {
@@ -1051,6 +1536,46 @@ func (fake *FakeInternalClient) DesireLRPReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeInternalClient) DesireLRPs(arg1 lager.Logger, arg2 []*models.DesiredLRP) ([]*models.DesiredLRPLifecycleResult, error) {
+	var arg2Copy []*models.DesiredLRP
+	if arg2 != nil {
+		arg2Copy = make([]*models.DesiredLRP, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.desireLRPsMutex.Lock()
+	fake.desireLRPsArgsForCall = append(fake.desireLRPsArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 []*models.DesiredLRP
+	}{arg1, arg2Copy})
+	fake.recordInvocation("DesireLRPs", []interface{}{arg1, arg2Copy})
+	fake.desireLRPsMutex.Unlock()
+	if fake.DesireLRPsStub != nil {
+		return fake.DesireLRPsStub(arg1, arg2)
+	} else {
+		return fake.desireLRPsReturns.result1, fake.desireLRPsReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) DesireLRPsCallCount() int {
+	fake.desireLRPsMutex.RLock()
+	defer fake.desireLRPsMutex.RUnlock()
+	return len(fake.desireLRPsArgsForCall)
+}
+
+func (fake *FakeInternalClient) DesireLRPsArgsForCall(i int) (lager.Logger, []*models.DesiredLRP) {
+	fake.desireLRPsMutex.RLock()
+	defer fake.desireLRPsMutex.RUnlock()
+	return fake.desireLRPsArgsForCall[i].arg1, fake.desireLRPsArgsForCall[i].arg2
+}
+
+func (fake *FakeInternalClient) DesireLRPsReturns(result1 []*models.DesiredLRPLifecycleResult, result2 error) {
+	fake.DesireLRPsStub = nil
+	fake.desireLRPsReturns = struct {
+		result1 []*models.DesiredLRPLifecycleResult
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) UpdateDesiredLRP(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) error {
 	fake.updateDesiredLRPMutex.Lock()
 	fake.updateDesiredLRPArgsForCall = append(fake.updateDesiredLRPArgsForCall, struct {
@@ -1086,6 +1611,42 @@ func (fake *FakeInternalClient) UpdateDesiredLRPReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeInternalClient) UpdateDesiredLRPWithModificationTag(logger lager.Logger, processGuid string, expectedTag models.ModificationTag, update *models.DesiredLRPUpdate) error {
+	fake.updateDesiredLRPWithModificationTagMutex.Lock()
+	fake.updateDesiredLRPWithModificationTagArgsForCall = append(fake.updateDesiredLRPWithModificationTagArgsForCall, struct {
+		logger      lager.Logger
+		processGuid string
+		expectedTag models.ModificationTag
+		update      *models.DesiredLRPUpdate
+	}{logger, processGuid, expectedTag, update})
+	fake.recordInvocation("UpdateDesiredLRPWithModificationTag", []interface{}{logger, processGuid, expectedTag, update})
+	fake.updateDesiredLRPWithModificationTagMutex.Unlock()
+	if fake.UpdateDesiredLRPWithModificationTagStub != nil {
+		return fake.UpdateDesiredLRPWithModificationTagStub(logger, processGuid, expectedTag, update)
+	} else {
+		return fake.updateDesiredLRPWithModificationTagReturns.result1
+	}
+}
+
+func (fake *FakeInternalClient) UpdateDesiredLRPWithModificationTagCallCount() int {
+	fake.updateDesiredLRPWithModificationTagMutex.RLock()
+	defer fake.updateDesiredLRPWithModificationTagMutex.RUnlock()
+	return len(fake.updateDesiredLRPWithModificationTagArgsForCall)
+}
+
+func (fake *FakeInternalClient) UpdateDesiredLRPWithModificationTagArgsForCall(i int) (lager.Logger, string, models.ModificationTag, *models.DesiredLRPUpdate) {
+	fake.updateDesiredLRPWithModificationTagMutex.RLock()
+	defer fake.updateDesiredLRPWithModificationTagMutex.RUnlock()
+	return fake.updateDesiredLRPWithModificationTagArgsForCall[i].logger, fake.updateDesiredLRPWithModificationTagArgsForCall[i].processGuid, fake.updateDesiredLRPWithModificationTagArgsForCall[i].expectedTag, fake.updateDesiredLRPWithModificationTagArgsForCall[i].update
+}
+
+func (fake *FakeInternalClient) UpdateDesiredLRPWithModificationTagReturns(result1 error) {
+	fake.UpdateDesiredLRPWithModificationTagStub = nil
+	fake.updateDesiredLRPWithModificationTagReturns = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeInternalClient) RemoveDesiredLRP(logger lager.Logger, processGuid string) error {
 	fake.removeDesiredLRPMutex.Lock()
 	fake.removeDesiredLRPArgsForCall = append(fake.removeDesiredLRPArgsForCall, struct {
@@ -1120,6 +1681,301 @@ func (fake *FakeInternalClient) RemoveDesiredLRPReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeInternalClient) RemoveDesiredLRPWithModificationTag(logger lager.Logger, processGuid string, expectedTag models.ModificationTag) error {
+	fake.removeDesiredLRPWithModificationTagMutex.Lock()
+	fake.removeDesiredLRPWithModificationTagArgsForCall = append(fake.removeDesiredLRPWithModificationTagArgsForCall, struct {
+		logger      lager.Logger
+		processGuid string
+		expectedTag models.ModificationTag
+	}{logger, processGuid, expectedTag})
+	fake.recordInvocation("RemoveDesiredLRPWithModificationTag", []interface{}{logger, processGuid, expectedTag})
+	fake.removeDesiredLRPWithModificationTagMutex.Unlock()
+	if fake.RemoveDesiredLRPWithModificationTagStub != nil {
+		return fake.RemoveDesiredLRPWithModificationTagStub(logger, processGuid, expectedTag)
+	} else {
+		return fake.removeDesiredLRPWithModificationTagReturns.result1
+	}
+}
+
+func (fake *FakeInternalClient) RemoveDesiredLRPWithModificationTagCallCount() int {
+	fake.removeDesiredLRPWithModificationTagMutex.RLock()
+	defer fake.removeDesiredLRPWithModificationTagMutex.RUnlock()
+	return len(fake.removeDesiredLRPWithModificationTagArgsForCall)
+}
+
+func (fake *FakeInternalClient) RemoveDesiredLRPWithModificationTagArgsForCall(i int) (lager.Logger, string, models.ModificationTag) {
+	fake.removeDesiredLRPWithModificationTagMutex.RLock()
+	defer fake.removeDesiredLRPWithModificationTagMutex.RUnlock()
+	return fake.removeDesiredLRPWithModificationTagArgsForCall[i].logger, fake.removeDesiredLRPWithModificationTagArgsForCall[i].processGuid, fake.removeDesiredLRPWithModificationTagArgsForCall[i].expectedTag
+}
+
+func (fake *FakeInternalClient) RemoveDesiredLRPWithModificationTagReturns(result1 error) {
+	fake.RemoveDesiredLRPWithModificationTagStub = nil
+	fake.removeDesiredLRPWithModificationTagReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) RemoveDesiredLRPs(logger lager.Logger, processGuids []string) ([]*models.DesiredLRPLifecycleResult, error) {
+	var processGuidsCopy []string
+	if processGuids != nil {
+		processGuidsCopy = make([]string, len(processGuids))
+		copy(processGuidsCopy, processGuids)
+	}
+	fake.removeDesiredLRPsMutex.Lock()
+	fake.removeDesiredLRPsArgsForCall = append(fake.removeDesiredLRPsArgsForCall, struct {
+		logger       lager.Logger
+		processGuids []string
+	}{logger, processGuidsCopy})
+	fake.recordInvocation("RemoveDesiredLRPs", []interface{}{logger, processGuidsCopy})
+	fake.removeDesiredLRPsMutex.Unlock()
+	if fake.RemoveDesiredLRPsStub != nil {
+		return fake.RemoveDesiredLRPsStub(logger, processGuids)
+	} else {
+		return fake.removeDesiredLRPsReturns.result1, fake.removeDesiredLRPsReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) RemoveDesiredLRPsCallCount() int {
+	fake.removeDesiredLRPsMutex.RLock()
+	defer fake.removeDesiredLRPsMutex.RUnlock()
+	return len(fake.removeDesiredLRPsArgsForCall)
+}
+
+func (fake *FakeInternalClient) RemoveDesiredLRPsArgsForCall(i int) (lager.Logger, []string) {
+	fake.removeDesiredLRPsMutex.RLock()
+	defer fake.removeDesiredLRPsMutex.RUnlock()
+	return fake.removeDesiredLRPsArgsForCall[i].logger, fake.removeDesiredLRPsArgsForCall[i].processGuids
+}
+
+func (fake *FakeInternalClient) RemoveDesiredLRPsReturns(result1 []*models.DesiredLRPLifecycleResult, result2 error) {
+	fake.RemoveDesiredLRPsStub = nil
+	fake.removeDesiredLRPsReturns = struct {
+		result1 []*models.DesiredLRPLifecycleResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) ApplyDesiredLRPs(logger lager.Logger, domain string, desiredLRPs []*models.DesiredLRP, ttl time.Duration) (*models.ApplyDesiredLRPsResponse, error) {
+	var desiredLRPsCopy []*models.DesiredLRP
+	if desiredLRPs != nil {
+		desiredLRPsCopy = make([]*models.DesiredLRP, len(desiredLRPs))
+		copy(desiredLRPsCopy, desiredLRPs)
+	}
+	fake.applyDesiredLRPsMutex.Lock()
+	fake.applyDesiredLRPsArgsForCall = append(fake.applyDesiredLRPsArgsForCall, struct {
+		logger      lager.Logger
+		domain      string
+		desiredLRPs []*models.DesiredLRP
+		ttl         time.Duration
+	}{logger, domain, desiredLRPsCopy, ttl})
+	fake.recordInvocation("ApplyDesiredLRPs", []interface{}{logger, domain, desiredLRPsCopy, ttl})
+	fake.applyDesiredLRPsMutex.Unlock()
+	if fake.ApplyDesiredLRPsStub != nil {
+		return fake.ApplyDesiredLRPsStub(logger, domain, desiredLRPs, ttl)
+	} else {
+		return fake.applyDesiredLRPsReturns.result1, fake.applyDesiredLRPsReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) ApplyDesiredLRPsCallCount() int {
+	fake.applyDesiredLRPsMutex.RLock()
+	defer fake.applyDesiredLRPsMutex.RUnlock()
+	return len(fake.applyDesiredLRPsArgsForCall)
+}
+
+func (fake *FakeInternalClient) ApplyDesiredLRPsArgsForCall(i int) (lager.Logger, string, []*models.DesiredLRP, time.Duration) {
+	fake.applyDesiredLRPsMutex.RLock()
+	defer fake.applyDesiredLRPsMutex.RUnlock()
+	return fake.applyDesiredLRPsArgsForCall[i].logger, fake.applyDesiredLRPsArgsForCall[i].domain, fake.applyDesiredLRPsArgsForCall[i].desiredLRPs, fake.applyDesiredLRPsArgsForCall[i].ttl
+}
+
+func (fake *FakeInternalClient) ApplyDesiredLRPsReturns(result1 *models.ApplyDesiredLRPsResponse, result2 error) {
+	fake.ApplyDesiredLRPsStub = nil
+	fake.applyDesiredLRPsReturns = struct {
+		result1 *models.ApplyDesiredLRPsResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) RedeployDesiredLRP(logger lager.Logger, processGuid string, runInfo *models.DesiredLRPRunInfo, resource *models.DesiredLRPResource, maxInFlight int32) error {
+	fake.redeployDesiredLRPMutex.Lock()
+	fake.redeployDesiredLRPArgsForCall = append(fake.redeployDesiredLRPArgsForCall, struct {
+		logger      lager.Logger
+		processGuid string
+		runInfo     *models.DesiredLRPRunInfo
+		resource    *models.DesiredLRPResource
+		maxInFlight int32
+	}{logger, processGuid, runInfo, resource, maxInFlight})
+	fake.recordInvocation("RedeployDesiredLRP", []interface{}{logger, processGuid, runInfo, resource, maxInFlight})
+	fake.redeployDesiredLRPMutex.Unlock()
+	if fake.RedeployDesiredLRPStub != nil {
+		return fake.RedeployDesiredLRPStub(logger, processGuid, runInfo, resource, maxInFlight)
+	} else {
+		return fake.redeployDesiredLRPReturns.result1
+	}
+}
+
+func (fake *FakeInternalClient) RedeployDesiredLRPCallCount() int {
+	fake.redeployDesiredLRPMutex.RLock()
+	defer fake.redeployDesiredLRPMutex.RUnlock()
+	return len(fake.redeployDesiredLRPArgsForCall)
+}
+
+func (fake *FakeInternalClient) RedeployDesiredLRPArgsForCall(i int) (lager.Logger, string, *models.DesiredLRPRunInfo, *models.DesiredLRPResource, int32) {
+	fake.redeployDesiredLRPMutex.RLock()
+	defer fake.redeployDesiredLRPMutex.RUnlock()
+	return fake.redeployDesiredLRPArgsForCall[i].logger, fake.redeployDesiredLRPArgsForCall[i].processGuid, fake.redeployDesiredLRPArgsForCall[i].runInfo, fake.redeployDesiredLRPArgsForCall[i].resource, fake.redeployDesiredLRPArgsForCall[i].maxInFlight
+}
+
+func (fake *FakeInternalClient) RedeployDesiredLRPReturns(result1 error) {
+	fake.RedeployDesiredLRPStub = nil
+	fake.redeployDesiredLRPReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) DesiredLRPRollout(logger lager.Logger, processGuid string) (*models.DesiredLRPRollout, error) {
+	fake.desiredLRPRolloutMutex.Lock()
+	fake.desiredLRPRolloutArgsForCall = append(fake.desiredLRPRolloutArgsForCall, struct {
+		logger      lager.Logger
+		processGuid string
+	}{logger, processGuid})
+	fake.recordInvocation("DesiredLRPRollout", []interface{}{logger, processGuid})
+	fake.desiredLRPRolloutMutex.Unlock()
+	if fake.DesiredLRPRolloutStub != nil {
+		return fake.DesiredLRPRolloutStub(logger, processGuid)
+	} else {
+		return fake.desiredLRPRolloutReturns.result1, fake.desiredLRPRolloutReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) DesiredLRPRolloutCallCount() int {
+	fake.desiredLRPRolloutMutex.RLock()
+	defer fake.desiredLRPRolloutMutex.RUnlock()
+	return len(fake.desiredLRPRolloutArgsForCall)
+}
+
+func (fake *FakeInternalClient) DesiredLRPRolloutArgsForCall(i int) (lager.Logger, string) {
+	fake.desiredLRPRolloutMutex.RLock()
+	defer fake.desiredLRPRolloutMutex.RUnlock()
+	return fake.desiredLRPRolloutArgsForCall[i].logger, fake.desiredLRPRolloutArgsForCall[i].processGuid
+}
+
+func (fake *FakeInternalClient) DesiredLRPRolloutReturns(result1 *models.DesiredLRPRollout, result2 error) {
+	fake.DesiredLRPRolloutStub = nil
+	fake.desiredLRPRolloutReturns = struct {
+		result1 *models.DesiredLRPRollout
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) DesiredLRPRevisions(logger lager.Logger, processGuid string) ([]*models.DesiredLRPRevision, error) {
+	fake.desiredLRPRevisionsMutex.Lock()
+	fake.desiredLRPRevisionsArgsForCall = append(fake.desiredLRPRevisionsArgsForCall, struct {
+		logger      lager.Logger
+		processGuid string
+	}{logger, processGuid})
+	fake.recordInvocation("DesiredLRPRevisions", []interface{}{logger, processGuid})
+	fake.desiredLRPRevisionsMutex.Unlock()
+	if fake.DesiredLRPRevisionsStub != nil {
+		return fake.DesiredLRPRevisionsStub(logger, processGuid)
+	} else {
+		return fake.desiredLRPRevisionsReturns.result1, fake.desiredLRPRevisionsReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) DesiredLRPRevisionsCallCount() int {
+	fake.desiredLRPRevisionsMutex.RLock()
+	defer fake.desiredLRPRevisionsMutex.RUnlock()
+	return len(fake.desiredLRPRevisionsArgsForCall)
+}
+
+func (fake *FakeInternalClient) DesiredLRPRevisionsArgsForCall(i int) (lager.Logger, string) {
+	fake.desiredLRPRevisionsMutex.RLock()
+	defer fake.desiredLRPRevisionsMutex.RUnlock()
+	return fake.desiredLRPRevisionsArgsForCall[i].logger, fake.desiredLRPRevisionsArgsForCall[i].processGuid
+}
+
+func (fake *FakeInternalClient) DesiredLRPRevisionsReturns(result1 []*models.DesiredLRPRevision, result2 error) {
+	fake.DesiredLRPRevisionsStub = nil
+	fake.desiredLRPRevisionsReturns = struct {
+		result1 []*models.DesiredLRPRevision
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) DesiredLRPRevision(logger lager.Logger, processGuid string, revision int64) (*models.DesiredLRPRevision, error) {
+	fake.desiredLRPRevisionMutex.Lock()
+	fake.desiredLRPRevisionArgsForCall = append(fake.desiredLRPRevisionArgsForCall, struct {
+		logger      lager.Logger
+		processGuid string
+		revision    int64
+	}{logger, processGuid, revision})
+	fake.recordInvocation("DesiredLRPRevision", []interface{}{logger, processGuid, revision})
+	fake.desiredLRPRevisionMutex.Unlock()
+	if fake.DesiredLRPRevisionStub != nil {
+		return fake.DesiredLRPRevisionStub(logger, processGuid, revision)
+	} else {
+		return fake.desiredLRPRevisionReturns.result1, fake.desiredLRPRevisionReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) DesiredLRPRevisionCallCount() int {
+	fake.desiredLRPRevisionMutex.RLock()
+	defer fake.desiredLRPRevisionMutex.RUnlock()
+	return len(fake.desiredLRPRevisionArgsForCall)
+}
+
+func (fake *FakeInternalClient) DesiredLRPRevisionArgsForCall(i int) (lager.Logger, string, int64) {
+	fake.desiredLRPRevisionMutex.RLock()
+	defer fake.desiredLRPRevisionMutex.RUnlock()
+	return fake.desiredLRPRevisionArgsForCall[i].logger, fake.desiredLRPRevisionArgsForCall[i].processGuid, fake.desiredLRPRevisionArgsForCall[i].revision
+}
+
+func (fake *FakeInternalClient) DesiredLRPRevisionReturns(result1 *models.DesiredLRPRevision, result2 error) {
+	fake.DesiredLRPRevisionStub = nil
+	fake.desiredLRPRevisionReturns = struct {
+		result1 *models.DesiredLRPRevision
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) RollbackDesiredLRP(logger lager.Logger, processGuid string, revision int64) error {
+	fake.rollbackDesiredLRPMutex.Lock()
+	fake.rollbackDesiredLRPArgsForCall = append(fake.rollbackDesiredLRPArgsForCall, struct {
+		logger      lager.Logger
+		processGuid string
+		revision    int64
+	}{logger, processGuid, revision})
+	fake.recordInvocation("RollbackDesiredLRP", []interface{}{logger, processGuid, revision})
+	fake.rollbackDesiredLRPMutex.Unlock()
+	if fake.RollbackDesiredLRPStub != nil {
+		return fake.RollbackDesiredLRPStub(logger, processGuid, revision)
+	} else {
+		return fake.rollbackDesiredLRPReturns.result1
+	}
+}
+
+func (fake *FakeInternalClient) RollbackDesiredLRPCallCount() int {
+	fake.rollbackDesiredLRPMutex.RLock()
+	defer fake.rollbackDesiredLRPMutex.RUnlock()
+	return len(fake.rollbackDesiredLRPArgsForCall)
+}
+
+func (fake *FakeInternalClient) RollbackDesiredLRPArgsForCall(i int) (lager.Logger, string, int64) {
+	fake.rollbackDesiredLRPMutex.RLock()
+	defer fake.rollbackDesiredLRPMutex.RUnlock()
+	return fake.rollbackDesiredLRPArgsForCall[i].logger, fake.rollbackDesiredLRPArgsForCall[i].processGuid, fake.rollbackDesiredLRPArgsForCall[i].revision
+}
+
+func (fake *FakeInternalClient) RollbackDesiredLRPReturns(result1 error) {
+	fake.RollbackDesiredLRPStub = nil
+	fake.rollbackDesiredLRPReturns = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeInternalClient) SubscribeToEvents(logger lager.Logger) (events.EventSource, error) {
 	fake.subscribeToEventsMutex.Lock()
 	fake.subscribeToEventsArgsForCall = append(fake.subscribeToEventsArgsForCall, struct {
@@ -1154,6 +2010,110 @@ func (fake *FakeInternalClient) SubscribeToEventsReturns(result1 events.EventSou
 	}{result1, result2}
 }
 
+func (fake *FakeInternalClient) SubscribeToEventsWithFilter(logger lager.Logger, filter models.EventFilter) (events.EventSource, error) {
+	fake.subscribeToEventsWithFilterMutex.Lock()
+	fake.subscribeToEventsWithFilterArgsForCall = append(fake.subscribeToEventsWithFilterArgsForCall, struct {
+		logger lager.Logger
+		filter models.EventFilter
+	}{logger, filter})
+	fake.recordInvocation("SubscribeToEventsWithFilter", []interface{}{logger, filter})
+	fake.subscribeToEventsWithFilterMutex.Unlock()
+	if fake.SubscribeToEventsWithFilterStub != nil {
+		return fake.SubscribeToEventsWithFilterStub(logger, filter)
+	} else {
+		return fake.subscribeToEventsWithFilterReturns.result1, fake.subscribeToEventsWithFilterReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) SubscribeToEventsWithFilterCallCount() int {
+	fake.subscribeToEventsWithFilterMutex.RLock()
+	defer fake.subscribeToEventsWithFilterMutex.RUnlock()
+	return len(fake.subscribeToEventsWithFilterArgsForCall)
+}
+
+func (fake *FakeInternalClient) SubscribeToEventsWithFilterArgsForCall(i int) (lager.Logger, models.EventFilter) {
+	fake.subscribeToEventsWithFilterMutex.RLock()
+	defer fake.subscribeToEventsWithFilterMutex.RUnlock()
+	return fake.subscribeToEventsWithFilterArgsForCall[i].logger, fake.subscribeToEventsWithFilterArgsForCall[i].filter
+}
+
+func (fake *FakeInternalClient) SubscribeToEventsWithFilterReturns(result1 events.EventSource, result2 error) {
+	fake.SubscribeToEventsWithFilterStub = nil
+	fake.subscribeToEventsWithFilterReturns = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) SubscribeToTaskEvents(logger lager.Logger) (events.EventSource, error) {
+	fake.subscribeToTaskEventsMutex.Lock()
+	fake.subscribeToTaskEventsArgsForCall = append(fake.subscribeToTaskEventsArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("SubscribeToTaskEvents", []interface{}{logger})
+	fake.subscribeToTaskEventsMutex.Unlock()
+	if fake.SubscribeToTaskEventsStub != nil {
+		return fake.SubscribeToTaskEventsStub(logger)
+	} else {
+		return fake.subscribeToTaskEventsReturns.result1, fake.subscribeToTaskEventsReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) SubscribeToTaskEventsCallCount() int {
+	fake.subscribeToTaskEventsMutex.RLock()
+	defer fake.subscribeToTaskEventsMutex.RUnlock()
+	return len(fake.subscribeToTaskEventsArgsForCall)
+}
+
+func (fake *FakeInternalClient) SubscribeToTaskEventsArgsForCall(i int) lager.Logger {
+	fake.subscribeToTaskEventsMutex.RLock()
+	defer fake.subscribeToTaskEventsMutex.RUnlock()
+	return fake.subscribeToTaskEventsArgsForCall[i].logger
+}
+
+func (fake *FakeInternalClient) SubscribeToTaskEventsReturns(result1 events.EventSource, result2 error) {
+	fake.SubscribeToTaskEventsStub = nil
+	fake.subscribeToTaskEventsReturns = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) SubscribeToTaskEventsWithFilter(logger lager.Logger, filter models.EventFilter) (events.EventSource, error) {
+	fake.subscribeToTaskEventsWithFilterMutex.Lock()
+	fake.subscribeToTaskEventsWithFilterArgsForCall = append(fake.subscribeToTaskEventsWithFilterArgsForCall, struct {
+		logger lager.Logger
+		filter models.EventFilter
+	}{logger, filter})
+	fake.recordInvocation("SubscribeToTaskEventsWithFilter", []interface{}{logger, filter})
+	fake.subscribeToTaskEventsWithFilterMutex.Unlock()
+	if fake.SubscribeToTaskEventsWithFilterStub != nil {
+		return fake.SubscribeToTaskEventsWithFilterStub(logger, filter)
+	} else {
+		return fake.subscribeToTaskEventsWithFilterReturns.result1, fake.subscribeToTaskEventsWithFilterReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) SubscribeToTaskEventsWithFilterCallCount() int {
+	fake.subscribeToTaskEventsWithFilterMutex.RLock()
+	defer fake.subscribeToTaskEventsWithFilterMutex.RUnlock()
+	return len(fake.subscribeToTaskEventsWithFilterArgsForCall)
+}
+
+func (fake *FakeInternalClient) SubscribeToTaskEventsWithFilterArgsForCall(i int) (lager.Logger, models.EventFilter) {
+	fake.subscribeToTaskEventsWithFilterMutex.RLock()
+	defer fake.subscribeToTaskEventsWithFilterMutex.RUnlock()
+	return fake.subscribeToTaskEventsWithFilterArgsForCall[i].logger, fake.subscribeToTaskEventsWithFilterArgsForCall[i].filter
+}
+
+func (fake *FakeInternalClient) SubscribeToTaskEventsWithFilterReturns(result1 events.EventSource, result2 error) {
+	fake.SubscribeToTaskEventsWithFilterStub = nil
+	fake.subscribeToTaskEventsWithFilterReturns = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) Ping(logger lager.Logger) bool {
 	fake.pingMutex.Lock()
 	fake.pingArgsForCall = append(fake.pingArgsForCall, struct {
@@ -1221,6 +2181,252 @@ func (fake *FakeInternalClient) CellsReturns(result1 []*models.CellPresence, res
 	}{result1, result2}
 }
 
+func (fake *FakeInternalClient) AuditEvents(logger lager.Logger, filter models.AuditEventFilter) ([]*models.AuditEvent, error) {
+	fake.auditEventsMutex.Lock()
+	fake.auditEventsArgsForCall = append(fake.auditEventsArgsForCall, struct {
+		logger lager.Logger
+		filter models.AuditEventFilter
+	}{logger, filter})
+	fake.recordInvocation("AuditEvents", []interface{}{logger, filter})
+	fake.auditEventsMutex.Unlock()
+	if fake.AuditEventsStub != nil {
+		return fake.AuditEventsStub(logger, filter)
+	} else {
+		return fake.auditEventsReturns.result1, fake.auditEventsReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) AuditEventsCallCount() int {
+	fake.auditEventsMutex.RLock()
+	defer fake.auditEventsMutex.RUnlock()
+	return len(fake.auditEventsArgsForCall)
+}
+
+func (fake *FakeInternalClient) AuditEventsArgsForCall(i int) (lager.Logger, models.AuditEventFilter) {
+	fake.auditEventsMutex.RLock()
+	defer fake.auditEventsMutex.RUnlock()
+	return fake.auditEventsArgsForCall[i].logger, fake.auditEventsArgsForCall[i].filter
+}
+
+func (fake *FakeInternalClient) AuditEventsReturns(result1 []*models.AuditEvent, result2 error) {
+	fake.AuditEventsStub = nil
+	fake.auditEventsReturns = struct {
+		result1 []*models.AuditEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) QuarantinedRecords(logger lager.Logger, tableName string) ([]*models.QuarantinedRecord, error) {
+	fake.quarantinedRecordsMutex.Lock()
+	fake.quarantinedRecordsArgsForCall = append(fake.quarantinedRecordsArgsForCall, struct {
+		logger    lager.Logger
+		tableName string
+	}{logger, tableName})
+	fake.recordInvocation("QuarantinedRecords", []interface{}{logger, tableName})
+	fake.quarantinedRecordsMutex.Unlock()
+	if fake.QuarantinedRecordsStub != nil {
+		return fake.QuarantinedRecordsStub(logger, tableName)
+	} else {
+		return fake.quarantinedRecordsReturns.result1, fake.quarantinedRecordsReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) QuarantinedRecordsCallCount() int {
+	fake.quarantinedRecordsMutex.RLock()
+	defer fake.quarantinedRecordsMutex.RUnlock()
+	return len(fake.quarantinedRecordsArgsForCall)
+}
+
+func (fake *FakeInternalClient) QuarantinedRecordsArgsForCall(i int) (lager.Logger, string) {
+	fake.quarantinedRecordsMutex.RLock()
+	defer fake.quarantinedRecordsMutex.RUnlock()
+	return fake.quarantinedRecordsArgsForCall[i].logger, fake.quarantinedRecordsArgsForCall[i].tableName
+}
+
+func (fake *FakeInternalClient) QuarantinedRecordsReturns(result1 []*models.QuarantinedRecord, result2 error) {
+	fake.QuarantinedRecordsStub = nil
+	fake.quarantinedRecordsReturns = struct {
+		result1 []*models.QuarantinedRecord
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) QuarantinedRecord(logger lager.Logger, id int64) (*models.QuarantinedRecord, error) {
+	fake.quarantinedRecordMutex.Lock()
+	fake.quarantinedRecordArgsForCall = append(fake.quarantinedRecordArgsForCall, struct {
+		logger lager.Logger
+		id     int64
+	}{logger, id})
+	fake.recordInvocation("QuarantinedRecord", []interface{}{logger, id})
+	fake.quarantinedRecordMutex.Unlock()
+	if fake.QuarantinedRecordStub != nil {
+		return fake.QuarantinedRecordStub(logger, id)
+	} else {
+		return fake.quarantinedRecordReturns.result1, fake.quarantinedRecordReturns.result2
+	}
+}
+
+func (fake *FakeInternalClient) QuarantinedRecordCallCount() int {
+	fake.quarantinedRecordMutex.RLock()
+	defer fake.quarantinedRecordMutex.RUnlock()
+	return len(fake.quarantinedRecordArgsForCall)
+}
+
+func (fake *FakeInternalClient) QuarantinedRecordArgsForCall(i int) (lager.Logger, int64) {
+	fake.quarantinedRecordMutex.RLock()
+	defer fake.quarantinedRecordMutex.RUnlock()
+	return fake.quarantinedRecordArgsForCall[i].logger, fake.quarantinedRecordArgsForCall[i].id
+}
+
+func (fake *FakeInternalClient) QuarantinedRecordReturns(result1 *models.QuarantinedRecord, result2 error) {
+	fake.QuarantinedRecordStub = nil
+	fake.quarantinedRecordReturns = struct {
+		result1 *models.QuarantinedRecord
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) RestoreQuarantinedRecord(logger lager.Logger, id int64) error {
+	fake.restoreQuarantinedRecordMutex.Lock()
+	fake.restoreQuarantinedRecordArgsForCall = append(fake.restoreQuarantinedRecordArgsForCall, struct {
+		logger lager.Logger
+		id     int64
+	}{logger, id})
+	fake.recordInvocation("RestoreQuarantinedRecord", []interface{}{logger, id})
+	fake.restoreQuarantinedRecordMutex.Unlock()
+	if fake.RestoreQuarantinedRecordStub != nil {
+		return fake.RestoreQuarantinedRecordStub(logger, id)
+	} else {
+		return fake.restoreQuarantinedRecordReturns.result1
+	}
+}
+
+func (fake *FakeInternalClient) RestoreQuarantinedRecordCallCount() int {
+	fake.restoreQuarantinedRecordMutex.RLock()
+	defer fake.restoreQuarantinedRecordMutex.RUnlock()
+	return len(fake.restoreQuarantinedRecordArgsForCall)
+}
+
+func (fake *FakeInternalClient) RestoreQuarantinedRecordArgsForCall(i int) (lager.Logger, int64) {
+	fake.restoreQuarantinedRecordMutex.RLock()
+	defer fake.restoreQuarantinedRecordMutex.RUnlock()
+	return fake.restoreQuarantinedRecordArgsForCall[i].logger, fake.restoreQuarantinedRecordArgsForCall[i].id
+}
+
+func (fake *FakeInternalClient) RestoreQuarantinedRecordReturns(result1 error) {
+	fake.RestoreQuarantinedRecordStub = nil
+	fake.restoreQuarantinedRecordReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) RestoreQuarantinedRecords(logger lager.Logger, tableName string) ([]int64, []int64, error) {
+	fake.restoreQuarantinedRecordsMutex.Lock()
+	fake.restoreQuarantinedRecordsArgsForCall = append(fake.restoreQuarantinedRecordsArgsForCall, struct {
+		logger    lager.Logger
+		tableName string
+	}{logger, tableName})
+	fake.recordInvocation("RestoreQuarantinedRecords", []interface{}{logger, tableName})
+	fake.restoreQuarantinedRecordsMutex.Unlock()
+	if fake.RestoreQuarantinedRecordsStub != nil {
+		return fake.RestoreQuarantinedRecordsStub(logger, tableName)
+	} else {
+		return fake.restoreQuarantinedRecordsReturns.result1, fake.restoreQuarantinedRecordsReturns.result2, fake.restoreQuarantinedRecordsReturns.result3
+	}
+}
+
+func (fake *FakeInternalClient) RestoreQuarantinedRecordsCallCount() int {
+	fake.restoreQuarantinedRecordsMutex.RLock()
+	defer fake.restoreQuarantinedRecordsMutex.RUnlock()
+	return len(fake.restoreQuarantinedRecordsArgsForCall)
+}
+
+func (fake *FakeInternalClient) RestoreQuarantinedRecordsArgsForCall(i int) (lager.Logger, string) {
+	fake.restoreQuarantinedRecordsMutex.RLock()
+	defer fake.restoreQuarantinedRecordsMutex.RUnlock()
+	return fake.restoreQuarantinedRecordsArgsForCall[i].logger, fake.restoreQuarantinedRecordsArgsForCall[i].tableName
+}
+
+func (fake *FakeInternalClient) RestoreQuarantinedRecordsReturns(result1 []int64, result2 []int64, result3 error) {
+	fake.RestoreQuarantinedRecordsStub = nil
+	fake.restoreQuarantinedRecordsReturns = struct {
+		result1 []int64
+		result2 []int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInternalClient) EncryptionProgress(logger lager.Logger) (string, []*models.EncryptionTableProgress, error) {
+	fake.encryptionProgressMutex.Lock()
+	fake.encryptionProgressArgsForCall = append(fake.encryptionProgressArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("EncryptionProgress", []interface{}{logger})
+	fake.encryptionProgressMutex.Unlock()
+	if fake.EncryptionProgressStub != nil {
+		return fake.EncryptionProgressStub(logger)
+	} else {
+		return fake.encryptionProgressReturns.result1, fake.encryptionProgressReturns.result2, fake.encryptionProgressReturns.result3
+	}
+}
+
+func (fake *FakeInternalClient) EncryptionProgressCallCount() int {
+	fake.encryptionProgressMutex.RLock()
+	defer fake.encryptionProgressMutex.RUnlock()
+	return len(fake.encryptionProgressArgsForCall)
+}
+
+func (fake *FakeInternalClient) EncryptionProgressArgsForCall(i int) lager.Logger {
+	fake.encryptionProgressMutex.RLock()
+	defer fake.encryptionProgressMutex.RUnlock()
+	return fake.encryptionProgressArgsForCall[i].logger
+}
+
+func (fake *FakeInternalClient) EncryptionProgressReturns(result1 string, result2 []*models.EncryptionTableProgress, result3 error) {
+	fake.EncryptionProgressStub = nil
+	fake.encryptionProgressReturns = struct {
+		result1 string
+		result2 []*models.EncryptionTableProgress
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInternalClient) VerifyEncryptionKeyRetired(logger lager.Logger, keyLabel string) (bool, int64, error) {
+	fake.verifyEncryptionKeyRetiredMutex.Lock()
+	fake.verifyEncryptionKeyRetiredArgsForCall = append(fake.verifyEncryptionKeyRetiredArgsForCall, struct {
+		logger   lager.Logger
+		keyLabel string
+	}{logger, keyLabel})
+	fake.recordInvocation("VerifyEncryptionKeyRetired", []interface{}{logger, keyLabel})
+	fake.verifyEncryptionKeyRetiredMutex.Unlock()
+	if fake.VerifyEncryptionKeyRetiredStub != nil {
+		return fake.VerifyEncryptionKeyRetiredStub(logger, keyLabel)
+	} else {
+		return fake.verifyEncryptionKeyRetiredReturns.result1, fake.verifyEncryptionKeyRetiredReturns.result2, fake.verifyEncryptionKeyRetiredReturns.result3
+	}
+}
+
+func (fake *FakeInternalClient) VerifyEncryptionKeyRetiredCallCount() int {
+	fake.verifyEncryptionKeyRetiredMutex.RLock()
+	defer fake.verifyEncryptionKeyRetiredMutex.RUnlock()
+	return len(fake.verifyEncryptionKeyRetiredArgsForCall)
+}
+
+func (fake *FakeInternalClient) VerifyEncryptionKeyRetiredArgsForCall(i int) (lager.Logger, string) {
+	fake.verifyEncryptionKeyRetiredMutex.RLock()
+	defer fake.verifyEncryptionKeyRetiredMutex.RUnlock()
+	return fake.verifyEncryptionKeyRetiredArgsForCall[i].logger, fake.verifyEncryptionKeyRetiredArgsForCall[i].keyLabel
+}
+
+func (fake *FakeInternalClient) VerifyEncryptionKeyRetiredReturns(result1 bool, result2 int64, result3 error) {
+	fake.VerifyEncryptionKeyRetiredStub = nil
+	fake.verifyEncryptionKeyRetiredReturns = struct {
+		result1 bool
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeInternalClient) ClaimActualLRP(logger lager.Logger, processGuid string, index int, instanceKey *models.ActualLRPInstanceKey) error {
 	fake.claimActualLRPMutex.Lock()
 	fake.claimActualLRPArgsForCall = append(fake.claimActualLRPArgsForCall, struct {
@@ -1696,6 +2902,8 @@ func (fake *FakeInternalClient) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.desireTaskMutex.RLock()
 	defer fake.desireTaskMutex.RUnlock()
+	fake.desireTasksMutex.RLock()
+	defer fake.desireTasksMutex.RUnlock()
 	fake.tasksMutex.RLock()
 	defer fake.tasksMutex.RUnlock()
 	fake.tasksWithFilterMutex.RLock()
@@ -1704,20 +2912,28 @@ func (fake *FakeInternalClient) Invocations() map[string][][]interface{} {
 	defer fake.tasksByDomainMutex.RUnlock()
 	fake.tasksByCellIDMutex.RLock()
 	defer fake.tasksByCellIDMutex.RUnlock()
+	fake.taskPagesMutex.RLock()
+	defer fake.taskPagesMutex.RUnlock()
 	fake.taskByGuidMutex.RLock()
 	defer fake.taskByGuidMutex.RUnlock()
 	fake.cancelTaskMutex.RLock()
 	defer fake.cancelTaskMutex.RUnlock()
+	fake.cancelTasksMutex.RLock()
+	defer fake.cancelTasksMutex.RUnlock()
 	fake.resolvingTaskMutex.RLock()
 	defer fake.resolvingTaskMutex.RUnlock()
 	fake.deleteTaskMutex.RLock()
 	defer fake.deleteTaskMutex.RUnlock()
+	fake.deleteTasksMutex.RLock()
+	defer fake.deleteTasksMutex.RUnlock()
 	fake.domainsMutex.RLock()
 	defer fake.domainsMutex.RUnlock()
 	fake.upsertDomainMutex.RLock()
 	defer fake.upsertDomainMutex.RUnlock()
 	fake.actualLRPGroupsMutex.RLock()
 	defer fake.actualLRPGroupsMutex.RUnlock()
+	fake.actualLRPGroupPagesMutex.RLock()
+	defer fake.actualLRPGroupPagesMutex.RUnlock()
 	fake.actualLRPGroupsByProcessGuidMutex.RLock()
 	defer fake.actualLRPGroupsByProcessGuidMutex.RUnlock()
 	fake.actualLRPGroupByProcessGuidAndIndexMutex.RLock()
@@ -1726,22 +2942,64 @@ func (fake *FakeInternalClient) Invocations() map[string][][]interface{} {
 	defer fake.retireActualLRPMutex.RUnlock()
 	fake.desiredLRPsMutex.RLock()
 	defer fake.desiredLRPsMutex.RUnlock()
+	fake.desiredLRPPagesMutex.RLock()
+	defer fake.desiredLRPPagesMutex.RUnlock()
 	fake.desiredLRPByProcessGuidMutex.RLock()
 	defer fake.desiredLRPByProcessGuidMutex.RUnlock()
 	fake.desiredLRPSchedulingInfosMutex.RLock()
 	defer fake.desiredLRPSchedulingInfosMutex.RUnlock()
 	fake.desireLRPMutex.RLock()
 	defer fake.desireLRPMutex.RUnlock()
+	fake.desireLRPsMutex.RLock()
+	defer fake.desireLRPsMutex.RUnlock()
 	fake.updateDesiredLRPMutex.RLock()
 	defer fake.updateDesiredLRPMutex.RUnlock()
+	fake.updateDesiredLRPWithModificationTagMutex.RLock()
+	defer fake.updateDesiredLRPWithModificationTagMutex.RUnlock()
 	fake.removeDesiredLRPMutex.RLock()
 	defer fake.removeDesiredLRPMutex.RUnlock()
+	fake.removeDesiredLRPWithModificationTagMutex.RLock()
+	defer fake.removeDesiredLRPWithModificationTagMutex.RUnlock()
+	fake.removeDesiredLRPsMutex.RLock()
+	defer fake.removeDesiredLRPsMutex.RUnlock()
+	fake.applyDesiredLRPsMutex.RLock()
+	defer fake.applyDesiredLRPsMutex.RUnlock()
+	fake.redeployDesiredLRPMutex.RLock()
+	defer fake.redeployDesiredLRPMutex.RUnlock()
+	fake.desiredLRPRolloutMutex.RLock()
+	defer fake.desiredLRPRolloutMutex.RUnlock()
+	fake.desiredLRPRevisionsMutex.RLock()
+	defer fake.desiredLRPRevisionsMutex.RUnlock()
+	fake.desiredLRPRevisionMutex.RLock()
+	defer fake.desiredLRPRevisionMutex.RUnlock()
+	fake.rollbackDesiredLRPMutex.RLock()
+	defer fake.rollbackDesiredLRPMutex.RUnlock()
 	fake.subscribeToEventsMutex.RLock()
 	defer fake.subscribeToEventsMutex.RUnlock()
+	fake.subscribeToEventsWithFilterMutex.RLock()
+	defer fake.subscribeToEventsWithFilterMutex.RUnlock()
+	fake.subscribeToTaskEventsMutex.RLock()
+	defer fake.subscribeToTaskEventsMutex.RUnlock()
+	fake.subscribeToTaskEventsWithFilterMutex.RLock()
+	defer fake.subscribeToTaskEventsWithFilterMutex.RUnlock()
 	fake.pingMutex.RLock()
 	defer fake.pingMutex.RUnlock()
 	fake.cellsMutex.RLock()
 	defer fake.cellsMutex.RUnlock()
+	fake.auditEventsMutex.RLock()
+	defer fake.auditEventsMutex.RUnlock()
+	fake.quarantinedRecordsMutex.RLock()
+	defer fake.quarantinedRecordsMutex.RUnlock()
+	fake.quarantinedRecordMutex.RLock()
+	defer fake.quarantinedRecordMutex.RUnlock()
+	fake.restoreQuarantinedRecordMutex.RLock()
+	defer fake.restoreQuarantinedRecordMutex.RUnlock()
+	fake.restoreQuarantinedRecordsMutex.RLock()
+	defer fake.restoreQuarantinedRecordsMutex.RUnlock()
+	fake.encryptionProgressMutex.RLock()
+	defer fake.encryptionProgressMutex.RUnlock()
+	fake.verifyEncryptionKeyRetiredMutex.RLock()
+	defer fake.verifyEncryptionKeyRetiredMutex.RUnlock()
 	fake.claimActualLRPMutex.RLock()
 	defer fake.claimActualLRPMutex.RUnlock()
 	fake.startActualLRPMutex.RLock()
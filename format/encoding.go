@@ -1,8 +1,12 @@
 package format
 
 import (
+	"bytes"
+	"compress/gzip"
 	"encoding/base64"
+	"errors"
 	"fmt"
+	"io/ioutil"
 
 	"code.cloudfoundry.org/bbs/encryption"
 )
@@ -14,6 +18,10 @@ var (
 	UNENCODED        Encoding = [2]byte{'0', '0'}
 	BASE64           Encoding = [2]byte{'0', '1'}
 	BASE64_ENCRYPTED Encoding = [2]byte{'0', '2'}
+
+	// BASE64_ENCRYPTED_COMPRESSED payloads are gzipped before being
+	// encrypted, which shrinks large run infos and task definitions.
+	BASE64_ENCRYPTED_COMPRESSED Encoding = [2]byte{'0', '3'}
 )
 
 const EncodingOffset int = 2
@@ -47,6 +55,17 @@ func (e *encoder) Encode(encoding Encoding, payload []byte) ([]byte, error) {
 		}
 		encoded := encodeBase64(encrypted)
 		return append(encoding[:], encoded...), nil
+	case BASE64_ENCRYPTED_COMPRESSED:
+		compressed, err := compress(payload)
+		if err != nil {
+			return nil, err
+		}
+		encrypted, err := e.encrypt(compressed)
+		if err != nil {
+			return nil, err
+		}
+		encoded := encodeBase64(encrypted)
+		return append(encoding[:], encoded...), nil
 	default:
 		return nil, fmt.Errorf("Unknown encoding: %v", encoding)
 	}
@@ -67,11 +86,41 @@ func (e *encoder) Decode(payload []byte) ([]byte, error) {
 			return nil, err
 		}
 		return e.decrypt(encrypted)
+	case BASE64_ENCRYPTED_COMPRESSED:
+		encrypted, err := decodeBase64(payload[EncodingOffset:])
+		if err != nil {
+			return nil, err
+		}
+		compressed, err := e.decrypt(encrypted)
+		if err != nil {
+			return nil, err
+		}
+		return decompress(compressed)
 	default:
 		return nil, fmt.Errorf("Unknown encoding: %v", encoding)
 	}
 }
 
+// EncryptionKeyLabel returns the label of the key an encoded payload was
+// encrypted with, or an empty label if the payload is not encrypted.
+func EncryptionKeyLabel(payload []byte) (string, error) {
+	encoding := encodingFromPayload(payload)
+	if encoding != BASE64_ENCRYPTED && encoding != BASE64_ENCRYPTED_COMPRESSED {
+		return "", nil
+	}
+
+	encrypted, err := decodeBase64(payload[EncodingOffset:])
+	if err != nil {
+		return "", err
+	}
+
+	if len(encrypted) == 0 || len(encrypted) < 1+int(encrypted[0]) {
+		return "", errors.New("Encrypted payload is too short")
+	}
+
+	return string(encrypted[1 : 1+encrypted[0]]), nil
+}
+
 func (e *encoder) encrypt(cleartext []byte) ([]byte, error) {
 	encrypted, err := e.cryptor.Encrypt(cleartext)
 	if err != nil {
@@ -104,6 +153,29 @@ func (e *encoder) decrypt(encryptedData []byte) ([]byte, error) {
 	})
 }
 
+func compress(payload []byte) ([]byte, error) {
+	var buffer bytes.Buffer
+	writer := gzip.NewWriter(&buffer)
+	_, err := writer.Write(payload)
+	if err != nil {
+		return nil, err
+	}
+	err = writer.Close()
+	if err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func decompress(compressed []byte) ([]byte, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return ioutil.ReadAll(reader)
+}
+
 func encodeBase64(unencodedPayload []byte) []byte {
 	encodedLen := base64.StdEncoding.EncodedLen(len(unencodedPayload))
 	encodedPayload := make([]byte, encodedLen)
@@ -1,9 +1,12 @@
 package format_test
 
 import (
+	"bytes"
+	"compress/gzip"
 	"encoding/base64"
 	"errors"
 	"io"
+	"io/ioutil"
 
 	"code.cloudfoundry.org/bbs/encryption"
 	"code.cloudfoundry.org/bbs/encryption/encryptionfakes"
@@ -115,6 +118,37 @@ var _ = Describe("Encoding", func() {
 			})
 		})
 
+		Describe("BASE64_ENCRYPTED_COMPRESSED", func() {
+			It("returns the base64 encoded ciphertext of the gzipped payload with an encoding type prefix", func() {
+				payload := bytes.Repeat([]byte("some-payload"), 100)
+				encoded, err := encoder.Encode(format.BASE64_ENCRYPTED_COMPRESSED, payload)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(encoded[0:2]).To(Equal(format.BASE64_ENCRYPTED_COMPRESSED[:]))
+				decoded, err := base64.StdEncoding.DecodeString(string(encoded[2:]))
+				Expect(err).NotTo(HaveOccurred())
+
+				labelLength := decoded[0]
+				label := string(decoded[1 : 1+labelLength])
+				decoded = decoded[1+labelLength:]
+				Expect(label).To(Equal("label"))
+
+				decrypted, err := cryptor.Decrypt(encryption.Encrypted{
+					KeyLabel:   label,
+					Nonce:      decoded[:encryption.NonceSize],
+					CipherText: decoded[encryption.NonceSize:],
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(decrypted)).To(BeNumerically("<", len(payload)))
+
+				reader, err := gzip.NewReader(bytes.NewReader(decrypted))
+				Expect(err).NotTo(HaveOccurred())
+				decompressed, err := ioutil.ReadAll(reader)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decompressed).To(Equal(payload))
+			})
+		})
+
 		Describe("unkown encoding", func() {
 			It("fails with an unknown encoding error", func() {
 				payload := []byte("some-payload")
@@ -182,6 +216,27 @@ var _ = Describe("Encoding", func() {
 			})
 		})
 
+		Describe("BASE64_ENCRYPTED_COMPRESSED", func() {
+			It("returns the decrypted and decompressed payload without an encoding type prefix", func() {
+				payload := []byte("payload")
+				encoded, err := encoder.Encode(format.BASE64_ENCRYPTED_COMPRESSED, payload)
+				Expect(err).NotTo(HaveOccurred())
+
+				decoded, err := encoder.Decode(encoded)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decoded).To(Equal(payload))
+			})
+
+			It("returns an error if the decrypted payload is not gzipped", func() {
+				encoded, err := encoder.Encode(format.BASE64_ENCRYPTED, []byte("payload"))
+				Expect(err).NotTo(HaveOccurred())
+				copy(encoded, format.BASE64_ENCRYPTED_COMPRESSED[:])
+
+				_, err = encoder.Decode(encoded)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
 		Describe("unkown encoding", func() {
 			It("fails with an unknown encoding error", func() {
 				payload := []byte("99some-payload")
@@ -191,6 +246,43 @@ var _ = Describe("Encoding", func() {
 			})
 		})
 	})
+
+	Describe("EncryptionKeyLabel", func() {
+		It("returns the label of the key an encrypted payload was encrypted with", func() {
+			encoded, err := encoder.Encode(format.BASE64_ENCRYPTED, []byte("some-payload"))
+			Expect(err).NotTo(HaveOccurred())
+
+			label, err := format.EncryptionKeyLabel(encoded)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(label).To(Equal("label"))
+		})
+
+		It("returns the label of the key a compressed payload was encrypted with", func() {
+			encoded, err := encoder.Encode(format.BASE64_ENCRYPTED_COMPRESSED, []byte("some-payload"))
+			Expect(err).NotTo(HaveOccurred())
+
+			label, err := format.EncryptionKeyLabel(encoded)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(label).To(Equal("label"))
+		})
+
+		It("returns an empty label for payloads that are not encrypted", func() {
+			for _, encoding := range []format.Encoding{format.LEGACY_UNENCODED, format.UNENCODED, format.BASE64} {
+				encoded, err := encoder.Encode(encoding, []byte("some-payload"))
+				Expect(err).NotTo(HaveOccurred())
+
+				label, err := format.EncryptionKeyLabel(encoded)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(label).To(BeEmpty())
+			}
+		})
+
+		It("returns an error if the encrypted payload is truncated", func() {
+			encoded := append(format.BASE64_ENCRYPTED[:], []byte(base64.StdEncoding.EncodeToString([]byte{5, 'a'}))...)
+			_, err := format.EncryptionKeyLabel(encoded)
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
 
 type zeroReader struct{}
@@ -11,10 +11,11 @@ type Format struct {
 }
 
 var (
-	LEGACY_FORMATTING *Format = NewFormat(LEGACY_UNENCODED, LEGACY_JSON)
-	FORMATTED_JSON    *Format = NewFormat(UNENCODED, JSON)
-	ENCODED_PROTO     *Format = NewFormat(BASE64, PROTO)
-	ENCRYPTED_PROTO   *Format = NewFormat(BASE64_ENCRYPTED, PROTO)
+	LEGACY_FORMATTING          *Format = NewFormat(LEGACY_UNENCODED, LEGACY_JSON)
+	FORMATTED_JSON             *Format = NewFormat(UNENCODED, JSON)
+	ENCODED_PROTO              *Format = NewFormat(BASE64, PROTO)
+	ENCRYPTED_PROTO            *Format = NewFormat(BASE64_ENCRYPTED, PROTO)
+	COMPRESSED_ENCRYPTED_PROTO *Format = NewFormat(BASE64_ENCRYPTED_COMPRESSED, PROTO)
 )
 
 type serializer struct {
@@ -104,6 +104,23 @@ var _ = Describe("Format", func() {
 				Expect(actualTask).To(Equal(*task))
 			})
 		})
+
+		Describe("COMPRESSED_ENCRYPTED_PROTO", func() {
+			It("marshals the data as protobuf with a base64 encoded ciphertext of the compressed envelope", func() {
+				encoded, err := serializer.Marshal(logger, format.COMPRESSED_ENCRYPTED_PROTO, task)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(encoded[0:2]).To(Equal(format.BASE64_ENCRYPTED_COMPRESSED[:]))
+
+				unencoded, err := encoder.Decode(encoded)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(unencoded[0]).To(BeEquivalentTo(format.PROTO))
+				var actualTask models.Task
+				err = proto.Unmarshal(unencoded[2:], &actualTask)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actualTask).To(Equal(*task))
+			})
+		})
 	})
 
 	Describe("Unmarshal", func() {
@@ -154,5 +171,17 @@ var _ = Describe("Format", func() {
 				Expect(*task).To(Equal(decodedTask))
 			})
 		})
+
+		Describe("COMPRESSED_ENCRYPTED_PROTO", func() {
+			It("unmarshals the protobuf data from a base64 encoded ciphertext of the compressed envelope", func() {
+				payload, err := serializer.Marshal(logger, format.COMPRESSED_ENCRYPTED_PROTO, task)
+				Expect(err).NotTo(HaveOccurred())
+
+				var decodedTask models.Task
+				err = serializer.Unmarshal(logger, payload, &decodedTask)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(*task).To(Equal(decodedTask))
+			})
+		})
 	})
 })
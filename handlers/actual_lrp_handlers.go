@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager"
 )
@@ -29,13 +30,14 @@ func (h *ActualLRPHandler) ActualLRPGroups(logger lager.Logger, w http.ResponseW
 
 	err = parseRequest(logger, req, request)
 	if err == nil {
-		filter := models.ActualLRPFilter{Domain: request.Domain, CellID: request.CellId}
-		response.ActualLrpGroups, err = h.db.ActualLRPGroups(logger, filter)
+		filter := request.Filter()
+		filter.AllowedDomains = middleware.DomainScopeFromRequest(req).Domains()
+		response.ActualLrpGroups, response.NextPageToken, err = h.db.ActualLRPGroupsPage(logger, filter)
 	}
 
 	response.Error = models.ConvertError(err)
 
-	writeResponse(w, response)
+	writeResponse(w, req, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
 
@@ -49,11 +51,12 @@ func (h *ActualLRPHandler) ActualLRPGroupsByProcessGuid(logger lager.Logger, w h
 	err = parseRequest(logger, req, request)
 	if err == nil {
 		response.ActualLrpGroups, err = h.db.ActualLRPGroupsByProcessGuid(logger, request.ProcessGuid)
+		response.ActualLrpGroups = scopeActualLRPGroups(middleware.DomainScopeFromRequest(req), response.ActualLrpGroups)
 	}
 
 	response.Error = models.ConvertError(err)
 
-	writeResponse(w, response)
+	writeResponse(w, req, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
 
@@ -68,9 +71,15 @@ func (h *ActualLRPHandler) ActualLRPGroupByProcessGuidAndIndex(logger lager.Logg
 	if err == nil {
 		response.ActualLrpGroup, err = h.db.ActualLRPGroupByProcessGuidAndIndex(logger, request.ProcessGuid, request.Index)
 	}
+	if err == nil {
+		err = authorizeDomain(req, actualLRPGroupDomain(response.ActualLrpGroup))
+		if err != nil {
+			response.ActualLrpGroup = nil
+		}
+	}
 
 	response.Error = models.ConvertError(err)
 
-	writeResponse(w, response)
+	writeResponse(w, req, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
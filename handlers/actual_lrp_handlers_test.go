@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/bbs/db/dbfakes"
 	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager/lagertest"
 	. "github.com/onsi/ginkgo"
@@ -35,10 +36,14 @@ var _ = Describe("ActualLRP Handlers", func() {
 	})
 
 	Describe("ActualLRPGroups", func() {
-		var requestBody interface{}
+		var (
+			requestBody interface{}
+			scope       middleware.DomainScope
+		)
 
 		BeforeEach(func() {
 			requestBody = &models.ActualLRPGroupsRequest{}
+			scope = nil
 			actualLRP1 = models.ActualLRP{
 				ActualLRPKey: models.NewActualLRPKey(
 					"process-guid-0",
@@ -73,7 +78,7 @@ var _ = Describe("ActualLRP Handlers", func() {
 		})
 
 		JustBeforeEach(func() {
-			request := newTestRequest(requestBody)
+			request := middleware.WithDomainScope(newTestRequest(requestBody), scope)
 			handler.ActualLRPGroups(logger, responseRecorder, request)
 		})
 
@@ -86,7 +91,7 @@ var _ = Describe("ActualLRP Handlers", func() {
 						{Instance: &actualLRP1},
 						{Instance: &actualLRP2, Evacuating: &evacuatingLRP2},
 					}
-				fakeActualLRPDB.ActualLRPGroupsReturns(actualLRPGroups, nil)
+				fakeActualLRPDB.ActualLRPGroupsPageReturns(actualLRPGroups, "", nil)
 			})
 
 			It("returns a list of actual lrp groups", func() {
@@ -101,8 +106,8 @@ var _ = Describe("ActualLRP Handlers", func() {
 
 			Context("and no filter is provided", func() {
 				It("call the DB with no filters to retrieve the actual lrp groups", func() {
-					Expect(fakeActualLRPDB.ActualLRPGroupsCallCount()).To(Equal(1))
-					_, filter := fakeActualLRPDB.ActualLRPGroupsArgsForCall(0)
+					Expect(fakeActualLRPDB.ActualLRPGroupsPageCallCount()).To(Equal(1))
+					_, filter := fakeActualLRPDB.ActualLRPGroupsPageArgsForCall(0)
 					Expect(filter).To(Equal(models.ActualLRPFilter{}))
 				})
 			})
@@ -113,20 +118,60 @@ var _ = Describe("ActualLRP Handlers", func() {
 				})
 
 				It("call the DB with the domain filter to retrieve the actual lrp groups", func() {
-					Expect(fakeActualLRPDB.ActualLRPGroupsCallCount()).To(Equal(1))
-					_, filter := fakeActualLRPDB.ActualLRPGroupsArgsForCall(0)
+					Expect(fakeActualLRPDB.ActualLRPGroupsPageCallCount()).To(Equal(1))
+					_, filter := fakeActualLRPDB.ActualLRPGroupsPageArgsForCall(0)
 					Expect(filter.Domain).To(Equal("domain-1"))
 				})
 			})
 
+			Context("and the client is restricted to some domains", func() {
+				BeforeEach(func() {
+					scope = middleware.NewDomainScope("domain-1")
+				})
+
+				It("call the DB restricted to those domains to retrieve the actual lrp groups", func() {
+					Expect(fakeActualLRPDB.ActualLRPGroupsPageCallCount()).To(Equal(1))
+					_, filter := fakeActualLRPDB.ActualLRPGroupsPageArgsForCall(0)
+					Expect(filter.AllowedDomains).To(Equal([]string{"domain-1"}))
+				})
+			})
+
+			Context("and paging", func() {
+				BeforeEach(func() {
+					pageToken := models.ActualLRPFilter{PageSize: 1}.NextPageToken("process-guid-0", 1)
+					requestBody = &models.ActualLRPGroupsRequest{PageSize: 2, PageToken: pageToken}
+					fakeActualLRPDB.ActualLRPGroupsPageReturns(actualLRPGroups, models.ActualLRPFilter{PageSize: 2}.NextPageToken("process-guid-1", 2), nil)
+				})
+
+				It("call the DB with the page to retrieve the actual lrp groups", func() {
+					Expect(fakeActualLRPDB.ActualLRPGroupsPageCallCount()).To(Equal(1))
+					_, filter := fakeActualLRPDB.ActualLRPGroupsPageArgsForCall(0)
+					Expect(filter.PageSize).To(Equal(2))
+					Expect(filter.AfterProcessGuid).To(Equal("process-guid-0"))
+					Expect(filter.AfterIndex).To(BeEquivalentTo(1))
+				})
+
+				It("returns the token for the next page", func() {
+					response := models.ActualLRPGroupsResponse{}
+					err := response.Unmarshal(responseRecorder.Body.Bytes())
+					Expect(err).NotTo(HaveOccurred())
+
+					nextRequest := models.ActualLRPGroupsRequest{PageSize: 2, PageToken: response.NextPageToken}
+					Expect(nextRequest.Validate()).To(Succeed())
+					filter := nextRequest.Filter()
+					Expect(filter.AfterProcessGuid).To(Equal("process-guid-1"))
+					Expect(filter.AfterIndex).To(BeEquivalentTo(2))
+				})
+			})
+
 			Context("and filtering by cellId", func() {
 				BeforeEach(func() {
 					requestBody = &models.ActualLRPGroupsRequest{CellId: "cellid-1"}
 				})
 
 				It("call the DB with the cell id filter to retrieve the actual lrp groups", func() {
-					Expect(fakeActualLRPDB.ActualLRPGroupsCallCount()).To(Equal(1))
-					_, filter := fakeActualLRPDB.ActualLRPGroupsArgsForCall(0)
+					Expect(fakeActualLRPDB.ActualLRPGroupsPageCallCount()).To(Equal(1))
+					_, filter := fakeActualLRPDB.ActualLRPGroupsPageArgsForCall(0)
 					Expect(filter.CellID).To(Equal("cellid-1"))
 				})
 			})
@@ -137,8 +182,8 @@ var _ = Describe("ActualLRP Handlers", func() {
 				})
 
 				It("call the DB with the both filters to retrieve the actual lrp groups", func() {
-					Expect(fakeActualLRPDB.ActualLRPGroupsCallCount()).To(Equal(1))
-					_, filter := fakeActualLRPDB.ActualLRPGroupsArgsForCall(0)
+					Expect(fakeActualLRPDB.ActualLRPGroupsPageCallCount()).To(Equal(1))
+					_, filter := fakeActualLRPDB.ActualLRPGroupsPageArgsForCall(0)
 					Expect(filter.CellID).To(Equal("cellid-1"))
 					Expect(filter.Domain).To(Equal("potato"))
 				})
@@ -147,7 +192,7 @@ var _ = Describe("ActualLRP Handlers", func() {
 
 		Context("when the DB returns no actual lrp groups", func() {
 			BeforeEach(func() {
-				fakeActualLRPDB.ActualLRPGroupsReturns([]*models.ActualLRPGroup{}, nil)
+				fakeActualLRPDB.ActualLRPGroupsPageReturns([]*models.ActualLRPGroup{}, "", nil)
 			})
 
 			It("returns an empty list", func() {
@@ -163,7 +208,7 @@ var _ = Describe("ActualLRP Handlers", func() {
 
 		Context("when the DB returns an unrecoverable error", func() {
 			BeforeEach(func() {
-				fakeActualLRPDB.ActualLRPGroupsReturns([]*models.ActualLRPGroup{}, models.NewUnrecoverableError(nil))
+				fakeActualLRPDB.ActualLRPGroupsPageReturns([]*models.ActualLRPGroup{}, "", models.NewUnrecoverableError(nil))
 			})
 
 			It("logs and writes to the exit channel", func() {
@@ -174,7 +219,7 @@ var _ = Describe("ActualLRP Handlers", func() {
 
 		Context("when the DB errors out", func() {
 			BeforeEach(func() {
-				fakeActualLRPDB.ActualLRPGroupsReturns([]*models.ActualLRPGroup{}, models.ErrUnknownError)
+				fakeActualLRPDB.ActualLRPGroupsPageReturns([]*models.ActualLRPGroup{}, "", models.ErrUnknownError)
 			})
 
 			It("provides relevant error information", func() {
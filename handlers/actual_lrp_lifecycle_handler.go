@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/auctioneer"
 	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager"
 )
@@ -30,10 +31,12 @@ type ActualLRPLifecycleHandler struct {
 }
 
 func NewActualLRPLifecycleHandler(
+	db db.ActualLRPDB,
 	controller ActualLRPLifecycleController,
 	exitChan chan<- struct{},
 ) *ActualLRPLifecycleHandler {
 	return &ActualLRPLifecycleHandler{
+		db:         db,
 		controller: controller,
 		exitChan:   exitChan,
 	}
@@ -46,7 +49,7 @@ func (h *ActualLRPLifecycleHandler) ClaimActualLRP(logger lager.Logger, w http.R
 	request := &models.ClaimActualLRPRequest{}
 	response := &models.ActualLRPLifecycleResponse{}
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -67,7 +70,7 @@ func (h *ActualLRPLifecycleHandler) StartActualLRP(logger lager.Logger, w http.R
 	response := &models.ActualLRPLifecycleResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -85,7 +88,7 @@ func (h *ActualLRPLifecycleHandler) CrashActualLRP(logger lager.Logger, w http.R
 	request := &models.CrashActualLRPRequest{}
 	response := &models.ActualLRPLifecycleResponse{}
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err := parseRequest(logger, req, request)
 	if err != nil {
@@ -108,7 +111,7 @@ func (h *ActualLRPLifecycleHandler) FailActualLRP(logger lager.Logger, w http.Re
 	response := &models.ActualLRPLifecycleResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -128,7 +131,7 @@ func (h *ActualLRPLifecycleHandler) RemoveActualLRP(logger lager.Logger, w http.
 	response := &models.ActualLRPLifecycleResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -147,7 +150,7 @@ func (h *ActualLRPLifecycleHandler) RetireActualLRP(logger lager.Logger, w http.
 
 	var err error
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -155,6 +158,19 @@ func (h *ActualLRPLifecycleHandler) RetireActualLRP(logger lager.Logger, w http.
 		return
 	}
 
+	if middleware.DomainScopeFromRequest(req) != nil {
+		// the domain in the request key is not checked against the stored LRP
+		var group *models.ActualLRPGroup
+		group, err = h.db.ActualLRPGroupByProcessGuidAndIndex(logger, request.ActualLrpKey.ProcessGuid, request.ActualLrpKey.Index)
+		if err == nil {
+			err = authorizeDomain(req, actualLRPGroupDomain(group))
+		}
+		if err != nil {
+			response.Error = models.ConvertError(err)
+			return
+		}
+	}
+
 	err = h.controller.RetireActualLRP(logger, request.ActualLrpKey)
 	response.Error = models.ConvertError(err)
 }
@@ -5,12 +5,15 @@ import (
 	"net/http"
 	"net/http/httptest"
 
+	"code.cloudfoundry.org/bbs/db/dbfakes"
 	"code.cloudfoundry.org/bbs/fake_bbs"
 	"code.cloudfoundry.org/bbs/handlers"
 	"code.cloudfoundry.org/bbs/handlers/fake_controllers"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
 	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"code.cloudfoundry.org/bbs/tracedclients/tracedclientsfakes"
 	"code.cloudfoundry.org/lager/lagertest"
-	"code.cloudfoundry.org/rep/repfakes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gbytes"
@@ -22,6 +25,7 @@ var _ = Describe("ActualLRP Lifecycle Handlers", func() {
 		responseRecorder *httptest.ResponseRecorder
 		handler          *handlers.ActualLRPLifecycleHandler
 		fakeController   *fake_controllers.FakeActualLRPLifecycleController
+		fakeActualLRPDB  *dbfakes.FakeActualLRPDB
 		exitCh           chan struct{}
 	)
 
@@ -30,13 +34,14 @@ var _ = Describe("ActualLRP Lifecycle Handlers", func() {
 		responseRecorder = httptest.NewRecorder()
 
 		fakeServiceClient = new(fake_bbs.FakeServiceClient)
-		fakeRepClientFactory = new(repfakes.FakeClientFactory)
-		fakeRepClient = new(repfakes.FakeClient)
+		fakeRepClientFactory = new(tracedclientsfakes.FakeRepClientFactory)
+		fakeRepClient = new(tracedclientsfakes.FakeRepClient)
 		fakeRepClientFactory.CreateClientReturns(fakeRepClient, nil)
 
 		exitCh = make(chan struct{}, 1)
 		fakeController = &fake_controllers.FakeActualLRPLifecycleController{}
-		handler = handlers.NewActualLRPLifecycleHandler(fakeController, exitCh)
+		fakeActualLRPDB = new(dbfakes.FakeActualLRPDB)
+		handler = handlers.NewActualLRPLifecycleHandler(fakeActualLRPDB, fakeController, exitCh)
 	})
 
 	Describe("ClaimActualLRP", func() {
@@ -294,6 +299,7 @@ var _ = Describe("ActualLRP Lifecycle Handlers", func() {
 			key models.ActualLRPKey
 
 			requestBody interface{}
+			scope       middleware.DomainScope
 		)
 
 		BeforeEach(func() {
@@ -306,10 +312,11 @@ var _ = Describe("ActualLRP Lifecycle Handlers", func() {
 			requestBody = &models.RetireActualLRPRequest{
 				ActualLrpKey: &key,
 			}
+			scope = nil
 		})
 
 		JustBeforeEach(func() {
-			request = newTestRequest(requestBody)
+			request = middleware.WithDomainScope(newTestRequest(requestBody), scope)
 			handler.RetireActualLRP(logger, responseRecorder, request)
 
 			response = &models.ActualLRPLifecycleResponse{}
@@ -343,6 +350,33 @@ var _ = Describe("ActualLRP Lifecycle Handlers", func() {
 				Expect(response.Error.Message).To(Equal("could not find lrp"))
 			})
 		})
+
+		Context("when the client is restricted to some domains", func() {
+			BeforeEach(func() {
+				scope = middleware.NewDomainScope("domain-0")
+
+				// the stored LRP's domain is checked, not the one in the request
+				actualLRP := model_helpers.NewValidActualLRP(processGuid, index)
+				actualLRP.Domain = "domain-1"
+				fakeActualLRPDB.ActualLRPGroupByProcessGuidAndIndexReturns(&models.ActualLRPGroup{Instance: actualLRP}, nil)
+			})
+
+			It("does not retire LRPs in other domains", func() {
+				Expect(response.Error).To(Equal(models.ErrUnauthorized))
+				Expect(fakeController.RetireActualLRPCallCount()).To(Equal(0))
+			})
+
+			Context("when the LRP is in an allowed domain", func() {
+				BeforeEach(func() {
+					scope = middleware.NewDomainScope("domain-0", "domain-1")
+				})
+
+				It("retires it", func() {
+					Expect(response.Error).To(BeNil())
+					Expect(fakeController.RetireActualLRPCallCount()).To(Equal(1))
+				})
+			})
+		})
 	})
 
 	Describe("FailActualLRP", func() {
@@ -0,0 +1,385 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/trace"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/gogo/protobuf/proto"
+)
+
+// auditDescriber fills in the domain, guid and summary of the event from the
+// request decoded by decode, which returns false if the body could not be
+// decoded.
+type auditDescriber func(a *Auditor, logger lager.Logger, decode func(proto.Message) bool, event *models.AuditEvent)
+
+// auditedRoutes are the routes changing LRPs, tasks and domains on behalf of
+// API clients. The calls made by the cell reps are not audited.
+var auditedRoutes = map[string]auditDescriber{
+	bbs.UpsertDomainRoute:    (*Auditor).describeUpsertDomain,
+	bbs.RetireActualLRPRoute: (*Auditor).describeRetireActualLRP,
+
+	bbs.DesireDesiredLRPRoute:    (*Auditor).describeDesireLRP,
+	bbs.DesireDesiredLRPRoute_r1: (*Auditor).describeDesireLRP,
+	bbs.DesireDesiredLRPRoute_r0: (*Auditor).describeDesireLRP,
+	bbs.UpdateDesiredLRPRoute:    (*Auditor).describeUpdateDesiredLRP,
+	bbs.RemoveDesiredLRPRoute:    (*Auditor).describeRemoveDesiredLRP,
+	bbs.RedeployDesiredLRPRoute:  (*Auditor).describeRedeployDesiredLRP,
+	bbs.RollbackDesiredLRPRoute:  (*Auditor).describeRollbackDesiredLRP,
+	bbs.DesireDesiredLRPsRoute:   (*Auditor).describeDesireLRPs,
+	bbs.RemoveDesiredLRPsRoute:   (*Auditor).describeRemoveDesiredLRPs,
+	bbs.ApplyDesiredLRPsRoute:    (*Auditor).describeApplyDesiredLRPs,
+
+	bbs.DesireTaskRoute:    (*Auditor).describeDesireTask,
+	bbs.DesireTaskRoute_r1: (*Auditor).describeDesireTask,
+	bbs.DesireTaskRoute_r0: (*Auditor).describeDesireTask,
+	bbs.DesireTasksRoute:   (*Auditor).describeDesireTasks,
+	bbs.CancelTaskRoute:    (*Auditor).describeTaskGuid,
+	bbs.DeleteTaskRoute:    (*Auditor).describeTaskGuid,
+	bbs.CancelTasksRoute:   (*Auditor).describeTaskGuids,
+	bbs.DeleteTasksRoute:   (*Auditor).describeTaskGuids,
+
+	bbs.RestoreQuarantinedRecordRoute:  (*Auditor).describeRestoreQuarantinedRecord,
+	bbs.RestoreQuarantinedRecordsRoute: (*Auditor).describeRestoreQuarantinedRecords,
+}
+
+// Auditor records every call to the audited routes in the audit log, with the
+// client that made it, when, what it asked for and how it was answered.
+type Auditor struct {
+	logger       lager.Logger
+	auditDB      db.AuditDB
+	desiredLRPDB db.DesiredLRPDB
+	taskDB       db.TaskDB
+	clock        clock.Clock
+}
+
+func NewAuditor(logger lager.Logger, auditDB db.AuditDB, desiredLRPDB db.DesiredLRPDB, taskDB db.TaskDB, clock clock.Clock) *Auditor {
+	return &Auditor{
+		logger:       logger.Session("auditor"),
+		auditDB:      auditDB,
+		desiredLRPDB: desiredLRPDB,
+		taskDB:       taskDB,
+		clock:        clock,
+	}
+}
+
+// Wrap records the requests to route in the audit log once they have been
+// served, including those rejected by the Authorizer. Routes that are not
+// audited are returned unwrapped. The domain of the LRP or task named by the
+// request is looked up before it is served, as the request may remove it.
+func (a *Auditor) Wrap(route string, handler http.Handler) http.Handler {
+	describe, ok := auditedRoutes[route]
+	if !ok {
+		return handler
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestTrace, ok := trace.FromContext(r.Context())
+		if !ok {
+			requestTrace = trace.FromRequest(r)
+			r = r.WithContext(trace.NewContext(r.Context(), requestTrace))
+		}
+
+		logger := trace.WithTrace(a.logger.Session("audit", lager.Data{"route": route}), requestTrace)
+
+		event := &models.AuditEvent{
+			CreatedAt:     a.clock.Now().UnixNano(),
+			Route:         route,
+			Actor:         middleware.ClientName(r.TLS),
+			RemoteAddress: r.RemoteAddr,
+			RequestId:     requestTrace.RequestID,
+		}
+
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			logger.Error("failed-to-read-body", err)
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(data))
+
+		describe(a, logger, func(message proto.Message) bool {
+			return decodeAuditedRequest(r, data, message)
+		}, event)
+
+		recorder := middleware.NewResponseRecorder(w)
+		handler.ServeHTTP(recorder, r)
+
+		event.Error = recorder.Error()
+		if event.Error == nil && recorder.Status() >= http.StatusBadRequest {
+			event.Error = models.NewError(models.Error_UnknownError, http.StatusText(recorder.Status()))
+		}
+
+		err = a.auditDB.RecordAuditEvent(logger, event)
+		if err != nil {
+			logger.Error("failed-to-record-audit-event", err, event.LagerData())
+		}
+	})
+}
+
+// decodeAuditedRequest decodes the body of req like parseRequest, without
+// validating it.
+func decodeAuditedRequest(req *http.Request, data []byte, message proto.Message) bool {
+	if middleware.IsJSONMediaType(req.Header.Get(bbs.ContentTypeHeader)) {
+		return len(data) == 0 || json.Unmarshal(data, message) == nil
+	}
+	return proto.Unmarshal(data, message) == nil
+}
+
+func (a *Auditor) describeUpsertDomain(logger lager.Logger, decode func(proto.Message) bool, event *models.AuditEvent) {
+	request := &models.UpsertDomainRequest{}
+	if !decode(request) {
+		return
+	}
+
+	event.Domain = request.Domain
+	event.Summary = fmt.Sprintf("ttl: %d", request.Ttl)
+}
+
+func (a *Auditor) describeRetireActualLRP(logger lager.Logger, decode func(proto.Message) bool, event *models.AuditEvent) {
+	request := &models.RetireActualLRPRequest{}
+	if !decode(request) || request.ActualLrpKey == nil {
+		return
+	}
+
+	event.Domain = request.ActualLrpKey.Domain
+	event.Guid = request.ActualLrpKey.ProcessGuid
+	event.Summary = fmt.Sprintf("index: %d", request.ActualLrpKey.Index)
+}
+
+func (a *Auditor) describeDesireLRP(logger lager.Logger, decode func(proto.Message) bool, event *models.AuditEvent) {
+	request := &models.DesireLRPRequest{}
+	if !decode(request) || request.DesiredLrp == nil {
+		return
+	}
+
+	event.Domain = request.DesiredLrp.Domain
+	event.Guid = request.DesiredLrp.ProcessGuid
+	event.Summary = fmt.Sprintf("instances: %d", request.DesiredLrp.Instances)
+}
+
+func (a *Auditor) describeUpdateDesiredLRP(logger lager.Logger, decode func(proto.Message) bool, event *models.AuditEvent) {
+	request := &models.UpdateDesiredLRPRequest{}
+	if !decode(request) {
+		return
+	}
+
+	event.Guid = request.ProcessGuid
+	event.Domain = a.processGuidsDomain(logger, request.ProcessGuid)
+
+	changes := []string{}
+	if update := request.Update; update != nil {
+		if update.Instances != nil {
+			changes = append(changes, fmt.Sprintf("instances: %d", *update.Instances))
+		}
+		if update.Routes != nil {
+			changes = append(changes, "routes: changed")
+		}
+		if update.Annotation != nil {
+			changes = append(changes, "annotation: changed")
+		}
+	}
+	event.Summary = strings.Join(changes, ", ")
+}
+
+func (a *Auditor) describeRemoveDesiredLRP(logger lager.Logger, decode func(proto.Message) bool, event *models.AuditEvent) {
+	request := &models.RemoveDesiredLRPRequest{}
+	if !decode(request) {
+		return
+	}
+
+	event.Guid = request.ProcessGuid
+	event.Domain = a.processGuidsDomain(logger, request.ProcessGuid)
+}
+
+func (a *Auditor) describeRedeployDesiredLRP(logger lager.Logger, decode func(proto.Message) bool, event *models.AuditEvent) {
+	request := &models.RedeployDesiredLRPRequest{}
+	if !decode(request) {
+		return
+	}
+
+	event.Guid = request.ProcessGuid
+	event.Domain = a.processGuidsDomain(logger, request.ProcessGuid)
+	event.Summary = fmt.Sprintf("max_in_flight: %d", request.MaxInFlight)
+}
+
+func (a *Auditor) describeRollbackDesiredLRP(logger lager.Logger, decode func(proto.Message) bool, event *models.AuditEvent) {
+	request := &models.RollbackDesiredLRPRequest{}
+	if !decode(request) {
+		return
+	}
+
+	event.Guid = request.ProcessGuid
+	event.Domain = a.processGuidsDomain(logger, request.ProcessGuid)
+	event.Summary = fmt.Sprintf("revision: %d", request.Revision)
+}
+
+func (a *Auditor) describeRestoreQuarantinedRecord(logger lager.Logger, decode func(proto.Message) bool, event *models.AuditEvent) {
+	request := &models.RestoreQuarantinedRecordRequest{}
+	if !decode(request) {
+		return
+	}
+
+	event.Summary = fmt.Sprintf("quarantined_record_id: %d", request.Id)
+}
+
+func (a *Auditor) describeRestoreQuarantinedRecords(logger lager.Logger, decode func(proto.Message) bool, event *models.AuditEvent) {
+	request := &models.RestoreQuarantinedRecordsRequest{}
+	if !decode(request) {
+		return
+	}
+
+	if request.TableName != "" {
+		event.Summary = fmt.Sprintf("table_name: %s", request.TableName)
+	}
+}
+
+func (a *Auditor) describeDesireLRPs(logger lager.Logger, decode func(proto.Message) bool, event *models.AuditEvent) {
+	request := &models.DesireLRPsRequest{}
+	if !decode(request) {
+		return
+	}
+
+	domains := []string{}
+	processGuids := []string{}
+	for _, desiredLRP := range request.DesiredLrps {
+		domains = append(domains, desiredLRP.Domain)
+		processGuids = append(processGuids, desiredLRP.ProcessGuid)
+	}
+
+	event.Domain = commonDomain(domains)
+	event.Summary = "process_guids: " + strings.Join(processGuids, ", ")
+}
+
+func (a *Auditor) describeRemoveDesiredLRPs(logger lager.Logger, decode func(proto.Message) bool, event *models.AuditEvent) {
+	request := &models.RemoveDesiredLRPsRequest{}
+	if !decode(request) {
+		return
+	}
+
+	event.Domain = a.processGuidsDomain(logger, request.ProcessGuids...)
+	event.Summary = "process_guids: " + strings.Join(request.ProcessGuids, ", ")
+}
+
+func (a *Auditor) describeApplyDesiredLRPs(logger lager.Logger, decode func(proto.Message) bool, event *models.AuditEvent) {
+	request := &models.ApplyDesiredLRPsRequest{}
+	if !decode(request) {
+		return
+	}
+
+	event.Domain = request.Domain
+	event.Summary = fmt.Sprintf("desired_lrps: %d", len(request.DesiredLrps))
+}
+
+func (a *Auditor) describeDesireTask(logger lager.Logger, decode func(proto.Message) bool, event *models.AuditEvent) {
+	request := &models.DesireTaskRequest{}
+	if !decode(request) {
+		return
+	}
+
+	event.Domain = request.Domain
+	event.Guid = request.TaskGuid
+}
+
+func (a *Auditor) describeDesireTasks(logger lager.Logger, decode func(proto.Message) bool, event *models.AuditEvent) {
+	request := &models.DesireTasksRequest{}
+	if !decode(request) {
+		return
+	}
+
+	domains := []string{}
+	taskGuids := []string{}
+	for _, task := range request.Tasks {
+		domains = append(domains, task.Domain)
+		taskGuids = append(taskGuids, task.TaskGuid)
+	}
+
+	event.Domain = commonDomain(domains)
+	event.Summary = "task_guids: " + strings.Join(taskGuids, ", ")
+}
+
+func (a *Auditor) describeTaskGuid(logger lager.Logger, decode func(proto.Message) bool, event *models.AuditEvent) {
+	request := &models.TaskGuidRequest{}
+	if !decode(request) {
+		return
+	}
+
+	event.Guid = request.TaskGuid
+	if request.TaskGuid == "" {
+		return
+	}
+
+	task, err := a.taskDB.TaskByGuid(logger, request.TaskGuid)
+	if err != nil {
+		logger.Debug("failed-to-fetch-task-domain", lager.Data{"task_guid": request.TaskGuid, "error": err.Error()})
+		return
+	}
+	event.Domain = task.Domain
+}
+
+func (a *Auditor) describeTaskGuids(logger lager.Logger, decode func(proto.Message) bool, event *models.AuditEvent) {
+	request := &models.TaskGuidsRequest{}
+	if !decode(request) {
+		return
+	}
+
+	event.Summary = "task_guids: " + strings.Join(request.TaskGuids, ", ")
+
+	domains := []string{}
+	for _, taskGuid := range request.TaskGuids {
+		task, err := a.taskDB.TaskByGuid(logger, taskGuid)
+		if err != nil {
+			logger.Debug("failed-to-fetch-task-domain", lager.Data{"task_guid": taskGuid, "error": err.Error()})
+			continue
+		}
+
+		domains = append(domains, task.Domain)
+		if task.Domain != domains[0] {
+			return
+		}
+	}
+	event.Domain = commonDomain(domains)
+}
+
+// processGuidsDomain returns the domain of the DesiredLRPs, or an empty string
+// if they are not all in the same domain or none of them exist.
+func (a *Auditor) processGuidsDomain(logger lager.Logger, processGuids ...string) string {
+	if len(processGuids) == 0 || processGuids[0] == "" {
+		return ""
+	}
+
+	schedulingInfos, err := a.desiredLRPDB.DesiredLRPSchedulingInfos(logger, models.DesiredLRPFilter{ProcessGuids: processGuids})
+	if err != nil {
+		logger.Debug("failed-to-fetch-desired-lrp-domain", lager.Data{"process_guids": processGuids, "error": err.Error()})
+		return ""
+	}
+
+	domains := []string{}
+	for _, schedulingInfo := range schedulingInfos {
+		domains = append(domains, schedulingInfo.Domain)
+	}
+	return commonDomain(domains)
+}
+
+// commonDomain returns the domain shared by all the domains, or an empty
+// string if there is more than one.
+func commonDomain(domains []string) string {
+	if len(domains) == 0 {
+		return ""
+	}
+
+	for _, domain := range domains[1:] {
+		if domain != domains[0] {
+			return ""
+		}
+	}
+	return domains[0]
+}
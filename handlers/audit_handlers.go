@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+var ErrAuditLogDisabled = models.NewError(models.Error_InvalidRequest, "the audit log is not enabled")
+
+type AuditHandler struct {
+	db       db.AuditDB
+	exitChan chan<- struct{}
+}
+
+// NewAuditHandler returns a handler serving the audit log from db, which is
+// nil when the audit log is disabled.
+func NewAuditHandler(db db.AuditDB, exitChan chan<- struct{}) *AuditHandler {
+	return &AuditHandler{
+		db:       db,
+		exitChan: exitChan,
+	}
+}
+
+func (h *AuditHandler) AuditEvents(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("audit-events")
+
+	request := &models.AuditEventsRequest{}
+	response := &models.AuditEventsResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, req, response) }()
+
+	if h.db == nil {
+		response.Error = ErrAuditLogDisabled
+		return
+	}
+
+	err = parseRequest(logger, req, request)
+	if err != nil {
+		logger.Error("failed-parsing-request", err)
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	filter := request.Filter()
+	filter.AllowedDomains = middleware.DomainScopeFromRequest(req).Domains()
+	response.AuditEvents, err = h.db.AuditEvents(logger, filter)
+	response.Error = models.ConvertError(err)
+}
@@ -0,0 +1,113 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Audit Handlers", func() {
+	var (
+		logger           *lagertest.TestLogger
+		fakeAuditDB      *dbfakes.FakeAuditDB
+		responseRecorder *httptest.ResponseRecorder
+		handler          *handlers.AuditHandler
+		requestBody      interface{}
+		request          *http.Request
+		exitCh           chan struct{}
+
+		event1, event2 *models.AuditEvent
+	)
+
+	BeforeEach(func() {
+		fakeAuditDB = new(dbfakes.FakeAuditDB)
+		logger = lagertest.NewTestLogger("test")
+		responseRecorder = httptest.NewRecorder()
+		exitCh = make(chan struct{}, 1)
+		handler = handlers.NewAuditHandler(fakeAuditDB, exitCh)
+
+		event1 = &models.AuditEvent{Id: 1, Route: "DesireTask_r2", Domain: "domain-1", Guid: "task-1"}
+		event2 = &models.AuditEvent{Id: 2, Route: "DesireTask_r2", Domain: "domain-2", Guid: "task-2"}
+		fakeAuditDB.AuditEventsReturns([]*models.AuditEvent{event1, event2}, nil)
+
+		requestBody = &models.AuditEventsRequest{Domain: "domain-1", Since: 10, Until: 20, Limit: 5}
+	})
+
+	JustBeforeEach(func() {
+		if request == nil {
+			request = newTestRequest(requestBody)
+		}
+		handler.AuditEvents(logger, responseRecorder, request)
+	})
+
+	AfterEach(func() {
+		request = nil
+	})
+
+	parseResponse := func() *models.AuditEventsResponse {
+		Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+		response := &models.AuditEventsResponse{}
+		Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+		return response
+	}
+
+	It("lists the audit events matching the request", func() {
+		Expect(fakeAuditDB.AuditEventsCallCount()).To(Equal(1))
+		_, filter := fakeAuditDB.AuditEventsArgsForCall(0)
+		Expect(filter).To(Equal(models.AuditEventFilter{Domain: "domain-1", Since: 10, Until: 20, Limit: 5}))
+
+		response := parseResponse()
+		Expect(response.Error).To(BeNil())
+		Expect(response.AuditEvents).To(Equal([]*models.AuditEvent{event1, event2}))
+	})
+
+	Context("when the client is restricted to some domains", func() {
+		BeforeEach(func() {
+			request = middleware.WithDomainScope(newTestRequest(requestBody), middleware.NewDomainScope("domain-2"))
+		})
+
+		It("lists only the events in those domains", func() {
+			Expect(fakeAuditDB.AuditEventsCallCount()).To(Equal(1))
+			_, filter := fakeAuditDB.AuditEventsArgsForCall(0)
+			Expect(filter.AllowedDomains).To(Equal([]string{"domain-2"}))
+		})
+	})
+
+	Context("when the request is invalid", func() {
+		BeforeEach(func() {
+			requestBody = &models.AuditEventsRequest{Limit: -1}
+		})
+
+		It("responds with an InvalidRequest error", func() {
+			Expect(fakeAuditDB.AuditEventsCallCount()).To(Equal(0))
+			Expect(parseResponse().Error.Type).To(Equal(models.Error_InvalidRequest))
+		})
+	})
+
+	Context("when the DB fails", func() {
+		BeforeEach(func() {
+			fakeAuditDB.AuditEventsReturns(nil, models.ErrUnknownError)
+		})
+
+		It("responds with the error", func() {
+			Expect(parseResponse().Error).To(Equal(models.ErrUnknownError))
+		})
+	})
+
+	Context("when the audit log is disabled", func() {
+		BeforeEach(func() {
+			handler = handlers.NewAuditHandler(nil, exitCh)
+		})
+
+		It("responds with an error saying so", func() {
+			Expect(parseResponse().Error).To(Equal(handlers.ErrAuditLogDisabled))
+		})
+	})
+})
@@ -0,0 +1,252 @@
+package handlers_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/trace"
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/gogo/protobuf/proto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Auditor", func() {
+	var (
+		logger           *lagertest.TestLogger
+		fakeAuditDB      *dbfakes.FakeAuditDB
+		fakeDesiredLRPDB *dbfakes.FakeDesiredLRPDB
+		fakeTaskDB       *dbfakes.FakeTaskDB
+		fakeClock        *fakeclock.FakeClock
+		auditor          *handlers.Auditor
+
+		route        string
+		requestBody  interface{}
+		response     proto.Message
+		servedBody   []byte
+		innerHandler http.Handler
+		request      *http.Request
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeAuditDB = new(dbfakes.FakeAuditDB)
+		fakeDesiredLRPDB = new(dbfakes.FakeDesiredLRPDB)
+		fakeTaskDB = new(dbfakes.FakeTaskDB)
+		fakeClock = fakeclock.NewFakeClock(time.Unix(1000, 0))
+		auditor = handlers.NewAuditor(logger, fakeAuditDB, fakeDesiredLRPDB, fakeTaskDB, fakeClock)
+
+		route = bbs.DesireDesiredLRPRoute
+		requestBody = &models.DesireLRPRequest{
+			DesiredLrp: &models.DesiredLRP{ProcessGuid: "process-guid", Domain: "cf-apps", Instances: 3},
+		}
+		response = &models.DesiredLRPLifecycleResponse{}
+		servedBody = nil
+
+		innerHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var err error
+			servedBody, err = ioutil.ReadAll(r.Body)
+			Expect(err).NotTo(HaveOccurred())
+			middleware.WriteMessage(w, r, response)
+		})
+	})
+
+	JustBeforeEach(func() {
+		request = newTestRequest(requestBody)
+		request.RemoteAddr = "10.0.0.1:52000"
+		request.Header.Set(trace.RequestIDHeader, "some-request-id")
+		request.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{
+			{Subject: pkix.Name{CommonName: "cloud-controller"}},
+		}}
+
+		auditor.Wrap(route, innerHandler).ServeHTTP(httptest.NewRecorder(), request)
+	})
+
+	recordedEvent := func() *models.AuditEvent {
+		Expect(fakeAuditDB.RecordAuditEventCallCount()).To(Equal(1))
+		_, event := fakeAuditDB.RecordAuditEventArgsForCall(0)
+		return event
+	}
+
+	It("records who made the request, when, and what it asked for", func() {
+		Expect(recordedEvent()).To(Equal(&models.AuditEvent{
+			CreatedAt:     fakeClock.Now().UnixNano(),
+			Route:         bbs.DesireDesiredLRPRoute,
+			Actor:         "cloud-controller",
+			RemoteAddress: "10.0.0.1:52000",
+			RequestId:     "some-request-id",
+			Domain:        "cf-apps",
+			Guid:          "process-guid",
+			Summary:       "instances: 3",
+		}))
+	})
+
+	It("passes the request body on to the handler", func() {
+		expectedBody, err := proto.Marshal(requestBody.(proto.Message))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(servedBody).To(Equal(expectedBody))
+	})
+
+	Context("when the request fails", func() {
+		BeforeEach(func() {
+			response = &models.DesiredLRPLifecycleResponse{Error: models.ErrResourceExists}
+		})
+
+		It("records the error", func() {
+			Expect(recordedEvent().Error).To(Equal(models.ErrResourceExists))
+		})
+	})
+
+	Context("when the request is rejected with an error status", func() {
+		BeforeEach(func() {
+			innerHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusForbidden)
+			})
+		})
+
+		It("records the status as the error", func() {
+			Expect(recordedEvent().Error).To(Equal(models.NewError(models.Error_UnknownError, "Forbidden")))
+		})
+	})
+
+	Context("when the request names an existing DesiredLRP", func() {
+		BeforeEach(func() {
+			route = bbs.RemoveDesiredLRPRoute
+			requestBody = &models.RemoveDesiredLRPRequest{ProcessGuid: "process-guid"}
+			fakeDesiredLRPDB.DesiredLRPSchedulingInfosReturns([]*models.DesiredLRPSchedulingInfo{
+				{DesiredLRPKey: models.NewDesiredLRPKey("process-guid", "cf-apps", "log-guid")},
+			}, nil)
+		})
+
+		It("looks up the domain of the DesiredLRP", func() {
+			Expect(fakeDesiredLRPDB.DesiredLRPSchedulingInfosCallCount()).To(Equal(1))
+			_, filter := fakeDesiredLRPDB.DesiredLRPSchedulingInfosArgsForCall(0)
+			Expect(filter.ProcessGuids).To(ConsistOf("process-guid"))
+
+			event := recordedEvent()
+			Expect(event.Guid).To(Equal("process-guid"))
+			Expect(event.Domain).To(Equal("cf-apps"))
+		})
+	})
+
+	Context("when the request names a task", func() {
+		BeforeEach(func() {
+			route = bbs.CancelTaskRoute
+			requestBody = &models.TaskGuidRequest{TaskGuid: "task-guid"}
+		})
+
+		Context("that exists", func() {
+			BeforeEach(func() {
+				fakeTaskDB.TaskByGuidReturns(&models.Task{TaskGuid: "task-guid", Domain: "cf-tasks"}, nil)
+			})
+
+			It("looks up the domain of the task", func() {
+				event := recordedEvent()
+				Expect(event.Guid).To(Equal("task-guid"))
+				Expect(event.Domain).To(Equal("cf-tasks"))
+			})
+		})
+
+		Context("that does not exist", func() {
+			BeforeEach(func() {
+				fakeTaskDB.TaskByGuidReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("records the request without a domain", func() {
+				event := recordedEvent()
+				Expect(event.Guid).To(Equal("task-guid"))
+				Expect(event.Domain).To(BeEmpty())
+			})
+		})
+	})
+
+	Context("when the request updates a DesiredLRP", func() {
+		BeforeEach(func() {
+			instances := int32(5)
+			route = bbs.UpdateDesiredLRPRoute
+			requestBody = &models.UpdateDesiredLRPRequest{
+				ProcessGuid: "process-guid",
+				Update:      &models.DesiredLRPUpdate{Instances: &instances},
+			}
+		})
+
+		It("summarizes the update", func() {
+			Expect(recordedEvent().Summary).To(Equal("instances: 5"))
+		})
+	})
+
+	Context("when the request desires several tasks", func() {
+		BeforeEach(func() {
+			route = bbs.DesireTasksRoute
+			requestBody = &models.DesireTasksRequest{Tasks: []*models.DesireTaskRequest{
+				{TaskGuid: "task-1", Domain: "cf-tasks"},
+				{TaskGuid: "task-2", Domain: "other-domain"},
+			}}
+		})
+
+		It("lists the tasks, without a domain", func() {
+			event := recordedEvent()
+			Expect(event.Domain).To(BeEmpty())
+			Expect(event.Summary).To(Equal("task_guids: task-1, task-2"))
+		})
+	})
+
+	Context("when the request restores a quarantined record", func() {
+		BeforeEach(func() {
+			route = bbs.RestoreQuarantinedRecordRoute
+			requestBody = &models.RestoreQuarantinedRecordRequest{Id: 7}
+		})
+
+		It("records the id of the record", func() {
+			event := recordedEvent()
+			Expect(event.Guid).To(BeEmpty())
+			Expect(event.Summary).To(Equal("quarantined_record_id: 7"))
+		})
+	})
+
+	Context("when the body cannot be decoded", func() {
+		BeforeEach(func() {
+			requestBody = "garbage"
+		})
+
+		It("still records the request", func() {
+			event := recordedEvent()
+			Expect(event.Route).To(Equal(bbs.DesireDesiredLRPRoute))
+			Expect(event.Guid).To(BeEmpty())
+		})
+	})
+
+	Context("when recording the event fails", func() {
+		BeforeEach(func() {
+			fakeAuditDB.RecordAuditEventReturns(errors.New("boom"))
+		})
+
+		It("logs the failure", func() {
+			Expect(logger).To(gbytes.Say("failed-to-record-audit-event"))
+		})
+	})
+
+	Context("when the route is not audited", func() {
+		BeforeEach(func() {
+			route = bbs.DesiredLRPsRoute
+			requestBody = &models.DesiredLRPsRequest{}
+		})
+
+		It("does not record the request", func() {
+			Expect(fakeAuditDB.RecordAuditEventCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -7,44 +7,68 @@ import (
 	"code.cloudfoundry.org/bbs"
 	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
 	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/tracedclients"
+	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
-	"code.cloudfoundry.org/rep"
 	"code.cloudfoundry.org/workpool"
 )
 
+//go:generate counterfeiter -o fake_controllers/fake_desired_lrp_rollout_controller.go . DesiredLRPRolloutController
+type DesiredLRPRolloutController interface {
+	StartRollout(logger lager.Logger, desiredLRP *models.DesiredLRP, maxInFlight int32) error
+	FailRollout(logger lager.Logger, processGuid, reason string)
+	CancelRollout(logger lager.Logger, processGuid string)
+}
+
 type DesiredLRPHandler struct {
 	desiredLRPDB       db.DesiredLRPDB
 	actualLRPDB        db.ActualLRPDB
+	revisionDB         db.DesiredLRPRevisionDB
+	rolloutDB          db.DesiredLRPRolloutDB
 	desiredHub         events.Hub
 	actualHub          events.Hub
 	auctioneerClient   auctioneer.Client
-	repClientFactory   rep.ClientFactory
+	repClientFactory   tracedclients.RepClientFactory
 	serviceClient      bbs.ServiceClient
+	rolloutController  DesiredLRPRolloutController
+	clock              clock.Clock
 	updateWorkersCount int
+	maxBulkItems       int
 	exitChan           chan<- struct{}
 }
 
 func NewDesiredLRPHandler(
 	updateWorkersCount int,
+	maxBulkItems int,
 	desiredLRPDB db.DesiredLRPDB,
 	actualLRPDB db.ActualLRPDB,
+	revisionDB db.DesiredLRPRevisionDB,
+	rolloutDB db.DesiredLRPRolloutDB,
 	desiredHub events.Hub,
 	actualHub events.Hub,
 	auctioneerClient auctioneer.Client,
-	repClientFactory rep.ClientFactory,
+	repClientFactory tracedclients.RepClientFactory,
 	serviceClient bbs.ServiceClient,
+	rolloutController DesiredLRPRolloutController,
+	clock clock.Clock,
 	exitChan chan<- struct{},
 ) *DesiredLRPHandler {
 	return &DesiredLRPHandler{
 		desiredLRPDB:       desiredLRPDB,
 		actualLRPDB:        actualLRPDB,
+		revisionDB:         revisionDB,
+		rolloutDB:          rolloutDB,
 		desiredHub:         desiredHub,
 		actualHub:          actualHub,
 		auctioneerClient:   auctioneerClient,
 		repClientFactory:   repClientFactory,
 		serviceClient:      serviceClient,
+		rolloutController:  rolloutController,
+		clock:              clock,
 		updateWorkersCount: updateWorkersCount,
+		maxBulkItems:       maxBulkItems,
 		exitChan:           exitChan,
 	}
 }
@@ -58,12 +82,13 @@ func (h *DesiredLRPHandler) DesiredLRPs(logger lager.Logger, w http.ResponseWrit
 
 	err = parseRequest(logger, req, request)
 	if err == nil {
-		filter := models.DesiredLRPFilter{Domain: request.Domain, ProcessGuids: request.ProcessGuids}
-		response.DesiredLrps, err = h.desiredLRPDB.DesiredLRPs(logger, filter)
+		filter := request.Filter()
+		filter.AllowedDomains = middleware.DomainScopeFromRequest(req).Domains()
+		response.DesiredLrps, response.NextPageToken, err = h.desiredLRPDB.DesiredLRPsPage(logger, filter)
 	}
 
 	response.Error = models.ConvertError(err)
-	writeResponse(w, response)
+	writeResponse(w, req, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
 
@@ -78,9 +103,15 @@ func (h *DesiredLRPHandler) DesiredLRPByProcessGuid(logger lager.Logger, w http.
 	if err == nil {
 		response.DesiredLrp, err = h.desiredLRPDB.DesiredLRPByProcessGuid(logger, request.ProcessGuid)
 	}
+	if err == nil {
+		err = authorizeDomain(req, response.DesiredLrp.Domain)
+		if err != nil {
+			response.DesiredLrp = nil
+		}
+	}
 
 	response.Error = models.ConvertError(err)
-	writeResponse(w, response)
+	writeResponse(w, req, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
 
@@ -98,10 +129,11 @@ func (h *DesiredLRPHandler) DesiredLRPSchedulingInfos(logger lager.Logger, w htt
 			ProcessGuids: request.ProcessGuids,
 		}
 		response.DesiredLrpSchedulingInfos, err = h.desiredLRPDB.DesiredLRPSchedulingInfos(logger, filter)
+		response.DesiredLrpSchedulingInfos = scopeSchedulingInfos(middleware.DomainScopeFromRequest(req), response.DesiredLrpSchedulingInfos)
 	}
 
 	response.Error = models.ConvertError(err)
-	writeResponse(w, response)
+	writeResponse(w, req, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
 
@@ -111,7 +143,7 @@ func (h *DesiredLRPHandler) DesireDesiredLRP(logger lager.Logger, w http.Respons
 	request := &models.DesireLRPRequest{}
 	response := &models.DesiredLRPLifecycleResponse{}
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err := parseRequest(logger, req, request)
 	if err != nil {
@@ -119,22 +151,78 @@ func (h *DesiredLRPHandler) DesireDesiredLRP(logger lager.Logger, w http.Respons
 		return
 	}
 
-	err = h.desiredLRPDB.DesireLRP(logger, request.DesiredLrp)
+	err = authorizeDomain(req, request.DesiredLrp.Domain)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.desireLRP(logger, request.DesiredLrp)
+	response.Error = models.ConvertError(err)
+}
+
+// DesireDesiredLRPs desires each of the DesiredLRPs in the request, at most
+// updateWorkersCount at a time, and reports the outcome for each of them.
+func (h *DesiredLRPHandler) DesireDesiredLRPs(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("desire-lrps")
+
+	request := &models.DesireLRPsRequest{}
+	response := &models.DesiredLRPsLifecycleResponse{}
+	defer func() { h.exitIfAnyUnrecoverable(logger, response) }()
+	defer writeResponse(w, req, response)
+
+	err := parseRequest(logger, req, request)
 	if err != nil {
 		response.Error = models.ConvertError(err)
 		return
 	}
 
-	desiredLRP, err := h.desiredLRPDB.DesiredLRPByProcessGuid(logger, request.DesiredLrp.ProcessGuid)
+	results := make([]*models.DesiredLRPLifecycleResult, len(request.DesiredLrps))
+	err = workBulk(logger, h.updateWorkersCount, h.maxBulkItems, len(request.DesiredLrps), func(i int) {
+		desiredLRP := request.DesiredLrps[i]
+
+		itemRequest := &models.DesireLRPRequest{DesiredLrp: desiredLRP}
+		err := itemRequest.Validate()
+		if err != nil {
+			err = models.NewError(models.Error_InvalidRequest, err.Error())
+		} else {
+			err = authorizeDomain(req, desiredLRP.Domain)
+		}
+		if err == nil {
+			err = h.desireLRP(logger, desiredLRP)
+		}
+
+		results[i] = &models.DesiredLRPLifecycleResult{
+			ProcessGuid: desiredLRP.GetProcessGuid(),
+			Error:       models.ConvertError(err),
+		}
+	})
 	if err != nil {
 		response.Error = models.ConvertError(err)
 		return
 	}
 
-	go h.desiredHub.Emit(models.NewDesiredLRPCreatedEvent(desiredLRP))
+	response.Results = results
+}
+
+func (h *DesiredLRPHandler) desireLRP(logger lager.Logger, desiredLRP *models.DesiredLRP) error {
+	logger = logger.WithData(lager.Data{"process_guid": desiredLRP.ProcessGuid})
+
+	err := h.desiredLRPDB.DesireLRP(logger, desiredLRP)
+	if err != nil {
+		return err
+	}
+
+	createdLRP, err := h.desiredLRPDB.DesiredLRPByProcessGuid(logger, desiredLRP.ProcessGuid)
+	if err != nil {
+		return err
+	}
+
+	go h.desiredHub.Emit(models.NewDesiredLRPCreatedEvent(createdLRP))
 
-	schedulingInfo := request.DesiredLrp.DesiredLRPSchedulingInfo()
+	schedulingInfo := desiredLRP.DesiredLRPSchedulingInfo()
 	h.startInstanceRange(logger, 0, schedulingInfo.Instances, &schedulingInfo)
+	return nil
 }
 
 func (h *DesiredLRPHandler) UpdateDesiredLRP(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
@@ -143,7 +231,7 @@ func (h *DesiredLRPHandler) UpdateDesiredLRP(logger lager.Logger, w http.Respons
 	request := &models.UpdateDesiredLRPRequest{}
 	response := &models.DesiredLRPLifecycleResponse{}
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err := parseRequest(logger, req, request)
 	if err != nil {
@@ -154,42 +242,55 @@ func (h *DesiredLRPHandler) UpdateDesiredLRP(logger lager.Logger, w http.Respons
 
 	logger = logger.WithData(lager.Data{"guid": request.ProcessGuid})
 
-	logger.Debug("updating-desired-lrp")
-	beforeDesiredLRP, err := h.desiredLRPDB.UpdateDesiredLRP(logger, request.ProcessGuid, request.Update)
+	err = h.authorizeProcessGuid(logger, req, request.ProcessGuid)
 	if err != nil {
-		logger.Debug("failed-updating-desired-lrp")
 		response.Error = models.ConvertError(err)
 		return
 	}
+
+	err = h.updateDesiredLRP(logger, request.ProcessGuid, request.Update, request.ExpectedModificationTag)
+	response.Error = models.ConvertError(err)
+}
+
+// updateDesiredLRP applies the update to the DesiredLRP, then starts or stops
+// instances to follow its new instance count.
+func (h *DesiredLRPHandler) updateDesiredLRP(logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate, expectedTag *models.ModificationTag) error {
+	logger.Debug("updating-desired-lrp")
+	beforeDesiredLRP, err := h.desiredLRPDB.UpdateDesiredLRP(logger, processGuid, update, expectedTag)
+	if err != nil {
+		logger.Debug("failed-updating-desired-lrp")
+		return err
+	}
 	logger.Debug("completed-updating-desired-lrp")
 
-	desiredLRP, err := h.desiredLRPDB.DesiredLRPByProcessGuid(logger, request.ProcessGuid)
+	desiredLRP, err := h.desiredLRPDB.DesiredLRPByProcessGuid(logger, processGuid)
 	if err != nil {
 		logger.Error("failed-fetching-desired-lrp", err)
-		return
+		return nil
 	}
 
-	if request.Update.Instances != nil {
+	if update.Instances != nil {
 		logger.Debug("updating-lrp-instances")
 		previousInstanceCount := beforeDesiredLRP.Instances
 
-		requestedInstances := *request.Update.Instances - previousInstanceCount
+		requestedInstances := *update.Instances - previousInstanceCount
 
 		logger = logger.WithData(lager.Data{"instances_delta": requestedInstances})
 		if requestedInstances > 0 {
 			logger.Debug("increasing-the-instances")
 			schedulingInfo := desiredLRP.DesiredLRPSchedulingInfo()
-			h.startInstanceRange(logger, previousInstanceCount, *request.Update.Instances, &schedulingInfo)
+			h.startInstanceRange(logger, previousInstanceCount, *update.Instances, &schedulingInfo)
 		}
 
 		if requestedInstances < 0 {
 			logger.Debug("decreasing-the-instances")
 			numExtraActualLRP := previousInstanceCount + requestedInstances
-			h.stopInstancesFrom(logger, request.ProcessGuid, int(numExtraActualLRP))
+			h.stopInstancesFrom(logger, processGuid, int(numExtraActualLRP))
 		}
 	}
 
 	go h.desiredHub.Emit(models.NewDesiredLRPChangedEvent(beforeDesiredLRP, desiredLRP))
+	return nil
 }
 
 func (h *DesiredLRPHandler) RemoveDesiredLRP(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
@@ -198,30 +299,255 @@ func (h *DesiredLRPHandler) RemoveDesiredLRP(logger lager.Logger, w http.Respons
 	request := &models.RemoveDesiredLRPRequest{}
 	response := &models.DesiredLRPLifecycleResponse{}
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err := parseRequest(logger, req, request)
 	if err != nil {
 		response.Error = models.ConvertError(err)
 		return
 	}
-	logger = logger.WithData(lager.Data{"process_guid": request.ProcessGuid})
 
-	desiredLRP, err := h.desiredLRPDB.DesiredLRPByProcessGuid(logger.Session("fetch-desired"), request.ProcessGuid)
+	err = h.removeDesiredLRP(logger, req, request.ProcessGuid, request.ExpectedModificationTag)
+	response.Error = models.ConvertError(err)
+}
+
+// RemoveDesiredLRPs removes each of the DesiredLRPs in the request, at most
+// updateWorkersCount at a time, and reports the outcome for each of them.
+func (h *DesiredLRPHandler) RemoveDesiredLRPs(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("remove-desired-lrps")
+
+	request := &models.RemoveDesiredLRPsRequest{}
+	response := &models.DesiredLRPsLifecycleResponse{}
+	defer func() { h.exitIfAnyUnrecoverable(logger, response) }()
+	defer writeResponse(w, req, response)
+
+	err := parseRequest(logger, req, request)
 	if err != nil {
 		response.Error = models.ConvertError(err)
 		return
 	}
 
-	err = h.desiredLRPDB.RemoveDesiredLRP(logger.Session("remove-desired"), request.ProcessGuid)
+	results := make([]*models.DesiredLRPLifecycleResult, len(request.ProcessGuids))
+	err = workBulk(logger, h.updateWorkersCount, h.maxBulkItems, len(request.ProcessGuids), func(i int) {
+		processGuid := request.ProcessGuids[i]
+
+		itemRequest := &models.RemoveDesiredLRPRequest{ProcessGuid: processGuid}
+		err := itemRequest.Validate()
+		if err != nil {
+			err = models.NewError(models.Error_InvalidRequest, err.Error())
+		} else {
+			err = h.removeDesiredLRP(logger, req, processGuid, nil)
+		}
+
+		results[i] = &models.DesiredLRPLifecycleResult{
+			ProcessGuid: processGuid,
+			Error:       models.ConvertError(err),
+		}
+	})
 	if err != nil {
 		response.Error = models.ConvertError(err)
 		return
 	}
 
+	response.Results = results
+}
+
+func (h *DesiredLRPHandler) removeDesiredLRP(logger lager.Logger, req *http.Request, processGuid string, expectedTag *models.ModificationTag) error {
+	logger = logger.WithData(lager.Data{"process_guid": processGuid})
+
+	desiredLRP, err := h.desiredLRPDB.DesiredLRPByProcessGuid(logger.Session("fetch-desired"), processGuid)
+	if err != nil {
+		return err
+	}
+
+	err = authorizeDomain(req, desiredLRP.Domain)
+	if err != nil {
+		return err
+	}
+
+	err = h.desiredLRPDB.RemoveDesiredLRP(logger.Session("remove-desired"), processGuid, expectedTag)
+	if err != nil {
+		return err
+	}
+
 	go h.desiredHub.Emit(models.NewDesiredLRPRemovedEvent(desiredLRP))
 
-	h.stopInstancesFrom(logger, request.ProcessGuid, 0)
+	h.rolloutController.CancelRollout(logger, processGuid)
+	h.stopInstancesFrom(logger, processGuid, 0)
+	return nil
+}
+
+// authorizeProcessGuid returns ErrUnauthorized unless the client making req
+// may access the domain of the DesiredLRP.
+func (h *DesiredLRPHandler) authorizeProcessGuid(logger lager.Logger, req *http.Request, processGuid string) error {
+	if middleware.DomainScopeFromRequest(req) == nil {
+		return nil
+	}
+
+	schedulingInfos, err := h.desiredLRPDB.DesiredLRPSchedulingInfos(logger, models.DesiredLRPFilter{ProcessGuids: []string{processGuid}})
+	if err != nil {
+		return err
+	}
+	if len(schedulingInfos) == 0 {
+		return models.ErrResourceNotFound
+	}
+
+	return authorizeDomain(req, schedulingInfos[0].Domain)
+}
+
+func (h *DesiredLRPHandler) exitIfAnyUnrecoverable(logger lager.Logger, response *models.DesiredLRPsLifecycleResponse) {
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+	for _, result := range response.Results {
+		exitIfUnrecoverable(logger, h.exitChan, result.Error)
+	}
+}
+
+func (h *DesiredLRPHandler) RedeployDesiredLRP(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("redeploy-desired-lrp")
+
+	request := &models.RedeployDesiredLRPRequest{}
+	response := &models.DesiredLRPLifecycleResponse{}
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer writeResponse(w, req, response)
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+	logger = logger.WithData(lager.Data{"process_guid": request.ProcessGuid})
+
+	err = h.authorizeProcessGuid(logger, req, request.ProcessGuid)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.redeployDesiredLRP(logger, request.ProcessGuid, request.RunInfo, request.Resource, request.MaxInFlight)
+	response.Error = models.ConvertError(err)
+}
+
+// redeployDesiredLRP replaces the run definition and, if resource is non-nil,
+// the resources of the DesiredLRP, then starts rolling its instances onto
+// them at most maxInFlight at a time.
+func (h *DesiredLRPHandler) redeployDesiredLRP(logger lager.Logger, processGuid string, runInfo *models.DesiredLRPRunInfo, resource *models.DesiredLRPResource, maxInFlight int32) error {
+	beforeDesiredLRP, err := h.desiredLRPDB.UpdateDesiredLRPRunInfo(logger, processGuid, runInfo, resource)
+	if err != nil {
+		return err
+	}
+
+	desiredLRP, err := h.desiredLRPDB.DesiredLRPByProcessGuid(logger, processGuid)
+	if err != nil {
+		logger.Error("failed-fetching-desired-lrp", err)
+		h.rolloutController.FailRollout(logger, processGuid, "failed to fetch the redeployed desired lrp: "+err.Error())
+		return err
+	}
+
+	go h.desiredHub.Emit(models.NewDesiredLRPChangedEvent(beforeDesiredLRP, desiredLRP))
+
+	return h.rolloutController.StartRollout(logger, desiredLRP, maxInFlight)
+}
+
+// DesiredLRPRollout returns the progress of the last rollout of the
+// DesiredLRP.
+func (h *DesiredLRPHandler) DesiredLRPRollout(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("desired-lrp-rollout")
+
+	request := &models.DesiredLRPRolloutRequest{}
+	response := &models.DesiredLRPRolloutResponse{}
+
+	err = parseRequest(logger, req, request)
+	if err == nil {
+		err = h.authorizeProcessGuid(logger, req, request.ProcessGuid)
+	}
+	if err == nil {
+		response.Rollout, err = h.rolloutDB.DesiredLRPRollout(logger, request.ProcessGuid)
+	}
+
+	response.Error = models.ConvertError(err)
+	writeResponse(w, req, response)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+}
+
+// ApplyDesiredLRPs makes the DesiredLRPs of a domain match the complete set in
+// the request, then starts, stops and rolls instances to follow the changes.
+func (h *DesiredLRPHandler) ApplyDesiredLRPs(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("apply-desired-lrps")
+
+	request := &models.ApplyDesiredLRPsRequest{}
+	response := &models.ApplyDesiredLRPsResponse{}
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer writeResponse(w, req, response)
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+	logger = logger.WithData(lager.Data{"domain": request.Domain})
+
+	err = authorizeDomain(req, request.Domain)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	result, err := h.desiredLRPDB.ApplyDesiredLRPs(logger, request.Domain, request.DesiredLrps, request.Ttl)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	for _, desiredLRP := range result.Created {
+		response.Created = append(response.Created, desiredLRP.ProcessGuid)
+		go h.desiredHub.Emit(models.NewDesiredLRPCreatedEvent(desiredLRP))
+
+		schedulingInfo := desiredLRP.DesiredLRPSchedulingInfo()
+		h.startInstanceRange(logger, 0, schedulingInfo.Instances, &schedulingInfo)
+	}
+
+	for _, change := range result.Updated {
+		response.Updated = append(response.Updated, change.After.ProcessGuid)
+		go h.desiredHub.Emit(models.NewDesiredLRPChangedEvent(change.Before, change.After))
+
+		h.applyDesiredLRPChange(logger, change)
+	}
+
+	for _, desiredLRP := range result.Removed {
+		response.Removed = append(response.Removed, desiredLRP.ProcessGuid)
+		go h.desiredHub.Emit(models.NewDesiredLRPRemovedEvent(desiredLRP))
+
+		h.rolloutController.CancelRollout(logger, desiredLRP.ProcessGuid)
+		h.stopInstancesFrom(logger, desiredLRP.ProcessGuid, 0)
+	}
+}
+
+// applyDesiredLRPChange starts or stops instances when the instance count
+// changed, and rolls the existing instances when their run definition or
+// resources changed.
+func (h *DesiredLRPHandler) applyDesiredLRPChange(logger lager.Logger, change models.DesiredLRPChange) {
+	before, after := change.Before, change.After
+	logger = logger.WithData(lager.Data{"process_guid": after.ProcessGuid})
+
+	if after.Instances > before.Instances {
+		schedulingInfo := after.DesiredLRPSchedulingInfo()
+		h.startInstanceRange(logger, before.Instances, after.Instances, &schedulingInfo)
+	} else if after.Instances < before.Instances {
+		h.stopInstancesFrom(logger, after.ProcessGuid, int(after.Instances))
+	}
+
+	now := h.clock.Now()
+	beforeRunInfo, afterRunInfo := before.DesiredLRPRunInfo(now), after.DesiredLRPRunInfo(now)
+	beforeResource, afterResource := before.DesiredLRPResource(), after.DesiredLRPResource()
+	if beforeRunInfo.Equal(&afterRunInfo) && beforeResource.Equal(&afterResource) {
+		return
+	}
+
+	err := h.rolloutController.StartRollout(logger, after, 1)
+	if err != nil {
+		logger.Error("failed-starting-rollout", err)
+	}
 }
 
 func (h *DesiredLRPHandler) startInstanceRange(logger lager.Logger, lower, upper int32, schedulingInfo *models.DesiredLRPSchedulingInfo) {
@@ -309,23 +635,27 @@ func (h *DesiredLRPHandler) stopInstancesFrom(logger lager.Logger, processGuid s
 						logger.Error("failed-removing-lrp-instance", err)
 					}
 				default:
-					cellPresence, err := h.serviceClient.CellById(logger, lrp.CellId)
-					if err != nil {
-						logger.Error("failed-fetching-cell-presence", err)
-						continue
-					}
-					repClient, err := h.repClientFactory.CreateClient(cellPresence.RepAddress, cellPresence.RepUrl)
-					if err != nil {
-						logger.Error("create-rep-client-failed", err)
-						continue
-					}
-					logger.Debug("stopping-lrp-instance")
-					err = repClient.StopLRPInstance(lrp.ActualLRPKey, lrp.ActualLRPInstanceKey)
-					if err != nil {
-						logger.Error("failed-stopping-lrp-instance", err)
-					}
+					h.stopActualLRP(logger, lrp)
 				}
 			}
 		}
 	}
 }
+
+func (h *DesiredLRPHandler) stopActualLRP(logger lager.Logger, lrp *models.ActualLRP) {
+	cellPresence, err := h.serviceClient.CellById(logger, lrp.CellId)
+	if err != nil {
+		logger.Error("failed-fetching-cell-presence", err)
+		return
+	}
+	repClient, err := h.repClientFactory.CreateClient(logger, cellPresence.RepAddress, cellPresence.RepUrl)
+	if err != nil {
+		logger.Error("create-rep-client-failed", err)
+		return
+	}
+	logger.Debug("stopping-lrp-instance")
+	err = repClient.StopLRPInstance(lrp.ActualLRPKey, lrp.ActualLRPInstanceKey)
+	if err != nil {
+		logger.Error("failed-stopping-lrp-instance", err)
+	}
+}
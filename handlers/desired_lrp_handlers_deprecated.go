@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager"
 )
@@ -22,6 +23,7 @@ func (h *DesiredLRPHandler) DesiredLRPs_r0(logger lager.Logger, w http.ResponseW
 
 		filter := models.DesiredLRPFilter{Domain: request.Domain}
 		lrps, err = h.desiredLRPDB.DesiredLRPs(logger, filter)
+		lrps = scopeDesiredLRPs(middleware.DomainScopeFromRequest(req), lrps)
 		if err == nil {
 			for i := range lrps {
 				transformedLRP := lrps[i].VersionDownTo(format.V0)
@@ -32,7 +34,7 @@ func (h *DesiredLRPHandler) DesiredLRPs_r0(logger lager.Logger, w http.ResponseW
 
 	response.Error = models.ConvertError(err)
 
-	writeResponse(w, response)
+	writeResponse(w, req, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
 
@@ -49,6 +51,7 @@ func (h *DesiredLRPHandler) DesiredLRPs_r1(logger lager.Logger, w http.ResponseW
 
 		filter := models.DesiredLRPFilter{Domain: request.Domain}
 		lrps, err = h.desiredLRPDB.DesiredLRPs(logger, filter)
+		lrps = scopeDesiredLRPs(middleware.DomainScopeFromRequest(req), lrps)
 		if err == nil {
 			for i := range lrps {
 				transformedLRP := lrps[i].VersionDownTo(format.V1)
@@ -59,7 +62,7 @@ func (h *DesiredLRPHandler) DesiredLRPs_r1(logger lager.Logger, w http.ResponseW
 
 	response.Error = models.ConvertError(err)
 
-	writeResponse(w, response)
+	writeResponse(w, req, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
 
@@ -74,6 +77,9 @@ func (h *DesiredLRPHandler) DesiredLRPByProcessGuid_r0(logger lager.Logger, w ht
 	if err == nil {
 		var lrp *models.DesiredLRP
 		lrp, err = h.desiredLRPDB.DesiredLRPByProcessGuid(logger, request.ProcessGuid)
+		if err == nil {
+			err = authorizeDomain(req, lrp.Domain)
+		}
 		if err == nil {
 			transformedLRP := lrp.VersionDownTo(format.V0)
 			response.DesiredLrp = transformedLRP
@@ -82,7 +88,7 @@ func (h *DesiredLRPHandler) DesiredLRPByProcessGuid_r0(logger lager.Logger, w ht
 
 	response.Error = models.ConvertError(err)
 
-	writeResponse(w, response)
+	writeResponse(w, req, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
 
@@ -97,6 +103,9 @@ func (h *DesiredLRPHandler) DesiredLRPByProcessGuid_r1(logger lager.Logger, w ht
 	if err == nil {
 		var lrp *models.DesiredLRP
 		lrp, err = h.desiredLRPDB.DesiredLRPByProcessGuid(logger, request.ProcessGuid)
+		if err == nil {
+			err = authorizeDomain(req, lrp.Domain)
+		}
 		if err == nil {
 			transformedLRP := lrp.VersionDownTo(format.V1)
 			response.DesiredLrp = transformedLRP
@@ -105,7 +114,7 @@ func (h *DesiredLRPHandler) DesiredLRPByProcessGuid_r1(logger lager.Logger, w ht
 
 	response.Error = models.ConvertError(err)
 
-	writeResponse(w, response)
+	writeResponse(w, req, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
 
@@ -115,7 +124,7 @@ func (h *DesiredLRPHandler) DesireDesiredLRP_r1(logger lager.Logger, w http.Resp
 	request := &models.DesireLRPRequest{}
 	response := &models.DesiredLRPLifecycleResponse{}
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err := parseRequestForDesireDesiredLRP_r1(logger, req, request)
 	if err != nil {
@@ -123,6 +132,12 @@ func (h *DesiredLRPHandler) DesireDesiredLRP_r1(logger lager.Logger, w http.Resp
 		return
 	}
 
+	err = authorizeDomain(req, request.DesiredLrp.Domain)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
 	err = h.desiredLRPDB.DesireLRP(logger, request.DesiredLrp)
 	if err != nil {
 		response.Error = models.ConvertError(err)
@@ -172,7 +187,7 @@ func (h *DesiredLRPHandler) DesireDesiredLRP_r0(logger lager.Logger, w http.Resp
 	request := &models.DesireLRPRequest{}
 	response := &models.DesiredLRPLifecycleResponse{}
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err := parseRequestForDesireDesiredLRP_r0(logger, req, request)
 	if err != nil {
@@ -180,6 +195,12 @@ func (h *DesiredLRPHandler) DesireDesiredLRP_r0(logger lager.Logger, w http.Resp
 		return
 	}
 
+	err = authorizeDomain(req, request.DesiredLrp.Domain)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
 	err = h.desiredLRPDB.DesireLRP(logger, request.DesiredLrp)
 	if err != nil {
 		response.Error = models.ConvertError(err)
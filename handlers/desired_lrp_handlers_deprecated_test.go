@@ -15,6 +15,7 @@ import (
 	"code.cloudfoundry.org/bbs/handlers"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"code.cloudfoundry.org/clock/fakeclock"
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
 	"code.cloudfoundry.org/rep"
@@ -48,12 +49,14 @@ var _ = Describe("DesiredLRP Handlers", func() {
 		desiredHub = new(eventfakes.FakeHub)
 		actualHub = new(eventfakes.FakeHub)
 		exitCh = make(chan struct{}, 1)
-		handler = handlers.NewDesiredLRPHandler(5, fakeDesiredLRPDB,
+		handler = handlers.NewDesiredLRPHandler(5, 10, fakeDesiredLRPDB,
 			fakeActualLRPDB,
+			new(dbfakes.FakeDesiredLRPRevisionDB),
+			new(dbfakes.FakeDesiredLRPRolloutDB),
 			desiredHub,
 			actualHub,
 			fakeAuctioneerClient,
-			nil, nil, exitCh)
+			nil, nil, nil, fakeclock.NewFakeClock(time.Unix(1000, 0)), exitCh)
 	})
 
 	Describe("DesiredLRPs_r0", func() {
@@ -2,16 +2,21 @@ package handlers_test
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"time"
 
 	"code.cloudfoundry.org/auctioneer"
 	"code.cloudfoundry.org/auctioneer/auctioneerfakes"
 	"code.cloudfoundry.org/bbs/db/dbfakes"
 	"code.cloudfoundry.org/bbs/events/eventfakes"
 	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/fake_controllers"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"code.cloudfoundry.org/clock/fakeclock"
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
 	"code.cloudfoundry.org/rep"
@@ -22,12 +27,16 @@ import (
 
 var _ = Describe("DesiredLRP Handlers", func() {
 	var (
-		logger               *lagertest.TestLogger
-		fakeDesiredLRPDB     *dbfakes.FakeDesiredLRPDB
-		fakeActualLRPDB      *dbfakes.FakeActualLRPDB
-		fakeAuctioneerClient *auctioneerfakes.FakeClient
-		desiredHub           *eventfakes.FakeHub
-		actualHub            *eventfakes.FakeHub
+		logger                *lagertest.TestLogger
+		fakeDesiredLRPDB      *dbfakes.FakeDesiredLRPDB
+		fakeActualLRPDB       *dbfakes.FakeActualLRPDB
+		fakeRevisionDB        *dbfakes.FakeDesiredLRPRevisionDB
+		fakeRolloutDB         *dbfakes.FakeDesiredLRPRolloutDB
+		fakeRolloutController *fake_controllers.FakeDesiredLRPRolloutController
+		fakeAuctioneerClient  *auctioneerfakes.FakeClient
+		desiredHub            *eventfakes.FakeHub
+		actualHub             *eventfakes.FakeHub
+		fakeClock             *fakeclock.FakeClock
 
 		responseRecorder *httptest.ResponseRecorder
 		handler          *handlers.DesiredLRPHandler
@@ -41,38 +50,51 @@ var _ = Describe("DesiredLRP Handlers", func() {
 		var err error
 		fakeDesiredLRPDB = new(dbfakes.FakeDesiredLRPDB)
 		fakeActualLRPDB = new(dbfakes.FakeActualLRPDB)
+		fakeRevisionDB = new(dbfakes.FakeDesiredLRPRevisionDB)
+		fakeRolloutDB = new(dbfakes.FakeDesiredLRPRolloutDB)
+		fakeRolloutController = new(fake_controllers.FakeDesiredLRPRolloutController)
 		fakeAuctioneerClient = new(auctioneerfakes.FakeClient)
 		logger = lagertest.NewTestLogger("test")
 		logger.RegisterSink(lager.NewWriterSink(GinkgoWriter, lager.DEBUG))
 		responseRecorder = httptest.NewRecorder()
 		desiredHub = new(eventfakes.FakeHub)
 		actualHub = new(eventfakes.FakeHub)
+		fakeClock = fakeclock.NewFakeClock(time.Unix(1000, 0))
 		Expect(err).NotTo(HaveOccurred())
 		exitCh = make(chan struct{}, 1)
 		handler = handlers.NewDesiredLRPHandler(
 			5,
+			10,
 			fakeDesiredLRPDB,
 			fakeActualLRPDB,
+			fakeRevisionDB,
+			fakeRolloutDB,
 			desiredHub,
 			actualHub,
 			fakeAuctioneerClient,
 			fakeRepClientFactory,
 			fakeServiceClient,
+			fakeRolloutController,
+			fakeClock,
 			exitCh,
 		)
 	})
 
 	Describe("DesiredLRPs", func() {
-		var requestBody interface{}
+		var (
+			requestBody interface{}
+			scope       middleware.DomainScope
+		)
 
 		BeforeEach(func() {
 			requestBody = &models.DesiredLRPsRequest{}
+			scope = nil
 			desiredLRP1 = models.DesiredLRP{}
 			desiredLRP2 = models.DesiredLRP{}
 		})
 
 		JustBeforeEach(func() {
-			request := newTestRequest(requestBody)
+			request := middleware.WithDomainScope(newTestRequest(requestBody), scope)
 			handler.DesiredLRPs(logger, responseRecorder, request)
 		})
 
@@ -81,7 +103,7 @@ var _ = Describe("DesiredLRP Handlers", func() {
 
 			BeforeEach(func() {
 				desiredLRPs = []*models.DesiredLRP{&desiredLRP1, &desiredLRP2}
-				fakeDesiredLRPDB.DesiredLRPsReturns(desiredLRPs, nil)
+				fakeDesiredLRPDB.DesiredLRPsPageReturns(desiredLRPs, "", nil)
 			})
 
 			It("returns a list of desired lrp groups", func() {
@@ -96,8 +118,8 @@ var _ = Describe("DesiredLRP Handlers", func() {
 
 			Context("and no filter is provided", func() {
 				It("call the DB with no filters to retrieve the desired lrps", func() {
-					Expect(fakeDesiredLRPDB.DesiredLRPsCallCount()).To(Equal(1))
-					_, filter := fakeDesiredLRPDB.DesiredLRPsArgsForCall(0)
+					Expect(fakeDesiredLRPDB.DesiredLRPsPageCallCount()).To(Equal(1))
+					_, filter := fakeDesiredLRPDB.DesiredLRPsPageArgsForCall(0)
 					Expect(filter).To(Equal(models.DesiredLRPFilter{}))
 				})
 			})
@@ -108,8 +130,8 @@ var _ = Describe("DesiredLRP Handlers", func() {
 				})
 
 				It("call the DB with the domain filter to retrieve the desired lrps", func() {
-					Expect(fakeDesiredLRPDB.DesiredLRPsCallCount()).To(Equal(1))
-					_, filter := fakeDesiredLRPDB.DesiredLRPsArgsForCall(0)
+					Expect(fakeDesiredLRPDB.DesiredLRPsPageCallCount()).To(Equal(1))
+					_, filter := fakeDesiredLRPDB.DesiredLRPsPageArgsForCall(0)
 					Expect(filter.Domain).To(Equal("domain-1"))
 				})
 			})
@@ -120,16 +142,55 @@ var _ = Describe("DesiredLRP Handlers", func() {
 				})
 
 				It("call the DB with the process guid filter to retrieve the desired lrps", func() {
-					Expect(fakeDesiredLRPDB.DesiredLRPsCallCount()).To(Equal(1))
-					_, filter := fakeDesiredLRPDB.DesiredLRPsArgsForCall(0)
+					Expect(fakeDesiredLRPDB.DesiredLRPsPageCallCount()).To(Equal(1))
+					_, filter := fakeDesiredLRPDB.DesiredLRPsPageArgsForCall(0)
 					Expect(filter.ProcessGuids).To(Equal([]string{"g1", "g2"}))
 				})
 			})
+
+			Context("and the client is restricted to some domains", func() {
+				BeforeEach(func() {
+					scope = middleware.NewDomainScope("domain-2", "domain-1")
+				})
+
+				It("call the DB restricted to those domains to retrieve the desired lrps", func() {
+					Expect(fakeDesiredLRPDB.DesiredLRPsPageCallCount()).To(Equal(1))
+					_, filter := fakeDesiredLRPDB.DesiredLRPsPageArgsForCall(0)
+					Expect(filter.AllowedDomains).To(Equal([]string{"domain-1", "domain-2"}))
+				})
+			})
+
+			Context("and paging", func() {
+				BeforeEach(func() {
+					desiredLRP1.ProcessGuid = "process-guid-1"
+					desiredLRP2.ProcessGuid = "process-guid-2"
+					pageToken := models.DesiredLRPFilter{PageSize: 1}.NextPageToken("process-guid-0")
+					requestBody = &models.DesiredLRPsRequest{PageSize: 2, PageToken: pageToken}
+					fakeDesiredLRPDB.DesiredLRPsPageReturns(desiredLRPs, models.DesiredLRPFilter{PageSize: 2}.NextPageToken("process-guid-2"), nil)
+				})
+
+				It("call the DB with the page to retrieve the desired lrps", func() {
+					Expect(fakeDesiredLRPDB.DesiredLRPsPageCallCount()).To(Equal(1))
+					_, filter := fakeDesiredLRPDB.DesiredLRPsPageArgsForCall(0)
+					Expect(filter.PageSize).To(Equal(2))
+					Expect(filter.AfterProcessGuid).To(Equal("process-guid-0"))
+				})
+
+				It("returns the token for the next page", func() {
+					response := models.DesiredLRPsResponse{}
+					err := response.Unmarshal(responseRecorder.Body.Bytes())
+					Expect(err).NotTo(HaveOccurred())
+
+					nextRequest := models.DesiredLRPsRequest{PageSize: 2, PageToken: response.NextPageToken}
+					Expect(nextRequest.Validate()).To(Succeed())
+					Expect(nextRequest.Filter().AfterProcessGuid).To(Equal("process-guid-2"))
+				})
+			})
 		})
 
 		Context("when the DB returns no desired lrp groups", func() {
 			BeforeEach(func() {
-				fakeDesiredLRPDB.DesiredLRPsReturns([]*models.DesiredLRP{}, nil)
+				fakeDesiredLRPDB.DesiredLRPsPageReturns([]*models.DesiredLRP{}, "", nil)
 			})
 
 			It("returns an empty list", func() {
@@ -145,7 +206,7 @@ var _ = Describe("DesiredLRP Handlers", func() {
 
 		Context("when the DB returns an unrecoverable error", func() {
 			BeforeEach(func() {
-				fakeDesiredLRPDB.DesiredLRPsReturns([]*models.DesiredLRP{}, models.NewUnrecoverableError(nil))
+				fakeDesiredLRPDB.DesiredLRPsPageReturns([]*models.DesiredLRP{}, "", models.NewUnrecoverableError(nil))
 			})
 
 			It("logs and writes to the exit channel", func() {
@@ -156,7 +217,7 @@ var _ = Describe("DesiredLRP Handlers", func() {
 
 		Context("when the DB errors out", func() {
 			BeforeEach(func() {
-				fakeDesiredLRPDB.DesiredLRPsReturns([]*models.DesiredLRP{}, models.ErrUnknownError)
+				fakeDesiredLRPDB.DesiredLRPsPageReturns([]*models.DesiredLRP{}, "", models.ErrUnknownError)
 			})
 
 			It("provides relevant error information", func() {
@@ -370,6 +431,7 @@ var _ = Describe("DesiredLRP Handlers", func() {
 			desiredLRP *models.DesiredLRP
 
 			requestBody interface{}
+			scope       middleware.DomainScope
 		)
 
 		BeforeEach(func() {
@@ -378,13 +440,30 @@ var _ = Describe("DesiredLRP Handlers", func() {
 			requestBody = &models.DesireLRPRequest{
 				DesiredLrp: desiredLRP,
 			}
+			scope = nil
 		})
 
 		JustBeforeEach(func() {
-			request := newTestRequest(requestBody)
+			request := middleware.WithDomainScope(newTestRequest(requestBody), scope)
 			handler.DesireDesiredLRP(logger, responseRecorder, request)
 		})
 
+		Context("when the client is restricted to other domains", func() {
+			BeforeEach(func() {
+				scope = middleware.NewDomainScope("other-domain")
+			})
+
+			It("does not desire the lrp", func() {
+				Expect(fakeDesiredLRPDB.DesireLRPCallCount()).To(Equal(0))
+
+				response := models.DesiredLRPLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrUnauthorized))
+			})
+		})
+
 		Context("when creating desired lrp in DB succeeds", func() {
 			var createdActualLRPGroups []*models.ActualLRPGroup
 
@@ -558,9 +637,10 @@ var _ = Describe("DesiredLRP Handlers", func() {
 
 			It("updates the desired lrp", func() {
 				Expect(fakeDesiredLRPDB.UpdateDesiredLRPCallCount()).To(Equal(1))
-				_, actualProcessGuid, actualUpdate := fakeDesiredLRPDB.UpdateDesiredLRPArgsForCall(0)
+				_, actualProcessGuid, actualUpdate, actualTag := fakeDesiredLRPDB.UpdateDesiredLRPArgsForCall(0)
 				Expect(actualProcessGuid).To(Equal(processGuid))
 				Expect(actualUpdate).To(Equal(update))
+				Expect(actualTag).To(BeNil())
 
 				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
 				response := models.DesiredLRPLifecycleResponse{}
@@ -569,6 +649,25 @@ var _ = Describe("DesiredLRP Handlers", func() {
 				Expect(response.Error).To(BeNil())
 			})
 
+			Context("when an expected modification tag is given", func() {
+				var expectedTag models.ModificationTag
+
+				BeforeEach(func() {
+					expectedTag = models.NewModificationTag("some-epoch", 2)
+					requestBody = &models.UpdateDesiredLRPRequest{
+						ProcessGuid:             processGuid,
+						Update:                  update,
+						ExpectedModificationTag: &expectedTag,
+					}
+				})
+
+				It("passes the tag to the DB", func() {
+					Expect(fakeDesiredLRPDB.UpdateDesiredLRPCallCount()).To(Equal(1))
+					_, _, _, actualTag := fakeDesiredLRPDB.UpdateDesiredLRPArgsForCall(0)
+					Expect(actualTag).To(Equal(&expectedTag))
+				})
+			})
+
 			It("emits a create event to the hub", func(done Done) {
 				Eventually(desiredHub.EmitCallCount).Should(Equal(1))
 				event := desiredHub.EmitArgsForCall(0)
@@ -622,10 +721,10 @@ var _ = Describe("DesiredLRP Handlers", func() {
 
 						Expect(fakeServiceClient.CellByIdCallCount()).To(Equal(2))
 						Expect(fakeRepClientFactory.CreateClientCallCount()).To(Equal(2))
-						repAddr, repURL := fakeRepClientFactory.CreateClientArgsForCall(0)
+						_, repAddr, repURL := fakeRepClientFactory.CreateClientArgsForCall(0)
 						Expect(repAddr).To(Equal("some-address"))
 						Expect(repURL).To(Equal("http://some-address"))
-						repAddr, repURL = fakeRepClientFactory.CreateClientArgsForCall(1)
+						_, repAddr, repURL = fakeRepClientFactory.CreateClientArgsForCall(1)
 						Expect(repAddr).To(Equal("some-address"))
 						Expect(repURL).To(Equal("http://some-address"))
 
@@ -645,7 +744,7 @@ var _ = Describe("DesiredLRP Handlers", func() {
 						})
 
 						It("creates a rep client using the rep url", func() {
-							repAddr, repURL := fakeRepClientFactory.CreateClientArgsForCall(0)
+							_, repAddr, repURL := fakeRepClientFactory.CreateClientArgsForCall(0)
 							Expect(repAddr).To(Equal("some-address"))
 							Expect(repURL).To(Equal("http://some-address"))
 						})
@@ -821,6 +920,25 @@ var _ = Describe("DesiredLRP Handlers", func() {
 				Expect(response.Error).To(Equal(models.ErrUnknownError))
 			})
 		})
+
+		Context("when the expected modification tag is stale", func() {
+			BeforeEach(func() {
+				fakeDesiredLRPDB.UpdateDesiredLRPReturns(nil, models.ErrResourceConflict)
+			})
+
+			It("responds with a resource conflict error", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				response := models.DesiredLRPLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrResourceConflict))
+			})
+
+			It("does not emit a change event", func() {
+				Consistently(desiredHub.EmitCallCount).Should(Equal(0))
+			})
+		})
 	})
 
 	Describe("RemoveDesiredLRP", func() {
@@ -828,6 +946,7 @@ var _ = Describe("DesiredLRP Handlers", func() {
 			processGuid string
 
 			requestBody interface{}
+			scope       middleware.DomainScope
 		)
 
 		BeforeEach(func() {
@@ -835,14 +954,33 @@ var _ = Describe("DesiredLRP Handlers", func() {
 			requestBody = &models.RemoveDesiredLRPRequest{
 				ProcessGuid: processGuid,
 			}
+			scope = nil
+			fakeDesiredLRPDB.DesiredLRPByProcessGuidReturns(model_helpers.NewValidDesiredLRP(processGuid), nil)
 			fakeServiceClient.CellByIdReturns(&models.CellPresence{RepAddress: "some-address"}, nil)
 		})
 
 		JustBeforeEach(func() {
-			request := newTestRequest(requestBody)
+			request := middleware.WithDomainScope(newTestRequest(requestBody), scope)
 			handler.RemoveDesiredLRP(logger, responseRecorder, request)
 		})
 
+		Context("when the client is restricted to other domains", func() {
+			BeforeEach(func() {
+				fakeDesiredLRPDB.DesiredLRPByProcessGuidReturns(model_helpers.NewValidDesiredLRP(processGuid), nil)
+				scope = middleware.NewDomainScope("other-domain")
+			})
+
+			It("does not remove the lrp", func() {
+				Expect(fakeDesiredLRPDB.RemoveDesiredLRPCallCount()).To(Equal(0))
+
+				response := models.DesiredLRPLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrUnauthorized))
+			})
+		})
+
 		Context("when removing desired lrp in DB succeeds", func() {
 			var desiredLRP *models.DesiredLRP
 
@@ -854,8 +992,9 @@ var _ = Describe("DesiredLRP Handlers", func() {
 
 			It("removes the desired lrp", func() {
 				Expect(fakeDesiredLRPDB.RemoveDesiredLRPCallCount()).To(Equal(1))
-				_, actualProcessGuid := fakeDesiredLRPDB.RemoveDesiredLRPArgsForCall(0)
+				_, actualProcessGuid, actualTag := fakeDesiredLRPDB.RemoveDesiredLRPArgsForCall(0)
 				Expect(actualProcessGuid).To(Equal(processGuid))
+				Expect(actualTag).To(BeNil())
 
 				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
 				response := models.DesiredLRPLifecycleResponse{}
@@ -878,6 +1017,12 @@ var _ = Describe("DesiredLRP Handlers", func() {
 				close(done)
 			})
 
+			It("cancels the rollout of the desired lrp", func() {
+				Expect(fakeRolloutController.CancelRolloutCallCount()).To(Equal(1))
+				_, actualProcessGuid := fakeRolloutController.CancelRolloutArgsForCall(0)
+				Expect(actualProcessGuid).To(Equal(processGuid))
+			})
+
 			Context("when there are running instances on a present cell", func() {
 				var (
 					runningActualLRPGroup, evacuatingAndRunningActualLRPGroup, evacuatingActualLRPGroup *models.ActualLRPGroup
@@ -925,8 +1070,10 @@ var _ = Describe("DesiredLRP Handlers", func() {
 					Expect(processGuid).To(Equal("some-guid"))
 
 					Expect(fakeRepClientFactory.CreateClientCallCount()).To(Equal(2))
-					Expect(fakeRepClientFactory.CreateClientArgsForCall(0)).To(Equal("some-address"))
-					Expect(fakeRepClientFactory.CreateClientArgsForCall(1)).To(Equal("some-address"))
+					_, repAddr, _ := fakeRepClientFactory.CreateClientArgsForCall(0)
+					Expect(repAddr).To(Equal("some-address"))
+					_, repAddr, _ = fakeRepClientFactory.CreateClientArgsForCall(1)
+					Expect(repAddr).To(Equal("some-address"))
 
 					Expect(fakeRepClient.StopLRPInstanceCallCount()).To(Equal(2))
 					key, instanceKey := fakeRepClient.StopLRPInstanceArgsForCall(0)
@@ -999,5 +1146,630 @@ var _ = Describe("DesiredLRP Handlers", func() {
 				Expect(response.Error).To(Equal(models.ErrUnknownError))
 			})
 		})
+
+		Context("when an expected modification tag is given", func() {
+			var expectedTag models.ModificationTag
+
+			BeforeEach(func() {
+				expectedTag = models.NewModificationTag("some-epoch", 2)
+				requestBody = &models.RemoveDesiredLRPRequest{
+					ProcessGuid:             processGuid,
+					ExpectedModificationTag: &expectedTag,
+				}
+			})
+
+			It("passes the tag to the DB", func() {
+				Expect(fakeDesiredLRPDB.RemoveDesiredLRPCallCount()).To(Equal(1))
+				_, _, actualTag := fakeDesiredLRPDB.RemoveDesiredLRPArgsForCall(0)
+				Expect(actualTag).To(Equal(&expectedTag))
+			})
+
+			Context("when the tag is stale", func() {
+				BeforeEach(func() {
+					fakeDesiredLRPDB.RemoveDesiredLRPReturns(models.ErrResourceConflict)
+				})
+
+				It("responds with a resource conflict error", func() {
+					Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+					response := models.DesiredLRPLifecycleResponse{}
+					err := response.Unmarshal(responseRecorder.Body.Bytes())
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(response.Error).To(Equal(models.ErrResourceConflict))
+				})
+
+				It("does not stop any actual lrps", func() {
+					Expect(fakeActualLRPDB.ActualLRPGroupsByProcessGuidCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
+
+	Describe("DesireDesiredLRPs", func() {
+		var (
+			firstLRP, secondLRP *models.DesiredLRP
+
+			requestBody interface{}
+		)
+
+		BeforeEach(func() {
+			firstLRP = model_helpers.NewValidDesiredLRP("guid-1")
+			firstLRP.Instances = 1
+			secondLRP = model_helpers.NewValidDesiredLRP("guid-2")
+			secondLRP.Instances = 1
+			requestBody = &models.DesireLRPsRequest{
+				DesiredLrps: []*models.DesiredLRP{firstLRP, secondLRP},
+			}
+
+			fakeDesiredLRPDB.DesiredLRPByProcessGuidStub = func(_ lager.Logger, processGuid string) (*models.DesiredLRP, error) {
+				if processGuid == firstLRP.ProcessGuid {
+					return firstLRP, nil
+				}
+				return secondLRP, nil
+			}
+			fakeActualLRPDB.CreateUnclaimedActualLRPStub = func(_ lager.Logger, key *models.ActualLRPKey) (*models.ActualLRPGroup, error) {
+				return &models.ActualLRPGroup{Instance: model_helpers.NewValidActualLRP(key.ProcessGuid, key.Index)}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			request := newTestRequest(requestBody)
+			handler.DesireDesiredLRPs(logger, responseRecorder, request)
+		})
+
+		It("desires each of the desired lrps", func() {
+			Expect(fakeDesiredLRPDB.DesireLRPCallCount()).To(Equal(2))
+			_, first := fakeDesiredLRPDB.DesireLRPArgsForCall(0)
+			_, second := fakeDesiredLRPDB.DesireLRPArgsForCall(1)
+			Expect([]*models.DesiredLRP{first, second}).To(ConsistOf(firstLRP, secondLRP))
+		})
+
+		It("returns a result for each desired lrp, in order", func() {
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			response := models.DesiredLRPsLifecycleResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(response.Error).To(BeNil())
+			Expect(response.Results).To(Equal([]*models.DesiredLRPLifecycleResult{
+				{ProcessGuid: "guid-1"},
+				{ProcessGuid: "guid-2"},
+			}))
+		})
+
+		It("emits a create event and requests an auction for each desired lrp", func() {
+			Eventually(desiredHub.EmitCallCount).Should(Equal(2))
+			Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(2))
+		})
+
+		Context("when one of the desired lrps is invalid", func() {
+			BeforeEach(func() {
+				secondLRP.Domain = ""
+			})
+
+			It("desires the valid desired lrp", func() {
+				Expect(fakeDesiredLRPDB.DesireLRPCallCount()).To(Equal(1))
+				_, actualDesiredLRP := fakeDesiredLRPDB.DesireLRPArgsForCall(0)
+				Expect(actualDesiredLRP).To(Equal(firstLRP))
+			})
+
+			It("returns an InvalidRequest error for the invalid desired lrp", func() {
+				response := models.DesiredLRPsLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(BeNil())
+				Expect(response.Results).To(HaveLen(2))
+				Expect(response.Results[0].Error).To(BeNil())
+				Expect(response.Results[1].ProcessGuid).To(Equal("guid-2"))
+				Expect(response.Results[1].Error.Type).To(Equal(models.Error_InvalidRequest))
+			})
+		})
+
+		Context("when desiring one of the desired lrps fails", func() {
+			BeforeEach(func() {
+				fakeDesiredLRPDB.DesireLRPStub = func(_ lager.Logger, desiredLRP *models.DesiredLRP) error {
+					if desiredLRP.ProcessGuid == "guid-1" {
+						return models.ErrResourceExists
+					}
+					return nil
+				}
+			})
+
+			It("returns the error for that desired lrp only", func() {
+				response := models.DesiredLRPsLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(BeNil())
+				Expect(response.Results).To(Equal([]*models.DesiredLRPLifecycleResult{
+					{ProcessGuid: "guid-1", Error: models.ErrResourceExists},
+					{ProcessGuid: "guid-2"},
+				}))
+			})
+		})
+
+		Context("when the DB returns an unrecoverable error", func() {
+			BeforeEach(func() {
+				fakeDesiredLRPDB.DesireLRPReturns(models.NewUnrecoverableError(nil))
+			})
+
+			It("logs and writes to the exit channel", func() {
+				Eventually(logger).Should(gbytes.Say("unrecoverable-error"))
+				Eventually(exitCh).Should(Receive())
+			})
+		})
+
+		Context("when the request has more desired lrps than allowed", func() {
+			BeforeEach(func() {
+				desiredLRPs := []*models.DesiredLRP{}
+				for i := 0; i < 11; i++ {
+					desiredLRPs = append(desiredLRPs, model_helpers.NewValidDesiredLRP(fmt.Sprintf("guid-%d", i)))
+				}
+				requestBody = &models.DesireLRPsRequest{DesiredLrps: desiredLRPs}
+			})
+
+			It("rejects the request without desiring any desired lrp", func() {
+				Expect(fakeDesiredLRPDB.DesireLRPCallCount()).To(Equal(0))
+
+				response := models.DesiredLRPsLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrBadRequest))
+				Expect(response.Results).To(BeEmpty())
+			})
+		})
+
+		Context("when the request has no desired lrps", func() {
+			BeforeEach(func() {
+				requestBody = &models.DesireLRPsRequest{}
+			})
+
+			It("returns an InvalidRequest error", func() {
+				response := models.DesiredLRPsLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).NotTo(BeNil())
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+				Expect(response.Results).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("RemoveDesiredLRPs", func() {
+		var requestBody interface{}
+
+		BeforeEach(func() {
+			requestBody = &models.RemoveDesiredLRPsRequest{
+				ProcessGuids: []string{"guid-1", "guid-2"},
+			}
+			fakeDesiredLRPDB.DesiredLRPByProcessGuidStub = func(_ lager.Logger, processGuid string) (*models.DesiredLRP, error) {
+				if processGuid == "guid-2" {
+					return nil, models.ErrResourceNotFound
+				}
+				return model_helpers.NewValidDesiredLRP(processGuid), nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			request := newTestRequest(requestBody)
+			handler.RemoveDesiredLRPs(logger, responseRecorder, request)
+		})
+
+		It("removes the desired lrps that exist", func() {
+			Expect(fakeDesiredLRPDB.RemoveDesiredLRPCallCount()).To(Equal(1))
+			_, processGuid, tag := fakeDesiredLRPDB.RemoveDesiredLRPArgsForCall(0)
+			Expect(processGuid).To(Equal("guid-1"))
+			Expect(tag).To(BeNil())
+		})
+
+		It("emits a removed event for the removed desired lrp", func() {
+			Eventually(desiredHub.EmitCallCount).Should(Equal(1))
+			event := desiredHub.EmitArgsForCall(0)
+			removeEvent, ok := event.(*models.DesiredLRPRemovedEvent)
+			Expect(ok).To(BeTrue())
+			Expect(removeEvent.DesiredLrp.ProcessGuid).To(Equal("guid-1"))
+		})
+
+		It("returns a result for each process guid, in order", func() {
+			response := models.DesiredLRPsLifecycleResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(response.Error).To(BeNil())
+			Expect(response.Results).To(Equal([]*models.DesiredLRPLifecycleResult{
+				{ProcessGuid: "guid-1"},
+				{ProcessGuid: "guid-2", Error: models.ErrResourceNotFound},
+			}))
+		})
+
+		Context("when the request has more process guids than allowed", func() {
+			BeforeEach(func() {
+				processGuids := []string{}
+				for i := 0; i < 11; i++ {
+					processGuids = append(processGuids, fmt.Sprintf("guid-%d", i))
+				}
+				requestBody = &models.RemoveDesiredLRPsRequest{ProcessGuids: processGuids}
+			})
+
+			It("rejects the request without removing any desired lrp", func() {
+				Expect(fakeDesiredLRPDB.RemoveDesiredLRPCallCount()).To(Equal(0))
+
+				response := models.DesiredLRPsLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrBadRequest))
+				Expect(response.Results).To(BeEmpty())
+			})
+		})
+
+		Context("when the request has no process guids", func() {
+			BeforeEach(func() {
+				requestBody = &models.RemoveDesiredLRPsRequest{}
+			})
+
+			It("returns an InvalidRequest error", func() {
+				response := models.DesiredLRPsLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+			})
+		})
+	})
+
+	Describe("ApplyDesiredLRPs", func() {
+		var (
+			requestBody *models.ApplyDesiredLRPsRequest
+			result      *models.DesiredLRPApplyResult
+
+			created, before, after, removed *models.DesiredLRP
+		)
+
+		BeforeEach(func() {
+			created = model_helpers.NewValidDesiredLRP("created-guid")
+			created.Instances = 2
+			before = model_helpers.NewValidDesiredLRP("changed-guid")
+			after = model_helpers.NewValidDesiredLRP("changed-guid")
+			after.Instances = 3
+			removed = model_helpers.NewValidDesiredLRP("removed-guid")
+
+			result = &models.DesiredLRPApplyResult{
+				Created: []*models.DesiredLRP{created},
+				Updated: []models.DesiredLRPChange{{Before: before, After: after}},
+				Removed: []*models.DesiredLRP{removed},
+			}
+			fakeDesiredLRPDB.ApplyDesiredLRPsStub = func(lager.Logger, string, []*models.DesiredLRP, uint32) (*models.DesiredLRPApplyResult, error) {
+				return result, nil
+			}
+			fakeActualLRPDB.CreateUnclaimedActualLRPStub = func(_ lager.Logger, key *models.ActualLRPKey) (*models.ActualLRPGroup, error) {
+				return &models.ActualLRPGroup{Instance: model_helpers.NewValidActualLRP(key.ProcessGuid, key.Index)}, nil
+			}
+			actualHub.SubscribeWithFilterReturns(nil, errors.New("no events"))
+
+			requestBody = &models.ApplyDesiredLRPsRequest{
+				Domain:      "some-domain",
+				DesiredLrps: []*models.DesiredLRP{created, after},
+				Ttl:         60,
+			}
+		})
+
+		JustBeforeEach(func() {
+			request := newTestRequest(requestBody)
+			handler.ApplyDesiredLRPs(logger, responseRecorder, request)
+		})
+
+		It("applies the desired lrps of the domain", func() {
+			Expect(fakeDesiredLRPDB.ApplyDesiredLRPsCallCount()).To(Equal(1))
+			_, domain, desiredLRPs, ttl := fakeDesiredLRPDB.ApplyDesiredLRPsArgsForCall(0)
+			Expect(domain).To(Equal("some-domain"))
+			Expect(desiredLRPs).To(Equal([]*models.DesiredLRP{created, after}))
+			Expect(ttl).To(BeEquivalentTo(60))
+		})
+
+		It("responds with the process guids that changed", func() {
+			response := models.ApplyDesiredLRPsResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(response.Error).To(BeNil())
+			Expect(response.Created).To(Equal([]string{"created-guid"}))
+			Expect(response.Updated).To(Equal([]string{"changed-guid"}))
+			Expect(response.Removed).To(Equal([]string{"removed-guid"}))
+		})
+
+		It("emits an event for each change", func() {
+			Eventually(desiredHub.EmitCallCount).Should(Equal(3))
+
+			var emitted []models.Event
+			for i := 0; i < 3; i++ {
+				emitted = append(emitted, desiredHub.EmitArgsForCall(i))
+			}
+			Expect(emitted).To(ConsistOf(
+				models.NewDesiredLRPCreatedEvent(created),
+				models.NewDesiredLRPChangedEvent(before, after),
+				models.NewDesiredLRPRemovedEvent(removed),
+			))
+		})
+
+		It("starts the instances of created lrps and the added instances of updated lrps", func() {
+			Expect(fakeActualLRPDB.CreateUnclaimedActualLRPCallCount()).To(Equal(4))
+
+			var keys []models.ActualLRPKey
+			for i := 0; i < 4; i++ {
+				_, key := fakeActualLRPDB.CreateUnclaimedActualLRPArgsForCall(i)
+				keys = append(keys, *key)
+			}
+			Expect(keys).To(ConsistOf(
+				models.NewActualLRPKey("created-guid", 0, "some-domain"),
+				models.NewActualLRPKey("created-guid", 1, "some-domain"),
+				models.NewActualLRPKey("changed-guid", 1, "some-domain"),
+				models.NewActualLRPKey("changed-guid", 2, "some-domain"),
+			))
+		})
+
+		It("stops the instances of removed lrps", func() {
+			Expect(fakeActualLRPDB.ActualLRPGroupsByProcessGuidCallCount()).To(Equal(1))
+			_, processGuid := fakeActualLRPDB.ActualLRPGroupsByProcessGuidArgsForCall(0)
+			Expect(processGuid).To(Equal("removed-guid"))
+		})
+
+		It("cancels the rollouts of removed lrps", func() {
+			Expect(fakeRolloutController.CancelRolloutCallCount()).To(Equal(1))
+			_, processGuid := fakeRolloutController.CancelRolloutArgsForCall(0)
+			Expect(processGuid).To(Equal("removed-guid"))
+		})
+
+		It("does not roll instances when the run info is unchanged", func() {
+			Expect(fakeRolloutController.StartRolloutCallCount()).To(Equal(0))
+		})
+
+		Context("when the run info of an lrp changed", func() {
+			BeforeEach(func() {
+				after.EnvironmentVariables = []*models.EnvironmentVariable{{Name: "NEW", Value: "value"}}
+			})
+
+			It("rolls its instances one at a time", func() {
+				Expect(fakeRolloutController.StartRolloutCallCount()).To(Equal(1))
+				_, desiredLRP, maxInFlight := fakeRolloutController.StartRolloutArgsForCall(0)
+				Expect(desiredLRP).To(Equal(after))
+				Expect(maxInFlight).To(BeEquivalentTo(1))
+			})
+		})
+
+		Context("when the request is invalid", func() {
+			BeforeEach(func() {
+				requestBody.Domain = ""
+			})
+
+			It("returns an InvalidRequest error", func() {
+				response := models.ApplyDesiredLRPsResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+				Expect(fakeDesiredLRPDB.ApplyDesiredLRPsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the DB errors out", func() {
+			BeforeEach(func() {
+				fakeDesiredLRPDB.ApplyDesiredLRPsStub = nil
+				fakeDesiredLRPDB.ApplyDesiredLRPsReturns(nil, models.ErrResourceExists)
+			})
+
+			It("returns the error and makes no further changes", func() {
+				response := models.ApplyDesiredLRPsResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrResourceExists))
+				Expect(fakeActualLRPDB.CreateUnclaimedActualLRPCallCount()).To(Equal(0))
+				Consistently(desiredHub.EmitCallCount).Should(Equal(0))
+			})
+		})
+
+		Context("when the DB returns an unrecoverable error", func() {
+			BeforeEach(func() {
+				fakeDesiredLRPDB.ApplyDesiredLRPsStub = nil
+				fakeDesiredLRPDB.ApplyDesiredLRPsReturns(nil, models.NewUnrecoverableError(nil))
+			})
+
+			It("logs and writes to the exit channel", func() {
+				Eventually(logger).Should(gbytes.Say("unrecoverable-error"))
+				Eventually(exitCh).Should(Receive())
+			})
+		})
+	})
+
+	Describe("RedeployDesiredLRP", func() {
+		var (
+			processGuid      string
+			runInfo          models.DesiredLRPRunInfo
+			beforeDesiredLRP *models.DesiredLRP
+			afterDesiredLRP  *models.DesiredLRP
+
+			requestBody *models.RedeployDesiredLRPRequest
+		)
+
+		BeforeEach(func() {
+			processGuid = "some-guid"
+			beforeDesiredLRP = model_helpers.NewValidDesiredLRP(processGuid)
+			afterDesiredLRP = model_helpers.NewValidDesiredLRP(processGuid)
+			afterDesiredLRP.EnvironmentVariables = []*models.EnvironmentVariable{{Name: "NEW", Value: "value"}}
+			runInfo = afterDesiredLRP.DesiredLRPRunInfo(time.Unix(42, 0))
+
+			fakeDesiredLRPDB.UpdateDesiredLRPRunInfoReturns(beforeDesiredLRP, nil)
+			fakeDesiredLRPDB.DesiredLRPByProcessGuidReturns(afterDesiredLRP, nil)
+
+			requestBody = &models.RedeployDesiredLRPRequest{
+				ProcessGuid: processGuid,
+				RunInfo:     &runInfo,
+				MaxInFlight: 2,
+			}
+		})
+
+		JustBeforeEach(func() {
+			request := newTestRequest(requestBody)
+			handler.RedeployDesiredLRP(logger, responseRecorder, request)
+		})
+
+		It("replaces the run info of the desired lrp", func() {
+			Expect(fakeDesiredLRPDB.UpdateDesiredLRPRunInfoCallCount()).To(Equal(1))
+			_, actualProcessGuid, actualRunInfo, actualResource := fakeDesiredLRPDB.UpdateDesiredLRPRunInfoArgsForCall(0)
+			Expect(actualProcessGuid).To(Equal(processGuid))
+			Expect(actualRunInfo).To(Equal(&runInfo))
+			Expect(actualResource).To(BeNil())
+
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			response := models.DesiredLRPLifecycleResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error).To(BeNil())
+		})
+
+		It("emits a change event to the hub", func() {
+			Eventually(desiredHub.EmitCallCount).Should(Equal(1))
+			changeEvent, ok := desiredHub.EmitArgsForCall(0).(*models.DesiredLRPChangedEvent)
+			Expect(ok).To(BeTrue())
+			Expect(changeEvent.Before).To(Equal(beforeDesiredLRP))
+			Expect(changeEvent.After).To(Equal(afterDesiredLRP))
+		})
+
+		It("starts a rollout of the updated desired lrp", func() {
+			Expect(fakeRolloutController.StartRolloutCallCount()).To(Equal(1))
+			_, desiredLRP, maxInFlight := fakeRolloutController.StartRolloutArgsForCall(0)
+			Expect(desiredLRP).To(Equal(afterDesiredLRP))
+			Expect(maxInFlight).To(BeEquivalentTo(2))
+		})
+
+		Context("when the rollout cannot be recorded", func() {
+			BeforeEach(func() {
+				fakeRolloutController.StartRolloutReturns(models.ErrUnknownError)
+			})
+
+			It("responds with the error", func() {
+				response := models.DesiredLRPLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error).To(Equal(models.ErrUnknownError))
+			})
+		})
+
+		Context("when the updated desired lrp cannot be fetched", func() {
+			BeforeEach(func() {
+				fakeDesiredLRPDB.DesiredLRPByProcessGuidReturns(nil, models.ErrUnknownError)
+			})
+
+			It("marks the rollout failed", func() {
+				Expect(fakeRolloutController.StartRolloutCallCount()).To(Equal(0))
+				Expect(fakeRolloutController.FailRolloutCallCount()).To(Equal(1))
+				_, failedGuid, reason := fakeRolloutController.FailRolloutArgsForCall(0)
+				Expect(failedGuid).To(Equal(processGuid))
+				Expect(reason).To(ContainSubstring(models.ErrUnknownError.Error()))
+			})
+
+			It("responds with the error", func() {
+				response := models.DesiredLRPLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error).To(Equal(models.ErrUnknownError))
+			})
+		})
+
+		Context("when the request is invalid", func() {
+			BeforeEach(func() {
+				requestBody.RunInfo = nil
+			})
+
+			It("responds with an invalid request error", func() {
+				response := models.DesiredLRPLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+				Expect(fakeDesiredLRPDB.UpdateDesiredLRPRunInfoCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the DB errors out", func() {
+			BeforeEach(func() {
+				fakeDesiredLRPDB.UpdateDesiredLRPRunInfoReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("provides relevant error information", func() {
+				response := models.DesiredLRPLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error).To(Equal(models.ErrResourceNotFound))
+			})
+
+			It("does not start a rollout", func() {
+				Expect(fakeRolloutController.StartRolloutCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("DesiredLRPRollout", func() {
+		var (
+			requestBody *models.DesiredLRPRolloutRequest
+			rollout     *models.DesiredLRPRollout
+		)
+
+		BeforeEach(func() {
+			requestBody = &models.DesiredLRPRolloutRequest{ProcessGuid: "some-guid"}
+			rollout = &models.DesiredLRPRollout{
+				ProcessGuid:     "some-guid",
+				State:           models.DesiredLRPRolloutStateInProgress,
+				ReplacedIndices: []int32{0},
+			}
+			fakeRolloutDB.DesiredLRPRolloutReturns(rollout, nil)
+		})
+
+		JustBeforeEach(func() {
+			request := newTestRequest(requestBody)
+			handler.DesiredLRPRollout(logger, responseRecorder, request)
+		})
+
+		It("returns the rollout", func() {
+			Expect(fakeRolloutDB.DesiredLRPRolloutCallCount()).To(Equal(1))
+			_, processGuid := fakeRolloutDB.DesiredLRPRolloutArgsForCall(0)
+			Expect(processGuid).To(Equal("some-guid"))
+
+			response := models.DesiredLRPRolloutResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error).To(BeNil())
+			Expect(response.Rollout).To(Equal(rollout))
+		})
+
+		Context("when there is no rollout", func() {
+			BeforeEach(func() {
+				fakeRolloutDB.DesiredLRPRolloutReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("responds with a resource not found error", func() {
+				response := models.DesiredLRPRolloutResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+
+		Context("when the request is invalid", func() {
+			BeforeEach(func() {
+				requestBody.ProcessGuid = ""
+			})
+
+			It("responds with an invalid request error", func() {
+				response := models.DesiredLRPRolloutResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+				Expect(fakeRolloutDB.DesiredLRPRolloutCallCount()).To(Equal(0))
+			})
+		})
 	})
 })
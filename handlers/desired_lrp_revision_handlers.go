@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+var ErrDesiredLRPRevisionsUnavailable = models.NewError(models.Error_InvalidRequest, "desired lrp revisions require a SQL database")
+
+// DesiredLRPRevisions lists the recorded revisions of a DesiredLRP, oldest
+// first.
+func (h *DesiredLRPHandler) DesiredLRPRevisions(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("desired-lrp-revisions")
+
+	request := &models.DesiredLRPRevisionsRequest{}
+	response := &models.DesiredLRPRevisionsResponse{}
+
+	err = parseRequest(logger, req, request)
+	if err == nil && h.revisionDB == nil {
+		err = ErrDesiredLRPRevisionsUnavailable
+	}
+	if err == nil {
+		err = h.authorizeProcessGuid(logger, req, request.ProcessGuid)
+	}
+	if err == nil {
+		response.Revisions, err = h.revisionDB.DesiredLRPRevisions(logger, request.ProcessGuid)
+	}
+
+	response.Error = models.ConvertError(err)
+	writeResponse(w, req, response)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+}
+
+func (h *DesiredLRPHandler) DesiredLRPRevision(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("desired-lrp-revision")
+
+	request := &models.DesiredLRPRevisionRequest{}
+	response := &models.DesiredLRPRevisionResponse{}
+
+	err = parseRequest(logger, req, request)
+	if err == nil && h.revisionDB == nil {
+		err = ErrDesiredLRPRevisionsUnavailable
+	}
+	if err == nil {
+		err = h.authorizeProcessGuid(logger, req, request.ProcessGuid)
+	}
+	if err == nil {
+		response.Revision, err = h.revisionDB.DesiredLRPRevision(logger, request.ProcessGuid, request.Revision)
+	}
+
+	response.Error = models.ConvertError(err)
+	writeResponse(w, req, response)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+}
+
+// RollbackDesiredLRP reapplies the instances, routes and annotation of a
+// revision of the DesiredLRP and, if they differ from the current ones, its run
+// definition and resources. The database applies them together as one new
+// revision, so a failure leaves the DesiredLRP unchanged. Instances are then
+// started or stopped to follow the instance count and, when the run definition
+// or resources changed, rolled onto them one at a time.
+func (h *DesiredLRPHandler) RollbackDesiredLRP(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("rollback-desired-lrp")
+
+	request := &models.RollbackDesiredLRPRequest{}
+	response := &models.DesiredLRPLifecycleResponse{}
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer writeResponse(w, req, response)
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	if h.revisionDB == nil {
+		response.Error = ErrDesiredLRPRevisionsUnavailable
+		return
+	}
+
+	logger = logger.WithData(lager.Data{"guid": request.ProcessGuid, "revision": request.Revision})
+
+	err = h.authorizeProcessGuid(logger, req, request.ProcessGuid)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	before, after, err := h.revisionDB.RollbackDesiredLRP(logger, request.ProcessGuid, request.Revision, request.ExpectedModificationTag)
+	if err != nil {
+		logger.Error("failed-rolling-back-desired-lrp", err)
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	go h.desiredHub.Emit(models.NewDesiredLRPChangedEvent(before, after))
+
+	h.applyDesiredLRPChange(logger, models.DesiredLRPChange{Before: before, After: after})
+}
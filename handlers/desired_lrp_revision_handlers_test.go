@@ -0,0 +1,294 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"code.cloudfoundry.org/auctioneer/auctioneerfakes"
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/events/eventfakes"
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/fake_controllers"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DesiredLRP Revision Handlers", func() {
+	var (
+		logger           *lagertest.TestLogger
+		fakeDesiredLRPDB *dbfakes.FakeDesiredLRPDB
+		fakeActualLRPDB  *dbfakes.FakeActualLRPDB
+		fakeRevisionDB   *dbfakes.FakeDesiredLRPRevisionDB
+		desiredHub       *eventfakes.FakeHub
+
+		fakeRolloutController *fake_controllers.FakeDesiredLRPRolloutController
+		fakeClock             *fakeclock.FakeClock
+
+		responseRecorder *httptest.ResponseRecorder
+		handler          *handlers.DesiredLRPHandler
+		exitCh           chan struct{}
+
+		revision1, revision2 *models.DesiredLRPRevision
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeDesiredLRPDB = new(dbfakes.FakeDesiredLRPDB)
+		fakeActualLRPDB = new(dbfakes.FakeActualLRPDB)
+		fakeRevisionDB = new(dbfakes.FakeDesiredLRPRevisionDB)
+		desiredHub = new(eventfakes.FakeHub)
+		fakeRolloutController = new(fake_controllers.FakeDesiredLRPRolloutController)
+		fakeClock = fakeclock.NewFakeClock(time.Unix(1000, 0))
+		responseRecorder = httptest.NewRecorder()
+		exitCh = make(chan struct{}, 1)
+		handler = handlers.NewDesiredLRPHandler(
+			5,
+			10,
+			fakeDesiredLRPDB,
+			fakeActualLRPDB,
+			fakeRevisionDB,
+			new(dbfakes.FakeDesiredLRPRolloutDB),
+			desiredHub,
+			new(eventfakes.FakeHub),
+			new(auctioneerfakes.FakeClient),
+			fakeRepClientFactory,
+			fakeServiceClient,
+			fakeRolloutController,
+			fakeClock,
+			exitCh,
+		)
+
+		desiredLRP := model_helpers.NewValidDesiredLRP("some-guid")
+		revision1 = &models.DesiredLRPRevision{ProcessGuid: "some-guid", Revision: 1, DesiredLrp: desiredLRP}
+		revision2 = &models.DesiredLRPRevision{ProcessGuid: "some-guid", Revision: 2, DesiredLrp: desiredLRP}
+
+		fakeDesiredLRPDB.DesiredLRPSchedulingInfosReturns([]*models.DesiredLRPSchedulingInfo{
+			{DesiredLRPKey: models.NewDesiredLRPKey("some-guid", "some-domain", "some-log-guid")},
+		}, nil)
+	})
+
+	Describe("DesiredLRPRevisions", func() {
+		var request *http.Request
+
+		BeforeEach(func() {
+			fakeRevisionDB.DesiredLRPRevisionsReturns([]*models.DesiredLRPRevision{revision1, revision2}, nil)
+			request = newTestRequest(&models.DesiredLRPRevisionsRequest{ProcessGuid: "some-guid"})
+		})
+
+		JustBeforeEach(func() {
+			handler.DesiredLRPRevisions(logger, responseRecorder, request)
+		})
+
+		parseResponse := func() *models.DesiredLRPRevisionsResponse {
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			response := &models.DesiredLRPRevisionsResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			return response
+		}
+
+		It("returns the revisions of the desired lrp", func() {
+			Expect(fakeRevisionDB.DesiredLRPRevisionsCallCount()).To(Equal(1))
+			_, processGuid := fakeRevisionDB.DesiredLRPRevisionsArgsForCall(0)
+			Expect(processGuid).To(Equal("some-guid"))
+
+			response := parseResponse()
+			Expect(response.Error).To(BeNil())
+			Expect(response.Revisions).To(Equal([]*models.DesiredLRPRevision{revision1, revision2}))
+		})
+
+		Context("when the client may not access the domain of the desired lrp", func() {
+			BeforeEach(func() {
+				request = middleware.WithDomainScope(request, middleware.NewDomainScope("other-domain"))
+			})
+
+			It("responds with an Unauthorized error", func() {
+				Expect(fakeRevisionDB.DesiredLRPRevisionsCallCount()).To(Equal(0))
+				Expect(parseResponse().Error).To(Equal(models.ErrUnauthorized))
+			})
+		})
+
+		Context("when revisions are not recorded", func() {
+			BeforeEach(func() {
+				handler = handlers.NewDesiredLRPHandler(5, 10, fakeDesiredLRPDB, nil, nil, nil, desiredHub, nil, nil, nil, nil, nil, nil, exitCh)
+			})
+
+			It("responds with an error saying so", func() {
+				Expect(parseResponse().Error).To(Equal(handlers.ErrDesiredLRPRevisionsUnavailable))
+			})
+		})
+	})
+
+	Describe("DesiredLRPRevision", func() {
+		JustBeforeEach(func() {
+			request := newTestRequest(&models.DesiredLRPRevisionRequest{ProcessGuid: "some-guid", Revision: 2})
+			handler.DesiredLRPRevision(logger, responseRecorder, request)
+		})
+
+		parseResponse := func() *models.DesiredLRPRevisionResponse {
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			response := &models.DesiredLRPRevisionResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			return response
+		}
+
+		Context("when the revision exists", func() {
+			BeforeEach(func() {
+				fakeRevisionDB.DesiredLRPRevisionReturns(revision2, nil)
+			})
+
+			It("returns it", func() {
+				_, processGuid, revision := fakeRevisionDB.DesiredLRPRevisionArgsForCall(0)
+				Expect(processGuid).To(Equal("some-guid"))
+				Expect(revision).To(BeEquivalentTo(2))
+
+				response := parseResponse()
+				Expect(response.Error).To(BeNil())
+				Expect(response.Revision).To(Equal(revision2))
+			})
+		})
+
+		Context("when the revision does not exist", func() {
+			BeforeEach(func() {
+				fakeRevisionDB.DesiredLRPRevisionReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("responds with a ResourceNotFound error", func() {
+				Expect(parseResponse().Error).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+	})
+
+	Describe("RollbackDesiredLRP", func() {
+		var (
+			requestBody      *models.RollbackDesiredLRPRequest
+			beforeDesiredLRP *models.DesiredLRP
+			afterDesiredLRP  *models.DesiredLRP
+		)
+
+		BeforeEach(func() {
+			beforeDesiredLRP = model_helpers.NewValidDesiredLRP("some-guid")
+			beforeDesiredLRP.Instances = 2
+			afterDesiredLRP = model_helpers.NewValidDesiredLRP("some-guid")
+			afterDesiredLRP.Instances = 2
+			afterDesiredLRP.Annotation = "previous-annotation"
+			fakeRevisionDB.RollbackDesiredLRPReturns(beforeDesiredLRP, afterDesiredLRP, nil)
+
+			requestBody = &models.RollbackDesiredLRPRequest{ProcessGuid: "some-guid", Revision: 1}
+		})
+
+		JustBeforeEach(func() {
+			handler.RollbackDesiredLRP(logger, responseRecorder, newTestRequest(requestBody))
+		})
+
+		parseResponse := func() *models.DesiredLRPLifecycleResponse {
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			response := &models.DesiredLRPLifecycleResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			return response
+		}
+
+		It("rolls the desired lrp back to the revision in the DB", func() {
+			Expect(fakeRevisionDB.RollbackDesiredLRPCallCount()).To(Equal(1))
+			_, processGuid, revision, tag := fakeRevisionDB.RollbackDesiredLRPArgsForCall(0)
+			Expect(processGuid).To(Equal("some-guid"))
+			Expect(revision).To(BeEquivalentTo(1))
+			Expect(tag).To(BeNil())
+
+			Expect(parseResponse().Error).To(BeNil())
+		})
+
+		It("does not change the desired lrp outside of the rollback", func() {
+			Expect(fakeDesiredLRPDB.UpdateDesiredLRPCallCount()).To(Equal(0))
+			Expect(fakeDesiredLRPDB.UpdateDesiredLRPRunInfoCallCount()).To(Equal(0))
+		})
+
+		It("does not roll the instances when the run definition is unchanged", func() {
+			Expect(fakeRolloutController.StartRolloutCallCount()).To(Equal(0))
+		})
+
+		It("emits a changed event", func() {
+			Eventually(desiredHub.EmitCallCount).Should(Equal(1))
+			event, ok := desiredHub.EmitArgsForCall(0).(*models.DesiredLRPChangedEvent)
+			Expect(ok).To(BeTrue())
+			Expect(event.Before).To(Equal(beforeDesiredLRP))
+			Expect(event.After).To(Equal(afterDesiredLRP))
+		})
+
+		Context("when an expected modification tag is given", func() {
+			BeforeEach(func() {
+				tag := models.NewModificationTag("some-epoch", 3)
+				requestBody.ExpectedModificationTag = &tag
+			})
+
+			It("passes the tag to the DB", func() {
+				_, _, _, tag := fakeRevisionDB.RollbackDesiredLRPArgsForCall(0)
+				Expect(tag).To(Equal(requestBody.ExpectedModificationTag))
+			})
+		})
+
+		Context("when the revision increases the instances", func() {
+			BeforeEach(func() {
+				afterDesiredLRP.Instances = 3
+			})
+
+			It("starts the new instance", func() {
+				Expect(fakeActualLRPDB.CreateUnclaimedActualLRPCallCount()).To(Equal(1))
+				_, key := fakeActualLRPDB.CreateUnclaimedActualLRPArgsForCall(0)
+				Expect(key.Index).To(BeEquivalentTo(2))
+			})
+		})
+
+		Context("when the revision has a different run definition or resources", func() {
+			BeforeEach(func() {
+				afterDesiredLRP.EnvironmentVariables = []*models.EnvironmentVariable{{Name: "OLD", Value: "value"}}
+				afterDesiredLRP.MemoryMb = 512
+			})
+
+			It("rolls the instances onto them one at a time", func() {
+				Expect(fakeRolloutController.StartRolloutCallCount()).To(Equal(1))
+				_, desiredLRP, maxInFlight := fakeRolloutController.StartRolloutArgsForCall(0)
+				Expect(desiredLRP).To(Equal(afterDesiredLRP))
+				Expect(maxInFlight).To(BeEquivalentTo(1))
+
+				Expect(parseResponse().Error).To(BeNil())
+			})
+
+			It("emits a single changed event", func() {
+				Eventually(desiredHub.EmitCallCount).Should(Equal(1))
+				Consistently(desiredHub.EmitCallCount).Should(Equal(1))
+			})
+		})
+
+		Context("when the rollback fails", func() {
+			BeforeEach(func() {
+				fakeRevisionDB.RollbackDesiredLRPReturns(nil, nil, models.ErrUnknownError)
+			})
+
+			It("responds with the error", func() {
+				Expect(parseResponse().Error).To(Equal(models.ErrUnknownError))
+			})
+
+			It("does not change the instances or emit an event", func() {
+				Expect(fakeActualLRPDB.CreateUnclaimedActualLRPCallCount()).To(Equal(0))
+				Expect(fakeRolloutController.StartRolloutCallCount()).To(Equal(0))
+				Consistently(desiredHub.EmitCallCount).Should(Equal(0))
+			})
+		})
+
+		Context("when the revision does not exist", func() {
+			BeforeEach(func() {
+				fakeRevisionDB.RollbackDesiredLRPReturns(nil, nil, models.ErrResourceNotFound)
+			})
+
+			It("responds with the error", func() {
+				Expect(parseResponse().Error).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+	})
+})
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager"
 )
@@ -32,8 +33,9 @@ func (h *DomainHandler) Domains(logger lager.Logger, w http.ResponseWriter, req
 	logger = logger.Session("domains")
 	response := &models.DomainsResponse{}
 	response.Domains, err = h.db.Domains(logger)
+	response.Domains = scopeDomains(middleware.DomainScopeFromRequest(req), response.Domains)
 	response.Error = models.ConvertError(err)
-	writeResponse(w, response)
+	writeResponse(w, req, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
 
@@ -45,11 +47,14 @@ func (h *DomainHandler) Upsert(logger lager.Logger, w http.ResponseWriter, req *
 	response := &models.UpsertDomainResponse{}
 
 	err = parseRequest(logger, req, request)
+	if err == nil {
+		err = authorizeDomain(req, request.Domain)
+	}
 	if err == nil {
 		err = h.db.UpsertDomain(logger, request.Domain, request.Ttl)
 	}
 
 	response.Error = models.ConvertError(err)
-	writeResponse(w, response)
+	writeResponse(w, req, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
@@ -1,6 +1,7 @@
 package handlers_test
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 
@@ -20,6 +21,7 @@ var _ = Describe("Domain Handlers", func() {
 		responseRecorder *httptest.ResponseRecorder
 		handler          *handlers.DomainHandler
 		requestBody      interface{}
+		requestHeader    http.Header
 		exitCh           chan struct{}
 	)
 
@@ -29,6 +31,7 @@ var _ = Describe("Domain Handlers", func() {
 		responseRecorder = httptest.NewRecorder()
 		exitCh = make(chan struct{}, 1)
 		handler = handlers.NewDomainHandler(fakeDomainDB, exitCh)
+		requestHeader = http.Header{}
 	})
 
 	Describe("Upsert", func() {
@@ -49,6 +52,7 @@ var _ = Describe("Domain Handlers", func() {
 
 		JustBeforeEach(func() {
 			request := newTestRequest(requestBody)
+			request.Header = requestHeader
 			handler.Upsert(logger, responseRecorder, request)
 		})
 
@@ -138,6 +142,58 @@ var _ = Describe("Domain Handlers", func() {
 				Expect(upsertDomainResponse.Error).To(Equal(models.ErrUnknownError))
 			})
 		})
+
+		Context("when the request is JSON", func() {
+			BeforeEach(func() {
+				requestHeader.Set("Content-Type", "application/json; charset=utf-8")
+				requestBody = `{"domain":"domain-to-add","ttl":12345}`
+			})
+
+			It("decodes the JSON body", func() {
+				Expect(fakeDomainDB.UpsertDomainCallCount()).To(Equal(1))
+				_, domainUpserted, ttlUpserted := fakeDomainDB.UpsertDomainArgsForCall(0)
+				Expect(domainUpserted).To(Equal(domain))
+				Expect(ttlUpserted).To(Equal(ttl))
+			})
+
+			It("responds with JSON", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				Expect(responseRecorder.Header().Get("Content-Type")).To(Equal("application/json"))
+				Expect(responseRecorder.Body.String()).To(MatchJSON(`{}`))
+			})
+
+			Context("when the JSON is malformed", func() {
+				BeforeEach(func() {
+					requestBody = `{"domain":`
+				})
+
+				It("responds with an InvalidJSON error object", func() {
+					Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+					Expect(fakeDomainDB.UpsertDomainCallCount()).To(Equal(0))
+
+					var upsertDomainResponse models.UpsertDomainResponse
+					err := json.Unmarshal(responseRecorder.Body.Bytes(), &upsertDomainResponse)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(upsertDomainResponse.Error.Type).To(Equal(models.Error_InvalidJSON))
+					Expect(responseRecorder.Body.String()).To(ContainSubstring(`"type":"InvalidJSON"`))
+				})
+			})
+
+			Context("when the client accepts only protobuf", func() {
+				BeforeEach(func() {
+					requestHeader.Set("Accept", "application/x-protobuf")
+				})
+
+				It("responds with protobuf", func() {
+					Expect(responseRecorder.Header().Get("Content-Type")).To(Equal("application/x-protobuf"))
+
+					var upsertDomainResponse models.UpsertDomainResponse
+					err := upsertDomainResponse.Unmarshal(responseRecorder.Body.Bytes())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(upsertDomainResponse.Error).To(BeNil())
+				})
+			})
+		})
 	})
 
 	Describe("Domains", func() {
@@ -148,7 +204,9 @@ var _ = Describe("Domain Handlers", func() {
 		})
 
 		JustBeforeEach(func() {
-			handler.Domains(logger, responseRecorder, newTestRequest(""))
+			request := newTestRequest("")
+			request.Header = requestHeader
+			handler.Domains(logger, responseRecorder, request)
 		})
 
 		Context("when reading domains from DB succeeds", func() {
@@ -216,5 +274,18 @@ var _ = Describe("Domain Handlers", func() {
 				Expect(response.Domains).To(BeNil())
 			})
 		})
+
+		Context("when the client accepts JSON", func() {
+			BeforeEach(func() {
+				requestHeader.Set("Accept", "text/plain, application/json;q=0.9")
+				fakeDomainDB.DomainsReturns(domains, nil)
+			})
+
+			It("returns the domains as JSON", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				Expect(responseRecorder.Header().Get("Content-Type")).To(Equal("application/json"))
+				Expect(responseRecorder.Body.String()).To(MatchJSON(`{"domains":["domain-a","domain-b"]}`))
+			})
+		})
 	})
 })
@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+)
+
+// authorizeDomain returns ErrUnauthorized unless the client making req may
+// access the domain.
+func authorizeDomain(req *http.Request, domain string) error {
+	if !middleware.DomainScopeFromRequest(req).Allows(domain) {
+		return models.ErrUnauthorized
+	}
+	return nil
+}
+
+func scopeDomains(scope middleware.DomainScope, domains []string) []string {
+	if scope == nil {
+		return domains
+	}
+
+	scoped := []string{}
+	for _, domain := range domains {
+		if scope.Allows(domain) {
+			scoped = append(scoped, domain)
+		}
+	}
+	return scoped
+}
+
+func scopeDesiredLRPs(scope middleware.DomainScope, desiredLRPs []*models.DesiredLRP) []*models.DesiredLRP {
+	if scope == nil {
+		return desiredLRPs
+	}
+
+	scoped := []*models.DesiredLRP{}
+	for _, desiredLRP := range desiredLRPs {
+		if scope.Allows(desiredLRP.Domain) {
+			scoped = append(scoped, desiredLRP)
+		}
+	}
+	return scoped
+}
+
+func scopeSchedulingInfos(scope middleware.DomainScope, schedulingInfos []*models.DesiredLRPSchedulingInfo) []*models.DesiredLRPSchedulingInfo {
+	if scope == nil {
+		return schedulingInfos
+	}
+
+	scoped := []*models.DesiredLRPSchedulingInfo{}
+	for _, schedulingInfo := range schedulingInfos {
+		if scope.Allows(schedulingInfo.Domain) {
+			scoped = append(scoped, schedulingInfo)
+		}
+	}
+	return scoped
+}
+
+func scopeActualLRPGroups(scope middleware.DomainScope, groups []*models.ActualLRPGroup) []*models.ActualLRPGroup {
+	if scope == nil {
+		return groups
+	}
+
+	scoped := []*models.ActualLRPGroup{}
+	for _, group := range groups {
+		if scope.Allows(actualLRPGroupDomain(group)) {
+			scoped = append(scoped, group)
+		}
+	}
+	return scoped
+}
+
+func actualLRPGroupDomain(group *models.ActualLRPGroup) string {
+	if group.Instance == nil && group.Evacuating == nil {
+		return ""
+	}
+	actualLRP, _ := group.Resolve()
+	return actualLRP.Domain
+}
+
+func scopeTasks(scope middleware.DomainScope, tasks []*models.Task) []*models.Task {
+	if scope == nil {
+		return tasks
+	}
+
+	scoped := []*models.Task{}
+	for _, task := range tasks {
+		if scope.Allows(task.Domain) {
+			scoped = append(scoped, task)
+		}
+	}
+	return scoped
+}
@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+var ErrEncryptionProgressUnavailable = models.NewError(models.Error_InvalidRequest, "encryption progress requires a SQL database")
+
+type EncryptionHandler struct {
+	db         db.EncryptionDB
+	progressDB db.EncryptionProgressDB
+	exitChan   chan<- struct{}
+}
+
+// NewEncryptionHandler returns a handler reporting the re-encryption of the
+// records in progressDB, which is nil when the BBS is not backed by a SQL
+// database.
+func NewEncryptionHandler(db db.EncryptionDB, progressDB db.EncryptionProgressDB, exitChan chan<- struct{}) *EncryptionHandler {
+	return &EncryptionHandler{
+		db:         db,
+		progressDB: progressDB,
+		exitChan:   exitChan,
+	}
+}
+
+func (h *EncryptionHandler) EncryptionProgress(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("encryption-progress")
+
+	response := &models.EncryptionProgressResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, req, response) }()
+
+	if h.progressDB == nil {
+		response.Error = ErrEncryptionProgressUnavailable
+		return
+	}
+
+	response.KeyLabel, err = h.db.EncryptionKeyLabel(logger)
+	if err != nil && models.ConvertError(err) != models.ErrResourceNotFound {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	response.Tables, err = h.progressDB.EncryptionProgress(logger)
+	response.Error = models.ConvertError(err)
+}
+
+// VerifyEncryptionKeyRetired reports whether any record, including the
+// quarantined ones, is still encrypted with a key, which must not be removed
+// from the encryption keys until none is.
+func (h *EncryptionHandler) VerifyEncryptionKeyRetired(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("verify-encryption-key-retired")
+
+	request := &models.VerifyEncryptionKeyRetiredRequest{}
+	response := &models.VerifyEncryptionKeyRetiredResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, req, response) }()
+
+	if h.progressDB == nil {
+		response.Error = ErrEncryptionProgressUnavailable
+		return
+	}
+
+	err = parseRequest(logger, req, request)
+	if err != nil {
+		logger.Error("failed-parsing-request", err)
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	tables, err := h.progressDB.EncryptionProgress(logger)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	quarantined, err := h.progressDB.QuarantinedRowsByKeyLabel(logger)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	for _, table := range tables {
+		response.RemainingRows += table.RowsUnderKeyLabel(request.KeyLabel)
+	}
+	for _, keyLabelRows := range quarantined {
+		if keyLabelRows.KeyLabel == request.KeyLabel {
+			response.QuarantinedRows += keyLabelRows.Rows
+		}
+	}
+	response.RemainingRows += response.QuarantinedRows
+	response.Retired = response.RemainingRows == 0
+
+	logger.Info("verified", lager.Data{
+		"key_label":        request.KeyLabel,
+		"remaining_rows":   response.RemainingRows,
+		"quarantined_rows": response.QuarantinedRows,
+	})
+}
@@ -0,0 +1,199 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Encryption Handlers", func() {
+	var (
+		logger           *lagertest.TestLogger
+		fakeDB           *dbfakes.FakeEncryptionDB
+		fakeProgressDB   *dbfakes.FakeEncryptionProgressDB
+		responseRecorder *httptest.ResponseRecorder
+		handler          *handlers.EncryptionHandler
+		exitCh           chan struct{}
+
+		tasks, desiredLRPs *models.EncryptionTableProgress
+	)
+
+	BeforeEach(func() {
+		fakeDB = new(dbfakes.FakeEncryptionDB)
+		fakeProgressDB = new(dbfakes.FakeEncryptionProgressDB)
+		logger = lagertest.NewTestLogger("test")
+		responseRecorder = httptest.NewRecorder()
+		exitCh = make(chan struct{}, 1)
+		handler = handlers.NewEncryptionHandler(fakeDB, fakeProgressDB, exitCh)
+
+		tasks = &models.EncryptionTableProgress{
+			TableName:     "tasks",
+			KeyLabel:      "new",
+			RowsDone:      10,
+			RowsRemaining: 5,
+			KeyLabelRows: []*models.EncryptionKeyRows{
+				{KeyLabel: "new", Rows: 10},
+				{KeyLabel: "old", Rows: 5},
+			},
+		}
+		desiredLRPs = &models.EncryptionTableProgress{
+			TableName:    "desired_lrps",
+			KeyLabel:     "new",
+			RowsDone:     2,
+			Completed:    true,
+			KeyLabelRows: []*models.EncryptionKeyRows{{KeyLabel: "new", Rows: 2}},
+		}
+
+		fakeDB.EncryptionKeyLabelReturns("old", nil)
+		fakeProgressDB.EncryptionProgressReturns([]*models.EncryptionTableProgress{tasks, desiredLRPs}, nil)
+	})
+
+	Describe("EncryptionProgress", func() {
+		JustBeforeEach(func() {
+			handler.EncryptionProgress(logger, responseRecorder, newTestRequest(&models.EncryptionProgressRequest{}))
+		})
+
+		parseResponse := func() *models.EncryptionProgressResponse {
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			response := &models.EncryptionProgressResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			return response
+		}
+
+		It("returns the current key label and the progress of every table", func() {
+			response := parseResponse()
+			Expect(response.Error).To(BeNil())
+			Expect(response.KeyLabel).To(Equal("old"))
+			Expect(response.Tables).To(Equal([]*models.EncryptionTableProgress{tasks, desiredLRPs}))
+		})
+
+		Context("when no key label has been recorded yet", func() {
+			BeforeEach(func() {
+				fakeDB.EncryptionKeyLabelReturns("", models.ErrResourceNotFound)
+			})
+
+			It("returns the progress without a key label", func() {
+				response := parseResponse()
+				Expect(response.Error).To(BeNil())
+				Expect(response.KeyLabel).To(BeEmpty())
+				Expect(response.Tables).To(HaveLen(2))
+			})
+		})
+
+		Context("when the DB fails", func() {
+			BeforeEach(func() {
+				fakeProgressDB.EncryptionProgressReturns(nil, models.ErrUnknownError)
+			})
+
+			It("responds with the error", func() {
+				Expect(parseResponse().Error).To(Equal(models.ErrUnknownError))
+			})
+		})
+
+		Context("when the BBS is not backed by a SQL database", func() {
+			BeforeEach(func() {
+				handler = handlers.NewEncryptionHandler(fakeDB, nil, exitCh)
+			})
+
+			It("responds with an error saying so", func() {
+				Expect(parseResponse().Error).To(Equal(handlers.ErrEncryptionProgressUnavailable))
+			})
+		})
+	})
+
+	Describe("VerifyEncryptionKeyRetired", func() {
+		var requestBody interface{}
+
+		BeforeEach(func() {
+			requestBody = &models.VerifyEncryptionKeyRetiredRequest{KeyLabel: "old"}
+		})
+
+		JustBeforeEach(func() {
+			handler.VerifyEncryptionKeyRetired(logger, responseRecorder, newTestRequest(requestBody))
+		})
+
+		parseResponse := func() *models.VerifyEncryptionKeyRetiredResponse {
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			response := &models.VerifyEncryptionKeyRetiredResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			return response
+		}
+
+		It("reports the rows still encrypted with the key", func() {
+			response := parseResponse()
+			Expect(response.Error).To(BeNil())
+			Expect(response.Retired).To(BeFalse())
+			Expect(response.RemainingRows).To(BeEquivalentTo(5))
+		})
+
+		Context("when no row is encrypted with the key", func() {
+			BeforeEach(func() {
+				requestBody = &models.VerifyEncryptionKeyRetiredRequest{KeyLabel: "older"}
+			})
+
+			It("reports the key as retired", func() {
+				response := parseResponse()
+				Expect(response.Error).To(BeNil())
+				Expect(response.Retired).To(BeTrue())
+				Expect(response.RemainingRows).To(BeZero())
+			})
+		})
+
+		Context("when quarantined rows are encrypted with the key", func() {
+			BeforeEach(func() {
+				requestBody = &models.VerifyEncryptionKeyRetiredRequest{KeyLabel: "older"}
+				fakeProgressDB.QuarantinedRowsByKeyLabelReturns([]*models.EncryptionKeyRows{
+					{KeyLabel: "old", Rows: 1},
+					{KeyLabel: "older", Rows: 2},
+				}, nil)
+			})
+
+			It("does not report the key as retired", func() {
+				response := parseResponse()
+				Expect(response.Error).To(BeNil())
+				Expect(response.Retired).To(BeFalse())
+				Expect(response.RemainingRows).To(BeEquivalentTo(2))
+				Expect(response.QuarantinedRows).To(BeEquivalentTo(2))
+			})
+		})
+
+		Context("when counting the quarantined rows fails", func() {
+			BeforeEach(func() {
+				fakeProgressDB.QuarantinedRowsByKeyLabelReturns(nil, models.ErrUnknownError)
+			})
+
+			It("responds with the error", func() {
+				response := parseResponse()
+				Expect(response.Error).To(Equal(models.ErrUnknownError))
+				Expect(response.Retired).To(BeFalse())
+			})
+		})
+
+		Context("when the request is invalid", func() {
+			BeforeEach(func() {
+				requestBody = &models.VerifyEncryptionKeyRetiredRequest{}
+			})
+
+			It("responds with an InvalidRequest error", func() {
+				Expect(fakeProgressDB.EncryptionProgressCallCount()).To(Equal(0))
+				Expect(parseResponse().Error.Type).To(Equal(models.Error_InvalidRequest))
+			})
+		})
+
+		Context("when the DB fails unrecoverably", func() {
+			BeforeEach(func() {
+				fakeProgressDB.EncryptionProgressReturns(nil, models.NewUnrecoverableError(nil))
+			})
+
+			It("exits", func() {
+				Eventually(exitCh).Should(Receive())
+			})
+		})
+	})
+})
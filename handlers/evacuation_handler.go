@@ -54,7 +54,7 @@ func (h *EvacuationHandler) RemoveEvacuatingActualLRP(logger lager.Logger, w htt
 	response := &models.RemoveEvacuatingActualLRPResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -85,7 +85,7 @@ func (h *EvacuationHandler) EvacuateClaimedActualLRP(logger lager.Logger, w http
 	request := &models.EvacuateClaimedActualLRPRequest{}
 	response := &models.EvacuationResponse{}
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err := parseRequest(logger, req, request)
 	if err != nil {
@@ -123,7 +123,7 @@ func (h *EvacuationHandler) EvacuateCrashedActualLRP(logger lager.Logger, w http
 	request := &models.EvacuateCrashedActualLRPRequest{}
 	response := &models.EvacuationResponse{}
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err := parseRequest(logger, req, request)
 	if err != nil {
@@ -160,7 +160,7 @@ func (h *EvacuationHandler) EvacuateRunningActualLRP(logger lager.Logger, w http
 	response := &models.EvacuationResponse{}
 	response.KeepContainer = true
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	request := &models.EvacuateRunningActualLRPRequest{}
 	err := parseRequest(logger, req, request)
@@ -279,7 +279,7 @@ func (h *EvacuationHandler) EvacuateStoppedActualLRP(logger lager.Logger, w http
 	var bbsErr *models.Error
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, bbsErr) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err := parseRequest(logger, req, request)
 	if err != nil {
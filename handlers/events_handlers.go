@@ -2,25 +2,115 @@ package handlers
 
 import (
 	"net/http"
+	"strconv"
 
 	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager"
+	"github.com/vito/go-sse/sse"
 )
 
+const LastEventIDHeader = "Last-Event-ID"
+
+var lrpEventTypes = []string{
+	models.EventTypeDesiredLRPCreated,
+	models.EventTypeDesiredLRPChanged,
+	models.EventTypeDesiredLRPRemoved,
+	models.EventTypeActualLRPCreated,
+	models.EventTypeActualLRPChanged,
+	models.EventTypeActualLRPRemoved,
+	models.EventTypeActualLRPCrashed,
+}
+
+var taskEventTypes = []string{
+	models.EventTypeTaskCreated,
+	models.EventTypeTaskChanged,
+	models.EventTypeTaskRemoved,
+}
+
 type EventHandler struct {
 	desiredHub events.Hub
 	actualHub  events.Hub
+	eventLog   events.EventLog
 }
 
-func NewEventHandler(desiredHub, actualHub events.Hub) *EventHandler {
+func NewEventHandler(desiredHub, actualHub events.Hub, eventLog events.EventLog) *EventHandler {
 	return &EventHandler{
 		desiredHub: desiredHub,
 		actualHub:  actualHub,
+		eventLog:   eventLog,
+	}
+}
+
+type TaskEventHandler struct {
+	taskHub  events.Hub
+	eventLog events.EventLog
+}
+
+func NewTaskEventHandler(taskHub events.Hub, eventLog events.EventLog) *TaskEventHandler {
+	return &TaskEventHandler{
+		taskHub:  taskHub,
+		eventLog: eventLog,
+	}
+}
+
+// replayEvents returns the events of the given types missed by a client
+// resuming from the ID in header (its Last-Event-ID header), or a
+// ResyncRequiredEvent if they are no longer available. The resync event
+// carries the ID of the latest event, so that a client reconnecting after
+// resyncing resumes from there instead of being asked to resync again. It must
+// be called after subscribing to the live events so that nothing is lost
+// between the replay and the live stream.
+func replayEvents(logger lager.Logger, header string, eventLog events.EventLog, filter models.EventFilter, eventTypes []string) []events.SequencedEvent {
+	if header == "" {
+		return nil
+	}
+
+	lastEventID, err := strconv.ParseUint(header, 10, 64)
+	if err == nil {
+		var logged []events.SequencedEvent
+		logged, err = eventLog.Since(lastEventID, filter)
+		if err == nil {
+			replay := []events.SequencedEvent{}
+			for _, event := range logged {
+				if containsEventType(eventTypes, event.Event.EventType()) {
+					replay = append(replay, event)
+				}
+			}
+
+			logger.Info("replaying-events", lager.Data{"last-event-id": lastEventID, "count": len(replay)})
+			return replay
+		}
+	}
+
+	lastID := eventLog.LastID()
+	logger.Info("resync-required", lager.Data{"last-event-id": header, "resume-event-id": lastID, "reason": err.Error()})
+	return []events.SequencedEvent{{
+		ID:    lastID,
+		Event: models.NewResyncRequiredEvent(lastEventID),
+	}}
+}
+
+func containsEventType(eventTypes []string, eventType string) bool {
+	for _, t := range eventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// eventEncoder returns the function encoding the events streamed in response
+// to req: JSON if the client accepts it, base64 encoded protobuf otherwise.
+func eventEncoder(req *http.Request) func(uint64, models.Event) (sse.Event, error) {
+	if middleware.PrefersJSON(req) {
+		return events.NewJSONEventFromModelEvent
 	}
+	return events.NewEventFromModelEvent
 }
 
-func streamEventsToResponse(logger lager.Logger, w http.ResponseWriter, eventChan <-chan models.Event, errorChan <-chan error) {
+func streamEventsToResponse(logger lager.Logger, w http.ResponseWriter, encode func(uint64, models.Event) (sse.Event, error), replay []events.SequencedEvent, eventChan <-chan events.SequencedEvent, errorChan <-chan error) {
 	w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
 	w.Header().Add("Cache-Control", "no-cache, no-store, must-revalidate")
 	w.Header().Add("Connection", "keep-alive")
@@ -29,10 +119,39 @@ func streamEventsToResponse(logger lager.Logger, w http.ResponseWriter, eventCha
 
 	flusher := w.(http.Flusher)
 	flusher.Flush()
-	var event models.Event
-	eventID := 0
 	closeNotifier := w.(http.CloseNotifier).CloseNotify()
 
+	writeEvent := func(event events.SequencedEvent) bool {
+		sseEvent, err := encode(event.ID, event.Event)
+		if err != nil {
+			logger.Error("failed-to-marshal-event", err)
+			return false
+		}
+
+		err = sseEvent.Write(w)
+		if err != nil {
+			return false
+		}
+
+		flusher.Flush()
+		return true
+	}
+
+	// live events emitted while the replay was read from the log are
+	// delivered twice; skip the ones the client has already been sent, or
+	// will pick up when resyncing
+	var replayedThrough uint64
+	replayed := false
+	for _, event := range replay {
+		if !writeEvent(event) {
+			return
+		}
+
+		replayedThrough = event.ID
+		replayed = true
+	}
+
+	var event events.SequencedEvent
 	for {
 		select {
 		case event = <-eventChan:
@@ -43,26 +162,19 @@ func streamEventsToResponse(logger lager.Logger, w http.ResponseWriter, eventCha
 			return
 		}
 
-		sseEvent, err := events.NewEventFromModelEvent(eventID, event)
-		if err != nil {
-			logger.Error("failed-to-marshal-event", err)
-			return
+		if replayed && event.ID <= replayedThrough {
+			continue
 		}
 
-		err = sseEvent.Write(w)
-		if err != nil {
+		if !writeEvent(event) {
 			return
 		}
-
-		flusher.Flush()
-
-		eventID++
 	}
 }
 
-type EventFetcher func() (models.Event, error)
+type EventFetcher func() (events.SequencedEvent, error)
 
-func streamSource(eventChan chan<- models.Event, errorChan chan<- error, closeChan chan struct{}, fetchEvent EventFetcher) {
+func streamSource(eventChan chan<- events.SequencedEvent, errorChan chan<- error, closeChan chan struct{}, fetchEvent EventFetcher) {
 	for {
 		event, err := fetchEvent()
 		if err != nil {
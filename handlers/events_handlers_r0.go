@@ -3,6 +3,8 @@ package handlers
 import (
 	"net/http"
 
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager"
 )
@@ -10,38 +12,63 @@ import (
 func (h *EventHandler) Subscribe_r0(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	logger = logger.Session("subscribe-r0")
 
-	desiredSource, err := h.desiredHub.Subscribe()
+	filter := models.NewEventFilterFromQuery(req.URL.Query())
+	filter.AllowedDomains = middleware.DomainScopeFromRequest(req).Domains()
+
+	source, err := events.SubscribeToHubs(filter, h.desiredHub, h.actualHub)
 	if err != nil {
-		logger.Error("failed-to-subscribe-to-desired-event-hub", err)
+		logger.Error("failed-to-subscribe-to-event-hubs", err)
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
-	defer desiredSource.Close()
+	defer source.Close()
 
-	actualSource, err := h.actualHub.Subscribe()
-	if err != nil {
-		logger.Error("failed-to-subscribe-to-actual-event-hub", err)
-		w.WriteHeader(http.StatusInternalServerError)
-		return
+	replay := replayEvents(logger, req.Header.Get(LastEventIDHeader), h.eventLog, filter, lrpEventTypes)
+	for i := range replay {
+		replay[i].Event = models.VersionDesiredLRPsToV0(replay[i].Event)
 	}
-	defer actualSource.Close()
 
-	eventChan := make(chan models.Event)
+	eventChan := make(chan events.SequencedEvent)
 	errorChan := make(chan error)
 	closeChan := make(chan struct{})
 	defer close(closeChan)
 
-	desiredEventsFetcher := func() (models.Event, error) {
-		event, err := desiredSource.Next()
+	eventsFetcher := func() (events.SequencedEvent, error) {
+		event, err := source.NextSequenced()
 		if err != nil {
 			return event, err
 		}
-		event = models.VersionDesiredLRPsToV0(event)
+		event.Event = models.VersionDesiredLRPsToV0(event.Event)
 		return event, err
 	}
 
-	go streamSource(eventChan, errorChan, closeChan, desiredEventsFetcher)
-	go streamSource(eventChan, errorChan, closeChan, actualSource.Next)
+	go streamSource(eventChan, errorChan, closeChan, eventsFetcher)
+
+	streamEventsToResponse(logger, w, eventEncoder(req), replay, eventChan, errorChan)
+}
+
+func (h *TaskEventHandler) Subscribe_r0(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("tasks-subscribe-r0")
+
+	filter := models.NewEventFilterFromQuery(req.URL.Query())
+	filter.AllowedDomains = middleware.DomainScopeFromRequest(req).Domains()
+
+	taskSource, err := events.SubscribeToHubs(filter, h.taskHub)
+	if err != nil {
+		logger.Error("failed-to-subscribe-to-task-event-hub", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	defer taskSource.Close()
+
+	replay := replayEvents(logger, req.Header.Get(LastEventIDHeader), h.eventLog, filter, taskEventTypes)
+
+	eventChan := make(chan events.SequencedEvent)
+	errorChan := make(chan error)
+	closeChan := make(chan struct{})
+	defer close(closeChan)
+
+	go streamSource(eventChan, errorChan, closeChan, taskSource.NextSequenced)
 
-	streamEventsToResponse(logger, w, eventChan, errorChan)
+	streamEventsToResponse(logger, w, eventEncoder(req), replay, eventChan, errorChan)
 }
@@ -2,6 +2,7 @@ package handlers_test
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -23,19 +24,25 @@ import (
 var _ = Describe("Event Handlers", func() {
 	var (
 		logger     lager.Logger
+		eventLog   events.EventLog
 		desiredHub events.Hub
 		actualHub  events.Hub
+		taskHub    events.Hub
 
-		handler         *handlers.EventHandler
-		eventStreamDone chan struct{}
-		server          *httptest.Server
+		handler          *handlers.EventHandler
+		taskEventHandler *handlers.TaskEventHandler
+		eventStreamDone  chan struct{}
+		server           *httptest.Server
 	)
 
 	BeforeEach(func() {
 		logger = lagertest.NewTestLogger("test")
-		desiredHub = events.NewHub()
-		actualHub = events.NewHub()
-		handler = handlers.NewEventHandler(desiredHub, actualHub)
+		eventLog = events.NewEventLog(100, 0)
+		desiredHub = events.NewHubWithLog(eventLog)
+		actualHub = events.NewHubWithLog(eventLog)
+		taskHub = events.NewHubWithLog(eventLog)
+		handler = handlers.NewEventHandler(desiredHub, actualHub, eventLog)
+		taskEventHandler = handlers.NewTaskEventHandler(taskHub, eventLog)
 
 		eventStreamDone = make(chan struct{})
 	})
@@ -43,6 +50,7 @@ var _ = Describe("Event Handlers", func() {
 	AfterEach(func() {
 		desiredHub.Close()
 		actualHub.Close()
+		taskHub.Close()
 		server.Close()
 	})
 
@@ -167,8 +175,182 @@ var _ = Describe("Event Handlers", func() {
 			})
 		})
 
+		Describe("Resuming with Last-Event-ID", func() {
+			var (
+				desiredLRP *models.DesiredLRP
+				actualLRP  *models.ActualLRP
+				request    *http.Request
+			)
+
+			BeforeEach(func() {
+				desiredLRP = model_helpers.NewValidDesiredLRP("guid").VersionDownTo(format.V0)
+				actualLRP = model_helpers.NewValidActualLRP("guid", 0)
+
+				desiredHub.Emit(models.NewDesiredLRPCreatedEvent(desiredLRP))
+				taskHub.Emit(models.NewTaskCreatedEvent(model_helpers.NewValidTask("task-guid")))
+				actualHub.Emit(models.NewActualLRPCreatedEvent(&models.ActualLRPGroup{Instance: actualLRP}))
+
+				var err error
+				request, err = http.NewRequest("GET", server.URL, nil)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("replays the LRP events after the given ID before streaming new ones", func() {
+				request.Header.Set("Last-Event-ID", "0")
+				response, err := http.DefaultClient.Do(request)
+				Expect(err).NotTo(HaveOccurred())
+				reader := sse.NewReadCloser(response.Body)
+
+				actualHub.Emit(models.NewActualLRPRemovedEvent(&models.ActualLRPGroup{Instance: actualLRP}))
+
+				event, err := reader.Next()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(event.ID).To(Equal("2"))
+				Expect(event.Name).To(Equal(models.EventTypeActualLRPCreated))
+
+				event, err = reader.Next()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(event.ID).To(Equal("3"))
+				Expect(event.Name).To(Equal(models.EventTypeActualLRPRemoved))
+			})
+
+			Context("when the events after the given ID are no longer available", func() {
+				BeforeEach(func() {
+					request.Header.Set("Last-Event-ID", "1000")
+				})
+
+				It("sends a resync required event", func() {
+					response, err := http.DefaultClient.Do(request)
+					Expect(err).NotTo(HaveOccurred())
+					reader := sse.NewReadCloser(response.Body)
+
+					events := events.NewEventSource(reader)
+					event, err := events.Next()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(event).To(Equal(models.NewResyncRequiredEvent(1000)))
+				})
+
+				It("gives the resync required event the ID of the latest event", func() {
+					response, err := http.DefaultClient.Do(request)
+					Expect(err).NotTo(HaveOccurred())
+					reader := sse.NewReadCloser(response.Body)
+
+					event, err := reader.Next()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(event.Name).To(Equal(models.EventTypeResyncRequired))
+					Expect(event.ID).To(Equal("2"))
+				})
+
+				It("streams the events following it when the client resumes from it", func() {
+					request.Header.Set("Last-Event-ID", "2")
+					response, err := http.DefaultClient.Do(request)
+					Expect(err).NotTo(HaveOccurred())
+					reader := sse.NewReadCloser(response.Body)
+
+					actualHub.Emit(models.NewActualLRPRemovedEvent(&models.ActualLRPGroup{Instance: actualLRP}))
+
+					event, err := reader.Next()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(event.ID).To(Equal("3"))
+					Expect(event.Name).To(Equal(models.EventTypeActualLRPRemoved))
+				})
+			})
+		})
+
 		Describe("Subscribe to Actual Events", func() {
 			ItStreamsEventsFromHub(&actualHub)
+
+			It("only streams events matching the filter in the query", func() {
+				response, err := http.Get(server.URL + "?domain=some-domain&cell_id=some-cell")
+				Expect(err).NotTo(HaveOccurred())
+				reader := sse.NewReadCloser(response.Body)
+
+				otherLRP := model_helpers.NewValidActualLRP("other-guid", 0)
+				otherLRP.Domain = "other-domain"
+				otherLRP.CellId = "some-cell"
+				actualHub.Emit(models.NewActualLRPCreatedEvent(&models.ActualLRPGroup{Instance: otherLRP}))
+
+				actualLRP := model_helpers.NewValidActualLRP("some-guid", 0)
+				actualLRP.Domain = "some-domain"
+				actualLRP.CellId = "some-cell"
+				event := models.NewActualLRPCreatedEvent(&models.ActualLRPGroup{Instance: actualLRP})
+				actualHub.Emit(event)
+
+				events := events.NewEventSource(reader)
+				actualEvent, err := events.Next()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actualEvent).To(Equal(event))
+			})
+		})
+
+		Describe("Subscribing with Accept: application/json", func() {
+			It("streams the events as JSON", func() {
+				request, err := http.NewRequest("GET", server.URL, nil)
+				Expect(err).NotTo(HaveOccurred())
+				request.Header.Set("Accept", "application/json")
+
+				response, err := http.DefaultClient.Do(request)
+				Expect(err).NotTo(HaveOccurred())
+				reader := sse.NewReadCloser(response.Body)
+
+				actualLRP := model_helpers.NewValidActualLRP("some-guid", 0)
+				event := models.NewActualLRPCreatedEvent(&models.ActualLRPGroup{Instance: actualLRP})
+				actualHub.Emit(event)
+
+				expectedJSON, err := json.Marshal(event)
+				Expect(err).NotTo(HaveOccurred())
+
+				sseEvent, err := reader.Next()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(sseEvent.Name).To(Equal(models.EventTypeActualLRPCreated))
+				Expect(sseEvent.Data).To(MatchJSON(expectedJSON))
+			})
+		})
+	})
+
+	Describe("Task Subscribe_r0", func() {
+		BeforeEach(func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				taskEventHandler.Subscribe_r0(logger, w, r)
+				close(eventStreamDone)
+			}))
+		})
+
+		Describe("Subscribe to Task Events", func() {
+			ItStreamsEventsFromHub(&taskHub)
+
+			It("streams task events", func() {
+				response, err := http.Get(server.URL)
+				Expect(err).NotTo(HaveOccurred())
+				reader := sse.NewReadCloser(response.Body)
+
+				task := model_helpers.NewValidTask("task-guid")
+				event := models.NewTaskCreatedEvent(task)
+
+				taskHub.Emit(event)
+
+				events := events.NewEventSource(reader)
+				actualEvent, err := events.Next()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actualEvent).To(Equal(event))
+			})
+
+			It("only streams events matching the filter in the query", func() {
+				response, err := http.Get(server.URL + "?event_type=task_removed")
+				Expect(err).NotTo(HaveOccurred())
+				reader := sse.NewReadCloser(response.Body)
+
+				task := model_helpers.NewValidTask("task-guid")
+				taskHub.Emit(models.NewTaskCreatedEvent(task))
+
+				event := models.NewTaskRemovedEvent(task)
+				taskHub.Emit(event)
+
+				events := events.NewEventSource(reader)
+				actualEvent, err := events.Next()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actualEvent).To(Equal(event))
+			})
 		})
 	})
 
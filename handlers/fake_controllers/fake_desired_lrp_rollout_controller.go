@@ -0,0 +1,150 @@
+// This file was generated by counterfeiter
+package fake_controllers
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakeDesiredLRPRolloutController struct {
+	StartRolloutStub        func(logger lager.Logger, desiredLRP *models.DesiredLRP, maxInFlight int32) error
+	startRolloutMutex       sync.RWMutex
+	startRolloutArgsForCall []struct {
+		logger      lager.Logger
+		desiredLRP  *models.DesiredLRP
+		maxInFlight int32
+	}
+	startRolloutReturns struct {
+		result1 error
+	}
+	FailRolloutStub        func(logger lager.Logger, processGuid, reason string)
+	failRolloutMutex       sync.RWMutex
+	failRolloutArgsForCall []struct {
+		logger      lager.Logger
+		processGuid string
+		reason      string
+	}
+	CancelRolloutStub        func(logger lager.Logger, processGuid string)
+	cancelRolloutMutex       sync.RWMutex
+	cancelRolloutArgsForCall []struct {
+		logger      lager.Logger
+		processGuid string
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDesiredLRPRolloutController) StartRollout(logger lager.Logger, desiredLRP *models.DesiredLRP, maxInFlight int32) error {
+	fake.startRolloutMutex.Lock()
+	fake.startRolloutArgsForCall = append(fake.startRolloutArgsForCall, struct {
+		logger      lager.Logger
+		desiredLRP  *models.DesiredLRP
+		maxInFlight int32
+	}{logger, desiredLRP, maxInFlight})
+	fake.recordInvocation("StartRollout", []interface{}{logger, desiredLRP, maxInFlight})
+	fake.startRolloutMutex.Unlock()
+	if fake.StartRolloutStub != nil {
+		return fake.StartRolloutStub(logger, desiredLRP, maxInFlight)
+	} else {
+		return fake.startRolloutReturns.result1
+	}
+}
+
+func (fake *FakeDesiredLRPRolloutController) StartRolloutCallCount() int {
+	fake.startRolloutMutex.RLock()
+	defer fake.startRolloutMutex.RUnlock()
+	return len(fake.startRolloutArgsForCall)
+}
+
+func (fake *FakeDesiredLRPRolloutController) StartRolloutArgsForCall(i int) (lager.Logger, *models.DesiredLRP, int32) {
+	fake.startRolloutMutex.RLock()
+	defer fake.startRolloutMutex.RUnlock()
+	return fake.startRolloutArgsForCall[i].logger, fake.startRolloutArgsForCall[i].desiredLRP, fake.startRolloutArgsForCall[i].maxInFlight
+}
+
+func (fake *FakeDesiredLRPRolloutController) StartRolloutReturns(result1 error) {
+	fake.StartRolloutStub = nil
+	fake.startRolloutReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDesiredLRPRolloutController) FailRollout(logger lager.Logger, processGuid string, reason string) {
+	fake.failRolloutMutex.Lock()
+	fake.failRolloutArgsForCall = append(fake.failRolloutArgsForCall, struct {
+		logger      lager.Logger
+		processGuid string
+		reason      string
+	}{logger, processGuid, reason})
+	fake.recordInvocation("FailRollout", []interface{}{logger, processGuid, reason})
+	fake.failRolloutMutex.Unlock()
+	if fake.FailRolloutStub != nil {
+		fake.FailRolloutStub(logger, processGuid, reason)
+	}
+}
+
+func (fake *FakeDesiredLRPRolloutController) FailRolloutCallCount() int {
+	fake.failRolloutMutex.RLock()
+	defer fake.failRolloutMutex.RUnlock()
+	return len(fake.failRolloutArgsForCall)
+}
+
+func (fake *FakeDesiredLRPRolloutController) FailRolloutArgsForCall(i int) (lager.Logger, string, string) {
+	fake.failRolloutMutex.RLock()
+	defer fake.failRolloutMutex.RUnlock()
+	return fake.failRolloutArgsForCall[i].logger, fake.failRolloutArgsForCall[i].processGuid, fake.failRolloutArgsForCall[i].reason
+}
+
+func (fake *FakeDesiredLRPRolloutController) CancelRollout(logger lager.Logger, processGuid string) {
+	fake.cancelRolloutMutex.Lock()
+	fake.cancelRolloutArgsForCall = append(fake.cancelRolloutArgsForCall, struct {
+		logger      lager.Logger
+		processGuid string
+	}{logger, processGuid})
+	fake.recordInvocation("CancelRollout", []interface{}{logger, processGuid})
+	fake.cancelRolloutMutex.Unlock()
+	if fake.CancelRolloutStub != nil {
+		fake.CancelRolloutStub(logger, processGuid)
+	}
+}
+
+func (fake *FakeDesiredLRPRolloutController) CancelRolloutCallCount() int {
+	fake.cancelRolloutMutex.RLock()
+	defer fake.cancelRolloutMutex.RUnlock()
+	return len(fake.cancelRolloutArgsForCall)
+}
+
+func (fake *FakeDesiredLRPRolloutController) CancelRolloutArgsForCall(i int) (lager.Logger, string) {
+	fake.cancelRolloutMutex.RLock()
+	defer fake.cancelRolloutMutex.RUnlock()
+	return fake.cancelRolloutArgsForCall[i].logger, fake.cancelRolloutArgsForCall[i].processGuid
+}
+
+func (fake *FakeDesiredLRPRolloutController) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.startRolloutMutex.RLock()
+	defer fake.startRolloutMutex.RUnlock()
+	fake.failRolloutMutex.RLock()
+	defer fake.failRolloutMutex.RUnlock()
+	fake.cancelRolloutMutex.RLock()
+	defer fake.cancelRolloutMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeDesiredLRPRolloutController) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ handlers.DesiredLRPRolloutController = new(FakeDesiredLRPRolloutController)
@@ -11,16 +11,16 @@ import (
 )
 
 type FakeTaskController struct {
-	TasksStub        func(logger lager.Logger, domain, cellId string) ([]*models.Task, error)
+	TasksStub        func(logger lager.Logger, filter models.TaskFilter) (tasks []*models.Task, nextPageToken string, err error)
 	tasksMutex       sync.RWMutex
 	tasksArgsForCall []struct {
 		logger lager.Logger
-		domain string
-		cellId string
+		filter models.TaskFilter
 	}
 	tasksReturns struct {
 		result1 []*models.Task
-		result2 error
+		result2 string
+		result3 error
 	}
 	TaskByGuidStub        func(logger lager.Logger, taskGuid string) (*models.Task, error)
 	taskByGuidMutex       sync.RWMutex
@@ -119,19 +119,18 @@ type FakeTaskController struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeTaskController) Tasks(logger lager.Logger, domain string, cellId string) ([]*models.Task, error) {
+func (fake *FakeTaskController) Tasks(logger lager.Logger, filter models.TaskFilter) (tasks []*models.Task, nextPageToken string, err error) {
 	fake.tasksMutex.Lock()
 	fake.tasksArgsForCall = append(fake.tasksArgsForCall, struct {
 		logger lager.Logger
-		domain string
-		cellId string
-	}{logger, domain, cellId})
-	fake.recordInvocation("Tasks", []interface{}{logger, domain, cellId})
+		filter models.TaskFilter
+	}{logger, filter})
+	fake.recordInvocation("Tasks", []interface{}{logger, filter})
 	fake.tasksMutex.Unlock()
 	if fake.TasksStub != nil {
-		return fake.TasksStub(logger, domain, cellId)
+		return fake.TasksStub(logger, filter)
 	} else {
-		return fake.tasksReturns.result1, fake.tasksReturns.result2
+		return fake.tasksReturns.result1, fake.tasksReturns.result2, fake.tasksReturns.result3
 	}
 }
 
@@ -141,18 +140,19 @@ func (fake *FakeTaskController) TasksCallCount() int {
 	return len(fake.tasksArgsForCall)
 }
 
-func (fake *FakeTaskController) TasksArgsForCall(i int) (lager.Logger, string, string) {
+func (fake *FakeTaskController) TasksArgsForCall(i int) (lager.Logger, models.TaskFilter) {
 	fake.tasksMutex.RLock()
 	defer fake.tasksMutex.RUnlock()
-	return fake.tasksArgsForCall[i].logger, fake.tasksArgsForCall[i].domain, fake.tasksArgsForCall[i].cellId
+	return fake.tasksArgsForCall[i].logger, fake.tasksArgsForCall[i].filter
 }
 
-func (fake *FakeTaskController) TasksReturns(result1 []*models.Task, result2 error) {
+func (fake *FakeTaskController) TasksReturns(result1 []*models.Task, result2 string, result3 error) {
 	fake.TasksStub = nil
 	fake.tasksReturns = struct {
 		result1 []*models.Task
-		result2 error
-	}{result1, result2}
+		result2 string
+		result3 error
+	}{result1, result2, result3}
 }
 
 func (fake *FakeTaskController) TaskByGuid(logger lager.Logger, taskGuid string) (*models.Task, error) {
@@ -0,0 +1,493 @@
+package handlers
+
+import (
+	"bytes"
+	"crypto/tls"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+	"github.com/gogo/protobuf/proto"
+	"github.com/tedsuo/rata"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// GRPCServer implements the BBS and InternalBBS gRPC services. Unary calls are
+// dispatched in-process to the HTTP handler, so they go through the same
+// routing, middleware and controllers as the HTTP API. Event subscriptions are
+// served directly from the event hubs, restricted to the domains the client may
+// read when an authorizer is given.
+type GRPCServer struct {
+	logger           lager.Logger
+	handler          http.Handler
+	authorizer       *middleware.Authorizer
+	requestGenerator *rata.RequestGenerator
+	desiredHub       events.Hub
+	actualHub        events.Hub
+	taskHub          events.Hub
+	eventLog         events.EventLog
+	migrationsDone   <-chan struct{}
+}
+
+func NewGRPCServer(
+	logger lager.Logger,
+	handler http.Handler,
+	desiredHub, actualHub, taskHub events.Hub,
+	eventLog events.EventLog,
+	migrationsDone <-chan struct{},
+	authorizer *middleware.Authorizer,
+) *GRPCServer {
+	return &GRPCServer{
+		logger:           logger.Session("grpc-server"),
+		handler:          handler,
+		authorizer:       authorizer,
+		requestGenerator: rata.NewRequestGenerator("", bbs.Routes),
+		desiredHub:       desiredHub,
+		actualHub:        actualHub,
+		taskHub:          taskHub,
+		eventLog:         eventLog,
+		migrationsDone:   migrationsDone,
+	}
+}
+
+// call serves request through the HTTP route of the given name and decodes the
+// result into response. Failures reported by the handler in the response's
+// error field are not gRPC errors and are left for the caller to inspect.
+func (s *GRPCServer) call(ctx context.Context, route string, request, response proto.Message) error {
+	body, err := proto.Marshal(request)
+	if err != nil {
+		return grpc.Errorf(codes.InvalidArgument, "%s", err.Error())
+	}
+
+	req, err := s.requestGenerator.CreateRequest(route, nil, bytes.NewReader(body))
+	if err != nil {
+		s.logger.Error("failed-to-create-request", err, lager.Data{"route": route})
+		return grpc.Errorf(codes.Internal, "%s", err.Error())
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set(bbs.ContentTypeHeader, bbs.ProtoContentType)
+	if p, ok := peer.FromContext(ctx); ok {
+		req.RemoteAddr = p.Addr.String()
+		req.TLS = tlsState(p)
+	}
+
+	w := newResponseBuffer()
+	s.handler.ServeHTTP(w, req)
+
+	switch w.status {
+	case http.StatusOK:
+	case http.StatusServiceUnavailable:
+		return grpc.Errorf(codes.Unavailable, "service unavailable")
+	default:
+		return grpc.Errorf(codes.Internal, "unexpected status code %d", w.status)
+	}
+
+	err = proto.Unmarshal(w.body.Bytes(), response)
+	if err != nil {
+		s.logger.Error("failed-to-parse-response", err, lager.Data{"route": route})
+		return grpc.Errorf(codes.Internal, "%s", err.Error())
+	}
+
+	return nil
+}
+
+func tlsState(p *peer.Peer) *tls.ConnectionState {
+	if info, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+		return &info.State
+	}
+	return nil
+}
+
+// responseBuffer is the http.ResponseWriter handed to the HTTP handler by
+// unary gRPC calls.
+type responseBuffer struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func newResponseBuffer() *responseBuffer {
+	return &responseBuffer{header: http.Header{}}
+}
+
+func (w *responseBuffer) Header() http.Header {
+	return w.header
+}
+
+func (w *responseBuffer) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *responseBuffer) Write(data []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	return w.body.Write(data)
+}
+
+// Public API
+
+func (s *GRPCServer) Ping(ctx context.Context, request *models.PingRequest) (*models.PingResponse, error) {
+	response := &models.PingResponse{}
+	return response, s.call(ctx, bbs.PingRoute, request, response)
+}
+
+func (s *GRPCServer) Domains(ctx context.Context, request *models.DomainsRequest) (*models.DomainsResponse, error) {
+	response := &models.DomainsResponse{}
+	return response, s.call(ctx, bbs.DomainsRoute, request, response)
+}
+
+func (s *GRPCServer) UpsertDomain(ctx context.Context, request *models.UpsertDomainRequest) (*models.UpsertDomainResponse, error) {
+	response := &models.UpsertDomainResponse{}
+	return response, s.call(ctx, bbs.UpsertDomainRoute, request, response)
+}
+
+func (s *GRPCServer) ActualLRPGroups(ctx context.Context, request *models.ActualLRPGroupsRequest) (*models.ActualLRPGroupsResponse, error) {
+	response := &models.ActualLRPGroupsResponse{}
+	return response, s.call(ctx, bbs.ActualLRPGroupsRoute, request, response)
+}
+
+func (s *GRPCServer) ActualLRPGroupsByProcessGuid(ctx context.Context, request *models.ActualLRPGroupsByProcessGuidRequest) (*models.ActualLRPGroupsResponse, error) {
+	response := &models.ActualLRPGroupsResponse{}
+	return response, s.call(ctx, bbs.ActualLRPGroupsByProcessGuidRoute, request, response)
+}
+
+func (s *GRPCServer) ActualLRPGroupByProcessGuidAndIndex(ctx context.Context, request *models.ActualLRPGroupByProcessGuidAndIndexRequest) (*models.ActualLRPGroupResponse, error) {
+	response := &models.ActualLRPGroupResponse{}
+	return response, s.call(ctx, bbs.ActualLRPGroupByProcessGuidAndIndexRoute, request, response)
+}
+
+func (s *GRPCServer) RetireActualLRP(ctx context.Context, request *models.RetireActualLRPRequest) (*models.ActualLRPLifecycleResponse, error) {
+	response := &models.ActualLRPLifecycleResponse{}
+	return response, s.call(ctx, bbs.RetireActualLRPRoute, request, response)
+}
+
+func (s *GRPCServer) DesiredLRPs(ctx context.Context, request *models.DesiredLRPsRequest) (*models.DesiredLRPsResponse, error) {
+	response := &models.DesiredLRPsResponse{}
+	return response, s.call(ctx, bbs.DesiredLRPsRoute, request, response)
+}
+
+func (s *GRPCServer) DesiredLRPByProcessGuid(ctx context.Context, request *models.DesiredLRPByProcessGuidRequest) (*models.DesiredLRPResponse, error) {
+	response := &models.DesiredLRPResponse{}
+	return response, s.call(ctx, bbs.DesiredLRPByProcessGuidRoute, request, response)
+}
+
+func (s *GRPCServer) DesiredLRPSchedulingInfos(ctx context.Context, request *models.DesiredLRPsRequest) (*models.DesiredLRPSchedulingInfosResponse, error) {
+	response := &models.DesiredLRPSchedulingInfosResponse{}
+	return response, s.call(ctx, bbs.DesiredLRPSchedulingInfosRoute, request, response)
+}
+
+func (s *GRPCServer) DesireLRP(ctx context.Context, request *models.DesireLRPRequest) (*models.DesiredLRPLifecycleResponse, error) {
+	response := &models.DesiredLRPLifecycleResponse{}
+	return response, s.call(ctx, bbs.DesireDesiredLRPRoute, request, response)
+}
+
+func (s *GRPCServer) DesireLRPs(ctx context.Context, request *models.DesireLRPsRequest) (*models.DesiredLRPsLifecycleResponse, error) {
+	response := &models.DesiredLRPsLifecycleResponse{}
+	return response, s.call(ctx, bbs.DesireDesiredLRPsRoute, request, response)
+}
+
+func (s *GRPCServer) UpdateDesiredLRP(ctx context.Context, request *models.UpdateDesiredLRPRequest) (*models.DesiredLRPLifecycleResponse, error) {
+	response := &models.DesiredLRPLifecycleResponse{}
+	return response, s.call(ctx, bbs.UpdateDesiredLRPRoute, request, response)
+}
+
+func (s *GRPCServer) RemoveDesiredLRP(ctx context.Context, request *models.RemoveDesiredLRPRequest) (*models.DesiredLRPLifecycleResponse, error) {
+	response := &models.DesiredLRPLifecycleResponse{}
+	return response, s.call(ctx, bbs.RemoveDesiredLRPRoute, request, response)
+}
+
+func (s *GRPCServer) RemoveDesiredLRPs(ctx context.Context, request *models.RemoveDesiredLRPsRequest) (*models.DesiredLRPsLifecycleResponse, error) {
+	response := &models.DesiredLRPsLifecycleResponse{}
+	return response, s.call(ctx, bbs.RemoveDesiredLRPsRoute, request, response)
+}
+
+func (s *GRPCServer) RedeployDesiredLRP(ctx context.Context, request *models.RedeployDesiredLRPRequest) (*models.DesiredLRPLifecycleResponse, error) {
+	response := &models.DesiredLRPLifecycleResponse{}
+	return response, s.call(ctx, bbs.RedeployDesiredLRPRoute, request, response)
+}
+
+func (s *GRPCServer) ApplyDesiredLRPs(ctx context.Context, request *models.ApplyDesiredLRPsRequest) (*models.ApplyDesiredLRPsResponse, error) {
+	response := &models.ApplyDesiredLRPsResponse{}
+	return response, s.call(ctx, bbs.ApplyDesiredLRPsRoute, request, response)
+}
+
+func (s *GRPCServer) DesiredLRPRevisions(ctx context.Context, request *models.DesiredLRPRevisionsRequest) (*models.DesiredLRPRevisionsResponse, error) {
+	response := &models.DesiredLRPRevisionsResponse{}
+	return response, s.call(ctx, bbs.DesiredLRPRevisionsRoute, request, response)
+}
+
+func (s *GRPCServer) DesiredLRPRevision(ctx context.Context, request *models.DesiredLRPRevisionRequest) (*models.DesiredLRPRevisionResponse, error) {
+	response := &models.DesiredLRPRevisionResponse{}
+	return response, s.call(ctx, bbs.DesiredLRPRevisionRoute, request, response)
+}
+
+func (s *GRPCServer) DesiredLRPRollout(ctx context.Context, request *models.DesiredLRPRolloutRequest) (*models.DesiredLRPRolloutResponse, error) {
+	response := &models.DesiredLRPRolloutResponse{}
+	return response, s.call(ctx, bbs.DesiredLRPRolloutRoute, request, response)
+}
+
+func (s *GRPCServer) RollbackDesiredLRP(ctx context.Context, request *models.RollbackDesiredLRPRequest) (*models.DesiredLRPLifecycleResponse, error) {
+	response := &models.DesiredLRPLifecycleResponse{}
+	return response, s.call(ctx, bbs.RollbackDesiredLRPRoute, request, response)
+}
+
+func (s *GRPCServer) Tasks(ctx context.Context, request *models.TasksRequest) (*models.TasksResponse, error) {
+	response := &models.TasksResponse{}
+	return response, s.call(ctx, bbs.TasksRoute, request, response)
+}
+
+func (s *GRPCServer) TaskByGuid(ctx context.Context, request *models.TaskByGuidRequest) (*models.TaskResponse, error) {
+	response := &models.TaskResponse{}
+	return response, s.call(ctx, bbs.TaskByGuidRoute, request, response)
+}
+
+func (s *GRPCServer) DesireTask(ctx context.Context, request *models.DesireTaskRequest) (*models.TaskLifecycleResponse, error) {
+	response := &models.TaskLifecycleResponse{}
+	return response, s.call(ctx, bbs.DesireTaskRoute, request, response)
+}
+
+func (s *GRPCServer) DesireTasks(ctx context.Context, request *models.DesireTasksRequest) (*models.TasksLifecycleResponse, error) {
+	response := &models.TasksLifecycleResponse{}
+	return response, s.call(ctx, bbs.DesireTasksRoute, request, response)
+}
+
+func (s *GRPCServer) CancelTask(ctx context.Context, request *models.TaskGuidRequest) (*models.TaskLifecycleResponse, error) {
+	response := &models.TaskLifecycleResponse{}
+	return response, s.call(ctx, bbs.CancelTaskRoute, request, response)
+}
+
+func (s *GRPCServer) ResolvingTask(ctx context.Context, request *models.TaskGuidRequest) (*models.TaskLifecycleResponse, error) {
+	response := &models.TaskLifecycleResponse{}
+	return response, s.call(ctx, bbs.ResolvingTaskRoute, request, response)
+}
+
+func (s *GRPCServer) DeleteTask(ctx context.Context, request *models.TaskGuidRequest) (*models.TaskLifecycleResponse, error) {
+	response := &models.TaskLifecycleResponse{}
+	return response, s.call(ctx, bbs.DeleteTaskRoute, request, response)
+}
+
+func (s *GRPCServer) CancelTasks(ctx context.Context, request *models.TaskGuidsRequest) (*models.TasksLifecycleResponse, error) {
+	response := &models.TasksLifecycleResponse{}
+	return response, s.call(ctx, bbs.CancelTasksRoute, request, response)
+}
+
+func (s *GRPCServer) DeleteTasks(ctx context.Context, request *models.TaskGuidsRequest) (*models.TasksLifecycleResponse, error) {
+	response := &models.TasksLifecycleResponse{}
+	return response, s.call(ctx, bbs.DeleteTasksRoute, request, response)
+}
+
+func (s *GRPCServer) Cells(ctx context.Context, request *models.CellsRequest) (*models.CellsResponse, error) {
+	response := &models.CellsResponse{}
+	return response, s.call(ctx, bbs.CellsRoute, request, response)
+}
+
+func (s *GRPCServer) AuditEvents(ctx context.Context, request *models.AuditEventsRequest) (*models.AuditEventsResponse, error) {
+	response := &models.AuditEventsResponse{}
+	return response, s.call(ctx, bbs.AuditEventsRoute, request, response)
+}
+
+func (s *GRPCServer) QuarantinedRecords(ctx context.Context, request *models.QuarantinedRecordsRequest) (*models.QuarantinedRecordsResponse, error) {
+	response := &models.QuarantinedRecordsResponse{}
+	return response, s.call(ctx, bbs.QuarantinedRecordsRoute, request, response)
+}
+
+func (s *GRPCServer) QuarantinedRecord(ctx context.Context, request *models.QuarantinedRecordRequest) (*models.QuarantinedRecordResponse, error) {
+	response := &models.QuarantinedRecordResponse{}
+	return response, s.call(ctx, bbs.QuarantinedRecordRoute, request, response)
+}
+
+func (s *GRPCServer) RestoreQuarantinedRecord(ctx context.Context, request *models.RestoreQuarantinedRecordRequest) (*models.RestoreQuarantinedRecordResponse, error) {
+	response := &models.RestoreQuarantinedRecordResponse{}
+	return response, s.call(ctx, bbs.RestoreQuarantinedRecordRoute, request, response)
+}
+
+func (s *GRPCServer) RestoreQuarantinedRecords(ctx context.Context, request *models.RestoreQuarantinedRecordsRequest) (*models.RestoreQuarantinedRecordsResponse, error) {
+	response := &models.RestoreQuarantinedRecordsResponse{}
+	return response, s.call(ctx, bbs.RestoreQuarantinedRecordsRoute, request, response)
+}
+
+func (s *GRPCServer) EncryptionProgress(ctx context.Context, request *models.EncryptionProgressRequest) (*models.EncryptionProgressResponse, error) {
+	response := &models.EncryptionProgressResponse{}
+	return response, s.call(ctx, bbs.EncryptionProgressRoute, request, response)
+}
+
+func (s *GRPCServer) VerifyEncryptionKeyRetired(ctx context.Context, request *models.VerifyEncryptionKeyRetiredRequest) (*models.VerifyEncryptionKeyRetiredResponse, error) {
+	response := &models.VerifyEncryptionKeyRetiredResponse{}
+	return response, s.call(ctx, bbs.VerifyEncryptionKeyRetiredRoute, request, response)
+}
+
+func (s *GRPCServer) SubscribeToEvents(request *models.EventsRequest, stream models.BBS_SubscribeToEventsServer) error {
+	logger := s.logger.Session("subscribe")
+	return s.subscribe(logger, request, stream, lrpEventTypes, s.desiredHub, s.actualHub)
+}
+
+func (s *GRPCServer) SubscribeToTaskEvents(request *models.EventsRequest, stream models.BBS_SubscribeToTaskEventsServer) error {
+	logger := s.logger.Session("tasks-subscribe")
+	return s.subscribe(logger, request, stream, taskEventTypes, s.taskHub)
+}
+
+// Internal API
+
+func (s *GRPCServer) ClaimActualLRP(ctx context.Context, request *models.ClaimActualLRPRequest) (*models.ActualLRPLifecycleResponse, error) {
+	response := &models.ActualLRPLifecycleResponse{}
+	return response, s.call(ctx, bbs.ClaimActualLRPRoute, request, response)
+}
+
+func (s *GRPCServer) StartActualLRP(ctx context.Context, request *models.StartActualLRPRequest) (*models.ActualLRPLifecycleResponse, error) {
+	response := &models.ActualLRPLifecycleResponse{}
+	return response, s.call(ctx, bbs.StartActualLRPRoute, request, response)
+}
+
+func (s *GRPCServer) CrashActualLRP(ctx context.Context, request *models.CrashActualLRPRequest) (*models.ActualLRPLifecycleResponse, error) {
+	response := &models.ActualLRPLifecycleResponse{}
+	return response, s.call(ctx, bbs.CrashActualLRPRoute, request, response)
+}
+
+func (s *GRPCServer) FailActualLRP(ctx context.Context, request *models.FailActualLRPRequest) (*models.ActualLRPLifecycleResponse, error) {
+	response := &models.ActualLRPLifecycleResponse{}
+	return response, s.call(ctx, bbs.FailActualLRPRoute, request, response)
+}
+
+func (s *GRPCServer) RemoveActualLRP(ctx context.Context, request *models.RemoveActualLRPRequest) (*models.ActualLRPLifecycleResponse, error) {
+	response := &models.ActualLRPLifecycleResponse{}
+	return response, s.call(ctx, bbs.RemoveActualLRPRoute, request, response)
+}
+
+func (s *GRPCServer) EvacuateClaimedActualLRP(ctx context.Context, request *models.EvacuateClaimedActualLRPRequest) (*models.EvacuationResponse, error) {
+	response := &models.EvacuationResponse{}
+	return response, s.call(ctx, bbs.EvacuateClaimedActualLRPRoute, request, response)
+}
+
+func (s *GRPCServer) EvacuateRunningActualLRP(ctx context.Context, request *models.EvacuateRunningActualLRPRequest) (*models.EvacuationResponse, error) {
+	response := &models.EvacuationResponse{}
+	return response, s.call(ctx, bbs.EvacuateRunningActualLRPRoute, request, response)
+}
+
+func (s *GRPCServer) EvacuateStoppedActualLRP(ctx context.Context, request *models.EvacuateStoppedActualLRPRequest) (*models.EvacuationResponse, error) {
+	response := &models.EvacuationResponse{}
+	return response, s.call(ctx, bbs.EvacuateStoppedActualLRPRoute, request, response)
+}
+
+func (s *GRPCServer) EvacuateCrashedActualLRP(ctx context.Context, request *models.EvacuateCrashedActualLRPRequest) (*models.EvacuationResponse, error) {
+	response := &models.EvacuationResponse{}
+	return response, s.call(ctx, bbs.EvacuateCrashedActualLRPRoute, request, response)
+}
+
+func (s *GRPCServer) RemoveEvacuatingActualLRP(ctx context.Context, request *models.RemoveEvacuatingActualLRPRequest) (*models.RemoveEvacuatingActualLRPResponse, error) {
+	response := &models.RemoveEvacuatingActualLRPResponse{}
+	return response, s.call(ctx, bbs.RemoveEvacuatingActualLRPRoute, request, response)
+}
+
+func (s *GRPCServer) StartTask(ctx context.Context, request *models.StartTaskRequest) (*models.StartTaskResponse, error) {
+	response := &models.StartTaskResponse{}
+	return response, s.call(ctx, bbs.StartTaskRoute, request, response)
+}
+
+func (s *GRPCServer) FailTask(ctx context.Context, request *models.FailTaskRequest) (*models.TaskLifecycleResponse, error) {
+	response := &models.TaskLifecycleResponse{}
+	return response, s.call(ctx, bbs.FailTaskRoute, request, response)
+}
+
+func (s *GRPCServer) CompleteTask(ctx context.Context, request *models.CompleteTaskRequest) (*models.TaskLifecycleResponse, error) {
+	response := &models.TaskLifecycleResponse{}
+	return response, s.call(ctx, bbs.CompleteTaskRoute, request, response)
+}
+
+type eventEnvelopeStream interface {
+	Send(*models.EventEnvelope) error
+	Context() context.Context
+}
+
+// subscribe streams the events from hubs matching the request until the client
+// goes away or the hubs are closed. Like the HTTP event streams, it replays the
+// events following the request's last_event_id before the live ones.
+func (s *GRPCServer) subscribe(logger lager.Logger, request *models.EventsRequest, stream eventEnvelopeStream, eventTypes []string, hubs ...events.Hub) error {
+	select {
+	case <-s.migrationsDone:
+	default:
+		return grpc.Errorf(codes.Unavailable, "service unavailable")
+	}
+
+	filter := models.NewEventFilterFromRequest(request)
+
+	if s.authorizer != nil {
+		var state *tls.ConnectionState
+		if p, ok := peer.FromContext(stream.Context()); ok {
+			state = tlsState(p)
+		}
+		if !s.authorizer.Permits(state, middleware.PermissionRead) {
+			logger.Info("unauthorized")
+			return grpc.Errorf(codes.PermissionDenied, "%s", models.ErrUnauthorized.Message)
+		}
+		filter.AllowedDomains = s.authorizer.DomainScope(state, middleware.PermissionRead).Domains()
+	}
+
+	source, err := events.SubscribeToHubs(filter, hubs...)
+	if err != nil {
+		logger.Error("failed-to-subscribe-to-event-hubs", err)
+		return grpc.Errorf(codes.Unavailable, "%s", err.Error())
+	}
+	defer source.Close()
+
+	var lastEventID string
+	if request.LastEventId != 0 {
+		lastEventID = strconv.FormatUint(request.LastEventId, 10)
+	}
+	replay := replayEvents(logger, lastEventID, s.eventLog, filter, eventTypes)
+
+	eventChan := make(chan events.SequencedEvent)
+	errorChan := make(chan error)
+	closeChan := make(chan struct{})
+	defer close(closeChan)
+
+	go streamSource(eventChan, errorChan, closeChan, source.NextSequenced)
+
+	send := func(event events.SequencedEvent) error {
+		envelope, err := events.NewEnvelopeFromModelEvent(event.ID, event.Event)
+		if err != nil {
+			logger.Error("failed-to-marshal-event", err)
+			return grpc.Errorf(codes.Internal, "%s", err.Error())
+		}
+		return stream.Send(envelope)
+	}
+
+	var replayedThrough uint64
+	replayed := false
+	for _, event := range replay {
+		if err := send(event); err != nil {
+			return err
+		}
+
+		replayedThrough = event.ID
+		replayed = true
+	}
+
+	var event events.SequencedEvent
+	for {
+		select {
+		case event = <-eventChan:
+		case err := <-errorChan:
+			logger.Error("failed-to-get-next-event", err)
+			return grpc.Errorf(codes.Unavailable, "%s", err.Error())
+		case <-stream.Context().Done():
+			return nil
+		}
+
+		if replayed && event.ID <= replayedThrough {
+			continue
+		}
+
+		if err := send(event); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,250 @@
+package handlers_test
+
+import (
+	"io/ioutil"
+	"net"
+	"net/http"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GRPCServer", func() {
+	var (
+		logger         *lagertest.TestLogger
+		eventLog       events.EventLog
+		desiredHub     events.Hub
+		actualHub      events.Hub
+		taskHub        events.Hub
+		migrationsDone chan struct{}
+		authorizer     *middleware.Authorizer
+
+		httpRequests chan *http.Request
+		httpStatus   int
+		httpResponse proto.Message
+
+		server     *grpc.Server
+		conn       *grpc.ClientConn
+		client     models.BBSClient
+		internal   models.InternalBBSClient
+		requestCtx context.Context
+		cancel     context.CancelFunc
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		eventLog = events.NewEventLog(100, 1)
+		desiredHub = events.NewHubWithLog(eventLog)
+		actualHub = events.NewHubWithLog(eventLog)
+		taskHub = events.NewHubWithLog(eventLog)
+		migrationsDone = make(chan struct{})
+		close(migrationsDone)
+		authorizer = nil
+
+		httpRequests = make(chan *http.Request, 1)
+		httpStatus = http.StatusOK
+		httpResponse = &models.PingResponse{Available: true}
+
+		requestCtx, cancel = context.WithCancel(context.Background())
+	})
+
+	JustBeforeEach(func() {
+		grpcServer := handlers.NewGRPCServer(logger, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			httpRequests <- req
+			w.WriteHeader(httpStatus)
+			data, err := proto.Marshal(httpResponse)
+			Expect(err).NotTo(HaveOccurred())
+			w.Write(data)
+		}), desiredHub, actualHub, taskHub, eventLog, migrationsDone, authorizer)
+
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+
+		server = grpc.NewServer()
+		models.RegisterBBSServer(server, grpcServer)
+		models.RegisterInternalBBSServer(server, grpcServer)
+		go server.Serve(listener)
+
+		conn, err = grpc.Dial(listener.Addr().String(), grpc.WithInsecure())
+		Expect(err).NotTo(HaveOccurred())
+		client = models.NewBBSClient(conn)
+		internal = models.NewInternalBBSClient(conn)
+	})
+
+	AfterEach(func() {
+		cancel()
+		conn.Close()
+		server.Stop()
+		desiredHub.Close()
+		actualHub.Close()
+		taskHub.Close()
+	})
+
+	waitForSubscriber := func(hub events.Hub) {
+		counts := make(chan int, 10)
+		hub.RegisterCallback(func(count int) { counts <- count })
+		Eventually(counts).Should(Receive(Equal(1)))
+	}
+
+	Describe("unary calls", func() {
+		It("dispatches the request to the matching HTTP route", func() {
+			response, err := client.Ping(requestCtx, &models.PingRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Available).To(BeTrue())
+
+			var req *http.Request
+			Eventually(httpRequests).Should(Receive(&req))
+			Expect(req.Method).To(Equal("POST"))
+			Expect(req.URL.Path).To(Equal("/v1/ping"))
+			Expect(req.Header.Get(bbs.ContentTypeHeader)).To(Equal(bbs.ProtoContentType))
+		})
+
+		It("sends the protobuf encoded request as the body", func() {
+			httpResponse = &models.ActualLRPLifecycleResponse{}
+			request := &models.ClaimActualLRPRequest{ProcessGuid: "process-guid", Index: 3}
+
+			_, err := internal.ClaimActualLRP(requestCtx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			var req *http.Request
+			Eventually(httpRequests).Should(Receive(&req))
+			Expect(req.URL.Path).To(Equal("/v1/actual_lrps/claim"))
+
+			data, err := ioutil.ReadAll(req.Body)
+			Expect(err).NotTo(HaveOccurred())
+			received := &models.ClaimActualLRPRequest{}
+			Expect(proto.Unmarshal(data, received)).To(Succeed())
+			Expect(received).To(Equal(request))
+		})
+
+		Context("when the handler responds with an error", func() {
+			BeforeEach(func() {
+				httpResponse = &models.TaskLifecycleResponse{Error: models.ErrResourceNotFound}
+			})
+
+			It("returns it in the response", func() {
+				response, err := client.CancelTask(requestCtx, &models.TaskGuidRequest{TaskGuid: "task-guid"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+
+		Context("when the service is unavailable", func() {
+			BeforeEach(func() {
+				httpStatus = http.StatusServiceUnavailable
+			})
+
+			It("returns an Unavailable error", func() {
+				_, err := client.Ping(requestCtx, &models.PingRequest{})
+				Expect(grpc.Code(err)).To(Equal(codes.Unavailable))
+			})
+		})
+	})
+
+	Describe("SubscribeToEvents", func() {
+		var (
+			request *models.EventsRequest
+			stream  models.BBS_SubscribeToEventsClient
+			err     error
+		)
+
+		BeforeEach(func() {
+			request = &models.EventsRequest{}
+		})
+
+		JustBeforeEach(func() {
+			stream, err = client.SubscribeToEvents(requestCtx, request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		recv := func() models.Event {
+			envelope, err := stream.Recv()
+			Expect(err).NotTo(HaveOccurred())
+			event, err := events.NewEventFromEnvelope(envelope)
+			Expect(err).NotTo(HaveOccurred())
+			return event
+		}
+
+		It("streams the LRP events from the hubs", func() {
+			desiredLRP := model_helpers.NewValidDesiredLRP("some-guid")
+
+			waitForSubscriber(desiredHub)
+			desiredHub.Emit(models.NewDesiredLRPCreatedEvent(desiredLRP))
+
+			Expect(recv()).To(Equal(models.NewDesiredLRPCreatedEvent(desiredLRP)))
+		})
+
+		Context("when resuming from a previous event", func() {
+			var desiredLRP *models.DesiredLRP
+
+			BeforeEach(func() {
+				desiredLRP = model_helpers.NewValidDesiredLRP("some-guid")
+				desiredHub.Emit(models.NewDesiredLRPCreatedEvent(desiredLRP))
+				desiredHub.Emit(models.NewDesiredLRPRemovedEvent(desiredLRP))
+
+				request.LastEventId = 1
+			})
+
+			It("replays the missed events", func() {
+				Expect(recv()).To(Equal(models.NewDesiredLRPRemovedEvent(desiredLRP)))
+			})
+		})
+
+		Context("when migrations have not finished", func() {
+			BeforeEach(func() {
+				migrationsDone = make(chan struct{})
+			})
+
+			It("returns an Unavailable error", func() {
+				_, err := stream.Recv()
+				Expect(grpc.Code(err)).To(Equal(codes.Unavailable))
+			})
+		})
+
+		Context("when the client is not authorized to read", func() {
+			BeforeEach(func() {
+				var err error
+				authorizer, err = middleware.NewAuthorizer(logger, []middleware.RoleMapping{
+					{Role: middleware.RoleReadOnly, CommonNames: []string{"dashboard"}},
+				})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns a PermissionDenied error", func() {
+				_, err := stream.Recv()
+				Expect(grpc.Code(err)).To(Equal(codes.PermissionDenied))
+			})
+		})
+	})
+
+	Describe("SubscribeToTaskEvents", func() {
+		It("streams the task events from the task hub", func() {
+			stream, err := client.SubscribeToTaskEvents(requestCtx, &models.EventsRequest{})
+			Expect(err).NotTo(HaveOccurred())
+
+			task := model_helpers.NewValidTask("task-guid")
+			waitForSubscriber(taskHub)
+			taskHub.Emit(models.NewTaskCreatedEvent(task))
+
+			envelope, err := stream.Recv()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(envelope.Type).To(Equal(models.EventTypeTaskCreated))
+
+			event, err := events.NewEventFromEnvelope(envelope)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(event).To(Equal(models.NewTaskCreatedEvent(task)))
+		})
+	})
+})
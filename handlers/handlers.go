@@ -1,9 +1,9 @@
 package handlers
 
 import (
+	"encoding/json"
 	"io/ioutil"
 	"net/http"
-	"strconv"
 
 	"code.cloudfoundry.org/auctioneer"
 	"code.cloudfoundry.org/bbs"
@@ -13,106 +13,174 @@ import (
 	"code.cloudfoundry.org/bbs/handlers/middleware"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/taskworkpool"
+	"code.cloudfoundry.org/bbs/tracedclients"
+	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
-	"code.cloudfoundry.org/rep"
+	"code.cloudfoundry.org/workpool"
 	"github.com/gogo/protobuf/proto"
 	"github.com/tedsuo/rata"
 )
 
 func New(
-	logger, accessLogger lager.Logger,
+	logger lager.Logger,
+	accessLogger *middleware.AccessLogger,
 	updateWorkers int,
+	maxBulkItems int,
 	convergenceWorkersSize int,
 	db db.DB,
-	desiredHub, actualHub events.Hub,
+	desiredLRPRevisionDB db.DesiredLRPRevisionDB,
+	quarantineDB db.QuarantineDB,
+	encryptionProgressDB db.EncryptionProgressDB,
+	desiredHub, actualHub, taskHub events.Hub,
+	eventLog events.EventLog,
 	taskCompletionClient taskworkpool.TaskCompletionClient,
 	serviceClient bbs.ServiceClient,
 	auctioneerClient auctioneer.Client,
-	repClientFactory rep.ClientFactory,
+	repClientFactory tracedclients.RepClientFactory,
+	desiredLRPRolloutController DesiredLRPRolloutController,
+	clock clock.Clock,
 	migrationsDone <-chan struct{},
 	exitChan chan struct{},
+	authorizer *middleware.Authorizer,
+	auditor *Auditor,
 ) http.Handler {
 	pingHandler := NewPingHandler()
 	domainHandler := NewDomainHandler(db, exitChan)
 	actualLRPHandler := NewActualLRPHandler(db, exitChan)
 	actualLRPController := controllers.NewActualLRPLifecycleController(db, db, db, auctioneerClient, serviceClient, repClientFactory, actualHub)
-	actualLRPLifecycleHandler := NewActualLRPLifecycleHandler(actualLRPController, exitChan)
+	actualLRPLifecycleHandler := NewActualLRPLifecycleHandler(db, actualLRPController, exitChan)
 	evacuationHandler := NewEvacuationHandler(db, db, db, actualHub, auctioneerClient, exitChan)
-	desiredLRPHandler := NewDesiredLRPHandler(updateWorkers, db, db, desiredHub, actualHub, auctioneerClient, repClientFactory, serviceClient, exitChan)
-	taskController := controllers.NewTaskController(db, taskCompletionClient, auctioneerClient, serviceClient, repClientFactory)
-	taskHandler := NewTaskHandler(taskController, exitChan)
-	eventsHandler := NewEventHandler(desiredHub, actualHub)
+	desiredLRPHandler := NewDesiredLRPHandler(updateWorkers, maxBulkItems, db, db, desiredLRPRevisionDB, db, desiredHub, actualHub, auctioneerClient, repClientFactory, serviceClient, desiredLRPRolloutController, clock, exitChan)
+	taskController := controllers.NewTaskController(db, taskCompletionClient, auctioneerClient, serviceClient, repClientFactory, taskHub)
+	taskHandler := NewTaskHandler(updateWorkers, maxBulkItems, taskController, exitChan)
+	eventsHandler := NewEventHandler(desiredHub, actualHub, eventLog)
+	taskEventsHandler := NewTaskEventHandler(taskHub, eventLog)
 	cellsHandler := NewCellHandler(serviceClient, exitChan)
+	quarantineHandler := NewQuarantineHandler(quarantineDB, exitChan)
+	encryptionHandler := NewEncryptionHandler(db, encryptionProgressDB, exitChan)
+
+	auditHandler := NewAuditHandler(nil, exitChan)
+	if auditor != nil {
+		auditHandler = NewAuditHandler(auditor.auditDB, exitChan)
+	}
 
 	emitter := middleware.NewLatencyEmitter(logger)
 
 	actions := rata.Handlers{
 		// Ping
-		bbs.PingRoute: emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, pingHandler.Ping)),
+		bbs.PingRoute: emitter.EmitLatency(middleware.LogWrap(logger, pingHandler.Ping)),
 
 		// Domains
-		bbs.DomainsRoute:      route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, domainHandler.Domains))),
-		bbs.UpsertDomainRoute: route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, domainHandler.Upsert))),
+		bbs.DomainsRoute:      route(emitter.EmitLatency(middleware.LogWrap(logger, domainHandler.Domains))),
+		bbs.UpsertDomainRoute: route(emitter.EmitLatency(middleware.LogWrap(logger, domainHandler.Upsert))),
 
 		// Actual LRPs
-		bbs.ActualLRPGroupsRoute:                     route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, actualLRPHandler.ActualLRPGroups))),
-		bbs.ActualLRPGroupsByProcessGuidRoute:        route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, actualLRPHandler.ActualLRPGroupsByProcessGuid))),
-		bbs.ActualLRPGroupByProcessGuidAndIndexRoute: route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, actualLRPHandler.ActualLRPGroupByProcessGuidAndIndex))),
+		bbs.ActualLRPGroupsRoute:                     route(emitter.EmitLatency(middleware.LogWrap(logger, actualLRPHandler.ActualLRPGroups))),
+		bbs.ActualLRPGroupsByProcessGuidRoute:        route(emitter.EmitLatency(middleware.LogWrap(logger, actualLRPHandler.ActualLRPGroupsByProcessGuid))),
+		bbs.ActualLRPGroupByProcessGuidAndIndexRoute: route(emitter.EmitLatency(middleware.LogWrap(logger, actualLRPHandler.ActualLRPGroupByProcessGuidAndIndex))),
 
 		// Actual LRP Lifecycle
-		bbs.ClaimActualLRPRoute:  route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.ClaimActualLRP))),
-		bbs.StartActualLRPRoute:  route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.StartActualLRP))),
-		bbs.CrashActualLRPRoute:  route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.CrashActualLRP))),
-		bbs.RetireActualLRPRoute: route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.RetireActualLRP))),
-		bbs.FailActualLRPRoute:   route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.FailActualLRP))),
-		bbs.RemoveActualLRPRoute: route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.RemoveActualLRP))),
+		bbs.ClaimActualLRPRoute:  route(emitter.EmitLatency(middleware.LogWrap(logger, actualLRPLifecycleHandler.ClaimActualLRP))),
+		bbs.StartActualLRPRoute:  route(emitter.EmitLatency(middleware.LogWrap(logger, actualLRPLifecycleHandler.StartActualLRP))),
+		bbs.CrashActualLRPRoute:  route(emitter.EmitLatency(middleware.LogWrap(logger, actualLRPLifecycleHandler.CrashActualLRP))),
+		bbs.RetireActualLRPRoute: route(emitter.EmitLatency(middleware.LogWrap(logger, actualLRPLifecycleHandler.RetireActualLRP))),
+		bbs.FailActualLRPRoute:   route(emitter.EmitLatency(middleware.LogWrap(logger, actualLRPLifecycleHandler.FailActualLRP))),
+		bbs.RemoveActualLRPRoute: route(emitter.EmitLatency(middleware.LogWrap(logger, actualLRPLifecycleHandler.RemoveActualLRP))),
 
 		// Evacuation
-		bbs.RemoveEvacuatingActualLRPRoute: route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, evacuationHandler.RemoveEvacuatingActualLRP))),
-		bbs.EvacuateClaimedActualLRPRoute:  route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, evacuationHandler.EvacuateClaimedActualLRP))),
-		bbs.EvacuateCrashedActualLRPRoute:  route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, evacuationHandler.EvacuateCrashedActualLRP))),
-		bbs.EvacuateStoppedActualLRPRoute:  route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, evacuationHandler.EvacuateStoppedActualLRP))),
-		bbs.EvacuateRunningActualLRPRoute:  route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, evacuationHandler.EvacuateRunningActualLRP))),
+		bbs.RemoveEvacuatingActualLRPRoute: route(emitter.EmitLatency(middleware.LogWrap(logger, evacuationHandler.RemoveEvacuatingActualLRP))),
+		bbs.EvacuateClaimedActualLRPRoute:  route(emitter.EmitLatency(middleware.LogWrap(logger, evacuationHandler.EvacuateClaimedActualLRP))),
+		bbs.EvacuateCrashedActualLRPRoute:  route(emitter.EmitLatency(middleware.LogWrap(logger, evacuationHandler.EvacuateCrashedActualLRP))),
+		bbs.EvacuateStoppedActualLRPRoute:  route(emitter.EmitLatency(middleware.LogWrap(logger, evacuationHandler.EvacuateStoppedActualLRP))),
+		bbs.EvacuateRunningActualLRPRoute:  route(emitter.EmitLatency(middleware.LogWrap(logger, evacuationHandler.EvacuateRunningActualLRP))),
 
 		// Desired LRPs
-		bbs.DesiredLRPsRoute:               route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesiredLRPs))),
-		bbs.DesiredLRPByProcessGuidRoute:   route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesiredLRPByProcessGuid))),
-		bbs.DesiredLRPSchedulingInfosRoute: route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesiredLRPSchedulingInfos))),
-		bbs.DesireDesiredLRPRoute:          route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesireDesiredLRP))),
-		bbs.UpdateDesiredLRPRoute:          route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.UpdateDesiredLRP))),
-		bbs.RemoveDesiredLRPRoute:          route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.RemoveDesiredLRP))),
-
-		bbs.DesiredLRPsRoute_r0:             route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesiredLRPs_r0))),
-		bbs.DesiredLRPsRoute_r1:             route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesiredLRPs_r1))),
-		bbs.DesiredLRPByProcessGuidRoute_r0: route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesiredLRPByProcessGuid_r0))),
-		bbs.DesiredLRPByProcessGuidRoute_r1: route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesiredLRPByProcessGuid_r1))),
-		bbs.DesireDesiredLRPRoute_r0:        route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesireDesiredLRP_r0))),
-		bbs.DesireDesiredLRPRoute_r1:        route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesireDesiredLRP_r1))),
+		bbs.DesiredLRPsRoute:               route(emitter.EmitLatency(middleware.LogWrap(logger, desiredLRPHandler.DesiredLRPs))),
+		bbs.DesiredLRPByProcessGuidRoute:   route(emitter.EmitLatency(middleware.LogWrap(logger, desiredLRPHandler.DesiredLRPByProcessGuid))),
+		bbs.DesiredLRPSchedulingInfosRoute: route(emitter.EmitLatency(middleware.LogWrap(logger, desiredLRPHandler.DesiredLRPSchedulingInfos))),
+		bbs.DesireDesiredLRPRoute:          route(emitter.EmitLatency(middleware.LogWrap(logger, desiredLRPHandler.DesireDesiredLRP))),
+		bbs.UpdateDesiredLRPRoute:          route(emitter.EmitLatency(middleware.LogWrap(logger, desiredLRPHandler.UpdateDesiredLRP))),
+		bbs.RemoveDesiredLRPRoute:          route(emitter.EmitLatency(middleware.LogWrap(logger, desiredLRPHandler.RemoveDesiredLRP))),
+		bbs.RedeployDesiredLRPRoute:        route(emitter.EmitLatency(middleware.LogWrap(logger, desiredLRPHandler.RedeployDesiredLRP))),
+		bbs.DesireDesiredLRPsRoute:         route(emitter.EmitLatency(middleware.LogWrap(logger, desiredLRPHandler.DesireDesiredLRPs))),
+		bbs.RemoveDesiredLRPsRoute:         route(emitter.EmitLatency(middleware.LogWrap(logger, desiredLRPHandler.RemoveDesiredLRPs))),
+		bbs.ApplyDesiredLRPsRoute:          route(emitter.EmitLatency(middleware.LogWrap(logger, desiredLRPHandler.ApplyDesiredLRPs))),
+
+		bbs.DesiredLRPsRoute_r0:             route(emitter.EmitLatency(middleware.LogWrap(logger, desiredLRPHandler.DesiredLRPs_r0))),
+		bbs.DesiredLRPsRoute_r1:             route(emitter.EmitLatency(middleware.LogWrap(logger, desiredLRPHandler.DesiredLRPs_r1))),
+		bbs.DesiredLRPByProcessGuidRoute_r0: route(emitter.EmitLatency(middleware.LogWrap(logger, desiredLRPHandler.DesiredLRPByProcessGuid_r0))),
+		bbs.DesiredLRPByProcessGuidRoute_r1: route(emitter.EmitLatency(middleware.LogWrap(logger, desiredLRPHandler.DesiredLRPByProcessGuid_r1))),
+		bbs.DesireDesiredLRPRoute_r0:        route(emitter.EmitLatency(middleware.LogWrap(logger, desiredLRPHandler.DesireDesiredLRP_r0))),
+		bbs.DesireDesiredLRPRoute_r1:        route(emitter.EmitLatency(middleware.LogWrap(logger, desiredLRPHandler.DesireDesiredLRP_r1))),
+
+		bbs.DesiredLRPRevisionsRoute: route(emitter.EmitLatency(middleware.LogWrap(logger, desiredLRPHandler.DesiredLRPRevisions))),
+		bbs.DesiredLRPRevisionRoute:  route(emitter.EmitLatency(middleware.LogWrap(logger, desiredLRPHandler.DesiredLRPRevision))),
+		bbs.DesiredLRPRolloutRoute:   route(emitter.EmitLatency(middleware.LogWrap(logger, desiredLRPHandler.DesiredLRPRollout))),
+		bbs.RollbackDesiredLRPRoute:  route(emitter.EmitLatency(middleware.LogWrap(logger, desiredLRPHandler.RollbackDesiredLRP))),
 
 		// Tasks
-		bbs.TasksRoute:         route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.Tasks))),
-		bbs.TaskByGuidRoute:    route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.TaskByGuid))),
-		bbs.DesireTaskRoute:    route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.DesireTask))),
-		bbs.StartTaskRoute:     route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.StartTask))),
-		bbs.CancelTaskRoute:    route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.CancelTask))),
-		bbs.FailTaskRoute:      route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.FailTask))),
-		bbs.CompleteTaskRoute:  route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.CompleteTask))),
-		bbs.ResolvingTaskRoute: route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.ResolvingTask))),
-		bbs.DeleteTaskRoute:    route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.DeleteTask))),
-
-		bbs.TasksRoute_r1:      route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.Tasks_r1))),
-		bbs.TasksRoute_r0:      route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.Tasks_r0))),
-		bbs.TaskByGuidRoute_r1: route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.TaskByGuid_r1))),
-		bbs.TaskByGuidRoute_r0: route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.TaskByGuid_r0))),
-		bbs.DesireTaskRoute_r1: route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.DesireTask_r1))),
-		bbs.DesireTaskRoute_r0: route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, taskHandler.DesireTask_r0))),
+		bbs.TasksRoute:         route(emitter.EmitLatency(middleware.LogWrap(logger, taskHandler.Tasks))),
+		bbs.TaskByGuidRoute:    route(emitter.EmitLatency(middleware.LogWrap(logger, taskHandler.TaskByGuid))),
+		bbs.DesireTaskRoute:    route(emitter.EmitLatency(middleware.LogWrap(logger, taskHandler.DesireTask))),
+		bbs.StartTaskRoute:     route(emitter.EmitLatency(middleware.LogWrap(logger, taskHandler.StartTask))),
+		bbs.CancelTaskRoute:    route(emitter.EmitLatency(middleware.LogWrap(logger, taskHandler.CancelTask))),
+		bbs.FailTaskRoute:      route(emitter.EmitLatency(middleware.LogWrap(logger, taskHandler.FailTask))),
+		bbs.CompleteTaskRoute:  route(emitter.EmitLatency(middleware.LogWrap(logger, taskHandler.CompleteTask))),
+		bbs.ResolvingTaskRoute: route(emitter.EmitLatency(middleware.LogWrap(logger, taskHandler.ResolvingTask))),
+		bbs.DeleteTaskRoute:    route(emitter.EmitLatency(middleware.LogWrap(logger, taskHandler.DeleteTask))),
+		bbs.DesireTasksRoute:   route(emitter.EmitLatency(middleware.LogWrap(logger, taskHandler.DesireTasks))),
+		bbs.CancelTasksRoute:   route(emitter.EmitLatency(middleware.LogWrap(logger, taskHandler.CancelTasks))),
+		bbs.DeleteTasksRoute:   route(emitter.EmitLatency(middleware.LogWrap(logger, taskHandler.DeleteTasks))),
+
+		bbs.TasksRoute_r1:      route(emitter.EmitLatency(middleware.LogWrap(logger, taskHandler.Tasks_r1))),
+		bbs.TasksRoute_r0:      route(emitter.EmitLatency(middleware.LogWrap(logger, taskHandler.Tasks_r0))),
+		bbs.TaskByGuidRoute_r1: route(emitter.EmitLatency(middleware.LogWrap(logger, taskHandler.TaskByGuid_r1))),
+		bbs.TaskByGuidRoute_r0: route(emitter.EmitLatency(middleware.LogWrap(logger, taskHandler.TaskByGuid_r0))),
+		bbs.DesireTaskRoute_r1: route(emitter.EmitLatency(middleware.LogWrap(logger, taskHandler.DesireTask_r1))),
+		bbs.DesireTaskRoute_r0: route(emitter.EmitLatency(middleware.LogWrap(logger, taskHandler.DesireTask_r0))),
 
 		// Events
-		bbs.EventStreamRoute_r0: route(middleware.LogWrap(logger, accessLogger, eventsHandler.Subscribe_r0)),
+		bbs.EventStreamRoute_r0:     route(middleware.LogWrap(logger, eventsHandler.Subscribe_r0)),
+		bbs.TaskEventStreamRoute_r0: route(middleware.LogWrap(logger, taskEventsHandler.Subscribe_r0)),
 
 		// Cells
-		bbs.CellsRoute:    route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, cellsHandler.Cells))),
-		bbs.CellsRoute_r1: route(emitter.EmitLatency(middleware.LogWrap(logger, accessLogger, cellsHandler.Cells))),
+		bbs.CellsRoute:    route(emitter.EmitLatency(middleware.LogWrap(logger, cellsHandler.Cells))),
+		bbs.CellsRoute_r1: route(emitter.EmitLatency(middleware.LogWrap(logger, cellsHandler.Cells))),
+
+		// Audit Log
+		bbs.AuditEventsRoute: route(emitter.EmitLatency(middleware.LogWrap(logger, auditHandler.AuditEvents))),
+
+		// Quarantine
+		bbs.QuarantinedRecordsRoute:        route(emitter.EmitLatency(middleware.LogWrap(logger, quarantineHandler.QuarantinedRecords))),
+		bbs.QuarantinedRecordRoute:         route(emitter.EmitLatency(middleware.LogWrap(logger, quarantineHandler.QuarantinedRecord))),
+		bbs.RestoreQuarantinedRecordRoute:  route(emitter.EmitLatency(middleware.LogWrap(logger, quarantineHandler.RestoreQuarantinedRecord))),
+		bbs.RestoreQuarantinedRecordsRoute: route(emitter.EmitLatency(middleware.LogWrap(logger, quarantineHandler.RestoreQuarantinedRecords))),
+
+		// Encryption
+		bbs.EncryptionProgressRoute:         route(emitter.EmitLatency(middleware.LogWrap(logger, encryptionHandler.EncryptionProgress))),
+		bbs.VerifyEncryptionKeyRetiredRoute: route(emitter.EmitLatency(middleware.LogWrap(logger, encryptionHandler.VerifyEncryptionKeyRetired))),
+	}
+
+	if authorizer != nil {
+		for name, action := range actions {
+			actions[name] = authorizer.Wrap(name, action)
+		}
+	}
+
+	if auditor != nil {
+		for name, action := range actions {
+			actions[name] = auditor.Wrap(name, action)
+		}
+	}
+
+	for name, action := range actions {
+		actions[name] = emitter.EmitRouteMetrics(name, action)
+	}
+
+	if accessLogger != nil {
+		for name, action := range actions {
+			actions[name] = accessLogger.Wrap(name, action)
+		}
 	}
 
 	handler, err := rata.NewRouter(bbs.Routes, actions)
@@ -131,6 +199,8 @@ func route(f http.HandlerFunc) http.Handler {
 	return f
 }
 
+// parseRequest decodes the request body into request, as JSON if the request
+// has a JSON Content-Type and as protobuf otherwise, and validates it.
 func parseRequest(logger lager.Logger, req *http.Request, request MessageValidator) error {
 	data, err := ioutil.ReadAll(req.Body)
 	if err != nil {
@@ -138,10 +208,20 @@ func parseRequest(logger lager.Logger, req *http.Request, request MessageValidat
 		return models.ErrUnknownError
 	}
 
-	err = request.Unmarshal(data)
-	if err != nil {
-		logger.Error("failed-to-parse-request-body", err)
-		return models.ErrBadRequest
+	if middleware.IsJSONMediaType(req.Header.Get(bbs.ContentTypeHeader)) {
+		if len(data) > 0 {
+			err = json.Unmarshal(data, request)
+			if err != nil {
+				logger.Error("failed-to-parse-json-request-body", err)
+				return models.NewError(models.Error_InvalidJSON, err.Error())
+			}
+		}
+	} else {
+		err = request.Unmarshal(data)
+		if err != nil {
+			logger.Error("failed-to-parse-request-body", err)
+			return models.ErrBadRequest
+		}
 	}
 
 	if err := request.Validate(); err != nil {
@@ -152,6 +232,32 @@ func parseRequest(logger lager.Logger, req *http.Request, request MessageValidat
 	return nil
 }
 
+// workBulk calls work with the index of each of the count items of a bulk
+// request, at most maxWorkers at a time, and returns once all of them have
+// finished. A request with more than maxItems items is rejected with
+// ErrBadRequest, so that a single request cannot tie up the workers.
+func workBulk(logger lager.Logger, maxWorkers, maxItems, count int, work func(i int)) error {
+	if count > maxItems {
+		logger.Error("too-many-items", models.ErrBadRequest, lager.Data{"num_items": count, "max_items": maxItems})
+		return models.ErrBadRequest
+	}
+
+	works := make([]func(), count)
+	for i := range works {
+		i := i
+		works[i] = func() { work(i) }
+	}
+
+	throttler, err := workpool.NewThrottler(maxWorkers, works)
+	if err != nil {
+		logger.Error("failed-constructing-throttler", err, lager.Data{"max_workers": maxWorkers, "num_works": len(works)})
+		return err
+	}
+
+	throttler.Work()
+	return nil
+}
+
 func exitIfUnrecoverable(logger lager.Logger, exitCh chan<- struct{}, err *models.Error) {
 	if err != nil && err.Type == models.Error_Unrecoverable {
 		logger.Error("unrecoverable-error", err)
@@ -162,15 +268,6 @@ func exitIfUnrecoverable(logger lager.Logger, exitCh chan<- struct{}, err *model
 	}
 }
 
-func writeResponse(w http.ResponseWriter, message proto.Message) {
-	responseBytes, err := proto.Marshal(message)
-	if err != nil {
-		panic("Unable to encode Proto: " + err.Error())
-	}
-
-	w.Header().Set("Content-Length", strconv.Itoa(len(responseBytes)))
-	w.Header().Set("Content-Type", "application/x-protobuf")
-	w.WriteHeader(http.StatusOK)
-
-	w.Write(responseBytes)
+func writeResponse(w http.ResponseWriter, req *http.Request, message proto.Message) {
+	middleware.WriteMessage(w, req, message)
 }
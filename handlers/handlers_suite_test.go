@@ -7,7 +7,7 @@ import (
 	"strings"
 
 	"code.cloudfoundry.org/bbs/fake_bbs"
-	"code.cloudfoundry.org/rep/repfakes"
+	"code.cloudfoundry.org/bbs/tracedclients/tracedclientsfakes"
 	"github.com/gogo/protobuf/proto"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -22,14 +22,14 @@ func TestHandlers(t *testing.T) {
 
 var (
 	fakeServiceClient    *fake_bbs.FakeServiceClient
-	fakeRepClient        *repfakes.FakeClient
-	fakeRepClientFactory *repfakes.FakeClientFactory
+	fakeRepClient        *tracedclientsfakes.FakeRepClient
+	fakeRepClientFactory *tracedclientsfakes.FakeRepClientFactory
 )
 
 var _ = BeforeEach(func() {
 	fakeServiceClient = new(fake_bbs.FakeServiceClient)
-	fakeRepClientFactory = new(repfakes.FakeClientFactory)
-	fakeRepClient = new(repfakes.FakeClient)
+	fakeRepClientFactory = new(tracedclientsfakes.FakeRepClientFactory)
+	fakeRepClient = new(tracedclientsfakes.FakeRepClient)
 	fakeRepClientFactory.CreateClientReturns(fakeRepClient, nil)
 })
 
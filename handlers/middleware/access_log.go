@@ -0,0 +1,169 @@
+package middleware
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/bbs/trace"
+	"code.cloudfoundry.org/lager"
+)
+
+type AccessLogFormat string
+
+const (
+	AccessLogFormatJSON     AccessLogFormat = "json"
+	AccessLogFormatCombined AccessLogFormat = "combined"
+
+	combinedTimeFormat = "02/Jan/2006:15:04:05 -0700"
+)
+
+// AccessLogger records every request to the BBS, who made it and how it was
+// answered, either as lager JSON or in the Apache combined log format.
+type AccessLogger struct {
+	logger lager.Logger
+
+	lock   sync.Mutex
+	writer io.Writer
+}
+
+func NewAccessLogger(writer io.Writer, format AccessLogFormat) (*AccessLogger, error) {
+	accessLogger := &AccessLogger{writer: writer}
+
+	switch format {
+	case AccessLogFormatJSON:
+		accessLogger.logger = lager.NewLogger("bbs-access")
+		accessLogger.logger.RegisterSink(lager.NewWriterSink(writer, lager.INFO))
+	case AccessLogFormatCombined:
+	default:
+		return nil, fmt.Errorf("unknown access log format %q", format)
+	}
+
+	return accessLogger, nil
+}
+
+type accessLogEntry struct {
+	route      string
+	request    *http.Request
+	trace      trace.Trace
+	start      time.Time
+	duration   time.Duration
+	status     int
+	size       int
+	errorType  string
+	clientName string
+}
+
+// Wrap logs the requests to route once they have been served. It starts the
+// trace of each request, which LogWrap picks up, so that the access log and the
+// BBS log share the request id.
+func (a *AccessLogger) Wrap(route string, handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestTrace := trace.FromRequest(r)
+		r = r.WithContext(trace.NewContext(r.Context(), requestTrace))
+
+		var requestLogger lager.Logger
+		if a.logger != nil {
+			requestLogger = trace.WithTrace(a.logger.Session("request", lager.Data{
+				"route":   route,
+				"method":  r.Method,
+				"request": r.URL.String(),
+			}), requestTrace)
+			requestLogger.Info("serving")
+		}
+
+		recorder := NewResponseRecorder(w)
+		start := time.Now()
+		handler.ServeHTTP(recorder, r)
+
+		entry := accessLogEntry{
+			route:      route,
+			request:    r,
+			trace:      requestTrace,
+			start:      start,
+			duration:   time.Since(start),
+			status:     recorder.Status(),
+			size:       recorder.Size(),
+			clientName: ClientName(r.TLS),
+		}
+		if recorder.Error() != nil {
+			entry.errorType = recorder.Error().Type.String()
+		}
+
+		if requestLogger != nil {
+			requestLogger.Info("done", entry.lagerData())
+		} else {
+			a.writeCombined(entry)
+		}
+	})
+}
+
+func (e accessLogEntry) lagerData() lager.Data {
+	data := lager.Data{
+		"duration":       e.duration,
+		"status":         e.status,
+		"size":           e.size,
+		"remote-address": e.request.RemoteAddr,
+	}
+	if e.clientName != "" {
+		data["client-common-name"] = e.clientName
+	}
+	if e.errorType != "" {
+		data["error-type"] = e.errorType
+	}
+	return data
+}
+
+// writeCombined writes the entry in the Apache combined log format, with the
+// client certificate's common name as the user, followed by the route, error
+// type, request id and response time in seconds.
+func (a *AccessLogger) writeCombined(e accessLogEntry) {
+	host, _, err := net.SplitHostPort(e.request.RemoteAddr)
+	if err != nil {
+		host = e.request.RemoteAddr
+	}
+
+	size := "-"
+	if e.size > 0 {
+		size = fmt.Sprint(e.size)
+	}
+
+	line := fmt.Sprintf("%s - %s [%s] \"%s %s %s\" %d %s %s %s route=%s error=%s request_id=%s response_time=%.6f\n",
+		combinedField(host),
+		combinedField(e.clientName),
+		e.start.Format(combinedTimeFormat),
+		e.request.Method,
+		e.request.URL.RequestURI(),
+		e.request.Proto,
+		e.status,
+		size,
+		combinedQuoted(e.request.Referer()),
+		combinedQuoted(e.request.UserAgent()),
+		e.route,
+		combinedField(e.errorType),
+		combinedField(e.trace.RequestID),
+		e.duration.Seconds(),
+	)
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	io.WriteString(a.writer, line)
+}
+
+func combinedField(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return strings.Replace(value, " ", "_", -1)
+}
+
+func combinedQuoted(value string) string {
+	if value == "" {
+		return `"-"`
+	}
+	return `"` + strings.Replace(value, `"`, `\"`, -1) + `"`
+}
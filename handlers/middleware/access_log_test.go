@@ -0,0 +1,120 @@
+package middleware_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/trace"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/onsi/gomega/gbytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AccessLogger", func() {
+	var (
+		buffer       *gbytes.Buffer
+		format       middleware.AccessLogFormat
+		accessLogger *middleware.AccessLogger
+		innerHandler http.Handler
+		handlerTrace trace.Trace
+		req          *http.Request
+	)
+
+	BeforeEach(func() {
+		buffer = gbytes.NewBuffer()
+		format = middleware.AccessLogFormatJSON
+		handlerTrace = trace.Trace{}
+
+		innerHandler = middleware.LogWrap(lagertest.NewTestLogger("test"), func(logger lager.Logger, w http.ResponseWriter, r *http.Request) {
+			handlerTrace, _ = trace.FromLogger(logger)
+			middleware.WriteMessage(w, r, &models.DesiredLRPLifecycleResponse{Error: models.ErrResourceNotFound})
+		})
+
+		var err error
+		req, err = http.NewRequest("POST", "http://example.com/v1/desired_lrp/remove", nil)
+		Expect(err).NotTo(HaveOccurred())
+		req.RemoteAddr = "10.0.0.1:52000"
+		req.Header.Set(trace.RequestIDHeader, "some-request-id")
+		req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{
+			{Subject: pkix.Name{CommonName: "nsync"}},
+		}}
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		accessLogger, err = middleware.NewAccessLogger(buffer, format)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	serve := func() *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		handler := accessLogger.Wrap(bbs.RemoveDesiredLRPRoute, middleware.NewLatencyEmitter(lagertest.NewTestLogger("test")).EmitRouteMetrics(bbs.RemoveDesiredLRPRoute, innerHandler))
+		handler.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	Context("with the json format", func() {
+		It("logs the request before serving it", func() {
+			serve()
+			Expect(buffer).To(gbytes.Say(`"message":"bbs-access.request.serving".*"request-id":"some-request-id".*"route":"RemoveDesiredLRP"`))
+		})
+
+		It("logs who made the request and how it was answered", func() {
+			recorder := serve()
+
+			Expect(buffer).To(gbytes.Say(`"message":"bbs-access.request.done"`))
+			contents := string(buffer.Contents())
+			Expect(contents).To(ContainSubstring(`"client-common-name":"nsync"`))
+			Expect(contents).To(ContainSubstring(`"error-type":"ResourceNotFound"`))
+			Expect(contents).To(ContainSubstring(`"remote-address":"10.0.0.1:52000"`))
+			Expect(contents).To(ContainSubstring(`"status":200`))
+			Expect(contents).To(ContainSubstring(`"size":` + fmt.Sprint(recorder.Body.Len())))
+		})
+
+		It("shares the request's trace with the handler", func() {
+			serve()
+			Expect(handlerTrace.RequestID).To(Equal("some-request-id"))
+		})
+	})
+
+	Context("with the combined format", func() {
+		BeforeEach(func() {
+			format = middleware.AccessLogFormatCombined
+			req.Header.Set("User-Agent", "some-agent")
+		})
+
+		It("writes a line in the Apache combined format", func() {
+			recorder := serve()
+
+			Expect(buffer).To(gbytes.Say(
+				`^10\.0\.0\.1 - nsync \[\d{2}/\w{3}/\d{4}:\d{2}:\d{2}:\d{2} [-+]\d{4}\] "POST /v1/desired_lrp/remove HTTP/1\.1" 200 %d "-" "some-agent" route=RemoveDesiredLRP error=ResourceNotFound request_id=some-request-id response_time=\d+\.\d{6}\n`,
+				recorder.Body.Len(),
+			))
+		})
+
+		It("writes dashes for the missing fields", func() {
+			req.TLS = nil
+			innerHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			})
+			serve()
+
+			Expect(buffer).To(gbytes.Say(`^10\.0\.0\.1 - - \[.*\] "POST /v1/desired_lrp/remove HTTP/1\.1" 204 - "-" "some-agent" route=RemoveDesiredLRP error=- request_id=some-request-id`))
+		})
+	})
+
+	It("rejects unknown formats", func() {
+		_, err := middleware.NewAccessLogger(buffer, middleware.AccessLogFormat("xml"))
+		Expect(err).To(MatchError(`unknown access log format "xml"`))
+	})
+})
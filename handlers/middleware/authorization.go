@@ -0,0 +1,302 @@
+package middleware
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+// Permission is the kind of access a route requires.
+type Permission string
+
+const (
+	// PermissionRead allows reading state and subscribing to events.
+	PermissionRead Permission = "read"
+	// PermissionWrite allows desiring and changing LRPs, tasks and domains.
+	PermissionWrite Permission = "write"
+	// PermissionCell allows the internal LRP and task lifecycle calls made by
+	// the cell reps.
+	PermissionCell Permission = "cell"
+	// PermissionAdmin allows inspecting and restoring the quarantined records.
+	// It is only granted by mappings that are not restricted to domains.
+	PermissionAdmin Permission = "admin"
+)
+
+// Role is a named set of permissions granted to API clients.
+type Role string
+
+const (
+	RoleReadOnly  Role = "read-only"
+	RoleScheduler Role = "scheduler"
+	RoleCell      Role = "cell"
+	RoleAdmin     Role = "admin"
+)
+
+var rolePermissions = map[Role][]Permission{
+	RoleReadOnly:  {PermissionRead},
+	RoleScheduler: {PermissionRead, PermissionWrite},
+	RoleCell:      {PermissionRead, PermissionCell},
+	RoleAdmin:     {PermissionRead, PermissionWrite, PermissionCell, PermissionAdmin},
+}
+
+// RoutePermissions is the permission required by each of bbs.Routes. Ping is
+// left out so that any client holding a valid certificate can check whether
+// the BBS is up.
+var RoutePermissions = map[string]Permission{
+	// Domains
+	bbs.DomainsRoute:      PermissionRead,
+	bbs.UpsertDomainRoute: PermissionWrite,
+
+	// Actual LRPs
+	bbs.ActualLRPGroupsRoute:                     PermissionRead,
+	bbs.ActualLRPGroupsByProcessGuidRoute:        PermissionRead,
+	bbs.ActualLRPGroupByProcessGuidAndIndexRoute: PermissionRead,
+
+	// Actual LRP Lifecycle
+	bbs.ClaimActualLRPRoute:  PermissionCell,
+	bbs.StartActualLRPRoute:  PermissionCell,
+	bbs.CrashActualLRPRoute:  PermissionCell,
+	bbs.FailActualLRPRoute:   PermissionCell,
+	bbs.RemoveActualLRPRoute: PermissionCell,
+	bbs.RetireActualLRPRoute: PermissionWrite,
+
+	// Evacuation
+	bbs.RemoveEvacuatingActualLRPRoute: PermissionCell,
+	bbs.EvacuateClaimedActualLRPRoute:  PermissionCell,
+	bbs.EvacuateCrashedActualLRPRoute:  PermissionCell,
+	bbs.EvacuateStoppedActualLRPRoute:  PermissionCell,
+	bbs.EvacuateRunningActualLRPRoute:  PermissionCell,
+
+	// Desired LRPs
+	bbs.DesiredLRPsRoute:                PermissionRead,
+	bbs.DesiredLRPSchedulingInfosRoute:  PermissionRead,
+	bbs.DesiredLRPByProcessGuidRoute:    PermissionRead,
+	bbs.DesiredLRPsRoute_r1:             PermissionRead,
+	bbs.DesiredLRPByProcessGuidRoute_r1: PermissionRead,
+	bbs.DesiredLRPsRoute_r0:             PermissionRead,
+	bbs.DesiredLRPByProcessGuidRoute_r0: PermissionRead,
+	bbs.DesiredLRPRevisionsRoute:        PermissionRead,
+	bbs.DesiredLRPRevisionRoute:         PermissionRead,
+	bbs.DesiredLRPRolloutRoute:          PermissionRead,
+
+	// Desire LRP Lifecycle
+	bbs.DesireDesiredLRPRoute:    PermissionWrite,
+	bbs.UpdateDesiredLRPRoute:    PermissionWrite,
+	bbs.RemoveDesiredLRPRoute:    PermissionWrite,
+	bbs.RedeployDesiredLRPRoute:  PermissionWrite,
+	bbs.DesireDesiredLRPsRoute:   PermissionWrite,
+	bbs.RemoveDesiredLRPsRoute:   PermissionWrite,
+	bbs.ApplyDesiredLRPsRoute:    PermissionWrite,
+	bbs.RollbackDesiredLRPRoute:  PermissionWrite,
+	bbs.DesireDesiredLRPRoute_r1: PermissionWrite,
+	bbs.DesireDesiredLRPRoute_r0: PermissionWrite,
+
+	// Tasks
+	bbs.TasksRoute:         PermissionRead,
+	bbs.TaskByGuidRoute:    PermissionRead,
+	bbs.DesireTaskRoute:    PermissionWrite,
+	bbs.StartTaskRoute:     PermissionCell,
+	bbs.CancelTaskRoute:    PermissionWrite,
+	bbs.FailTaskRoute:      PermissionCell,
+	bbs.CompleteTaskRoute:  PermissionCell,
+	bbs.ResolvingTaskRoute: PermissionWrite,
+	bbs.DeleteTaskRoute:    PermissionWrite,
+	bbs.DesireTasksRoute:   PermissionWrite,
+	bbs.CancelTasksRoute:   PermissionWrite,
+	bbs.DeleteTasksRoute:   PermissionWrite,
+
+	bbs.TasksRoute_r1:      PermissionRead,
+	bbs.TaskByGuidRoute_r1: PermissionRead,
+	bbs.DesireTaskRoute_r0: PermissionWrite,
+	bbs.DesireTaskRoute_r1: PermissionWrite,
+	bbs.TasksRoute_r0:      PermissionRead,
+	bbs.TaskByGuidRoute_r0: PermissionRead,
+
+	// Event Streaming
+	bbs.EventStreamRoute_r0:     PermissionRead,
+	bbs.TaskEventStreamRoute_r0: PermissionRead,
+
+	// Cell Presence
+	bbs.CellsRoute:    PermissionRead,
+	bbs.CellsRoute_r1: PermissionRead,
+
+	// Audit Log
+	bbs.AuditEventsRoute: PermissionRead,
+
+	// Quarantine
+	bbs.QuarantinedRecordsRoute:        PermissionAdmin,
+	bbs.QuarantinedRecordRoute:         PermissionAdmin,
+	bbs.RestoreQuarantinedRecordRoute:  PermissionAdmin,
+	bbs.RestoreQuarantinedRecordsRoute: PermissionAdmin,
+
+	// Encryption
+	bbs.EncryptionProgressRoute:         PermissionAdmin,
+	bbs.VerifyEncryptionKeyRetiredRoute: PermissionAdmin,
+}
+
+// RoleMapping grants a role to the clients whose certificate has one of the
+// given common names or subject alternative names (DNS names, email addresses
+// or IP addresses). When Domains is set, the read and write permissions of the
+// role only apply to the LRPs and tasks in those domains.
+type RoleMapping struct {
+	Role        Role     `json:"role"`
+	CommonNames []string `json:"common_names,omitempty"`
+	SANs        []string `json:"subject_alternative_names,omitempty"`
+	Domains     []string `json:"domains,omitempty"`
+}
+
+// Authorizer restricts routes to the clients holding a role with the
+// permission the route requires.
+type Authorizer struct {
+	logger   lager.Logger
+	mappings []RoleMapping
+}
+
+// NewAuthorizer returns an error if a mapping names an unknown role or a route
+// in bbs.Routes other than Ping is missing from RoutePermissions.
+func NewAuthorizer(logger lager.Logger, mappings []RoleMapping) (*Authorizer, error) {
+	for _, mapping := range mappings {
+		if _, ok := rolePermissions[mapping.Role]; !ok {
+			return nil, fmt.Errorf("unknown role %q", mapping.Role)
+		}
+	}
+
+	for _, route := range bbs.Routes {
+		if _, ok := RoutePermissions[route.Name]; !ok && route.Name != bbs.PingRoute {
+			return nil, fmt.Errorf("no permission defined for route %q", route.Name)
+		}
+	}
+
+	return &Authorizer{
+		logger:   logger.Session("authorizer"),
+		mappings: mappings,
+	}, nil
+}
+
+// Wrap rejects requests to the named route from clients lacking the
+// permission it requires. Rejected requests get a 200 response carrying an
+// Unauthorized error, like any other failed request, except on the event
+// streams, which get a 403. Permitted read and write requests carry the
+// client's DomainScope for the handlers to enforce.
+func (a *Authorizer) Wrap(route string, handler http.Handler) http.Handler {
+	permission, ok := RoutePermissions[route]
+	if !ok {
+		return handler
+	}
+
+	streaming := isEventStream(route)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if a.Permits(req.TLS, permission) {
+			if permission == PermissionRead || permission == PermissionWrite {
+				req = WithDomainScope(req, a.DomainScope(req.TLS, permission))
+			}
+			handler.ServeHTTP(w, req)
+			return
+		}
+
+		a.logger.Info("unauthorized", lager.Data{
+			"route":      route,
+			"permission": permission,
+			"client":     ClientName(req.TLS),
+		})
+
+		if streaming {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		WriteMessage(w, req, &models.ErrorResponse{Error: models.ErrUnauthorized})
+	})
+}
+
+// Permits returns true if the client certificate of the connection is granted
+// the permission by one of the role mappings.
+func (a *Authorizer) Permits(state *tls.ConnectionState, permission Permission) bool {
+	return len(a.grantingMappings(state, permission)) > 0
+}
+
+// DomainScope returns the domains in which the client certificate of the
+// connection is granted the permission, or nil if it is granted in every
+// domain.
+func (a *Authorizer) DomainScope(state *tls.ConnectionState, permission Permission) DomainScope {
+	scope := DomainScope{}
+	for _, mapping := range a.grantingMappings(state, permission) {
+		if len(mapping.Domains) == 0 {
+			return nil
+		}
+
+		for _, domain := range mapping.Domains {
+			scope[domain] = struct{}{}
+		}
+	}
+	return scope
+}
+
+func (a *Authorizer) grantingMappings(state *tls.ConnectionState, permission Permission) []RoleMapping {
+	if state == nil || len(state.PeerCertificates) == 0 {
+		return nil
+	}
+
+	cert := state.PeerCertificates[0]
+	granting := []RoleMapping{}
+	for _, mapping := range a.mappings {
+		if !mapping.matches(cert) {
+			continue
+		}
+
+		if permission == PermissionAdmin && len(mapping.Domains) > 0 {
+			continue
+		}
+
+		for _, p := range rolePermissions[mapping.Role] {
+			if p == permission {
+				granting = append(granting, mapping)
+				break
+			}
+		}
+	}
+
+	return granting
+}
+
+func (m RoleMapping) matches(cert *x509.Certificate) bool {
+	for _, name := range m.CommonNames {
+		if cert.Subject.CommonName == name {
+			return true
+		}
+	}
+
+	for _, san := range m.SANs {
+		for _, name := range cert.DNSNames {
+			if name == san {
+				return true
+			}
+		}
+		for _, address := range cert.EmailAddresses {
+			if address == san {
+				return true
+			}
+		}
+		for _, ip := range cert.IPAddresses {
+			if ip.String() == san {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ClientName returns the common name of the client certificate of the
+// connection, if there is one.
+func ClientName(state *tls.ConnectionState) string {
+	if state == nil || len(state.PeerCertificates) == 0 {
+		return ""
+	}
+	return state.PeerCertificates[0].Subject.CommonName
+}
@@ -0,0 +1,254 @@
+package middleware_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/gogo/protobuf/proto"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Authorizer", func() {
+	var (
+		logger     *lagertest.TestLogger
+		mappings   []middleware.RoleMapping
+		authorizer *middleware.Authorizer
+		cert       *x509.Certificate
+		called     bool
+		scope      middleware.DomainScope
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		mappings = []middleware.RoleMapping{
+			{Role: middleware.RoleCell, SANs: []string{"cell.service.cf.internal", "10.0.16.4"}},
+			{Role: middleware.RoleScheduler, CommonNames: []string{"nsync"}},
+			{Role: middleware.RoleReadOnly, CommonNames: []string{"dashboard"}},
+		}
+		cert = &x509.Certificate{}
+		called = false
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		authorizer, err = middleware.NewAuthorizer(logger, mappings)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	serve := func(route string) *httptest.ResponseRecorder {
+		handler := authorizer.Wrap(route, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+			scope = middleware.DomainScopeFromRequest(r)
+		}))
+
+		req, err := http.NewRequest("POST", "/", nil)
+		Expect(err).NotTo(HaveOccurred())
+		req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w
+	}
+
+	expectUnauthorized := func(w *httptest.ResponseRecorder) {
+		Expect(called).To(BeFalse())
+		Expect(w.Code).To(Equal(http.StatusOK))
+
+		data, err := ioutil.ReadAll(w.Body)
+		Expect(err).NotTo(HaveOccurred())
+		response := &models.ErrorResponse{}
+		Expect(proto.Unmarshal(data, response)).To(Succeed())
+		Expect(response.Error).To(Equal(models.ErrUnauthorized))
+	}
+
+	Describe("NewAuthorizer", func() {
+		It("defines a permission for every route but ping", func() {
+			for _, route := range bbs.Routes {
+				if route.Name == bbs.PingRoute {
+					continue
+				}
+				Expect(middleware.RoutePermissions).To(HaveKey(route.Name))
+			}
+		})
+
+		Context("when a mapping has an unknown role", func() {
+			It("returns an error", func() {
+				_, err := middleware.NewAuthorizer(logger, []middleware.RoleMapping{
+					{Role: "superuser", CommonNames: []string{"root"}},
+				})
+				Expect(err).To(MatchError(ContainSubstring("superuser")))
+			})
+		})
+	})
+
+	Context("when the certificate common name is mapped to a role", func() {
+		BeforeEach(func() {
+			cert.Subject = pkix.Name{CommonName: "nsync"}
+		})
+
+		It("allows the routes the role has permission for", func() {
+			serve(bbs.DesireDesiredLRPRoute)
+			Expect(called).To(BeTrue())
+		})
+
+		It("rejects the other routes with an Unauthorized error", func() {
+			expectUnauthorized(serve(bbs.StartActualLRPRoute))
+		})
+	})
+
+	Context("when a certificate DNS name is mapped to a role", func() {
+		BeforeEach(func() {
+			cert.DNSNames = []string{"cell.service.cf.internal"}
+		})
+
+		It("allows the routes the role has permission for", func() {
+			serve(bbs.StartActualLRPRoute)
+			Expect(called).To(BeTrue())
+		})
+
+		It("rejects the other routes", func() {
+			expectUnauthorized(serve(bbs.DesireDesiredLRPRoute))
+		})
+	})
+
+	Context("when a certificate IP address is mapped to a role", func() {
+		BeforeEach(func() {
+			cert.IPAddresses = []net.IP{net.ParseIP("10.0.16.4")}
+		})
+
+		It("allows the routes the role has permission for", func() {
+			serve(bbs.CompleteTaskRoute)
+			Expect(called).To(BeTrue())
+		})
+	})
+
+	Context("when the certificate matches several mappings", func() {
+		BeforeEach(func() {
+			cert.Subject = pkix.Name{CommonName: "nsync"}
+			cert.DNSNames = []string{"cell.service.cf.internal"}
+		})
+
+		It("grants the permissions of all of the roles", func() {
+			serve(bbs.DesireTaskRoute)
+			Expect(called).To(BeTrue())
+
+			called = false
+			serve(bbs.StartTaskRoute)
+			Expect(called).To(BeTrue())
+		})
+	})
+
+	Context("when the certificate is not mapped to any role", func() {
+		BeforeEach(func() {
+			cert.Subject = pkix.Name{CommonName: "stranger"}
+		})
+
+		It("rejects the requests", func() {
+			expectUnauthorized(serve(bbs.DomainsRoute))
+		})
+
+		It("allows pinging", func() {
+			serve(bbs.PingRoute)
+			Expect(called).To(BeTrue())
+		})
+
+		It("responds to event stream requests with a 403", func() {
+			w := serve(bbs.EventStreamRoute_r0)
+			Expect(called).To(BeFalse())
+			Expect(w.Code).To(Equal(http.StatusForbidden))
+		})
+
+		It("logs the rejection", func() {
+			serve(bbs.DomainsRoute)
+			Expect(logger).To(gbytes.Say("unauthorized.*stranger"))
+		})
+	})
+
+	Context("when the route requires the admin permission", func() {
+		BeforeEach(func() {
+			mappings = append(mappings,
+				middleware.RoleMapping{Role: middleware.RoleAdmin, CommonNames: []string{"operator"}},
+				middleware.RoleMapping{Role: middleware.RoleAdmin, CommonNames: []string{"team-admin"}, Domains: []string{"domain-a"}},
+			)
+		})
+
+		It("allows the admin role", func() {
+			cert.Subject = pkix.Name{CommonName: "operator"}
+			serve(bbs.RestoreQuarantinedRecordRoute)
+			Expect(called).To(BeTrue())
+			Expect(scope).To(BeNil())
+		})
+
+		It("rejects the other roles", func() {
+			cert.Subject = pkix.Name{CommonName: "nsync"}
+			expectUnauthorized(serve(bbs.QuarantinedRecordsRoute))
+		})
+
+		It("rejects admins restricted to domains", func() {
+			cert.Subject = pkix.Name{CommonName: "team-admin"}
+			expectUnauthorized(serve(bbs.QuarantinedRecordsRoute))
+		})
+	})
+
+	Context("when the mappings are restricted to domains", func() {
+		BeforeEach(func() {
+			mappings = []middleware.RoleMapping{
+				{Role: middleware.RoleScheduler, CommonNames: []string{"team-a"}, Domains: []string{"domain-a"}},
+				{Role: middleware.RoleReadOnly, CommonNames: []string{"team-a"}, Domains: []string{"domain-b"}},
+				{Role: middleware.RoleReadOnly, CommonNames: []string{"auditor"}},
+			}
+			cert.Subject = pkix.Name{CommonName: "team-a"}
+		})
+
+		It("passes the domains the client may write to the write routes", func() {
+			serve(bbs.DesireTaskRoute)
+			Expect(called).To(BeTrue())
+			Expect(scope.Domains()).To(Equal([]string{"domain-a"}))
+		})
+
+		It("passes the domains the client may read to the read routes", func() {
+			serve(bbs.TasksRoute)
+			Expect(called).To(BeTrue())
+			Expect(scope.Domains()).To(Equal([]string{"domain-a", "domain-b"}))
+		})
+
+		Context("when a matching mapping is not restricted", func() {
+			BeforeEach(func() {
+				cert.Subject = pkix.Name{CommonName: "auditor"}
+			})
+
+			It("allows every domain", func() {
+				serve(bbs.TasksRoute)
+				Expect(called).To(BeTrue())
+				Expect(scope).To(BeNil())
+				Expect(scope.Allows("any-domain")).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("Permits", func() {
+		It("does not permit connections without a client certificate", func() {
+			Expect(authorizer.Permits(nil, middleware.PermissionRead)).To(BeFalse())
+			Expect(authorizer.Permits(&tls.ConnectionState{}, middleware.PermissionRead)).To(BeFalse())
+		})
+
+		It("permits the read-only role to read", func() {
+			cert.Subject = pkix.Name{CommonName: "dashboard"}
+			state := &tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}
+			Expect(authorizer.Permits(state, middleware.PermissionRead)).To(BeTrue())
+			Expect(authorizer.Permits(state, middleware.PermissionWrite)).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"sort"
+)
+
+// DomainScope is the set of domains a client may access. A nil DomainScope
+// allows every domain.
+type DomainScope map[string]struct{}
+
+func NewDomainScope(domains ...string) DomainScope {
+	scope := DomainScope{}
+	for _, domain := range domains {
+		scope[domain] = struct{}{}
+	}
+	return scope
+}
+
+func (s DomainScope) Allows(domain string) bool {
+	if s == nil {
+		return true
+	}
+	_, ok := s[domain]
+	return ok
+}
+
+// Domains returns the domains in the scope, sorted, or nil if the scope allows
+// every domain.
+func (s DomainScope) Domains() []string {
+	if s == nil {
+		return nil
+	}
+
+	domains := make([]string, 0, len(s))
+	for domain := range s {
+		domains = append(domains, domain)
+	}
+	sort.Strings(domains)
+	return domains
+}
+
+type domainScopeKey struct{}
+
+// WithDomainScope returns a copy of req restricted to the domains in scope.
+func WithDomainScope(req *http.Request, scope DomainScope) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), domainScopeKey{}, scope))
+}
+
+// DomainScopeFromRequest returns the scope attached to req by the Authorizer,
+// or nil if the request is not restricted to any domains.
+func DomainScopeFromRequest(req *http.Request) DomainScope {
+	scope, _ := req.Context().Value(domainScopeKey{}).(DomainScope)
+	return scope
+}
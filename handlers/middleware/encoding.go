@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/models"
+	"github.com/gogo/protobuf/proto"
+)
+
+type errorResponse interface {
+	GetError() *models.Error
+}
+
+type errorRecorder interface {
+	recordError(err *models.Error)
+}
+
+// WriteMessage encodes message in the format negotiated with the client: JSON
+// if it prefers application/json in its Accept header, or if it sent JSON and
+// expressed no preference, and protobuf otherwise. The error carried by the
+// message is recorded for the route metrics.
+func WriteMessage(w http.ResponseWriter, req *http.Request, message proto.Message) {
+	if recorder, ok := w.(errorRecorder); ok {
+		if response, ok := message.(errorResponse); ok {
+			recorder.recordError(response.GetError())
+		}
+	}
+
+	var responseBytes []byte
+	var err error
+	contentType := bbs.ProtoContentType
+
+	if PrefersJSON(req) {
+		contentType = bbs.JSONContentType
+		responseBytes, err = json.Marshal(message)
+		if err != nil {
+			panic("Unable to encode JSON: " + err.Error())
+		}
+	} else {
+		responseBytes, err = proto.Marshal(message)
+		if err != nil {
+			panic("Unable to encode Proto: " + err.Error())
+		}
+	}
+
+	w.Header().Set("Content-Length", strconv.Itoa(len(responseBytes)))
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+
+	w.Write(responseBytes)
+}
+
+// PrefersJSON returns true if the response to req should be JSON rather than
+// protobuf.
+func PrefersJSON(req *http.Request) bool {
+	for _, accept := range req.Header[bbs.AcceptHeader] {
+		for _, mediaRange := range strings.Split(accept, ",") {
+			mediaType, _, err := mime.ParseMediaType(mediaRange)
+			if err != nil {
+				continue
+			}
+
+			switch mediaType {
+			case bbs.JSONContentType:
+				return true
+			case bbs.ProtoContentType:
+				return false
+			}
+		}
+	}
+
+	return IsJSONMediaType(req.Header.Get(bbs.ContentTypeHeader))
+}
+
+func IsJSONMediaType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && mediaType == bbs.JSONContentType
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"time"
 
+	"code.cloudfoundry.org/bbs/trace"
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/runtimeschema/metric"
 )
@@ -15,7 +16,10 @@ const (
 
 type LoggableHandlerFunc func(logger lager.Logger, w http.ResponseWriter, r *http.Request)
 
-func LogWrap(logger, accessLogger lager.Logger, loggableHandlerFunc LoggableHandlerFunc) http.HandlerFunc {
+// LogWrap serves each request with a logger session carrying the request's
+// trace, continued from its X-Request-Id and traceparent headers or started
+// afresh. The request id is returned in the X-Request-Id response header.
+func LogWrap(logger lager.Logger, loggableHandlerFunc LoggableHandlerFunc) http.HandlerFunc {
 	lagerDataFromReq := func(r *http.Request) lager.Data {
 		return lager.Data{
 			"method":  r.Method,
@@ -23,33 +27,27 @@ func LogWrap(logger, accessLogger lager.Logger, loggableHandlerFunc LoggableHand
 		}
 	}
 
-	if accessLogger != nil {
-		return func(w http.ResponseWriter, r *http.Request) {
-			requestLog := logger.Session("request", lagerDataFromReq(r))
-			requestAccessLogger := accessLogger.Session("request", lagerDataFromReq(r))
-
-			requestAccessLogger.Info("serving")
-
-			requestLog.Debug("serving")
+	return func(w http.ResponseWriter, r *http.Request) {
+		requestTrace := traceFromRequest(r)
+		w.Header().Set(trace.RequestIDHeader, requestTrace.RequestID)
 
-			start := time.Now()
-			defer requestLog.Debug("done")
-			defer func() {
-				requestAccessLogger.Info("done", lager.Data{"duration": time.Since(start)})
-			}()
-			loggableHandlerFunc(requestLog, w, r)
-		}
-	} else {
-		return func(w http.ResponseWriter, r *http.Request) {
-			requestLog := logger.Session("request", lagerDataFromReq(r))
+		requestLog := trace.WithTrace(logger.Session("request", lagerDataFromReq(r)), requestTrace)
 
-			requestLog.Debug("serving")
-			defer requestLog.Debug("done")
+		requestLog.Debug("serving")
+		defer requestLog.Debug("done")
 
-			loggableHandlerFunc(requestLog, w, r)
+		loggableHandlerFunc(requestLog, w, r)
+	}
+}
 
-		}
+// traceFromRequest returns the trace started for r by the AccessLogger, or
+// continues the trace of its headers if it was not logged.
+func traceFromRequest(r *http.Request) trace.Trace {
+	requestTrace, ok := trace.FromContext(r.Context())
+	if !ok {
+		requestTrace = trace.FromRequest(r)
 	}
+	return requestTrace
 }
 
 func NewLatencyEmitter(logger lager.Logger) LatencyEmitter {
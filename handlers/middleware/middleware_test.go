@@ -2,13 +2,18 @@ package middleware_test
 
 import (
 	"net/http"
+	"net/http/httptest"
 	"time"
 
+	"code.cloudfoundry.org/bbs"
 	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/trace"
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/cloudfoundry/dropsonde/metric_sender/fake"
 	dropsonde_metrics "github.com/cloudfoundry/dropsonde/metrics"
+	"github.com/gogo/protobuf/proto"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -63,6 +68,70 @@ var _ = Describe("Middleware", func() {
 		})
 	})
 
+	Describe("EmitRouteMetrics", func() {
+		var (
+			sender   *fake.FakeMetricSender
+			route    string
+			response proto.Message
+			handler  http.Handler
+		)
+
+		BeforeEach(func() {
+			sender = fake.NewFakeMetricSender()
+			dropsonde_metrics.Initialize(sender, nil)
+			route = bbs.TasksRoute
+			response = &models.TasksResponse{}
+		})
+
+		JustBeforeEach(func() {
+			logger := lager.NewLogger("test-session")
+			handler = middleware.NewLatencyEmitter(logger).EmitRouteMetrics(route, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				time.Sleep(10)
+				middleware.WriteMessage(w, r, response)
+			}))
+
+			req, err := http.NewRequest("POST", "/", nil)
+			Expect(err).NotTo(HaveOccurred())
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+		})
+
+		It("reports the latency of the route", func() {
+			latency := sender.GetValue("RequestLatency.Tasks_r2")
+			Expect(latency.Value).NotTo(BeZero())
+			Expect(latency.Unit).To(Equal("nanos"))
+		})
+
+		It("counts the requests to the route and their status", func() {
+			Expect(sender.GetCounter("RequestCount.Tasks_r2")).To(Equal(uint64(1)))
+			Expect(sender.GetCounter("ResponseStatus.Tasks_r2.200")).To(Equal(uint64(1)))
+		})
+
+		It("does not count errors", func() {
+			Expect(sender.GetCounter("RequestErrors.Tasks_r2.UnknownError")).To(BeZero())
+		})
+
+		Context("when the response carries an error", func() {
+			BeforeEach(func() {
+				response = &models.TasksResponse{Error: models.ErrResourceNotFound}
+			})
+
+			It("counts the error by type", func() {
+				Expect(sender.GetCounter("RequestErrors.Tasks_r2.ResourceNotFound")).To(Equal(uint64(1)))
+			})
+		})
+
+		Context("when the route is an event stream", func() {
+			BeforeEach(func() {
+				route = bbs.EventStreamRoute_r0
+			})
+
+			It("does not report the latency", func() {
+				Expect(sender.GetValue("RequestLatency.EventStream_r0").Unit).To(BeEmpty())
+				Expect(sender.GetCounter("RequestCount.EventStream_r0")).To(Equal(uint64(1)))
+			})
+		})
+	})
+
 	Describe("LogWrap", func() {
 		var (
 			logger              *lagertest.TestLogger
@@ -79,10 +148,10 @@ var _ = Describe("Middleware", func() {
 		})
 
 		It("creates \"request\" session and passes it to LoggableHandlerFunc", func() {
-			handler := middleware.LogWrap(logger, nil, loggableHandlerFunc)
+			handler := middleware.LogWrap(logger, loggableHandlerFunc)
 			req, err := http.NewRequest("GET", "http://example.com", nil)
 			Expect(err).NotTo(HaveOccurred())
-			handler.ServeHTTP(nil, req)
+			handler.ServeHTTP(httptest.NewRecorder(), req)
 			Expect(logger.Buffer()).To(gbytes.Say("test-session.request.serving"))
 			Expect(logger.Buffer()).To(gbytes.Say("\"session\":\"1\""))
 			Expect(logger.Buffer()).To(gbytes.Say("test-session.request.logger-group.written-in-loggable-handler"))
@@ -91,29 +160,55 @@ var _ = Describe("Middleware", func() {
 			Expect(logger.Buffer()).To(gbytes.Say("\"session\":\"1\""))
 		})
 
-		Context("with access loggger", func() {
-			var accessLogger *lagertest.TestLogger
+		Describe("request tracing", func() {
+			var (
+				handler      http.HandlerFunc
+				req          *http.Request
+				recorder     *httptest.ResponseRecorder
+				handlerTrace trace.Trace
+			)
 
 			BeforeEach(func() {
-				accessLogger = lagertest.NewTestLogger("test-access-session")
-				accessLogger.RegisterSink(lager.NewWriterSink(GinkgoWriter, lager.DEBUG))
+				loggableHandlerFunc = func(logger lager.Logger, w http.ResponseWriter, r *http.Request) {
+					var ok bool
+					handlerTrace, ok = trace.FromLogger(logger.Session("logger-group"))
+					Expect(ok).To(BeTrue())
+				}
+				handler = middleware.LogWrap(logger, loggableHandlerFunc)
+
+				var err error
+				req, err = http.NewRequest("GET", "http://example.com", nil)
+				Expect(err).NotTo(HaveOccurred())
+				recorder = httptest.NewRecorder()
 			})
 
-			It("creates \"request\" session and passes it to LoggableHandlerFunc", func() {
-				handler := middleware.LogWrap(logger, accessLogger, loggableHandlerFunc)
-				req, err := http.NewRequest("GET", "http://example.com", nil)
-				Expect(err).NotTo(HaveOccurred())
-				handler.ServeHTTP(nil, req)
-				Expect(logger.Buffer()).To(gbytes.Say("test-session.request.serving"))
-				Expect(logger.Buffer()).To(gbytes.Say("\"session\":\"1\""))
-				Expect(accessLogger.Buffer()).To(gbytes.Say("test-access-session.request.serving"))
-				Expect(accessLogger.Buffer()).To(gbytes.Say("\"session\":\"1\""))
-				Expect(logger.Buffer()).To(gbytes.Say("test-session.request.logger-group.written-in-loggable-handler"))
-				Expect(logger.Buffer()).To(gbytes.Say("\"session\":\"1.1\""))
-				Expect(accessLogger.Buffer()).To(gbytes.Say("test-access-session.request.done"))
-				Expect(accessLogger.Buffer()).To(gbytes.Say("\"session\":\"1\""))
-				Expect(logger.Buffer()).To(gbytes.Say("test-session.request.done"))
-				Expect(logger.Buffer()).To(gbytes.Say("\"session\":\"1\""))
+			It("uses the trace started by the access logger", func() {
+				requestTrace := trace.New()
+				req = req.WithContext(trace.NewContext(req.Context(), requestTrace))
+				handler.ServeHTTP(recorder, req)
+
+				Expect(handlerTrace).To(Equal(requestTrace))
+				Expect(recorder.Header().Get(trace.RequestIDHeader)).To(Equal(requestTrace.RequestID))
+			})
+
+			It("continues the trace of the request", func() {
+				req.Header.Set(trace.RequestIDHeader, "some-request-id")
+				req.Header.Set(trace.TraceparentHeader, "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+				handler.ServeHTTP(recorder, req)
+
+				Expect(handlerTrace.RequestID).To(Equal("some-request-id"))
+				Expect(handlerTrace.TraceID).To(Equal("0af7651916cd43dd8448eb211c80319c"))
+				Expect(handlerTrace.SpanID).NotTo(Equal("b7ad6b7169203331"))
+				Expect(recorder.Header().Get(trace.RequestIDHeader)).To(Equal("some-request-id"))
+				Expect(logger.Buffer()).To(gbytes.Say("test-session.request.serving.*\"request-id\":\"some-request-id\""))
+			})
+
+			It("starts a trace for requests without one", func() {
+				handler.ServeHTTP(recorder, req)
+
+				Expect(handlerTrace.RequestID).NotTo(BeEmpty())
+				Expect(handlerTrace.TraceID).To(HaveLen(32))
+				Expect(recorder.Header().Get(trace.RequestIDHeader)).To(Equal(handlerTrace.RequestID))
 			})
 		})
 	})
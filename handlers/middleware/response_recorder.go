@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/models"
+)
+
+// ResponseRecorder remembers the status and size of the response and the error
+// written by WriteMessage. It passes flushes and close notifications through
+// for the event streams.
+type ResponseRecorder struct {
+	http.ResponseWriter
+	status int
+	size   int
+	err    *models.Error
+}
+
+func NewResponseRecorder(w http.ResponseWriter) *ResponseRecorder {
+	return &ResponseRecorder{ResponseWriter: w}
+}
+
+func (w *ResponseRecorder) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *ResponseRecorder) Write(data []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	n, err := w.ResponseWriter.Write(data)
+	w.size += n
+	return n, err
+}
+
+func (w *ResponseRecorder) Status() int {
+	if w.status == 0 {
+		return http.StatusOK
+	}
+	return w.status
+}
+
+func (w *ResponseRecorder) Size() int {
+	return w.size
+}
+
+// Error returns the error carried by the message written with WriteMessage, if
+// any.
+func (w *ResponseRecorder) Error() *models.Error {
+	return w.err
+}
+
+func (w *ResponseRecorder) recordError(err *models.Error) {
+	w.err = err
+	if recorder, ok := w.ResponseWriter.(errorRecorder); ok {
+		recorder.recordError(err)
+	}
+}
+
+func (w *ResponseRecorder) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (w *ResponseRecorder) CloseNotify() <-chan bool {
+	if notifier, ok := w.ResponseWriter.(http.CloseNotifier); ok {
+		return notifier.CloseNotify()
+	}
+	return make(chan bool)
+}
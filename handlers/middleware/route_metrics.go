@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/runtimeschema/metric"
+)
+
+// EmitRouteMetrics sends metrics named after the route for every request to
+// it: RequestCount.<route> counts the requests, RequestLatency.<route> is the
+// time taken to serve them, ResponseStatus.<route>.<status> counts the
+// responses with each status and RequestErrors.<route>.<type> the responses
+// carrying an error of each type. The latency of the event streams, which is
+// the lifetime of the subscription, is not sent.
+func (l LatencyEmitter) EmitRouteMetrics(route string, handler http.Handler) http.Handler {
+	count := metric.Counter("RequestCount." + route)
+	latency := metric.Duration("RequestLatency." + route)
+	streaming := isEventStream(route)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count.Increment()
+
+		recorder := NewResponseRecorder(w)
+		startTime := time.Now()
+		handler.ServeHTTP(recorder, r)
+
+		if !streaming {
+			err := latency.Send(time.Since(startTime))
+			if err != nil {
+				l.logger.Error("failed-to-send-route-latency-metric", err, lager.Data{"route": route})
+			}
+		}
+
+		metric.Counter(fmt.Sprintf("ResponseStatus.%s.%d", route, recorder.Status())).Increment()
+		if recorder.Error() != nil {
+			metric.Counter(fmt.Sprintf("RequestErrors.%s.%s", route, recorder.Error().Type)).Increment()
+		}
+	})
+}
+
+func isEventStream(route string) bool {
+	return route == bbs.EventStreamRoute_r0 || route == bbs.TaskEventStreamRoute_r0
+}
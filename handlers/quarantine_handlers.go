@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+var ErrQuarantineUnavailable = models.NewError(models.Error_InvalidRequest, "quarantined records require a SQL database")
+
+type QuarantineHandler struct {
+	db       db.QuarantineDB
+	exitChan chan<- struct{}
+}
+
+// NewQuarantineHandler returns a handler serving the quarantined records from
+// db, which is nil when the BBS is not backed by a SQL database.
+func NewQuarantineHandler(db db.QuarantineDB, exitChan chan<- struct{}) *QuarantineHandler {
+	return &QuarantineHandler{
+		db:       db,
+		exitChan: exitChan,
+	}
+}
+
+func (h *QuarantineHandler) QuarantinedRecords(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("quarantined-records")
+
+	request := &models.QuarantinedRecordsRequest{}
+	response := &models.QuarantinedRecordsResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, req, response) }()
+
+	if h.db == nil {
+		response.Error = ErrQuarantineUnavailable
+		return
+	}
+
+	err = parseRequest(logger, req, request)
+	if err != nil {
+		logger.Error("failed-parsing-request", err)
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	response.QuarantinedRecords, err = h.db.QuarantinedRecords(logger, request.TableName)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *QuarantineHandler) QuarantinedRecord(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("quarantined-record")
+
+	request := &models.QuarantinedRecordRequest{}
+	response := &models.QuarantinedRecordResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, req, response) }()
+
+	if h.db == nil {
+		response.Error = ErrQuarantineUnavailable
+		return
+	}
+
+	err = parseRequest(logger, req, request)
+	if err != nil {
+		logger.Error("failed-parsing-request", err)
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	response.QuarantinedRecord, err = h.db.QuarantinedRecord(logger, request.Id)
+	response.Error = models.ConvertError(err)
+}
+
+// RestoreQuarantinedRecord puts a quarantined row back into its table. No
+// events are emitted for the restored LRP or task.
+func (h *QuarantineHandler) RestoreQuarantinedRecord(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("restore-quarantined-record")
+
+	request := &models.RestoreQuarantinedRecordRequest{}
+	response := &models.RestoreQuarantinedRecordResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, req, response) }()
+
+	if h.db == nil {
+		response.Error = ErrQuarantineUnavailable
+		return
+	}
+
+	err = parseRequest(logger, req, request)
+	if err != nil {
+		logger.Error("failed-parsing-request", err)
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.db.RestoreQuarantinedRecord(logger, request.Id)
+	response.Error = models.ConvertError(err)
+}
+
+// RestoreQuarantinedRecords puts the quarantined rows of a table, or of every
+// table, back. The records that cannot be restored stay in quarantine and are
+// listed in the response.
+func (h *QuarantineHandler) RestoreQuarantinedRecords(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("restore-quarantined-records")
+
+	request := &models.RestoreQuarantinedRecordsRequest{}
+	response := &models.RestoreQuarantinedRecordsResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, req, response) }()
+
+	if h.db == nil {
+		response.Error = ErrQuarantineUnavailable
+		return
+	}
+
+	err = parseRequest(logger, req, request)
+	if err != nil {
+		logger.Error("failed-parsing-request", err)
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	response.RestoredIds, response.FailedIds, err = h.db.RestoreQuarantinedRecords(logger, request.TableName)
+	response.Error = models.ConvertError(err)
+}
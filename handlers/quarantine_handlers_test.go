@@ -0,0 +1,257 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Quarantine Handlers", func() {
+	var (
+		logger           *lagertest.TestLogger
+		fakeQuarantineDB *dbfakes.FakeQuarantineDB
+		responseRecorder *httptest.ResponseRecorder
+		handler          *handlers.QuarantineHandler
+		exitCh           chan struct{}
+
+		record1, record2 *models.QuarantinedRecord
+	)
+
+	BeforeEach(func() {
+		fakeQuarantineDB = new(dbfakes.FakeQuarantineDB)
+		logger = lagertest.NewTestLogger("test")
+		responseRecorder = httptest.NewRecorder()
+		exitCh = make(chan struct{}, 1)
+		handler = handlers.NewQuarantineHandler(fakeQuarantineDB, exitCh)
+
+		record1 = &models.QuarantinedRecord{Id: 1, TableName: models.QuarantinedDesiredLRPsTable, Guid: "process-guid", Reason: "failed to deserialize run_info"}
+		record2 = &models.QuarantinedRecord{Id: 2, TableName: models.QuarantinedTasksTable, Guid: "task-guid", Reason: "failed to deserialize task_definition"}
+	})
+
+	Describe("QuarantinedRecords", func() {
+		var requestBody interface{}
+
+		BeforeEach(func() {
+			fakeQuarantineDB.QuarantinedRecordsReturns([]*models.QuarantinedRecord{record1, record2}, nil)
+			requestBody = &models.QuarantinedRecordsRequest{}
+		})
+
+		JustBeforeEach(func() {
+			handler.QuarantinedRecords(logger, responseRecorder, newTestRequest(requestBody))
+		})
+
+		parseResponse := func() *models.QuarantinedRecordsResponse {
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			response := &models.QuarantinedRecordsResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			return response
+		}
+
+		It("lists the quarantined records", func() {
+			Expect(fakeQuarantineDB.QuarantinedRecordsCallCount()).To(Equal(1))
+			_, tableName := fakeQuarantineDB.QuarantinedRecordsArgsForCall(0)
+			Expect(tableName).To(BeEmpty())
+
+			response := parseResponse()
+			Expect(response.Error).To(BeNil())
+			Expect(response.QuarantinedRecords).To(Equal([]*models.QuarantinedRecord{record1, record2}))
+		})
+
+		Context("when filtering by table", func() {
+			BeforeEach(func() {
+				requestBody = &models.QuarantinedRecordsRequest{TableName: models.QuarantinedTasksTable}
+			})
+
+			It("passes the table name to the DB", func() {
+				_, tableName := fakeQuarantineDB.QuarantinedRecordsArgsForCall(0)
+				Expect(tableName).To(Equal(models.QuarantinedTasksTable))
+			})
+		})
+
+		Context("when the request is invalid", func() {
+			BeforeEach(func() {
+				requestBody = &models.QuarantinedRecordsRequest{TableName: "domains"}
+			})
+
+			It("responds with an InvalidRequest error", func() {
+				Expect(fakeQuarantineDB.QuarantinedRecordsCallCount()).To(Equal(0))
+				Expect(parseResponse().Error.Type).To(Equal(models.Error_InvalidRequest))
+			})
+		})
+
+		Context("when the DB fails", func() {
+			BeforeEach(func() {
+				fakeQuarantineDB.QuarantinedRecordsReturns(nil, models.ErrUnknownError)
+			})
+
+			It("responds with the error", func() {
+				Expect(parseResponse().Error).To(Equal(models.ErrUnknownError))
+			})
+		})
+
+		Context("when the BBS is not backed by a SQL database", func() {
+			BeforeEach(func() {
+				handler = handlers.NewQuarantineHandler(nil, exitCh)
+			})
+
+			It("responds with an error saying so", func() {
+				Expect(parseResponse().Error).To(Equal(handlers.ErrQuarantineUnavailable))
+			})
+		})
+	})
+
+	Describe("QuarantinedRecord", func() {
+		var requestBody interface{}
+
+		BeforeEach(func() {
+			fakeQuarantineDB.QuarantinedRecordReturns(record1, nil)
+			requestBody = &models.QuarantinedRecordRequest{Id: 1}
+		})
+
+		JustBeforeEach(func() {
+			handler.QuarantinedRecord(logger, responseRecorder, newTestRequest(requestBody))
+		})
+
+		parseResponse := func() *models.QuarantinedRecordResponse {
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			response := &models.QuarantinedRecordResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			return response
+		}
+
+		It("returns the quarantined record", func() {
+			Expect(fakeQuarantineDB.QuarantinedRecordCallCount()).To(Equal(1))
+			_, id := fakeQuarantineDB.QuarantinedRecordArgsForCall(0)
+			Expect(id).To(BeEquivalentTo(1))
+
+			response := parseResponse()
+			Expect(response.Error).To(BeNil())
+			Expect(response.QuarantinedRecord).To(Equal(record1))
+		})
+
+		Context("when the record does not exist", func() {
+			BeforeEach(func() {
+				fakeQuarantineDB.QuarantinedRecordReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("responds with a ResourceNotFound", func() {
+				Expect(parseResponse().Error).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+
+		Context("when the request is invalid", func() {
+			BeforeEach(func() {
+				requestBody = &models.QuarantinedRecordRequest{}
+			})
+
+			It("responds with an InvalidRequest error", func() {
+				Expect(fakeQuarantineDB.QuarantinedRecordCallCount()).To(Equal(0))
+				Expect(parseResponse().Error.Type).To(Equal(models.Error_InvalidRequest))
+			})
+		})
+	})
+
+	Describe("RestoreQuarantinedRecord", func() {
+		var requestBody interface{}
+
+		BeforeEach(func() {
+			requestBody = &models.RestoreQuarantinedRecordRequest{Id: 2}
+		})
+
+		JustBeforeEach(func() {
+			handler.RestoreQuarantinedRecord(logger, responseRecorder, newTestRequest(requestBody))
+		})
+
+		parseResponse := func() *models.RestoreQuarantinedRecordResponse {
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			response := &models.RestoreQuarantinedRecordResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			return response
+		}
+
+		It("restores the record", func() {
+			Expect(fakeQuarantineDB.RestoreQuarantinedRecordCallCount()).To(Equal(1))
+			_, id := fakeQuarantineDB.RestoreQuarantinedRecordArgsForCall(0)
+			Expect(id).To(BeEquivalentTo(2))
+			Expect(parseResponse().Error).To(BeNil())
+		})
+
+		Context("when the row has been replaced", func() {
+			BeforeEach(func() {
+				fakeQuarantineDB.RestoreQuarantinedRecordReturns(models.ErrResourceExists)
+			})
+
+			It("responds with a ResourceExists", func() {
+				Expect(parseResponse().Error).To(Equal(models.ErrResourceExists))
+			})
+		})
+
+		Context("when the DB fails unrecoverably", func() {
+			BeforeEach(func() {
+				fakeQuarantineDB.RestoreQuarantinedRecordReturns(models.NewUnrecoverableError(nil))
+			})
+
+			It("exits", func() {
+				Eventually(exitCh).Should(Receive())
+			})
+		})
+	})
+
+	Describe("RestoreQuarantinedRecords", func() {
+		var requestBody interface{}
+
+		BeforeEach(func() {
+			fakeQuarantineDB.RestoreQuarantinedRecordsReturns([]int64{1}, []int64{2}, nil)
+			requestBody = &models.RestoreQuarantinedRecordsRequest{TableName: models.QuarantinedTasksTable}
+		})
+
+		JustBeforeEach(func() {
+			handler.RestoreQuarantinedRecords(logger, responseRecorder, newTestRequest(requestBody))
+		})
+
+		parseResponse := func() *models.RestoreQuarantinedRecordsResponse {
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			response := &models.RestoreQuarantinedRecordsResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			return response
+		}
+
+		It("restores the records of the table and lists those that failed", func() {
+			Expect(fakeQuarantineDB.RestoreQuarantinedRecordsCallCount()).To(Equal(1))
+			_, tableName := fakeQuarantineDB.RestoreQuarantinedRecordsArgsForCall(0)
+			Expect(tableName).To(Equal(models.QuarantinedTasksTable))
+
+			response := parseResponse()
+			Expect(response.Error).To(BeNil())
+			Expect(response.RestoredIds).To(Equal([]int64{1}))
+			Expect(response.FailedIds).To(Equal([]int64{2}))
+		})
+
+		Context("when the request is invalid", func() {
+			BeforeEach(func() {
+				requestBody = &models.RestoreQuarantinedRecordsRequest{TableName: "domains"}
+			})
+
+			It("responds with an InvalidRequest error", func() {
+				Expect(fakeQuarantineDB.RestoreQuarantinedRecordsCallCount()).To(Equal(0))
+				Expect(parseResponse().Error.Type).To(Equal(models.Error_InvalidRequest))
+			})
+		})
+
+		Context("when the BBS is not backed by a SQL database", func() {
+			BeforeEach(func() {
+				handler = handlers.NewQuarantineHandler(nil, exitCh)
+			})
+
+			It("responds with an error saying so", func() {
+				Expect(parseResponse().Error).To(Equal(handlers.ErrQuarantineUnavailable))
+			})
+		})
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"time"
 
+	"code.cloudfoundry.org/bbs/handlers/middleware"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager"
 )
@@ -11,7 +12,7 @@ import (
 //go:generate counterfeiter -o fake_controllers/fake_task_controller.go . TaskController
 
 type TaskController interface {
-	Tasks(logger lager.Logger, domain, cellId string) ([]*models.Task, error)
+	Tasks(logger lager.Logger, filter models.TaskFilter) (tasks []*models.Task, nextPageToken string, err error)
 	TaskByGuid(logger lager.Logger, taskGuid string) (*models.Task, error)
 	DesireTask(logger lager.Logger, taskDefinition *models.TaskDefinition, taskGuid, domain string) error
 	StartTask(logger lager.Logger, taskGuid, cellId string) (shouldStart bool, err error)
@@ -24,17 +25,23 @@ type TaskController interface {
 }
 
 type TaskHandler struct {
-	controller TaskController
-	exitChan   chan<- struct{}
+	updateWorkersCount int
+	maxBulkItems       int
+	controller         TaskController
+	exitChan           chan<- struct{}
 }
 
 func NewTaskHandler(
+	updateWorkersCount int,
+	maxBulkItems int,
 	controller TaskController,
 	exitChan chan<- struct{},
 ) *TaskHandler {
 	return &TaskHandler{
-		controller: controller,
-		exitChan:   exitChan,
+		updateWorkersCount: updateWorkersCount,
+		maxBulkItems:       maxBulkItems,
+		controller:         controller,
+		exitChan:           exitChan,
 	}
 }
 
@@ -46,7 +53,7 @@ func (h *TaskHandler) Tasks(logger lager.Logger, w http.ResponseWriter, req *htt
 	response := &models.TasksResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer func() { writeResponse(w, response) }()
+	defer func() { writeResponse(w, req, response) }()
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -55,7 +62,9 @@ func (h *TaskHandler) Tasks(logger lager.Logger, w http.ResponseWriter, req *htt
 		return
 	}
 
-	response.Tasks, err = h.controller.Tasks(logger, request.Domain, request.CellId)
+	filter := request.Filter()
+	filter.AllowedDomains = middleware.DomainScopeFromRequest(req).Domains()
+	response.Tasks, response.NextPageToken, err = h.controller.Tasks(logger, filter)
 	response.Error = models.ConvertError(err)
 }
 
@@ -67,7 +76,7 @@ func (h *TaskHandler) TaskByGuid(logger lager.Logger, w http.ResponseWriter, req
 	response := &models.TaskResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer func() { writeResponse(w, response) }()
+	defer func() { writeResponse(w, req, response) }()
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -77,6 +86,12 @@ func (h *TaskHandler) TaskByGuid(logger lager.Logger, w http.ResponseWriter, req
 	}
 
 	response.Task, err = h.controller.TaskByGuid(logger, request.TaskGuid)
+	if err == nil {
+		err = authorizeDomain(req, response.Task.Domain)
+		if err != nil {
+			response.Task = nil
+		}
+	}
 	response.Error = models.ConvertError(err)
 }
 
@@ -88,7 +103,7 @@ func (h *TaskHandler) DesireTask(logger lager.Logger, w http.ResponseWriter, req
 	response := &models.TaskLifecycleResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer func() { writeResponse(w, response) }()
+	defer func() { writeResponse(w, req, response) }()
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -97,10 +112,60 @@ func (h *TaskHandler) DesireTask(logger lager.Logger, w http.ResponseWriter, req
 		return
 	}
 
+	err = authorizeDomain(req, request.Domain)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
 	err = h.controller.DesireTask(logger, request.TaskDefinition, request.TaskGuid, request.Domain)
 	response.Error = models.ConvertError(err)
 }
 
+// DesireTasks desires each of the Tasks in the request, at most
+// updateWorkersCount at a time, and reports the outcome for each of them.
+func (h *TaskHandler) DesireTasks(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("desire-tasks")
+
+	request := &models.DesireTasksRequest{}
+	response := &models.TasksLifecycleResponse{}
+
+	defer func() { h.exitIfAnyUnrecoverable(logger, response) }()
+	defer func() { writeResponse(w, req, response) }()
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		logger.Error("failed-parsing-request", err)
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	results := make([]*models.TaskLifecycleResult, len(request.Tasks))
+	err = workBulk(logger, h.updateWorkersCount, h.maxBulkItems, len(request.Tasks), func(i int) {
+		taskRequest := request.Tasks[i]
+
+		var err error
+		if taskRequest == nil {
+			err = models.NewError(models.Error_InvalidRequest, models.ErrInvalidField{"tasks"}.Error())
+		} else if err = taskRequest.Validate(); err != nil {
+			err = models.NewError(models.Error_InvalidRequest, err.Error())
+		} else if err = authorizeDomain(req, taskRequest.Domain); err == nil {
+			err = h.controller.DesireTask(logger, taskRequest.TaskDefinition, taskRequest.TaskGuid, taskRequest.Domain)
+		}
+
+		results[i] = &models.TaskLifecycleResult{
+			TaskGuid: taskRequest.GetTaskGuid(),
+			Error:    models.ConvertError(err),
+		}
+	})
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	response.Results = results
+}
+
 func (h *TaskHandler) StartTask(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	var err error
 	logger = logger.Session("start-task")
@@ -109,7 +174,7 @@ func (h *TaskHandler) StartTask(logger lager.Logger, w http.ResponseWriter, req
 	response := &models.StartTaskResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer func() { writeResponse(w, response) }()
+	defer func() { writeResponse(w, req, response) }()
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -129,7 +194,7 @@ func (h *TaskHandler) CancelTask(logger lager.Logger, w http.ResponseWriter, req
 	response := &models.TaskLifecycleResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer func() { writeResponse(w, response) }()
+	defer func() { writeResponse(w, req, response) }()
 
 	err := parseRequest(logger, req, request)
 	if err != nil {
@@ -138,6 +203,12 @@ func (h *TaskHandler) CancelTask(logger lager.Logger, w http.ResponseWriter, req
 		return
 	}
 
+	err = h.authorizeTaskGuid(logger, req, request.TaskGuid)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
 	err = h.controller.CancelTask(logger, request.TaskGuid)
 	response.Error = models.ConvertError(err)
 }
@@ -150,7 +221,7 @@ func (h *TaskHandler) FailTask(logger lager.Logger, w http.ResponseWriter, req *
 	response := &models.TaskLifecycleResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer func() { writeResponse(w, response) }()
+	defer func() { writeResponse(w, req, response) }()
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -171,7 +242,7 @@ func (h *TaskHandler) CompleteTask(logger lager.Logger, w http.ResponseWriter, r
 	response := &models.TaskLifecycleResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer func() { writeResponse(w, response) }()
+	defer func() { writeResponse(w, req, response) }()
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -192,7 +263,7 @@ func (h *TaskHandler) ResolvingTask(logger lager.Logger, w http.ResponseWriter,
 	response := &models.TaskLifecycleResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer func() { writeResponse(w, response) }()
+	defer func() { writeResponse(w, req, response) }()
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -201,6 +272,12 @@ func (h *TaskHandler) ResolvingTask(logger lager.Logger, w http.ResponseWriter,
 		return
 	}
 
+	err = h.authorizeTaskGuid(logger, req, request.TaskGuid)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
 	err = h.controller.ResolvingTask(logger, request.TaskGuid)
 	response.Error = models.ConvertError(err)
 }
@@ -213,7 +290,7 @@ func (h *TaskHandler) DeleteTask(logger lager.Logger, w http.ResponseWriter, req
 	response := &models.TaskLifecycleResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer func() { writeResponse(w, response) }()
+	defer func() { writeResponse(w, req, response) }()
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -222,6 +299,87 @@ func (h *TaskHandler) DeleteTask(logger lager.Logger, w http.ResponseWriter, req
 		return
 	}
 
+	err = h.authorizeTaskGuid(logger, req, request.TaskGuid)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
 	err = h.controller.DeleteTask(logger, request.TaskGuid)
 	response.Error = models.ConvertError(err)
 }
+
+// CancelTasks cancels each of the Tasks in the request, at most
+// updateWorkersCount at a time, and reports the outcome for each of them.
+func (h *TaskHandler) CancelTasks(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	h.bulkTaskGuids(logger.Session("cancel-tasks"), w, req, h.controller.CancelTask)
+}
+
+// DeleteTasks deletes each of the resolving Tasks in the request, at most
+// updateWorkersCount at a time, and reports the outcome for each of them.
+func (h *TaskHandler) DeleteTasks(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	h.bulkTaskGuids(logger.Session("delete-tasks"), w, req, h.controller.DeleteTask)
+}
+
+// bulkTaskGuids applies action to each of the Tasks of a TaskGuidsRequest as
+// the route for a single Task would.
+func (h *TaskHandler) bulkTaskGuids(logger lager.Logger, w http.ResponseWriter, req *http.Request, action func(lager.Logger, string) error) {
+	request := &models.TaskGuidsRequest{}
+	response := &models.TasksLifecycleResponse{}
+
+	defer func() { h.exitIfAnyUnrecoverable(logger, response) }()
+	defer func() { writeResponse(w, req, response) }()
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		logger.Error("failed-parsing-request", err)
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	results := make([]*models.TaskLifecycleResult, len(request.TaskGuids))
+	err = workBulk(logger, h.updateWorkersCount, h.maxBulkItems, len(request.TaskGuids), func(i int) {
+		taskGuid := request.TaskGuids[i]
+
+		itemRequest := &models.TaskGuidRequest{TaskGuid: taskGuid}
+		err := itemRequest.Validate()
+		if err != nil {
+			err = models.NewError(models.Error_InvalidRequest, err.Error())
+		} else if err = h.authorizeTaskGuid(logger, req, taskGuid); err == nil {
+			err = action(logger, taskGuid)
+		}
+
+		results[i] = &models.TaskLifecycleResult{
+			TaskGuid: taskGuid,
+			Error:    models.ConvertError(err),
+		}
+	})
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	response.Results = results
+}
+
+func (h *TaskHandler) exitIfAnyUnrecoverable(logger lager.Logger, response *models.TasksLifecycleResponse) {
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+	for _, result := range response.Results {
+		exitIfUnrecoverable(logger, h.exitChan, result.Error)
+	}
+}
+
+// authorizeTaskGuid returns ErrUnauthorized unless the client making req may
+// access the domain of the Task.
+func (h *TaskHandler) authorizeTaskGuid(logger lager.Logger, req *http.Request, taskGuid string) error {
+	if middleware.DomainScopeFromRequest(req) == nil {
+		return nil
+	}
+
+	task, err := h.controller.TaskByGuid(logger, taskGuid)
+	if err != nil {
+		return err
+	}
+
+	return authorizeDomain(req, task.Domain)
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager"
 )
@@ -17,7 +18,7 @@ func (h *TaskHandler) commonTasks(logger lager.Logger, w http.ResponseWriter, re
 	response := &models.TasksResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -27,11 +28,12 @@ func (h *TaskHandler) commonTasks(logger lager.Logger, w http.ResponseWriter, re
 	}
 
 	filter := models.TaskFilter{Domain: request.Domain, CellID: request.CellId}
-	response.Tasks, err = h.controller.Tasks(logger, filter.Domain, filter.CellID)
+	response.Tasks, _, err = h.controller.Tasks(logger, filter)
 	if err != nil {
 		response.Error = models.ConvertError(err)
 		return
 	}
+	response.Tasks = scopeTasks(middleware.DomainScopeFromRequest(req), response.Tasks)
 
 	for i := range response.Tasks {
 		task := response.Tasks[i]
@@ -58,7 +60,7 @@ func (h *TaskHandler) commonTaskByGuid(logger lager.Logger, w http.ResponseWrite
 	response := &models.TaskResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -68,7 +70,11 @@ func (h *TaskHandler) commonTaskByGuid(logger lager.Logger, w http.ResponseWrite
 	}
 
 	response.Task, err = h.controller.TaskByGuid(logger, request.TaskGuid)
+	if err == nil {
+		err = authorizeDomain(req, response.Task.Domain)
+	}
 	if err != nil {
+		response.Task = nil
 		response.Error = models.ConvertError(err)
 		return
 	}
@@ -95,7 +101,7 @@ func (h *TaskHandler) DesireTask_r1(logger lager.Logger, w http.ResponseWriter,
 	response := &models.TaskLifecycleResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer func() { writeResponse(w, response) }()
+	defer func() { writeResponse(w, req, response) }()
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -108,6 +114,12 @@ func (h *TaskHandler) DesireTask_r1(logger lager.Logger, w http.ResponseWriter,
 		request.TaskDefinition.VolumeMounts[i] = mount.VersionUpToV1()
 	}
 
+	err = authorizeDomain(req, request.Domain)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
 	err = h.controller.DesireTask(logger, request.TaskDefinition, request.TaskGuid, request.Domain)
 	response.Error = models.ConvertError(err)
 }
@@ -120,7 +132,7 @@ func (h *TaskHandler) DesireTask_r0(logger lager.Logger, w http.ResponseWriter,
 	response := &models.TaskLifecycleResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err = parseRequestForDesireTask_r0(logger, req, request)
 	if err != nil {
@@ -129,6 +141,12 @@ func (h *TaskHandler) DesireTask_r0(logger lager.Logger, w http.ResponseWriter,
 		return
 	}
 
+	err = authorizeDomain(req, request.Domain)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
 	err = h.controller.DesireTask(logger, request.TaskDefinition, request.TaskGuid, request.Domain)
 	if err != nil {
 		response.Error = models.ConvertError(err)
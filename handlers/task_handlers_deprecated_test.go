@@ -39,7 +39,7 @@ var _ = Describe("Task Handlers", func() {
 		responseRecorder = httptest.NewRecorder()
 		exitCh = make(chan struct{}, 1)
 		controller = &fake_controllers.FakeTaskController{}
-		handler = handlers.NewTaskHandler(controller, exitCh)
+		handler = handlers.NewTaskHandler(5, 10, controller, exitCh)
 	})
 
 	Describe("DesireTask", func() {
@@ -111,7 +111,7 @@ var _ = Describe("Task Handlers", func() {
 
 			BeforeEach(func() {
 				tasks = []*models.Task{&task1, &task2}
-				controller.TasksReturns(tasks, nil)
+				controller.TasksReturns(tasks, "", nil)
 			})
 
 			It("returns a list of task", func() {
@@ -126,9 +126,9 @@ var _ = Describe("Task Handlers", func() {
 
 			It("calls the DB with no filter", func() {
 				Expect(controller.TasksCallCount()).To(Equal(1))
-				_, domain, cellID := controller.TasksArgsForCall(0)
-				Expect(domain).To(Equal(""))
-				Expect(cellID).To(Equal(""))
+				_, filter := controller.TasksArgsForCall(0)
+				Expect(filter.Domain).To(Equal(""))
+				Expect(filter.CellID).To(Equal(""))
 			})
 
 			Context("and filtering by domain", func() {
@@ -140,9 +140,9 @@ var _ = Describe("Task Handlers", func() {
 
 				It("calls the DB with a domain filter", func() {
 					Expect(controller.TasksCallCount()).To(Equal(1))
-					_, domain, cellID := controller.TasksArgsForCall(0)
-					Expect(domain).To(Equal("domain-1"))
-					Expect(cellID).To(Equal(""))
+					_, filter := controller.TasksArgsForCall(0)
+					Expect(filter.Domain).To(Equal("domain-1"))
+					Expect(filter.CellID).To(Equal(""))
 				})
 			})
 
@@ -155,9 +155,9 @@ var _ = Describe("Task Handlers", func() {
 
 				It("calls the DB with a cell filter", func() {
 					Expect(controller.TasksCallCount()).To(Equal(1))
-					_, domain, cellID := controller.TasksArgsForCall(0)
-					Expect(domain).To(Equal(""))
-					Expect(cellID).To(Equal("cell-id"))
+					_, filter := controller.TasksArgsForCall(0)
+					Expect(filter.Domain).To(Equal(""))
+					Expect(filter.CellID).To(Equal("cell-id"))
 				})
 			})
 
@@ -198,7 +198,7 @@ var _ = Describe("Task Handlers", func() {
 
 		Context("when the DB returns an unrecoverable error", func() {
 			BeforeEach(func() {
-				controller.TasksReturns(nil, models.NewUnrecoverableError(nil))
+				controller.TasksReturns(nil, "", models.NewUnrecoverableError(nil))
 			})
 
 			It("logs and writes to the exit channel", func() {
@@ -209,7 +209,7 @@ var _ = Describe("Task Handlers", func() {
 
 		Context("when the DB errors out", func() {
 			BeforeEach(func() {
-				controller.TasksReturns(nil, models.ErrUnknownError)
+				controller.TasksReturns(nil, "", models.ErrUnknownError)
 			})
 
 			It("provides relevant error information", func() {
@@ -240,7 +240,7 @@ var _ = Describe("Task Handlers", func() {
 
 			BeforeEach(func() {
 				tasks = []*models.Task{&task1, &task2}
-				controller.TasksReturns(tasks, nil)
+				controller.TasksReturns(tasks, "", nil)
 			})
 
 			It("returns a list of task", func() {
@@ -255,9 +255,9 @@ var _ = Describe("Task Handlers", func() {
 
 			It("calls the DB with no filter", func() {
 				Expect(controller.TasksCallCount()).To(Equal(1))
-				_, domain, cellID := controller.TasksArgsForCall(0)
-				Expect(domain).To(Equal(""))
-				Expect(cellID).To(Equal(""))
+				_, filter := controller.TasksArgsForCall(0)
+				Expect(filter.Domain).To(Equal(""))
+				Expect(filter.CellID).To(Equal(""))
 			})
 
 			Context("and filtering by domain", func() {
@@ -269,9 +269,9 @@ var _ = Describe("Task Handlers", func() {
 
 				It("calls the DB with a domain filter", func() {
 					Expect(controller.TasksCallCount()).To(Equal(1))
-					_, domain, cellID := controller.TasksArgsForCall(0)
-					Expect(domain).To(Equal("domain-1"))
-					Expect(cellID).To(Equal(""))
+					_, filter := controller.TasksArgsForCall(0)
+					Expect(filter.Domain).To(Equal("domain-1"))
+					Expect(filter.CellID).To(Equal(""))
 				})
 			})
 
@@ -284,9 +284,9 @@ var _ = Describe("Task Handlers", func() {
 
 				It("calls the DB with a cell filter", func() {
 					Expect(controller.TasksCallCount()).To(Equal(1))
-					_, domain, cellID := controller.TasksArgsForCall(0)
-					Expect(domain).To(Equal(""))
-					Expect(cellID).To(Equal("cell-id"))
+					_, filter := controller.TasksArgsForCall(0)
+					Expect(filter.Domain).To(Equal(""))
+					Expect(filter.CellID).To(Equal("cell-id"))
 				})
 			})
 
@@ -321,7 +321,7 @@ var _ = Describe("Task Handlers", func() {
 
 		Context("when the DB returns an unrecoverable error", func() {
 			BeforeEach(func() {
-				controller.TasksReturns(nil, models.NewUnrecoverableError(nil))
+				controller.TasksReturns(nil, "", models.NewUnrecoverableError(nil))
 			})
 
 			It("logs and writes to the exit channel", func() {
@@ -332,7 +332,7 @@ var _ = Describe("Task Handlers", func() {
 
 		Context("when the DB errors out", func() {
 			BeforeEach(func() {
-				controller.TasksReturns(nil, models.ErrUnknownError)
+				controller.TasksReturns(nil, "", models.ErrUnknownError)
 			})
 
 			It("provides relevant error information", func() {
@@ -1,13 +1,16 @@
 package handlers_test
 
 import (
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 
 	"code.cloudfoundry.org/bbs/handlers"
 	"code.cloudfoundry.org/bbs/handlers/fake_controllers"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -34,7 +37,7 @@ var _ = Describe("Task Handlers", func() {
 		responseRecorder = httptest.NewRecorder()
 		exitCh = make(chan struct{}, 1)
 		controller = &fake_controllers.FakeTaskController{}
-		handler = handlers.NewTaskHandler(controller, exitCh)
+		handler = handlers.NewTaskHandler(5, 10, controller, exitCh)
 	})
 
 	Describe("Tasks", func() {
@@ -42,20 +45,26 @@ var _ = Describe("Task Handlers", func() {
 			task1          models.Task
 			task2          models.Task
 			cellId, domain string
+			pageSize       int32
+			pageToken      string
+			scope          middleware.DomainScope
 		)
 
 		BeforeEach(func() {
 			task1 = models.Task{Domain: "domain-1"}
 			task2 = models.Task{CellId: "cell-id"}
 			requestBody = &models.TasksRequest{}
+			scope = nil
 		})
 
 		JustBeforeEach(func() {
 			requestBody = &models.TasksRequest{
-				Domain: domain,
-				CellId: cellId,
+				Domain:    domain,
+				CellId:    cellId,
+				PageSize:  pageSize,
+				PageToken: pageToken,
 			}
-			request = newTestRequest(requestBody)
+			request = middleware.WithDomainScope(newTestRequest(requestBody), scope)
 			handler.Tasks(logger, responseRecorder, request)
 		})
 
@@ -64,7 +73,7 @@ var _ = Describe("Task Handlers", func() {
 
 			BeforeEach(func() {
 				tasks = []*models.Task{&task1, &task2}
-				controller.TasksReturns(tasks, nil)
+				controller.TasksReturns(tasks, "", nil)
 			})
 
 			It("returns a list of task", func() {
@@ -79,9 +88,10 @@ var _ = Describe("Task Handlers", func() {
 
 			It("calls the controller with no filter", func() {
 				Expect(controller.TasksCallCount()).To(Equal(1))
-				_, actualDomain, actualCellId := controller.TasksArgsForCall(0)
-				Expect(actualDomain).To(Equal(domain))
-				Expect(actualCellId).To(Equal(cellId))
+				_, filter := controller.TasksArgsForCall(0)
+				Expect(filter.Domain).To(Equal(domain))
+				Expect(filter.CellID).To(Equal(cellId))
+				Expect(filter.AllowedDomains).To(BeNil())
 			})
 
 			Context("and filtering by domain", func() {
@@ -91,9 +101,21 @@ var _ = Describe("Task Handlers", func() {
 
 				It("calls the controller with a domain filter", func() {
 					Expect(controller.TasksCallCount()).To(Equal(1))
-					_, actualDomain, actualCellId := controller.TasksArgsForCall(0)
-					Expect(actualDomain).To(Equal(domain))
-					Expect(actualCellId).To(Equal(cellId))
+					_, filter := controller.TasksArgsForCall(0)
+					Expect(filter.Domain).To(Equal(domain))
+					Expect(filter.CellID).To(Equal(cellId))
+				})
+			})
+
+			Context("and the client is restricted to some domains", func() {
+				BeforeEach(func() {
+					scope = middleware.NewDomainScope("domain-1")
+				})
+
+				It("calls the controller restricted to those domains", func() {
+					Expect(controller.TasksCallCount()).To(Equal(1))
+					_, filter := controller.TasksArgsForCall(0)
+					Expect(filter.AllowedDomains).To(Equal([]string{"domain-1"}))
 				})
 			})
 
@@ -104,16 +126,86 @@ var _ = Describe("Task Handlers", func() {
 
 				It("calls the controller with a cell filter", func() {
 					Expect(controller.TasksCallCount()).To(Equal(1))
-					_, actualDomain, actualCellId := controller.TasksArgsForCall(0)
-					Expect(actualDomain).To(Equal(domain))
-					Expect(actualCellId).To(Equal(cellId))
+					_, filter := controller.TasksArgsForCall(0)
+					Expect(filter.Domain).To(Equal(domain))
+					Expect(filter.CellID).To(Equal(cellId))
+				})
+			})
+
+			Context("and paging", func() {
+				BeforeEach(func() {
+					task1.TaskGuid = "task-guid-1"
+					task2.TaskGuid = "task-guid-2"
+					pageSize = 2
+				})
+
+				AfterEach(func() {
+					pageSize = 0
+					pageToken = ""
+				})
+
+				It("calls the controller with the page size", func() {
+					Expect(controller.TasksCallCount()).To(Equal(1))
+					_, filter := controller.TasksArgsForCall(0)
+					Expect(filter.PageSize).To(Equal(2))
+					Expect(filter.AfterTaskGuid).To(BeEmpty())
+				})
+
+				It("does not return a next page token when the controller reports the last page", func() {
+					response := models.TasksResponse{}
+					err := response.Unmarshal(responseRecorder.Body.Bytes())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(response.NextPageToken).To(BeEmpty())
+				})
+
+				Context("when the controller returns a token for the next page", func() {
+					BeforeEach(func() {
+						controller.TasksReturns(tasks, models.TaskFilter{PageSize: 2}.NextPageToken("task-guid-3"), nil)
+					})
+
+					It("returns the token", func() {
+						response := models.TasksResponse{}
+						err := response.Unmarshal(responseRecorder.Body.Bytes())
+						Expect(err).NotTo(HaveOccurred())
+						Expect(response.NextPageToken).NotTo(BeEmpty())
+
+						nextRequest := models.TasksRequest{PageSize: 2, PageToken: response.NextPageToken}
+						Expect(nextRequest.Validate()).To(Succeed())
+						Expect(nextRequest.Filter().AfterTaskGuid).To(Equal("task-guid-3"))
+					})
+				})
+
+				Context("when a page token is provided", func() {
+					BeforeEach(func() {
+						pageToken = models.TaskFilter{PageSize: 1}.NextPageToken("task-guid-0")
+					})
+
+					It("calls the controller starting after the token", func() {
+						Expect(controller.TasksCallCount()).To(Equal(1))
+						_, filter := controller.TasksArgsForCall(0)
+						Expect(filter.AfterTaskGuid).To(Equal("task-guid-0"))
+					})
+				})
+
+				Context("when the page token is invalid", func() {
+					BeforeEach(func() {
+						pageToken = "not-a-token!"
+					})
+
+					It("returns an invalid request error", func() {
+						Expect(controller.TasksCallCount()).To(Equal(0))
+						response := models.TasksResponse{}
+						err := response.Unmarshal(responseRecorder.Body.Bytes())
+						Expect(err).NotTo(HaveOccurred())
+						Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+					})
 				})
 			})
 		})
 
 		Context("when the controller returns an unrecoverable error", func() {
 			BeforeEach(func() {
-				controller.TasksReturns(nil, models.NewUnrecoverableError(nil))
+				controller.TasksReturns(nil, "", models.NewUnrecoverableError(nil))
 			})
 
 			It("logs and writes to the exit channel", func() {
@@ -124,7 +216,7 @@ var _ = Describe("Task Handlers", func() {
 
 		Context("when the controller errors out", func() {
 			BeforeEach(func() {
-				controller.TasksReturns(nil, models.ErrUnknownError)
+				controller.TasksReturns(nil, "", models.ErrUnknownError)
 			})
 
 			It("provides relevant error information", func() {
@@ -284,6 +376,142 @@ var _ = Describe("Task Handlers", func() {
 		})
 	})
 
+	Describe("DesireTasks", func() {
+		var taskDef *models.TaskDefinition
+
+		BeforeEach(func() {
+			taskDef = model_helpers.NewValidTaskDefinition()
+			requestBody = &models.DesireTasksRequest{
+				Tasks: []*models.DesireTaskRequest{
+					{TaskGuid: "task-guid-1", Domain: "domain", TaskDefinition: taskDef},
+					{TaskGuid: "task-guid-2", Domain: "domain", TaskDefinition: taskDef},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			request := newTestRequest(requestBody)
+			handler.DesireTasks(logger, responseRecorder, request)
+		})
+
+		It("desires each of the tasks", func() {
+			Expect(controller.DesireTaskCallCount()).To(Equal(2))
+			guids := []string{}
+			for i := 0; i < 2; i++ {
+				_, actualTaskDef, actualTaskGuid, actualDomain := controller.DesireTaskArgsForCall(i)
+				Expect(actualTaskDef).To(Equal(taskDef))
+				Expect(actualDomain).To(Equal("domain"))
+				guids = append(guids, actualTaskGuid)
+			}
+			Expect(guids).To(ConsistOf("task-guid-1", "task-guid-2"))
+		})
+
+		It("returns a result for each task, in order", func() {
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			response := &models.TasksLifecycleResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(response.Error).To(BeNil())
+			Expect(response.Results).To(Equal([]*models.TaskLifecycleResult{
+				{TaskGuid: "task-guid-1"},
+				{TaskGuid: "task-guid-2"},
+			}))
+		})
+
+		Context("when one of the tasks is invalid", func() {
+			BeforeEach(func() {
+				requestBody.(*models.DesireTasksRequest).Tasks[1].Domain = ""
+			})
+
+			It("only desires the valid task", func() {
+				Expect(controller.DesireTaskCallCount()).To(Equal(1))
+				_, _, actualTaskGuid, _ := controller.DesireTaskArgsForCall(0)
+				Expect(actualTaskGuid).To(Equal("task-guid-1"))
+			})
+
+			It("returns an InvalidRequest error for the invalid task", func() {
+				response := &models.TasksLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Results).To(HaveLen(2))
+				Expect(response.Results[0].Error).To(BeNil())
+				Expect(response.Results[1].TaskGuid).To(Equal("task-guid-2"))
+				Expect(response.Results[1].Error.Type).To(Equal(models.Error_InvalidRequest))
+			})
+		})
+
+		Context("when desiring one of the tasks fails", func() {
+			BeforeEach(func() {
+				controller.DesireTaskStub = func(_ lager.Logger, _ *models.TaskDefinition, taskGuid, _ string) error {
+					if taskGuid == "task-guid-2" {
+						return models.ErrResourceExists
+					}
+					return nil
+				}
+			})
+
+			It("returns the error for that task only", func() {
+				response := &models.TasksLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(BeNil())
+				Expect(response.Results).To(Equal([]*models.TaskLifecycleResult{
+					{TaskGuid: "task-guid-1"},
+					{TaskGuid: "task-guid-2", Error: models.ErrResourceExists},
+				}))
+			})
+		})
+
+		Context("when the controller returns an unrecoverable error", func() {
+			BeforeEach(func() {
+				controller.DesireTaskReturns(models.NewUnrecoverableError(nil))
+			})
+
+			It("logs and writes to the exit channel", func() {
+				Eventually(logger).Should(gbytes.Say("unrecoverable-error"))
+				Eventually(exitCh).Should(Receive())
+			})
+		})
+
+		Context("when the request has no tasks", func() {
+			BeforeEach(func() {
+				requestBody = &models.DesireTasksRequest{}
+			})
+
+			It("responds with an InvalidRequest error", func() {
+				response := &models.TasksLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+			})
+		})
+
+		Context("when the request has more tasks than allowed", func() {
+			BeforeEach(func() {
+				tasks := []*models.DesireTaskRequest{}
+				for i := 0; i < 11; i++ {
+					tasks = append(tasks, &models.DesireTaskRequest{TaskGuid: fmt.Sprintf("task-guid-%d", i), Domain: "domain", TaskDefinition: taskDef})
+				}
+				requestBody = &models.DesireTasksRequest{Tasks: tasks}
+			})
+
+			It("rejects the request without desiring any task", func() {
+				Expect(controller.DesireTaskCallCount()).To(Equal(0))
+
+				response := &models.TasksLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrBadRequest))
+				Expect(response.Results).To(BeEmpty())
+			})
+		})
+	})
+
 	Describe("StartTask", func() {
 		Context("when the start is successful", func() {
 			BeforeEach(func() {
@@ -432,6 +660,23 @@ var _ = Describe("Task Handlers", func() {
 			})
 		})
 
+		Context("when the client is restricted to some domains", func() {
+			BeforeEach(func() {
+				controller.TaskByGuidReturns(&models.Task{TaskGuid: "task-guid", Domain: "domain-1"}, nil)
+				request = middleware.WithDomainScope(request, middleware.NewDomainScope("domain-0"))
+			})
+
+			It("does not cancel tasks in other domains", func() {
+				Expect(controller.CancelTaskCallCount()).To(Equal(0))
+
+				response := &models.TaskLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrUnauthorized))
+			})
+		})
+
 		Context("when the cancel task request is not valid", func() {
 			BeforeEach(func() {
 				request = newTestRequest("{{")
@@ -700,4 +945,179 @@ var _ = Describe("Task Handlers", func() {
 			})
 		})
 	})
+
+	Describe("CancelTasks", func() {
+		var scope middleware.DomainScope
+
+		BeforeEach(func() {
+			scope = nil
+			requestBody = &models.TaskGuidsRequest{
+				TaskGuids: []string{"task-guid-1", "task-guid-2"},
+			}
+			controller.CancelTaskStub = func(_ lager.Logger, taskGuid string) error {
+				if taskGuid == "task-guid-2" {
+					return models.ErrResourceNotFound
+				}
+				return nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			request := middleware.WithDomainScope(newTestRequest(requestBody), scope)
+			handler.CancelTasks(logger, responseRecorder, request)
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+		})
+
+		It("cancels each of the tasks", func() {
+			Expect(controller.CancelTaskCallCount()).To(Equal(2))
+			guids := []string{}
+			for i := 0; i < 2; i++ {
+				taskLogger, taskGuid := controller.CancelTaskArgsForCall(i)
+				Expect(taskLogger.SessionName()).To(ContainSubstring("cancel-tasks"))
+				guids = append(guids, taskGuid)
+			}
+			Expect(guids).To(ConsistOf("task-guid-1", "task-guid-2"))
+		})
+
+		It("returns a result for each task guid, in order", func() {
+			response := &models.TasksLifecycleResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(response.Error).To(BeNil())
+			Expect(response.Results).To(Equal([]*models.TaskLifecycleResult{
+				{TaskGuid: "task-guid-1"},
+				{TaskGuid: "task-guid-2", Error: models.ErrResourceNotFound},
+			}))
+		})
+
+		Context("when one of the task guids is empty", func() {
+			BeforeEach(func() {
+				requestBody = &models.TaskGuidsRequest{TaskGuids: []string{"task-guid-1", ""}}
+			})
+
+			It("returns an InvalidRequest error for it", func() {
+				Expect(controller.CancelTaskCallCount()).To(Equal(1))
+
+				response := &models.TasksLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Results).To(HaveLen(2))
+				Expect(response.Results[0].Error).To(BeNil())
+				Expect(response.Results[1].Error.Type).To(Equal(models.Error_InvalidRequest))
+			})
+		})
+
+		Context("when the client is restricted to some domains", func() {
+			BeforeEach(func() {
+				controller.TaskByGuidStub = func(_ lager.Logger, taskGuid string) (*models.Task, error) {
+					if taskGuid == "task-guid-1" {
+						return &models.Task{TaskGuid: taskGuid, Domain: "domain-0"}, nil
+					}
+					return &models.Task{TaskGuid: taskGuid, Domain: "domain-1"}, nil
+				}
+				scope = middleware.NewDomainScope("domain-0")
+			})
+
+			It("only cancels the tasks in those domains", func() {
+				Expect(controller.CancelTaskCallCount()).To(Equal(1))
+				_, taskGuid := controller.CancelTaskArgsForCall(0)
+				Expect(taskGuid).To(Equal("task-guid-1"))
+
+				response := &models.TasksLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Results[1].Error).To(Equal(models.ErrUnauthorized))
+			})
+		})
+
+		Context("when the controller returns an unrecoverable error", func() {
+			BeforeEach(func() {
+				controller.CancelTaskStub = nil
+				controller.CancelTaskReturns(models.NewUnrecoverableError(nil))
+			})
+
+			It("logs and writes to the exit channel", func() {
+				Eventually(logger).Should(gbytes.Say("unrecoverable-error"))
+				Eventually(exitCh).Should(Receive())
+			})
+		})
+
+		Context("when the request has more task guids than allowed", func() {
+			BeforeEach(func() {
+				taskGuids := []string{}
+				for i := 0; i < 11; i++ {
+					taskGuids = append(taskGuids, fmt.Sprintf("task-guid-%d", i))
+				}
+				requestBody = &models.TaskGuidsRequest{TaskGuids: taskGuids}
+			})
+
+			It("rejects the request without cancelling any task", func() {
+				Expect(controller.CancelTaskCallCount()).To(Equal(0))
+
+				response := &models.TasksLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrBadRequest))
+			})
+		})
+
+		Context("when the request has no task guids", func() {
+			BeforeEach(func() {
+				requestBody = &models.TaskGuidsRequest{}
+			})
+
+			It("responds with an InvalidRequest error", func() {
+				response := &models.TasksLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+			})
+		})
+	})
+
+	Describe("DeleteTasks", func() {
+		BeforeEach(func() {
+			requestBody = &models.TaskGuidsRequest{
+				TaskGuids: []string{"task-guid-1", "task-guid-2"},
+			}
+			controller.DeleteTaskStub = func(_ lager.Logger, taskGuid string) error {
+				if taskGuid == "task-guid-1" {
+					return models.ErrResourceConflict
+				}
+				return nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			handler.DeleteTasks(logger, responseRecorder, newTestRequest(requestBody))
+		})
+
+		It("deletes each of the tasks", func() {
+			Expect(controller.DeleteTaskCallCount()).To(Equal(2))
+			guids := []string{}
+			for i := 0; i < 2; i++ {
+				_, taskGuid := controller.DeleteTaskArgsForCall(i)
+				guids = append(guids, taskGuid)
+			}
+			Expect(guids).To(ConsistOf("task-guid-1", "task-guid-2"))
+		})
+
+		It("returns a result for each task guid, in order", func() {
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			response := &models.TasksLifecycleResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(response.Error).To(BeNil())
+			Expect(response.Results).To(Equal([]*models.TaskLifecycleResult{
+				{TaskGuid: "task-guid-1", Error: models.ErrResourceConflict},
+				{TaskGuid: "task-guid-2"},
+			}))
+		})
+	})
 })
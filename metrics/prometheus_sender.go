@@ -0,0 +1,225 @@
+package metrics
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	dropsonde_metrics "github.com/cloudfoundry/dropsonde/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const prometheusNamespace = "bbs"
+
+// durationBuckets cover both request latencies and convergence runs, which
+// can take minutes on large deployments.
+var durationBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60, 120, 300}
+
+type labelledMetric struct {
+	name   string
+	labels []string
+}
+
+// labelledMetrics maps the prefix of the metrics whose names carry a route,
+// domain or table to the Prometheus metric they are exported as, with the
+// rest of the name split into the labels.
+var labelledMetrics = map[string]labelledMetric{
+	"RequestCount":            {"RouteRequestCount", []string{"route"}},
+	"RequestLatency":          {"RouteRequestLatency", []string{"route"}},
+	"ResponseStatus":          {"RouteResponseStatus", []string{"route", "status"}},
+	"RequestErrors":           {"RouteRequestErrors", []string{"route", "type"}},
+	"Domain":                  {"Domain", []string{"domain"}},
+	"EncryptionRowsDone":      {"EncryptionRowsDone", []string{"table"}},
+	"EncryptionRowsRemaining": {"EncryptionRowsRemaining", []string{"table"}},
+}
+
+// PrometheusSender is a dropsonde MetricSender that records every metric sent
+// through it for Prometheus to scrape before passing it on to sender. Values
+// in nanoseconds are exported as histograms in seconds, other values as
+// gauges and counters as counters.
+type PrometheusSender struct {
+	dropsonde_metrics.MetricSender
+
+	registry *prometheus.Registry
+
+	lock       sync.Mutex
+	gauges     map[string]*prometheus.GaugeVec
+	counters   map[string]*prometheus.CounterVec
+	histograms map[string]*prometheus.HistogramVec
+}
+
+func NewPrometheusSender(sender dropsonde_metrics.MetricSender) *PrometheusSender {
+	return &PrometheusSender{
+		MetricSender: sender,
+		registry:     prometheus.NewRegistry(),
+		gauges:       map[string]*prometheus.GaugeVec{},
+		counters:     map[string]*prometheus.CounterVec{},
+		histograms:   map[string]*prometheus.HistogramVec{},
+	}
+}
+
+// Handler serves the recorded metrics in the Prometheus exposition format.
+func (s *PrometheusSender) Handler() http.Handler {
+	return promhttp.HandlerFor(s.registry, promhttp.HandlerOpts{})
+}
+
+func (s *PrometheusSender) SendValue(name string, value float64, unit string) error {
+	var err error
+	if unit == "nanos" {
+		err = s.observe(name, value/float64(time.Second))
+	} else {
+		err = s.set(name, value)
+	}
+
+	sendErr := s.MetricSender.SendValue(name, value, unit)
+	if sendErr != nil {
+		return sendErr
+	}
+	return err
+}
+
+func (s *PrometheusSender) IncrementCounter(name string) error {
+	return s.AddToCounter(name, 1)
+}
+
+func (s *PrometheusSender) AddToCounter(name string, delta uint64) error {
+	err := s.add(name, float64(delta))
+
+	sendErr := s.MetricSender.AddToCounter(name, delta)
+	if sendErr != nil {
+		return sendErr
+	}
+	return err
+}
+
+func (s *PrometheusSender) set(name string, value float64) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	metricName, labels, values := prometheusMetric(name, "")
+	vec, ok := s.gauges[metricName]
+	if !ok {
+		vec = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: metricName,
+			Help: "Last value of the " + name + " metric.",
+		}, labels)
+		err := s.registry.Register(vec)
+		if err != nil {
+			return err
+		}
+		s.gauges[metricName] = vec
+	}
+
+	vec.WithLabelValues(values...).Set(value)
+	return nil
+}
+
+func (s *PrometheusSender) add(name string, delta float64) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	metricName, labels, values := prometheusMetric(name, "_total")
+	vec, ok := s.counters[metricName]
+	if !ok {
+		vec = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: metricName,
+			Help: "Total of the " + name + " counter.",
+		}, labels)
+		err := s.registry.Register(vec)
+		if err != nil {
+			return err
+		}
+		s.counters[metricName] = vec
+	}
+
+	vec.WithLabelValues(values...).Add(delta)
+	return nil
+}
+
+func (s *PrometheusSender) observe(name string, seconds float64) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	metricName, labels, values := prometheusMetric(name, "_seconds")
+	vec, ok := s.histograms[metricName]
+	if !ok {
+		vec = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    metricName,
+			Help:    "Distribution of the " + name + " duration.",
+			Buckets: durationBuckets,
+		}, labels)
+		err := s.registry.Register(vec)
+		if err != nil {
+			return err
+		}
+		s.histograms[metricName] = vec
+	}
+
+	vec.WithLabelValues(values...).Observe(seconds)
+	return nil
+}
+
+// prometheusMetric returns the Prometheus name, label names and label values
+// for the dropsonde metric name. RequestLatency.Tasks_r2, for example, becomes
+// bbs_route_request_latency_seconds{route="Tasks_r2"}.
+func prometheusMetric(name, suffix string) (string, []string, []string) {
+	parts := strings.SplitN(name, ".", 2)
+	if len(parts) == 2 {
+		if labelled, ok := labelledMetrics[parts[0]]; ok {
+			values := strings.SplitN(parts[1], ".", len(labelled.labels))
+			if len(values) == len(labelled.labels) {
+				return prometheusName(labelled.name) + suffix, labelled.labels, values
+			}
+		}
+	}
+
+	return prometheusName(name) + suffix, nil, nil
+}
+
+// prometheusName converts a CamelCase dropsonde name such as
+// ConvergenceLRPDuration into a snake_case name such as
+// bbs_convergence_lrp_duration. Names already starting with BBS, like
+// BBSMasterElected, are not prefixed twice.
+func prometheusName(name string) string {
+	runes := []rune(name)
+	converted := make([]rune, 0, len(runes)+8)
+
+	for i, r := range runes {
+		if !isNameRune(r) {
+			converted = append(converted, '_')
+			continue
+		}
+
+		if unicode.IsUpper(r) && i > 0 {
+			previous := runes[i-1]
+			if unicode.IsLower(previous) || unicode.IsDigit(previous) || endsAcronym(runes, i) {
+				converted = append(converted, '_')
+			}
+		}
+		converted = append(converted, unicode.ToLower(r))
+	}
+
+	snakeCase := string(converted)
+	if strings.HasPrefix(snakeCase, prometheusNamespace+"_") {
+		return snakeCase
+	}
+	return prometheusNamespace + "_" + snakeCase
+}
+
+// endsAcronym reports whether the upper case rune at i starts a word after an
+// acronym, as the D in LRPDuration does. The s of a plural acronym such as
+// LRPs is not a new word.
+func endsAcronym(runes []rune, i int) bool {
+	if !unicode.IsUpper(runes[i-1]) || i+1 >= len(runes) || !unicode.IsLower(runes[i+1]) {
+		return false
+	}
+	pluralAcronym := runes[i+1] == 's' && (i+2 == len(runes) || !unicode.IsLower(runes[i+2]))
+	return !pluralAcronym
+}
+
+func isNameRune(r rune) bool {
+	return r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r))
+}
@@ -0,0 +1,103 @@
+package metrics_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"code.cloudfoundry.org/bbs/metrics"
+	"code.cloudfoundry.org/runtimeschema/metric"
+	"github.com/cloudfoundry/dropsonde/metric_sender/fake"
+	dropsonde_metrics "github.com/cloudfoundry/dropsonde/metrics"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PrometheusSender", func() {
+	var (
+		sender           *fake.FakeMetricSender
+		prometheusSender *metrics.PrometheusSender
+	)
+
+	BeforeEach(func() {
+		sender = fake.NewFakeMetricSender()
+		prometheusSender = metrics.NewPrometheusSender(sender)
+		dropsonde_metrics.Initialize(prometheusSender, nil)
+	})
+
+	scrape := func() string {
+		server := httptest.NewServer(prometheusSender.Handler())
+		defer server.Close()
+
+		resp, err := http.Get(server.URL)
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+		body, err := ioutil.ReadAll(resp.Body)
+		Expect(err).NotTo(HaveOccurred())
+		return string(body)
+	}
+
+	It("exports values as gauges", func() {
+		metric.Metric("TasksPending").Send(3)
+		metric.Metric("LRPsDesired").Send(5)
+		metric.Metric("TasksPending").Send(2)
+
+		body := scrape()
+		Expect(body).To(ContainSubstring("\nbbs_tasks_pending 2\n"))
+		Expect(body).To(ContainSubstring("\nbbs_lrps_desired 5\n"))
+	})
+
+	It("exports counters as counters", func() {
+		metric.Counter("BBSMasterElected").Increment()
+		metric.Counter("ConvergenceTasksKicked").Add(4)
+		metric.Counter("ConvergenceTasksKicked").Increment()
+
+		body := scrape()
+		Expect(body).To(ContainSubstring("\nbbs_master_elected_total 1\n"))
+		Expect(body).To(ContainSubstring("\nbbs_convergence_tasks_kicked_total 5\n"))
+	})
+
+	It("exports durations as histograms in seconds", func() {
+		metric.Duration("ConvergenceLRPDuration").Send(2 * time.Second)
+		metric.Duration("ConvergenceLRPDuration").Send(40 * time.Second)
+
+		body := scrape()
+		Expect(body).To(ContainSubstring("\nbbs_convergence_lrp_duration_seconds_count 2\n"))
+		Expect(body).To(ContainSubstring("\nbbs_convergence_lrp_duration_seconds_sum 42\n"))
+		Expect(body).To(ContainSubstring("\nbbs_convergence_lrp_duration_seconds_bucket{le=\"30\"} 1\n"))
+	})
+
+	It("splits route, domain and table metric names into labels", func() {
+		metric.Counter("RequestCount").Increment()
+		metric.Counter("RequestCount.Tasks_r2").Increment()
+		metric.Duration("RequestLatency.Tasks_r2").Send(time.Second)
+		metric.Counter("ResponseStatus.Tasks_r2.200").Increment()
+		metric.Counter("RequestErrors.Tasks_r2.Unauthorized").Increment()
+		metric.Metric("Domain.cf.apps").Send(1)
+		metric.Metric("EncryptionRowsRemaining.tasks").Send(5)
+
+		body := scrape()
+		Expect(body).To(ContainSubstring("\nbbs_request_count_total 1\n"))
+		Expect(body).To(ContainSubstring("\nbbs_route_request_count_total{route=\"Tasks_r2\"} 1\n"))
+		Expect(body).To(ContainSubstring("\nbbs_route_request_latency_seconds_count{route=\"Tasks_r2\"} 1\n"))
+		Expect(body).To(ContainSubstring("\nbbs_route_response_status_total{route=\"Tasks_r2\",status=\"200\"} 1\n"))
+		Expect(body).To(ContainSubstring("\nbbs_route_request_errors_total{route=\"Tasks_r2\",type=\"Unauthorized\"} 1\n"))
+		Expect(body).To(ContainSubstring("\nbbs_domain{domain=\"cf.apps\"} 1\n"))
+		Expect(body).To(ContainSubstring("\nbbs_encryption_rows_remaining{table=\"tasks\"} 5\n"))
+	})
+
+	It("passes the metrics on to the wrapped sender", func() {
+		metric.Metric("TasksPending").Send(3)
+		metric.Counter("BBSMasterElected").Increment()
+		metric.Duration("ConvergenceLRPDuration").Send(time.Second)
+
+		Expect(sender.GetValue("TasksPending").Value).To(BeEquivalentTo(3))
+		Expect(sender.GetCounter("BBSMasterElected")).To(BeEquivalentTo(1))
+		Expect(sender.GetValue("ConvergenceLRPDuration").Value).To(BeEquivalentTo(time.Second))
+		Expect(sender.GetValue("ConvergenceLRPDuration").Unit).To(Equal("nanos"))
+	})
+})
@@ -9,12 +9,17 @@
 		actions.proto
 		actual_lrp.proto
 		actual_lrp_requests.proto
+		audit.proto
+		bbs.proto
 		cached_dependency.proto
 		cells.proto
 		certificate_properties.proto
 		desired_lrp.proto
 		desired_lrp_requests.proto
+		desired_lrp_revision.proto
+		desired_lrp_rollout.proto
 		domain.proto
+		encryption_progress.proto
 		environment_variables.proto
 		error.proto
 		evacuation.proto
@@ -23,6 +28,7 @@
 		modification_tag.proto
 		network.proto
 		ping.proto
+		quarantine.proto
 		security_group.proto
 		task.proto
 		task_requests.proto
@@ -58,11 +64,15 @@
 		FailActualLRPRequest
 		RetireActualLRPRequest
 		RemoveActualLRPRequest
+		AuditEvent
+		AuditEventsRequest
+		AuditEventsResponse
 		CachedDependency
 		CellCapacity
 		CellPresence
 		Provider
 		CellsResponse
+		CellsRequest
 		CertificateProperties
 		DesiredLRPSchedulingInfo
 		DesiredLRPRunInfo
@@ -71,6 +81,7 @@
 		DesiredLRPKey
 		DesiredLRPResource
 		DesiredLRP
+		RestartPolicy
 		DesiredLRPLifecycleResponse
 		DesiredLRPsResponse
 		DesiredLRPsRequest
@@ -80,11 +91,35 @@
 		DesireLRPRequest
 		UpdateDesiredLRPRequest
 		RemoveDesiredLRPRequest
+		RedeployDesiredLRPRequest
+		DesireLRPsRequest
+		RemoveDesiredLRPsRequest
+		DesiredLRPLifecycleResult
+		DesiredLRPsLifecycleResponse
+		ApplyDesiredLRPsRequest
+		ApplyDesiredLRPsResponse
+		DesiredLRPRevision
+		DesiredLRPRevisionsRequest
+		DesiredLRPRevisionsResponse
+		DesiredLRPRevisionRequest
+		DesiredLRPRevisionResponse
+		RollbackDesiredLRPRequest
+		DesiredLRPRollout
+		DesiredLRPRolloutRequest
+		DesiredLRPRolloutResponse
 		DomainsResponse
 		UpsertDomainResponse
 		UpsertDomainRequest
+		DomainsRequest
+		EncryptionKeyRows
+		EncryptionTableProgress
+		EncryptionProgressRequest
+		EncryptionProgressResponse
+		VerifyEncryptionKeyRetiredRequest
+		VerifyEncryptionKeyRetiredResponse
 		EnvironmentVariable
 		Error
+		ErrorResponse
 		EvacuationResponse
 		EvacuateClaimedActualLRPRequest
 		EvacuateRunningActualLRPRequest
@@ -99,21 +134,43 @@
 		DesiredLRPChangedEvent
 		DesiredLRPRemovedEvent
 		ActualLRPCrashedEvent
+		TaskCreatedEvent
+		TaskChangedEvent
+		TaskRemovedEvent
+		ResyncRequiredEvent
+		EventsRequest
+		EventEnvelope
 		ConvergeLRPsResponse
 		ModificationTag
 		Network
 		PingResponse
+		PingRequest
+		QuarantinedRecord
+		QuarantinedRecordsRequest
+		QuarantinedRecordsResponse
+		QuarantinedRecordRequest
+		QuarantinedRecordResponse
+		RestoreQuarantinedRecordRequest
+		RestoreQuarantinedRecordResponse
+		RestoreQuarantinedRecordsRequest
+		RestoreQuarantinedRecordsResponse
 		PortRange
 		ICMPInfo
 		SecurityGroupRule
 		TaskDefinition
+		TaskRetryPolicy
+		TaskAttemptFailure
 		Task
 		TaskLifecycleResponse
 		DesireTaskRequest
+		DesireTasksRequest
+		TaskLifecycleResult
+		TasksLifecycleResponse
 		StartTaskRequest
 		StartTaskResponse
 		FailTaskRequest
 		TaskGuidRequest
+		TaskGuidsRequest
 		CompleteTaskRequest
 		TaskCallbackResponse
 		ConvergeTasksRequest
@@ -31,8 +31,14 @@ type ActualLRPChange struct {
 }
 
 type ActualLRPFilter struct {
-	Domain string
-	CellID string
+	Domain           string
+	CellID           string
+	PageSize         int
+	AfterProcessGuid string
+	AfterIndex       int32
+	// AllowedDomains, when non-nil, restricts the listing to actual LRPs in
+	// these domains.
+	AllowedDomains []string
 }
 
 func NewActualLRPKey(processGuid string, index int32, domain string) ActualLRPKey {
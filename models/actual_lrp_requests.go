@@ -1,6 +1,14 @@
 package models
 
 func (request *ActualLRPGroupsRequest) Validate() error {
+	var validationError ValidationError
+
+	validationError = validatePage(validationError, request.PageSize, request.PageToken, 2)
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
 	return nil
 }
 
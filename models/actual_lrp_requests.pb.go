@@ -39,6 +39,7 @@ func (m *ActualLRPLifecycleResponse) GetError() *Error {
 type ActualLRPGroupsResponse struct {
 	Error           *Error            `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
 	ActualLrpGroups []*ActualLRPGroup `protobuf:"bytes,2,rep,name=actual_lrp_groups,json=actualLrpGroups" json:"actual_lrp_groups,omitempty"`
+	NextPageToken   string            `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken" json:"next_page_token"`
 }
 
 func (m *ActualLRPGroupsResponse) Reset()      { *m = ActualLRPGroupsResponse{} }
@@ -61,6 +62,13 @@ func (m *ActualLRPGroupsResponse) GetActualLrpGroups() []*ActualLRPGroup {
 	return nil
 }
 
+func (m *ActualLRPGroupsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
 type ActualLRPGroupResponse struct {
 	Error          *Error          `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
 	ActualLrpGroup *ActualLRPGroup `protobuf:"bytes,2,opt,name=actual_lrp_group,json=actualLrpGroup" json:"actual_lrp_group,omitempty"`
@@ -87,8 +95,10 @@ func (m *ActualLRPGroupResponse) GetActualLrpGroup() *ActualLRPGroup {
 }
 
 type ActualLRPGroupsRequest struct {
-	Domain string `protobuf:"bytes,1,opt,name=domain" json:"domain"`
-	CellId string `protobuf:"bytes,2,opt,name=cell_id,json=cellId" json:"cell_id"`
+	Domain    string `protobuf:"bytes,1,opt,name=domain" json:"domain"`
+	CellId    string `protobuf:"bytes,2,opt,name=cell_id,json=cellId" json:"cell_id"`
+	PageSize  int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize" json:"page_size"`
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken" json:"page_token"`
 }
 
 func (m *ActualLRPGroupsRequest) Reset()      { *m = ActualLRPGroupsRequest{} }
@@ -111,6 +121,20 @@ func (m *ActualLRPGroupsRequest) GetCellId() string {
 	return ""
 }
 
+func (m *ActualLRPGroupsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ActualLRPGroupsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
 type ActualLRPGroupsByProcessGuidRequest struct {
 	ProcessGuid string `protobuf:"bytes,1,opt,name=process_guid,json=processGuid" json:"process_guid"`
 }
@@ -409,6 +433,9 @@ func (this *ActualLRPGroupsResponse) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.NextPageToken != that1.NextPageToken {
+		return false
+	}
 	return true
 }
 func (this *ActualLRPGroupResponse) Equal(that interface{}) bool {
@@ -475,6 +502,12 @@ func (this *ActualLRPGroupsRequest) Equal(that interface{}) bool {
 	if this.CellId != that1.CellId {
 		return false
 	}
+	if this.PageSize != that1.PageSize {
+		return false
+	}
+	if this.PageToken != that1.PageToken {
+		return false
+	}
 	return true
 }
 func (this *ActualLRPGroupsByProcessGuidRequest) Equal(that interface{}) bool {
@@ -763,7 +796,7 @@ func (this *ActualLRPGroupsResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&models.ActualLRPGroupsResponse{")
 	if this.Error != nil {
 		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
@@ -771,6 +804,7 @@ func (this *ActualLRPGroupsResponse) GoString() string {
 	if this.ActualLrpGroups != nil {
 		s = append(s, "ActualLrpGroups: "+fmt.Sprintf("%#v", this.ActualLrpGroups)+",\n")
 	}
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -793,10 +827,12 @@ func (this *ActualLRPGroupsRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 8)
 	s = append(s, "&models.ActualLRPGroupsRequest{")
 	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
 	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
+	s = append(s, "PageSize: "+fmt.Sprintf("%#v", this.PageSize)+",\n")
+	s = append(s, "PageToken: "+fmt.Sprintf("%#v", this.PageToken)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -981,6 +1017,10 @@ func (m *ActualLRPGroupsResponse) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintActualLrpRequests(dAtA, i, uint64(len(m.NextPageToken)))
+	i += copy(dAtA[i:], m.NextPageToken)
 	return i, nil
 }
 
@@ -1045,6 +1085,13 @@ func (m *ActualLRPGroupsRequest) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	i = encodeVarintActualLrpRequests(dAtA, i, uint64(len(m.CellId)))
 	i += copy(dAtA[i:], m.CellId)
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintActualLrpRequests(dAtA, i, uint64(m.PageSize))
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintActualLrpRequests(dAtA, i, uint64(len(m.PageToken)))
+	i += copy(dAtA[i:], m.PageToken)
 	return i, nil
 }
 
@@ -1365,6 +1412,8 @@ func (m *ActualLRPGroupsResponse) Size() (n int) {
 			n += 1 + l + sovActualLrpRequests(uint64(l))
 		}
 	}
+	l = len(m.NextPageToken)
+	n += 1 + l + sovActualLrpRequests(uint64(l))
 	return n
 }
 
@@ -1389,6 +1438,9 @@ func (m *ActualLRPGroupsRequest) Size() (n int) {
 	n += 1 + l + sovActualLrpRequests(uint64(l))
 	l = len(m.CellId)
 	n += 1 + l + sovActualLrpRequests(uint64(l))
+	n += 1 + sovActualLrpRequests(uint64(m.PageSize))
+	l = len(m.PageToken)
+	n += 1 + l + sovActualLrpRequests(uint64(l))
 	return n
 }
 
@@ -1521,6 +1573,7 @@ func (this *ActualLRPGroupsResponse) String() string {
 	s := strings.Join([]string{`&ActualLRPGroupsResponse{`,
 		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
 		`ActualLrpGroups:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrpGroups), "ActualLRPGroup", "ActualLRPGroup", 1) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1543,6 +1596,8 @@ func (this *ActualLRPGroupsRequest) String() string {
 	s := strings.Join([]string{`&ActualLRPGroupsRequest{`,
 		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
 		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
+		`PageSize:` + fmt.Sprintf("%v", this.PageSize) + `,`,
+		`PageToken:` + fmt.Sprintf("%v", this.PageToken) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1821,6 +1876,35 @@ func (m *ActualLRPGroupsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipActualLrpRequests(dAtA[iNdEx:])
@@ -2045,6 +2129,54 @@ func (m *ActualLRPGroupsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.CellId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipActualLrpRequests(dAtA[iNdEx:])
@@ -3102,41 +3234,45 @@ var (
 func init() { proto.RegisterFile("actual_lrp_requests.proto", fileDescriptorActualLrpRequests) }
 
 var fileDescriptorActualLrpRequests = []byte{
-	// 576 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xd4, 0x93, 0xcf, 0x6e, 0xd3, 0x4c,
-	0x14, 0xc5, 0x33, 0xe9, 0xd7, 0x7e, 0x62, 0xd2, 0x96, 0x60, 0xd2, 0x34, 0x44, 0xc5, 0x54, 0xd3,
-	0x05, 0x05, 0x41, 0x2a, 0x75, 0xc9, 0x8a, 0x04, 0x41, 0x14, 0x35, 0x54, 0x95, 0x0b, 0x6b, 0xcb,
-	0xb5, 0x6f, 0xdc, 0x11, 0xb6, 0xc7, 0x9d, 0xb1, 0x11, 0x59, 0x20, 0x10, 0x4f, 0xc0, 0x63, 0xb0,
-	0x85, 0xa7, 0xe8, 0xb2, 0x12, 0x1b, 0x56, 0x88, 0x98, 0x0d, 0xcb, 0xf2, 0x06, 0xc8, 0x33, 0x8e,
-	0x9b, 0x3f, 0x2a, 0x52, 0x50, 0x16, 0xb0, 0xf3, 0x9c, 0x7b, 0xe7, 0x77, 0xce, 0xd5, 0xf5, 0xe0,
-	0x1b, 0x96, 0x1d, 0xc5, 0x96, 0x67, 0x7a, 0x3c, 0x34, 0x39, 0x9c, 0xc4, 0x20, 0x22, 0xd1, 0x08,
-	0x39, 0x8b, 0x98, 0xb6, 0xe4, 0x33, 0x07, 0x3c, 0x51, 0xbf, 0xef, 0xd2, 0xe8, 0x38, 0x3e, 0x6a,
-	0xd8, 0xcc, 0xdf, 0x71, 0x99, 0xcb, 0x76, 0x64, 0xf9, 0x28, 0xee, 0xc9, 0x93, 0x3c, 0xc8, 0x2f,
-	0x75, 0xad, 0x5e, 0xbe, 0x20, 0x66, 0x4a, 0x09, 0x38, 0x67, 0x5c, 0x1d, 0x48, 0x13, 0xd7, 0x9b,
-	0xb2, 0xa1, 0x6b, 0x1c, 0x74, 0x69, 0x0f, 0xec, 0xbe, 0xed, 0x81, 0x01, 0x22, 0x64, 0x81, 0x00,
-	0x6d, 0x0b, 0x2f, 0xca, 0xe6, 0x1a, 0xda, 0x44, 0xdb, 0xa5, 0xdd, 0x95, 0x86, 0xca, 0xd0, 0x78,
-	0x9c, 0x8a, 0x86, 0xaa, 0x91, 0x77, 0x08, 0xaf, 0xe7, 0x8c, 0x36, 0x67, 0x71, 0x28, 0x66, 0x02,
-	0x68, 0x2d, 0x7c, 0x6d, 0x64, 0x6c, 0x57, 0x12, 0x6a, 0xc5, 0xcd, 0x85, 0xed, 0xd2, 0x6e, 0x75,
-	0x78, 0x61, 0xdc, 0xc0, 0xb8, 0xaa, 0x2e, 0x74, 0x79, 0xa8, 0x0c, 0xc9, 0x1b, 0x5c, 0x9d, 0x68,
-	0x99, 0x29, 0xc2, 0x43, 0x5c, 0x9e, 0x8c, 0x50, 0x2b, 0xca, 0xfe, 0xcb, 0x12, 0xac, 0x8e, 0x27,
-	0x20, 0xcf, 0x27, 0x03, 0x08, 0x43, 0xed, 0x4f, 0xdb, 0xc0, 0x4b, 0x0e, 0xf3, 0x2d, 0x1a, 0xc8,
-	0x04, 0x57, 0x5a, 0xff, 0x9d, 0x7e, 0xbd, 0x55, 0x30, 0x32, 0x4d, 0xbb, 0x89, 0xff, 0xb7, 0xc1,
-	0xf3, 0x4c, 0xea, 0x48, 0xc3, 0xbc, 0x9c, 0x8a, 0x1d, 0x87, 0xec, 0xe3, 0xad, 0x09, 0x6c, 0xab,
-	0x7f, 0xc0, 0x99, 0x0d, 0x42, 0xb4, 0x63, 0xea, 0x0c, 0x3d, 0x6e, 0xe3, 0xe5, 0x50, 0xa9, 0xa6,
-	0x1b, 0x53, 0x67, 0xcc, 0xa9, 0x14, 0x5e, 0xf4, 0x93, 0x13, 0x7c, 0x77, 0x9c, 0x37, 0x86, 0x6b,
-	0x06, 0x4e, 0x27, 0x70, 0xe0, 0xd5, 0xac, 0x58, 0xad, 0x8e, 0x17, 0x69, 0x7a, 0x51, 0xce, 0xb0,
-	0x98, 0x75, 0x28, 0x89, 0x7c, 0x44, 0x78, 0xed, 0x91, 0x67, 0x51, 0x3f, 0x37, 0x9e, 0x27, 0x5e,
-	0x3b, 0xc4, 0xeb, 0x23, 0xab, 0xa3, 0x81, 0x88, 0xac, 0xc0, 0x06, 0xf3, 0x05, 0xf4, 0x6b, 0x0b,
-	0x72, 0x83, 0x1b, 0x53, 0x1b, 0xec, 0x64, 0x4d, 0x7b, 0xd0, 0x37, 0x2a, 0xf9, 0x1e, 0x47, 0x54,
-	0xf2, 0x13, 0xe1, 0xb5, 0xc3, 0xc8, 0xe2, 0xd1, 0x54, 0xe6, 0x07, 0x78, 0x75, 0xc4, 0x2e, 0x75,
-	0x51, 0xff, 0x55, 0x65, 0xca, 0x25, 0xa5, 0x2f, 0xe7, 0xf4, 0x3d, 0xe8, 0xff, 0x2e, 0x6a, 0xf1,
-	0x4f, 0xa3, 0x6a, 0x6d, 0x7c, 0x7d, 0x04, 0x1a, 0x40, 0x64, 0xd2, 0xa0, 0xc7, 0xb2, 0xd9, 0x6b,
-	0x53, 0xc0, 0x7d, 0x88, 0x3a, 0x41, 0x8f, 0x19, 0xe5, 0x1c, 0x96, 0x29, 0xe4, 0x73, 0xba, 0x27,
-	0x6e, 0x89, 0xe3, 0xbf, 0x7f, 0xe6, 0x3b, 0x78, 0x45, 0xbe, 0x5b, 0xd3, 0x07, 0x21, 0x2c, 0x17,
-	0xe4, 0xb4, 0xc3, 0x3f, 0x67, 0x59, 0x96, 0x9e, 0xaa, 0x0a, 0x79, 0x8d, 0x2b, 0x4f, 0x2c, 0xea,
-	0xcd, 0x75, 0xa6, 0x29, 0xfb, 0xe2, 0xa5, 0xf6, 0xcf, 0x70, 0xd5, 0x80, 0x88, 0x72, 0x98, 0x67,
-	0x00, 0xf2, 0x09, 0xa5, 0x58, 0x9f, 0xbd, 0x84, 0x7f, 0xe7, 0x4d, 0xb5, 0xee, 0x9d, 0x0d, 0xf4,
-	0xc2, 0x97, 0x81, 0x5e, 0x38, 0x1f, 0xe8, 0xe8, 0x6d, 0xa2, 0xa3, 0x0f, 0x89, 0x8e, 0x4e, 0x13,
-	0x1d, 0x9d, 0x25, 0x3a, 0xfa, 0x96, 0xe8, 0xe8, 0x47, 0xa2, 0x17, 0xce, 0x13, 0x1d, 0xbd, 0xff,
-	0xae, 0x17, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x81, 0x21, 0xf4, 0x0a, 0x07, 0x00, 0x00,
+	// 635 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xd4, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0xb3, 0xe9, 0x07, 0x74, 0xd2, 0x2f, 0x4c, 0x3f, 0x4c, 0x54, 0x4c, 0x70, 0x0f, 0x14,
+	0x54, 0x52, 0xa9, 0x47, 0x4e, 0x34, 0x08, 0xaa, 0xa8, 0xa5, 0xaa, 0xdc, 0xde, 0x2d, 0xd7, 0x9e,
+	0xb8, 0xab, 0x3a, 0x5e, 0x77, 0x77, 0x8d, 0x9a, 0x4a, 0x08, 0x1e, 0x81, 0x17, 0xe0, 0xce, 0x11,
+	0x78, 0x8a, 0x1e, 0x2b, 0x71, 0xe1, 0x84, 0xa8, 0xb9, 0x70, 0x2c, 0x6f, 0x80, 0xbc, 0x4e, 0x53,
+	0x27, 0x11, 0x95, 0x8a, 0x72, 0x80, 0x5b, 0xf6, 0x3f, 0xb3, 0xbf, 0xf9, 0xcf, 0x78, 0xb2, 0x70,
+	0xc7, 0x71, 0x65, 0xec, 0x04, 0x76, 0xc0, 0x23, 0x9b, 0xe3, 0x61, 0x8c, 0x42, 0x8a, 0x6a, 0xc4,
+	0x99, 0x64, 0xda, 0x68, 0x93, 0x79, 0x18, 0x88, 0xf2, 0x63, 0x9f, 0xca, 0xfd, 0x78, 0xaf, 0xea,
+	0xb2, 0xe6, 0x8a, 0xcf, 0x7c, 0xb6, 0xa2, 0xc2, 0x7b, 0x71, 0x43, 0x9d, 0xd4, 0x41, 0xfd, 0xca,
+	0xae, 0x95, 0xa7, 0x2f, 0x89, 0x6d, 0xa5, 0x84, 0x9c, 0x33, 0x9e, 0x1d, 0xcc, 0x35, 0x28, 0xaf,
+	0xa9, 0x84, 0x4d, 0x6b, 0x7b, 0x93, 0x36, 0xd0, 0x6d, 0xb9, 0x01, 0x5a, 0x28, 0x22, 0x16, 0x0a,
+	0xd4, 0x16, 0x61, 0x44, 0x25, 0xeb, 0xa4, 0x42, 0x96, 0x4a, 0xab, 0x13, 0xd5, 0xcc, 0x43, 0xf5,
+	0x79, 0x2a, 0x5a, 0x59, 0xcc, 0xfc, 0x48, 0x60, 0xbe, 0xc3, 0x58, 0xe7, 0x2c, 0x8e, 0xc4, 0xb5,
+	0x00, 0x5a, 0x0d, 0x6e, 0xe5, 0xda, 0xf6, 0x15, 0x41, 0x2f, 0x56, 0x86, 0x96, 0x4a, 0xab, 0x73,
+	0x17, 0x17, 0xba, 0x0b, 0x58, 0x53, 0xd9, 0x85, 0x4d, 0x1e, 0x65, 0x05, 0xb5, 0x65, 0x98, 0x0a,
+	0xf1, 0x48, 0xda, 0x91, 0xe3, 0xa3, 0x2d, 0xd9, 0x01, 0x86, 0xfa, 0x50, 0x85, 0x2c, 0x8d, 0xd5,
+	0x86, 0x4f, 0xbe, 0xdd, 0x2b, 0x58, 0x13, 0x69, 0x70, 0xdb, 0xf1, 0x71, 0x37, 0x0d, 0x99, 0x6f,
+	0x60, 0xae, 0x07, 0x78, 0x2d, 0xc3, 0x4f, 0x61, 0xba, 0xd7, 0xb0, 0x5e, 0xac, 0x90, 0x2b, 0xfc,
+	0x4e, 0x76, 0xfb, 0x35, 0xdf, 0x93, 0x5e, 0x07, 0xc2, 0xca, 0x3e, 0xb7, 0xb6, 0x00, 0xa3, 0x1e,
+	0x6b, 0x3a, 0x34, 0xd4, 0x49, 0xae, 0x81, 0xb6, 0xa6, 0xdd, 0x85, 0x1b, 0x2e, 0x06, 0x81, 0x4d,
+	0x3d, 0xbd, 0x98, 0x0f, 0xa7, 0x62, 0xdd, 0xd3, 0xee, 0xc3, 0x98, 0x9a, 0x80, 0xa0, 0xc7, 0xa8,
+	0x06, 0x30, 0xd2, 0x4e, 0xb8, 0x99, 0xca, 0x3b, 0xf4, 0x38, 0xed, 0x10, 0x72, 0x43, 0x1a, 0xce,
+	0x41, 0xc6, 0xa2, 0xce, 0x80, 0xb6, 0x60, 0xb1, 0xc7, 0x5e, 0xad, 0xb5, 0xcd, 0x99, 0x8b, 0x42,
+	0xac, 0xc7, 0xd4, 0xbb, 0xf0, 0xfa, 0x00, 0xc6, 0xa3, 0x4c, 0xb5, 0xfd, 0x98, 0x7a, 0x5d, 0x8e,
+	0x4b, 0xd1, 0x65, 0xbe, 0x79, 0x08, 0x8f, 0xba, 0x79, 0x5d, 0xb8, 0xb5, 0xd0, 0xab, 0x87, 0x1e,
+	0x1e, 0x5d, 0x17, 0xab, 0x95, 0x61, 0x84, 0xa6, 0x17, 0xf5, 0x62, 0xae, 0xd5, 0x4c, 0x32, 0x3f,
+	0x11, 0x98, 0x7d, 0x16, 0x38, 0xb4, 0xd9, 0x29, 0x3c, 0x48, 0xbc, 0xb6, 0x03, 0xf3, 0xb9, 0x1d,
+	0xa0, 0xa1, 0x90, 0x4e, 0xe8, 0xa2, 0x7d, 0x80, 0x2d, 0x35, 0xf7, 0xd2, 0xea, 0x42, 0xdf, 0x2a,
+	0xd4, 0xdb, 0x49, 0x1b, 0xd8, 0xb2, 0x66, 0x3a, 0x0b, 0x91, 0x53, 0xcd, 0x5f, 0x04, 0x66, 0x77,
+	0xa4, 0xc3, 0x65, 0x9f, 0xe7, 0x27, 0x30, 0x99, 0x2b, 0x97, 0x56, 0xc9, 0x16, 0x74, 0xa6, 0xaf,
+	0x4a, 0x4a, 0x1f, 0xef, 0xd0, 0x37, 0xb0, 0x75, 0x95, 0xd5, 0xe2, 0xdf, 0x5a, 0xd5, 0xd6, 0xe1,
+	0x76, 0x0e, 0x1a, 0xa2, 0xb4, 0x69, 0xd8, 0x60, 0xed, 0xde, 0xf5, 0x3e, 0xe0, 0x16, 0xca, 0x7a,
+	0xd8, 0x60, 0xd6, 0x74, 0x07, 0xd6, 0x56, 0xcc, 0x2f, 0xe9, 0x77, 0xe2, 0x8e, 0xd8, 0xff, 0xf7,
+	0x7b, 0x7e, 0x08, 0x13, 0xea, 0x01, 0xb0, 0x9b, 0x28, 0x84, 0xe3, 0x63, 0xd7, 0x13, 0x33, 0xae,
+	0x42, 0x2f, 0xb3, 0x88, 0xf9, 0x1a, 0x66, 0x5e, 0x38, 0x34, 0x18, 0x68, 0x4f, 0x7d, 0xe5, 0x8b,
+	0x7f, 0x2c, 0xbf, 0x0b, 0x73, 0x16, 0x4a, 0xca, 0x71, 0x90, 0x06, 0xcc, 0xcf, 0x24, 0xc5, 0x36,
+	0xd9, 0x2b, 0xfc, 0x7f, 0xfe, 0x53, 0xb5, 0xe5, 0xd3, 0x33, 0xa3, 0xf0, 0xf5, 0xcc, 0x28, 0x9c,
+	0x9f, 0x19, 0xe4, 0x6d, 0x62, 0x90, 0x0f, 0x89, 0x41, 0x4e, 0x12, 0x83, 0x9c, 0x26, 0x06, 0xf9,
+	0x9e, 0x18, 0xe4, 0x67, 0x62, 0x14, 0xce, 0x13, 0x83, 0xbc, 0xfb, 0x61, 0x14, 0x7e, 0x07, 0x00,
+	0x00, 0xff, 0xff, 0x3a, 0xaf, 0xf9, 0xcb, 0x81, 0x07, 0x00, 0x00,
 }
@@ -21,6 +21,41 @@ var _ = Describe("ActualLRP Requests", func() {
 					Expect(request.Validate()).To(BeNil())
 				})
 			})
+
+			Context("when the PageSize is negative", func() {
+				BeforeEach(func() {
+					request.PageSize = -1
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"page_size"}))
+				})
+			})
+
+			Context("when the PageToken is malformed", func() {
+				BeforeEach(func() {
+					request.PageToken = "garbage"
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"page_token"}))
+				})
+			})
+		})
+
+		Describe("Filter", func() {
+			It("resumes after the actual lrp group in the page token", func() {
+				request := models.ActualLRPGroupsRequest{
+					PageSize:  10,
+					PageToken: models.ActualLRPFilter{PageSize: 1}.NextPageToken("some-guid", 3),
+				}
+
+				Expect(request.Filter()).To(Equal(models.ActualLRPFilter{
+					PageSize:         10,
+					AfterProcessGuid: "some-guid",
+					AfterIndex:       3,
+				}))
+			})
 		})
 	})
 
@@ -0,0 +1,61 @@
+package models
+
+import "code.cloudfoundry.org/lager"
+
+// AuditEventFilter selects the audit events in Domain recorded at or after
+// Since and before Until, in nanoseconds since the epoch. Zero values match
+// every event, and a Limit of zero returns all of them.
+type AuditEventFilter struct {
+	Domain string
+	Since  int64
+	Until  int64
+	Limit  int
+	// AllowedDomains, when non-nil, restricts the listing to events in these
+	// domains.
+	AllowedDomains []string
+}
+
+func (event *AuditEvent) LagerData() lager.Data {
+	data := lager.Data{
+		"route":      event.Route,
+		"actor":      event.Actor,
+		"request_id": event.RequestId,
+		"domain":     event.Domain,
+		"guid":       event.Guid,
+	}
+	if event.Error != nil {
+		data["error"] = event.Error.Type.String()
+	}
+	return data
+}
+
+func (request *AuditEventsRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.Since < 0 {
+		validationError = validationError.Append(ErrInvalidField{"since"})
+	}
+
+	if request.Until < 0 || (request.Until > 0 && request.Until < request.Since) {
+		validationError = validationError.Append(ErrInvalidField{"until"})
+	}
+
+	if request.Limit < 0 {
+		validationError = validationError.Append(ErrInvalidField{"limit"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+func (request *AuditEventsRequest) Filter() AuditEventFilter {
+	return AuditEventFilter{
+		Domain: request.Domain,
+		Since:  request.Since,
+		Until:  request.Until,
+		Limit:  int(request.Limit),
+	}
+}
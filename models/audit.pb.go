@@ -0,0 +1,1353 @@
+// Code generated by protoc-gen-gogo.
+// source: audit.proto
+// DO NOT EDIT!
+
+package models
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+
+import strings "strings"
+import reflect "reflect"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type AuditEvent struct {
+	Id            int64  `protobuf:"varint,1,opt,name=id" json:"id"`
+	CreatedAt     int64  `protobuf:"varint,2,opt,name=created_at,json=createdAt" json:"created_at"`
+	Route         string `protobuf:"bytes,3,opt,name=route" json:"route"`
+	Actor         string `protobuf:"bytes,4,opt,name=actor" json:"actor"`
+	RemoteAddress string `protobuf:"bytes,5,opt,name=remote_address,json=remoteAddress" json:"remote_address"`
+	RequestId     string `protobuf:"bytes,6,opt,name=request_id,json=requestId" json:"request_id"`
+	Domain        string `protobuf:"bytes,7,opt,name=domain" json:"domain"`
+	Guid          string `protobuf:"bytes,8,opt,name=guid" json:"guid"`
+	Summary       string `protobuf:"bytes,9,opt,name=summary" json:"summary"`
+	Error         *Error `protobuf:"bytes,10,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *AuditEvent) Reset()                    { *m = AuditEvent{} }
+func (*AuditEvent) ProtoMessage()               {}
+func (*AuditEvent) Descriptor() ([]byte, []int) { return fileDescriptorAudit, []int{0} }
+
+func (m *AuditEvent) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AuditEvent) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *AuditEvent) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetRemoteAddress() string {
+	if m != nil {
+		return m.RemoteAddress
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetGuid() string {
+	if m != nil {
+		return m.Guid
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetSummary() string {
+	if m != nil {
+		return m.Summary
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+type AuditEventsRequest struct {
+	Domain string `protobuf:"bytes,1,opt,name=domain" json:"domain"`
+	Since  int64  `protobuf:"varint,2,opt,name=since" json:"since"`
+	Until  int64  `protobuf:"varint,3,opt,name=until" json:"until"`
+	Limit  int32  `protobuf:"varint,4,opt,name=limit" json:"limit"`
+}
+
+func (m *AuditEventsRequest) Reset()                    { *m = AuditEventsRequest{} }
+func (*AuditEventsRequest) ProtoMessage()               {}
+func (*AuditEventsRequest) Descriptor() ([]byte, []int) { return fileDescriptorAudit, []int{1} }
+
+func (m *AuditEventsRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *AuditEventsRequest) GetSince() int64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
+func (m *AuditEventsRequest) GetUntil() int64 {
+	if m != nil {
+		return m.Until
+	}
+	return 0
+}
+
+func (m *AuditEventsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type AuditEventsResponse struct {
+	Error       *Error        `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	AuditEvents []*AuditEvent `protobuf:"bytes,2,rep,name=audit_events,json=auditEvents" json:"audit_events,omitempty"`
+}
+
+func (m *AuditEventsResponse) Reset()                    { *m = AuditEventsResponse{} }
+func (*AuditEventsResponse) ProtoMessage()               {}
+func (*AuditEventsResponse) Descriptor() ([]byte, []int) { return fileDescriptorAudit, []int{2} }
+
+func (m *AuditEventsResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *AuditEventsResponse) GetAuditEvents() []*AuditEvent {
+	if m != nil {
+		return m.AuditEvents
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AuditEvent)(nil), "models.AuditEvent")
+	proto.RegisterType((*AuditEventsRequest)(nil), "models.AuditEventsRequest")
+	proto.RegisterType((*AuditEventsResponse)(nil), "models.AuditEventsResponse")
+}
+func (this *AuditEvent) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*AuditEvent)
+	if !ok {
+		that2, ok := that.(AuditEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.CreatedAt != that1.CreatedAt {
+		return false
+	}
+	if this.Route != that1.Route {
+		return false
+	}
+	if this.Actor != that1.Actor {
+		return false
+	}
+	if this.RemoteAddress != that1.RemoteAddress {
+		return false
+	}
+	if this.RequestId != that1.RequestId {
+		return false
+	}
+	if this.Domain != that1.Domain {
+		return false
+	}
+	if this.Guid != that1.Guid {
+		return false
+	}
+	if this.Summary != that1.Summary {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	return true
+}
+func (this *AuditEventsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*AuditEventsRequest)
+	if !ok {
+		that2, ok := that.(AuditEventsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Domain != that1.Domain {
+		return false
+	}
+	if this.Since != that1.Since {
+		return false
+	}
+	if this.Until != that1.Until {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	return true
+}
+func (this *AuditEventsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*AuditEventsResponse)
+	if !ok {
+		that2, ok := that.(AuditEventsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if len(this.AuditEvents) != len(that1.AuditEvents) {
+		return false
+	}
+	for i := range this.AuditEvents {
+		if !this.AuditEvents[i].Equal(that1.AuditEvents[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AuditEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 14)
+	s = append(s, "&models.AuditEvent{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
+	s = append(s, "Route: "+fmt.Sprintf("%#v", this.Route)+",\n")
+	s = append(s, "Actor: "+fmt.Sprintf("%#v", this.Actor)+",\n")
+	s = append(s, "RemoteAddress: "+fmt.Sprintf("%#v", this.RemoteAddress)+",\n")
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
+	s = append(s, "Guid: "+fmt.Sprintf("%#v", this.Guid)+",\n")
+	s = append(s, "Summary: "+fmt.Sprintf("%#v", this.Summary)+",\n")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AuditEventsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&models.AuditEventsRequest{")
+	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
+	s = append(s, "Since: "+fmt.Sprintf("%#v", this.Since)+",\n")
+	s = append(s, "Until: "+fmt.Sprintf("%#v", this.Until)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AuditEventsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.AuditEventsResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.AuditEvents != nil {
+		s = append(s, "AuditEvents: "+fmt.Sprintf("%#v", this.AuditEvents)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringAudit(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *AuditEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintAudit(dAtA, i, uint64(m.Id))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintAudit(dAtA, i, uint64(m.CreatedAt))
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintAudit(dAtA, i, uint64(len(m.Route)))
+	i += copy(dAtA[i:], m.Route)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintAudit(dAtA, i, uint64(len(m.Actor)))
+	i += copy(dAtA[i:], m.Actor)
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintAudit(dAtA, i, uint64(len(m.RemoteAddress)))
+	i += copy(dAtA[i:], m.RemoteAddress)
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintAudit(dAtA, i, uint64(len(m.RequestId)))
+	i += copy(dAtA[i:], m.RequestId)
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintAudit(dAtA, i, uint64(len(m.Domain)))
+	i += copy(dAtA[i:], m.Domain)
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintAudit(dAtA, i, uint64(len(m.Guid)))
+	i += copy(dAtA[i:], m.Guid)
+	dAtA[i] = 0x4a
+	i++
+	i = encodeVarintAudit(dAtA, i, uint64(len(m.Summary)))
+	i += copy(dAtA[i:], m.Summary)
+	if m.Error != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintAudit(dAtA, i, uint64(m.Error.Size()))
+		n1, err := m.Error.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	return i, nil
+}
+
+func (m *AuditEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintAudit(dAtA, i, uint64(len(m.Domain)))
+	i += copy(dAtA[i:], m.Domain)
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintAudit(dAtA, i, uint64(m.Since))
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintAudit(dAtA, i, uint64(m.Until))
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintAudit(dAtA, i, uint64(m.Limit))
+	return i, nil
+}
+
+func (m *AuditEventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditEventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAudit(dAtA, i, uint64(m.Error.Size()))
+		n2, err := m.Error.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if len(m.AuditEvents) > 0 {
+		for _, msg := range m.AuditEvents {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintAudit(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func encodeFixed64Audit(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Audit(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintAudit(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *AuditEvent) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovAudit(uint64(m.Id))
+	n += 1 + sovAudit(uint64(m.CreatedAt))
+	l = len(m.Route)
+	n += 1 + l + sovAudit(uint64(l))
+	l = len(m.Actor)
+	n += 1 + l + sovAudit(uint64(l))
+	l = len(m.RemoteAddress)
+	n += 1 + l + sovAudit(uint64(l))
+	l = len(m.RequestId)
+	n += 1 + l + sovAudit(uint64(l))
+	l = len(m.Domain)
+	n += 1 + l + sovAudit(uint64(l))
+	l = len(m.Guid)
+	n += 1 + l + sovAudit(uint64(l))
+	l = len(m.Summary)
+	n += 1 + l + sovAudit(uint64(l))
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovAudit(uint64(l))
+	}
+	return n
+}
+
+func (m *AuditEventsRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Domain)
+	n += 1 + l + sovAudit(uint64(l))
+	n += 1 + sovAudit(uint64(m.Since))
+	n += 1 + sovAudit(uint64(m.Until))
+	n += 1 + sovAudit(uint64(m.Limit))
+	return n
+}
+
+func (m *AuditEventsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovAudit(uint64(l))
+	}
+	if len(m.AuditEvents) > 0 {
+		for _, e := range m.AuditEvents {
+			l = e.Size()
+			n += 1 + l + sovAudit(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAudit(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozAudit(x uint64) (n int) {
+	return sovAudit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *AuditEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AuditEvent{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
+		`Route:` + fmt.Sprintf("%v", this.Route) + `,`,
+		`Actor:` + fmt.Sprintf("%v", this.Actor) + `,`,
+		`RemoteAddress:` + fmt.Sprintf("%v", this.RemoteAddress) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
+		`Guid:` + fmt.Sprintf("%v", this.Guid) + `,`,
+		`Summary:` + fmt.Sprintf("%v", this.Summary) + `,`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AuditEventsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AuditEventsRequest{`,
+		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
+		`Since:` + fmt.Sprintf("%v", this.Since) + `,`,
+		`Until:` + fmt.Sprintf("%v", this.Until) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AuditEventsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AuditEventsResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`AuditEvents:` + strings.Replace(fmt.Sprintf("%v", this.AuditEvents), "AuditEvent", "AuditEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringAudit(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AuditEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAudit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuditEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuditEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Summary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAudit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuditEventsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAudit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuditEventsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuditEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			m.Since = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Since |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
+			}
+			m.Until = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Until |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAudit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuditEventsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAudit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuditEventsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuditEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuditEvents = append(m.AuditEvents, &AuditEvent{})
+			if err := m.AuditEvents[len(m.AuditEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAudit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAudit(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAudit
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthAudit
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowAudit
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipAudit(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthAudit = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAudit   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("audit.proto", fileDescriptorAudit) }
+
+var fileDescriptorAudit = []byte{
+	// 415 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x74, 0x92, 0xbf, 0x8e, 0xd3, 0x30,
+	0x1c, 0xc7, 0xe3, 0xb4, 0xe9, 0xd1, 0x5f, 0x38, 0x06, 0xc3, 0x60, 0x55, 0xc8, 0x54, 0xbd, 0xa5,
+	0x12, 0x90, 0x93, 0x4e, 0xe2, 0x01, 0x7a, 0xd2, 0x0d, 0xac, 0x79, 0x81, 0xc8, 0x17, 0x9b, 0x60,
+	0xa9, 0x89, 0xef, 0xfc, 0x07, 0x89, 0x8d, 0x89, 0x99, 0xc7, 0xe0, 0x51, 0x6e, 0x42, 0x37, 0x32,
+	0x21, 0x1a, 0x16, 0xc6, 0x7b, 0x04, 0x14, 0x3b, 0xa5, 0xae, 0x04, 0x5b, 0x7e, 0x9f, 0xcf, 0xcf,
+	0xd6, 0xd7, 0x5f, 0x05, 0x72, 0xe6, 0xb8, 0xb4, 0xc5, 0x8d, 0x56, 0x56, 0xe1, 0x59, 0xab, 0xb8,
+	0xd8, 0x9a, 0xc5, 0xeb, 0x46, 0xda, 0xf7, 0xee, 0xba, 0xa8, 0x55, 0x7b, 0xde, 0xa8, 0x46, 0x9d,
+	0x7b, 0x7d, 0xed, 0xde, 0xf9, 0xc9, 0x0f, 0xfe, 0x2b, 0x1c, 0x5b, 0xe4, 0x42, 0x6b, 0xa5, 0xc3,
+	0xb0, 0xfa, 0x96, 0x02, 0x6c, 0x86, 0x3b, 0xaf, 0x3e, 0x88, 0xce, 0xe2, 0x67, 0x90, 0x4a, 0x4e,
+	0xd0, 0x12, 0xad, 0x27, 0x97, 0xd3, 0xbb, 0x1f, 0x2f, 0x92, 0x32, 0x95, 0x1c, 0x9f, 0x01, 0xd4,
+	0x5a, 0x30, 0x2b, 0x78, 0xc5, 0x2c, 0x49, 0x23, 0x3b, 0x1f, 0xf9, 0xc6, 0xe2, 0x05, 0x64, 0x5a,
+	0x39, 0x2b, 0xc8, 0x64, 0x89, 0xd6, 0xf3, 0xd1, 0x07, 0x34, 0x38, 0x56, 0x5b, 0xa5, 0xc9, 0x34,
+	0x76, 0x1e, 0xe1, 0x97, 0xf0, 0x44, 0x8b, 0x56, 0x59, 0x51, 0x31, 0xce, 0xb5, 0x30, 0x86, 0x64,
+	0xd1, 0xd2, 0x69, 0x70, 0x9b, 0xa0, 0x86, 0x24, 0x5a, 0xdc, 0x3a, 0x61, 0x6c, 0x25, 0x39, 0x99,
+	0x45, 0x8b, 0xf3, 0x91, 0xbf, 0xe5, 0xf8, 0x39, 0xcc, 0xb8, 0x6a, 0x99, 0xec, 0xc8, 0x49, 0xb4,
+	0x30, 0x32, 0x4c, 0x60, 0xda, 0x38, 0xc9, 0xc9, 0xa3, 0xc8, 0x79, 0x82, 0x29, 0x9c, 0x18, 0xd7,
+	0xb6, 0x4c, 0x7f, 0x24, 0xf3, 0x48, 0xee, 0x21, 0x3e, 0x83, 0xcc, 0x57, 0x47, 0x60, 0x89, 0xd6,
+	0xf9, 0xc5, 0x69, 0x11, 0xfa, 0x2f, 0xae, 0x06, 0x58, 0x06, 0xb7, 0xfa, 0x8c, 0x00, 0x1f, 0x0a,
+	0x35, 0x65, 0x48, 0x15, 0x65, 0x42, 0xff, 0xc8, 0xb4, 0x80, 0xcc, 0xc8, 0xae, 0x16, 0x47, 0xdd,
+	0x06, 0x34, 0x38, 0xd7, 0x59, 0xb9, 0x25, 0x93, 0xd8, 0x79, 0x34, 0xb8, 0xad, 0x6c, 0xa5, 0xf5,
+	0xbd, 0x66, 0x7b, 0xe7, 0xd1, 0xea, 0x16, 0x9e, 0x1e, 0xe5, 0x30, 0x37, 0xaa, 0x33, 0xe2, 0xf0,
+	0x08, 0xf4, 0xff, 0x47, 0xe0, 0x37, 0xf0, 0xd8, 0xff, 0x68, 0x95, 0xf0, 0x87, 0x49, 0xba, 0x9c,
+	0xac, 0xf3, 0x0b, 0xbc, 0xdf, 0x3d, 0xdc, 0x5b, 0xe6, 0xec, 0xef, 0xb7, 0xb9, 0x7c, 0x75, 0xbf,
+	0xa3, 0xc9, 0xf7, 0x1d, 0x4d, 0x1e, 0x76, 0x14, 0x7d, 0xea, 0x29, 0xfa, 0xda, 0x53, 0x74, 0xd7,
+	0x53, 0x74, 0xdf, 0x53, 0xf4, 0xb3, 0xa7, 0xe8, 0x77, 0x4f, 0x93, 0x87, 0x9e, 0xa2, 0x2f, 0xbf,
+	0x68, 0xf2, 0x27, 0x00, 0x00, 0xff, 0xff, 0x2b, 0xa8, 0x3a, 0x81, 0xcc, 0x02, 0x00, 0x00,
+}
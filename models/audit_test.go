@@ -0,0 +1,79 @@
+package models_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Audit Requests", func() {
+	Describe("AuditEventsRequest", func() {
+		var request models.AuditEventsRequest
+
+		BeforeEach(func() {
+			request = models.AuditEventsRequest{
+				Domain: "some-domain",
+				Since:  1000,
+				Until:  2000,
+				Limit:  10,
+			}
+		})
+
+		Describe("Validate", func() {
+			Context("when valid", func() {
+				It("returns nil", func() {
+					Expect(request.Validate()).To(BeNil())
+				})
+			})
+
+			Context("when nothing is set", func() {
+				It("returns nil", func() {
+					request = models.AuditEventsRequest{}
+					Expect(request.Validate()).To(BeNil())
+				})
+			})
+
+			Context("when the limit is negative", func() {
+				BeforeEach(func() {
+					request.Limit = -1
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"limit"}))
+				})
+			})
+
+			Context("when until is before since", func() {
+				BeforeEach(func() {
+					request.Until = 999
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"until"}))
+				})
+			})
+
+			Context("when since is negative", func() {
+				BeforeEach(func() {
+					request.Since = -1
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"since"}))
+				})
+			})
+		})
+
+		Describe("Filter", func() {
+			It("returns the filter of the request", func() {
+				Expect(request.Filter()).To(Equal(models.AuditEventFilter{
+					Domain: "some-domain",
+					Since:  1000,
+					Until:  2000,
+					Limit:  10,
+				}))
+			})
+		})
+	})
+})
@@ -0,0 +1,1976 @@
+// Code generated by protoc-gen-gogo.
+// source: bbs.proto
+// DO NOT EDIT!
+
+package models
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for BBS service
+
+// BBS is the public API, served over gRPC alongside the HTTP routes.
+type BBSClient interface {
+	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
+	Domains(ctx context.Context, in *DomainsRequest, opts ...grpc.CallOption) (*DomainsResponse, error)
+	UpsertDomain(ctx context.Context, in *UpsertDomainRequest, opts ...grpc.CallOption) (*UpsertDomainResponse, error)
+	ActualLRPGroups(ctx context.Context, in *ActualLRPGroupsRequest, opts ...grpc.CallOption) (*ActualLRPGroupsResponse, error)
+	ActualLRPGroupsByProcessGuid(ctx context.Context, in *ActualLRPGroupsByProcessGuidRequest, opts ...grpc.CallOption) (*ActualLRPGroupsResponse, error)
+	ActualLRPGroupByProcessGuidAndIndex(ctx context.Context, in *ActualLRPGroupByProcessGuidAndIndexRequest, opts ...grpc.CallOption) (*ActualLRPGroupResponse, error)
+	RetireActualLRP(ctx context.Context, in *RetireActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error)
+	DesiredLRPs(ctx context.Context, in *DesiredLRPsRequest, opts ...grpc.CallOption) (*DesiredLRPsResponse, error)
+	DesiredLRPByProcessGuid(ctx context.Context, in *DesiredLRPByProcessGuidRequest, opts ...grpc.CallOption) (*DesiredLRPResponse, error)
+	DesiredLRPSchedulingInfos(ctx context.Context, in *DesiredLRPsRequest, opts ...grpc.CallOption) (*DesiredLRPSchedulingInfosResponse, error)
+	DesireLRP(ctx context.Context, in *DesireLRPRequest, opts ...grpc.CallOption) (*DesiredLRPLifecycleResponse, error)
+	DesireLRPs(ctx context.Context, in *DesireLRPsRequest, opts ...grpc.CallOption) (*DesiredLRPsLifecycleResponse, error)
+	UpdateDesiredLRP(ctx context.Context, in *UpdateDesiredLRPRequest, opts ...grpc.CallOption) (*DesiredLRPLifecycleResponse, error)
+	RemoveDesiredLRP(ctx context.Context, in *RemoveDesiredLRPRequest, opts ...grpc.CallOption) (*DesiredLRPLifecycleResponse, error)
+	RemoveDesiredLRPs(ctx context.Context, in *RemoveDesiredLRPsRequest, opts ...grpc.CallOption) (*DesiredLRPsLifecycleResponse, error)
+	RedeployDesiredLRP(ctx context.Context, in *RedeployDesiredLRPRequest, opts ...grpc.CallOption) (*DesiredLRPLifecycleResponse, error)
+	ApplyDesiredLRPs(ctx context.Context, in *ApplyDesiredLRPsRequest, opts ...grpc.CallOption) (*ApplyDesiredLRPsResponse, error)
+	DesiredLRPRevisions(ctx context.Context, in *DesiredLRPRevisionsRequest, opts ...grpc.CallOption) (*DesiredLRPRevisionsResponse, error)
+	DesiredLRPRevision(ctx context.Context, in *DesiredLRPRevisionRequest, opts ...grpc.CallOption) (*DesiredLRPRevisionResponse, error)
+	RollbackDesiredLRP(ctx context.Context, in *RollbackDesiredLRPRequest, opts ...grpc.CallOption) (*DesiredLRPLifecycleResponse, error)
+	DesiredLRPRollout(ctx context.Context, in *DesiredLRPRolloutRequest, opts ...grpc.CallOption) (*DesiredLRPRolloutResponse, error)
+	Tasks(ctx context.Context, in *TasksRequest, opts ...grpc.CallOption) (*TasksResponse, error)
+	TaskByGuid(ctx context.Context, in *TaskByGuidRequest, opts ...grpc.CallOption) (*TaskResponse, error)
+	DesireTask(ctx context.Context, in *DesireTaskRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error)
+	DesireTasks(ctx context.Context, in *DesireTasksRequest, opts ...grpc.CallOption) (*TasksLifecycleResponse, error)
+	CancelTask(ctx context.Context, in *TaskGuidRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error)
+	ResolvingTask(ctx context.Context, in *TaskGuidRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error)
+	DeleteTask(ctx context.Context, in *TaskGuidRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error)
+	CancelTasks(ctx context.Context, in *TaskGuidsRequest, opts ...grpc.CallOption) (*TasksLifecycleResponse, error)
+	DeleteTasks(ctx context.Context, in *TaskGuidsRequest, opts ...grpc.CallOption) (*TasksLifecycleResponse, error)
+	Cells(ctx context.Context, in *CellsRequest, opts ...grpc.CallOption) (*CellsResponse, error)
+	AuditEvents(ctx context.Context, in *AuditEventsRequest, opts ...grpc.CallOption) (*AuditEventsResponse, error)
+	QuarantinedRecords(ctx context.Context, in *QuarantinedRecordsRequest, opts ...grpc.CallOption) (*QuarantinedRecordsResponse, error)
+	QuarantinedRecord(ctx context.Context, in *QuarantinedRecordRequest, opts ...grpc.CallOption) (*QuarantinedRecordResponse, error)
+	RestoreQuarantinedRecord(ctx context.Context, in *RestoreQuarantinedRecordRequest, opts ...grpc.CallOption) (*RestoreQuarantinedRecordResponse, error)
+	RestoreQuarantinedRecords(ctx context.Context, in *RestoreQuarantinedRecordsRequest, opts ...grpc.CallOption) (*RestoreQuarantinedRecordsResponse, error)
+	EncryptionProgress(ctx context.Context, in *EncryptionProgressRequest, opts ...grpc.CallOption) (*EncryptionProgressResponse, error)
+	VerifyEncryptionKeyRetired(ctx context.Context, in *VerifyEncryptionKeyRetiredRequest, opts ...grpc.CallOption) (*VerifyEncryptionKeyRetiredResponse, error)
+	SubscribeToEvents(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (BBS_SubscribeToEventsClient, error)
+	SubscribeToTaskEvents(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (BBS_SubscribeToTaskEventsClient, error)
+}
+
+type bBSClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBBSClient(cc *grpc.ClientConn) BBSClient {
+	return &bBSClient{cc}
+}
+
+func (c *bBSClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
+	out := new(PingResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/Ping", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) Domains(ctx context.Context, in *DomainsRequest, opts ...grpc.CallOption) (*DomainsResponse, error) {
+	out := new(DomainsResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/Domains", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) UpsertDomain(ctx context.Context, in *UpsertDomainRequest, opts ...grpc.CallOption) (*UpsertDomainResponse, error) {
+	out := new(UpsertDomainResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/UpsertDomain", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) ActualLRPGroups(ctx context.Context, in *ActualLRPGroupsRequest, opts ...grpc.CallOption) (*ActualLRPGroupsResponse, error) {
+	out := new(ActualLRPGroupsResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/ActualLRPGroups", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) ActualLRPGroupsByProcessGuid(ctx context.Context, in *ActualLRPGroupsByProcessGuidRequest, opts ...grpc.CallOption) (*ActualLRPGroupsResponse, error) {
+	out := new(ActualLRPGroupsResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/ActualLRPGroupsByProcessGuid", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) ActualLRPGroupByProcessGuidAndIndex(ctx context.Context, in *ActualLRPGroupByProcessGuidAndIndexRequest, opts ...grpc.CallOption) (*ActualLRPGroupResponse, error) {
+	out := new(ActualLRPGroupResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/ActualLRPGroupByProcessGuidAndIndex", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) RetireActualLRP(ctx context.Context, in *RetireActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error) {
+	out := new(ActualLRPLifecycleResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/RetireActualLRP", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DesiredLRPs(ctx context.Context, in *DesiredLRPsRequest, opts ...grpc.CallOption) (*DesiredLRPsResponse, error) {
+	out := new(DesiredLRPsResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/DesiredLRPs", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DesiredLRPByProcessGuid(ctx context.Context, in *DesiredLRPByProcessGuidRequest, opts ...grpc.CallOption) (*DesiredLRPResponse, error) {
+	out := new(DesiredLRPResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/DesiredLRPByProcessGuid", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DesiredLRPSchedulingInfos(ctx context.Context, in *DesiredLRPsRequest, opts ...grpc.CallOption) (*DesiredLRPSchedulingInfosResponse, error) {
+	out := new(DesiredLRPSchedulingInfosResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/DesiredLRPSchedulingInfos", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DesireLRP(ctx context.Context, in *DesireLRPRequest, opts ...grpc.CallOption) (*DesiredLRPLifecycleResponse, error) {
+	out := new(DesiredLRPLifecycleResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/DesireLRP", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DesireLRPs(ctx context.Context, in *DesireLRPsRequest, opts ...grpc.CallOption) (*DesiredLRPsLifecycleResponse, error) {
+	out := new(DesiredLRPsLifecycleResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/DesireLRPs", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) UpdateDesiredLRP(ctx context.Context, in *UpdateDesiredLRPRequest, opts ...grpc.CallOption) (*DesiredLRPLifecycleResponse, error) {
+	out := new(DesiredLRPLifecycleResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/UpdateDesiredLRP", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) RemoveDesiredLRP(ctx context.Context, in *RemoveDesiredLRPRequest, opts ...grpc.CallOption) (*DesiredLRPLifecycleResponse, error) {
+	out := new(DesiredLRPLifecycleResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/RemoveDesiredLRP", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) RemoveDesiredLRPs(ctx context.Context, in *RemoveDesiredLRPsRequest, opts ...grpc.CallOption) (*DesiredLRPsLifecycleResponse, error) {
+	out := new(DesiredLRPsLifecycleResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/RemoveDesiredLRPs", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) RedeployDesiredLRP(ctx context.Context, in *RedeployDesiredLRPRequest, opts ...grpc.CallOption) (*DesiredLRPLifecycleResponse, error) {
+	out := new(DesiredLRPLifecycleResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/RedeployDesiredLRP", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) ApplyDesiredLRPs(ctx context.Context, in *ApplyDesiredLRPsRequest, opts ...grpc.CallOption) (*ApplyDesiredLRPsResponse, error) {
+	out := new(ApplyDesiredLRPsResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/ApplyDesiredLRPs", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DesiredLRPRevisions(ctx context.Context, in *DesiredLRPRevisionsRequest, opts ...grpc.CallOption) (*DesiredLRPRevisionsResponse, error) {
+	out := new(DesiredLRPRevisionsResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/DesiredLRPRevisions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DesiredLRPRevision(ctx context.Context, in *DesiredLRPRevisionRequest, opts ...grpc.CallOption) (*DesiredLRPRevisionResponse, error) {
+	out := new(DesiredLRPRevisionResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/DesiredLRPRevision", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) RollbackDesiredLRP(ctx context.Context, in *RollbackDesiredLRPRequest, opts ...grpc.CallOption) (*DesiredLRPLifecycleResponse, error) {
+	out := new(DesiredLRPLifecycleResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/RollbackDesiredLRP", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DesiredLRPRollout(ctx context.Context, in *DesiredLRPRolloutRequest, opts ...grpc.CallOption) (*DesiredLRPRolloutResponse, error) {
+	out := new(DesiredLRPRolloutResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/DesiredLRPRollout", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) Tasks(ctx context.Context, in *TasksRequest, opts ...grpc.CallOption) (*TasksResponse, error) {
+	out := new(TasksResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/Tasks", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) TaskByGuid(ctx context.Context, in *TaskByGuidRequest, opts ...grpc.CallOption) (*TaskResponse, error) {
+	out := new(TaskResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/TaskByGuid", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DesireTask(ctx context.Context, in *DesireTaskRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error) {
+	out := new(TaskLifecycleResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/DesireTask", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DesireTasks(ctx context.Context, in *DesireTasksRequest, opts ...grpc.CallOption) (*TasksLifecycleResponse, error) {
+	out := new(TasksLifecycleResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/DesireTasks", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) CancelTask(ctx context.Context, in *TaskGuidRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error) {
+	out := new(TaskLifecycleResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/CancelTask", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) ResolvingTask(ctx context.Context, in *TaskGuidRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error) {
+	out := new(TaskLifecycleResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/ResolvingTask", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DeleteTask(ctx context.Context, in *TaskGuidRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error) {
+	out := new(TaskLifecycleResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/DeleteTask", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) CancelTasks(ctx context.Context, in *TaskGuidsRequest, opts ...grpc.CallOption) (*TasksLifecycleResponse, error) {
+	out := new(TasksLifecycleResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/CancelTasks", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DeleteTasks(ctx context.Context, in *TaskGuidsRequest, opts ...grpc.CallOption) (*TasksLifecycleResponse, error) {
+	out := new(TasksLifecycleResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/DeleteTasks", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) Cells(ctx context.Context, in *CellsRequest, opts ...grpc.CallOption) (*CellsResponse, error) {
+	out := new(CellsResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/Cells", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) AuditEvents(ctx context.Context, in *AuditEventsRequest, opts ...grpc.CallOption) (*AuditEventsResponse, error) {
+	out := new(AuditEventsResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/AuditEvents", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) QuarantinedRecords(ctx context.Context, in *QuarantinedRecordsRequest, opts ...grpc.CallOption) (*QuarantinedRecordsResponse, error) {
+	out := new(QuarantinedRecordsResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/QuarantinedRecords", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) QuarantinedRecord(ctx context.Context, in *QuarantinedRecordRequest, opts ...grpc.CallOption) (*QuarantinedRecordResponse, error) {
+	out := new(QuarantinedRecordResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/QuarantinedRecord", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) RestoreQuarantinedRecord(ctx context.Context, in *RestoreQuarantinedRecordRequest, opts ...grpc.CallOption) (*RestoreQuarantinedRecordResponse, error) {
+	out := new(RestoreQuarantinedRecordResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/RestoreQuarantinedRecord", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) RestoreQuarantinedRecords(ctx context.Context, in *RestoreQuarantinedRecordsRequest, opts ...grpc.CallOption) (*RestoreQuarantinedRecordsResponse, error) {
+	out := new(RestoreQuarantinedRecordsResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/RestoreQuarantinedRecords", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) EncryptionProgress(ctx context.Context, in *EncryptionProgressRequest, opts ...grpc.CallOption) (*EncryptionProgressResponse, error) {
+	out := new(EncryptionProgressResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/EncryptionProgress", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) VerifyEncryptionKeyRetired(ctx context.Context, in *VerifyEncryptionKeyRetiredRequest, opts ...grpc.CallOption) (*VerifyEncryptionKeyRetiredResponse, error) {
+	out := new(VerifyEncryptionKeyRetiredResponse)
+	err := grpc.Invoke(ctx, "/models.BBS/VerifyEncryptionKeyRetired", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) SubscribeToEvents(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (BBS_SubscribeToEventsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_BBS_serviceDesc.Streams[0], c.cc, "/models.BBS/SubscribeToEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bBSSubscribeToEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BBS_SubscribeToEventsClient interface {
+	Recv() (*EventEnvelope, error)
+	grpc.ClientStream
+}
+
+type bBSSubscribeToEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *bBSSubscribeToEventsClient) Recv() (*EventEnvelope, error) {
+	m := new(EventEnvelope)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bBSClient) SubscribeToTaskEvents(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (BBS_SubscribeToTaskEventsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_BBS_serviceDesc.Streams[1], c.cc, "/models.BBS/SubscribeToTaskEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bBSSubscribeToTaskEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BBS_SubscribeToTaskEventsClient interface {
+	Recv() (*EventEnvelope, error)
+	grpc.ClientStream
+}
+
+type bBSSubscribeToTaskEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *bBSSubscribeToTaskEventsClient) Recv() (*EventEnvelope, error) {
+	m := new(EventEnvelope)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for BBS service
+
+// BBS is the public API, served over gRPC alongside the HTTP routes.
+type BBSServer interface {
+	Ping(context.Context, *PingRequest) (*PingResponse, error)
+	Domains(context.Context, *DomainsRequest) (*DomainsResponse, error)
+	UpsertDomain(context.Context, *UpsertDomainRequest) (*UpsertDomainResponse, error)
+	ActualLRPGroups(context.Context, *ActualLRPGroupsRequest) (*ActualLRPGroupsResponse, error)
+	ActualLRPGroupsByProcessGuid(context.Context, *ActualLRPGroupsByProcessGuidRequest) (*ActualLRPGroupsResponse, error)
+	ActualLRPGroupByProcessGuidAndIndex(context.Context, *ActualLRPGroupByProcessGuidAndIndexRequest) (*ActualLRPGroupResponse, error)
+	RetireActualLRP(context.Context, *RetireActualLRPRequest) (*ActualLRPLifecycleResponse, error)
+	DesiredLRPs(context.Context, *DesiredLRPsRequest) (*DesiredLRPsResponse, error)
+	DesiredLRPByProcessGuid(context.Context, *DesiredLRPByProcessGuidRequest) (*DesiredLRPResponse, error)
+	DesiredLRPSchedulingInfos(context.Context, *DesiredLRPsRequest) (*DesiredLRPSchedulingInfosResponse, error)
+	DesireLRP(context.Context, *DesireLRPRequest) (*DesiredLRPLifecycleResponse, error)
+	DesireLRPs(context.Context, *DesireLRPsRequest) (*DesiredLRPsLifecycleResponse, error)
+	UpdateDesiredLRP(context.Context, *UpdateDesiredLRPRequest) (*DesiredLRPLifecycleResponse, error)
+	RemoveDesiredLRP(context.Context, *RemoveDesiredLRPRequest) (*DesiredLRPLifecycleResponse, error)
+	RemoveDesiredLRPs(context.Context, *RemoveDesiredLRPsRequest) (*DesiredLRPsLifecycleResponse, error)
+	RedeployDesiredLRP(context.Context, *RedeployDesiredLRPRequest) (*DesiredLRPLifecycleResponse, error)
+	ApplyDesiredLRPs(context.Context, *ApplyDesiredLRPsRequest) (*ApplyDesiredLRPsResponse, error)
+	DesiredLRPRevisions(context.Context, *DesiredLRPRevisionsRequest) (*DesiredLRPRevisionsResponse, error)
+	DesiredLRPRevision(context.Context, *DesiredLRPRevisionRequest) (*DesiredLRPRevisionResponse, error)
+	RollbackDesiredLRP(context.Context, *RollbackDesiredLRPRequest) (*DesiredLRPLifecycleResponse, error)
+	DesiredLRPRollout(context.Context, *DesiredLRPRolloutRequest) (*DesiredLRPRolloutResponse, error)
+	Tasks(context.Context, *TasksRequest) (*TasksResponse, error)
+	TaskByGuid(context.Context, *TaskByGuidRequest) (*TaskResponse, error)
+	DesireTask(context.Context, *DesireTaskRequest) (*TaskLifecycleResponse, error)
+	DesireTasks(context.Context, *DesireTasksRequest) (*TasksLifecycleResponse, error)
+	CancelTask(context.Context, *TaskGuidRequest) (*TaskLifecycleResponse, error)
+	ResolvingTask(context.Context, *TaskGuidRequest) (*TaskLifecycleResponse, error)
+	DeleteTask(context.Context, *TaskGuidRequest) (*TaskLifecycleResponse, error)
+	CancelTasks(context.Context, *TaskGuidsRequest) (*TasksLifecycleResponse, error)
+	DeleteTasks(context.Context, *TaskGuidsRequest) (*TasksLifecycleResponse, error)
+	Cells(context.Context, *CellsRequest) (*CellsResponse, error)
+	AuditEvents(context.Context, *AuditEventsRequest) (*AuditEventsResponse, error)
+	QuarantinedRecords(context.Context, *QuarantinedRecordsRequest) (*QuarantinedRecordsResponse, error)
+	QuarantinedRecord(context.Context, *QuarantinedRecordRequest) (*QuarantinedRecordResponse, error)
+	RestoreQuarantinedRecord(context.Context, *RestoreQuarantinedRecordRequest) (*RestoreQuarantinedRecordResponse, error)
+	RestoreQuarantinedRecords(context.Context, *RestoreQuarantinedRecordsRequest) (*RestoreQuarantinedRecordsResponse, error)
+	EncryptionProgress(context.Context, *EncryptionProgressRequest) (*EncryptionProgressResponse, error)
+	VerifyEncryptionKeyRetired(context.Context, *VerifyEncryptionKeyRetiredRequest) (*VerifyEncryptionKeyRetiredResponse, error)
+	SubscribeToEvents(*EventsRequest, BBS_SubscribeToEventsServer) error
+	SubscribeToTaskEvents(*EventsRequest, BBS_SubscribeToTaskEventsServer) error
+}
+
+func RegisterBBSServer(s *grpc.Server, srv BBSServer) {
+	s.RegisterService(&_BBS_serviceDesc, srv)
+}
+
+func _BBS_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/Ping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).Ping(ctx, req.(*PingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_Domains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DomainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).Domains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/Domains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).Domains(ctx, req.(*DomainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_UpsertDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).UpsertDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/UpsertDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).UpsertDomain(ctx, req.(*UpsertDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_ActualLRPGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActualLRPGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).ActualLRPGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/ActualLRPGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).ActualLRPGroups(ctx, req.(*ActualLRPGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_ActualLRPGroupsByProcessGuid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActualLRPGroupsByProcessGuidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).ActualLRPGroupsByProcessGuid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/ActualLRPGroupsByProcessGuid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).ActualLRPGroupsByProcessGuid(ctx, req.(*ActualLRPGroupsByProcessGuidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_ActualLRPGroupByProcessGuidAndIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActualLRPGroupByProcessGuidAndIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).ActualLRPGroupByProcessGuidAndIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/ActualLRPGroupByProcessGuidAndIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).ActualLRPGroupByProcessGuidAndIndex(ctx, req.(*ActualLRPGroupByProcessGuidAndIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_RetireActualLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetireActualLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).RetireActualLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/RetireActualLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).RetireActualLRP(ctx, req.(*RetireActualLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DesiredLRPs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DesiredLRPsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DesiredLRPs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DesiredLRPs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DesiredLRPs(ctx, req.(*DesiredLRPsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DesiredLRPByProcessGuid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DesiredLRPByProcessGuidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DesiredLRPByProcessGuid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DesiredLRPByProcessGuid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DesiredLRPByProcessGuid(ctx, req.(*DesiredLRPByProcessGuidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DesiredLRPSchedulingInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DesiredLRPsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DesiredLRPSchedulingInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DesiredLRPSchedulingInfos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DesiredLRPSchedulingInfos(ctx, req.(*DesiredLRPsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DesireLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DesireLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DesireLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DesireLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DesireLRP(ctx, req.(*DesireLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DesireLRPs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DesireLRPsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DesireLRPs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DesireLRPs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DesireLRPs(ctx, req.(*DesireLRPsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_UpdateDesiredLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDesiredLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).UpdateDesiredLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/UpdateDesiredLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).UpdateDesiredLRP(ctx, req.(*UpdateDesiredLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_RemoveDesiredLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDesiredLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).RemoveDesiredLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/RemoveDesiredLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).RemoveDesiredLRP(ctx, req.(*RemoveDesiredLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_RemoveDesiredLRPs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDesiredLRPsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).RemoveDesiredLRPs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/RemoveDesiredLRPs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).RemoveDesiredLRPs(ctx, req.(*RemoveDesiredLRPsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_RedeployDesiredLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeployDesiredLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).RedeployDesiredLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/RedeployDesiredLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).RedeployDesiredLRP(ctx, req.(*RedeployDesiredLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_ApplyDesiredLRPs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyDesiredLRPsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).ApplyDesiredLRPs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/ApplyDesiredLRPs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).ApplyDesiredLRPs(ctx, req.(*ApplyDesiredLRPsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DesiredLRPRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DesiredLRPRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DesiredLRPRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DesiredLRPRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DesiredLRPRevisions(ctx, req.(*DesiredLRPRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DesiredLRPRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DesiredLRPRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DesiredLRPRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DesiredLRPRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DesiredLRPRevision(ctx, req.(*DesiredLRPRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_RollbackDesiredLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackDesiredLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).RollbackDesiredLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/RollbackDesiredLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).RollbackDesiredLRP(ctx, req.(*RollbackDesiredLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DesiredLRPRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DesiredLRPRolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DesiredLRPRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DesiredLRPRollout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DesiredLRPRollout(ctx, req.(*DesiredLRPRolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_Tasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).Tasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/Tasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).Tasks(ctx, req.(*TasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_TaskByGuid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskByGuidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).TaskByGuid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/TaskByGuid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).TaskByGuid(ctx, req.(*TaskByGuidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DesireTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DesireTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DesireTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DesireTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DesireTask(ctx, req.(*DesireTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DesireTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DesireTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DesireTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DesireTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DesireTasks(ctx, req.(*DesireTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_CancelTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskGuidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).CancelTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/CancelTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).CancelTask(ctx, req.(*TaskGuidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_ResolvingTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskGuidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).ResolvingTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/ResolvingTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).ResolvingTask(ctx, req.(*TaskGuidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DeleteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskGuidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DeleteTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DeleteTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DeleteTask(ctx, req.(*TaskGuidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_CancelTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskGuidsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).CancelTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/CancelTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).CancelTasks(ctx, req.(*TaskGuidsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DeleteTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskGuidsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DeleteTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DeleteTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DeleteTasks(ctx, req.(*TaskGuidsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_Cells_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CellsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).Cells(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/Cells",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).Cells(ctx, req.(*CellsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_AuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).AuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/AuditEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).AuditEvents(ctx, req.(*AuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_QuarantinedRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuarantinedRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).QuarantinedRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/QuarantinedRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).QuarantinedRecords(ctx, req.(*QuarantinedRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_QuarantinedRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuarantinedRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).QuarantinedRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/QuarantinedRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).QuarantinedRecord(ctx, req.(*QuarantinedRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_RestoreQuarantinedRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreQuarantinedRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).RestoreQuarantinedRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/RestoreQuarantinedRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).RestoreQuarantinedRecord(ctx, req.(*RestoreQuarantinedRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_RestoreQuarantinedRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreQuarantinedRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).RestoreQuarantinedRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/RestoreQuarantinedRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).RestoreQuarantinedRecords(ctx, req.(*RestoreQuarantinedRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_EncryptionProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EncryptionProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).EncryptionProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/EncryptionProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).EncryptionProgress(ctx, req.(*EncryptionProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_VerifyEncryptionKeyRetired_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEncryptionKeyRetiredRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).VerifyEncryptionKeyRetired(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/VerifyEncryptionKeyRetired",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).VerifyEncryptionKeyRetired(ctx, req.(*VerifyEncryptionKeyRetiredRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_SubscribeToEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BBSServer).SubscribeToEvents(m, &bBSSubscribeToEventsServer{stream})
+}
+
+type BBS_SubscribeToEventsServer interface {
+	Send(*EventEnvelope) error
+	grpc.ServerStream
+}
+
+type bBSSubscribeToEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *bBSSubscribeToEventsServer) Send(m *EventEnvelope) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BBS_SubscribeToTaskEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BBSServer).SubscribeToTaskEvents(m, &bBSSubscribeToTaskEventsServer{stream})
+}
+
+type BBS_SubscribeToTaskEventsServer interface {
+	Send(*EventEnvelope) error
+	grpc.ServerStream
+}
+
+type bBSSubscribeToTaskEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *bBSSubscribeToTaskEventsServer) Send(m *EventEnvelope) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _BBS_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "models.BBS",
+	HandlerType: (*BBSServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Ping",
+			Handler:    _BBS_Ping_Handler,
+		},
+		{
+			MethodName: "Domains",
+			Handler:    _BBS_Domains_Handler,
+		},
+		{
+			MethodName: "UpsertDomain",
+			Handler:    _BBS_UpsertDomain_Handler,
+		},
+		{
+			MethodName: "ActualLRPGroups",
+			Handler:    _BBS_ActualLRPGroups_Handler,
+		},
+		{
+			MethodName: "ActualLRPGroupsByProcessGuid",
+			Handler:    _BBS_ActualLRPGroupsByProcessGuid_Handler,
+		},
+		{
+			MethodName: "ActualLRPGroupByProcessGuidAndIndex",
+			Handler:    _BBS_ActualLRPGroupByProcessGuidAndIndex_Handler,
+		},
+		{
+			MethodName: "RetireActualLRP",
+			Handler:    _BBS_RetireActualLRP_Handler,
+		},
+		{
+			MethodName: "DesiredLRPs",
+			Handler:    _BBS_DesiredLRPs_Handler,
+		},
+		{
+			MethodName: "DesiredLRPByProcessGuid",
+			Handler:    _BBS_DesiredLRPByProcessGuid_Handler,
+		},
+		{
+			MethodName: "DesiredLRPSchedulingInfos",
+			Handler:    _BBS_DesiredLRPSchedulingInfos_Handler,
+		},
+		{
+			MethodName: "DesireLRP",
+			Handler:    _BBS_DesireLRP_Handler,
+		},
+		{
+			MethodName: "DesireLRPs",
+			Handler:    _BBS_DesireLRPs_Handler,
+		},
+		{
+			MethodName: "UpdateDesiredLRP",
+			Handler:    _BBS_UpdateDesiredLRP_Handler,
+		},
+		{
+			MethodName: "RemoveDesiredLRP",
+			Handler:    _BBS_RemoveDesiredLRP_Handler,
+		},
+		{
+			MethodName: "RemoveDesiredLRPs",
+			Handler:    _BBS_RemoveDesiredLRPs_Handler,
+		},
+		{
+			MethodName: "RedeployDesiredLRP",
+			Handler:    _BBS_RedeployDesiredLRP_Handler,
+		},
+		{
+			MethodName: "ApplyDesiredLRPs",
+			Handler:    _BBS_ApplyDesiredLRPs_Handler,
+		},
+		{
+			MethodName: "DesiredLRPRevisions",
+			Handler:    _BBS_DesiredLRPRevisions_Handler,
+		},
+		{
+			MethodName: "DesiredLRPRevision",
+			Handler:    _BBS_DesiredLRPRevision_Handler,
+		},
+		{
+			MethodName: "RollbackDesiredLRP",
+			Handler:    _BBS_RollbackDesiredLRP_Handler,
+		},
+		{
+			MethodName: "DesiredLRPRollout",
+			Handler:    _BBS_DesiredLRPRollout_Handler,
+		},
+		{
+			MethodName: "Tasks",
+			Handler:    _BBS_Tasks_Handler,
+		},
+		{
+			MethodName: "TaskByGuid",
+			Handler:    _BBS_TaskByGuid_Handler,
+		},
+		{
+			MethodName: "DesireTask",
+			Handler:    _BBS_DesireTask_Handler,
+		},
+		{
+			MethodName: "DesireTasks",
+			Handler:    _BBS_DesireTasks_Handler,
+		},
+		{
+			MethodName: "CancelTask",
+			Handler:    _BBS_CancelTask_Handler,
+		},
+		{
+			MethodName: "ResolvingTask",
+			Handler:    _BBS_ResolvingTask_Handler,
+		},
+		{
+			MethodName: "DeleteTask",
+			Handler:    _BBS_DeleteTask_Handler,
+		},
+		{
+			MethodName: "CancelTasks",
+			Handler:    _BBS_CancelTasks_Handler,
+		},
+		{
+			MethodName: "DeleteTasks",
+			Handler:    _BBS_DeleteTasks_Handler,
+		},
+		{
+			MethodName: "Cells",
+			Handler:    _BBS_Cells_Handler,
+		},
+		{
+			MethodName: "AuditEvents",
+			Handler:    _BBS_AuditEvents_Handler,
+		},
+		{
+			MethodName: "QuarantinedRecords",
+			Handler:    _BBS_QuarantinedRecords_Handler,
+		},
+		{
+			MethodName: "QuarantinedRecord",
+			Handler:    _BBS_QuarantinedRecord_Handler,
+		},
+		{
+			MethodName: "RestoreQuarantinedRecord",
+			Handler:    _BBS_RestoreQuarantinedRecord_Handler,
+		},
+		{
+			MethodName: "RestoreQuarantinedRecords",
+			Handler:    _BBS_RestoreQuarantinedRecords_Handler,
+		},
+		{
+			MethodName: "EncryptionProgress",
+			Handler:    _BBS_EncryptionProgress_Handler,
+		},
+		{
+			MethodName: "VerifyEncryptionKeyRetired",
+			Handler:    _BBS_VerifyEncryptionKeyRetired_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeToEvents",
+			Handler:       _BBS_SubscribeToEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeToTaskEvents",
+			Handler:       _BBS_SubscribeToTaskEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "bbs.proto",
+}
+
+// Client API for InternalBBS service
+
+// InternalBBS is the API used by the cell reps.
+type InternalBBSClient interface {
+	ClaimActualLRP(ctx context.Context, in *ClaimActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error)
+	StartActualLRP(ctx context.Context, in *StartActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error)
+	CrashActualLRP(ctx context.Context, in *CrashActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error)
+	FailActualLRP(ctx context.Context, in *FailActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error)
+	RemoveActualLRP(ctx context.Context, in *RemoveActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error)
+	EvacuateClaimedActualLRP(ctx context.Context, in *EvacuateClaimedActualLRPRequest, opts ...grpc.CallOption) (*EvacuationResponse, error)
+	EvacuateRunningActualLRP(ctx context.Context, in *EvacuateRunningActualLRPRequest, opts ...grpc.CallOption) (*EvacuationResponse, error)
+	EvacuateStoppedActualLRP(ctx context.Context, in *EvacuateStoppedActualLRPRequest, opts ...grpc.CallOption) (*EvacuationResponse, error)
+	EvacuateCrashedActualLRP(ctx context.Context, in *EvacuateCrashedActualLRPRequest, opts ...grpc.CallOption) (*EvacuationResponse, error)
+	RemoveEvacuatingActualLRP(ctx context.Context, in *RemoveEvacuatingActualLRPRequest, opts ...grpc.CallOption) (*RemoveEvacuatingActualLRPResponse, error)
+	StartTask(ctx context.Context, in *StartTaskRequest, opts ...grpc.CallOption) (*StartTaskResponse, error)
+	FailTask(ctx context.Context, in *FailTaskRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error)
+	CompleteTask(ctx context.Context, in *CompleteTaskRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error)
+}
+
+type internalBBSClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewInternalBBSClient(cc *grpc.ClientConn) InternalBBSClient {
+	return &internalBBSClient{cc}
+}
+
+func (c *internalBBSClient) ClaimActualLRP(ctx context.Context, in *ClaimActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error) {
+	out := new(ActualLRPLifecycleResponse)
+	err := grpc.Invoke(ctx, "/models.InternalBBS/ClaimActualLRP", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalBBSClient) StartActualLRP(ctx context.Context, in *StartActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error) {
+	out := new(ActualLRPLifecycleResponse)
+	err := grpc.Invoke(ctx, "/models.InternalBBS/StartActualLRP", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalBBSClient) CrashActualLRP(ctx context.Context, in *CrashActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error) {
+	out := new(ActualLRPLifecycleResponse)
+	err := grpc.Invoke(ctx, "/models.InternalBBS/CrashActualLRP", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalBBSClient) FailActualLRP(ctx context.Context, in *FailActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error) {
+	out := new(ActualLRPLifecycleResponse)
+	err := grpc.Invoke(ctx, "/models.InternalBBS/FailActualLRP", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalBBSClient) RemoveActualLRP(ctx context.Context, in *RemoveActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error) {
+	out := new(ActualLRPLifecycleResponse)
+	err := grpc.Invoke(ctx, "/models.InternalBBS/RemoveActualLRP", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalBBSClient) EvacuateClaimedActualLRP(ctx context.Context, in *EvacuateClaimedActualLRPRequest, opts ...grpc.CallOption) (*EvacuationResponse, error) {
+	out := new(EvacuationResponse)
+	err := grpc.Invoke(ctx, "/models.InternalBBS/EvacuateClaimedActualLRP", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalBBSClient) EvacuateRunningActualLRP(ctx context.Context, in *EvacuateRunningActualLRPRequest, opts ...grpc.CallOption) (*EvacuationResponse, error) {
+	out := new(EvacuationResponse)
+	err := grpc.Invoke(ctx, "/models.InternalBBS/EvacuateRunningActualLRP", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalBBSClient) EvacuateStoppedActualLRP(ctx context.Context, in *EvacuateStoppedActualLRPRequest, opts ...grpc.CallOption) (*EvacuationResponse, error) {
+	out := new(EvacuationResponse)
+	err := grpc.Invoke(ctx, "/models.InternalBBS/EvacuateStoppedActualLRP", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalBBSClient) EvacuateCrashedActualLRP(ctx context.Context, in *EvacuateCrashedActualLRPRequest, opts ...grpc.CallOption) (*EvacuationResponse, error) {
+	out := new(EvacuationResponse)
+	err := grpc.Invoke(ctx, "/models.InternalBBS/EvacuateCrashedActualLRP", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalBBSClient) RemoveEvacuatingActualLRP(ctx context.Context, in *RemoveEvacuatingActualLRPRequest, opts ...grpc.CallOption) (*RemoveEvacuatingActualLRPResponse, error) {
+	out := new(RemoveEvacuatingActualLRPResponse)
+	err := grpc.Invoke(ctx, "/models.InternalBBS/RemoveEvacuatingActualLRP", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalBBSClient) StartTask(ctx context.Context, in *StartTaskRequest, opts ...grpc.CallOption) (*StartTaskResponse, error) {
+	out := new(StartTaskResponse)
+	err := grpc.Invoke(ctx, "/models.InternalBBS/StartTask", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalBBSClient) FailTask(ctx context.Context, in *FailTaskRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error) {
+	out := new(TaskLifecycleResponse)
+	err := grpc.Invoke(ctx, "/models.InternalBBS/FailTask", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalBBSClient) CompleteTask(ctx context.Context, in *CompleteTaskRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error) {
+	out := new(TaskLifecycleResponse)
+	err := grpc.Invoke(ctx, "/models.InternalBBS/CompleteTask", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for InternalBBS service
+
+// InternalBBS is the API used by the cell reps.
+type InternalBBSServer interface {
+	ClaimActualLRP(context.Context, *ClaimActualLRPRequest) (*ActualLRPLifecycleResponse, error)
+	StartActualLRP(context.Context, *StartActualLRPRequest) (*ActualLRPLifecycleResponse, error)
+	CrashActualLRP(context.Context, *CrashActualLRPRequest) (*ActualLRPLifecycleResponse, error)
+	FailActualLRP(context.Context, *FailActualLRPRequest) (*ActualLRPLifecycleResponse, error)
+	RemoveActualLRP(context.Context, *RemoveActualLRPRequest) (*ActualLRPLifecycleResponse, error)
+	EvacuateClaimedActualLRP(context.Context, *EvacuateClaimedActualLRPRequest) (*EvacuationResponse, error)
+	EvacuateRunningActualLRP(context.Context, *EvacuateRunningActualLRPRequest) (*EvacuationResponse, error)
+	EvacuateStoppedActualLRP(context.Context, *EvacuateStoppedActualLRPRequest) (*EvacuationResponse, error)
+	EvacuateCrashedActualLRP(context.Context, *EvacuateCrashedActualLRPRequest) (*EvacuationResponse, error)
+	RemoveEvacuatingActualLRP(context.Context, *RemoveEvacuatingActualLRPRequest) (*RemoveEvacuatingActualLRPResponse, error)
+	StartTask(context.Context, *StartTaskRequest) (*StartTaskResponse, error)
+	FailTask(context.Context, *FailTaskRequest) (*TaskLifecycleResponse, error)
+	CompleteTask(context.Context, *CompleteTaskRequest) (*TaskLifecycleResponse, error)
+}
+
+func RegisterInternalBBSServer(s *grpc.Server, srv InternalBBSServer) {
+	s.RegisterService(&_InternalBBS_serviceDesc, srv)
+}
+
+func _InternalBBS_ClaimActualLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimActualLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalBBSServer).ClaimActualLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.InternalBBS/ClaimActualLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalBBSServer).ClaimActualLRP(ctx, req.(*ClaimActualLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalBBS_StartActualLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartActualLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalBBSServer).StartActualLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.InternalBBS/StartActualLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalBBSServer).StartActualLRP(ctx, req.(*StartActualLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalBBS_CrashActualLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CrashActualLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalBBSServer).CrashActualLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.InternalBBS/CrashActualLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalBBSServer).CrashActualLRP(ctx, req.(*CrashActualLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalBBS_FailActualLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FailActualLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalBBSServer).FailActualLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.InternalBBS/FailActualLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalBBSServer).FailActualLRP(ctx, req.(*FailActualLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalBBS_RemoveActualLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveActualLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalBBSServer).RemoveActualLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.InternalBBS/RemoveActualLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalBBSServer).RemoveActualLRP(ctx, req.(*RemoveActualLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalBBS_EvacuateClaimedActualLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvacuateClaimedActualLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalBBSServer).EvacuateClaimedActualLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.InternalBBS/EvacuateClaimedActualLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalBBSServer).EvacuateClaimedActualLRP(ctx, req.(*EvacuateClaimedActualLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalBBS_EvacuateRunningActualLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvacuateRunningActualLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalBBSServer).EvacuateRunningActualLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.InternalBBS/EvacuateRunningActualLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalBBSServer).EvacuateRunningActualLRP(ctx, req.(*EvacuateRunningActualLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalBBS_EvacuateStoppedActualLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvacuateStoppedActualLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalBBSServer).EvacuateStoppedActualLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.InternalBBS/EvacuateStoppedActualLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalBBSServer).EvacuateStoppedActualLRP(ctx, req.(*EvacuateStoppedActualLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalBBS_EvacuateCrashedActualLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvacuateCrashedActualLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalBBSServer).EvacuateCrashedActualLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.InternalBBS/EvacuateCrashedActualLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalBBSServer).EvacuateCrashedActualLRP(ctx, req.(*EvacuateCrashedActualLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalBBS_RemoveEvacuatingActualLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveEvacuatingActualLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalBBSServer).RemoveEvacuatingActualLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.InternalBBS/RemoveEvacuatingActualLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalBBSServer).RemoveEvacuatingActualLRP(ctx, req.(*RemoveEvacuatingActualLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalBBS_StartTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalBBSServer).StartTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.InternalBBS/StartTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalBBSServer).StartTask(ctx, req.(*StartTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalBBS_FailTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FailTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalBBSServer).FailTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.InternalBBS/FailTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalBBSServer).FailTask(ctx, req.(*FailTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalBBS_CompleteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalBBSServer).CompleteTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.InternalBBS/CompleteTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalBBSServer).CompleteTask(ctx, req.(*CompleteTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _InternalBBS_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "models.InternalBBS",
+	HandlerType: (*InternalBBSServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ClaimActualLRP",
+			Handler:    _InternalBBS_ClaimActualLRP_Handler,
+		},
+		{
+			MethodName: "StartActualLRP",
+			Handler:    _InternalBBS_StartActualLRP_Handler,
+		},
+		{
+			MethodName: "CrashActualLRP",
+			Handler:    _InternalBBS_CrashActualLRP_Handler,
+		},
+		{
+			MethodName: "FailActualLRP",
+			Handler:    _InternalBBS_FailActualLRP_Handler,
+		},
+		{
+			MethodName: "RemoveActualLRP",
+			Handler:    _InternalBBS_RemoveActualLRP_Handler,
+		},
+		{
+			MethodName: "EvacuateClaimedActualLRP",
+			Handler:    _InternalBBS_EvacuateClaimedActualLRP_Handler,
+		},
+		{
+			MethodName: "EvacuateRunningActualLRP",
+			Handler:    _InternalBBS_EvacuateRunningActualLRP_Handler,
+		},
+		{
+			MethodName: "EvacuateStoppedActualLRP",
+			Handler:    _InternalBBS_EvacuateStoppedActualLRP_Handler,
+		},
+		{
+			MethodName: "EvacuateCrashedActualLRP",
+			Handler:    _InternalBBS_EvacuateCrashedActualLRP_Handler,
+		},
+		{
+			MethodName: "RemoveEvacuatingActualLRP",
+			Handler:    _InternalBBS_RemoveEvacuatingActualLRP_Handler,
+		},
+		{
+			MethodName: "StartTask",
+			Handler:    _InternalBBS_StartTask_Handler,
+		},
+		{
+			MethodName: "FailTask",
+			Handler:    _InternalBBS_FailTask_Handler,
+		},
+		{
+			MethodName: "CompleteTask",
+			Handler:    _InternalBBS_CompleteTask_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bbs.proto",
+}
+
+func init() { proto.RegisterFile("bbs.proto", fileDescriptorBbs) }
+
+var fileDescriptorBbs = []byte{
+	// 1160 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xa4, 0x57, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0xb6, 0xd0, 0xa6, 0x8d, 0xc7, 0x4e, 0x62, 0xd3, 0x4d, 0x6d, 0x29, 0x29, 0xf3, 0x57, 0x34,
+	0xe9, 0x0f, 0x8c, 0xd6, 0xc8, 0xa9, 0x40, 0x81, 0x5a, 0xb2, 0x23, 0x28, 0x75, 0x01, 0x85, 0x8a,
+	0x8b, 0x00, 0xfd, 0x31, 0x28, 0x72, 0xad, 0x10, 0x5e, 0xed, 0x32, 0x5c, 0x52, 0xa8, 0x6e, 0x7d,
+	0x84, 0x5e, 0xfb, 0x06, 0x7d, 0x94, 0x1e, 0x73, 0xec, 0xb1, 0x56, 0x2f, 0x3d, 0xe6, 0x09, 0x8a,
+	0x82, 0xe4, 0xfe, 0x90, 0xdc, 0x95, 0x2c, 0xd6, 0x47, 0xce, 0xf7, 0xcd, 0x37, 0xc3, 0xd9, 0xdd,
+	0xd9, 0x59, 0x58, 0x1d, 0x0e, 0xd9, 0x6e, 0x18, 0xd1, 0x98, 0x5a, 0xef, 0x8c, 0xa9, 0x8f, 0x30,
+	0x6b, 0x35, 0x5d, 0x2f, 0x4e, 0x5c, 0x7c, 0x82, 0xa3, 0xf0, 0x24, 0x42, 0xaf, 0x12, 0xc4, 0x62,
+	0x4e, 0x69, 0xad, 0xb9, 0x89, 0x1f, 0xc4, 0xe2, 0xc3, 0x43, 0x18, 0x0b, 0xa4, 0xe5, 0x23, 0x16,
+	0x44, 0xc8, 0x37, 0x79, 0x55, 0xb0, 0x49, 0xc0, 0x02, 0x4a, 0x38, 0xd6, 0x2c, 0x61, 0x14, 0x63,
+	0x9a, 0x08, 0xfd, 0x75, 0x9f, 0x8e, 0xdd, 0x40, 0x12, 0x11, 0xf1, 0xa2, 0x69, 0x18, 0x07, 0x94,
+	0x9c, 0x84, 0x11, 0x1d, 0x45, 0x88, 0x09, 0xfd, 0x0d, 0x34, 0x71, 0xbd, 0xc4, 0x8d, 0x95, 0xea,
+	0x3a, 0x9a, 0x20, 0x22, 0xe3, 0x43, 0x18, 0x90, 0x91, 0xe0, 0xbe, 0x4a, 0xdc, 0xc8, 0x25, 0x71,
+	0x40, 0x10, 0xb7, 0x6c, 0xc5, 0x2e, 0x3b, 0xab, 0xa4, 0xbc, 0xf7, 0x5b, 0x0b, 0xde, 0x6a, 0xb7,
+	0x07, 0xd6, 0x17, 0xf0, 0x76, 0x3f, 0x20, 0x23, 0x6b, 0x6b, 0x37, 0x2f, 0xce, 0x6e, 0xfa, 0xe5,
+	0xe4, 0xdc, 0xd6, 0x7b, 0x65, 0x23, 0x0b, 0x29, 0x61, 0xc8, 0xfa, 0x12, 0xde, 0x3d, 0xc8, 0x12,
+	0x67, 0xd6, 0xfb, 0x82, 0xc0, 0x0d, 0xc2, 0x71, 0x5b, 0xb3, 0x73, 0xdf, 0x1e, 0xac, 0x1f, 0x87,
+	0x0c, 0x45, 0x71, 0x0e, 0x58, 0xb7, 0x04, 0xb1, 0x68, 0x15, 0x2a, 0xb7, 0xcd, 0x20, 0x97, 0x72,
+	0xe0, 0xc6, 0x7e, 0xb6, 0x8e, 0x47, 0x4e, 0xbf, 0x1b, 0xd1, 0x24, 0x64, 0x96, 0x2d, 0x1c, 0x2a,
+	0x80, 0x10, 0xbc, 0x33, 0x17, 0xe7, 0x9a, 0x18, 0x6e, 0x57, 0xa0, 0xf6, 0xb4, 0x1f, 0x51, 0x0f,
+	0x31, 0xd6, 0x4d, 0x02, 0xdf, 0xfa, 0x74, 0x8e, 0x40, 0x89, 0xb5, 0x74, 0xb4, 0x29, 0x3c, 0x28,
+	0x43, 0x25, 0x99, 0x7d, 0xe2, 0xf7, 0x88, 0x8f, 0x7e, 0xb6, 0xf6, 0xcc, 0x3a, 0x46, 0xb2, 0x88,
+	0x3d, 0xa7, 0x12, 0x32, 0xf4, 0x31, 0xdc, 0x70, 0x50, 0x1c, 0x44, 0x48, 0xe2, 0xaa, 0x78, 0x15,
+	0x40, 0x48, 0xde, 0xd7, 0x24, 0x8f, 0x82, 0x53, 0xe4, 0x4d, 0x3d, 0x8c, 0xa4, 0xec, 0x13, 0x58,
+	0x3b, 0xc8, 0xb7, 0xfb, 0x91, 0xd3, 0x67, 0x56, 0x4b, 0x6e, 0x03, 0x65, 0x14, 0x72, 0xb7, 0x8c,
+	0x18, 0xd7, 0xf9, 0x11, 0xb6, 0x95, 0xb9, 0xbc, 0x04, 0x1f, 0xe9, 0x7e, 0xc6, 0xea, 0x1b, 0x62,
+	0x4b, 0xf9, 0x21, 0x34, 0x95, 0x75, 0xe0, 0xbd, 0x44, 0x7e, 0x82, 0x03, 0x32, 0xea, 0x91, 0x53,
+	0xba, 0x38, 0xe9, 0x8f, 0x75, 0xac, 0xe2, 0x2e, 0x63, 0x3c, 0x85, 0xd5, 0x9c, 0x94, 0xd6, 0x76,
+	0xa7, 0xec, 0x57, 0xa8, 0xea, 0x03, 0x5d, 0x51, 0x2f, 0xeb, 0xb7, 0x00, 0xd2, 0x91, 0x59, 0x4d,
+	0x4d, 0x4c, 0xe6, 0xf7, 0xa1, 0x21, 0x77, 0x5d, 0xee, 0x05, 0x6c, 0x1c, 0x87, 0xbe, 0x1b, 0x23,
+	0xc5, 0xb2, 0xee, 0xa8, 0xb3, 0x56, 0x46, 0x6a, 0x25, 0xfa, 0x02, 0x36, 0x1c, 0x34, 0xa6, 0x13,
+	0xa3, 0x72, 0x15, 0xa9, 0xa5, 0xfc, 0x3d, 0x6c, 0x56, 0xfd, 0x99, 0x75, 0x77, 0x9e, 0x74, 0xcd,
+	0x82, 0xfc, 0x00, 0x96, 0x83, 0x7c, 0x14, 0x62, 0x3a, 0x2d, 0x24, 0x7e, 0x4f, 0xa9, 0x57, 0xb1,
+	0x5a, 0xa9, 0x1f, 0xc3, 0xc6, 0x7e, 0x18, 0xe2, 0x69, 0x31, 0x73, 0xd5, 0x1b, 0x2a, 0x88, 0x50,
+	0xbe, 0x3b, 0x9f, 0xc0, 0x65, 0x7f, 0x82, 0xad, 0x62, 0x42, 0xf9, 0xa5, 0xc3, 0xac, 0xfb, 0xa6,
+	0x7d, 0xcf, 0xc1, 0x05, 0x69, 0x17, 0x38, 0xb2, 0xe2, 0x96, 0x0e, 0xab, 0xa2, 0xe8, 0x98, 0xd6,
+	0x28, 0x4c, 0x94, 0x42, 0xc5, 0x29, 0xc6, 0x43, 0xd7, 0x3b, 0x33, 0x56, 0x5c, 0xc3, 0x6a, 0x6e,
+	0xc3, 0xcd, 0x82, 0x67, 0x7e, 0xe7, 0xaa, 0xcd, 0xa2, 0x41, 0x42, 0xfb, 0xde, 0x02, 0x06, 0x57,
+	0x7e, 0x0c, 0x57, 0x9e, 0xbb, 0xec, 0x8c, 0x59, 0xf2, 0x6a, 0xcc, 0x3e, 0x85, 0xc2, 0xcd, 0x8a,
+	0x95, 0x7b, 0x7d, 0x05, 0x90, 0x1a, 0xda, 0xd3, 0xac, 0x83, 0x35, 0x8b, 0xa4, 0xdc, 0xa6, 0x5d,
+	0xb8, 0x29, 0x54, 0xe8, 0xaa, 0xfc, 0xf8, 0xa7, 0xd6, 0xea, 0xf1, 0xcf, 0x99, 0xb9, 0xfb, 0x07,
+	0x45, 0x77, 0xbd, 0x2c, 0x3d, 0xd1, 0x9d, 0xf3, 0x5f, 0x68, 0xe9, 0x42, 0x4c, 0xbb, 0x3f, 0x32,
+	0xab, 0x2e, 0x75, 0x00, 0xd0, 0x71, 0x89, 0x87, 0x70, 0x96, 0xd2, 0x76, 0x91, 0x5d, 0xfc, 0x9f,
+	0x0b, 0x12, 0xea, 0xc2, 0x35, 0x07, 0x31, 0x8a, 0x27, 0x01, 0x19, 0x5d, 0x4a, 0xe8, 0x20, 0xad,
+	0x10, 0x46, 0x31, 0xba, 0x64, 0x3a, 0x6b, 0xea, 0xa7, 0x98, 0x6a, 0xda, 0x42, 0x66, 0xe9, 0xea,
+	0x74, 0x61, 0x4d, 0xa5, 0x73, 0x19, 0xa1, 0xc7, 0x70, 0xa5, 0x83, 0x30, 0x2e, 0x6c, 0xb7, 0xec,
+	0x53, 0xdb, 0x6e, 0xdc, 0xaa, 0x6e, 0xe1, 0xfd, 0x74, 0x8c, 0x3d, 0xcc, 0x66, 0x44, 0xb5, 0xce,
+	0x05, 0xa3, 0x76, 0x0b, 0x97, 0x30, 0xd5, 0x01, 0x9e, 0xc9, 0x61, 0xd2, 0x77, 0x90, 0x47, 0x23,
+	0x9f, 0xa9, 0x43, 0xaa, 0x63, 0x5a, 0x07, 0x30, 0x51, 0xd4, 0x19, 0xd5, 0x50, 0x75, 0x46, 0x35,
+	0x48, 0x3b, 0xa3, 0x06, 0x06, 0x57, 0x1e, 0xc3, 0x8e, 0x83, 0x58, 0x4c, 0x23, 0xa4, 0x07, 0x78,
+	0xa8, 0x7a, 0xba, 0x99, 0x21, 0xe2, 0x3c, 0xba, 0x98, 0xc8, 0xc3, 0x85, 0xd0, 0x9c, 0xc7, 0x61,
+	0xd6, 0x85, 0x32, 0xfa, 0x68, 0xb1, 0x80, 0xa9, 0xd6, 0xe5, 0x50, 0xbe, 0x15, 0xfa, 0xfc, 0xa9,
+	0xa0, 0xd6, 0x45, 0xc7, 0xb4, 0x75, 0x31, 0x51, 0xb8, 0x38, 0x83, 0xd6, 0x77, 0x28, 0x0a, 0x4e,
+	0xa7, 0x8a, 0xf3, 0x0d, 0x9a, 0xe6, 0x33, 0xa1, 0x6f, 0xc9, 0x2c, 0xe7, 0x73, 0x44, 0xb0, 0x4f,
+	0x96, 0xa1, 0xf2, 0xa0, 0x1d, 0xd8, 0x1c, 0x24, 0x43, 0xe6, 0x45, 0xc1, 0x10, 0x3d, 0xa7, 0x7c,
+	0xdf, 0xca, 0xdd, 0x5d, 0xde, 0xb2, 0x65, 0xf3, 0x21, 0x99, 0x20, 0x4c, 0x43, 0xf4, 0x79, 0xc3,
+	0xea, 0xc2, 0xcd, 0x82, 0x48, 0x7a, 0xa2, 0xfe, 0x9f, 0xd0, 0xde, 0xbf, 0x57, 0x61, 0xad, 0x47,
+	0x62, 0x14, 0x11, 0x17, 0xa7, 0x6f, 0xa4, 0x01, 0x5c, 0xef, 0x60, 0x37, 0x18, 0xab, 0x59, 0x59,
+	0x36, 0x92, 0xb2, 0xbd, 0xce, 0xa8, 0x3c, 0x80, 0xeb, 0x83, 0xd8, 0x8d, 0x62, 0x83, 0x68, 0xd9,
+	0x5e, 0x53, 0xb4, 0x13, 0xb9, 0xec, 0xa5, 0x29, 0xd3, 0x92, 0xbd, 0x8e, 0xe8, 0x33, 0xb8, 0xf6,
+	0xc4, 0x0d, 0xb0, 0xd2, 0x94, 0xef, 0xb2, 0x92, 0xb9, 0x8e, 0x64, 0xf6, 0xfc, 0x48, 0x47, 0x36,
+	0xe3, 0xf3, 0xa3, 0x04, 0xd4, 0x91, 0x3d, 0x81, 0x9d, 0xc3, 0xfc, 0xa5, 0x8c, 0xb2, 0x85, 0x41,
+	0xbe, 0xd2, 0x7f, 0xa8, 0x56, 0xdb, 0xcc, 0xd0, 0x1e, 0x0e, 0x87, 0xf2, 0xd1, 0x6d, 0x0a, 0xe0,
+	0x24, 0x84, 0x04, 0x64, 0xb4, 0x20, 0x40, 0x95, 0x51, 0x33, 0xc0, 0x20, 0xa6, 0x61, 0xb8, 0xf0,
+	0x0f, 0xaa, 0x8c, 0x9a, 0x01, 0xb2, 0x1d, 0xb1, 0xb8, 0x44, 0x15, 0xc6, 0x32, 0x01, 0xb2, 0x76,
+	0x98, 0xae, 0xa0, 0xc0, 0x8a, 0x35, 0x7a, 0x54, 0x5e, 0x64, 0x03, 0xc5, 0xd0, 0x0e, 0xe7, 0x32,
+	0x79, 0xc4, 0xaf, 0x61, 0x35, 0x3b, 0x31, 0xd9, 0xdd, 0xbf, 0x53, 0x3a, 0x44, 0xc5, 0xe1, 0xa8,
+	0x69, 0x40, 0xb8, 0x42, 0x1b, 0xae, 0xa6, 0x5b, 0xb9, 0x3c, 0x3c, 0x08, 0xcb, 0x92, 0xc3, 0xc3,
+	0x53, 0x58, 0xef, 0xd0, 0x71, 0x28, 0x87, 0x10, 0x79, 0xb3, 0x16, 0xad, 0xcb, 0x69, 0xb5, 0x3f,
+	0x7b, 0x7d, 0x6e, 0xaf, 0xfc, 0x79, 0x6e, 0xaf, 0xbc, 0x39, 0xb7, 0x1b, 0xbf, 0xcc, 0xec, 0xc6,
+	0xef, 0x33, 0xbb, 0xf1, 0xc7, 0xcc, 0x6e, 0xbc, 0x9e, 0xd9, 0x8d, 0xbf, 0x66, 0x76, 0xe3, 0x9f,
+	0x99, 0xbd, 0xf2, 0x66, 0x66, 0x37, 0x7e, 0xfd, 0xdb, 0x5e, 0xf9, 0x2f, 0x00, 0x00, 0xff, 0xff,
+	0x76, 0xcb, 0x8f, 0xe2, 0xe2, 0x12, 0x00, 0x00,
+}
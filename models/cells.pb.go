@@ -167,11 +167,19 @@ func (m *CellsResponse) GetCells() []*CellPresence {
 	return nil
 }
 
+type CellsRequest struct {
+}
+
+func (m *CellsRequest) Reset()                    { *m = CellsRequest{} }
+func (*CellsRequest) ProtoMessage()               {}
+func (*CellsRequest) Descriptor() ([]byte, []int) { return fileDescriptorCells, []int{4} }
+
 func init() {
 	proto.RegisterType((*CellCapacity)(nil), "models.CellCapacity")
 	proto.RegisterType((*CellPresence)(nil), "models.CellPresence")
 	proto.RegisterType((*Provider)(nil), "models.Provider")
 	proto.RegisterType((*CellsResponse)(nil), "models.CellsResponse")
+	proto.RegisterType((*CellsRequest)(nil), "models.CellsRequest")
 }
 func (this *CellCapacity) Equal(that interface{}) bool {
 	if that == nil {
@@ -351,6 +359,33 @@ func (this *CellsResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *CellsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*CellsRequest)
+	if !ok {
+		that2, ok := that.(CellsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 func (this *CellCapacity) GoString() string {
 	if this == nil {
 		return "nil"
@@ -416,6 +451,15 @@ func (this *CellsResponse) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *CellsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&models.CellsRequest{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringCells(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -614,6 +658,24 @@ func (m *CellsResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *CellsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CellsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
 func encodeFixed64Cells(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	dAtA[offset+1] = uint8(v >> 8)
@@ -716,6 +778,12 @@ func (m *CellsResponse) Size() (n int) {
 	return n
 }
 
+func (m *CellsRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
 func sovCells(x uint64) (n int) {
 	for {
 		n++
@@ -780,6 +848,15 @@ func (this *CellsResponse) String() string {
 	}, "")
 	return s
 }
+func (this *CellsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CellsRequest{`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringCells(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1405,6 +1482,56 @@ func (m *CellsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CellsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCells
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CellsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CellsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCells(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCells
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCells(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1513,38 +1640,39 @@ var (
 func init() { proto.RegisterFile("cells.proto", fileDescriptorCells) }
 
 var fileDescriptorCells = []byte{
-	// 526 bytes of a gzipped FileDescriptorProto
+	// 537 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x74, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x8d, 0xc9, 0xf7, 0x98, 0x40, 0xb5, 0x2a, 0xc2, 0xaa, 0xe8, 0x26, 0x0d, 0x54, 0x8a, 0x50,
-	0x49, 0x51, 0x4e, 0x5c, 0x49, 0xc4, 0x81, 0x43, 0xa5, 0xc8, 0x82, 0x23, 0x18, 0xc7, 0x9e, 0x06,
-	0x0b, 0xdb, 0xbb, 0xda, 0xdd, 0x54, 0x0a, 0x27, 0x7e, 0x02, 0x3f, 0x83, 0x1f, 0xc2, 0xa1, 0xc7,
-	0x1e, 0x39, 0x45, 0xc4, 0x5c, 0x50, 0x4e, 0xfd, 0x09, 0x68, 0xd7, 0x75, 0x71, 0x22, 0xf5, 0xe6,
-	0x7d, 0xef, 0xcd, 0x9b, 0x9d, 0x79, 0x6b, 0xb0, 0x03, 0x8c, 0x63, 0x39, 0xe4, 0x82, 0x29, 0x46,
-	0x1a, 0x09, 0x0b, 0x31, 0x96, 0x07, 0x2f, 0xe6, 0x91, 0xfa, 0xbc, 0x98, 0x0d, 0x03, 0x96, 0x9c,
-	0xce, 0xd9, 0x9c, 0x9d, 0x1a, 0x7a, 0xb6, 0x38, 0x37, 0x27, 0x73, 0x30, 0x5f, 0x79, 0xd9, 0x81,
-	0x8d, 0x42, 0x30, 0x91, 0x1f, 0xfa, 0x17, 0x70, 0x7f, 0x82, 0x71, 0x3c, 0xf1, 0xb9, 0x1f, 0x44,
-	0x6a, 0x49, 0x8e, 0xa0, 0x9d, 0x60, 0xc2, 0xc4, 0xd2, 0x4b, 0x66, 0x8e, 0xd5, 0xb3, 0x06, 0xf5,
-	0x71, 0xed, 0x72, 0xd5, 0xad, 0xb8, 0xad, 0x1c, 0x3e, 0x9b, 0x91, 0x43, 0x68, 0x86, 0x91, 0xfc,
-	0xa2, 0x05, 0xf7, 0x4a, 0x82, 0x86, 0x06, 0xcf, 0x66, 0xe4, 0x19, 0x40, 0xc0, 0x52, 0xe5, 0x47,
-	0x29, 0x0a, 0xe9, 0x54, 0x4b, 0x8a, 0x12, 0xde, 0xff, 0x59, 0xcd, 0x1b, 0x4f, 0x05, 0x4a, 0x4c,
-	0x03, 0xd4, 0xae, 0x7a, 0x36, 0x2f, 0x0a, 0x4d, 0xdb, 0x76, 0xe1, 0xaa, 0xc1, 0xb7, 0x21, 0x39,
-	0x06, 0x5b, 0x20, 0xf7, 0xfc, 0x30, 0x14, 0x28, 0xa5, 0x69, 0x5c, 0x48, 0x40, 0x20, 0x7f, 0x9d,
-	0xe3, 0xc4, 0x81, 0xda, 0x57, 0x96, 0xa2, 0x69, 0x5b, 0xf0, 0x06, 0x21, 0x2f, 0xa1, 0x15, 0xdc,
-	0x0c, 0xe9, 0xd4, 0x7a, 0xd6, 0xc0, 0x1e, 0xed, 0x0f, 0xf3, 0xfd, 0x0d, 0xcb, 0x0b, 0x70, 0x6f,
-	0x55, 0xc4, 0x83, 0x3d, 0xc1, 0x98, 0x3a, 0x97, 0x1e, 0x17, 0xec, 0x22, 0x0a, 0xf5, 0x38, 0xf5,
-	0x5e, 0x75, 0x60, 0x8f, 0xf6, 0x8a, 0xca, 0xe9, 0x0d, 0x31, 0xee, 0x6f, 0x56, 0x5d, 0xba, 0xa3,
-	0xf6, 0xe2, 0x48, 0xaa, 0x13, 0x96, 0x44, 0x0a, 0x13, 0xae, 0x96, 0xee, 0xc3, 0x9c, 0x2f, 0x6a,
-	0x24, 0x99, 0xc0, 0x03, 0x1e, 0xfb, 0x01, 0x26, 0x98, 0x2a, 0x4f, 0xf9, 0x73, 0xe9, 0x34, 0x7a,
-	0xd5, 0x41, 0x7b, 0xfc, 0x64, 0xb3, 0xea, 0x3a, 0xdb, 0x4c, 0xc9, 0xa6, 0x73, 0xcb, 0xbc, 0xf3,
-	0xe7, 0x92, 0x7c, 0x80, 0xc7, 0x8c, 0xab, 0x88, 0xa5, 0x7e, 0xec, 0xed, 0xb8, 0x35, 0x8d, 0xdb,
-	0xf1, 0x66, 0xd5, 0x3d, 0xba, 0x43, 0x52, 0xb2, 0x7d, 0x54, 0x48, 0xa6, 0x5b, 0xf6, 0x87, 0xd0,
-	0xd4, 0x7b, 0x5f, 0x88, 0xd8, 0x69, 0x95, 0x63, 0x11, 0xc8, 0xdf, 0x8b, 0xb8, 0xff, 0x11, 0x5a,
-	0xc5, 0x3c, 0x7a, 0xf7, 0xa9, 0x9f, 0xe0, 0x56, 0x7c, 0x06, 0x21, 0xaf, 0x00, 0xb8, 0x60, 0x1c,
-	0x85, 0x8a, 0x50, 0x67, 0xa7, 0xaf, 0xe5, 0x6c, 0x56, 0xdd, 0xfd, 0xff, 0x68, 0xe9, 0x26, 0x25,
-	0x6d, 0xff, 0x13, 0x74, 0x74, 0x3a, 0xd2, 0x45, 0xc9, 0x59, 0x2a, 0x91, 0x3c, 0x85, 0xba, 0x79,
-	0xbe, 0xa6, 0x8b, 0x3d, 0xea, 0x14, 0x49, 0xbc, 0xd1, 0xa0, 0x9b, 0x73, 0xe4, 0x39, 0xd4, 0xcd,
-	0x7f, 0x62, 0x5a, 0xed, 0x04, 0x5d, 0x3c, 0x38, 0x37, 0x97, 0x8c, 0x4f, 0xae, 0xd6, 0xb4, 0xf2,
-	0x6b, 0x4d, 0x2b, 0xd7, 0x6b, 0x6a, 0x7d, 0xcb, 0xa8, 0xf5, 0x23, 0xa3, 0xd6, 0x65, 0x46, 0xad,
-	0xab, 0x8c, 0x5a, 0xbf, 0x33, 0x6a, 0xfd, 0xcd, 0x68, 0xe5, 0x3a, 0xa3, 0xd6, 0xf7, 0x3f, 0xb4,
-	0xf2, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x3c, 0xfb, 0x49, 0xc6, 0x80, 0x03, 0x00, 0x00,
+	0x10, 0x8d, 0x9b, 0xe6, 0x6b, 0x4d, 0x4a, 0xb5, 0x2a, 0xc2, 0xaa, 0xe8, 0x26, 0x35, 0x54, 0x8a,
+	0x50, 0x49, 0x51, 0x4e, 0x5c, 0x49, 0xc4, 0x81, 0x43, 0xa5, 0xc8, 0x82, 0x23, 0x18, 0xc7, 0x9e,
+	0x06, 0x0b, 0xdb, 0xbb, 0xec, 0x6e, 0x2a, 0x85, 0x13, 0x3f, 0x81, 0x9f, 0xc1, 0x0f, 0xe1, 0xd0,
+	0x63, 0x8f, 0x9c, 0x2c, 0x62, 0x2e, 0x28, 0xa7, 0xfe, 0x04, 0xb4, 0xeb, 0xba, 0x6c, 0x22, 0x71,
+	0xf3, 0xbe, 0xf7, 0xf6, 0xcd, 0xce, 0xbc, 0x31, 0xb2, 0x43, 0x48, 0x12, 0x31, 0x64, 0x9c, 0x4a,
+	0x8a, 0x9b, 0x29, 0x8d, 0x20, 0x11, 0x87, 0xcf, 0xe6, 0xb1, 0xfc, 0xb8, 0x98, 0x0d, 0x43, 0x9a,
+	0x9e, 0xcd, 0xe9, 0x9c, 0x9e, 0x69, 0x7a, 0xb6, 0xb8, 0xd0, 0x27, 0x7d, 0xd0, 0x5f, 0xe5, 0xb5,
+	0x43, 0x1b, 0x38, 0xa7, 0xbc, 0x3c, 0xb8, 0x97, 0xe8, 0xde, 0x04, 0x92, 0x64, 0x12, 0xb0, 0x20,
+	0x8c, 0xe5, 0x12, 0x1f, 0xa3, 0x4e, 0x0a, 0x29, 0xe5, 0x4b, 0x3f, 0x9d, 0x39, 0x56, 0xdf, 0x1a,
+	0x34, 0xc6, 0xbb, 0x57, 0x79, 0xaf, 0xe6, 0xb5, 0x4b, 0xf8, 0x7c, 0x86, 0x8f, 0x50, 0x2b, 0x8a,
+	0xc5, 0x27, 0x25, 0xd8, 0x31, 0x04, 0x4d, 0x05, 0x9e, 0xcf, 0xf0, 0x13, 0x84, 0x42, 0x9a, 0xc9,
+	0x20, 0xce, 0x80, 0x0b, 0xa7, 0x6e, 0x28, 0x0c, 0xdc, 0xfd, 0x51, 0x2f, 0x0b, 0x4f, 0x39, 0x08,
+	0xc8, 0x42, 0x50, 0xae, 0xaa, 0x37, 0x3f, 0x8e, 0x74, 0xd9, 0x4e, 0xe5, 0xaa, 0xc0, 0xd7, 0x11,
+	0x3e, 0x41, 0x36, 0x07, 0xe6, 0x07, 0x51, 0xc4, 0x41, 0x08, 0x67, 0xc7, 0x90, 0x20, 0x0e, 0xec,
+	0x65, 0x89, 0x63, 0x07, 0xed, 0x7e, 0xa1, 0x19, 0x38, 0x75, 0x83, 0xd7, 0x08, 0x7e, 0x8e, 0xda,
+	0xe1, 0x6d, 0x93, 0xce, 0x6e, 0xdf, 0x1a, 0xd8, 0xa3, 0x83, 0x61, 0x39, 0xbf, 0xa1, 0x39, 0x00,
+	0xef, 0x4e, 0x85, 0x7d, 0xb4, 0xcf, 0x29, 0x95, 0x17, 0xc2, 0x67, 0x9c, 0x5e, 0xc6, 0x91, 0x6a,
+	0xa7, 0xd1, 0xaf, 0x0f, 0xec, 0xd1, 0x7e, 0x75, 0x73, 0x7a, 0x4b, 0x8c, 0xdd, 0x75, 0xde, 0x23,
+	0x5b, 0x6a, 0x3f, 0x89, 0x85, 0x3c, 0xa5, 0x69, 0x2c, 0x21, 0x65, 0x72, 0xe9, 0xdd, 0x2f, 0xf9,
+	0xea, 0x8e, 0xc0, 0x13, 0xb4, 0xc7, 0x92, 0x20, 0x84, 0x14, 0x32, 0xe9, 0xcb, 0x60, 0x2e, 0x9c,
+	0x66, 0xbf, 0x3e, 0xe8, 0x8c, 0x1f, 0xad, 0xf3, 0x9e, 0xb3, 0xc9, 0x18, 0x36, 0xdd, 0x3b, 0xe6,
+	0x4d, 0x30, 0x17, 0xf8, 0x1d, 0x7a, 0x48, 0x99, 0x8c, 0x69, 0x16, 0x24, 0xfe, 0x96, 0x5b, 0x4b,
+	0xbb, 0x9d, 0xac, 0xf3, 0xde, 0xf1, 0x7f, 0x24, 0x86, 0xed, 0x83, 0x4a, 0x32, 0xdd, 0xb0, 0x3f,
+	0x42, 0x2d, 0x35, 0xf7, 0x05, 0x4f, 0x9c, 0xb6, 0x19, 0x0b, 0x07, 0xf6, 0x96, 0x27, 0xee, 0x7b,
+	0xd4, 0xae, 0xfa, 0x51, 0xb3, 0xcf, 0x82, 0x14, 0x36, 0xe2, 0xd3, 0x08, 0x7e, 0x81, 0x10, 0xe3,
+	0x94, 0x01, 0x97, 0x31, 0xa8, 0xec, 0xd4, 0xb3, 0x9c, 0x75, 0xde, 0x3b, 0xf8, 0x87, 0x1a, 0x2f,
+	0x31, 0xb4, 0xee, 0x07, 0xd4, 0x55, 0xe9, 0x08, 0x0f, 0x04, 0xa3, 0x99, 0x00, 0xfc, 0x18, 0x35,
+	0xf4, 0xfa, 0xea, 0x2a, 0xf6, 0xa8, 0x5b, 0x25, 0xf1, 0x4a, 0x81, 0x5e, 0xc9, 0xe1, 0xa7, 0xa8,
+	0xa1, 0xff, 0x13, 0x5d, 0x6a, 0x2b, 0xe8, 0x6a, 0xe1, 0xbc, 0x52, 0xe2, 0xee, 0x95, 0x7b, 0x28,
+	0x3c, 0xf8, 0xbc, 0x00, 0x21, 0xc7, 0xa7, 0xd7, 0x2b, 0x52, 0xfb, 0xb9, 0x22, 0xb5, 0x9b, 0x15,
+	0xb1, 0xbe, 0x16, 0xc4, 0xfa, 0x5e, 0x10, 0xeb, 0xaa, 0x20, 0xd6, 0x75, 0x41, 0xac, 0x5f, 0x05,
+	0xb1, 0xfe, 0x14, 0xa4, 0x76, 0x53, 0x10, 0xeb, 0xdb, 0x6f, 0x52, 0xfb, 0x1b, 0x00, 0x00, 0xff,
+	0xff, 0xd7, 0x86, 0xa3, 0x20, 0x90, 0x03, 0x00, 0x00,
 }
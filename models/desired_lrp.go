@@ -17,9 +17,22 @@ type DesiredLRPChange struct {
 	After  *DesiredLRP
 }
 
+// DesiredLRPApplyResult summarizes the changes made when applying the desired
+// set of DesiredLRPs for a domain.
+type DesiredLRPApplyResult struct {
+	Created []*DesiredLRP
+	Updated []DesiredLRPChange
+	Removed []*DesiredLRP
+}
+
 type DesiredLRPFilter struct {
-	Domain       string
-	ProcessGuids []string
+	Domain           string
+	ProcessGuids     []string
+	PageSize         int
+	AfterProcessGuid string
+	// AllowedDomains, when non-nil, restricts the listing to desired LRPs in
+	// these domains.
+	AllowedDomains []string
 }
 
 func PreloadedRootFS(stack string) string {
@@ -70,6 +83,7 @@ func NewDesiredLRP(schedInfo DesiredLRPSchedulingInfo, runInfo DesiredLRPRunInfo
 		Network:                       runInfo.Network,
 		PlacementTags:                 schedInfo.PlacementTags,
 		CertificateProperties:         runInfo.CertificateProperties,
+		RestartPolicy:                 schedInfo.RestartPolicy,
 	}
 }
 
@@ -179,6 +193,7 @@ func (d *DesiredLRP) DesiredLRPSchedulingInfo() DesiredLRPSchedulingInfo {
 		modificationTag,
 		&volumePlacement,
 		d.PlacementTags,
+		d.RestartPolicy,
 	)
 }
 
@@ -220,6 +235,32 @@ func (d *DesiredLRP) Copy() *DesiredLRP {
 	return &newDesired
 }
 
+// DefinitionEqual reports whether both DesiredLRPs have the same definition,
+// ignoring their modification tags.
+func (d *DesiredLRP) DefinitionEqual(other *DesiredLRP) bool {
+	if d == nil || other == nil {
+		return d == other
+	}
+
+	this, that := *d, *other
+	this.ModificationTag, that.ModificationTag = nil, nil
+	this.Routes, that.Routes = nil, nil
+
+	if !this.Equal(&that) {
+		return false
+	}
+
+	var thisRoutes, thatRoutes Routes
+	if d.Routes != nil {
+		thisRoutes = *d.Routes
+	}
+	if other.Routes != nil {
+		thatRoutes = *other.Routes
+	}
+
+	return thisRoutes.equivalent(thatRoutes)
+}
+
 func (d *DesiredLRP) CreateComponents(createdAt time.Time) (DesiredLRPSchedulingInfo, DesiredLRPRunInfo) {
 	return d.DesiredLRPSchedulingInfo(), d.DesiredLRPRunInfo(createdAt)
 }
@@ -305,6 +346,13 @@ func (desired DesiredLRP) Validate() error {
 		validationError = validationError.Append(ErrInvalidField{"max_pids"})
 	}
 
+	if desired.RestartPolicy != nil {
+		if err := desired.RestartPolicy.Validate(); err != nil {
+			validationError = validationError.Append(ErrInvalidField{"restart_policy"})
+			validationError = validationError.Append(err)
+		}
+	}
+
 	totalRoutesLength := 0
 	if desired.Routes != nil {
 		for _, value := range *desired.Routes {
@@ -391,6 +439,7 @@ func NewDesiredLRPSchedulingInfo(
 	modTag ModificationTag,
 	volumePlacement *VolumePlacement,
 	placementTags []string,
+	restartPolicy *RestartPolicy,
 ) DesiredLRPSchedulingInfo {
 	return DesiredLRPSchedulingInfo{
 		DesiredLRPKey:      key,
@@ -401,6 +450,7 @@ func NewDesiredLRPSchedulingInfo(
 		ModificationTag:    modTag,
 		VolumePlacement:    volumePlacement,
 		PlacementTags:      placementTags,
+		RestartPolicy:      restartPolicy,
 	}
 }
 
@@ -417,6 +467,23 @@ func (s *DesiredLRPSchedulingInfo) ApplyUpdate(update *DesiredLRPUpdate) {
 	s.ModificationTag.Increment()
 }
 
+// ApplyRedeploy updates the scheduling info for a new run definition: the
+// volume placement follows the mounts of runInfo and, if resource is non-nil,
+// the resource requirements are replaced.
+func (s *DesiredLRPSchedulingInfo) ApplyRedeploy(runInfo *DesiredLRPRunInfo, resource *DesiredLRPResource) {
+	if resource != nil {
+		s.DesiredLRPResource = *resource
+	}
+
+	volumePlacement := &VolumePlacement{DriverNames: []string{}}
+	for _, mount := range runInfo.VolumeMounts {
+		volumePlacement.DriverNames = append(volumePlacement.DriverNames, mount.Driver)
+	}
+	s.VolumePlacement = volumePlacement
+
+	s.ModificationTag.Increment()
+}
+
 func (*DesiredLRPSchedulingInfo) Version() format.Version {
 	return format.V0
 }
@@ -434,6 +501,10 @@ func (s DesiredLRPSchedulingInfo) Validate() error {
 		ve = ve.Append(ErrInvalidField{"annotation"})
 	}
 
+	if s.RestartPolicy != nil {
+		ve = ve.Check(s.RestartPolicy)
+	}
+
 	return ve.ToError()
 }
 
@@ -31,6 +31,7 @@ type DesiredLRPSchedulingInfo struct {
 	ModificationTag    `protobuf:"bytes,6,opt,name=modification_tag,json=modificationTag,embedded=modification_tag" json:""`
 	VolumePlacement    *VolumePlacement `protobuf:"bytes,7,opt,name=volume_placement,json=volumePlacement" json:"volume_placement,omitempty"`
 	PlacementTags      []string         `protobuf:"bytes,8,rep,name=PlacementTags" json:"placement_tags,omitempty"`
+	RestartPolicy      *RestartPolicy   `protobuf:"bytes,9,opt,name=restart_policy,json=restartPolicy" json:"restart_policy,omitempty"`
 }
 
 func (m *DesiredLRPSchedulingInfo) Reset()      { *m = DesiredLRPSchedulingInfo{} }
@@ -67,6 +68,13 @@ func (m *DesiredLRPSchedulingInfo) GetPlacementTags() []string {
 	return nil
 }
 
+func (m *DesiredLRPSchedulingInfo) GetRestartPolicy() *RestartPolicy {
+	if m != nil {
+		return m.RestartPolicy
+	}
+	return nil
+}
+
 type DesiredLRPRunInfo struct {
 	DesiredLRPKey                 `protobuf:"bytes,1,opt,name=desired_lrp_key,json=desiredLrpKey,embedded=desired_lrp_key" json:""`
 	EnvironmentVariables          []EnvironmentVariable  `protobuf:"bytes,2,rep,name=environment_variables,json=environmentVariables" json:"env"`
@@ -368,6 +376,7 @@ type DesiredLRP struct {
 	PlacementTags                 []string               `protobuf:"bytes,28,rep,name=PlacementTags" json:"placement_tags,omitempty"`
 	MaxPids                       int32                  `protobuf:"varint,29,opt,name=max_pids,json=maxPids" json:"max_pids"`
 	CertificateProperties         *CertificateProperties `protobuf:"bytes,30,opt,name=certificate_properties,json=certificateProperties" json:"certificate_properties,omitempty"`
+	RestartPolicy                 *RestartPolicy         `protobuf:"bytes,31,opt,name=restart_policy,json=restartPolicy" json:"restart_policy,omitempty"`
 }
 
 func (m *DesiredLRP) Reset()                    { *m = DesiredLRP{} }
@@ -577,6 +586,52 @@ func (m *DesiredLRP) GetCertificateProperties() *CertificateProperties {
 	return nil
 }
 
+func (m *DesiredLRP) GetRestartPolicy() *RestartPolicy {
+	if m != nil {
+		return m.RestartPolicy
+	}
+	return nil
+}
+
+type RestartPolicy struct {
+	ImmediateRestarts    *int32 `protobuf:"varint,1,opt,name=immediate_restarts,json=immediateRestarts" json:"immediate_restarts,omitempty"`
+	MaxBackoffDurationMs *int64 `protobuf:"varint,2,opt,name=max_backoff_duration_ms,json=maxBackoffDurationMs" json:"max_backoff_duration_ms,omitempty"`
+	MaxRestartAttempts   *int32 `protobuf:"varint,3,opt,name=max_restart_attempts,json=maxRestartAttempts" json:"max_restart_attempts,omitempty"`
+	NeverRestart         bool   `protobuf:"varint,4,opt,name=never_restart,json=neverRestart" json:"never_restart"`
+}
+
+func (m *RestartPolicy) Reset()                    { *m = RestartPolicy{} }
+func (*RestartPolicy) ProtoMessage()               {}
+func (*RestartPolicy) Descriptor() ([]byte, []int) { return fileDescriptorDesiredLrp, []int{7} }
+
+func (m *RestartPolicy) GetImmediateRestarts() int32 {
+	if m != nil && m.ImmediateRestarts != nil {
+		return *m.ImmediateRestarts
+	}
+	return 0
+}
+
+func (m *RestartPolicy) GetMaxBackoffDurationMs() int64 {
+	if m != nil && m.MaxBackoffDurationMs != nil {
+		return *m.MaxBackoffDurationMs
+	}
+	return 0
+}
+
+func (m *RestartPolicy) GetMaxRestartAttempts() int32 {
+	if m != nil && m.MaxRestartAttempts != nil {
+		return *m.MaxRestartAttempts
+	}
+	return 0
+}
+
+func (m *RestartPolicy) GetNeverRestart() bool {
+	if m != nil {
+		return m.NeverRestart
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*DesiredLRPSchedulingInfo)(nil), "models.DesiredLRPSchedulingInfo")
 	proto.RegisterType((*DesiredLRPRunInfo)(nil), "models.DesiredLRPRunInfo")
@@ -585,6 +640,7 @@ func init() {
 	proto.RegisterType((*DesiredLRPKey)(nil), "models.DesiredLRPKey")
 	proto.RegisterType((*DesiredLRPResource)(nil), "models.DesiredLRPResource")
 	proto.RegisterType((*DesiredLRP)(nil), "models.DesiredLRP")
+	proto.RegisterType((*RestartPolicy)(nil), "models.RestartPolicy")
 }
 func (this *DesiredLRPSchedulingInfo) Equal(that interface{}) bool {
 	if that == nil {
@@ -640,6 +696,9 @@ func (this *DesiredLRPSchedulingInfo) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.RestartPolicy.Equal(that1.RestartPolicy) {
+		return false
+	}
 	return true
 }
 func (this *DesiredLRPRunInfo) Equal(that interface{}) bool {
@@ -1065,13 +1124,73 @@ func (this *DesiredLRP) Equal(that interface{}) bool {
 	if !this.CertificateProperties.Equal(that1.CertificateProperties) {
 		return false
 	}
+	if !this.RestartPolicy.Equal(that1.RestartPolicy) {
+		return false
+	}
+	return true
+}
+func (this *RestartPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*RestartPolicy)
+	if !ok {
+		that2, ok := that.(RestartPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.ImmediateRestarts != nil && that1.ImmediateRestarts != nil {
+		if *this.ImmediateRestarts != *that1.ImmediateRestarts {
+			return false
+		}
+	} else if this.ImmediateRestarts != nil {
+		return false
+	} else if that1.ImmediateRestarts != nil {
+		return false
+	}
+	if this.MaxBackoffDurationMs != nil && that1.MaxBackoffDurationMs != nil {
+		if *this.MaxBackoffDurationMs != *that1.MaxBackoffDurationMs {
+			return false
+		}
+	} else if this.MaxBackoffDurationMs != nil {
+		return false
+	} else if that1.MaxBackoffDurationMs != nil {
+		return false
+	}
+	if this.MaxRestartAttempts != nil && that1.MaxRestartAttempts != nil {
+		if *this.MaxRestartAttempts != *that1.MaxRestartAttempts {
+			return false
+		}
+	} else if this.MaxRestartAttempts != nil {
+		return false
+	} else if that1.MaxRestartAttempts != nil {
+		return false
+	}
+	if this.NeverRestart != that1.NeverRestart {
+		return false
+	}
 	return true
 }
 func (this *DesiredLRPSchedulingInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 12)
+	s := make([]string, 0, 13)
 	s = append(s, "&models.DesiredLRPSchedulingInfo{")
 	s = append(s, "DesiredLRPKey: "+strings.Replace(this.DesiredLRPKey.GoString(), `&`, ``, 1)+",\n")
 	s = append(s, "Annotation: "+fmt.Sprintf("%#v", this.Annotation)+",\n")
@@ -1085,6 +1204,9 @@ func (this *DesiredLRPSchedulingInfo) GoString() string {
 	if this.PlacementTags != nil {
 		s = append(s, "PlacementTags: "+fmt.Sprintf("%#v", this.PlacementTags)+",\n")
 	}
+	if this.RestartPolicy != nil {
+		s = append(s, "RestartPolicy: "+fmt.Sprintf("%#v", this.RestartPolicy)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1206,7 +1328,7 @@ func (this *DesiredLRP) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 34)
+	s := make([]string, 0, 35)
 	s = append(s, "&models.DesiredLRP{")
 	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
 	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
@@ -1264,6 +1386,28 @@ func (this *DesiredLRP) GoString() string {
 	if this.CertificateProperties != nil {
 		s = append(s, "CertificateProperties: "+fmt.Sprintf("%#v", this.CertificateProperties)+",\n")
 	}
+	if this.RestartPolicy != nil {
+		s = append(s, "RestartPolicy: "+fmt.Sprintf("%#v", this.RestartPolicy)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RestartPolicy) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&models.RestartPolicy{")
+	if this.ImmediateRestarts != nil {
+		s = append(s, "ImmediateRestarts: "+valueToGoStringDesiredLrp(this.ImmediateRestarts, "int32")+",\n")
+	}
+	if this.MaxBackoffDurationMs != nil {
+		s = append(s, "MaxBackoffDurationMs: "+valueToGoStringDesiredLrp(this.MaxBackoffDurationMs, "int64")+",\n")
+	}
+	if this.MaxRestartAttempts != nil {
+		s = append(s, "MaxRestartAttempts: "+valueToGoStringDesiredLrp(this.MaxRestartAttempts, "int32")+",\n")
+	}
+	s = append(s, "NeverRestart: "+fmt.Sprintf("%#v", this.NeverRestart)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1354,6 +1498,16 @@ func (m *DesiredLRPSchedulingInfo) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if m.RestartPolicy != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintDesiredLrp(dAtA, i, uint64(m.RestartPolicy.Size()))
+		n6, err := m.RestartPolicy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
 	return i, nil
 }
 
@@ -1375,11 +1529,11 @@ func (m *DesiredLRPRunInfo) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintDesiredLrp(dAtA, i, uint64(m.DesiredLRPKey.Size()))
-	n6, err := m.DesiredLRPKey.MarshalTo(dAtA[i:])
+	n7, err := m.DesiredLRPKey.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n6
+	i += n7
 	if len(m.EnvironmentVariables) > 0 {
 		for _, msg := range m.EnvironmentVariables {
 			dAtA[i] = 0x12
@@ -1396,31 +1550,31 @@ func (m *DesiredLRPRunInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintDesiredLrp(dAtA, i, uint64(m.Setup.Size()))
-		n7, err := m.Setup.MarshalTo(dAtA[i:])
+		n8, err := m.Setup.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n8
 	}
 	if m.Action != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintDesiredLrp(dAtA, i, uint64(m.Action.Size()))
-		n8, err := m.Action.MarshalTo(dAtA[i:])
+		n9, err := m.Action.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n9
 	}
 	if m.Monitor != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintDesiredLrp(dAtA, i, uint64(m.Monitor.Size()))
-		n9, err := m.Monitor.MarshalTo(dAtA[i:])
+		n10, err := m.Monitor.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n10
 	}
 	dAtA[i] = 0x30
 	i++
@@ -1508,11 +1662,11 @@ func (m *DesiredLRPRunInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintDesiredLrp(dAtA, i, uint64(m.Network.Size()))
-		n10, err := m.Network.MarshalTo(dAtA[i:])
+		n11, err := m.Network.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n11
 	}
 	dAtA[i] = 0x98
 	i++
@@ -1525,11 +1679,11 @@ func (m *DesiredLRPRunInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintDesiredLrp(dAtA, i, uint64(m.CertificateProperties.Size()))
-		n11, err := m.CertificateProperties.MarshalTo(dAtA[i:])
+		n12, err := m.CertificateProperties.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n11
+		i += n12
 	}
 	return i, nil
 }
@@ -1599,11 +1753,11 @@ func (m *DesiredLRPUpdate) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintDesiredLrp(dAtA, i, uint64(m.Routes.Size()))
-		n12, err := m.Routes.MarshalTo(dAtA[i:])
+		n13, err := m.Routes.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n12
+		i += n13
 	}
 	if m.Annotation != nil {
 		dAtA[i] = 0x1a
@@ -1721,21 +1875,21 @@ func (m *DesiredLRP) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintDesiredLrp(dAtA, i, uint64(m.Setup.Size()))
-		n13, err := m.Setup.MarshalTo(dAtA[i:])
+		n14, err := m.Setup.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n14
 	}
 	if m.Action != nil {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintDesiredLrp(dAtA, i, uint64(m.Action.Size()))
-		n14, err := m.Action.MarshalTo(dAtA[i:])
+		n15, err := m.Action.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n14
+		i += n15
 	}
 	dAtA[i] = 0x40
 	i++
@@ -1744,11 +1898,11 @@ func (m *DesiredLRP) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4a
 		i++
 		i = encodeVarintDesiredLrp(dAtA, i, uint64(m.Monitor.Size()))
-		n15, err := m.Monitor.MarshalTo(dAtA[i:])
+		n16, err := m.Monitor.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n16
 	}
 	dAtA[i] = 0x50
 	i++
@@ -1778,11 +1932,11 @@ func (m *DesiredLRP) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x7a
 		i++
 		i = encodeVarintDesiredLrp(dAtA, i, uint64(m.Routes.Size()))
-		n16, err := m.Routes.MarshalTo(dAtA[i:])
+		n17, err := m.Routes.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n16
+		i += n17
 	}
 	dAtA[i] = 0x82
 	i++
@@ -1828,11 +1982,11 @@ func (m *DesiredLRP) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintDesiredLrp(dAtA, i, uint64(m.ModificationTag.Size()))
-		n17, err := m.ModificationTag.MarshalTo(dAtA[i:])
+		n18, err := m.ModificationTag.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n17
+		i += n18
 	}
 	if len(m.CachedDependencies) > 0 {
 		for _, msg := range m.CachedDependencies {
@@ -1880,11 +2034,11 @@ func (m *DesiredLRP) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintDesiredLrp(dAtA, i, uint64(m.Network.Size()))
-		n18, err := m.Network.MarshalTo(dAtA[i:])
+		n19, err := m.Network.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n18
+		i += n19
 	}
 	dAtA[i] = 0xd8
 	i++
@@ -1919,15 +2073,68 @@ func (m *DesiredLRP) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintDesiredLrp(dAtA, i, uint64(m.CertificateProperties.Size()))
-		n19, err := m.CertificateProperties.MarshalTo(dAtA[i:])
+		n20, err := m.CertificateProperties.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n19
+		i += n20
+	}
+	if m.RestartPolicy != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintDesiredLrp(dAtA, i, uint64(m.RestartPolicy.Size()))
+		n21, err := m.RestartPolicy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
 	}
 	return i, nil
 }
 
+func (m *RestartPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestartPolicy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ImmediateRestarts != nil {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintDesiredLrp(dAtA, i, uint64(*m.ImmediateRestarts))
+	}
+	if m.MaxBackoffDurationMs != nil {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintDesiredLrp(dAtA, i, uint64(*m.MaxBackoffDurationMs))
+	}
+	if m.MaxRestartAttempts != nil {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintDesiredLrp(dAtA, i, uint64(*m.MaxRestartAttempts))
+	}
+	dAtA[i] = 0x20
+	i++
+	if m.NeverRestart {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	return i, nil
+}
+
 func encodeFixed64DesiredLrp(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	dAtA[offset+1] = uint8(v >> 8)
@@ -1979,6 +2186,10 @@ func (m *DesiredLRPSchedulingInfo) Size() (n int) {
 			n += 1 + l + sovDesiredLrp(uint64(l))
 		}
 	}
+	if m.RestartPolicy != nil {
+		l = m.RestartPolicy.Size()
+		n += 1 + l + sovDesiredLrp(uint64(l))
+	}
 	return n
 }
 
@@ -2202,6 +2413,26 @@ func (m *DesiredLRP) Size() (n int) {
 		l = m.CertificateProperties.Size()
 		n += 2 + l + sovDesiredLrp(uint64(l))
 	}
+	if m.RestartPolicy != nil {
+		l = m.RestartPolicy.Size()
+		n += 2 + l + sovDesiredLrp(uint64(l))
+	}
+	return n
+}
+
+func (m *RestartPolicy) Size() (n int) {
+	var l int
+	_ = l
+	if m.ImmediateRestarts != nil {
+		n += 1 + sovDesiredLrp(uint64(*m.ImmediateRestarts))
+	}
+	if m.MaxBackoffDurationMs != nil {
+		n += 1 + sovDesiredLrp(uint64(*m.MaxBackoffDurationMs))
+	}
+	if m.MaxRestartAttempts != nil {
+		n += 1 + sovDesiredLrp(uint64(*m.MaxRestartAttempts))
+	}
+	n += 2
 	return n
 }
 
@@ -2231,6 +2462,7 @@ func (this *DesiredLRPSchedulingInfo) String() string {
 		`ModificationTag:` + strings.Replace(strings.Replace(this.ModificationTag.String(), "ModificationTag", "ModificationTag", 1), `&`, ``, 1) + `,`,
 		`VolumePlacement:` + strings.Replace(fmt.Sprintf("%v", this.VolumePlacement), "VolumePlacement", "VolumePlacement", 1) + `,`,
 		`PlacementTags:` + fmt.Sprintf("%v", this.PlacementTags) + `,`,
+		`RestartPolicy:` + strings.Replace(fmt.Sprintf("%v", this.RestartPolicy), "RestartPolicy", "RestartPolicy", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2356,6 +2588,20 @@ func (this *DesiredLRP) String() string {
 		`PlacementTags:` + fmt.Sprintf("%v", this.PlacementTags) + `,`,
 		`MaxPids:` + fmt.Sprintf("%v", this.MaxPids) + `,`,
 		`CertificateProperties:` + strings.Replace(fmt.Sprintf("%v", this.CertificateProperties), "CertificateProperties", "CertificateProperties", 1) + `,`,
+		`RestartPolicy:` + strings.Replace(fmt.Sprintf("%v", this.RestartPolicy), "RestartPolicy", "RestartPolicy", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RestartPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RestartPolicy{`,
+		`ImmediateRestarts:` + valueToStringDesiredLrp(this.ImmediateRestarts) + `,`,
+		`MaxBackoffDurationMs:` + valueToStringDesiredLrp(this.MaxBackoffDurationMs) + `,`,
+		`MaxRestartAttempts:` + valueToStringDesiredLrp(this.MaxRestartAttempts) + `,`,
+		`NeverRestart:` + fmt.Sprintf("%v", this.NeverRestart) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2627,6 +2873,39 @@ func (m *DesiredLRPSchedulingInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.PlacementTags = append(m.PlacementTags, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RestartPolicy == nil {
+				m.RestartPolicy = &RestartPolicy{}
+			}
+			if err := m.RestartPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDesiredLrp(dAtA[iNdEx:])
@@ -4753,6 +5032,169 @@ func (m *DesiredLRP) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RestartPolicy == nil {
+				m.RestartPolicy = &RestartPolicy{}
+			}
+			if err := m.RestartPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestartPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestartPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestartPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImmediateRestarts", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ImmediateRestarts = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBackoffDurationMs", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxBackoffDurationMs = &v
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRestartAttempts", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxRestartAttempts = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NeverRestart", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NeverRestart = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDesiredLrp(dAtA[iNdEx:])
@@ -4882,97 +5324,105 @@ var (
 func init() { proto.RegisterFile("desired_lrp.proto", fileDescriptorDesiredLrp) }
 
 var fileDescriptorDesiredLrp = []byte{
-	// 1466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6e, 0xdb, 0xc6,
-	0x16, 0x36, 0x2d, 0x5b, 0xb2, 0x47, 0x96, 0x7f, 0xc6, 0xb2, 0xcd, 0xc8, 0x36, 0xa5, 0xe8, 0x06,
-	0x89, 0xee, 0x45, 0xae, 0x03, 0x78, 0xd3, 0xa2, 0xed, 0xa2, 0x61, 0x92, 0x06, 0x45, 0xe2, 0x42,
-	0x90, 0x93, 0xf4, 0x07, 0x68, 0x09, 0x8a, 0x1c, 0xd3, 0x44, 0x48, 0x0e, 0x31, 0x33, 0x94, 0x23,
-	0x14, 0x28, 0xfa, 0x02, 0x05, 0xba, 0xed, 0xae, 0xcb, 0xbe, 0x44, 0x77, 0x2d, 0x90, 0x65, 0x96,
-	0x45, 0x17, 0x42, 0xa3, 0x6e, 0x0a, 0xaf, 0xf2, 0x08, 0x05, 0x87, 0x43, 0x69, 0x28, 0xd3, 0x8a,
-	0x03, 0x18, 0x5e, 0x49, 0x73, 0xfe, 0xe6, 0xcc, 0xf0, 0x9c, 0xf3, 0x7d, 0x03, 0xd6, 0x6c, 0x44,
-	0x5d, 0x82, 0x6c, 0xc3, 0x23, 0xe1, 0x5e, 0x48, 0x30, 0xc3, 0xb0, 0xe8, 0x63, 0x1b, 0x79, 0xb4,
-	0xf6, 0x7f, 0xc7, 0x65, 0xc7, 0x51, 0x77, 0xcf, 0xc2, 0xfe, 0x1d, 0x07, 0x3b, 0xf8, 0x0e, 0x57,
-	0x77, 0xa3, 0x23, 0xbe, 0xe2, 0x0b, 0xfe, 0x2f, 0x71, 0xab, 0x55, 0x4c, 0x8b, 0xb9, 0x38, 0xa0,
-	0x62, 0xb9, 0x65, 0x99, 0xd6, 0x31, 0xb2, 0x0d, 0x1b, 0x85, 0x28, 0xb0, 0x51, 0x60, 0xf5, 0x85,
-	0x62, 0xc7, 0x42, 0x84, 0xb9, 0x47, 0xae, 0x65, 0x32, 0x64, 0x84, 0x04, 0x87, 0xf1, 0x12, 0xa5,
-	0x6e, 0xdb, 0x28, 0xe8, 0xb9, 0x04, 0x07, 0x3e, 0x0a, 0x98, 0xd1, 0x33, 0x89, 0x6b, 0x76, 0xbd,
-	0x91, 0x72, 0xd3, 0xc7, 0x76, 0xe2, 0xe9, 0xe2, 0xc0, 0x60, 0xa6, 0x93, 0x6e, 0x1d, 0x20, 0x76,
-	0x82, 0xc9, 0x73, 0xb1, 0xac, 0x52, 0x64, 0x45, 0xc4, 0x65, 0x7d, 0xc3, 0x21, 0x38, 0x12, 0xc7,
-	0xaa, 0xc1, 0x1e, 0xf6, 0x22, 0x1f, 0x19, 0x3e, 0x8e, 0x02, 0x96, 0xc8, 0x9a, 0xbf, 0xcd, 0x01,
-	0xf5, 0x7e, 0x72, 0x01, 0x8f, 0x3b, 0xed, 0xc3, 0x38, 0xe1, 0xc8, 0x73, 0x03, 0xe7, 0xd3, 0xe0,
-	0x08, 0xc3, 0x47, 0x60, 0x45, 0xba, 0x1c, 0xe3, 0x39, 0xea, 0xab, 0x4a, 0x43, 0x69, 0x95, 0xf7,
-	0x37, 0xf6, 0x92, 0x1b, 0xda, 0x1b, 0xbb, 0x3e, 0x42, 0x7d, 0x7d, 0xe9, 0xe5, 0xa0, 0x3e, 0xf3,
-	0x6a, 0x50, 0x57, 0x4e, 0x07, 0xf5, 0x99, 0x4e, 0x45, 0xf8, 0x3e, 0x26, 0xe1, 0x23, 0xd4, 0x87,
-	0x37, 0x00, 0x30, 0x83, 0x00, 0x33, 0x9e, 0xba, 0x3a, 0xdb, 0x50, 0x5a, 0x8b, 0xfa, 0x5c, 0xec,
-	0xd0, 0x91, 0xe4, 0xb0, 0x09, 0x16, 0xdd, 0x80, 0x32, 0x33, 0xb0, 0x10, 0x55, 0x0b, 0x0d, 0xa5,
-	0x35, 0x2f, 0x8c, 0xc6, 0x62, 0xf8, 0x15, 0xa8, 0xca, 0x69, 0x11, 0x44, 0x71, 0x44, 0x2c, 0xa4,
-	0xce, 0xf1, 0xdc, 0x6a, 0x67, 0x73, 0xeb, 0x08, 0x8b, 0x89, 0x04, 0xe1, 0x38, 0xc1, 0xd4, 0x02,
-	0x7e, 0x08, 0x8a, 0x04, 0x47, 0x0c, 0x51, 0x75, 0x9e, 0x47, 0x5b, 0x4f, 0xa3, 0xb5, 0xe3, 0xeb,
-	0xea, 0x70, 0x95, 0xbe, 0x1c, 0x87, 0xf9, 0x73, 0x50, 0x2f, 0x26, 0xeb, 0x8e, 0x70, 0x81, 0x6d,
-	0xb0, 0x3a, 0xf9, 0x7d, 0xd4, 0x22, 0x0f, 0xb3, 0x95, 0x86, 0x39, 0x90, 0xf4, 0x4f, 0x4c, 0x67,
-	0x22, 0xa3, 0x15, 0x3f, 0xab, 0x86, 0x5d, 0xb0, 0x2a, 0x3e, 0x5a, 0xe8, 0x99, 0x16, 0x8a, 0x6b,
-	0x42, 0x2d, 0x65, 0x23, 0x3e, 0xe3, 0xfa, 0x76, 0xaa, 0xd6, 0xb5, 0xd3, 0x41, 0xbd, 0x36, 0xe9,
-	0x74, 0x1b, 0xfb, 0x2e, 0x43, 0x7e, 0xc8, 0xfa, 0x9d, 0x95, 0x5e, 0xd6, 0x01, 0xea, 0xa0, 0x32,
-	0x5a, 0x3c, 0x31, 0x1d, 0xaa, 0x2e, 0x34, 0x0a, 0xad, 0x45, 0x7d, 0xe7, 0x74, 0x50, 0x57, 0x47,
-	0x01, 0xe2, 0xb3, 0x50, 0x29, 0x4a, 0xd6, 0xa5, 0xf9, 0x2b, 0x00, 0x6b, 0xd2, 0x7d, 0x47, 0xc1,
-	0xe5, 0xd7, 0xcf, 0xd7, 0x60, 0x23, 0xb7, 0x33, 0xd4, 0xd9, 0x46, 0xa1, 0x55, 0xde, 0xdf, 0x4e,
-	0x43, 0x3e, 0x18, 0x1b, 0x3d, 0x13, 0x36, 0x7a, 0x39, 0x0e, 0x7c, 0x3a, 0xa8, 0x17, 0x50, 0xd0,
-	0xeb, 0x54, 0xd1, 0x59, 0x0b, 0x0a, 0x6f, 0x80, 0x79, 0x8a, 0x58, 0x14, 0xf2, 0xa2, 0x2b, 0xef,
-	0x2f, 0xa7, 0xe1, 0xee, 0xf2, 0x9e, 0xee, 0x24, 0x4a, 0x78, 0x13, 0x14, 0x93, 0x26, 0x17, 0xc5,
-	0x36, 0x69, 0x26, 0xb4, 0xb0, 0x05, 0x4a, 0x3e, 0x0e, 0x5c, 0x86, 0x89, 0xa8, 0xa3, 0x49, 0xc3,
-	0x54, 0x0d, 0xbf, 0x01, 0x35, 0x1b, 0x85, 0x04, 0xc5, 0xc3, 0xc0, 0x36, 0x28, 0x33, 0x09, 0x33,
-	0x98, 0xeb, 0x23, 0x1c, 0x31, 0x83, 0xf2, 0xea, 0xa9, 0xe8, 0xd7, 0x45, 0xfa, 0x5b, 0x19, 0xf5,
-	0xf8, 0x6b, 0xa8, 0x4a, 0x67, 0x6b, 0x1c, 0xe4, 0x30, 0x36, 0x7a, 0x92, 0xd8, 0x1c, 0xc6, 0x6d,
-	0x17, 0x12, 0xb7, 0xe7, 0x7a, 0xc8, 0x41, 0x36, 0xaf, 0x9d, 0x85, 0xb4, 0xed, 0xc6, 0x72, 0xf8,
-	0x1f, 0x00, 0xac, 0x30, 0x32, 0x4e, 0x90, 0xeb, 0x1c, 0x33, 0x75, 0x81, 0xef, 0x2a, 0xfa, 0xce,
-	0x0a, 0xa3, 0xcf, 0xb9, 0x18, 0x56, 0xc1, 0x7c, 0x88, 0x09, 0xa3, 0xea, 0x62, 0xa3, 0xd0, 0xaa,
-	0x74, 0x92, 0x05, 0xd4, 0xc1, 0x12, 0x72, 0x08, 0xa2, 0xd4, 0x20, 0x51, 0xfc, 0x39, 0x00, 0xff,
-	0x1c, 0xd7, 0xd2, 0xf3, 0x1e, 0x8a, 0x49, 0xf4, 0x30, 0x1e, 0x44, 0x9d, 0xc8, 0x43, 0x22, 0x6e,
-	0x39, 0x71, 0x8a, 0x25, 0x34, 0xde, 0xde, 0xc3, 0x8e, 0x21, 0xfa, 0xb8, 0x2c, 0xcd, 0x86, 0x45,
-	0x0f, 0x3b, 0x87, 0x49, 0x6b, 0xde, 0x02, 0x4b, 0x3e, 0x62, 0xc4, 0xb5, 0xa8, 0xe1, 0x44, 0xae,
-	0xad, 0x2e, 0x49, 0x66, 0x65, 0xa1, 0x79, 0x18, 0xb9, 0xc9, 0x61, 0x08, 0xe2, 0xf7, 0x69, 0x32,
-	0xb5, 0xd2, 0x50, 0x5a, 0x85, 0xd1, 0x61, 0x12, 0xf9, 0x5d, 0x06, 0x3d, 0xb0, 0x3e, 0x39, 0x9f,
-	0x5d, 0x44, 0xd5, 0x65, 0x9e, 0xbd, 0x9a, 0x66, 0x7f, 0x8f, 0x9b, 0xdc, 0x1f, 0x4d, 0x70, 0xfd,
-	0xfa, 0xe9, 0xa0, 0xbe, 0x9b, 0xe3, 0x28, 0xb5, 0x06, 0xb4, 0xb2, 0x4e, 0x2e, 0xa2, 0xf0, 0x0b,
-	0x50, 0xf5, 0x90, 0x63, 0x5a, 0x7d, 0xc3, 0xc6, 0x27, 0x81, 0x87, 0x4d, 0xdb, 0x88, 0x28, 0x22,
-	0xea, 0x0a, 0x3f, 0xc3, 0x4d, 0xf1, 0x7d, 0xb5, 0x3c, 0x1b, 0x39, 0x72, 0xa2, 0xbf, 0x2f, 0xd4,
-	0x4f, 0x29, 0x22, 0xf0, 0x5b, 0xd0, 0x60, 0x24, 0xa2, 0xbc, 0x78, 0xfa, 0x94, 0x21, 0xdf, 0x90,
-	0xd0, 0x85, 0x1a, 0xa1, 0xc9, 0x8e, 0xd5, 0x55, 0xbe, 0xcb, 0xbe, 0xd8, 0xe5, 0x7f, 0x6f, 0xb3,
-	0x97, 0x76, 0xdc, 0x15, 0xb6, 0x87, 0xdc, 0xf4, 0x9e, 0x64, 0xd9, 0x36, 0xd9, 0x31, 0x7c, 0x0a,
-	0x2a, 0x32, 0xa6, 0x50, 0x75, 0x8d, 0x5f, 0xdf, 0x7a, 0x76, 0x36, 0x1d, 0xc4, 0x3a, 0x7d, 0x3b,
-	0x2e, 0xe0, 0x8c, 0xb5, 0xb4, 0xcf, 0x52, 0x6f, 0x6c, 0x49, 0xe1, 0xc7, 0xa0, 0x24, 0xf0, 0x4c,
-	0x85, 0xbc, 0x7b, 0x56, 0xd2, 0x80, 0x9f, 0x25, 0x62, 0x7d, 0xe3, 0x74, 0x50, 0x5f, 0x13, 0x36,
-	0x52, 0x98, 0xd4, 0x0d, 0xee, 0x81, 0xd5, 0x6c, 0x2b, 0xf9, 0x54, 0x5d, 0x97, 0x0a, 0x61, 0x99,
-	0x4a, 0x4d, 0x72, 0x40, 0xe1, 0x77, 0x60, 0x33, 0x1f, 0x94, 0xd5, 0x2a, 0x4f, 0x60, 0x77, 0x54,
-	0x10, 0x63, 0xab, 0xf6, 0xc8, 0x48, 0x6f, 0xbd, 0x4c, 0x86, 0x56, 0x23, 0x3f, 0x88, 0x94, 0xe1,
-	0x86, 0x95, 0x17, 0xa0, 0xf9, 0x83, 0x02, 0xca, 0x12, 0xc2, 0xc0, 0xf7, 0x46, 0x30, 0xa4, 0xf0,
-	0x1b, 0xad, 0xe7, 0xc0, 0xd0, 0x5e, 0xf2, 0xf3, 0x20, 0x60, 0xa4, 0x9f, 0x42, 0x50, 0xed, 0x01,
-	0x28, 0x4b, 0x62, 0xb8, 0x09, 0x0a, 0xe9, 0xd4, 0x4d, 0x5b, 0x25, 0x16, 0xc0, 0x1a, 0x98, 0xef,
-	0x99, 0x5e, 0x84, 0x38, 0x0e, 0x2f, 0x09, 0x4d, 0x22, 0xfa, 0x60, 0xf6, 0x7d, 0xa5, 0xf9, 0x93,
-	0x02, 0x56, 0xc7, 0xb3, 0xf9, 0x69, 0x68, 0x9b, 0x0c, 0x65, 0xb1, 0x59, 0x19, 0x61, 0xb3, 0x22,
-	0x63, 0xf3, 0x18, 0x3f, 0x67, 0xa7, 0xe3, 0xa7, 0x92, 0x83, 0x9f, 0x59, 0x8a, 0x50, 0x18, 0x25,
-	0xad, 0xc8, 0x14, 0xa1, 0x79, 0x02, 0x2a, 0x19, 0xd8, 0x88, 0x07, 0x43, 0x48, 0xb0, 0x15, 0x8f,
-	0x20, 0x3e, 0x18, 0xe4, 0xd3, 0x96, 0x85, 0x86, 0x0f, 0x86, 0x1d, 0x50, 0xb4, 0xb1, 0x6f, 0xba,
-	0x59, 0xfa, 0x21, 0x64, 0xb0, 0x0e, 0x16, 0xe2, 0x21, 0xc4, 0x43, 0x14, 0x24, 0x7d, 0xc9, 0xc3,
-	0x4e, 0xec, 0xde, 0xfc, 0x59, 0x01, 0xf0, 0x2c, 0xa9, 0x80, 0xd7, 0xc1, 0xa2, 0x8f, 0x7c, 0x4c,
-	0xfa, 0x86, 0xdf, 0x95, 0xae, 0x65, 0xa6, 0xb3, 0x90, 0x88, 0x0f, 0xba, 0x70, 0x17, 0x94, 0x6c,
-	0x97, 0x3e, 0x8f, 0x0d, 0x66, 0x25, 0x83, 0x62, 0x2c, 0x3c, 0xe8, 0xc2, 0x5b, 0xa0, 0x44, 0x30,
-	0x66, 0xc6, 0x11, 0x15, 0x1b, 0x2f, 0x8b, 0x56, 0x2d, 0xc6, 0xe2, 0x23, 0x7e, 0x41, 0x98, 0x7d,
-	0x42, 0xe3, 0x14, 0x7d, 0xf3, 0x85, 0x11, 0xba, 0x36, 0xe5, 0x00, 0x94, 0x06, 0x2a, 0xf9, 0xe6,
-	0x8b, 0xb6, 0x6b, 0xd3, 0xe6, 0xef, 0x15, 0x00, 0xc6, 0x29, 0x5e, 0xd6, 0xcd, 0x5c, 0x38, 0xbf,
-	0x4c, 0x85, 0xcc, 0xe5, 0xb3, 0xb7, 0x2f, 0xcf, 0xc3, 0xf1, 0xf9, 0xb7, 0xe3, 0x78, 0xe9, 0x82,
-	0x18, 0x5e, 0xbc, 0x18, 0x86, 0x97, 0xa6, 0x62, 0xf8, 0xd1, 0x54, 0x64, 0x4e, 0x30, 0xf2, 0xbf,
-	0xe2, 0x22, 0xea, 0x92, 0x65, 0x6a, 0x13, 0xd0, 0x8b, 0x21, 0xb4, 0xc4, 0x15, 0x16, 0xa7, 0x73,
-	0x05, 0xa9, 0x8c, 0x40, 0x4e, 0x19, 0x65, 0x0a, 0xb1, 0x9c, 0x5b, 0x88, 0x59, 0x9c, 0x5f, 0xca,
-	0xc7, 0xf9, 0x2c, 0x65, 0xa8, 0x9c, 0x43, 0x19, 0x46, 0x6c, 0x60, 0x59, 0x66, 0x03, 0xe3, 0xfe,
-	0x5f, 0x79, 0xf7, 0xfe, 0xcf, 0xd2, 0x80, 0xd5, 0x7c, 0x1a, 0x20, 0xb7, 0xe9, 0x5a, 0x4e, 0x9b,
-	0x9e, 0xe1, 0x09, 0xf0, 0x3c, 0x9e, 0x90, 0x1d, 0x37, 0xeb, 0xe7, 0xbc, 0x48, 0x3e, 0x9a, 0xe0,
-	0x37, 0xd5, 0xb7, 0xf0, 0x9b, 0x2c, 0xb3, 0xd1, 0x73, 0x9e, 0x04, 0x1b, 0x53, 0x9f, 0x04, 0x67,
-	0x1f, 0x01, 0xe7, 0x50, 0x95, 0xcd, 0xab, 0xa5, 0x2a, 0x5b, 0x57, 0x42, 0x55, 0xd4, 0x2b, 0xa3,
-	0x2a, 0xd7, 0x2e, 0x9b, 0xaa, 0xd4, 0x2e, 0x8f, 0xaa, 0x6c, 0x4f, 0xa1, 0x2a, 0x67, 0x9e, 0x6b,
-	0x3b, 0xef, 0xfc, 0x5c, 0xcb, 0xe0, 0xc8, 0x6e, 0x0e, 0x8e, 0x4c, 0xe1, 0x43, 0xda, 0x55, 0xf0,
-	0x21, 0xfd, 0xf6, 0xab, 0xd7, 0xda, 0xcc, 0x1f, 0xaf, 0xb5, 0x99, 0x37, 0xaf, 0x35, 0xe5, 0xfb,
-	0xa1, 0xa6, 0xfc, 0x32, 0xd4, 0x94, 0x97, 0x43, 0x4d, 0x79, 0x35, 0xd4, 0x94, 0xbf, 0x86, 0x9a,
-	0xf2, 0xcf, 0x50, 0x9b, 0x79, 0x33, 0xd4, 0x94, 0x1f, 0xff, 0xd6, 0x66, 0xfe, 0x0d, 0x00, 0x00,
-	0xff, 0xff, 0x1f, 0x7c, 0xb6, 0x0f, 0xc3, 0x11, 0x00, 0x00,
+	// 1598 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xbc, 0x58, 0x4f, 0x6f, 0xdb, 0xc8,
+	0x15, 0x37, 0x2d, 0x4b, 0xb2, 0x46, 0x7f, 0x6c, 0x8f, 0x65, 0x9b, 0x91, 0x6d, 0x49, 0x51, 0x83,
+	0x44, 0x29, 0x52, 0x07, 0x70, 0x81, 0xb6, 0x68, 0x7b, 0x68, 0x18, 0xa7, 0x41, 0x91, 0xb8, 0x10,
+	0xe4, 0x24, 0x6d, 0x03, 0xb4, 0x04, 0x45, 0x8e, 0x68, 0xc2, 0x24, 0x87, 0x98, 0x19, 0xca, 0x16,
+	0x0a, 0x14, 0xfd, 0x02, 0x05, 0x7a, 0xed, 0x2d, 0xbd, 0xed, 0x97, 0xd8, 0x7b, 0x8e, 0x39, 0x2e,
+	0xf6, 0x20, 0x6c, 0xbc, 0x7b, 0x58, 0xf8, 0x94, 0x8f, 0xb0, 0xe0, 0x70, 0x28, 0x0d, 0x6d, 0xda,
+	0x71, 0x00, 0xc3, 0x27, 0x7b, 0xde, 0xfb, 0xbd, 0x37, 0x8f, 0x33, 0xef, 0xcf, 0x6f, 0x04, 0x56,
+	0x2c, 0x44, 0x1d, 0x82, 0x2c, 0xdd, 0x25, 0xc1, 0x4e, 0x40, 0x30, 0xc3, 0xb0, 0xe0, 0x61, 0x0b,
+	0xb9, 0xb4, 0xf1, 0x0b, 0xdb, 0x61, 0x87, 0xe1, 0x60, 0xc7, 0xc4, 0xde, 0x63, 0x1b, 0xdb, 0xf8,
+	0x31, 0x57, 0x0f, 0xc2, 0x21, 0x5f, 0xf1, 0x05, 0xff, 0x2f, 0x36, 0x6b, 0x54, 0x0d, 0x93, 0x39,
+	0xd8, 0xa7, 0x62, 0xb9, 0x61, 0x1a, 0xe6, 0x21, 0xb2, 0x74, 0x0b, 0x05, 0xc8, 0xb7, 0x90, 0x6f,
+	0x8e, 0x85, 0x62, 0xcb, 0x44, 0x84, 0x39, 0x43, 0xc7, 0x34, 0x18, 0xd2, 0x03, 0x82, 0x83, 0x68,
+	0x89, 0x12, 0xb3, 0x4d, 0xe4, 0x8f, 0x1c, 0x82, 0x7d, 0x0f, 0xf9, 0x4c, 0x1f, 0x19, 0xc4, 0x31,
+	0x06, 0xee, 0x54, 0xb9, 0xee, 0x61, 0x2b, 0xb6, 0x74, 0xb0, 0xaf, 0x33, 0xc3, 0x4e, 0xb6, 0xf6,
+	0x11, 0x3b, 0xc6, 0xe4, 0x48, 0x2c, 0xeb, 0x14, 0x99, 0x21, 0x71, 0xd8, 0x58, 0xb7, 0x09, 0x0e,
+	0xc5, 0x67, 0x35, 0xe0, 0x08, 0xbb, 0xa1, 0x87, 0x74, 0x0f, 0x87, 0x3e, 0x8b, 0x65, 0x9d, 0x77,
+	0x79, 0xa0, 0xee, 0xc5, 0x07, 0xf0, 0xb2, 0xdf, 0x3b, 0x88, 0x02, 0x0e, 0x5d, 0xc7, 0xb7, 0xff,
+	0xe4, 0x0f, 0x31, 0x7c, 0x01, 0x96, 0xa4, 0xc3, 0xd1, 0x8f, 0xd0, 0x58, 0x55, 0xda, 0x4a, 0xb7,
+	0xbc, 0xbb, 0xb6, 0x13, 0x9f, 0xd0, 0xce, 0xcc, 0xf4, 0x05, 0x1a, 0x6b, 0x95, 0xf7, 0x93, 0xd6,
+	0xdc, 0x87, 0x49, 0x4b, 0x39, 0x9b, 0xb4, 0xe6, 0xfa, 0x55, 0x61, 0xfb, 0x92, 0x04, 0x2f, 0xd0,
+	0x18, 0xde, 0x03, 0xc0, 0xf0, 0x7d, 0xcc, 0x78, 0xe8, 0xea, 0x7c, 0x5b, 0xe9, 0x96, 0xb4, 0x85,
+	0xc8, 0xa0, 0x2f, 0xc9, 0x61, 0x07, 0x94, 0x1c, 0x9f, 0x32, 0xc3, 0x37, 0x11, 0x55, 0x73, 0x6d,
+	0xa5, 0x9b, 0x17, 0xa0, 0x99, 0x18, 0xbe, 0x05, 0x75, 0x39, 0x2c, 0x82, 0x28, 0x0e, 0x89, 0x89,
+	0xd4, 0x05, 0x1e, 0x5b, 0xe3, 0x62, 0x6c, 0x7d, 0x81, 0x38, 0x17, 0x20, 0x9c, 0x05, 0x98, 0x20,
+	0xe0, 0xef, 0x40, 0x81, 0xe0, 0x90, 0x21, 0xaa, 0xe6, 0xb9, 0xb7, 0xd5, 0xc4, 0x5b, 0x2f, 0x3a,
+	0xae, 0x3e, 0x57, 0x69, 0xb5, 0xc8, 0xcd, 0xb7, 0x93, 0x56, 0x21, 0x5e, 0xf7, 0x85, 0x09, 0xec,
+	0x81, 0xe5, 0xf3, 0xf7, 0xa3, 0x16, 0xb8, 0x9b, 0x8d, 0xc4, 0xcd, 0xbe, 0xa4, 0x7f, 0x65, 0xd8,
+	0xe7, 0x22, 0x5a, 0xf2, 0xd2, 0x6a, 0x38, 0x00, 0xcb, 0xe2, 0xd2, 0x02, 0xd7, 0x30, 0x51, 0x94,
+	0x13, 0x6a, 0x31, 0xed, 0xf1, 0x0d, 0xd7, 0xf7, 0x12, 0xb5, 0xd6, 0x3c, 0x9b, 0xb4, 0x1a, 0xe7,
+	0x8d, 0x1e, 0x61, 0xcf, 0x61, 0xc8, 0x0b, 0xd8, 0xb8, 0xbf, 0x34, 0x4a, 0x1b, 0x40, 0x0d, 0x54,
+	0xa7, 0x8b, 0x57, 0x86, 0x4d, 0xd5, 0xc5, 0x76, 0xae, 0x5b, 0xd2, 0xb6, 0xce, 0x26, 0x2d, 0x75,
+	0xea, 0x20, 0xfa, 0x16, 0x2a, 0x79, 0x49, 0x9b, 0xc0, 0xb7, 0xa0, 0x46, 0x10, 0x65, 0x06, 0x61,
+	0x7a, 0x80, 0x5d, 0xc7, 0x1c, 0xab, 0xa5, 0x74, 0xa2, 0xf4, 0x63, 0x6d, 0x8f, 0x2b, 0x63, 0xdf,
+	0x69, 0x03, 0xd9, 0x37, 0x91, 0xc1, 0x9d, 0xaf, 0x01, 0x58, 0x91, 0xee, 0x32, 0xf4, 0x6f, 0x3e,
+	0x37, 0xff, 0x0e, 0xd6, 0x32, 0xab, 0x4e, 0x9d, 0x6f, 0xe7, 0xba, 0xe5, 0xdd, 0xcd, 0xc4, 0xe5,
+	0xb3, 0x19, 0xe8, 0x8d, 0xc0, 0x68, 0xe5, 0xc8, 0xf1, 0xd9, 0xa4, 0x95, 0x43, 0xfe, 0xa8, 0x5f,
+	0x47, 0x17, 0x11, 0x14, 0xde, 0x03, 0x79, 0x8a, 0x58, 0x18, 0xf0, 0x84, 0x2e, 0xef, 0xd6, 0x12,
+	0x77, 0x4f, 0x78, 0xbf, 0xe8, 0xc7, 0x4a, 0x78, 0x1f, 0x14, 0xe2, 0x06, 0xa2, 0x2e, 0x64, 0xc2,
+	0x84, 0x16, 0x76, 0x41, 0xd1, 0xc3, 0xbe, 0xc3, 0x30, 0x51, 0xf3, 0x99, 0xc0, 0x44, 0x0d, 0xff,
+	0x01, 0x1a, 0x16, 0x0a, 0x08, 0x8a, 0x1a, 0x8d, 0xa5, 0xc7, 0xa7, 0xcd, 0x1c, 0x0f, 0xe1, 0x90,
+	0xe9, 0x94, 0x67, 0x66, 0x55, 0xbb, 0x2b, 0xc2, 0xdf, 0x48, 0xa9, 0x67, 0xb7, 0xa1, 0x2a, 0xfd,
+	0x8d, 0x99, 0x93, 0x83, 0x08, 0xf4, 0x2a, 0xc6, 0x1c, 0x44, 0x25, 0x1d, 0x10, 0x67, 0xe4, 0xb8,
+	0xc8, 0x46, 0x16, 0xcf, 0xcb, 0xc5, 0xa4, 0xa4, 0x67, 0x72, 0xf8, 0x33, 0x00, 0xcc, 0x20, 0xd4,
+	0x8f, 0x91, 0x63, 0x1f, 0x32, 0x75, 0x91, 0xef, 0x2a, 0x6a, 0xda, 0x0c, 0xc2, 0xbf, 0x70, 0x31,
+	0xac, 0x83, 0x7c, 0x80, 0x09, 0xa3, 0x6a, 0xa9, 0x9d, 0xeb, 0x56, 0xfb, 0xf1, 0x02, 0x6a, 0xa0,
+	0x82, 0x6c, 0x82, 0x28, 0xd5, 0x49, 0x18, 0x5d, 0x07, 0xe0, 0xd7, 0x71, 0x27, 0xf9, 0xde, 0x03,
+	0xd1, 0xe5, 0x9e, 0x47, 0x4d, 0xae, 0x1f, 0xba, 0x48, 0xf8, 0x2d, 0xc7, 0x46, 0x91, 0x84, 0x46,
+	0xdb, 0xbb, 0xd8, 0xd6, 0x45, 0x8f, 0x28, 0x4b, 0x7d, 0xa7, 0xe4, 0x62, 0xfb, 0x20, 0x2e, 0xfb,
+	0x07, 0xa0, 0xe2, 0x21, 0x46, 0x1c, 0x93, 0xea, 0x76, 0xe8, 0x58, 0x6a, 0x45, 0x82, 0x95, 0x85,
+	0xe6, 0x79, 0xe8, 0xc4, 0x1f, 0x43, 0x10, 0x3f, 0x4f, 0x83, 0xa9, 0xd5, 0xb6, 0xd2, 0xcd, 0x4d,
+	0x3f, 0x26, 0x96, 0x3f, 0x61, 0xd0, 0x05, 0xab, 0xe7, 0x7b, 0xbf, 0x83, 0xa8, 0x5a, 0xe3, 0xd1,
+	0xab, 0x49, 0xf4, 0x4f, 0x39, 0x64, 0x6f, 0x3a, 0x1d, 0xb4, 0xbb, 0x67, 0x93, 0xd6, 0x76, 0x86,
+	0xa1, 0x54, 0x1a, 0xd0, 0x4c, 0x1b, 0x39, 0x88, 0xc2, 0xbf, 0x82, 0xba, 0x8b, 0x6c, 0xc3, 0x1c,
+	0xeb, 0x16, 0x3e, 0xf6, 0x5d, 0x6c, 0x58, 0x7a, 0x48, 0x11, 0x51, 0x97, 0xf8, 0x37, 0xdc, 0x17,
+	0xf7, 0xdb, 0xcc, 0xc2, 0xc8, 0x9e, 0x63, 0xfd, 0x9e, 0x50, 0xbf, 0xa6, 0x88, 0xc0, 0x7f, 0x82,
+	0x36, 0x23, 0x21, 0xe5, 0xc9, 0x33, 0xa6, 0x0c, 0x79, 0xba, 0x34, 0xb9, 0xa8, 0x1e, 0x18, 0xec,
+	0x50, 0x5d, 0xe6, 0xbb, 0xec, 0x8a, 0x5d, 0x7e, 0xfe, 0x39, 0xbc, 0xb4, 0xe3, 0xb6, 0xc0, 0x1e,
+	0x70, 0xe8, 0x53, 0x09, 0xd9, 0x33, 0xd8, 0x21, 0x7c, 0x0d, 0xaa, 0xf2, 0xbc, 0xa2, 0xea, 0x4a,
+	0x3b, 0x27, 0x37, 0xe4, 0xb8, 0xef, 0xed, 0x47, 0x3a, 0x6d, 0x33, 0x4a, 0xe0, 0x14, 0x5a, 0xda,
+	0xa7, 0x32, 0x9a, 0x21, 0x29, 0xfc, 0x03, 0x28, 0x8a, 0x59, 0xa9, 0x42, 0x5e, 0x3d, 0x4b, 0x89,
+	0xc3, 0x3f, 0xc7, 0x62, 0x6d, 0xed, 0x6c, 0xd2, 0x5a, 0x11, 0x18, 0xc9, 0x4d, 0x62, 0x06, 0x77,
+	0xc0, 0x72, 0xba, 0x94, 0x3c, 0xaa, 0xae, 0x4a, 0x89, 0x50, 0xa3, 0x52, 0x91, 0xec, 0x53, 0xf8,
+	0x2f, 0xb0, 0x9e, 0x3d, 0xf0, 0xd5, 0x3a, 0x0f, 0x60, 0x7b, 0x9a, 0x10, 0x33, 0x54, 0x6f, 0x0a,
+	0xd2, 0xba, 0xef, 0xe3, 0xa6, 0xd5, 0xce, 0x76, 0x22, 0x45, 0xb8, 0x66, 0x66, 0x39, 0xe8, 0xfc,
+	0x47, 0x01, 0x65, 0x69, 0x7a, 0xc1, 0x5f, 0x4f, 0x47, 0x9c, 0xc2, 0x4f, 0xb4, 0x95, 0x31, 0xe2,
+	0x76, 0xe2, 0x3f, 0xcf, 0x7c, 0x46, 0xc6, 0xc9, 0x78, 0x6b, 0x3c, 0x03, 0x65, 0x49, 0x0c, 0xd7,
+	0x41, 0x2e, 0xe9, 0xba, 0x49, 0xa9, 0x44, 0x02, 0xd8, 0x00, 0xf9, 0x91, 0xe1, 0x86, 0x88, 0xcf,
+	0xf8, 0x8a, 0xd0, 0xc4, 0xa2, 0xdf, 0xce, 0xff, 0x46, 0xe9, 0xfc, 0x4f, 0x01, 0xcb, 0xb3, 0xde,
+	0xfc, 0x3a, 0xb0, 0x0c, 0x86, 0xd2, 0x73, 0x5f, 0x99, 0xce, 0x7d, 0x45, 0x9e, 0xfb, 0xb3, 0xd9,
+	0x3c, 0x7f, 0xf5, 0x6c, 0x56, 0x32, 0x66, 0x73, 0x9a, 0x7e, 0xe4, 0xa6, 0x41, 0x2b, 0x32, 0xfd,
+	0xe8, 0x1c, 0x83, 0x6a, 0x6a, 0x6c, 0x44, 0x8d, 0x21, 0x20, 0xd8, 0x44, 0x54, 0x34, 0x06, 0xf9,
+	0x6b, 0xcb, 0x42, 0xc3, 0x1b, 0xc3, 0x16, 0x28, 0x58, 0xd8, 0x33, 0x9c, 0x34, 0xb5, 0x11, 0x32,
+	0xd8, 0x02, 0x8b, 0x51, 0x13, 0xe2, 0x2e, 0x72, 0x92, 0xbe, 0xe8, 0x62, 0x3b, 0x32, 0xef, 0xbc,
+	0x53, 0x00, 0xbc, 0x48, 0x58, 0xe0, 0x5d, 0x50, 0xf2, 0x90, 0x87, 0xc9, 0x58, 0xf7, 0x06, 0xd2,
+	0xb1, 0xcc, 0xf5, 0x17, 0x63, 0xf1, 0xfe, 0x00, 0x6e, 0x83, 0xa2, 0xe5, 0xd0, 0xa3, 0x08, 0x30,
+	0x2f, 0x01, 0x0a, 0x91, 0x70, 0x7f, 0x00, 0x1f, 0x80, 0x22, 0xc1, 0x98, 0xe9, 0x43, 0x2a, 0x36,
+	0xae, 0x89, 0x52, 0x2d, 0x44, 0xe2, 0x21, 0x3f, 0x20, 0xcc, 0xfe, 0x48, 0xa3, 0x10, 0x3d, 0xe3,
+	0x44, 0x0f, 0x1c, 0x8b, 0xaa, 0x0b, 0x92, 0xa3, 0xa2, 0x67, 0x9c, 0xf4, 0x1c, 0x8b, 0x76, 0xfe,
+	0x5f, 0x03, 0x60, 0x16, 0xe2, 0x4d, 0x9d, 0xcc, 0xb5, 0xe3, 0x4b, 0x65, 0xc8, 0x42, 0x36, 0x33,
+	0xfc, 0xdb, 0x65, 0x73, 0x3c, 0xff, 0xf9, 0x39, 0x5e, 0xbc, 0xe6, 0x0c, 0x2f, 0x5c, 0x6f, 0x86,
+	0x17, 0xaf, 0x9c, 0xe1, 0xc3, 0x2b, 0x27, 0x73, 0x3c, 0x23, 0x1f, 0x8a, 0x83, 0x68, 0x49, 0xc8,
+	0x04, 0xe3, 0xd3, 0xeb, 0x4d, 0x68, 0x89, 0x2b, 0x94, 0xae, 0xe6, 0x0a, 0x52, 0x1a, 0x81, 0x8c,
+	0x34, 0x4a, 0x25, 0x62, 0x39, 0x33, 0x11, 0xd3, 0x73, 0xbe, 0x92, 0x3d, 0xe7, 0xd3, 0x94, 0xa1,
+	0x7a, 0x09, 0x65, 0x98, 0xb2, 0x81, 0x9a, 0xcc, 0x06, 0x66, 0xf5, 0xbf, 0xf4, 0xe5, 0xf5, 0x9f,
+	0xa6, 0x01, 0xcb, 0xd9, 0x34, 0x40, 0x2e, 0xd3, 0x95, 0x8c, 0x32, 0xbd, 0xc0, 0x13, 0xe0, 0x65,
+	0x3c, 0x21, 0xdd, 0x6e, 0x56, 0x2f, 0x79, 0xed, 0xfc, 0xfe, 0x1c, 0xbf, 0xa9, 0x7f, 0x86, 0xdf,
+	0xa4, 0x99, 0x8d, 0x96, 0xf1, 0xdc, 0x58, 0xbb, 0xf2, 0xb9, 0x71, 0xf1, 0x81, 0x71, 0x09, 0x55,
+	0x59, 0xbf, 0x5d, 0xaa, 0xb2, 0x71, 0x2b, 0x54, 0x45, 0xbd, 0x35, 0xaa, 0x72, 0xe7, 0xa6, 0xa9,
+	0x4a, 0xe3, 0xe6, 0xa8, 0xca, 0xe6, 0x15, 0x54, 0xe5, 0xc2, 0x53, 0x70, 0xeb, 0xcb, 0x9f, 0x82,
+	0xf2, 0x1c, 0xd9, 0xce, 0x98, 0x23, 0x57, 0xf0, 0xa1, 0xe6, 0x6d, 0xf0, 0xa1, 0x8c, 0xb7, 0x6a,
+	0xeb, 0xc6, 0xde, 0xaa, 0x3f, 0x28, 0xa0, 0x9a, 0x32, 0x87, 0xbf, 0x04, 0xd0, 0xf1, 0x3c, 0x64,
+	0x39, 0x51, 0x98, 0x02, 0x9c, 0x66, 0x38, 0x2b, 0x53, 0xbd, 0xb0, 0x8c, 0x3a, 0xdd, 0x46, 0x74,
+	0x86, 0x03, 0xc3, 0x3c, 0xc2, 0xc3, 0xa1, 0x6e, 0x85, 0x24, 0xae, 0x70, 0x2f, 0xa6, 0x3e, 0x39,
+	0x61, 0x59, 0xf7, 0x8c, 0x13, 0x2d, 0xc6, 0xec, 0x09, 0xc8, 0x3e, 0x85, 0xbf, 0x02, 0x91, 0x3c,
+	0xd9, 0x4b, 0x37, 0x18, 0x0f, 0x55, 0xfe, 0x35, 0x45, 0xe9, 0x43, 0xcf, 0x38, 0x11, 0xbb, 0x3d,
+	0x11, 0x7a, 0xf8, 0x10, 0x54, 0x7d, 0x34, 0x42, 0x24, 0xb1, 0x54, 0x17, 0xa4, 0xee, 0x5c, 0xe1,
+	0x2a, 0x61, 0xa2, 0x3d, 0xfa, 0xf0, 0xb1, 0x39, 0xf7, 0xcd, 0xc7, 0xe6, 0xdc, 0xa7, 0x8f, 0x4d,
+	0xe5, 0xdf, 0xa7, 0x4d, 0xe5, 0xab, 0xd3, 0xa6, 0xf2, 0xfe, 0xb4, 0xa9, 0x7c, 0x38, 0x6d, 0x2a,
+	0xdf, 0x9d, 0x36, 0x95, 0x1f, 0x4f, 0x9b, 0x73, 0x9f, 0x4e, 0x9b, 0xca, 0x7f, 0xbf, 0x6f, 0xce,
+	0xfd, 0x14, 0x00, 0x00, 0xff, 0xff, 0x2f, 0xe7, 0x7b, 0x3c, 0x62, 0x13, 0x00, 0x00,
 }
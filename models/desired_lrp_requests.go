@@ -1,6 +1,14 @@
 package models
 
 func (request *DesiredLRPsRequest) Validate() error {
+	var validationError ValidationError
+
+	validationError = validatePage(validationError, request.PageSize, request.PageToken, 1)
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
 	return nil
 }
 
@@ -67,3 +75,104 @@ func (request *RemoveDesiredLRPRequest) Validate() error {
 
 	return nil
 }
+
+func (request *RedeployDesiredLRPRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.ProcessGuid == "" {
+		validationError = validationError.Append(ErrInvalidField{"process_guid"})
+	}
+
+	if request.RunInfo == nil {
+		validationError = validationError.Append(ErrInvalidField{"run_info"})
+	} else {
+		if request.RunInfo.ProcessGuid != request.ProcessGuid {
+			validationError = validationError.Append(ErrInvalidField{"run_info.process_guid"})
+		}
+
+		if request.RunInfo.Action == nil {
+			validationError = validationError.Append(ErrInvalidActionType)
+		}
+
+		validationError = validationError.Check(request.RunInfo)
+	}
+
+	if request.Resource != nil {
+		validationError = validationError.Check(request.Resource)
+	}
+
+	if request.MaxInFlight < 0 {
+		validationError = validationError.Append(ErrInvalidField{"max_in_flight"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+// Validate only checks that the request is not empty. Each DesiredLRP is
+// validated separately so that an invalid item fails on its own.
+func (request *DesireLRPsRequest) Validate() error {
+	var validationError ValidationError
+
+	if len(request.DesiredLrps) == 0 {
+		validationError = validationError.Append(ErrInvalidField{"desired_lrps"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+func (request *RemoveDesiredLRPsRequest) Validate() error {
+	var validationError ValidationError
+
+	if len(request.ProcessGuids) == 0 {
+		validationError = validationError.Append(ErrInvalidField{"process_guids"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+// Validate checks every DesiredLRP up front, since the set is applied as a
+// whole and one invalid item fails the entire request.
+func (request *ApplyDesiredLRPsRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.Domain == "" {
+		validationError = validationError.Append(ErrInvalidField{"domain"})
+	}
+
+	processGuids := make(map[string]struct{}, len(request.DesiredLrps))
+	for _, desiredLRP := range request.DesiredLrps {
+		if desiredLRP == nil {
+			validationError = validationError.Append(ErrInvalidField{"desired_lrps"})
+			continue
+		}
+
+		if desiredLRP.Domain != request.Domain {
+			validationError = validationError.Append(ErrInvalidField{"desired_lrps.domain"})
+		}
+
+		if _, ok := processGuids[desiredLRP.ProcessGuid]; ok {
+			validationError = validationError.Append(ErrInvalidField{"desired_lrps.process_guid"})
+		}
+		processGuids[desiredLRP.ProcessGuid] = struct{}{}
+
+		validationError = validationError.Check(desiredLRP)
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
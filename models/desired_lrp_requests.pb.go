@@ -37,8 +37,9 @@ func (m *DesiredLRPLifecycleResponse) GetError() *Error {
 }
 
 type DesiredLRPsResponse struct {
-	Error       *Error        `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
-	DesiredLrps []*DesiredLRP `protobuf:"bytes,2,rep,name=desired_lrps,json=desiredLrps" json:"desired_lrps,omitempty"`
+	Error         *Error        `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	DesiredLrps   []*DesiredLRP `protobuf:"bytes,2,rep,name=desired_lrps,json=desiredLrps" json:"desired_lrps,omitempty"`
+	NextPageToken string        `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken" json:"next_page_token"`
 }
 
 func (m *DesiredLRPsResponse) Reset()      { *m = DesiredLRPsResponse{} }
@@ -61,9 +62,18 @@ func (m *DesiredLRPsResponse) GetDesiredLrps() []*DesiredLRP {
 	return nil
 }
 
+func (m *DesiredLRPsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
 type DesiredLRPsRequest struct {
 	Domain       string   `protobuf:"bytes,1,opt,name=domain" json:"domain"`
 	ProcessGuids []string `protobuf:"bytes,2,rep,name=process_guids,json=processGuids" json:"process_guids,omitempty"`
+	PageSize     int32    `protobuf:"varint,3,opt,name=page_size,json=pageSize" json:"page_size"`
+	PageToken    string   `protobuf:"bytes,4,opt,name=page_token,json=pageToken" json:"page_token"`
 }
 
 func (m *DesiredLRPsRequest) Reset()      { *m = DesiredLRPsRequest{} }
@@ -86,6 +96,20 @@ func (m *DesiredLRPsRequest) GetProcessGuids() []string {
 	return nil
 }
 
+func (m *DesiredLRPsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *DesiredLRPsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
 type DesiredLRPResponse struct {
 	Error      *Error      `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
 	DesiredLrp *DesiredLRP `protobuf:"bytes,2,opt,name=desired_lrp,json=desiredLrp" json:"desired_lrp,omitempty"`
@@ -171,8 +195,9 @@ func (m *DesireLRPRequest) GetDesiredLrp() *DesiredLRP {
 }
 
 type UpdateDesiredLRPRequest struct {
-	ProcessGuid string            `protobuf:"bytes,1,opt,name=process_guid,json=processGuid" json:"process_guid"`
-	Update      *DesiredLRPUpdate `protobuf:"bytes,2,opt,name=update" json:"update,omitempty"`
+	ProcessGuid             string            `protobuf:"bytes,1,opt,name=process_guid,json=processGuid" json:"process_guid"`
+	Update                  *DesiredLRPUpdate `protobuf:"bytes,2,opt,name=update" json:"update,omitempty"`
+	ExpectedModificationTag *ModificationTag  `protobuf:"bytes,3,opt,name=expected_modification_tag,json=expectedModificationTag" json:"expected_modification_tag,omitempty"`
 }
 
 func (m *UpdateDesiredLRPRequest) Reset()      { *m = UpdateDesiredLRPRequest{} }
@@ -195,8 +220,16 @@ func (m *UpdateDesiredLRPRequest) GetUpdate() *DesiredLRPUpdate {
 	return nil
 }
 
+func (m *UpdateDesiredLRPRequest) GetExpectedModificationTag() *ModificationTag {
+	if m != nil {
+		return m.ExpectedModificationTag
+	}
+	return nil
+}
+
 type RemoveDesiredLRPRequest struct {
-	ProcessGuid string `protobuf:"bytes,1,opt,name=process_guid,json=processGuid" json:"process_guid"`
+	ProcessGuid             string           `protobuf:"bytes,1,opt,name=process_guid,json=processGuid" json:"process_guid"`
+	ExpectedModificationTag *ModificationTag `protobuf:"bytes,2,opt,name=expected_modification_tag,json=expectedModificationTag" json:"expected_modification_tag,omitempty"`
 }
 
 func (m *RemoveDesiredLRPRequest) Reset()      { *m = RemoveDesiredLRPRequest{} }
@@ -212,6 +245,212 @@ func (m *RemoveDesiredLRPRequest) GetProcessGuid() string {
 	return ""
 }
 
+func (m *RemoveDesiredLRPRequest) GetExpectedModificationTag() *ModificationTag {
+	if m != nil {
+		return m.ExpectedModificationTag
+	}
+	return nil
+}
+
+type RedeployDesiredLRPRequest struct {
+	ProcessGuid string              `protobuf:"bytes,1,opt,name=process_guid,json=processGuid" json:"process_guid"`
+	RunInfo     *DesiredLRPRunInfo  `protobuf:"bytes,2,opt,name=run_info,json=runInfo" json:"run_info,omitempty"`
+	Resource    *DesiredLRPResource `protobuf:"bytes,3,opt,name=resource" json:"resource,omitempty"`
+	MaxInFlight int32               `protobuf:"varint,4,opt,name=max_in_flight,json=maxInFlight" json:"max_in_flight"`
+}
+
+func (m *RedeployDesiredLRPRequest) Reset()      { *m = RedeployDesiredLRPRequest{} }
+func (*RedeployDesiredLRPRequest) ProtoMessage() {}
+func (*RedeployDesiredLRPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorDesiredLrpRequests, []int{9}
+}
+
+func (m *RedeployDesiredLRPRequest) GetProcessGuid() string {
+	if m != nil {
+		return m.ProcessGuid
+	}
+	return ""
+}
+
+func (m *RedeployDesiredLRPRequest) GetRunInfo() *DesiredLRPRunInfo {
+	if m != nil {
+		return m.RunInfo
+	}
+	return nil
+}
+
+func (m *RedeployDesiredLRPRequest) GetResource() *DesiredLRPResource {
+	if m != nil {
+		return m.Resource
+	}
+	return nil
+}
+
+func (m *RedeployDesiredLRPRequest) GetMaxInFlight() int32 {
+	if m != nil {
+		return m.MaxInFlight
+	}
+	return 0
+}
+
+type DesireLRPsRequest struct {
+	DesiredLrps []*DesiredLRP `protobuf:"bytes,1,rep,name=desired_lrps,json=desiredLrps" json:"desired_lrps,omitempty"`
+}
+
+func (m *DesireLRPsRequest) Reset()      { *m = DesireLRPsRequest{} }
+func (*DesireLRPsRequest) ProtoMessage() {}
+func (*DesireLRPsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorDesiredLrpRequests, []int{10}
+}
+
+func (m *DesireLRPsRequest) GetDesiredLrps() []*DesiredLRP {
+	if m != nil {
+		return m.DesiredLrps
+	}
+	return nil
+}
+
+type RemoveDesiredLRPsRequest struct {
+	ProcessGuids []string `protobuf:"bytes,1,rep,name=process_guids,json=processGuids" json:"process_guids,omitempty"`
+}
+
+func (m *RemoveDesiredLRPsRequest) Reset()      { *m = RemoveDesiredLRPsRequest{} }
+func (*RemoveDesiredLRPsRequest) ProtoMessage() {}
+func (*RemoveDesiredLRPsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorDesiredLrpRequests, []int{11}
+}
+
+func (m *RemoveDesiredLRPsRequest) GetProcessGuids() []string {
+	if m != nil {
+		return m.ProcessGuids
+	}
+	return nil
+}
+
+type DesiredLRPLifecycleResult struct {
+	ProcessGuid string `protobuf:"bytes,1,opt,name=process_guid,json=processGuid" json:"process_guid"`
+	Error       *Error `protobuf:"bytes,2,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *DesiredLRPLifecycleResult) Reset()      { *m = DesiredLRPLifecycleResult{} }
+func (*DesiredLRPLifecycleResult) ProtoMessage() {}
+func (*DesiredLRPLifecycleResult) Descriptor() ([]byte, []int) {
+	return fileDescriptorDesiredLrpRequests, []int{12}
+}
+
+func (m *DesiredLRPLifecycleResult) GetProcessGuid() string {
+	if m != nil {
+		return m.ProcessGuid
+	}
+	return ""
+}
+
+func (m *DesiredLRPLifecycleResult) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+type DesiredLRPsLifecycleResponse struct {
+	Error   *Error                       `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	Results []*DesiredLRPLifecycleResult `protobuf:"bytes,2,rep,name=results" json:"results,omitempty"`
+}
+
+func (m *DesiredLRPsLifecycleResponse) Reset()      { *m = DesiredLRPsLifecycleResponse{} }
+func (*DesiredLRPsLifecycleResponse) ProtoMessage() {}
+func (*DesiredLRPsLifecycleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorDesiredLrpRequests, []int{13}
+}
+
+func (m *DesiredLRPsLifecycleResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *DesiredLRPsLifecycleResponse) GetResults() []*DesiredLRPLifecycleResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type ApplyDesiredLRPsRequest struct {
+	Domain      string        `protobuf:"bytes,1,opt,name=domain" json:"domain"`
+	DesiredLrps []*DesiredLRP `protobuf:"bytes,2,rep,name=desired_lrps,json=desiredLrps" json:"desired_lrps,omitempty"`
+	Ttl         uint32        `protobuf:"varint,3,opt,name=ttl" json:"ttl"`
+}
+
+func (m *ApplyDesiredLRPsRequest) Reset()      { *m = ApplyDesiredLRPsRequest{} }
+func (*ApplyDesiredLRPsRequest) ProtoMessage() {}
+func (*ApplyDesiredLRPsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorDesiredLrpRequests, []int{14}
+}
+
+func (m *ApplyDesiredLRPsRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *ApplyDesiredLRPsRequest) GetDesiredLrps() []*DesiredLRP {
+	if m != nil {
+		return m.DesiredLrps
+	}
+	return nil
+}
+
+func (m *ApplyDesiredLRPsRequest) GetTtl() uint32 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
+type ApplyDesiredLRPsResponse struct {
+	Error   *Error   `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	Created []string `protobuf:"bytes,2,rep,name=created" json:"created,omitempty"`
+	Updated []string `protobuf:"bytes,3,rep,name=updated" json:"updated,omitempty"`
+	Removed []string `protobuf:"bytes,4,rep,name=removed" json:"removed,omitempty"`
+}
+
+func (m *ApplyDesiredLRPsResponse) Reset()      { *m = ApplyDesiredLRPsResponse{} }
+func (*ApplyDesiredLRPsResponse) ProtoMessage() {}
+func (*ApplyDesiredLRPsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorDesiredLrpRequests, []int{15}
+}
+
+func (m *ApplyDesiredLRPsResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *ApplyDesiredLRPsResponse) GetCreated() []string {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+func (m *ApplyDesiredLRPsResponse) GetUpdated() []string {
+	if m != nil {
+		return m.Updated
+	}
+	return nil
+}
+
+func (m *ApplyDesiredLRPsResponse) GetRemoved() []string {
+	if m != nil {
+		return m.Removed
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*DesiredLRPLifecycleResponse)(nil), "models.DesiredLRPLifecycleResponse")
 	proto.RegisterType((*DesiredLRPsResponse)(nil), "models.DesiredLRPsResponse")
@@ -222,6 +461,13 @@ func init() {
 	proto.RegisterType((*DesireLRPRequest)(nil), "models.DesireLRPRequest")
 	proto.RegisterType((*UpdateDesiredLRPRequest)(nil), "models.UpdateDesiredLRPRequest")
 	proto.RegisterType((*RemoveDesiredLRPRequest)(nil), "models.RemoveDesiredLRPRequest")
+	proto.RegisterType((*RedeployDesiredLRPRequest)(nil), "models.RedeployDesiredLRPRequest")
+	proto.RegisterType((*DesireLRPsRequest)(nil), "models.DesireLRPsRequest")
+	proto.RegisterType((*RemoveDesiredLRPsRequest)(nil), "models.RemoveDesiredLRPsRequest")
+	proto.RegisterType((*DesiredLRPLifecycleResult)(nil), "models.DesiredLRPLifecycleResult")
+	proto.RegisterType((*DesiredLRPsLifecycleResponse)(nil), "models.DesiredLRPsLifecycleResponse")
+	proto.RegisterType((*ApplyDesiredLRPsRequest)(nil), "models.ApplyDesiredLRPsRequest")
+	proto.RegisterType((*ApplyDesiredLRPsResponse)(nil), "models.ApplyDesiredLRPsResponse")
 }
 func (this *DesiredLRPLifecycleResponse) Equal(that interface{}) bool {
 	if that == nil {
@@ -289,6 +535,9 @@ func (this *DesiredLRPsResponse) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.NextPageToken != that1.NextPageToken {
+		return false
+	}
 	return true
 }
 func (this *DesiredLRPsRequest) Equal(that interface{}) bool {
@@ -327,6 +576,12 @@ func (this *DesiredLRPsRequest) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.PageSize != that1.PageSize {
+		return false
+	}
+	if this.PageToken != that1.PageToken {
+		return false
+	}
 	return true
 }
 func (this *DesiredLRPResponse) Equal(that interface{}) bool {
@@ -491,6 +746,9 @@ func (this *UpdateDesiredLRPRequest) Equal(that interface{}) bool {
 	if !this.Update.Equal(that1.Update) {
 		return false
 	}
+	if !this.ExpectedModificationTag.Equal(that1.ExpectedModificationTag) {
+		return false
+	}
 	return true
 }
 func (this *RemoveDesiredLRPRequest) Equal(that interface{}) bool {
@@ -521,71 +779,352 @@ func (this *RemoveDesiredLRPRequest) Equal(that interface{}) bool {
 	if this.ProcessGuid != that1.ProcessGuid {
 		return false
 	}
+	if !this.ExpectedModificationTag.Equal(that1.ExpectedModificationTag) {
+		return false
+	}
 	return true
 }
-func (this *DesiredLRPLifecycleResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *RedeployDesiredLRPRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&models.DesiredLRPLifecycleResponse{")
-	if this.Error != nil {
-		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+
+	that1, ok := that.(*RedeployDesiredLRPRequest)
+	if !ok {
+		that2, ok := that.(RedeployDesiredLRPRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DesiredLRPsResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&models.DesiredLRPsResponse{")
-	if this.Error != nil {
-		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	if this.ProcessGuid != that1.ProcessGuid {
+		return false
 	}
-	if this.DesiredLrps != nil {
-		s = append(s, "DesiredLrps: "+fmt.Sprintf("%#v", this.DesiredLrps)+",\n")
+	if !this.RunInfo.Equal(that1.RunInfo) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DesiredLRPsRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.Resource.Equal(that1.Resource) {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&models.DesiredLRPsRequest{")
-	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
-	if this.ProcessGuids != nil {
-		s = append(s, "ProcessGuids: "+fmt.Sprintf("%#v", this.ProcessGuids)+",\n")
+	if this.MaxInFlight != that1.MaxInFlight {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *DesiredLRPResponse) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 6)
-	s = append(s, "&models.DesiredLRPResponse{")
-	if this.Error != nil {
-		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+func (this *DesireLRPsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	if this.DesiredLrp != nil {
-		s = append(s, "DesiredLrp: "+fmt.Sprintf("%#v", this.DesiredLrp)+",\n")
+
+	that1, ok := that.(*DesireLRPsRequest)
+	if !ok {
+		that2, ok := that.(DesireLRPsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DesiredLRPSchedulingInfosResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&models.DesiredLRPSchedulingInfosResponse{")
-	if this.Error != nil {
-		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	if len(this.DesiredLrps) != len(that1.DesiredLrps) {
+		return false
+	}
+	for i := range this.DesiredLrps {
+		if !this.DesiredLrps[i].Equal(that1.DesiredLrps[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *RemoveDesiredLRPsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*RemoveDesiredLRPsRequest)
+	if !ok {
+		that2, ok := that.(RemoveDesiredLRPsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if len(this.ProcessGuids) != len(that1.ProcessGuids) {
+		return false
+	}
+	for i := range this.ProcessGuids {
+		if this.ProcessGuids[i] != that1.ProcessGuids[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *DesiredLRPLifecycleResult) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*DesiredLRPLifecycleResult)
+	if !ok {
+		that2, ok := that.(DesiredLRPLifecycleResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.ProcessGuid != that1.ProcessGuid {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	return true
+}
+func (this *DesiredLRPsLifecycleResponse) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*DesiredLRPsLifecycleResponse)
+	if !ok {
+		that2, ok := that.(DesiredLRPsLifecycleResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if len(this.Results) != len(that1.Results) {
+		return false
+	}
+	for i := range this.Results {
+		if !this.Results[i].Equal(that1.Results[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ApplyDesiredLRPsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*ApplyDesiredLRPsRequest)
+	if !ok {
+		that2, ok := that.(ApplyDesiredLRPsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Domain != that1.Domain {
+		return false
+	}
+	if len(this.DesiredLrps) != len(that1.DesiredLrps) {
+		return false
+	}
+	for i := range this.DesiredLrps {
+		if !this.DesiredLrps[i].Equal(that1.DesiredLrps[i]) {
+			return false
+		}
+	}
+	if this.Ttl != that1.Ttl {
+		return false
+	}
+	return true
+}
+func (this *ApplyDesiredLRPsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*ApplyDesiredLRPsResponse)
+	if !ok {
+		that2, ok := that.(ApplyDesiredLRPsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if len(this.Created) != len(that1.Created) {
+		return false
+	}
+	for i := range this.Created {
+		if this.Created[i] != that1.Created[i] {
+			return false
+		}
+	}
+	if len(this.Updated) != len(that1.Updated) {
+		return false
+	}
+	for i := range this.Updated {
+		if this.Updated[i] != that1.Updated[i] {
+			return false
+		}
+	}
+	if len(this.Removed) != len(that1.Removed) {
+		return false
+	}
+	for i := range this.Removed {
+		if this.Removed[i] != that1.Removed[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *DesiredLRPLifecycleResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.DesiredLRPLifecycleResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DesiredLRPsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.DesiredLRPsResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.DesiredLrps != nil {
+		s = append(s, "DesiredLrps: "+fmt.Sprintf("%#v", this.DesiredLrps)+",\n")
+	}
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DesiredLRPsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&models.DesiredLRPsRequest{")
+	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
+	if this.ProcessGuids != nil {
+		s = append(s, "ProcessGuids: "+fmt.Sprintf("%#v", this.ProcessGuids)+",\n")
+	}
+	s = append(s, "PageSize: "+fmt.Sprintf("%#v", this.PageSize)+",\n")
+	s = append(s, "PageToken: "+fmt.Sprintf("%#v", this.PageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DesiredLRPResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.DesiredLRPResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.DesiredLrp != nil {
+		s = append(s, "DesiredLrp: "+fmt.Sprintf("%#v", this.DesiredLrp)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DesiredLRPSchedulingInfosResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.DesiredLRPSchedulingInfosResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
 	}
 	if this.DesiredLrpSchedulingInfos != nil {
 		s = append(s, "DesiredLrpSchedulingInfos: "+fmt.Sprintf("%#v", this.DesiredLrpSchedulingInfos)+",\n")
@@ -619,12 +1158,15 @@ func (this *UpdateDesiredLRPRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&models.UpdateDesiredLRPRequest{")
 	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
 	if this.Update != nil {
 		s = append(s, "Update: "+fmt.Sprintf("%#v", this.Update)+",\n")
 	}
+	if this.ExpectedModificationTag != nil {
+		s = append(s, "ExpectedModificationTag: "+fmt.Sprintf("%#v", this.ExpectedModificationTag)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -632,22 +1174,129 @@ func (this *RemoveDesiredLRPRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
+	s := make([]string, 0, 6)
 	s = append(s, "&models.RemoveDesiredLRPRequest{")
 	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	if this.ExpectedModificationTag != nil {
+		s = append(s, "ExpectedModificationTag: "+fmt.Sprintf("%#v", this.ExpectedModificationTag)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func valueToGoStringDesiredLrpRequests(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
+func (this *RedeployDesiredLRPRequest) GoString() string {
+	if this == nil {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
-}
-func (m *DesiredLRPLifecycleResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
+	s := make([]string, 0, 8)
+	s = append(s, "&models.RedeployDesiredLRPRequest{")
+	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	if this.RunInfo != nil {
+		s = append(s, "RunInfo: "+fmt.Sprintf("%#v", this.RunInfo)+",\n")
+	}
+	if this.Resource != nil {
+		s = append(s, "Resource: "+fmt.Sprintf("%#v", this.Resource)+",\n")
+	}
+	s = append(s, "MaxInFlight: "+fmt.Sprintf("%#v", this.MaxInFlight)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DesireLRPsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.DesireLRPsRequest{")
+	if this.DesiredLrps != nil {
+		s = append(s, "DesiredLrps: "+fmt.Sprintf("%#v", this.DesiredLrps)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RemoveDesiredLRPsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.RemoveDesiredLRPsRequest{")
+	if this.ProcessGuids != nil {
+		s = append(s, "ProcessGuids: "+fmt.Sprintf("%#v", this.ProcessGuids)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DesiredLRPLifecycleResult) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.DesiredLRPLifecycleResult{")
+	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DesiredLRPsLifecycleResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.DesiredLRPsLifecycleResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Results != nil {
+		s = append(s, "Results: "+fmt.Sprintf("%#v", this.Results)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ApplyDesiredLRPsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.ApplyDesiredLRPsRequest{")
+	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
+	if this.DesiredLrps != nil {
+		s = append(s, "DesiredLrps: "+fmt.Sprintf("%#v", this.DesiredLrps)+",\n")
+	}
+	s = append(s, "Ttl: "+fmt.Sprintf("%#v", this.Ttl)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ApplyDesiredLRPsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&models.ApplyDesiredLRPsResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Created != nil {
+		s = append(s, "Created: "+fmt.Sprintf("%#v", this.Created)+",\n")
+	}
+	if this.Updated != nil {
+		s = append(s, "Updated: "+fmt.Sprintf("%#v", this.Updated)+",\n")
+	}
+	if this.Removed != nil {
+		s = append(s, "Removed: "+fmt.Sprintf("%#v", this.Removed)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringDesiredLrpRequests(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *DesiredLRPLifecycleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
 	if err != nil {
@@ -711,6 +1360,10 @@ func (m *DesiredLRPsResponse) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintDesiredLrpRequests(dAtA, i, uint64(len(m.NextPageToken)))
+	i += copy(dAtA[i:], m.NextPageToken)
 	return i, nil
 }
 
@@ -748,6 +1401,13 @@ func (m *DesiredLRPsRequest) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintDesiredLrpRequests(dAtA, i, uint64(m.PageSize))
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintDesiredLrpRequests(dAtA, i, uint64(len(m.PageToken)))
+	i += copy(dAtA[i:], m.PageToken)
 	return i, nil
 }
 
@@ -908,6 +1568,16 @@ func (m *UpdateDesiredLRPRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n7
 	}
+	if m.ExpectedModificationTag != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintDesiredLrpRequests(dAtA, i, uint64(m.ExpectedModificationTag.Size()))
+		n8, err := m.ExpectedModificationTag.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
 	return i, nil
 }
 
@@ -930,115 +1600,420 @@ func (m *RemoveDesiredLRPRequest) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	i = encodeVarintDesiredLrpRequests(dAtA, i, uint64(len(m.ProcessGuid)))
 	i += copy(dAtA[i:], m.ProcessGuid)
+	if m.ExpectedModificationTag != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintDesiredLrpRequests(dAtA, i, uint64(m.ExpectedModificationTag.Size()))
+		n9, err := m.ExpectedModificationTag.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
 	return i, nil
 }
 
-func encodeFixed64DesiredLrpRequests(dAtA []byte, offset int, v uint64) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	dAtA[offset+4] = uint8(v >> 32)
-	dAtA[offset+5] = uint8(v >> 40)
-	dAtA[offset+6] = uint8(v >> 48)
-	dAtA[offset+7] = uint8(v >> 56)
-	return offset + 8
-}
-func encodeFixed32DesiredLrpRequests(dAtA []byte, offset int, v uint32) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	return offset + 4
-}
-func encodeVarintDesiredLrpRequests(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RedeployDesiredLRPRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *DesiredLRPLifecycleResponse) Size() (n int) {
+
+func (m *RedeployDesiredLRPRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Error != nil {
-		l = m.Error.Size()
-		n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintDesiredLrpRequests(dAtA, i, uint64(len(m.ProcessGuid)))
+	i += copy(dAtA[i:], m.ProcessGuid)
+	if m.RunInfo != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintDesiredLrpRequests(dAtA, i, uint64(m.RunInfo.Size()))
+		n10, err := m.RunInfo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
 	}
-	return n
+	if m.Resource != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintDesiredLrpRequests(dAtA, i, uint64(m.Resource.Size()))
+		n11, err := m.Resource.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintDesiredLrpRequests(dAtA, i, uint64(m.MaxInFlight))
+	return i, nil
 }
 
-func (m *DesiredLRPsResponse) Size() (n int) {
+func (m *DesireLRPsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DesireLRPsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Error != nil {
-		l = m.Error.Size()
-		n += 1 + l + sovDesiredLrpRequests(uint64(l))
-	}
 	if len(m.DesiredLrps) > 0 {
-		for _, e := range m.DesiredLrps {
-			l = e.Size()
-			n += 1 + l + sovDesiredLrpRequests(uint64(l))
+		for _, msg := range m.DesiredLrps {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintDesiredLrpRequests(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	return n
+	return i, nil
 }
 
-func (m *DesiredLRPsRequest) Size() (n int) {
+func (m *RemoveDesiredLRPsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveDesiredLRPsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Domain)
-	n += 1 + l + sovDesiredLrpRequests(uint64(l))
 	if len(m.ProcessGuids) > 0 {
 		for _, s := range m.ProcessGuids {
+			dAtA[i] = 0xa
+			i++
 			l = len(s)
-			n += 1 + l + sovDesiredLrpRequests(uint64(l))
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	return n
+	return i, nil
 }
 
-func (m *DesiredLRPResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Error != nil {
-		l = m.Error.Size()
-		n += 1 + l + sovDesiredLrpRequests(uint64(l))
-	}
-	if m.DesiredLrp != nil {
-		l = m.DesiredLrp.Size()
-		n += 1 + l + sovDesiredLrpRequests(uint64(l))
+func (m *DesiredLRPLifecycleResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DesiredLRPSchedulingInfosResponse) Size() (n int) {
+func (m *DesiredLRPLifecycleResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintDesiredLrpRequests(dAtA, i, uint64(len(m.ProcessGuid)))
+	i += copy(dAtA[i:], m.ProcessGuid)
 	if m.Error != nil {
-		l = m.Error.Size()
-		n += 1 + l + sovDesiredLrpRequests(uint64(l))
-	}
-	if len(m.DesiredLrpSchedulingInfos) > 0 {
-		for _, e := range m.DesiredLrpSchedulingInfos {
-			l = e.Size()
-			n += 1 + l + sovDesiredLrpRequests(uint64(l))
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintDesiredLrpRequests(dAtA, i, uint64(m.Error.Size()))
+		n12, err := m.Error.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n12
 	}
-	return n
+	return i, nil
 }
 
-func (m *DesiredLRPByProcessGuidRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ProcessGuid)
-	n += 1 + l + sovDesiredLrpRequests(uint64(l))
-	return n
+func (m *DesiredLRPsLifecycleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func (m *DesireLRPRequest) Size() (n int) {
+func (m *DesiredLRPsLifecycleResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDesiredLrpRequests(dAtA, i, uint64(m.Error.Size()))
+		n13, err := m.Error.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	if len(m.Results) > 0 {
+		for _, msg := range m.Results {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintDesiredLrpRequests(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ApplyDesiredLRPsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplyDesiredLRPsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintDesiredLrpRequests(dAtA, i, uint64(len(m.Domain)))
+	i += copy(dAtA[i:], m.Domain)
+	if len(m.DesiredLrps) > 0 {
+		for _, msg := range m.DesiredLrps {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintDesiredLrpRequests(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintDesiredLrpRequests(dAtA, i, uint64(m.Ttl))
+	return i, nil
+}
+
+func (m *ApplyDesiredLRPsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplyDesiredLRPsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDesiredLrpRequests(dAtA, i, uint64(m.Error.Size()))
+		n14, err := m.Error.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	if len(m.Created) > 0 {
+		for _, s := range m.Created {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Updated) > 0 {
+		for _, s := range m.Updated {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Removed) > 0 {
+		for _, s := range m.Removed {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func encodeFixed64DesiredLrpRequests(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32DesiredLrpRequests(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintDesiredLrpRequests(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *DesiredLRPLifecycleResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *DesiredLRPsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	}
+	if len(m.DesiredLrps) > 0 {
+		for _, e := range m.DesiredLrps {
+			l = e.Size()
+			n += 1 + l + sovDesiredLrpRequests(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	return n
+}
+
+func (m *DesiredLRPsRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Domain)
+	n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	if len(m.ProcessGuids) > 0 {
+		for _, s := range m.ProcessGuids {
+			l = len(s)
+			n += 1 + l + sovDesiredLrpRequests(uint64(l))
+		}
+	}
+	n += 1 + sovDesiredLrpRequests(uint64(m.PageSize))
+	l = len(m.PageToken)
+	n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	return n
+}
+
+func (m *DesiredLRPResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	}
+	if m.DesiredLrp != nil {
+		l = m.DesiredLrp.Size()
+		n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *DesiredLRPSchedulingInfosResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	}
+	if len(m.DesiredLrpSchedulingInfos) > 0 {
+		for _, e := range m.DesiredLrpSchedulingInfos {
+			l = e.Size()
+			n += 1 + l + sovDesiredLrpRequests(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DesiredLRPByProcessGuidRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ProcessGuid)
+	n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	return n
+}
+
+func (m *DesireLRPRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.DesiredLrp != nil {
@@ -1057,6 +2032,10 @@ func (m *UpdateDesiredLRPRequest) Size() (n int) {
 		l = m.Update.Size()
 		n += 1 + l + sovDesiredLrpRequests(uint64(l))
 	}
+	if m.ExpectedModificationTag != nil {
+		l = m.ExpectedModificationTag.Size()
+		n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	}
 	return n
 }
 
@@ -1065,21 +2044,137 @@ func (m *RemoveDesiredLRPRequest) Size() (n int) {
 	_ = l
 	l = len(m.ProcessGuid)
 	n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	if m.ExpectedModificationTag != nil {
+		l = m.ExpectedModificationTag.Size()
+		n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	}
 	return n
 }
 
-func sovDesiredLrpRequests(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *RedeployDesiredLRPRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ProcessGuid)
+	n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	if m.RunInfo != nil {
+		l = m.RunInfo.Size()
+		n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	}
+	if m.Resource != nil {
+		l = m.Resource.Size()
+		n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	}
+	n += 1 + sovDesiredLrpRequests(uint64(m.MaxInFlight))
+	return n
+}
+
+func (m *DesireLRPsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.DesiredLrps) > 0 {
+		for _, e := range m.DesiredLrps {
+			l = e.Size()
+			n += 1 + l + sovDesiredLrpRequests(uint64(l))
 		}
 	}
 	return n
 }
-func sozDesiredLrpRequests(x uint64) (n int) {
-	return sovDesiredLrpRequests(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *RemoveDesiredLRPsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.ProcessGuids) > 0 {
+		for _, s := range m.ProcessGuids {
+			l = len(s)
+			n += 1 + l + sovDesiredLrpRequests(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DesiredLRPLifecycleResult) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ProcessGuid)
+	n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *DesiredLRPsLifecycleResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovDesiredLrpRequests(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ApplyDesiredLRPsRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Domain)
+	n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	if len(m.DesiredLrps) > 0 {
+		for _, e := range m.DesiredLrps {
+			l = e.Size()
+			n += 1 + l + sovDesiredLrpRequests(uint64(l))
+		}
+	}
+	n += 1 + sovDesiredLrpRequests(uint64(m.Ttl))
+	return n
+}
+
+func (m *ApplyDesiredLRPsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	}
+	if len(m.Created) > 0 {
+		for _, s := range m.Created {
+			l = len(s)
+			n += 1 + l + sovDesiredLrpRequests(uint64(l))
+		}
+	}
+	if len(m.Updated) > 0 {
+		for _, s := range m.Updated {
+			l = len(s)
+			n += 1 + l + sovDesiredLrpRequests(uint64(l))
+		}
+	}
+	if len(m.Removed) > 0 {
+		for _, s := range m.Removed {
+			l = len(s)
+			n += 1 + l + sovDesiredLrpRequests(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovDesiredLrpRequests(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozDesiredLrpRequests(x uint64) (n int) {
+	return sovDesiredLrpRequests(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (this *DesiredLRPLifecycleResponse) String() string {
 	if this == nil {
@@ -1098,6 +2193,7 @@ func (this *DesiredLRPsResponse) String() string {
 	s := strings.Join([]string{`&DesiredLRPsResponse{`,
 		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
 		`DesiredLrps:` + strings.Replace(fmt.Sprintf("%v", this.DesiredLrps), "DesiredLRP", "DesiredLRP", 1) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1109,6 +2205,8 @@ func (this *DesiredLRPsRequest) String() string {
 	s := strings.Join([]string{`&DesiredLRPsRequest{`,
 		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
 		`ProcessGuids:` + fmt.Sprintf("%v", this.ProcessGuids) + `,`,
+		`PageSize:` + fmt.Sprintf("%v", this.PageSize) + `,`,
+		`PageToken:` + fmt.Sprintf("%v", this.PageToken) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1162,6 +2260,7 @@ func (this *UpdateDesiredLRPRequest) String() string {
 	s := strings.Join([]string{`&UpdateDesiredLRPRequest{`,
 		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
 		`Update:` + strings.Replace(fmt.Sprintf("%v", this.Update), "DesiredLRPUpdate", "DesiredLRPUpdate", 1) + `,`,
+		`ExpectedModificationTag:` + strings.Replace(fmt.Sprintf("%v", this.ExpectedModificationTag), "ModificationTag", "ModificationTag", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1170,21 +2269,1021 @@ func (this *RemoveDesiredLRPRequest) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&RemoveDesiredLRPRequest{`,
-		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringDesiredLrpRequests(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	s := strings.Join([]string{`&RemoveDesiredLRPRequest{`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`ExpectedModificationTag:` + strings.Replace(fmt.Sprintf("%v", this.ExpectedModificationTag), "ModificationTag", "ModificationTag", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RedeployDesiredLRPRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RedeployDesiredLRPRequest{`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`RunInfo:` + strings.Replace(fmt.Sprintf("%v", this.RunInfo), "DesiredLRPRunInfo", "DesiredLRPRunInfo", 1) + `,`,
+		`Resource:` + strings.Replace(fmt.Sprintf("%v", this.Resource), "DesiredLRPResource", "DesiredLRPResource", 1) + `,`,
+		`MaxInFlight:` + fmt.Sprintf("%v", this.MaxInFlight) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DesireLRPsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DesireLRPsRequest{`,
+		`DesiredLrps:` + strings.Replace(fmt.Sprintf("%v", this.DesiredLrps), "DesiredLRP", "DesiredLRP", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoveDesiredLRPsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemoveDesiredLRPsRequest{`,
+		`ProcessGuids:` + fmt.Sprintf("%v", this.ProcessGuids) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DesiredLRPLifecycleResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DesiredLRPLifecycleResult{`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DesiredLRPsLifecycleResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DesiredLRPsLifecycleResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Results:` + strings.Replace(fmt.Sprintf("%v", this.Results), "DesiredLRPLifecycleResult", "DesiredLRPLifecycleResult", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplyDesiredLRPsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplyDesiredLRPsRequest{`,
+		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
+		`DesiredLrps:` + strings.Replace(fmt.Sprintf("%v", this.DesiredLrps), "DesiredLRP", "DesiredLRP", 1) + `,`,
+		`Ttl:` + fmt.Sprintf("%v", this.Ttl) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplyDesiredLRPsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplyDesiredLRPsResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Created:` + fmt.Sprintf("%v", this.Created) + `,`,
+		`Updated:` + fmt.Sprintf("%v", this.Updated) + `,`,
+		`Removed:` + fmt.Sprintf("%v", this.Removed) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringDesiredLrpRequests(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *DesiredLRPLifecycleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesiredLRPLifecycleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesiredLRPLifecycleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DesiredLRPsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesiredLRPsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesiredLRPsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredLrps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DesiredLrps = append(m.DesiredLrps, &DesiredLRP{})
+			if err := m.DesiredLrps[len(m.DesiredLrps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DesiredLRPsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesiredLRPsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesiredLRPsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuids = append(m.ProcessGuids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DesiredLRPResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesiredLRPResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesiredLRPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredLrp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DesiredLrp == nil {
+				m.DesiredLrp = &DesiredLRP{}
+			}
+			if err := m.DesiredLrp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DesiredLRPSchedulingInfosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesiredLRPSchedulingInfosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesiredLRPSchedulingInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredLrpSchedulingInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DesiredLrpSchedulingInfos = append(m.DesiredLrpSchedulingInfos, &DesiredLRPSchedulingInfo{})
+			if err := m.DesiredLrpSchedulingInfos[len(m.DesiredLrpSchedulingInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DesiredLRPByProcessGuidRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesiredLRPByProcessGuidRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesiredLRPByProcessGuidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DesireLRPRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesireLRPRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesireLRPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredLrp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DesiredLrp == nil {
+				m.DesiredLrp = &DesiredLRP{}
+			}
+			if err := m.DesiredLrp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateDesiredLRPRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateDesiredLRPRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateDesiredLRPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Update == nil {
+				m.Update = &DesiredLRPUpdate{}
+			}
+			if err := m.Update.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedModificationTag", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpectedModificationTag == nil {
+				m.ExpectedModificationTag = &ModificationTag{}
+			}
+			if err := m.ExpectedModificationTag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *DesiredLRPLifecycleResponse) Unmarshal(dAtA []byte) error {
+func (m *RemoveDesiredLRPRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1207,15 +3306,44 @@ func (m *DesiredLRPLifecycleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DesiredLRPLifecycleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveDesiredLRPRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DesiredLRPLifecycleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveDesiredLRPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedModificationTag", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1239,10 +3367,10 @@ func (m *DesiredLRPLifecycleResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Error == nil {
-				m.Error = &Error{}
+			if m.ExpectedModificationTag == nil {
+				m.ExpectedModificationTag = &ModificationTag{}
 			}
-			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExpectedModificationTag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1267,7 +3395,7 @@ func (m *DesiredLRPLifecycleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DesiredLRPsResponse) Unmarshal(dAtA []byte) error {
+func (m *RedeployDesiredLRPRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1290,15 +3418,44 @@ func (m *DesiredLRPsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DesiredLRPsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedeployDesiredLRPRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DesiredLRPsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedeployDesiredLRPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1322,14 +3479,116 @@ func (m *DesiredLRPsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Error == nil {
-				m.Error = &Error{}
+			if m.RunInfo == nil {
+				m.RunInfo = &DesiredLRPRunInfo{}
 			}
-			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RunInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resource == nil {
+				m.Resource = &DesiredLRPResource{}
+			}
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInFlight", wireType)
+			}
+			m.MaxInFlight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInFlight |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DesireLRPsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesireLRPsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesireLRPsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DesiredLrps", wireType)
 			}
@@ -1381,7 +3640,7 @@ func (m *DesiredLRPsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DesiredLRPsRequest) Unmarshal(dAtA []byte) error {
+func (m *RemoveDesiredLRPsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1404,42 +3663,13 @@ func (m *DesiredLRPsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DesiredLRPsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveDesiredLRPsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DesiredLRPsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveDesiredLRPsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDesiredLrpRequests
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDesiredLrpRequests
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Domain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuids", wireType)
 			}
@@ -1489,7 +3719,7 @@ func (m *DesiredLRPsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DesiredLRPResponse) Unmarshal(dAtA []byte) error {
+func (m *DesiredLRPLifecycleResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1512,17 +3742,17 @@ func (m *DesiredLRPResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DesiredLRPResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DesiredLRPLifecycleResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DesiredLRPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DesiredLRPLifecycleResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDesiredLrpRequests
@@ -1532,28 +3762,24 @@ func (m *DesiredLRPResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDesiredLrpRequests
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Error == nil {
-				m.Error = &Error{}
-			}
-			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredLrp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1577,10 +3803,10 @@ func (m *DesiredLRPResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DesiredLrp == nil {
-				m.DesiredLrp = &DesiredLRP{}
+			if m.Error == nil {
+				m.Error = &Error{}
 			}
-			if err := m.DesiredLrp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1605,7 +3831,7 @@ func (m *DesiredLRPResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DesiredLRPSchedulingInfosResponse) Unmarshal(dAtA []byte) error {
+func (m *DesiredLRPsLifecycleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1628,10 +3854,10 @@ func (m *DesiredLRPSchedulingInfosResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DesiredLRPSchedulingInfosResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DesiredLRPsLifecycleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DesiredLRPSchedulingInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DesiredLRPsLifecycleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1669,7 +3895,7 @@ func (m *DesiredLRPSchedulingInfosResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredLrpSchedulingInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1693,8 +3919,8 @@ func (m *DesiredLRPSchedulingInfosResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DesiredLrpSchedulingInfos = append(m.DesiredLrpSchedulingInfos, &DesiredLRPSchedulingInfo{})
-			if err := m.DesiredLrpSchedulingInfos[len(m.DesiredLrpSchedulingInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, &DesiredLRPLifecycleResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1719,7 +3945,7 @@ func (m *DesiredLRPSchedulingInfosResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DesiredLRPByProcessGuidRequest) Unmarshal(dAtA []byte) error {
+func (m *ApplyDesiredLRPsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1742,15 +3968,15 @@ func (m *DesiredLRPByProcessGuidRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DesiredLRPByProcessGuidRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplyDesiredLRPsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DesiredLRPByProcessGuidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplyDesiredLRPsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1775,61 +4001,11 @@ func (m *DesiredLRPByProcessGuidRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
+			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDesiredLrpRequests(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthDesiredLrpRequests
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DesireLRPRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDesiredLrpRequests
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DesireLRPRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DesireLRPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredLrp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredLrps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1853,13 +4029,30 @@ func (m *DesireLRPRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DesiredLrp == nil {
-				m.DesiredLrp = &DesiredLRP{}
-			}
-			if err := m.DesiredLrp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DesiredLrps = append(m.DesiredLrps, &DesiredLRP{})
+			if err := m.DesiredLrps[len(m.DesiredLrps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDesiredLrpRequests(dAtA[iNdEx:])
@@ -1881,7 +4074,7 @@ func (m *DesireLRPRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateDesiredLRPRequest) Unmarshal(dAtA []byte) error {
+func (m *ApplyDesiredLRPsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1904,17 +4097,17 @@ func (m *UpdateDesiredLRPRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateDesiredLRPRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplyDesiredLRPsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateDesiredLRPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplyDesiredLRPsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDesiredLrpRequests
@@ -1924,26 +4117,30 @@ func (m *UpdateDesiredLRPRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDesiredLrpRequests
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDesiredLrpRequests
@@ -1953,78 +4150,53 @@ func (m *UpdateDesiredLRPRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDesiredLrpRequests
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Update == nil {
-				m.Update = &DesiredLRPUpdate{}
-			}
-			if err := m.Update.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Created = append(m.Created, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDesiredLrpRequests(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthDesiredLrpRequests
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RemoveDesiredLRPRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDesiredLrpRequests
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveDesiredLRPRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveDesiredLRPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Updated = append(m.Updated, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2049,7 +4221,7 @@ func (m *RemoveDesiredLRPRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
+			m.Removed = append(m.Removed, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2180,33 +4352,54 @@ var (
 func init() { proto.RegisterFile("desired_lrp_requests.proto", fileDescriptorDesiredLrpRequests) }
 
 var fileDescriptorDesiredLrpRequests = []byte{
-	// 445 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xa4, 0x52, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xf5, 0x16, 0x88, 0xd4, 0x71, 0x2a, 0xc1, 0x72, 0xa8, 0x09, 0xd5, 0x12, 0xb6, 0x07, 0x7a,
-	0x00, 0x17, 0x15, 0xf1, 0x03, 0x16, 0xa8, 0xaa, 0x94, 0x43, 0xb5, 0x08, 0x71, 0xb4, 0x52, 0x7b,
-	0xe3, 0x5a, 0xb2, 0xbd, 0xee, 0xae, 0x8d, 0xd4, 0x1b, 0x9f, 0xc0, 0x67, 0x20, 0xf1, 0x23, 0x3d,
-	0xf6, 0xc8, 0x09, 0x11, 0x73, 0xe1, 0x98, 0x4f, 0x40, 0xd9, 0x75, 0xf0, 0x26, 0xe1, 0x80, 0xc5,
-	0xcd, 0x3b, 0xf3, 0xe6, 0xbd, 0x37, 0x6f, 0x0c, 0xa3, 0x98, 0xab, 0x54, 0xf2, 0x38, 0xcc, 0x64,
-	0x19, 0x4a, 0x7e, 0x55, 0x73, 0x55, 0x29, 0xbf, 0x94, 0xa2, 0x12, 0x78, 0x90, 0x8b, 0x98, 0x67,
-	0x6a, 0xf4, 0x22, 0x49, 0xab, 0xcb, 0xfa, 0xc2, 0x8f, 0x44, 0x7e, 0x9c, 0x88, 0x44, 0x1c, 0xeb,
-	0xf6, 0x45, 0x3d, 0xd3, 0x2f, 0xfd, 0xd0, 0x5f, 0x66, 0x6c, 0xf4, 0xc0, 0xa2, 0x6c, 0x4b, 0x2e,
-	0x97, 0x52, 0x48, 0xf3, 0xa0, 0x01, 0x3c, 0x7e, 0x63, 0x10, 0x13, 0x76, 0x3e, 0x49, 0x67, 0x3c,
-	0xba, 0x8e, 0x32, 0xce, 0xb8, 0x2a, 0x45, 0xa1, 0x38, 0x3e, 0x84, 0x7b, 0x1a, 0xed, 0xa1, 0x31,
-	0x3a, 0x72, 0x4f, 0xf6, 0x7c, 0xe3, 0xc2, 0x7f, 0xbb, 0x2c, 0x32, 0xd3, 0xa3, 0x57, 0xf0, 0xb0,
-	0xe3, 0x50, 0xbd, 0x66, 0xf1, 0x6b, 0x18, 0x5a, 0x0e, 0x95, 0xb7, 0x33, 0xbe, 0x73, 0xe4, 0x9e,
-	0xe0, 0x15, 0xb6, 0xe3, 0x65, 0x6e, 0x8b, 0x9b, 0xc8, 0x52, 0xd1, 0x0f, 0x80, 0xd7, 0x24, 0x75,
-	0x54, 0xf8, 0x00, 0x06, 0xb1, 0xc8, 0xa7, 0x69, 0xa1, 0x25, 0x77, 0x83, 0xbb, 0x37, 0xdf, 0x9f,
-	0x38, 0xac, 0xad, 0xe1, 0x43, 0xd8, 0x2b, 0xa5, 0x88, 0xb8, 0x52, 0x61, 0x52, 0xa7, 0xb1, 0xd1,
-	0xda, 0x65, 0xc3, 0xb6, 0x78, 0xba, 0xac, 0xd1, 0xc2, 0x26, 0xee, 0xb7, 0xca, 0x2b, 0x70, 0xad,
-	0x55, 0xbc, 0x1d, 0x0d, 0xfd, 0xdb, 0x26, 0xd0, 0x6d, 0x42, 0xbf, 0x22, 0x78, 0xda, 0xb5, 0xde,
-	0x45, 0x97, 0x3c, 0xae, 0xb3, 0xb4, 0x48, 0xce, 0x8a, 0x99, 0xe8, 0x19, 0xe5, 0x14, 0x0e, 0xec,
-	0xff, 0x47, 0xfd, 0xe1, 0x0a, 0xd3, 0x25, 0x59, 0x1b, 0xed, 0x78, 0xdb, 0xd0, 0xba, 0x2a, 0x7b,
-	0xd4, 0xd9, 0xdb, 0xf0, 0x43, 0xcf, 0x80, 0x74, 0x63, 0xc1, 0xf5, 0x79, 0x97, 0xdc, 0xea, 0x04,
-	0xcf, 0x60, 0x68, 0x87, 0xbc, 0x76, 0x08, 0xd7, 0x4a, 0x9a, 0x9e, 0xc2, 0x7d, 0x43, 0xa5, 0x73,
-	0x36, 0xc3, 0x1b, 0x09, 0xa2, 0x7f, 0x4a, 0xb0, 0x82, 0xfd, 0xf7, 0x65, 0x3c, 0xad, 0xb8, 0x7d,
-	0xb7, 0x7e, 0x66, 0xf0, 0x4b, 0x18, 0xd4, 0x9a, 0xa3, 0xbd, 0x9a, 0xb7, 0xad, 0x69, 0x34, 0x58,
-	0x8b, 0xa3, 0x01, 0xec, 0x33, 0x9e, 0x8b, 0x8f, 0xff, 0xa1, 0x1a, 0x3c, 0xbf, 0x9d, 0x13, 0xe7,
-	0xdb, 0x9c, 0x38, 0x8b, 0x39, 0x41, 0x9f, 0x1a, 0x82, 0xbe, 0x34, 0x04, 0xdd, 0x34, 0x04, 0xdd,
-	0x36, 0x04, 0xfd, 0x68, 0x08, 0xfa, 0xd5, 0x10, 0x67, 0xd1, 0x10, 0xf4, 0xf9, 0x27, 0x71, 0x7e,
-	0x07, 0x00, 0x00, 0xff, 0xff, 0x41, 0x49, 0x15, 0xcc, 0x1d, 0x04, 0x00, 0x00,
+	// 779 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xa4, 0x55, 0x4d, 0x6f, 0xd3, 0x4a,
+	0x14, 0xcd, 0x24, 0xe9, 0x47, 0xae, 0x1b, 0xbd, 0x57, 0x3f, 0xa9, 0x71, 0xf2, 0x2a, 0x93, 0xba,
+	0x0b, 0xb2, 0x28, 0x29, 0x2a, 0x1f, 0x1b, 0x16, 0x88, 0x08, 0xa8, 0x8a, 0x8a, 0x54, 0xb9, 0x65,
+	0x6d, 0xb9, 0xf6, 0xc4, 0x1d, 0xe1, 0x78, 0xcc, 0xd8, 0x46, 0x49, 0x57, 0x5d, 0xb1, 0x43, 0x62,
+	0xc7, 0x92, 0x2d, 0x12, 0x7f, 0xa4, 0xec, 0xba, 0x64, 0x81, 0x10, 0x0d, 0x1b, 0x96, 0xfd, 0x09,
+	0xc8, 0xe3, 0x49, 0x32, 0x4d, 0x4a, 0xd5, 0xb4, 0xbb, 0xcc, 0x3d, 0x73, 0xcf, 0x1c, 0x9f, 0x7b,
+	0x73, 0x2f, 0xd4, 0x5c, 0x1c, 0x11, 0x86, 0x5d, 0xcb, 0x67, 0xa1, 0xc5, 0xf0, 0x9b, 0x04, 0x47,
+	0x71, 0xd4, 0x0c, 0x19, 0x8d, 0xa9, 0x3a, 0xdb, 0xa1, 0x2e, 0xf6, 0xa3, 0xda, 0x1d, 0x8f, 0xc4,
+	0x07, 0xc9, 0x7e, 0xd3, 0xa1, 0x9d, 0x75, 0x8f, 0x7a, 0x74, 0x9d, 0xc3, 0xfb, 0x49, 0x9b, 0x9f,
+	0xf8, 0x81, 0xff, 0xca, 0xd2, 0x6a, 0x8b, 0x12, 0xa5, 0x08, 0x29, 0x98, 0x31, 0xca, 0xc4, 0x61,
+	0xa9, 0x43, 0x5d, 0xd2, 0x26, 0x8e, 0x1d, 0x13, 0x1a, 0x58, 0xb1, 0xed, 0x65, 0x71, 0xa3, 0x05,
+	0xff, 0x3f, 0xcd, 0x32, 0xb7, 0xcd, 0x9d, 0x6d, 0xd2, 0xc6, 0x4e, 0xcf, 0xf1, 0xb1, 0x89, 0xa3,
+	0x90, 0x06, 0x11, 0x56, 0x57, 0x61, 0x86, 0xb3, 0x68, 0xa8, 0x8e, 0x1a, 0xca, 0x46, 0xb9, 0x99,
+	0xa9, 0x6b, 0x3e, 0x4b, 0x83, 0x66, 0x86, 0x19, 0x9f, 0x10, 0xfc, 0x37, 0x22, 0x89, 0xa6, 0x4a,
+	0x56, 0x1f, 0xc0, 0x82, 0x24, 0x3d, 0xd2, 0xf2, 0xf5, 0x42, 0x43, 0xd9, 0x50, 0x07, 0x77, 0x47,
+	0xbc, 0xa6, 0x22, 0xee, 0x6d, 0xb3, 0x30, 0x52, 0xd7, 0xe0, 0x9f, 0x00, 0x77, 0x63, 0x2b, 0xb4,
+	0x3d, 0x6c, 0xc5, 0xf4, 0x35, 0x0e, 0xb4, 0x42, 0x1d, 0x35, 0x4a, 0xad, 0xe2, 0xf1, 0x8f, 0x5b,
+	0x39, 0xb3, 0x9c, 0x82, 0x3b, 0xb6, 0x87, 0xf7, 0x52, 0x28, 0x55, 0xa8, 0x9e, 0x53, 0xc8, 0x2d,
+	0x57, 0x97, 0x61, 0xd6, 0xa5, 0x1d, 0x9b, 0x04, 0x1a, 0x92, 0x72, 0x45, 0x4c, 0x5d, 0x85, 0x72,
+	0xc8, 0xa8, 0x83, 0xa3, 0xc8, 0xf2, 0x12, 0xe2, 0x66, 0xd2, 0x4a, 0xe6, 0x82, 0x08, 0x6e, 0xa6,
+	0x31, 0x75, 0x05, 0x4a, 0x5c, 0x42, 0x44, 0x0e, 0x31, 0x57, 0x30, 0x23, 0x58, 0xe6, 0xd3, 0xf0,
+	0x2e, 0x39, 0x4c, 0x6d, 0x00, 0x49, 0x65, 0x51, 0x7a, 0xa9, 0x14, 0x0e, 0x15, 0x06, 0xb2, 0xc0,
+	0xe9, 0x1c, 0xbc, 0x07, 0x8a, 0xe4, 0xa0, 0x96, 0xaf, 0xa3, 0xbf, 0x18, 0x08, 0x23, 0x03, 0x8d,
+	0x2f, 0x08, 0x56, 0x46, 0xd0, 0xae, 0x73, 0x80, 0xdd, 0xc4, 0x27, 0x81, 0xb7, 0x15, 0xb4, 0xe9,
+	0x94, 0x15, 0xb4, 0x61, 0x59, 0xee, 0xe7, 0x68, 0xc8, 0x65, 0x91, 0x94, 0x4c, 0x54, 0xb4, 0x3e,
+	0x29, 0xe8, 0xfc, 0xab, 0x66, 0x75, 0x24, 0x6f, 0x4c, 0x8f, 0xb1, 0x05, 0xfa, 0x28, 0xad, 0xd5,
+	0xdb, 0x19, 0x55, 0x60, 0x50, 0xca, 0xdb, 0xb0, 0x20, 0x17, 0xeb, 0x5c, 0x41, 0x15, 0xa9, 0x62,
+	0xc6, 0x26, 0xfc, 0x9b, 0x51, 0x71, 0x9f, 0xb3, 0xe4, 0x31, 0x07, 0xd1, 0x95, 0x1c, 0xfc, 0x8a,
+	0xa0, 0xf2, 0x2a, 0x74, 0xed, 0x18, 0x4b, 0x17, 0xa6, 0x54, 0xa3, 0xde, 0x85, 0xd9, 0x84, 0x73,
+	0x88, 0xb2, 0x69, 0x93, 0x8f, 0x66, 0x6f, 0x98, 0xe2, 0x9e, 0xba, 0x0b, 0x55, 0xdc, 0x0d, 0xb1,
+	0x13, 0x63, 0xd7, 0x1a, 0xff, 0x4f, 0xf3, 0x06, 0x54, 0x36, 0x2a, 0x03, 0x92, 0x97, 0x12, 0xbe,
+	0x67, 0x7b, 0x66, 0x65, 0x90, 0x39, 0x06, 0x18, 0x1f, 0x11, 0x54, 0x4c, 0xdc, 0xa1, 0x6f, 0x6f,
+	0xf2, 0x2d, 0x97, 0x2a, 0xcb, 0x5f, 0x53, 0xd9, 0x77, 0x04, 0x55, 0x13, 0xbb, 0x38, 0xf4, 0x69,
+	0xef, 0x06, 0xda, 0xee, 0xc3, 0x3c, 0x4b, 0x02, 0xde, 0x90, 0x42, 0x4a, 0xf5, 0x82, 0xf2, 0x26,
+	0x01, 0x6f, 0xc4, 0x39, 0x96, 0xfd, 0x50, 0x1f, 0xc2, 0x3c, 0xc3, 0x11, 0x4d, 0x98, 0x83, 0x85,
+	0xb5, 0xb5, 0x0b, 0xb2, 0xc4, 0x0d, 0x73, 0x78, 0x57, 0x6d, 0x40, 0xb9, 0x63, 0x77, 0x2d, 0x12,
+	0x58, 0x6d, 0x9f, 0x78, 0x07, 0xb1, 0x56, 0x94, 0x06, 0x83, 0xd2, 0xb1, 0xbb, 0x5b, 0xc1, 0x73,
+	0x0e, 0x18, 0x2f, 0x60, 0x71, 0xd8, 0x8d, 0xc3, 0xb1, 0x34, 0x3e, 0x12, 0xd1, 0x95, 0x46, 0xa2,
+	0xf1, 0x18, 0xb4, 0xf1, 0x1a, 0x0e, 0x29, 0x27, 0x66, 0x19, 0x9a, 0x9c, 0x65, 0x06, 0x81, 0xea,
+	0xc5, 0xbb, 0x20, 0xf1, 0xa7, 0xb0, 0x7a, 0x38, 0x33, 0xf2, 0x97, 0xac, 0x8c, 0x23, 0x04, 0xcb,
+	0x92, 0xcc, 0xeb, 0x2d, 0x1e, 0xf5, 0x11, 0xcc, 0x31, 0xae, 0x6e, 0x30, 0x64, 0x56, 0x26, 0x3d,
+	0x1a, 0xfb, 0x0e, 0x73, 0x90, 0x61, 0xbc, 0x43, 0x50, 0x79, 0x12, 0x86, 0x7e, 0x6f, 0xea, 0xc5,
+	0x70, 0xcd, 0x95, 0xb5, 0x04, 0x85, 0x38, 0xf6, 0x79, 0x23, 0x95, 0x05, 0x63, 0x1a, 0x30, 0xde,
+	0x23, 0xd0, 0x26, 0x85, 0x4c, 0xe3, 0x83, 0x06, 0x73, 0x0e, 0xc3, 0x76, 0x8c, 0x5d, 0xb1, 0xa3,
+	0x06, 0xc7, 0x14, 0xc9, 0xe6, 0x86, 0xab, 0x15, 0x32, 0x44, 0x1c, 0x53, 0x84, 0xf1, 0x6e, 0x71,
+	0xb5, 0x62, 0x86, 0x88, 0x63, 0x6b, 0xed, 0xe4, 0x54, 0xcf, 0x7d, 0x3b, 0xd5, 0x73, 0x67, 0xa7,
+	0x3a, 0x3a, 0xea, 0xeb, 0xe8, 0x73, 0x5f, 0x47, 0xc7, 0x7d, 0x1d, 0x9d, 0xf4, 0x75, 0xf4, 0xb3,
+	0xaf, 0xa3, 0xdf, 0x7d, 0x3d, 0x77, 0xd6, 0xd7, 0xd1, 0x87, 0x5f, 0x7a, 0xee, 0x4f, 0x00, 0x00,
+	0x00, 0xff, 0xff, 0x46, 0xa2, 0xa4, 0x10, 0xcc, 0x08, 0x00, 0x00,
 }
@@ -1,6 +1,8 @@
 package models_test
 
 import (
+	"time"
+
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
 
@@ -9,6 +11,45 @@ import (
 )
 
 var _ = Describe("DesiredLRP Requests", func() {
+	Describe("DesiredLRPsRequest", func() {
+		Describe("Validate", func() {
+			var request models.DesiredLRPsRequest
+
+			BeforeEach(func() {
+				request = models.DesiredLRPsRequest{
+					PageSize:  10,
+					PageToken: models.DesiredLRPFilter{PageSize: 1}.NextPageToken("some-guid"),
+				}
+			})
+
+			Context("when valid", func() {
+				It("returns nil", func() {
+					Expect(request.Validate()).To(BeNil())
+				})
+			})
+
+			Context("when the PageSize is negative", func() {
+				BeforeEach(func() {
+					request.PageSize = -1
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"page_size"}))
+				})
+			})
+
+			Context("when the PageToken is malformed", func() {
+				BeforeEach(func() {
+					request.PageToken = "garbage"
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"page_token"}))
+				})
+			})
+		})
+	})
+
 	Describe("DesiredLRPsByProcessGuidRequest", func() {
 		Describe("Validate", func() {
 			var request models.DesiredLRPByProcessGuidRequest
@@ -143,4 +184,162 @@ var _ = Describe("DesiredLRP Requests", func() {
 			})
 		})
 	})
+
+	Describe("DesireLRPsRequest", func() {
+		Describe("Validate", func() {
+			It("is valid with at least one DesiredLRP", func() {
+				request := models.DesireLRPsRequest{
+					DesiredLrps: []*models.DesiredLRP{model_helpers.NewValidDesiredLRP("some-guid")},
+				}
+				Expect(request.Validate()).To(BeNil())
+			})
+
+			It("does not validate the DesiredLRPs themselves", func() {
+				request := models.DesireLRPsRequest{
+					DesiredLrps: []*models.DesiredLRP{{ProcessGuid: "some-guid"}},
+				}
+				Expect(request.Validate()).To(BeNil())
+			})
+
+			It("returns a validation error when there are no DesiredLRPs", func() {
+				request := models.DesireLRPsRequest{}
+				Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"desired_lrps"}))
+			})
+		})
+	})
+
+	Describe("RemoveDesiredLRPsRequest", func() {
+		Describe("Validate", func() {
+			It("is valid with at least one process guid", func() {
+				request := models.RemoveDesiredLRPsRequest{ProcessGuids: []string{"some-guid"}}
+				Expect(request.Validate()).To(BeNil())
+			})
+
+			It("returns a validation error when there are no process guids", func() {
+				request := models.RemoveDesiredLRPsRequest{}
+				Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"process_guids"}))
+			})
+		})
+	})
+
+	Describe("ApplyDesiredLRPsRequest", func() {
+		Describe("Validate", func() {
+			var request models.ApplyDesiredLRPsRequest
+
+			BeforeEach(func() {
+				request = models.ApplyDesiredLRPsRequest{
+					Domain: "some-domain",
+					DesiredLrps: []*models.DesiredLRP{
+						model_helpers.NewValidDesiredLRP("guid-1"),
+						model_helpers.NewValidDesiredLRP("guid-2"),
+					},
+					Ttl: 60,
+				}
+			})
+
+			It("is valid", func() {
+				Expect(request.Validate()).To(BeNil())
+			})
+
+			It("is valid with no DesiredLRPs", func() {
+				request.DesiredLrps = nil
+				Expect(request.Validate()).To(BeNil())
+			})
+
+			It("requires a domain", func() {
+				request.Domain = ""
+				Expect(request.Validate()).To(ContainElement(models.ErrInvalidField{"domain"}))
+			})
+
+			It("requires every DesiredLRP to be in the domain", func() {
+				request.DesiredLrps[1].Domain = "other-domain"
+				Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"desired_lrps.domain"}))
+			})
+
+			It("requires unique process guids", func() {
+				request.DesiredLrps[1].ProcessGuid = "guid-1"
+				Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"desired_lrps.process_guid"}))
+			})
+
+			It("validates each DesiredLRP", func() {
+				request.DesiredLrps[0].Instances = -1
+				Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"instances"}))
+			})
+		})
+	})
+
+	Describe("RedeployDesiredLRPRequest", func() {
+		Describe("Validate", func() {
+			var request models.RedeployDesiredLRPRequest
+
+			BeforeEach(func() {
+				desiredLRP := model_helpers.NewValidDesiredLRP("some-guid")
+				runInfo := desiredLRP.DesiredLRPRunInfo(time.Unix(42, 0))
+				request = models.RedeployDesiredLRPRequest{
+					ProcessGuid: "some-guid",
+					RunInfo:     &runInfo,
+				}
+			})
+
+			Context("when valid", func() {
+				It("returns nil", func() {
+					Expect(request.Validate()).To(BeNil())
+				})
+			})
+
+			Context("when the ProcessGuid is blank", func() {
+				BeforeEach(func() {
+					request.ProcessGuid = ""
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(
+						models.ErrInvalidField{"process_guid"},
+						models.ErrInvalidField{"run_info.process_guid"},
+					))
+				})
+			})
+
+			Context("when the RunInfo is missing", func() {
+				BeforeEach(func() {
+					request.RunInfo = nil
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"run_info"}))
+				})
+			})
+
+			Context("when the RunInfo has no action", func() {
+				BeforeEach(func() {
+					request.RunInfo.Action = nil
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidActionType))
+				})
+			})
+
+			Context("when the Resource is invalid", func() {
+				BeforeEach(func() {
+					resource := models.NewDesiredLRPResource(-1, 0, 0, "preloaded:some-stack")
+					request.Resource = &resource
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"memory_mb"}))
+				})
+			})
+
+			Context("when MaxInFlight is negative", func() {
+				BeforeEach(func() {
+					request.MaxInFlight = -1
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"max_in_flight"}))
+				})
+			})
+		})
+	})
 })
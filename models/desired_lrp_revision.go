@@ -0,0 +1,69 @@
+package models
+
+// DesiredLRPUpdate returns the update reapplying the instances, routes and
+// annotation of the revision, which are the parts of a DesiredLRP that
+// UpdateDesiredLRP can change.
+func (r *DesiredLRPRevision) DesiredLRPUpdate() *DesiredLRPUpdate {
+	instances := r.DesiredLrp.Instances
+	annotation := r.DesiredLrp.Annotation
+	routes := &Routes{}
+	if r.DesiredLrp.Routes != nil {
+		routes = r.DesiredLrp.Routes
+	}
+
+	return &DesiredLRPUpdate{
+		Instances:  &instances,
+		Routes:     routes,
+		Annotation: &annotation,
+	}
+}
+
+func (request *DesiredLRPRevisionsRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.ProcessGuid == "" {
+		validationError = validationError.Append(ErrInvalidField{"process_guid"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+func (request *DesiredLRPRevisionRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.ProcessGuid == "" {
+		validationError = validationError.Append(ErrInvalidField{"process_guid"})
+	}
+
+	if request.Revision <= 0 {
+		validationError = validationError.Append(ErrInvalidField{"revision"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+func (request *RollbackDesiredLRPRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.ProcessGuid == "" {
+		validationError = validationError.Append(ErrInvalidField{"process_guid"})
+	}
+
+	if request.Revision <= 0 {
+		validationError = validationError.Append(ErrInvalidField{"revision"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
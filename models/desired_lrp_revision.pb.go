@@ -0,0 +1,1768 @@
+// Code generated by protoc-gen-gogo.
+// source: desired_lrp_revision.proto
+// DO NOT EDIT!
+
+package models
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+
+import strings "strings"
+import reflect "reflect"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type DesiredLRPRevision struct {
+	ProcessGuid     string           `protobuf:"bytes,1,opt,name=process_guid,json=processGuid" json:"process_guid"`
+	Revision        int64            `protobuf:"varint,2,opt,name=revision" json:"revision"`
+	ModificationTag *ModificationTag `protobuf:"bytes,3,opt,name=modification_tag,json=modificationTag" json:"modification_tag,omitempty"`
+	CreatedAt       int64            `protobuf:"varint,4,opt,name=created_at,json=createdAt" json:"created_at"`
+	DesiredLrp      *DesiredLRP      `protobuf:"bytes,5,opt,name=desired_lrp,json=desiredLrp" json:"desired_lrp,omitempty"`
+}
+
+func (m *DesiredLRPRevision) Reset()      { *m = DesiredLRPRevision{} }
+func (*DesiredLRPRevision) ProtoMessage() {}
+func (*DesiredLRPRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptorDesiredLrpRevision, []int{0}
+}
+
+func (m *DesiredLRPRevision) GetProcessGuid() string {
+	if m != nil {
+		return m.ProcessGuid
+	}
+	return ""
+}
+
+func (m *DesiredLRPRevision) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *DesiredLRPRevision) GetModificationTag() *ModificationTag {
+	if m != nil {
+		return m.ModificationTag
+	}
+	return nil
+}
+
+func (m *DesiredLRPRevision) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *DesiredLRPRevision) GetDesiredLrp() *DesiredLRP {
+	if m != nil {
+		return m.DesiredLrp
+	}
+	return nil
+}
+
+type DesiredLRPRevisionsRequest struct {
+	ProcessGuid string `protobuf:"bytes,1,opt,name=process_guid,json=processGuid" json:"process_guid"`
+}
+
+func (m *DesiredLRPRevisionsRequest) Reset()      { *m = DesiredLRPRevisionsRequest{} }
+func (*DesiredLRPRevisionsRequest) ProtoMessage() {}
+func (*DesiredLRPRevisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorDesiredLrpRevision, []int{1}
+}
+
+func (m *DesiredLRPRevisionsRequest) GetProcessGuid() string {
+	if m != nil {
+		return m.ProcessGuid
+	}
+	return ""
+}
+
+type DesiredLRPRevisionsResponse struct {
+	Error     *Error                `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	Revisions []*DesiredLRPRevision `protobuf:"bytes,2,rep,name=revisions" json:"revisions,omitempty"`
+}
+
+func (m *DesiredLRPRevisionsResponse) Reset()      { *m = DesiredLRPRevisionsResponse{} }
+func (*DesiredLRPRevisionsResponse) ProtoMessage() {}
+func (*DesiredLRPRevisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorDesiredLrpRevision, []int{2}
+}
+
+func (m *DesiredLRPRevisionsResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *DesiredLRPRevisionsResponse) GetRevisions() []*DesiredLRPRevision {
+	if m != nil {
+		return m.Revisions
+	}
+	return nil
+}
+
+type DesiredLRPRevisionRequest struct {
+	ProcessGuid string `protobuf:"bytes,1,opt,name=process_guid,json=processGuid" json:"process_guid"`
+	Revision    int64  `protobuf:"varint,2,opt,name=revision" json:"revision"`
+}
+
+func (m *DesiredLRPRevisionRequest) Reset()      { *m = DesiredLRPRevisionRequest{} }
+func (*DesiredLRPRevisionRequest) ProtoMessage() {}
+func (*DesiredLRPRevisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorDesiredLrpRevision, []int{3}
+}
+
+func (m *DesiredLRPRevisionRequest) GetProcessGuid() string {
+	if m != nil {
+		return m.ProcessGuid
+	}
+	return ""
+}
+
+func (m *DesiredLRPRevisionRequest) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+type DesiredLRPRevisionResponse struct {
+	Error    *Error              `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	Revision *DesiredLRPRevision `protobuf:"bytes,2,opt,name=revision" json:"revision,omitempty"`
+}
+
+func (m *DesiredLRPRevisionResponse) Reset()      { *m = DesiredLRPRevisionResponse{} }
+func (*DesiredLRPRevisionResponse) ProtoMessage() {}
+func (*DesiredLRPRevisionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorDesiredLrpRevision, []int{4}
+}
+
+func (m *DesiredLRPRevisionResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *DesiredLRPRevisionResponse) GetRevision() *DesiredLRPRevision {
+	if m != nil {
+		return m.Revision
+	}
+	return nil
+}
+
+type RollbackDesiredLRPRequest struct {
+	ProcessGuid             string           `protobuf:"bytes,1,opt,name=process_guid,json=processGuid" json:"process_guid"`
+	Revision                int64            `protobuf:"varint,2,opt,name=revision" json:"revision"`
+	ExpectedModificationTag *ModificationTag `protobuf:"bytes,3,opt,name=expected_modification_tag,json=expectedModificationTag" json:"expected_modification_tag,omitempty"`
+}
+
+func (m *RollbackDesiredLRPRequest) Reset()      { *m = RollbackDesiredLRPRequest{} }
+func (*RollbackDesiredLRPRequest) ProtoMessage() {}
+func (*RollbackDesiredLRPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorDesiredLrpRevision, []int{5}
+}
+
+func (m *RollbackDesiredLRPRequest) GetProcessGuid() string {
+	if m != nil {
+		return m.ProcessGuid
+	}
+	return ""
+}
+
+func (m *RollbackDesiredLRPRequest) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *RollbackDesiredLRPRequest) GetExpectedModificationTag() *ModificationTag {
+	if m != nil {
+		return m.ExpectedModificationTag
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DesiredLRPRevision)(nil), "models.DesiredLRPRevision")
+	proto.RegisterType((*DesiredLRPRevisionsRequest)(nil), "models.DesiredLRPRevisionsRequest")
+	proto.RegisterType((*DesiredLRPRevisionsResponse)(nil), "models.DesiredLRPRevisionsResponse")
+	proto.RegisterType((*DesiredLRPRevisionRequest)(nil), "models.DesiredLRPRevisionRequest")
+	proto.RegisterType((*DesiredLRPRevisionResponse)(nil), "models.DesiredLRPRevisionResponse")
+	proto.RegisterType((*RollbackDesiredLRPRequest)(nil), "models.RollbackDesiredLRPRequest")
+}
+func (this *DesiredLRPRevision) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*DesiredLRPRevision)
+	if !ok {
+		that2, ok := that.(DesiredLRPRevision)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.ProcessGuid != that1.ProcessGuid {
+		return false
+	}
+	if this.Revision != that1.Revision {
+		return false
+	}
+	if !this.ModificationTag.Equal(that1.ModificationTag) {
+		return false
+	}
+	if this.CreatedAt != that1.CreatedAt {
+		return false
+	}
+	if !this.DesiredLrp.Equal(that1.DesiredLrp) {
+		return false
+	}
+	return true
+}
+func (this *DesiredLRPRevisionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*DesiredLRPRevisionsRequest)
+	if !ok {
+		that2, ok := that.(DesiredLRPRevisionsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.ProcessGuid != that1.ProcessGuid {
+		return false
+	}
+	return true
+}
+func (this *DesiredLRPRevisionsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*DesiredLRPRevisionsResponse)
+	if !ok {
+		that2, ok := that.(DesiredLRPRevisionsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if len(this.Revisions) != len(that1.Revisions) {
+		return false
+	}
+	for i := range this.Revisions {
+		if !this.Revisions[i].Equal(that1.Revisions[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DesiredLRPRevisionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*DesiredLRPRevisionRequest)
+	if !ok {
+		that2, ok := that.(DesiredLRPRevisionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.ProcessGuid != that1.ProcessGuid {
+		return false
+	}
+	if this.Revision != that1.Revision {
+		return false
+	}
+	return true
+}
+func (this *DesiredLRPRevisionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*DesiredLRPRevisionResponse)
+	if !ok {
+		that2, ok := that.(DesiredLRPRevisionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if !this.Revision.Equal(that1.Revision) {
+		return false
+	}
+	return true
+}
+func (this *RollbackDesiredLRPRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*RollbackDesiredLRPRequest)
+	if !ok {
+		that2, ok := that.(RollbackDesiredLRPRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.ProcessGuid != that1.ProcessGuid {
+		return false
+	}
+	if this.Revision != that1.Revision {
+		return false
+	}
+	if !this.ExpectedModificationTag.Equal(that1.ExpectedModificationTag) {
+		return false
+	}
+	return true
+}
+func (this *DesiredLRPRevision) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&models.DesiredLRPRevision{")
+	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	s = append(s, "Revision: "+fmt.Sprintf("%#v", this.Revision)+",\n")
+	if this.ModificationTag != nil {
+		s = append(s, "ModificationTag: "+fmt.Sprintf("%#v", this.ModificationTag)+",\n")
+	}
+	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
+	if this.DesiredLrp != nil {
+		s = append(s, "DesiredLrp: "+fmt.Sprintf("%#v", this.DesiredLrp)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DesiredLRPRevisionsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.DesiredLRPRevisionsRequest{")
+	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DesiredLRPRevisionsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.DesiredLRPRevisionsResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Revisions != nil {
+		s = append(s, "Revisions: "+fmt.Sprintf("%#v", this.Revisions)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DesiredLRPRevisionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.DesiredLRPRevisionRequest{")
+	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	s = append(s, "Revision: "+fmt.Sprintf("%#v", this.Revision)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DesiredLRPRevisionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.DesiredLRPRevisionResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Revision != nil {
+		s = append(s, "Revision: "+fmt.Sprintf("%#v", this.Revision)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RollbackDesiredLRPRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.RollbackDesiredLRPRequest{")
+	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	s = append(s, "Revision: "+fmt.Sprintf("%#v", this.Revision)+",\n")
+	if this.ExpectedModificationTag != nil {
+		s = append(s, "ExpectedModificationTag: "+fmt.Sprintf("%#v", this.ExpectedModificationTag)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringDesiredLrpRevision(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *DesiredLRPRevision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DesiredLRPRevision) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintDesiredLrpRevision(dAtA, i, uint64(len(m.ProcessGuid)))
+	i += copy(dAtA[i:], m.ProcessGuid)
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintDesiredLrpRevision(dAtA, i, uint64(m.Revision))
+	if m.ModificationTag != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintDesiredLrpRevision(dAtA, i, uint64(m.ModificationTag.Size()))
+		n1, err := m.ModificationTag.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintDesiredLrpRevision(dAtA, i, uint64(m.CreatedAt))
+	if m.DesiredLrp != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintDesiredLrpRevision(dAtA, i, uint64(m.DesiredLrp.Size()))
+		n2, err := m.DesiredLrp.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	return i, nil
+}
+
+func (m *DesiredLRPRevisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DesiredLRPRevisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintDesiredLrpRevision(dAtA, i, uint64(len(m.ProcessGuid)))
+	i += copy(dAtA[i:], m.ProcessGuid)
+	return i, nil
+}
+
+func (m *DesiredLRPRevisionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DesiredLRPRevisionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDesiredLrpRevision(dAtA, i, uint64(m.Error.Size()))
+		n3, err := m.Error.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if len(m.Revisions) > 0 {
+		for _, msg := range m.Revisions {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintDesiredLrpRevision(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *DesiredLRPRevisionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DesiredLRPRevisionRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintDesiredLrpRevision(dAtA, i, uint64(len(m.ProcessGuid)))
+	i += copy(dAtA[i:], m.ProcessGuid)
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintDesiredLrpRevision(dAtA, i, uint64(m.Revision))
+	return i, nil
+}
+
+func (m *DesiredLRPRevisionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DesiredLRPRevisionResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDesiredLrpRevision(dAtA, i, uint64(m.Error.Size()))
+		n4, err := m.Error.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.Revision != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintDesiredLrpRevision(dAtA, i, uint64(m.Revision.Size()))
+		n5, err := m.Revision.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	return i, nil
+}
+
+func (m *RollbackDesiredLRPRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackDesiredLRPRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintDesiredLrpRevision(dAtA, i, uint64(len(m.ProcessGuid)))
+	i += copy(dAtA[i:], m.ProcessGuid)
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintDesiredLrpRevision(dAtA, i, uint64(m.Revision))
+	if m.ExpectedModificationTag != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintDesiredLrpRevision(dAtA, i, uint64(m.ExpectedModificationTag.Size()))
+		n6, err := m.ExpectedModificationTag.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	return i, nil
+}
+
+func encodeFixed64DesiredLrpRevision(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32DesiredLrpRevision(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintDesiredLrpRevision(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *DesiredLRPRevision) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ProcessGuid)
+	n += 1 + l + sovDesiredLrpRevision(uint64(l))
+	n += 1 + sovDesiredLrpRevision(uint64(m.Revision))
+	if m.ModificationTag != nil {
+		l = m.ModificationTag.Size()
+		n += 1 + l + sovDesiredLrpRevision(uint64(l))
+	}
+	n += 1 + sovDesiredLrpRevision(uint64(m.CreatedAt))
+	if m.DesiredLrp != nil {
+		l = m.DesiredLrp.Size()
+		n += 1 + l + sovDesiredLrpRevision(uint64(l))
+	}
+	return n
+}
+
+func (m *DesiredLRPRevisionsRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ProcessGuid)
+	n += 1 + l + sovDesiredLrpRevision(uint64(l))
+	return n
+}
+
+func (m *DesiredLRPRevisionsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovDesiredLrpRevision(uint64(l))
+	}
+	if len(m.Revisions) > 0 {
+		for _, e := range m.Revisions {
+			l = e.Size()
+			n += 1 + l + sovDesiredLrpRevision(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DesiredLRPRevisionRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ProcessGuid)
+	n += 1 + l + sovDesiredLrpRevision(uint64(l))
+	n += 1 + sovDesiredLrpRevision(uint64(m.Revision))
+	return n
+}
+
+func (m *DesiredLRPRevisionResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovDesiredLrpRevision(uint64(l))
+	}
+	if m.Revision != nil {
+		l = m.Revision.Size()
+		n += 1 + l + sovDesiredLrpRevision(uint64(l))
+	}
+	return n
+}
+
+func (m *RollbackDesiredLRPRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ProcessGuid)
+	n += 1 + l + sovDesiredLrpRevision(uint64(l))
+	n += 1 + sovDesiredLrpRevision(uint64(m.Revision))
+	if m.ExpectedModificationTag != nil {
+		l = m.ExpectedModificationTag.Size()
+		n += 1 + l + sovDesiredLrpRevision(uint64(l))
+	}
+	return n
+}
+
+func sovDesiredLrpRevision(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozDesiredLrpRevision(x uint64) (n int) {
+	return sovDesiredLrpRevision(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *DesiredLRPRevision) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DesiredLRPRevision{`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`ModificationTag:` + strings.Replace(fmt.Sprintf("%v", this.ModificationTag), "ModificationTag", "ModificationTag", 1) + `,`,
+		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
+		`DesiredLrp:` + strings.Replace(fmt.Sprintf("%v", this.DesiredLrp), "DesiredLRP", "DesiredLRP", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DesiredLRPRevisionsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DesiredLRPRevisionsRequest{`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DesiredLRPRevisionsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DesiredLRPRevisionsResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Revisions:` + strings.Replace(fmt.Sprintf("%v", this.Revisions), "DesiredLRPRevision", "DesiredLRPRevision", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DesiredLRPRevisionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DesiredLRPRevisionRequest{`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DesiredLRPRevisionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DesiredLRPRevisionResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Revision:` + strings.Replace(fmt.Sprintf("%v", this.Revision), "DesiredLRPRevision", "DesiredLRPRevision", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RollbackDesiredLRPRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RollbackDesiredLRPRequest{`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`ExpectedModificationTag:` + strings.Replace(fmt.Sprintf("%v", this.ExpectedModificationTag), "ModificationTag", "ModificationTag", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringDesiredLrpRevision(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *DesiredLRPRevision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpRevision
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesiredLRPRevision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesiredLRPRevision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModificationTag", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ModificationTag == nil {
+				m.ModificationTag = &ModificationTag{}
+			}
+			if err := m.ModificationTag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredLrp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DesiredLrp == nil {
+				m.DesiredLrp = &DesiredLRP{}
+			}
+			if err := m.DesiredLrp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpRevision(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DesiredLRPRevisionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpRevision
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesiredLRPRevisionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesiredLRPRevisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpRevision(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DesiredLRPRevisionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpRevision
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesiredLRPRevisionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesiredLRPRevisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, &DesiredLRPRevision{})
+			if err := m.Revisions[len(m.Revisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpRevision(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DesiredLRPRevisionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpRevision
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesiredLRPRevisionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesiredLRPRevisionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpRevision(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DesiredLRPRevisionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpRevision
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesiredLRPRevisionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesiredLRPRevisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Revision == nil {
+				m.Revision = &DesiredLRPRevision{}
+			}
+			if err := m.Revision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpRevision(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RollbackDesiredLRPRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpRevision
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollbackDesiredLRPRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollbackDesiredLRPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedModificationTag", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpectedModificationTag == nil {
+				m.ExpectedModificationTag = &ModificationTag{}
+			}
+			if err := m.ExpectedModificationTag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpRevision(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDesiredLrpRevision
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDesiredLrpRevision(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDesiredLrpRevision
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDesiredLrpRevision
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDesiredLrpRevision
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthDesiredLrpRevision
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowDesiredLrpRevision
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipDesiredLrpRevision(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthDesiredLrpRevision = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDesiredLrpRevision   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("desired_lrp_revision.proto", fileDescriptorDesiredLrpRevision) }
+
+var fileDescriptorDesiredLrpRevision = []byte{
+	// 440 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xac, 0x52, 0xc1, 0x8a, 0xd4, 0x40,
+	0x10, 0x4d, 0xef, 0xec, 0x8a, 0x53, 0x51, 0xd4, 0x3e, 0xb8, 0x99, 0x08, 0x6d, 0xc8, 0x1e, 0xcc,
+	0x41, 0xb3, 0x30, 0x82, 0x78, 0x75, 0x70, 0xf1, 0xb2, 0x82, 0xb4, 0xde, 0x43, 0x26, 0xe9, 0x89,
+	0x8d, 0xc9, 0x74, 0xec, 0xee, 0x88, 0x82, 0x07, 0x3f, 0xc1, 0xcf, 0xf0, 0x1f, 0xfc, 0x81, 0x3d,
+	0xee, 0xd1, 0x93, 0x38, 0xf1, 0xe2, 0x71, 0x7f, 0x40, 0x90, 0x4d, 0x3a, 0x4e, 0x1c, 0x83, 0x3a,
+	0xe0, 0x2d, 0xf5, 0xde, 0xab, 0x7a, 0xf5, 0x2a, 0x0d, 0x6e, 0xca, 0x14, 0x97, 0x2c, 0x8d, 0x72,
+	0x59, 0x46, 0x92, 0xbd, 0xe2, 0x8a, 0x8b, 0x65, 0x58, 0x4a, 0xa1, 0x05, 0xbe, 0x50, 0x88, 0x94,
+	0xe5, 0xca, 0xbd, 0x93, 0x71, 0xfd, 0xbc, 0x9a, 0x87, 0x89, 0x28, 0x0e, 0x33, 0x91, 0x89, 0xc3,
+	0x86, 0x9e, 0x57, 0x8b, 0xa6, 0x6a, 0x8a, 0xe6, 0xab, 0x6d, 0x73, 0xaf, 0xf5, 0x46, 0x1a, 0xc8,
+	0x66, 0x52, 0x0a, 0x69, 0x8a, 0xeb, 0x85, 0x48, 0xf9, 0x82, 0x27, 0xb1, 0xe6, 0x62, 0x19, 0xe9,
+	0x38, 0x6b, 0x71, 0xff, 0x3b, 0x02, 0xfc, 0xb0, 0x6d, 0x3d, 0xa6, 0x4f, 0xa8, 0xd9, 0x05, 0xdf,
+	0x82, 0x4b, 0xa5, 0x14, 0x09, 0x53, 0x2a, 0xca, 0x2a, 0x9e, 0x3a, 0xc8, 0x43, 0xc1, 0x78, 0xb6,
+	0x7b, 0xf2, 0xf9, 0xa6, 0x45, 0x6d, 0xc3, 0x3c, 0xaa, 0x78, 0x8a, 0x3d, 0xb8, 0xd8, 0x05, 0x70,
+	0x76, 0x3c, 0x14, 0x8c, 0x8c, 0xe8, 0x27, 0x8a, 0x67, 0x70, 0x75, 0xd3, 0xdb, 0x19, 0x79, 0x28,
+	0xb0, 0xa7, 0xfb, 0x61, 0x9b, 0x35, 0x7c, 0xdc, 0xe3, 0x9f, 0xc5, 0x19, 0xbd, 0x52, 0xfc, 0x0a,
+	0xe0, 0x03, 0x80, 0x44, 0xb2, 0x58, 0xb3, 0x34, 0x8a, 0xb5, 0xb3, 0xdb, 0xf3, 0x19, 0x1b, 0xfc,
+	0x81, 0xc6, 0x77, 0xc1, 0xee, 0x1d, 0xc1, 0xd9, 0x6b, 0x3c, 0x70, 0xe7, 0xd1, 0x0b, 0x09, 0x46,
+	0x76, 0x2c, 0x4b, 0xff, 0x08, 0xdc, 0xdf, 0xe3, 0x2b, 0xca, 0x5e, 0x56, 0x4c, 0xe9, 0x7f, 0x3e,
+	0x83, 0xff, 0x16, 0x6e, 0x0c, 0x8e, 0x51, 0xa5, 0x58, 0x2a, 0x86, 0x0f, 0x60, 0xaf, 0xf9, 0x19,
+	0xcd, 0x00, 0x7b, 0x7a, 0xb9, 0x5b, 0xea, 0xe8, 0x1c, 0xa4, 0x2d, 0x87, 0xef, 0xc3, 0xb8, 0x3b,
+	0x9a, 0x72, 0x76, 0xbc, 0x51, 0x60, 0x4f, 0xdd, 0x81, 0xed, 0x8d, 0x84, 0xae, 0xc5, 0xfe, 0x02,
+	0x26, 0x03, 0x82, 0x2d, 0x33, 0xfc, 0xfd, 0x57, 0xfa, 0x6f, 0x86, 0x8e, 0xb5, 0x5d, 0xc8, 0x7b,
+	0x1b, 0x26, 0x7f, 0xce, 0xb8, 0xb6, 0xfe, 0x88, 0x60, 0x42, 0x45, 0x9e, 0xcf, 0xe3, 0xe4, 0x45,
+	0x5f, 0xf8, 0xbf, 0x33, 0xe2, 0xa7, 0x30, 0x61, 0xaf, 0x4b, 0x96, 0x9c, 0xbf, 0xb5, 0x6d, 0xdf,
+	0xed, 0x7e, 0xd7, 0xb9, 0x41, 0xcc, 0x6e, 0x9f, 0xae, 0x88, 0xf5, 0x69, 0x45, 0xac, 0xb3, 0x15,
+	0x41, 0xef, 0x6a, 0x82, 0x3e, 0xd4, 0x04, 0x9d, 0xd4, 0x04, 0x9d, 0xd6, 0x04, 0x7d, 0xa9, 0x09,
+	0xfa, 0x56, 0x13, 0xeb, 0xac, 0x26, 0xe8, 0xfd, 0x57, 0x62, 0xfd, 0x08, 0x00, 0x00, 0xff, 0xff,
+	0x06, 0x10, 0xd0, 0x6f, 0x1f, 0x04, 0x00, 0x00,
+}
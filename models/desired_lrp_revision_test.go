@@ -0,0 +1,79 @@
+package models_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DesiredLRPRevision", func() {
+	Describe("DesiredLRPUpdate", func() {
+		var revision *models.DesiredLRPRevision
+
+		BeforeEach(func() {
+			desiredLRP := model_helpers.NewValidDesiredLRP("some-guid")
+			revision = &models.DesiredLRPRevision{ProcessGuid: "some-guid", Revision: 3, DesiredLrp: desiredLRP}
+		})
+
+		It("reapplies the instances, routes and annotation of the revision", func() {
+			update := revision.DesiredLRPUpdate()
+			Expect(*update.Instances).To(Equal(revision.DesiredLrp.Instances))
+			Expect(*update.Annotation).To(Equal(revision.DesiredLrp.Annotation))
+			Expect(update.Routes).To(Equal(revision.DesiredLrp.Routes))
+		})
+
+		Context("when the revision has no routes", func() {
+			BeforeEach(func() {
+				revision.DesiredLrp.Routes = nil
+			})
+
+			It("clears the routes", func() {
+				Expect(revision.DesiredLRPUpdate().Routes).To(Equal(&models.Routes{}))
+			})
+		})
+	})
+
+	Describe("DesiredLRPRevisionsRequest", func() {
+		Describe("Validate", func() {
+			It("requires a process guid", func() {
+				request := models.DesiredLRPRevisionsRequest{}
+				Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"process_guid"}))
+
+				request.ProcessGuid = "some-guid"
+				Expect(request.Validate()).To(BeNil())
+			})
+		})
+	})
+
+	Describe("DesiredLRPRevisionRequest", func() {
+		Describe("Validate", func() {
+			It("requires a process guid and a positive revision", func() {
+				request := models.DesiredLRPRevisionRequest{}
+				Expect(request.Validate()).To(ConsistOf(
+					models.ErrInvalidField{"process_guid"},
+					models.ErrInvalidField{"revision"},
+				))
+
+				request = models.DesiredLRPRevisionRequest{ProcessGuid: "some-guid", Revision: 1}
+				Expect(request.Validate()).To(BeNil())
+			})
+		})
+	})
+
+	Describe("RollbackDesiredLRPRequest", func() {
+		Describe("Validate", func() {
+			It("requires a process guid and a positive revision", func() {
+				request := models.RollbackDesiredLRPRequest{Revision: -1}
+				Expect(request.Validate()).To(ConsistOf(
+					models.ErrInvalidField{"process_guid"},
+					models.ErrInvalidField{"revision"},
+				))
+
+				request = models.RollbackDesiredLRPRequest{ProcessGuid: "some-guid", Revision: 2}
+				Expect(request.Validate()).To(BeNil())
+			})
+		})
+	})
+})
@@ -0,0 +1,58 @@
+package models
+
+import "code.cloudfoundry.org/bbs/format"
+
+const (
+	DesiredLRPRolloutStateInProgress = "IN_PROGRESS"
+	DesiredLRPRolloutStateComplete   = "COMPLETE"
+	DesiredLRPRolloutStateFailed     = "FAILED"
+)
+
+func (*DesiredLRPRollout) Version() format.Version {
+	return format.V0
+}
+
+func (rollout *DesiredLRPRollout) Validate() error {
+	var validationError ValidationError
+
+	if rollout.ProcessGuid == "" {
+		validationError = validationError.Append(ErrInvalidField{"process_guid"})
+	}
+
+	switch rollout.State {
+	case DesiredLRPRolloutStateInProgress, DesiredLRPRolloutStateComplete, DesiredLRPRolloutStateFailed:
+	default:
+		validationError = validationError.Append(ErrInvalidField{"state"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+// Replaced reports whether the instance at index has already been replaced
+// by the rollout.
+func (rollout *DesiredLRPRollout) Replaced(index int32) bool {
+	for _, replaced := range rollout.ReplacedIndices {
+		if replaced == index {
+			return true
+		}
+	}
+	return false
+}
+
+func (request *DesiredLRPRolloutRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.ProcessGuid == "" {
+		validationError = validationError.Append(ErrInvalidField{"process_guid"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
@@ -0,0 +1,1184 @@
+// Code generated by protoc-gen-gogo.
+// source: desired_lrp_rollout.proto
+// DO NOT EDIT!
+
+package models
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+
+import strings "strings"
+import reflect "reflect"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type DesiredLRPRollout struct {
+	ProcessGuid     string           `protobuf:"bytes,1,opt,name=process_guid,json=processGuid" json:"process_guid"`
+	ModificationTag *ModificationTag `protobuf:"bytes,2,opt,name=modification_tag,json=modificationTag" json:"modification_tag,omitempty"`
+	MaxInFlight     int32            `protobuf:"varint,3,opt,name=max_in_flight,json=maxInFlight" json:"max_in_flight"`
+	StartedAt       int64            `protobuf:"varint,4,opt,name=started_at,json=startedAt" json:"started_at"`
+	Deadline        int64            `protobuf:"varint,5,opt,name=deadline" json:"deadline"`
+	ReplacedIndices []int32          `protobuf:"varint,6,rep,name=replaced_indices,json=replacedIndices" json:"replaced_indices,omitempty"`
+	State           string           `protobuf:"bytes,7,opt,name=state" json:"state"`
+	FailureReason   string           `protobuf:"bytes,8,opt,name=failure_reason,json=failureReason" json:"failure_reason"`
+}
+
+func (m *DesiredLRPRollout) Reset()      { *m = DesiredLRPRollout{} }
+func (*DesiredLRPRollout) ProtoMessage() {}
+func (*DesiredLRPRollout) Descriptor() ([]byte, []int) {
+	return fileDescriptorDesiredLrpRollout, []int{0}
+}
+
+func (m *DesiredLRPRollout) GetProcessGuid() string {
+	if m != nil {
+		return m.ProcessGuid
+	}
+	return ""
+}
+
+func (m *DesiredLRPRollout) GetModificationTag() *ModificationTag {
+	if m != nil {
+		return m.ModificationTag
+	}
+	return nil
+}
+
+func (m *DesiredLRPRollout) GetMaxInFlight() int32 {
+	if m != nil {
+		return m.MaxInFlight
+	}
+	return 0
+}
+
+func (m *DesiredLRPRollout) GetStartedAt() int64 {
+	if m != nil {
+		return m.StartedAt
+	}
+	return 0
+}
+
+func (m *DesiredLRPRollout) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+func (m *DesiredLRPRollout) GetReplacedIndices() []int32 {
+	if m != nil {
+		return m.ReplacedIndices
+	}
+	return nil
+}
+
+func (m *DesiredLRPRollout) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *DesiredLRPRollout) GetFailureReason() string {
+	if m != nil {
+		return m.FailureReason
+	}
+	return ""
+}
+
+type DesiredLRPRolloutRequest struct {
+	ProcessGuid string `protobuf:"bytes,1,opt,name=process_guid,json=processGuid" json:"process_guid"`
+}
+
+func (m *DesiredLRPRolloutRequest) Reset()      { *m = DesiredLRPRolloutRequest{} }
+func (*DesiredLRPRolloutRequest) ProtoMessage() {}
+func (*DesiredLRPRolloutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorDesiredLrpRollout, []int{1}
+}
+
+func (m *DesiredLRPRolloutRequest) GetProcessGuid() string {
+	if m != nil {
+		return m.ProcessGuid
+	}
+	return ""
+}
+
+type DesiredLRPRolloutResponse struct {
+	Error   *Error             `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	Rollout *DesiredLRPRollout `protobuf:"bytes,2,opt,name=rollout" json:"rollout,omitempty"`
+}
+
+func (m *DesiredLRPRolloutResponse) Reset()      { *m = DesiredLRPRolloutResponse{} }
+func (*DesiredLRPRolloutResponse) ProtoMessage() {}
+func (*DesiredLRPRolloutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorDesiredLrpRollout, []int{2}
+}
+
+func (m *DesiredLRPRolloutResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *DesiredLRPRolloutResponse) GetRollout() *DesiredLRPRollout {
+	if m != nil {
+		return m.Rollout
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DesiredLRPRollout)(nil), "models.DesiredLRPRollout")
+	proto.RegisterType((*DesiredLRPRolloutRequest)(nil), "models.DesiredLRPRolloutRequest")
+	proto.RegisterType((*DesiredLRPRolloutResponse)(nil), "models.DesiredLRPRolloutResponse")
+}
+func (this *DesiredLRPRollout) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*DesiredLRPRollout)
+	if !ok {
+		that2, ok := that.(DesiredLRPRollout)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.ProcessGuid != that1.ProcessGuid {
+		return false
+	}
+	if !this.ModificationTag.Equal(that1.ModificationTag) {
+		return false
+	}
+	if this.MaxInFlight != that1.MaxInFlight {
+		return false
+	}
+	if this.StartedAt != that1.StartedAt {
+		return false
+	}
+	if this.Deadline != that1.Deadline {
+		return false
+	}
+	if len(this.ReplacedIndices) != len(that1.ReplacedIndices) {
+		return false
+	}
+	for i := range this.ReplacedIndices {
+		if this.ReplacedIndices[i] != that1.ReplacedIndices[i] {
+			return false
+		}
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if this.FailureReason != that1.FailureReason {
+		return false
+	}
+	return true
+}
+func (this *DesiredLRPRolloutRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*DesiredLRPRolloutRequest)
+	if !ok {
+		that2, ok := that.(DesiredLRPRolloutRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.ProcessGuid != that1.ProcessGuid {
+		return false
+	}
+	return true
+}
+func (this *DesiredLRPRolloutResponse) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*DesiredLRPRolloutResponse)
+	if !ok {
+		that2, ok := that.(DesiredLRPRolloutResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if !this.Rollout.Equal(that1.Rollout) {
+		return false
+	}
+	return true
+}
+func (this *DesiredLRPRollout) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&models.DesiredLRPRollout{")
+	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	if this.ModificationTag != nil {
+		s = append(s, "ModificationTag: "+fmt.Sprintf("%#v", this.ModificationTag)+",\n")
+	}
+	s = append(s, "MaxInFlight: "+fmt.Sprintf("%#v", this.MaxInFlight)+",\n")
+	s = append(s, "StartedAt: "+fmt.Sprintf("%#v", this.StartedAt)+",\n")
+	s = append(s, "Deadline: "+fmt.Sprintf("%#v", this.Deadline)+",\n")
+	if this.ReplacedIndices != nil {
+		s = append(s, "ReplacedIndices: "+fmt.Sprintf("%#v", this.ReplacedIndices)+",\n")
+	}
+	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	s = append(s, "FailureReason: "+fmt.Sprintf("%#v", this.FailureReason)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DesiredLRPRolloutRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.DesiredLRPRolloutRequest{")
+	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DesiredLRPRolloutResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.DesiredLRPRolloutResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Rollout != nil {
+		s = append(s, "Rollout: "+fmt.Sprintf("%#v", this.Rollout)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringDesiredLrpRollout(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *DesiredLRPRollout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DesiredLRPRollout) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintDesiredLrpRollout(dAtA, i, uint64(len(m.ProcessGuid)))
+	i += copy(dAtA[i:], m.ProcessGuid)
+	if m.ModificationTag != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintDesiredLrpRollout(dAtA, i, uint64(m.ModificationTag.Size()))
+		n1, err := m.ModificationTag.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintDesiredLrpRollout(dAtA, i, uint64(m.MaxInFlight))
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintDesiredLrpRollout(dAtA, i, uint64(m.StartedAt))
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintDesiredLrpRollout(dAtA, i, uint64(m.Deadline))
+	if len(m.ReplacedIndices) > 0 {
+		for _, num := range m.ReplacedIndices {
+			dAtA[i] = 0x30
+			i++
+			i = encodeVarintDesiredLrpRollout(dAtA, i, uint64(num))
+		}
+	}
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintDesiredLrpRollout(dAtA, i, uint64(len(m.State)))
+	i += copy(dAtA[i:], m.State)
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintDesiredLrpRollout(dAtA, i, uint64(len(m.FailureReason)))
+	i += copy(dAtA[i:], m.FailureReason)
+	return i, nil
+}
+
+func (m *DesiredLRPRolloutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DesiredLRPRolloutRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintDesiredLrpRollout(dAtA, i, uint64(len(m.ProcessGuid)))
+	i += copy(dAtA[i:], m.ProcessGuid)
+	return i, nil
+}
+
+func (m *DesiredLRPRolloutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DesiredLRPRolloutResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDesiredLrpRollout(dAtA, i, uint64(m.Error.Size()))
+		n2, err := m.Error.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.Rollout != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintDesiredLrpRollout(dAtA, i, uint64(m.Rollout.Size()))
+		n3, err := m.Rollout.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	return i, nil
+}
+
+func encodeFixed64DesiredLrpRollout(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32DesiredLrpRollout(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintDesiredLrpRollout(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *DesiredLRPRollout) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ProcessGuid)
+	n += 1 + l + sovDesiredLrpRollout(uint64(l))
+	if m.ModificationTag != nil {
+		l = m.ModificationTag.Size()
+		n += 1 + l + sovDesiredLrpRollout(uint64(l))
+	}
+	n += 1 + sovDesiredLrpRollout(uint64(m.MaxInFlight))
+	n += 1 + sovDesiredLrpRollout(uint64(m.StartedAt))
+	n += 1 + sovDesiredLrpRollout(uint64(m.Deadline))
+	if len(m.ReplacedIndices) > 0 {
+		for _, e := range m.ReplacedIndices {
+			n += 1 + sovDesiredLrpRollout(uint64(e))
+		}
+	}
+	l = len(m.State)
+	n += 1 + l + sovDesiredLrpRollout(uint64(l))
+	l = len(m.FailureReason)
+	n += 1 + l + sovDesiredLrpRollout(uint64(l))
+	return n
+}
+
+func (m *DesiredLRPRolloutRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ProcessGuid)
+	n += 1 + l + sovDesiredLrpRollout(uint64(l))
+	return n
+}
+
+func (m *DesiredLRPRolloutResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovDesiredLrpRollout(uint64(l))
+	}
+	if m.Rollout != nil {
+		l = m.Rollout.Size()
+		n += 1 + l + sovDesiredLrpRollout(uint64(l))
+	}
+	return n
+}
+
+func sovDesiredLrpRollout(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozDesiredLrpRollout(x uint64) (n int) {
+	return sovDesiredLrpRollout(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *DesiredLRPRollout) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DesiredLRPRollout{`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`ModificationTag:` + strings.Replace(fmt.Sprintf("%v", this.ModificationTag), "ModificationTag", "ModificationTag", 1) + `,`,
+		`MaxInFlight:` + fmt.Sprintf("%v", this.MaxInFlight) + `,`,
+		`StartedAt:` + fmt.Sprintf("%v", this.StartedAt) + `,`,
+		`Deadline:` + fmt.Sprintf("%v", this.Deadline) + `,`,
+		`ReplacedIndices:` + fmt.Sprintf("%v", this.ReplacedIndices) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`FailureReason:` + fmt.Sprintf("%v", this.FailureReason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DesiredLRPRolloutRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DesiredLRPRolloutRequest{`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DesiredLRPRolloutResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DesiredLRPRolloutResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Rollout:` + strings.Replace(fmt.Sprintf("%v", this.Rollout), "DesiredLRPRollout", "DesiredLRPRollout", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringDesiredLrpRollout(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *DesiredLRPRollout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpRollout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesiredLRPRollout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesiredLRPRollout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModificationTag", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRollout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ModificationTag == nil {
+				m.ModificationTag = &ModificationTag{}
+			}
+			if err := m.ModificationTag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInFlight", wireType)
+			}
+			m.MaxInFlight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInFlight |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			m.StartedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDesiredLrpRollout
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ReplacedIndices = append(m.ReplacedIndices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDesiredLrpRollout
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthDesiredLrpRollout
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDesiredLrpRollout
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ReplacedIndices = append(m.ReplacedIndices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplacedIndices", wireType)
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpRollout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDesiredLrpRollout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DesiredLRPRolloutRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpRollout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesiredLRPRolloutRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesiredLRPRolloutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpRollout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDesiredLrpRollout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DesiredLRPRolloutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrpRollout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesiredLRPRolloutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesiredLRPRolloutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRollout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRollout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rollout == nil {
+				m.Rollout = &DesiredLRPRollout{}
+			}
+			if err := m.Rollout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrpRollout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDesiredLrpRollout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDesiredLrpRollout(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDesiredLrpRollout
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDesiredLrpRollout
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDesiredLrpRollout
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthDesiredLrpRollout
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowDesiredLrpRollout
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipDesiredLrpRollout(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthDesiredLrpRollout = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDesiredLrpRollout   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("desired_lrp_rollout.proto", fileDescriptorDesiredLrpRollout) }
+
+var fileDescriptorDesiredLrpRollout = []byte{
+	// 436 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x6e, 0xd4, 0x30,
+	0x10, 0xc6, 0xe3, 0x6e, 0xd3, 0x3f, 0x5e, 0x96, 0x16, 0x1f, 0xc0, 0xbb, 0x07, 0x13, 0x6d, 0x0f,
+	0x04, 0x01, 0x5b, 0xa9, 0x3c, 0x01, 0xcb, 0x3f, 0x55, 0x02, 0x09, 0x45, 0xdc, 0x2d, 0x37, 0x9e,
+	0x4d, 0x2d, 0x25, 0x71, 0xb0, 0x1d, 0xa9, 0x47, 0x1e, 0x81, 0xc7, 0xe0, 0x51, 0x7a, 0xec, 0x91,
+	0x13, 0x62, 0x83, 0x90, 0x38, 0xf6, 0x11, 0x10, 0x71, 0x82, 0x02, 0xcb, 0xa1, 0xb7, 0xcc, 0xef,
+	0xfb, 0x66, 0x26, 0xfa, 0xc6, 0x78, 0x2a, 0xc1, 0x2a, 0x03, 0x92, 0xe7, 0xa6, 0xe2, 0x46, 0xe7,
+	0xb9, 0xae, 0xdd, 0xa2, 0x32, 0xda, 0x69, 0xb2, 0x53, 0x68, 0x09, 0xb9, 0x9d, 0x3d, 0xc9, 0x94,
+	0x3b, 0xaf, 0xcf, 0x16, 0xa9, 0x2e, 0x8e, 0x33, 0x9d, 0xe9, 0xe3, 0x56, 0x3e, 0xab, 0x57, 0x6d,
+	0xd5, 0x16, 0xed, 0x97, 0x6f, 0x9b, 0x8d, 0xc1, 0x18, 0x6d, 0xba, 0xe2, 0x6e, 0xa1, 0xa5, 0x5a,
+	0xa9, 0x54, 0x38, 0xa5, 0x4b, 0xee, 0x44, 0xe6, 0xf9, 0xfc, 0xc7, 0x16, 0xbe, 0xf3, 0xc2, 0x6f,
+	0x7e, 0x93, 0xbc, 0x4b, 0xfc, 0x5e, 0xf2, 0x00, 0xdf, 0xaa, 0x8c, 0x4e, 0xc1, 0x5a, 0x9e, 0xd5,
+	0x4a, 0x52, 0x14, 0xa1, 0x78, 0x7f, 0xb9, 0x7d, 0xf9, 0xf5, 0x7e, 0x90, 0x8c, 0x3b, 0xe5, 0x75,
+	0xad, 0x24, 0x59, 0xe2, 0xc3, 0x7f, 0x07, 0xd3, 0xad, 0x08, 0xc5, 0xe3, 0x93, 0x7b, 0x0b, 0xff,
+	0xd7, 0x8b, 0xb7, 0x03, 0xfd, 0xbd, 0xc8, 0x92, 0x83, 0xe2, 0x6f, 0x40, 0x62, 0x3c, 0x29, 0xc4,
+	0x05, 0x57, 0x25, 0x5f, 0xe5, 0x2a, 0x3b, 0x77, 0x74, 0x14, 0xa1, 0x38, 0xec, 0xb7, 0x15, 0xe2,
+	0xe2, 0xb4, 0x7c, 0xd5, 0x0a, 0xe4, 0x08, 0x63, 0xeb, 0x84, 0x71, 0x20, 0xb9, 0x70, 0x74, 0x3b,
+	0x42, 0xf1, 0xa8, 0xb3, 0xed, 0x77, 0xfc, 0x99, 0x23, 0x11, 0xde, 0x93, 0x20, 0x64, 0xae, 0x4a,
+	0xa0, 0xe1, 0xc0, 0xf2, 0x87, 0x92, 0x87, 0xf8, 0xd0, 0x40, 0x95, 0x8b, 0x14, 0x24, 0x57, 0xa5,
+	0x54, 0x29, 0x58, 0xba, 0x13, 0x8d, 0xe2, 0x30, 0x39, 0xe8, 0xf9, 0xa9, 0xc7, 0x64, 0x86, 0x43,
+	0xeb, 0x84, 0x03, 0xba, 0x3b, 0x48, 0xc0, 0x23, 0xf2, 0x08, 0xdf, 0x5e, 0x09, 0x95, 0xd7, 0x06,
+	0xb8, 0x01, 0x61, 0x75, 0x49, 0xf7, 0x06, 0xa6, 0x49, 0xa7, 0x25, 0xad, 0x34, 0x7f, 0x8e, 0xe9,
+	0x46, 0xcc, 0x09, 0x7c, 0xa8, 0xc1, 0xde, 0x3c, 0xed, 0x79, 0x8d, 0xa7, 0xff, 0x19, 0x62, 0x2b,
+	0x5d, 0x5a, 0x20, 0x47, 0x38, 0x6c, 0x0f, 0xde, 0xb6, 0x8f, 0x4f, 0x26, 0x7d, 0xfe, 0x2f, 0x7f,
+	0xc3, 0xc4, 0x6b, 0xe4, 0x29, 0xde, 0xed, 0xde, 0x56, 0x77, 0xa6, 0x69, 0x6f, 0xdb, 0x1c, 0xdc,
+	0x3b, 0x97, 0x8f, 0xaf, 0xd6, 0x2c, 0xf8, 0xb2, 0x66, 0xc1, 0xf5, 0x9a, 0xa1, 0x8f, 0x0d, 0x43,
+	0x9f, 0x1b, 0x86, 0x2e, 0x1b, 0x86, 0xae, 0x1a, 0x86, 0xbe, 0x35, 0x0c, 0xfd, 0x6c, 0x58, 0x70,
+	0xdd, 0x30, 0xf4, 0xe9, 0x3b, 0x0b, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0xda, 0x60, 0x64, 0x64,
+	0xc9, 0x02, 0x00, 0x00,
+}
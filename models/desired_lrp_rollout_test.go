@@ -0,0 +1,62 @@
+package models_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DesiredLRPRollout", func() {
+	var rollout *models.DesiredLRPRollout
+
+	BeforeEach(func() {
+		rollout = &models.DesiredLRPRollout{
+			ProcessGuid:     "some-guid",
+			State:           models.DesiredLRPRolloutStateInProgress,
+			ReplacedIndices: []int32{0, 2},
+		}
+	})
+
+	Describe("Validate", func() {
+		It("is valid", func() {
+			Expect(rollout.Validate()).To(Succeed())
+		})
+
+		It("requires a process guid", func() {
+			rollout.ProcessGuid = ""
+			err := rollout.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("process_guid"))
+		})
+
+		It("requires a known state", func() {
+			rollout.State = "SOMETIMES"
+			err := rollout.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("state"))
+		})
+	})
+
+	Describe("Replaced", func() {
+		It("reports the replaced indices", func() {
+			Expect(rollout.Replaced(0)).To(BeTrue())
+			Expect(rollout.Replaced(1)).To(BeFalse())
+			Expect(rollout.Replaced(2)).To(BeTrue())
+		})
+	})
+
+	Describe("DesiredLRPRolloutRequest", func() {
+		Describe("Validate", func() {
+			It("requires a process guid", func() {
+				request := models.DesiredLRPRolloutRequest{}
+				err := request.Validate()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("process_guid"))
+
+				request.ProcessGuid = "some-guid"
+				Expect(request.Validate()).To(Succeed())
+			})
+		})
+	})
+})
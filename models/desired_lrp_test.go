@@ -388,6 +388,86 @@ var _ = Describe("DesiredLRP", func() {
 		})
 	})
 
+	Describe("ApplyRedeploy", func() {
+		var runInfo models.DesiredLRPRunInfo
+
+		BeforeEach(func() {
+			runInfo = desiredLRP.DesiredLRPRunInfo(time.Unix(42, 0))
+			runInfo.VolumeMounts = []*models.VolumeMount{{Driver: "some-driver"}}
+		})
+
+		It("updates the volume placement and increments the modification tag", func() {
+			schedulingInfo := desiredLRP.DesiredLRPSchedulingInfo()
+
+			expectedSchedulingInfo := schedulingInfo
+			expectedSchedulingInfo.VolumePlacement = &models.VolumePlacement{DriverNames: []string{"some-driver"}}
+			expectedSchedulingInfo.ModificationTag.Increment()
+
+			schedulingInfo.ApplyRedeploy(&runInfo, nil)
+			Expect(schedulingInfo).To(Equal(expectedSchedulingInfo))
+		})
+
+		It("replaces the resource if one is given", func() {
+			resource := models.NewDesiredLRPResource(1024, 2048, 10, "preloaded:other-stack")
+			schedulingInfo := desiredLRP.DesiredLRPSchedulingInfo()
+
+			expectedSchedulingInfo := schedulingInfo
+			expectedSchedulingInfo.DesiredLRPResource = resource
+			expectedSchedulingInfo.VolumePlacement = &models.VolumePlacement{DriverNames: []string{"some-driver"}}
+			expectedSchedulingInfo.ModificationTag.Increment()
+
+			schedulingInfo.ApplyRedeploy(&runInfo, &resource)
+			Expect(schedulingInfo).To(Equal(expectedSchedulingInfo))
+		})
+	})
+
+	Describe("DefinitionEqual", func() {
+		var before, after *models.DesiredLRP
+
+		BeforeEach(func() {
+			before = model_helpers.NewValidDesiredLRP("some-guid")
+			after = model_helpers.NewValidDesiredLRP("some-guid")
+		})
+
+		It("is true for the same definition", func() {
+			Expect(before.DefinitionEqual(after)).To(BeTrue())
+		})
+
+		It("ignores the modification tag", func() {
+			after.ModificationTag = &models.ModificationTag{Epoch: "other-epoch", Index: 3}
+			Expect(before.DefinitionEqual(after)).To(BeTrue())
+		})
+
+		It("is false when a field differs", func() {
+			after.Instances = 5
+			Expect(before.DefinitionEqual(after)).To(BeFalse())
+		})
+
+		It("ignores whitespace in the route JSON", func() {
+			spaced := json.RawMessage(`{ "foo": "bar" }`)
+			after.Routes = &models.Routes{"my-router": &spaced}
+			Expect(before.DefinitionEqual(after)).To(BeTrue())
+		})
+
+		It("is false when the routes differ", func() {
+			other := json.RawMessage(`{"foo":"baz"}`)
+			after.Routes = &models.Routes{"my-router": &other}
+			Expect(before.DefinitionEqual(after)).To(BeFalse())
+		})
+
+		It("is false when only one has routes", func() {
+			after.Routes = nil
+			Expect(before.DefinitionEqual(after)).To(BeFalse())
+			Expect(after.DefinitionEqual(before)).To(BeFalse())
+		})
+
+		It("treats nil and empty routes the same", func() {
+			before.Routes = nil
+			after.Routes = &models.Routes{}
+			Expect(before.DefinitionEqual(after)).To(BeTrue())
+		})
+	})
+
 	Describe("Version Down To", func() {
 		Context("V1", func() {
 			BeforeEach(func() {
@@ -690,6 +770,12 @@ var _ = Describe("DesiredLRP", func() {
 			assertDesiredLRPValidationFailsWithMessage(desiredLRP, "annotation")
 		})
 
+		It("requires a valid RestartPolicy", func() {
+			immediateRestarts := int32(-1)
+			desiredLRP.RestartPolicy = &models.RestartPolicy{ImmediateRestarts: &immediateRestarts}
+			assertDesiredLRPValidationFailsWithMessage(desiredLRP, "restart_policy")
+		})
+
 		Context("when security group is present", func() {
 			It("must be valid", func() {
 				desiredLRP.EgressRules = []*models.SecurityGroupRule{{
@@ -889,7 +975,8 @@ var _ = Describe("DesiredLRPSchedulingInfo", func() {
 		largeRoutes = models.Routes{
 			"router": &largeRoute,
 		}
-		tag = models.ModificationTag{}
+		tag              = models.ModificationTag{}
+		negativeRestarts = int32(-1)
 	)
 
 	DescribeTable("Validation",
@@ -902,12 +989,13 @@ var _ = Describe("DesiredLRPSchedulingInfo", func() {
 				Expect(err.Error()).To(ContainSubstring(expectedErr))
 			}
 		},
-		Entry("valid scheduling info", models.NewDesiredLRPSchedulingInfo(newValidLRPKey(), annotation, instances, newValidResource(), routes, tag, nil, nil), ""),
-		Entry("invalid annotation", models.NewDesiredLRPSchedulingInfo(newValidLRPKey(), largeString, instances, newValidResource(), routes, tag, nil, nil), "annotation"),
-		Entry("invalid instances", models.NewDesiredLRPSchedulingInfo(newValidLRPKey(), annotation, -2, newValidResource(), routes, tag, nil, nil), "instances"),
-		Entry("invalid key", models.NewDesiredLRPSchedulingInfo(models.DesiredLRPKey{}, annotation, instances, newValidResource(), routes, tag, nil, nil), "process_guid"),
-		Entry("invalid resource", models.NewDesiredLRPSchedulingInfo(newValidLRPKey(), annotation, instances, models.DesiredLRPResource{}, routes, tag, nil, nil), "rootfs"),
-		Entry("invalid routes", models.NewDesiredLRPSchedulingInfo(newValidLRPKey(), annotation, instances, newValidResource(), largeRoutes, tag, nil, nil), "routes"),
+		Entry("valid scheduling info", models.NewDesiredLRPSchedulingInfo(newValidLRPKey(), annotation, instances, newValidResource(), routes, tag, nil, nil, nil), ""),
+		Entry("invalid annotation", models.NewDesiredLRPSchedulingInfo(newValidLRPKey(), largeString, instances, newValidResource(), routes, tag, nil, nil, nil), "annotation"),
+		Entry("invalid instances", models.NewDesiredLRPSchedulingInfo(newValidLRPKey(), annotation, -2, newValidResource(), routes, tag, nil, nil, nil), "instances"),
+		Entry("invalid key", models.NewDesiredLRPSchedulingInfo(models.DesiredLRPKey{}, annotation, instances, newValidResource(), routes, tag, nil, nil, nil), "process_guid"),
+		Entry("invalid resource", models.NewDesiredLRPSchedulingInfo(newValidLRPKey(), annotation, instances, models.DesiredLRPResource{}, routes, tag, nil, nil, nil), "rootfs"),
+		Entry("invalid routes", models.NewDesiredLRPSchedulingInfo(newValidLRPKey(), annotation, instances, newValidResource(), largeRoutes, tag, nil, nil, nil), "routes"),
+		Entry("invalid restart policy", models.NewDesiredLRPSchedulingInfo(newValidLRPKey(), annotation, instances, newValidResource(), routes, tag, nil, nil, &models.RestartPolicy{MaxRestartAttempts: &negativeRestarts}), "max_restart_attempts"),
 	)
 })
 
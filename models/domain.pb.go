@@ -80,10 +80,18 @@ func (m *UpsertDomainRequest) GetTtl() uint32 {
 	return 0
 }
 
+type DomainsRequest struct {
+}
+
+func (m *DomainsRequest) Reset()                    { *m = DomainsRequest{} }
+func (*DomainsRequest) ProtoMessage()               {}
+func (*DomainsRequest) Descriptor() ([]byte, []int) { return fileDescriptorDomain, []int{3} }
+
 func init() {
 	proto.RegisterType((*DomainsResponse)(nil), "models.DomainsResponse")
 	proto.RegisterType((*UpsertDomainResponse)(nil), "models.UpsertDomainResponse")
 	proto.RegisterType((*UpsertDomainRequest)(nil), "models.UpsertDomainRequest")
+	proto.RegisterType((*DomainsRequest)(nil), "models.DomainsRequest")
 }
 func (this *DomainsResponse) GoString() string {
 	if this == nil {
@@ -123,6 +131,15 @@ func (this *UpsertDomainRequest) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *DomainsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&models.DomainsRequest{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringDomain(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -227,6 +244,24 @@ func (m *UpsertDomainRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *DomainsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
 func encodeFixed64Domain(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	dAtA[offset+1] = uint8(v >> 8)
@@ -289,6 +324,12 @@ func (m *UpsertDomainRequest) Size() (n int) {
 	return n
 }
 
+func (m *DomainsRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
 func sovDomain(x uint64) (n int) {
 	for {
 		n++
@@ -334,6 +375,15 @@ func (this *UpsertDomainRequest) String() string {
 	}, "")
 	return s
 }
+func (this *DomainsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DomainsRequest{`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringDomain(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -635,6 +685,56 @@ func (m *UpsertDomainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DomainsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DomainsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DomainsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDomain(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -743,7 +843,7 @@ var (
 func init() { proto.RegisterFile("domain.proto", fileDescriptorDomain) }
 
 var fileDescriptorDomain = []byte{
-	// 250 bytes of a gzipped FileDescriptorProto
+	// 259 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xe2, 0xe2, 0x49, 0xc9, 0xcf, 0x4d,
 	0xcc, 0xcc, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0xcb, 0xcd, 0x4f, 0x49, 0xcd, 0x29,
 	0x96, 0xd2, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xcf, 0x4f,
@@ -755,9 +855,10 @@ var fileDescriptorDomain = []byte{
 	0x59, 0x73, 0x89, 0x84, 0x16, 0x14, 0xa7, 0x16, 0x95, 0x40, 0xcc, 0x25, 0xc9, 0x58, 0x25, 0x6f,
 	0x2e, 0x61, 0x54, 0xcd, 0x85, 0xa5, 0xa9, 0xc5, 0x25, 0x42, 0x32, 0x5c, 0x6c, 0x10, 0xe3, 0xc1,
 	0x9a, 0x39, 0x9d, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0x8a, 0x09, 0x89, 0x71, 0x31, 0x97,
-	0x94, 0xe4, 0x48, 0x30, 0x29, 0x30, 0x6a, 0xf0, 0x42, 0xa5, 0x40, 0x02, 0x4e, 0x3a, 0x17, 0x1e,
-	0xca, 0x31, 0xdc, 0x78, 0x28, 0xc7, 0xf0, 0xe1, 0xa1, 0x1c, 0x63, 0xc3, 0x23, 0x39, 0xc6, 0x15,
-	0x8f, 0xe4, 0x18, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6,
-	0x17, 0x8f, 0xe4, 0x18, 0x3e, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0x01, 0x10, 0x00, 0x00,
-	0xff, 0xff, 0x44, 0xa3, 0xd6, 0xcb, 0x5c, 0x01, 0x00, 0x00,
+	0x94, 0xe4, 0x48, 0x30, 0x29, 0x30, 0x6a, 0xf0, 0x42, 0xa5, 0x40, 0x02, 0x4a, 0x02, 0x5c, 0x7c,
+	0x70, 0xbf, 0x81, 0xcd, 0x71, 0xd2, 0xb9, 0xf0, 0x50, 0x8e, 0xe1, 0xc6, 0x43, 0x39, 0x86, 0x0f,
+	0x0f, 0xe5, 0x18, 0x1b, 0x1e, 0xc9, 0x31, 0xae, 0x78, 0x24, 0xc7, 0x70, 0xe2, 0x91, 0x1c, 0xe3,
+	0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0xbe, 0x78, 0x24, 0xc7, 0xf0, 0xe1, 0x91, 0x1c,
+	0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb8, 0x86, 0x11, 0x96, 0x6e,
+	0x01, 0x00, 0x00,
 }
@@ -0,0 +1,30 @@
+package models
+
+// RowsUnderKeyLabel returns how many rows of the table are encrypted with
+// the key labelled keyLabel. An empty keyLabel counts the unencrypted rows.
+func (progress *EncryptionTableProgress) RowsUnderKeyLabel(keyLabel string) int64 {
+	for _, keyRows := range progress.KeyLabelRows {
+		if keyRows.KeyLabel == keyLabel {
+			return keyRows.Rows
+		}
+	}
+	return 0
+}
+
+func (request *EncryptionProgressRequest) Validate() error {
+	return nil
+}
+
+func (request *VerifyEncryptionKeyRetiredRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.KeyLabel == "" {
+		validationError = validationError.Append(ErrInvalidField{"key_label"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
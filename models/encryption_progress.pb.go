@@ -0,0 +1,1797 @@
+// Code generated by protoc-gen-gogo.
+// source: encryption_progress.proto
+// DO NOT EDIT!
+
+package models
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+
+import strings "strings"
+import reflect "reflect"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type EncryptionKeyRows struct {
+	KeyLabel string `protobuf:"bytes,1,opt,name=key_label,json=keyLabel" json:"key_label"`
+	Rows     int64  `protobuf:"varint,2,opt,name=rows" json:"rows"`
+}
+
+func (m *EncryptionKeyRows) Reset()      { *m = EncryptionKeyRows{} }
+func (*EncryptionKeyRows) ProtoMessage() {}
+func (*EncryptionKeyRows) Descriptor() ([]byte, []int) {
+	return fileDescriptorEncryptionProgress, []int{0}
+}
+
+func (m *EncryptionKeyRows) GetKeyLabel() string {
+	if m != nil {
+		return m.KeyLabel
+	}
+	return ""
+}
+
+func (m *EncryptionKeyRows) GetRows() int64 {
+	if m != nil {
+		return m.Rows
+	}
+	return 0
+}
+
+type EncryptionTableProgress struct {
+	TableName     string               `protobuf:"bytes,1,opt,name=table_name,json=tableName" json:"table_name"`
+	KeyLabel      string               `protobuf:"bytes,2,opt,name=key_label,json=keyLabel" json:"key_label"`
+	RowsDone      int64                `protobuf:"varint,3,opt,name=rows_done,json=rowsDone" json:"rows_done"`
+	RowsRemaining int64                `protobuf:"varint,4,opt,name=rows_remaining,json=rowsRemaining" json:"rows_remaining"`
+	Completed     bool                 `protobuf:"varint,5,opt,name=completed" json:"completed"`
+	KeyLabelRows  []*EncryptionKeyRows `protobuf:"bytes,6,rep,name=key_label_rows,json=keyLabelRows" json:"key_label_rows,omitempty"`
+	OldKeyRows    int64                `protobuf:"varint,7,opt,name=old_key_rows,json=oldKeyRows" json:"old_key_rows"`
+}
+
+func (m *EncryptionTableProgress) Reset()      { *m = EncryptionTableProgress{} }
+func (*EncryptionTableProgress) ProtoMessage() {}
+func (*EncryptionTableProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptorEncryptionProgress, []int{1}
+}
+
+func (m *EncryptionTableProgress) GetTableName() string {
+	if m != nil {
+		return m.TableName
+	}
+	return ""
+}
+
+func (m *EncryptionTableProgress) GetKeyLabel() string {
+	if m != nil {
+		return m.KeyLabel
+	}
+	return ""
+}
+
+func (m *EncryptionTableProgress) GetRowsDone() int64 {
+	if m != nil {
+		return m.RowsDone
+	}
+	return 0
+}
+
+func (m *EncryptionTableProgress) GetRowsRemaining() int64 {
+	if m != nil {
+		return m.RowsRemaining
+	}
+	return 0
+}
+
+func (m *EncryptionTableProgress) GetCompleted() bool {
+	if m != nil {
+		return m.Completed
+	}
+	return false
+}
+
+func (m *EncryptionTableProgress) GetKeyLabelRows() []*EncryptionKeyRows {
+	if m != nil {
+		return m.KeyLabelRows
+	}
+	return nil
+}
+
+func (m *EncryptionTableProgress) GetOldKeyRows() int64 {
+	if m != nil {
+		return m.OldKeyRows
+	}
+	return 0
+}
+
+type EncryptionProgressRequest struct {
+}
+
+func (m *EncryptionProgressRequest) Reset()      { *m = EncryptionProgressRequest{} }
+func (*EncryptionProgressRequest) ProtoMessage() {}
+func (*EncryptionProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorEncryptionProgress, []int{2}
+}
+
+type EncryptionProgressResponse struct {
+	Error    *Error                     `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	KeyLabel string                     `protobuf:"bytes,2,opt,name=key_label,json=keyLabel" json:"key_label"`
+	Tables   []*EncryptionTableProgress `protobuf:"bytes,3,rep,name=tables" json:"tables,omitempty"`
+}
+
+func (m *EncryptionProgressResponse) Reset()      { *m = EncryptionProgressResponse{} }
+func (*EncryptionProgressResponse) ProtoMessage() {}
+func (*EncryptionProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorEncryptionProgress, []int{3}
+}
+
+func (m *EncryptionProgressResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *EncryptionProgressResponse) GetKeyLabel() string {
+	if m != nil {
+		return m.KeyLabel
+	}
+	return ""
+}
+
+func (m *EncryptionProgressResponse) GetTables() []*EncryptionTableProgress {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
+type VerifyEncryptionKeyRetiredRequest struct {
+	KeyLabel string `protobuf:"bytes,1,opt,name=key_label,json=keyLabel" json:"key_label"`
+}
+
+func (m *VerifyEncryptionKeyRetiredRequest) Reset()      { *m = VerifyEncryptionKeyRetiredRequest{} }
+func (*VerifyEncryptionKeyRetiredRequest) ProtoMessage() {}
+func (*VerifyEncryptionKeyRetiredRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorEncryptionProgress, []int{4}
+}
+
+func (m *VerifyEncryptionKeyRetiredRequest) GetKeyLabel() string {
+	if m != nil {
+		return m.KeyLabel
+	}
+	return ""
+}
+
+type VerifyEncryptionKeyRetiredResponse struct {
+	Error           *Error `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	Retired         bool   `protobuf:"varint,2,opt,name=retired" json:"retired"`
+	RemainingRows   int64  `protobuf:"varint,3,opt,name=remaining_rows,json=remainingRows" json:"remaining_rows"`
+	QuarantinedRows int64  `protobuf:"varint,4,opt,name=quarantined_rows,json=quarantinedRows" json:"quarantined_rows"`
+}
+
+func (m *VerifyEncryptionKeyRetiredResponse) Reset()      { *m = VerifyEncryptionKeyRetiredResponse{} }
+func (*VerifyEncryptionKeyRetiredResponse) ProtoMessage() {}
+func (*VerifyEncryptionKeyRetiredResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorEncryptionProgress, []int{5}
+}
+
+func (m *VerifyEncryptionKeyRetiredResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *VerifyEncryptionKeyRetiredResponse) GetRetired() bool {
+	if m != nil {
+		return m.Retired
+	}
+	return false
+}
+
+func (m *VerifyEncryptionKeyRetiredResponse) GetRemainingRows() int64 {
+	if m != nil {
+		return m.RemainingRows
+	}
+	return 0
+}
+
+func (m *VerifyEncryptionKeyRetiredResponse) GetQuarantinedRows() int64 {
+	if m != nil {
+		return m.QuarantinedRows
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EncryptionKeyRows)(nil), "models.EncryptionKeyRows")
+	proto.RegisterType((*EncryptionTableProgress)(nil), "models.EncryptionTableProgress")
+	proto.RegisterType((*EncryptionProgressRequest)(nil), "models.EncryptionProgressRequest")
+	proto.RegisterType((*EncryptionProgressResponse)(nil), "models.EncryptionProgressResponse")
+	proto.RegisterType((*VerifyEncryptionKeyRetiredRequest)(nil), "models.VerifyEncryptionKeyRetiredRequest")
+	proto.RegisterType((*VerifyEncryptionKeyRetiredResponse)(nil), "models.VerifyEncryptionKeyRetiredResponse")
+}
+func (this *EncryptionKeyRows) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*EncryptionKeyRows)
+	if !ok {
+		that2, ok := that.(EncryptionKeyRows)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.KeyLabel != that1.KeyLabel {
+		return false
+	}
+	if this.Rows != that1.Rows {
+		return false
+	}
+	return true
+}
+func (this *EncryptionTableProgress) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*EncryptionTableProgress)
+	if !ok {
+		that2, ok := that.(EncryptionTableProgress)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.TableName != that1.TableName {
+		return false
+	}
+	if this.KeyLabel != that1.KeyLabel {
+		return false
+	}
+	if this.RowsDone != that1.RowsDone {
+		return false
+	}
+	if this.RowsRemaining != that1.RowsRemaining {
+		return false
+	}
+	if this.Completed != that1.Completed {
+		return false
+	}
+	if len(this.KeyLabelRows) != len(that1.KeyLabelRows) {
+		return false
+	}
+	for i := range this.KeyLabelRows {
+		if !this.KeyLabelRows[i].Equal(that1.KeyLabelRows[i]) {
+			return false
+		}
+	}
+	if this.OldKeyRows != that1.OldKeyRows {
+		return false
+	}
+	return true
+}
+func (this *EncryptionProgressRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*EncryptionProgressRequest)
+	if !ok {
+		that2, ok := that.(EncryptionProgressRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *EncryptionProgressResponse) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*EncryptionProgressResponse)
+	if !ok {
+		that2, ok := that.(EncryptionProgressResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if this.KeyLabel != that1.KeyLabel {
+		return false
+	}
+	if len(this.Tables) != len(that1.Tables) {
+		return false
+	}
+	for i := range this.Tables {
+		if !this.Tables[i].Equal(that1.Tables[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *VerifyEncryptionKeyRetiredRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*VerifyEncryptionKeyRetiredRequest)
+	if !ok {
+		that2, ok := that.(VerifyEncryptionKeyRetiredRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.KeyLabel != that1.KeyLabel {
+		return false
+	}
+	return true
+}
+func (this *VerifyEncryptionKeyRetiredResponse) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*VerifyEncryptionKeyRetiredResponse)
+	if !ok {
+		that2, ok := that.(VerifyEncryptionKeyRetiredResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if this.Retired != that1.Retired {
+		return false
+	}
+	if this.RemainingRows != that1.RemainingRows {
+		return false
+	}
+	if this.QuarantinedRows != that1.QuarantinedRows {
+		return false
+	}
+	return true
+}
+func (this *EncryptionKeyRows) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.EncryptionKeyRows{")
+	s = append(s, "KeyLabel: "+fmt.Sprintf("%#v", this.KeyLabel)+",\n")
+	s = append(s, "Rows: "+fmt.Sprintf("%#v", this.Rows)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EncryptionTableProgress) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&models.EncryptionTableProgress{")
+	s = append(s, "TableName: "+fmt.Sprintf("%#v", this.TableName)+",\n")
+	s = append(s, "KeyLabel: "+fmt.Sprintf("%#v", this.KeyLabel)+",\n")
+	s = append(s, "RowsDone: "+fmt.Sprintf("%#v", this.RowsDone)+",\n")
+	s = append(s, "RowsRemaining: "+fmt.Sprintf("%#v", this.RowsRemaining)+",\n")
+	s = append(s, "Completed: "+fmt.Sprintf("%#v", this.Completed)+",\n")
+	if this.KeyLabelRows != nil {
+		s = append(s, "KeyLabelRows: "+fmt.Sprintf("%#v", this.KeyLabelRows)+",\n")
+	}
+	s = append(s, "OldKeyRows: "+fmt.Sprintf("%#v", this.OldKeyRows)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EncryptionProgressRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&models.EncryptionProgressRequest{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EncryptionProgressResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.EncryptionProgressResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	s = append(s, "KeyLabel: "+fmt.Sprintf("%#v", this.KeyLabel)+",\n")
+	if this.Tables != nil {
+		s = append(s, "Tables: "+fmt.Sprintf("%#v", this.Tables)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VerifyEncryptionKeyRetiredRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.VerifyEncryptionKeyRetiredRequest{")
+	s = append(s, "KeyLabel: "+fmt.Sprintf("%#v", this.KeyLabel)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VerifyEncryptionKeyRetiredResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&models.VerifyEncryptionKeyRetiredResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	s = append(s, "Retired: "+fmt.Sprintf("%#v", this.Retired)+",\n")
+	s = append(s, "RemainingRows: "+fmt.Sprintf("%#v", this.RemainingRows)+",\n")
+	s = append(s, "QuarantinedRows: "+fmt.Sprintf("%#v", this.QuarantinedRows)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringEncryptionProgress(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *EncryptionKeyRows) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncryptionKeyRows) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintEncryptionProgress(dAtA, i, uint64(len(m.KeyLabel)))
+	i += copy(dAtA[i:], m.KeyLabel)
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintEncryptionProgress(dAtA, i, uint64(m.Rows))
+	return i, nil
+}
+
+func (m *EncryptionTableProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncryptionTableProgress) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintEncryptionProgress(dAtA, i, uint64(len(m.TableName)))
+	i += copy(dAtA[i:], m.TableName)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintEncryptionProgress(dAtA, i, uint64(len(m.KeyLabel)))
+	i += copy(dAtA[i:], m.KeyLabel)
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintEncryptionProgress(dAtA, i, uint64(m.RowsDone))
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintEncryptionProgress(dAtA, i, uint64(m.RowsRemaining))
+	dAtA[i] = 0x28
+	i++
+	if m.Completed {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if len(m.KeyLabelRows) > 0 {
+		for _, msg := range m.KeyLabelRows {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintEncryptionProgress(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x38
+	i++
+	i = encodeVarintEncryptionProgress(dAtA, i, uint64(m.OldKeyRows))
+	return i, nil
+}
+
+func (m *EncryptionProgressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncryptionProgressRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *EncryptionProgressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncryptionProgressResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintEncryptionProgress(dAtA, i, uint64(m.Error.Size()))
+		n1, err := m.Error.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintEncryptionProgress(dAtA, i, uint64(len(m.KeyLabel)))
+	i += copy(dAtA[i:], m.KeyLabel)
+	if len(m.Tables) > 0 {
+		for _, msg := range m.Tables {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintEncryptionProgress(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *VerifyEncryptionKeyRetiredRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyEncryptionKeyRetiredRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintEncryptionProgress(dAtA, i, uint64(len(m.KeyLabel)))
+	i += copy(dAtA[i:], m.KeyLabel)
+	return i, nil
+}
+
+func (m *VerifyEncryptionKeyRetiredResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyEncryptionKeyRetiredResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintEncryptionProgress(dAtA, i, uint64(m.Error.Size()))
+		n2, err := m.Error.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	dAtA[i] = 0x10
+	i++
+	if m.Retired {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintEncryptionProgress(dAtA, i, uint64(m.RemainingRows))
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintEncryptionProgress(dAtA, i, uint64(m.QuarantinedRows))
+	return i, nil
+}
+
+func encodeFixed64EncryptionProgress(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32EncryptionProgress(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintEncryptionProgress(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *EncryptionKeyRows) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.KeyLabel)
+	n += 1 + l + sovEncryptionProgress(uint64(l))
+	n += 1 + sovEncryptionProgress(uint64(m.Rows))
+	return n
+}
+
+func (m *EncryptionTableProgress) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.TableName)
+	n += 1 + l + sovEncryptionProgress(uint64(l))
+	l = len(m.KeyLabel)
+	n += 1 + l + sovEncryptionProgress(uint64(l))
+	n += 1 + sovEncryptionProgress(uint64(m.RowsDone))
+	n += 1 + sovEncryptionProgress(uint64(m.RowsRemaining))
+	n += 2
+	if len(m.KeyLabelRows) > 0 {
+		for _, e := range m.KeyLabelRows {
+			l = e.Size()
+			n += 1 + l + sovEncryptionProgress(uint64(l))
+		}
+	}
+	n += 1 + sovEncryptionProgress(uint64(m.OldKeyRows))
+	return n
+}
+
+func (m *EncryptionProgressRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EncryptionProgressResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovEncryptionProgress(uint64(l))
+	}
+	l = len(m.KeyLabel)
+	n += 1 + l + sovEncryptionProgress(uint64(l))
+	if len(m.Tables) > 0 {
+		for _, e := range m.Tables {
+			l = e.Size()
+			n += 1 + l + sovEncryptionProgress(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VerifyEncryptionKeyRetiredRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.KeyLabel)
+	n += 1 + l + sovEncryptionProgress(uint64(l))
+	return n
+}
+
+func (m *VerifyEncryptionKeyRetiredResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovEncryptionProgress(uint64(l))
+	}
+	n += 2
+	n += 1 + sovEncryptionProgress(uint64(m.RemainingRows))
+	n += 1 + sovEncryptionProgress(uint64(m.QuarantinedRows))
+	return n
+}
+
+func sovEncryptionProgress(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozEncryptionProgress(x uint64) (n int) {
+	return sovEncryptionProgress(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *EncryptionKeyRows) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EncryptionKeyRows{`,
+		`KeyLabel:` + fmt.Sprintf("%v", this.KeyLabel) + `,`,
+		`Rows:` + fmt.Sprintf("%v", this.Rows) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EncryptionTableProgress) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EncryptionTableProgress{`,
+		`TableName:` + fmt.Sprintf("%v", this.TableName) + `,`,
+		`KeyLabel:` + fmt.Sprintf("%v", this.KeyLabel) + `,`,
+		`RowsDone:` + fmt.Sprintf("%v", this.RowsDone) + `,`,
+		`RowsRemaining:` + fmt.Sprintf("%v", this.RowsRemaining) + `,`,
+		`Completed:` + fmt.Sprintf("%v", this.Completed) + `,`,
+		`KeyLabelRows:` + strings.Replace(fmt.Sprintf("%v", this.KeyLabelRows), "EncryptionKeyRows", "EncryptionKeyRows", 1) + `,`,
+		`OldKeyRows:` + fmt.Sprintf("%v", this.OldKeyRows) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EncryptionProgressRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EncryptionProgressRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EncryptionProgressResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EncryptionProgressResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`KeyLabel:` + fmt.Sprintf("%v", this.KeyLabel) + `,`,
+		`Tables:` + strings.Replace(fmt.Sprintf("%v", this.Tables), "EncryptionTableProgress", "EncryptionTableProgress", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VerifyEncryptionKeyRetiredRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VerifyEncryptionKeyRetiredRequest{`,
+		`KeyLabel:` + fmt.Sprintf("%v", this.KeyLabel) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VerifyEncryptionKeyRetiredResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VerifyEncryptionKeyRetiredResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Retired:` + fmt.Sprintf("%v", this.Retired) + `,`,
+		`RemainingRows:` + fmt.Sprintf("%v", this.RemainingRows) + `,`,
+		`QuarantinedRows:` + fmt.Sprintf("%v", this.QuarantinedRows) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringEncryptionProgress(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *EncryptionKeyRows) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncryptionProgress
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncryptionKeyRows: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncryptionKeyRows: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyLabel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptionProgress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncryptionProgress
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyLabel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rows", wireType)
+			}
+			m.Rows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptionProgress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rows |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncryptionProgress(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEncryptionProgress
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EncryptionTableProgress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncryptionProgress
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncryptionTableProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncryptionTableProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptionProgress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncryptionProgress
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TableName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyLabel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptionProgress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncryptionProgress
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyLabel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowsDone", wireType)
+			}
+			m.RowsDone = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptionProgress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RowsDone |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowsRemaining", wireType)
+			}
+			m.RowsRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptionProgress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RowsRemaining |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptionProgress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Completed = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyLabelRows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptionProgress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEncryptionProgress
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyLabelRows = append(m.KeyLabelRows, &EncryptionKeyRows{})
+			if err := m.KeyLabelRows[len(m.KeyLabelRows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldKeyRows", wireType)
+			}
+			m.OldKeyRows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptionProgress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldKeyRows |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncryptionProgress(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEncryptionProgress
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EncryptionProgressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncryptionProgress
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncryptionProgressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncryptionProgressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncryptionProgress(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEncryptionProgress
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EncryptionProgressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncryptionProgress
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncryptionProgressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncryptionProgressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptionProgress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEncryptionProgress
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyLabel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptionProgress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncryptionProgress
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyLabel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tables", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptionProgress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEncryptionProgress
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tables = append(m.Tables, &EncryptionTableProgress{})
+			if err := m.Tables[len(m.Tables)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncryptionProgress(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEncryptionProgress
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyEncryptionKeyRetiredRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncryptionProgress
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyEncryptionKeyRetiredRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyEncryptionKeyRetiredRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyLabel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptionProgress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncryptionProgress
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyLabel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncryptionProgress(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEncryptionProgress
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyEncryptionKeyRetiredResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncryptionProgress
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyEncryptionKeyRetiredResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyEncryptionKeyRetiredResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptionProgress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEncryptionProgress
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptionProgress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Retired = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingRows", wireType)
+			}
+			m.RemainingRows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptionProgress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingRows |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuarantinedRows", wireType)
+			}
+			m.QuarantinedRows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptionProgress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuarantinedRows |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncryptionProgress(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEncryptionProgress
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEncryptionProgress(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEncryptionProgress
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEncryptionProgress
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEncryptionProgress
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthEncryptionProgress
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowEncryptionProgress
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipEncryptionProgress(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthEncryptionProgress = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEncryptionProgress   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("encryption_progress.proto", fileDescriptorEncryptionProgress) }
+
+var fileDescriptorEncryptionProgress = []byte{
+	// 503 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x94, 0x52, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xf6, 0xc6, 0x69, 0x1a, 0x4f, 0xda, 0x02, 0xbe, 0xe0, 0x04, 0x69, 0x9b, 0xb8, 0x12, 0x8a,
+	0x04, 0x24, 0x52, 0x2f, 0x1c, 0x91, 0x2a, 0xe0, 0x02, 0x42, 0x95, 0x85, 0xb8, 0x5a, 0x4e, 0x3c,
+	0x35, 0x56, 0xed, 0x5d, 0x77, 0xd7, 0x51, 0xe5, 0x1b, 0x8f, 0xc0, 0x0b, 0x70, 0xe7, 0x29, 0xb8,
+	0xd2, 0x63, 0x8f, 0x9c, 0x10, 0x31, 0x17, 0x8e, 0x7d, 0x04, 0xe4, 0xb5, 0x9d, 0xda, 0x6a, 0x85,
+	0xca, 0x6d, 0xf7, 0xfb, 0xe6, 0xe7, 0x9b, 0xf9, 0x06, 0x86, 0xc8, 0x96, 0x22, 0x4b, 0xd2, 0x90,
+	0x33, 0x37, 0x11, 0x3c, 0x10, 0x28, 0xe5, 0x2c, 0x11, 0x3c, 0xe5, 0x66, 0x2f, 0xe6, 0x3e, 0x46,
+	0x72, 0xf4, 0x2c, 0x08, 0xd3, 0x8f, 0xab, 0xc5, 0x6c, 0xc9, 0xe3, 0x79, 0xc0, 0x03, 0x3e, 0x57,
+	0xf4, 0x62, 0x75, 0xa2, 0x7e, 0xea, 0xa3, 0x5e, 0x65, 0xda, 0x68, 0x80, 0x42, 0x70, 0x51, 0x7e,
+	0xec, 0x63, 0x78, 0xf0, 0x6a, 0xd3, 0xe0, 0x0d, 0x66, 0x0e, 0x3f, 0x97, 0xe6, 0x04, 0x8c, 0x53,
+	0xcc, 0xdc, 0xc8, 0x5b, 0x60, 0x64, 0x91, 0x31, 0x99, 0x1a, 0x47, 0xdd, 0x8b, 0x9f, 0xfb, 0x9a,
+	0xd3, 0x3f, 0xc5, 0xec, 0x6d, 0x81, 0x9a, 0x16, 0x74, 0x05, 0x3f, 0x97, 0x56, 0x67, 0x4c, 0xa6,
+	0x7a, 0xc5, 0x2a, 0xc4, 0xfe, 0xd6, 0x81, 0x87, 0xd7, 0x25, 0xdf, 0x7b, 0x8b, 0x08, 0x8f, 0x2b,
+	0xdd, 0xe6, 0x01, 0x40, 0x5a, 0x00, 0x2e, 0xf3, 0x62, 0x6c, 0x55, 0x36, 0x14, 0xfe, 0xce, 0x8b,
+	0xb1, 0xdd, 0xbd, 0x73, 0x6b, 0xf7, 0x09, 0x18, 0x45, 0x2f, 0xd7, 0xe7, 0x0c, 0x2d, 0xbd, 0x21,
+	0xa1, 0x5f, 0xc0, 0x2f, 0x39, 0x43, 0xf3, 0x09, 0xec, 0xa9, 0x10, 0x81, 0xb1, 0x17, 0xb2, 0x90,
+	0x05, 0x56, 0xb7, 0x11, 0xb7, 0x5b, 0x70, 0x4e, 0x4d, 0x99, 0x36, 0x18, 0x4b, 0x1e, 0x27, 0x11,
+	0xa6, 0xe8, 0x5b, 0x5b, 0x63, 0x32, 0xed, 0xd7, 0xb2, 0x36, 0xb0, 0xf9, 0x02, 0xf6, 0x36, 0xb2,
+	0x5c, 0x35, 0x7b, 0x6f, 0xac, 0x4f, 0x07, 0x87, 0xc3, 0x59, 0x69, 0xc3, 0xec, 0xc6, 0x1e, 0x9d,
+	0x9d, 0x5a, 0xb0, 0xda, 0xea, 0x63, 0xd8, 0xe1, 0x91, 0xef, 0x16, 0x45, 0x54, 0xfa, 0x76, 0x43,
+	0x0f, 0xf0, 0xc8, 0xaf, 0xb2, 0xec, 0x47, 0x30, 0xbc, 0x2e, 0x55, 0xaf, 0xce, 0xc1, 0xb3, 0x15,
+	0xca, 0xd4, 0xfe, 0x42, 0x60, 0x74, 0x1b, 0x2b, 0x13, 0xce, 0x24, 0x9a, 0x07, 0xb0, 0xa5, 0xdc,
+	0x55, 0xbb, 0x1d, 0x1c, 0xee, 0x6e, 0xb4, 0x15, 0xa0, 0x53, 0x72, 0x77, 0x59, 0xf0, 0x73, 0xe8,
+	0x29, 0x43, 0xa4, 0xa5, 0xab, 0x21, 0xf7, 0x6f, 0x0e, 0xd9, 0x72, 0xd6, 0xa9, 0xc2, 0xed, 0xd7,
+	0x30, 0xf9, 0x80, 0x22, 0x3c, 0xc9, 0xda, 0xdb, 0xc0, 0x34, 0x14, 0xe8, 0x57, 0x43, 0xdc, 0xe1,
+	0xbe, 0xec, 0xef, 0x04, 0xec, 0x7f, 0x15, 0xfa, 0x9f, 0x79, 0x29, 0x6c, 0x8b, 0x32, 0xcf, 0xea,
+	0x34, 0xbc, 0xad, 0x41, 0x75, 0x2a, 0xf5, 0x29, 0x94, 0xd6, 0xe8, 0xad, 0x53, 0xa9, 0x39, 0xe5,
+	0xe2, 0x1c, 0xee, 0x9f, 0xad, 0x3c, 0xe1, 0xb1, 0x34, 0x64, 0xe8, 0x97, 0xe1, 0xcd, 0xcb, 0xba,
+	0xd7, 0x60, 0x8b, 0x84, 0xa3, 0xa7, 0x97, 0x6b, 0xaa, 0xfd, 0x58, 0x53, 0xed, 0x6a, 0x4d, 0xc9,
+	0xa7, 0x9c, 0x92, 0xaf, 0x39, 0x25, 0x17, 0x39, 0x25, 0x97, 0x39, 0x25, 0xbf, 0x72, 0x4a, 0xfe,
+	0xe4, 0x54, 0xbb, 0xca, 0x29, 0xf9, 0xfc, 0x9b, 0x6a, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x45,
+	0x27, 0x19, 0x0d, 0xef, 0x03, 0x00, 0x00,
+}
@@ -0,0 +1,54 @@
+package models_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EncryptionProgress", func() {
+	Describe("EncryptionTableProgress", func() {
+		Describe("RowsUnderKeyLabel", func() {
+			var progress models.EncryptionTableProgress
+
+			BeforeEach(func() {
+				progress = models.EncryptionTableProgress{
+					TableName: "tasks",
+					KeyLabelRows: []*models.EncryptionKeyRows{
+						{KeyLabel: "old", Rows: 3},
+						{KeyLabel: "", Rows: 1},
+					},
+				}
+			})
+
+			It("returns the rows encrypted with the key", func() {
+				Expect(progress.RowsUnderKeyLabel("old")).To(BeEquivalentTo(3))
+			})
+
+			It("returns the unencrypted rows for an empty label", func() {
+				Expect(progress.RowsUnderKeyLabel("")).To(BeEquivalentTo(1))
+			})
+
+			It("returns zero for a key without rows", func() {
+				Expect(progress.RowsUnderKeyLabel("new")).To(BeZero())
+			})
+		})
+	})
+
+	Describe("VerifyEncryptionKeyRetiredRequest", func() {
+		Describe("Validate", func() {
+			It("requires a key label", func() {
+				request := models.VerifyEncryptionKeyRetiredRequest{}
+				err := request.Validate()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("key_label"))
+			})
+
+			It("accepts a key label", func() {
+				request := models.VerifyEncryptionKeyRetiredRequest{KeyLabel: "old"}
+				Expect(request.Validate()).To(BeNil())
+			})
+		})
+	})
+})
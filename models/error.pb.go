@@ -165,8 +165,27 @@ func (m *Error) GetMessage() string {
 	return ""
 }
 
+// ErrorResponse is sent when a request is rejected before reaching its
+// handler. Every other response carries its error in field 1 as well, so
+// clients can decode it as the response they expect.
+type ErrorResponse struct {
+	Error *Error `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *ErrorResponse) Reset()                    { *m = ErrorResponse{} }
+func (*ErrorResponse) ProtoMessage()               {}
+func (*ErrorResponse) Descriptor() ([]byte, []int) { return fileDescriptorError, []int{1} }
+
+func (m *ErrorResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Error)(nil), "models.Error")
+	proto.RegisterType((*ErrorResponse)(nil), "models.ErrorResponse")
 	proto.RegisterEnum("models.Error_Type", Error_Type_name, Error_Type_value)
 }
 func (x Error_Type) String() string {
@@ -187,6 +206,18 @@ func (this *Error) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *ErrorResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.ErrorResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringError(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -220,6 +251,34 @@ func (m *Error) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ErrorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ErrorResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintError(dAtA, i, uint64(m.Error.Size()))
+		n1, err := m.Error.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	return i, nil
+}
+
 func encodeFixed64Error(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	dAtA[offset+1] = uint8(v >> 8)
@@ -256,6 +315,16 @@ func (m *Error) Size() (n int) {
 	return n
 }
 
+func (m *ErrorResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovError(uint64(l))
+	}
+	return n
+}
+
 func sovError(x uint64) (n int) {
 	for {
 		n++
@@ -280,6 +349,16 @@ func (this *Error) String() string {
 	}, "")
 	return s
 }
+func (this *ErrorResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ErrorResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringError(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -386,6 +465,89 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ErrorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowError
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ErrorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ErrorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowError
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthError
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipError(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthError
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipError(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -494,43 +656,45 @@ var (
 func init() { proto.RegisterFile("error.proto", fileDescriptorError) }
 
 var fileDescriptorError = []byte{
-	// 601 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x74, 0x92, 0xcd, 0x4e, 0x1b, 0x3f,
-	0x14, 0xc5, 0x33, 0xfc, 0xc3, 0x97, 0xf9, 0x32, 0x86, 0x3f, 0x84, 0x00, 0x2e, 0x62, 0x53, 0xa4,
-	0xd2, 0x20, 0xf5, 0x0d, 0x4a, 0x12, 0x10, 0x15, 0x05, 0x94, 0x90, 0xee, 0xcd, 0xf8, 0x26, 0xb1,
-	0xe2, 0xf8, 0x4e, 0x6d, 0x4f, 0x28, 0xac, 0xfa, 0x08, 0x7d, 0x8c, 0x3e, 0x0a, 0x8b, 0x2e, 0x58,
-	0x76, 0x55, 0x95, 0x74, 0xd3, 0x25, 0x8b, 0x3e, 0x40, 0x35, 0x33, 0x81, 0x46, 0x25, 0xdd, 0x8d,
-	0xcf, 0xef, 0xde, 0x33, 0xc7, 0xf7, 0x9a, 0xcc, 0x80, 0xb5, 0x68, 0x4b, 0x91, 0x45, 0x8f, 0x6c,
-	0xa2, 0x8b, 0x12, 0xb4, 0x2b, 0xbe, 0x6c, 0x29, 0xdf, 0x8e, 0x2f, 0x4a, 0x21, 0x76, 0xf7, 0x5a,
-	0xd8, 0xc2, 0xbd, 0x14, 0x5f, 0xc4, 0xcd, 0xf4, 0x94, 0x1e, 0xd2, 0xaf, 0xac, 0x6d, 0xfb, 0xcb,
-	0x04, 0x19, 0xaf, 0x26, 0x36, 0x6c, 0x97, 0xe4, 0xfd, 0x55, 0x04, 0x85, 0x60, 0x2b, 0xd8, 0x99,
-	0x7f, 0xc5, 0x4a, 0x99, 0x5f, 0x29, 0x85, 0xa5, 0xf3, 0xab, 0x08, 0xf6, 0xf3, 0x37, 0xdf, 0x9e,
-	0xe5, 0x6a, 0x69, 0x15, 0xe3, 0x64, 0xb2, 0x0b, 0xce, 0x89, 0x16, 0x14, 0xc6, 0xb6, 0x82, 0x9d,
-	0xe9, 0x01, 0x7c, 0x10, 0xb7, 0x7f, 0x8d, 0x93, 0x7c, 0xd2, 0xc4, 0x28, 0x99, 0x6d, 0x98, 0x8e,
-	0xc1, 0x4b, 0x93, 0x3a, 0xd1, 0x1c, 0x5b, 0x24, 0x73, 0x47, 0xa6, 0x27, 0xb4, 0x92, 0x15, 0xec,
-	0x0a, 0x65, 0x68, 0x90, 0x48, 0x0d, 0xd3, 0xc1, 0x4b, 0xf3, 0x0e, 0xac, 0x53, 0x68, 0xe8, 0xd8,
-	0x50, 0x55, 0x0d, 0x42, 0xb4, 0x92, 0xfe, 0xc7, 0x18, 0x99, 0x7f, 0x94, 0xde, 0xc7, 0xe0, 0x3c,
-	0xcd, 0xb3, 0x25, 0xb2, 0xf0, 0xa8, 0xb9, 0x08, 0x8d, 0x03, 0x3a, 0xce, 0x8a, 0x64, 0x65, 0x20,
-	0x9e, 0x0d, 0x2e, 0xff, 0x36, 0x8b, 0x45, 0x27, 0xd8, 0x02, 0x99, 0x19, 0xb0, 0x37, 0xf5, 0xd3,
-	0x13, 0x3a, 0xc9, 0x0a, 0x64, 0xf9, 0x40, 0x28, 0x0d, 0xf2, 0x1c, 0x4f, 0x23, 0x30, 0x55, 0xd3,
-	0x03, 0x8d, 0x11, 0xd0, 0xa9, 0x21, 0x9b, 0xba, 0x17, 0x1e, 0xce, 0xad, 0x30, 0x4e, 0xf9, 0x24,
-	0xde, 0x74, 0x76, 0x2d, 0x11, 0xfb, 0x36, 0x5a, 0x75, 0x0d, 0x92, 0x12, 0xb6, 0x4c, 0x68, 0x0d,
-	0x1c, 0xc6, 0x36, 0x84, 0x32, 0x9a, 0xa6, 0x56, 0xa1, 0xa7, 0x33, 0x49, 0xe6, 0x07, 0xb5, 0xfa,
-	0x41, 0x39, 0xef, 0xe8, 0xec, 0x70, 0xe5, 0x09, 0xfa, 0x03, 0x8c, 0x8d, 0xa4, 0x73, 0x49, 0xb0,
-	0x1a, 0xc6, 0x1e, 0x6c, 0x36, 0xa7, 0x79, 0xb6, 0x41, 0x0a, 0xaf, 0x43, 0x1f, 0x0b, 0x7d, 0x5c,
-	0x3b, 0x2b, 0x0b, 0x63, 0xd0, 0xef, 0x43, 0x59, 0x0b, 0xd5, 0x05, 0x49, 0x17, 0x46, 0xd2, 0xba,
-	0x17, 0xd6, 0x83, 0xa4, 0x74, 0x74, 0xaf, 0x15, 0xae, 0x0d, 0x92, 0x2e, 0xb2, 0x75, 0xb2, 0xfa,
-	0x84, 0x66, 0x33, 0xa0, 0x6c, 0x64, 0x6b, 0x0d, 0xba, 0xd8, 0x03, 0x49, 0x97, 0xfe, 0xf1, 0x5b,
-	0x8c, 0x22, 0x90, 0x74, 0x99, 0x71, 0x52, 0x7c, 0x42, 0x1b, 0x26, 0x1c, 0x84, 0xfe, 0x7f, 0x24,
-	0xaf, 0xf6, 0x44, 0x18, 0x8b, 0x24, 0xf6, 0x0a, 0xdb, 0x24, 0x6b, 0x15, 0x70, 0xca, 0x82, 0x1c,
-	0x36, 0x88, 0x64, 0x8a, 0x57, 0x93, 0x85, 0xd4, 0x62, 0x63, 0x94, 0x69, 0x9d, 0x9a, 0x8a, 0x6a,
-	0x36, 0xc1, 0x82, 0xf1, 0x65, 0xd0, 0x9a, 0x16, 0xd8, 0x0b, 0xf2, 0xfc, 0x4f, 0x6b, 0x3d, 0x6c,
-	0x83, 0x8c, 0xb5, 0x32, 0xad, 0x23, 0xd3, 0xc4, 0xbf, 0x8d, 0xd6, 0x92, 0xad, 0x1c, 0x36, 0x8e,
-	0x2a, 0x87, 0x60, 0xc0, 0x8a, 0x74, 0xa3, 0xc5, 0x64, 0xfe, 0x15, 0x70, 0x60, 0x95, 0xd0, 0xea,
-	0x1a, 0xe8, 0x3a, 0x9b, 0x25, 0x53, 0x15, 0x10, 0x52, 0x63, 0xd8, 0xa1, 0x1b, 0xd9, 0x13, 0xb5,
-	0x10, 0x62, 0x0f, 0xac, 0xb8, 0xd0, 0x40, 0x37, 0x13, 0xe9, 0x18, 0xc3, 0x4e, 0x19, 0xb5, 0x56,
-	0xe9, 0xab, 0xe5, 0xfb, 0xbb, 0xb7, 0x77, 0x3c, 0xf8, 0x7a, 0xc7, 0x73, 0xf7, 0x77, 0x3c, 0xf8,
-	0xd8, 0xe7, 0xc1, 0xe7, 0x3e, 0xcf, 0xdd, 0xf4, 0x79, 0x70, 0xdb, 0xe7, 0xc1, 0xf7, 0x3e, 0x0f,
-	0x7e, 0xf6, 0x79, 0xee, 0xbe, 0xcf, 0x83, 0x4f, 0x3f, 0x78, 0xee, 0x77, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x04, 0x51, 0x15, 0xa2, 0xc1, 0x03, 0x00, 0x00,
+	// 628 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x74, 0x53, 0xcd, 0x52, 0xdb, 0x3a,
+	0x18, 0x8d, 0xb9, 0x09, 0x3f, 0x0a, 0x01, 0x21, 0xb8, 0x10, 0x02, 0xf8, 0x32, 0xdc, 0xc5, 0x65,
+	0xe6, 0xd2, 0x30, 0xc3, 0xf4, 0x05, 0x4a, 0x12, 0x18, 0x3a, 0x14, 0x18, 0x87, 0x74, 0x2f, 0xac,
+	0x2f, 0x89, 0x26, 0x8a, 0x3e, 0x57, 0x96, 0x43, 0x61, 0xd5, 0x47, 0xe8, 0x63, 0xf4, 0x51, 0x58,
+	0x74, 0xc1, 0xb2, 0xab, 0x4e, 0x71, 0x37, 0x5d, 0xb2, 0xe8, 0x03, 0x74, 0x6c, 0x07, 0x9a, 0x96,
+	0x74, 0x67, 0x9d, 0xa3, 0x73, 0x7c, 0xf4, 0xfd, 0x90, 0x22, 0x18, 0x83, 0xa6, 0x1a, 0x18, 0xb4,
+	0xc8, 0x26, 0xfb, 0x28, 0x40, 0x85, 0x95, 0x67, 0x1d, 0x69, 0xbb, 0xd1, 0x45, 0xd5, 0xc7, 0xfe,
+	0x6e, 0x07, 0x3b, 0xb8, 0x9b, 0xd2, 0x17, 0x51, 0x3b, 0x3d, 0xa5, 0x87, 0xf4, 0x2b, 0x93, 0x6d,
+	0x7d, 0x9c, 0x24, 0x85, 0x46, 0x62, 0xc3, 0x76, 0x48, 0xde, 0x5e, 0x05, 0x50, 0x76, 0x36, 0x9d,
+	0xed, 0xb9, 0x3d, 0x56, 0xcd, 0xfc, 0xaa, 0x29, 0x59, 0x3d, 0xbf, 0x0a, 0x60, 0x3f, 0x7f, 0xf3,
+	0xf9, 0x9f, 0x9c, 0x97, 0xde, 0x62, 0x2e, 0x99, 0xea, 0x43, 0x18, 0xf2, 0x0e, 0x94, 0x27, 0x36,
+	0x9d, 0xed, 0x99, 0x21, 0xf9, 0x00, 0x6e, 0x7d, 0x2f, 0x90, 0x7c, 0x22, 0x62, 0x94, 0xcc, 0xb6,
+	0x74, 0x4f, 0xe3, 0xa5, 0x4e, 0x9d, 0x68, 0x8e, 0x2d, 0x90, 0xd2, 0x91, 0x1e, 0x70, 0x25, 0x45,
+	0x1d, 0xfb, 0x5c, 0x6a, 0xea, 0x24, 0x50, 0x4b, 0xf7, 0xf0, 0x52, 0xbf, 0x06, 0x13, 0x4a, 0xd4,
+	0x74, 0x62, 0xe4, 0x96, 0x07, 0x3e, 0x1a, 0x41, 0xff, 0x62, 0x8c, 0xcc, 0x3d, 0x42, 0x6f, 0x22,
+	0x08, 0x2d, 0xcd, 0xb3, 0x45, 0x32, 0xff, 0x88, 0x85, 0x01, 0xea, 0x10, 0x68, 0x81, 0x55, 0xc8,
+	0xf2, 0x10, 0x3c, 0x1b, 0x3e, 0xfe, 0x55, 0x16, 0x8b, 0x4e, 0xb2, 0x79, 0x52, 0x1c, 0x72, 0x2f,
+	0x9b, 0xa7, 0x27, 0x74, 0x8a, 0x95, 0xc9, 0xd2, 0x01, 0x97, 0x0a, 0xc4, 0x39, 0x9e, 0x06, 0xa0,
+	0x1b, 0x7a, 0x00, 0x0a, 0x03, 0xa0, 0xd3, 0x23, 0x36, 0x4d, 0xcb, 0x2d, 0x9c, 0x1b, 0xae, 0x43,
+	0x69, 0x93, 0x78, 0x33, 0xd9, 0xb3, 0x78, 0x64, 0xbb, 0x68, 0xe4, 0x35, 0x08, 0x4a, 0xd8, 0x12,
+	0xa1, 0x1e, 0x84, 0x18, 0x19, 0x1f, 0x6a, 0xa8, 0xdb, 0x4a, 0xfa, 0x96, 0x16, 0x93, 0xcc, 0x0f,
+	0x68, 0xe3, 0xad, 0x0c, 0x6d, 0x48, 0x67, 0x47, 0x6f, 0x9e, 0xa0, 0x3d, 0xc0, 0x48, 0x0b, 0x5a,
+	0x4a, 0x82, 0x79, 0x18, 0x59, 0x30, 0x59, 0x9d, 0xe6, 0xd8, 0x3a, 0x29, 0xbf, 0xf0, 0x6d, 0xc4,
+	0xd5, 0xb1, 0x77, 0x56, 0xe3, 0x5a, 0xa3, 0xdd, 0x87, 0x9a, 0xe2, 0xb2, 0x0f, 0x82, 0xce, 0x8f,
+	0x65, 0x9b, 0x96, 0x1b, 0x0b, 0x82, 0xd2, 0xf1, 0x5a, 0xc3, 0xc3, 0x2e, 0x08, 0xba, 0xc0, 0xd6,
+	0xc8, 0xca, 0x13, 0x36, 0xab, 0x01, 0x65, 0x63, 0xa5, 0x1e, 0xf4, 0x71, 0x00, 0x82, 0x2e, 0xfe,
+	0xe1, 0xb7, 0x18, 0x04, 0x20, 0xe8, 0x12, 0x73, 0x49, 0xe5, 0x09, 0xdb, 0xd2, 0xfe, 0x30, 0xf4,
+	0xdf, 0x63, 0xf9, 0xc6, 0x80, 0xfb, 0x11, 0x4f, 0x62, 0x2f, 0xb3, 0x0d, 0xb2, 0x5a, 0x87, 0x50,
+	0x1a, 0x10, 0xa3, 0x06, 0x81, 0x48, 0xe9, 0x95, 0xa4, 0x21, 0x5e, 0xa4, 0xb5, 0xd4, 0x9d, 0x53,
+	0x5d, 0x97, 0xed, 0x36, 0x18, 0xd0, 0xb6, 0x06, 0x4a, 0xd1, 0x32, 0xfb, 0x9f, 0xfc, 0xf7, 0x53,
+	0xda, 0xf4, 0xbb, 0x20, 0x22, 0x25, 0x75, 0xe7, 0x48, 0xb7, 0xf1, 0x77, 0xa3, 0xd5, 0xa4, 0x2b,
+	0x87, 0xad, 0xa3, 0xfa, 0x21, 0x68, 0x30, 0x3c, 0xed, 0x68, 0x25, 0xa9, 0x7f, 0x1d, 0x42, 0x30,
+	0x92, 0x2b, 0x79, 0x0d, 0x74, 0x8d, 0xcd, 0x92, 0xe9, 0x3a, 0x70, 0xa1, 0xd0, 0xef, 0xd1, 0xf5,
+	0x6c, 0x44, 0x0d, 0xf8, 0x38, 0x00, 0xc3, 0x2f, 0x14, 0xd0, 0x8d, 0x04, 0x3a, 0x46, 0xbf, 0x57,
+	0x43, 0xa5, 0x64, 0x3a, 0xb5, 0xee, 0xd6, 0x73, 0x52, 0x4a, 0xdb, 0xf7, 0x30, 0x8c, 0xec, 0x5f,
+	0x52, 0x48, 0xb7, 0x34, 0x5d, 0xab, 0xe2, 0x5e, 0xe9, 0x97, 0xb5, 0xf2, 0x32, 0x6e, 0x7f, 0xe7,
+	0xf6, 0xce, 0x75, 0x3e, 0xdd, 0xb9, 0xb9, 0xfb, 0x3b, 0xd7, 0x79, 0x17, 0xbb, 0xce, 0x87, 0xd8,
+	0xcd, 0xdd, 0xc4, 0xae, 0x73, 0x1b, 0xbb, 0xce, 0x97, 0xd8, 0x75, 0xbe, 0xc5, 0x6e, 0xee, 0x3e,
+	0x76, 0x9d, 0xf7, 0x5f, 0xdd, 0xdc, 0x8f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xea, 0x8c, 0x0f, 0x23,
+	0xf7, 0x03, 0x00, 0x00,
 }
@@ -71,6 +71,11 @@ var (
 		Message: "the request received is invalid",
 	}
 
+	ErrUnauthorized = &Error{
+		Type:    Error_Unauthorized,
+		Message: "the client is not authorized to make this request",
+	}
+
 	ErrUnknownError = &Error{
 		Type:    Error_UnknownError,
 		Message: "the request failed for an unknown reason",
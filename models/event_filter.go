@@ -0,0 +1,182 @@
+package models
+
+import "net/url"
+
+const (
+	EventFilterDomainParam      = "domain"
+	EventFilterProcessGuidParam = "process_guid"
+	EventFilterCellIDParam      = "cell_id"
+	EventFilterEventTypeParam   = "event_type"
+)
+
+// EventFilter restricts the events delivered to an event stream subscriber.
+// Empty fields match every event. An event that does not carry the filtered
+// attribute, such as a DesiredLRP event when filtering by CellID, does not
+// match.
+//
+// AllowedDomains is set by the BBS to the domains the subscriber may read and
+// is not sent by clients.
+type EventFilter struct {
+	Domain         string
+	ProcessGuids   []string
+	CellID         string
+	EventTypes     []string
+	AllowedDomains []string
+}
+
+func NewEventFilterFromQuery(query url.Values) EventFilter {
+	return EventFilter{
+		Domain:       query.Get(EventFilterDomainParam),
+		ProcessGuids: query[EventFilterProcessGuidParam],
+		CellID:       query.Get(EventFilterCellIDParam),
+		EventTypes:   query[EventFilterEventTypeParam],
+	}
+}
+
+func NewEventFilterFromRequest(request *EventsRequest) EventFilter {
+	return EventFilter{
+		Domain:       request.Domain,
+		ProcessGuids: request.ProcessGuids,
+		CellID:       request.CellId,
+		EventTypes:   request.EventTypes,
+	}
+}
+
+func (filter EventFilter) QueryParams() url.Values {
+	query := url.Values{}
+	if filter.Domain != "" {
+		query.Set(EventFilterDomainParam, filter.Domain)
+	}
+	for _, processGuid := range filter.ProcessGuids {
+		query.Add(EventFilterProcessGuidParam, processGuid)
+	}
+	if filter.CellID != "" {
+		query.Set(EventFilterCellIDParam, filter.CellID)
+	}
+	for _, eventType := range filter.EventTypes {
+		query.Add(EventFilterEventTypeParam, eventType)
+	}
+	return query
+}
+
+func (filter EventFilter) Empty() bool {
+	return filter.Domain == "" && len(filter.ProcessGuids) == 0 && filter.CellID == "" && len(filter.EventTypes) == 0 && len(filter.AllowedDomains) == 0
+}
+
+// Matches returns true if the event satisfies every criterion in the filter.
+// Change events match if either their before or after state does, so that
+// subscribers see records moving out of the filtered set.
+func (filter EventFilter) Matches(event Event) bool {
+	if filter.Empty() {
+		return true
+	}
+
+	if len(filter.EventTypes) > 0 && !contains(filter.EventTypes, event.EventType()) {
+		return false
+	}
+
+	if filter.Domain == "" && len(filter.ProcessGuids) == 0 && filter.CellID == "" && len(filter.AllowedDomains) == 0 {
+		return true
+	}
+
+	for _, attributes := range eventAttributes(event) {
+		if filter.matchesAttributes(attributes) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type eventAttributeSet struct {
+	domain      string
+	processGuid string
+	cellID      string
+	hasProcess  bool
+	hasCell     bool
+}
+
+func (filter EventFilter) matchesAttributes(attributes eventAttributeSet) bool {
+	if filter.Domain != "" && filter.Domain != attributes.domain {
+		return false
+	}
+
+	if len(filter.AllowedDomains) > 0 && !contains(filter.AllowedDomains, attributes.domain) {
+		return false
+	}
+
+	if len(filter.ProcessGuids) > 0 && (!attributes.hasProcess || !contains(filter.ProcessGuids, attributes.processGuid)) {
+		return false
+	}
+
+	if filter.CellID != "" && (!attributes.hasCell || filter.CellID != attributes.cellID) {
+		return false
+	}
+
+	return true
+}
+
+func eventAttributes(event Event) []eventAttributeSet {
+	switch event := event.(type) {
+	case *DesiredLRPCreatedEvent:
+		return []eventAttributeSet{desiredLRPAttributes(event.DesiredLrp)}
+	case *DesiredLRPChangedEvent:
+		return []eventAttributeSet{desiredLRPAttributes(event.Before), desiredLRPAttributes(event.After)}
+	case *DesiredLRPRemovedEvent:
+		return []eventAttributeSet{desiredLRPAttributes(event.DesiredLrp)}
+	case *ActualLRPCreatedEvent:
+		return []eventAttributeSet{actualLRPGroupAttributes(event.ActualLrpGroup)}
+	case *ActualLRPChangedEvent:
+		return []eventAttributeSet{actualLRPGroupAttributes(event.Before), actualLRPGroupAttributes(event.After)}
+	case *ActualLRPRemovedEvent:
+		return []eventAttributeSet{actualLRPGroupAttributes(event.ActualLrpGroup)}
+	case *ActualLRPCrashedEvent:
+		return []eventAttributeSet{{
+			domain:      event.ActualLRPKey.Domain,
+			processGuid: event.ActualLRPKey.ProcessGuid,
+			cellID:      event.ActualLRPInstanceKey.CellId,
+			hasProcess:  true,
+			hasCell:     true,
+		}}
+	case *TaskCreatedEvent:
+		return []eventAttributeSet{taskAttributes(event.Task)}
+	case *TaskChangedEvent:
+		return []eventAttributeSet{taskAttributes(event.Before), taskAttributes(event.After)}
+	case *TaskRemovedEvent:
+		return []eventAttributeSet{taskAttributes(event.Task)}
+	default:
+		return nil
+	}
+}
+
+func desiredLRPAttributes(desiredLRP *DesiredLRP) eventAttributeSet {
+	return eventAttributeSet{
+		domain:      desiredLRP.GetDomain(),
+		processGuid: desiredLRP.GetProcessGuid(),
+		hasProcess:  true,
+	}
+}
+
+func actualLRPGroupAttributes(group *ActualLRPGroup) eventAttributeSet {
+	if group == nil || (group.Instance == nil && group.Evacuating == nil) {
+		return eventAttributeSet{}
+	}
+
+	actualLRP, _ := group.Resolve()
+
+	return eventAttributeSet{
+		domain:      actualLRP.Domain,
+		processGuid: actualLRP.ProcessGuid,
+		cellID:      actualLRP.CellId,
+		hasProcess:  true,
+		hasCell:     true,
+	}
+}
+
+func taskAttributes(task *Task) eventAttributeSet {
+	return eventAttributeSet{
+		domain:  task.GetDomain(),
+		cellID:  task.GetCellId(),
+		hasCell: true,
+	}
+}
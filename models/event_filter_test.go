@@ -0,0 +1,180 @@
+package models_test
+
+import (
+	"net/url"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EventFilter", func() {
+	var (
+		filter models.EventFilter
+
+		desiredLRP *models.DesiredLRP
+		actualLRP  *models.ActualLRP
+		task       *models.Task
+	)
+
+	BeforeEach(func() {
+		filter = models.EventFilter{}
+
+		desiredLRP = model_helpers.NewValidDesiredLRP("some-guid")
+		desiredLRP.Domain = "some-domain"
+
+		actualLRP = model_helpers.NewValidActualLRP("some-guid", 0)
+		actualLRP.Domain = "some-domain"
+		actualLRP.CellId = "some-cell"
+
+		task = model_helpers.NewValidTask("some-task-guid")
+		task.Domain = "some-domain"
+		task.CellId = "some-cell"
+	})
+
+	Describe("Matches", func() {
+		Context("when the filter is empty", func() {
+			It("matches every event", func() {
+				Expect(filter.Matches(models.NewDesiredLRPCreatedEvent(desiredLRP))).To(BeTrue())
+				Expect(filter.Matches(models.NewActualLRPCrashedEvent(actualLRP))).To(BeTrue())
+				Expect(filter.Matches(models.NewTaskCreatedEvent(task))).To(BeTrue())
+			})
+		})
+
+		Context("when filtering by domain", func() {
+			BeforeEach(func() {
+				filter.Domain = "some-domain"
+			})
+
+			It("matches events in the domain", func() {
+				Expect(filter.Matches(models.NewDesiredLRPCreatedEvent(desiredLRP))).To(BeTrue())
+				Expect(filter.Matches(models.NewActualLRPCreatedEvent(&models.ActualLRPGroup{Instance: actualLRP}))).To(BeTrue())
+				Expect(filter.Matches(models.NewTaskRemovedEvent(task))).To(BeTrue())
+			})
+
+			It("does not match events in other domains", func() {
+				desiredLRP.Domain = "other-domain"
+				actualLRP.Domain = "other-domain"
+				task.Domain = "other-domain"
+
+				Expect(filter.Matches(models.NewDesiredLRPCreatedEvent(desiredLRP))).To(BeFalse())
+				Expect(filter.Matches(models.NewActualLRPCreatedEvent(&models.ActualLRPGroup{Instance: actualLRP}))).To(BeFalse())
+				Expect(filter.Matches(models.NewTaskRemovedEvent(task))).To(BeFalse())
+			})
+		})
+
+		Context("when restricted to allowed domains", func() {
+			BeforeEach(func() {
+				filter.AllowedDomains = []string{"other-domain", "some-domain"}
+			})
+
+			It("matches events in the allowed domains", func() {
+				Expect(filter.Matches(models.NewDesiredLRPCreatedEvent(desiredLRP))).To(BeTrue())
+				Expect(filter.Matches(models.NewActualLRPCrashedEvent(actualLRP))).To(BeTrue())
+				Expect(filter.Matches(models.NewTaskCreatedEvent(task))).To(BeTrue())
+			})
+
+			It("does not match events in other domains", func() {
+				desiredLRP.Domain = "forbidden-domain"
+				task.Domain = "forbidden-domain"
+
+				Expect(filter.Matches(models.NewDesiredLRPCreatedEvent(desiredLRP))).To(BeFalse())
+				Expect(filter.Matches(models.NewTaskCreatedEvent(task))).To(BeFalse())
+			})
+		})
+
+		Context("when filtering by process guid", func() {
+			BeforeEach(func() {
+				filter.ProcessGuids = []string{"other-guid", "some-guid"}
+			})
+
+			It("matches LRP events for any of the process guids", func() {
+				Expect(filter.Matches(models.NewDesiredLRPRemovedEvent(desiredLRP))).To(BeTrue())
+				Expect(filter.Matches(models.NewActualLRPCrashedEvent(actualLRP))).To(BeTrue())
+			})
+
+			It("does not match LRP events for other process guids", func() {
+				desiredLRP.ProcessGuid = "unknown-guid"
+				actualLRP.ProcessGuid = "unknown-guid"
+
+				Expect(filter.Matches(models.NewDesiredLRPRemovedEvent(desiredLRP))).To(BeFalse())
+				Expect(filter.Matches(models.NewActualLRPCrashedEvent(actualLRP))).To(BeFalse())
+			})
+
+			It("does not match task events", func() {
+				Expect(filter.Matches(models.NewTaskCreatedEvent(task))).To(BeFalse())
+			})
+		})
+
+		Context("when filtering by cell id", func() {
+			BeforeEach(func() {
+				filter.CellID = "some-cell"
+			})
+
+			It("matches actual LRP and task events on the cell", func() {
+				Expect(filter.Matches(models.NewActualLRPRemovedEvent(&models.ActualLRPGroup{Instance: actualLRP}))).To(BeTrue())
+				Expect(filter.Matches(models.NewTaskCreatedEvent(task))).To(BeTrue())
+			})
+
+			It("matches change events moving off the cell", func() {
+				after := *actualLRP
+				after.CellId = "other-cell"
+
+				event := models.NewActualLRPChangedEvent(
+					&models.ActualLRPGroup{Instance: actualLRP},
+					&models.ActualLRPGroup{Instance: &after},
+				)
+				Expect(filter.Matches(event)).To(BeTrue())
+			})
+
+			It("does not match events on other cells", func() {
+				actualLRP.CellId = "other-cell"
+				task.CellId = "other-cell"
+
+				Expect(filter.Matches(models.NewActualLRPRemovedEvent(&models.ActualLRPGroup{Instance: actualLRP}))).To(BeFalse())
+				Expect(filter.Matches(models.NewTaskCreatedEvent(task))).To(BeFalse())
+			})
+
+			It("does not match desired LRP events", func() {
+				Expect(filter.Matches(models.NewDesiredLRPCreatedEvent(desiredLRP))).To(BeFalse())
+			})
+		})
+
+		Context("when filtering by event type", func() {
+			BeforeEach(func() {
+				filter.EventTypes = []string{models.EventTypeActualLRPCrashed, models.EventTypeTaskCreated}
+			})
+
+			It("matches events of the given types", func() {
+				Expect(filter.Matches(models.NewActualLRPCrashedEvent(actualLRP))).To(BeTrue())
+				Expect(filter.Matches(models.NewTaskCreatedEvent(task))).To(BeTrue())
+			})
+
+			It("does not match events of other types", func() {
+				Expect(filter.Matches(models.NewDesiredLRPCreatedEvent(desiredLRP))).To(BeFalse())
+				Expect(filter.Matches(models.NewTaskRemovedEvent(task))).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("QueryParams", func() {
+		It("round-trips through NewEventFilterFromQuery", func() {
+			filter = models.EventFilter{
+				Domain:       "some-domain",
+				ProcessGuids: []string{"guid-1", "guid-2"},
+				CellID:       "some-cell",
+				EventTypes:   []string{models.EventTypeActualLRPCrashed},
+			}
+
+			query, err := url.ParseQuery(filter.QueryParams().Encode())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(models.NewEventFilterFromQuery(query)).To(Equal(filter))
+		})
+
+		It("encodes an empty filter as no parameters", func() {
+			Expect(filter.QueryParams()).To(BeEmpty())
+		})
+	})
+})
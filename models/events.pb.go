@@ -9,6 +9,8 @@ import fmt "fmt"
 import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
 
+import bytes "bytes"
+
 import strings "strings"
 import reflect "reflect"
 
@@ -158,6 +160,152 @@ func (m *ActualLRPCrashedEvent) GetSince() int64 {
 	return 0
 }
 
+type TaskCreatedEvent struct {
+	Task *Task `protobuf:"bytes,1,opt,name=task" json:"task,omitempty"`
+}
+
+func (m *TaskCreatedEvent) Reset()                    { *m = TaskCreatedEvent{} }
+func (*TaskCreatedEvent) ProtoMessage()               {}
+func (*TaskCreatedEvent) Descriptor() ([]byte, []int) { return fileDescriptorEvents, []int{7} }
+
+func (m *TaskCreatedEvent) GetTask() *Task {
+	if m != nil {
+		return m.Task
+	}
+	return nil
+}
+
+type TaskChangedEvent struct {
+	Before *Task `protobuf:"bytes,1,opt,name=before" json:"before,omitempty"`
+	After  *Task `protobuf:"bytes,2,opt,name=after" json:"after,omitempty"`
+}
+
+func (m *TaskChangedEvent) Reset()                    { *m = TaskChangedEvent{} }
+func (*TaskChangedEvent) ProtoMessage()               {}
+func (*TaskChangedEvent) Descriptor() ([]byte, []int) { return fileDescriptorEvents, []int{8} }
+
+func (m *TaskChangedEvent) GetBefore() *Task {
+	if m != nil {
+		return m.Before
+	}
+	return nil
+}
+
+func (m *TaskChangedEvent) GetAfter() *Task {
+	if m != nil {
+		return m.After
+	}
+	return nil
+}
+
+type TaskRemovedEvent struct {
+	Task *Task `protobuf:"bytes,1,opt,name=task" json:"task,omitempty"`
+}
+
+func (m *TaskRemovedEvent) Reset()                    { *m = TaskRemovedEvent{} }
+func (*TaskRemovedEvent) ProtoMessage()               {}
+func (*TaskRemovedEvent) Descriptor() ([]byte, []int) { return fileDescriptorEvents, []int{9} }
+
+func (m *TaskRemovedEvent) GetTask() *Task {
+	if m != nil {
+		return m.Task
+	}
+	return nil
+}
+
+type ResyncRequiredEvent struct {
+	LastEventId uint64 `protobuf:"varint,1,opt,name=last_event_id,json=lastEventId" json:"last_event_id"`
+}
+
+func (m *ResyncRequiredEvent) Reset()                    { *m = ResyncRequiredEvent{} }
+func (*ResyncRequiredEvent) ProtoMessage()               {}
+func (*ResyncRequiredEvent) Descriptor() ([]byte, []int) { return fileDescriptorEvents, []int{10} }
+
+func (m *ResyncRequiredEvent) GetLastEventId() uint64 {
+	if m != nil {
+		return m.LastEventId
+	}
+	return 0
+}
+
+type EventsRequest struct {
+	Domain       string   `protobuf:"bytes,1,opt,name=domain" json:"domain"`
+	ProcessGuids []string `protobuf:"bytes,2,rep,name=process_guids,json=processGuids" json:"process_guids,omitempty"`
+	CellId       string   `protobuf:"bytes,3,opt,name=cell_id,json=cellId" json:"cell_id"`
+	EventTypes   []string `protobuf:"bytes,4,rep,name=event_types,json=eventTypes" json:"event_types,omitempty"`
+	LastEventId  uint64   `protobuf:"varint,5,opt,name=last_event_id,json=lastEventId" json:"last_event_id"`
+}
+
+func (m *EventsRequest) Reset()                    { *m = EventsRequest{} }
+func (*EventsRequest) ProtoMessage()               {}
+func (*EventsRequest) Descriptor() ([]byte, []int) { return fileDescriptorEvents, []int{11} }
+
+func (m *EventsRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *EventsRequest) GetProcessGuids() []string {
+	if m != nil {
+		return m.ProcessGuids
+	}
+	return nil
+}
+
+func (m *EventsRequest) GetCellId() string {
+	if m != nil {
+		return m.CellId
+	}
+	return ""
+}
+
+func (m *EventsRequest) GetEventTypes() []string {
+	if m != nil {
+		return m.EventTypes
+	}
+	return nil
+}
+
+func (m *EventsRequest) GetLastEventId() uint64 {
+	if m != nil {
+		return m.LastEventId
+	}
+	return 0
+}
+
+type EventEnvelope struct {
+	Id      uint64 `protobuf:"varint,1,opt,name=id" json:"id"`
+	Type    string `protobuf:"bytes,2,opt,name=type" json:"type"`
+	Payload []byte `protobuf:"bytes,3,opt,name=payload" json:"payload"`
+}
+
+func (m *EventEnvelope) Reset()                    { *m = EventEnvelope{} }
+func (*EventEnvelope) ProtoMessage()               {}
+func (*EventEnvelope) Descriptor() ([]byte, []int) { return fileDescriptorEvents, []int{12} }
+
+func (m *EventEnvelope) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventEnvelope) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *EventEnvelope) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ActualLRPCreatedEvent)(nil), "models.ActualLRPCreatedEvent")
 	proto.RegisterType((*ActualLRPChangedEvent)(nil), "models.ActualLRPChangedEvent")
@@ -166,6 +314,12 @@ func init() {
 	proto.RegisterType((*DesiredLRPChangedEvent)(nil), "models.DesiredLRPChangedEvent")
 	proto.RegisterType((*DesiredLRPRemovedEvent)(nil), "models.DesiredLRPRemovedEvent")
 	proto.RegisterType((*ActualLRPCrashedEvent)(nil), "models.ActualLRPCrashedEvent")
+	proto.RegisterType((*TaskCreatedEvent)(nil), "models.TaskCreatedEvent")
+	proto.RegisterType((*TaskChangedEvent)(nil), "models.TaskChangedEvent")
+	proto.RegisterType((*TaskRemovedEvent)(nil), "models.TaskRemovedEvent")
+	proto.RegisterType((*ResyncRequiredEvent)(nil), "models.ResyncRequiredEvent")
+	proto.RegisterType((*EventsRequest)(nil), "models.EventsRequest")
+	proto.RegisterType((*EventEnvelope)(nil), "models.EventEnvelope")
 }
 func (this *ActualLRPCreatedEvent) Equal(that interface{}) bool {
 	if that == nil {
@@ -395,105 +549,395 @@ func (this *ActualLRPCrashedEvent) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *ActualLRPCreatedEvent) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&models.ActualLRPCreatedEvent{")
-	if this.ActualLrpGroup != nil {
-		s = append(s, "ActualLrpGroup: "+fmt.Sprintf("%#v", this.ActualLrpGroup)+",\n")
+func (this *TaskCreatedEvent) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ActualLRPChangedEvent) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*TaskCreatedEvent)
+	if !ok {
+		that2, ok := that.(TaskCreatedEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&models.ActualLRPChangedEvent{")
-	if this.Before != nil {
-		s = append(s, "Before: "+fmt.Sprintf("%#v", this.Before)+",\n")
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	if this.After != nil {
-		s = append(s, "After: "+fmt.Sprintf("%#v", this.After)+",\n")
+	if !this.Task.Equal(that1.Task) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *ActualLRPRemovedEvent) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *TaskChangedEvent) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&models.ActualLRPRemovedEvent{")
-	if this.ActualLrpGroup != nil {
-		s = append(s, "ActualLrpGroup: "+fmt.Sprintf("%#v", this.ActualLrpGroup)+",\n")
+
+	that1, ok := that.(*TaskChangedEvent)
+	if !ok {
+		that2, ok := that.(TaskChangedEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DesiredLRPCreatedEvent) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&models.DesiredLRPCreatedEvent{")
-	if this.DesiredLrp != nil {
-		s = append(s, "DesiredLrp: "+fmt.Sprintf("%#v", this.DesiredLrp)+",\n")
+	if !this.Before.Equal(that1.Before) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if !this.After.Equal(that1.After) {
+		return false
+	}
+	return true
 }
-func (this *DesiredLRPChangedEvent) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *TaskRemovedEvent) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&models.DesiredLRPChangedEvent{")
-	if this.Before != nil {
-		s = append(s, "Before: "+fmt.Sprintf("%#v", this.Before)+",\n")
+
+	that1, ok := that.(*TaskRemovedEvent)
+	if !ok {
+		that2, ok := that.(TaskRemovedEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.After != nil {
-		s = append(s, "After: "+fmt.Sprintf("%#v", this.After)+",\n")
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if !this.Task.Equal(that1.Task) {
+		return false
+	}
+	return true
 }
-func (this *DesiredLRPRemovedEvent) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ResyncRequiredEvent) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&models.DesiredLRPRemovedEvent{")
-	if this.DesiredLrp != nil {
-		s = append(s, "DesiredLrp: "+fmt.Sprintf("%#v", this.DesiredLrp)+",\n")
+
+	that1, ok := that.(*ResyncRequiredEvent)
+	if !ok {
+		that2, ok := that.(ResyncRequiredEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ActualLRPCrashedEvent) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&models.ActualLRPCrashedEvent{")
-	s = append(s, "ActualLRPKey: "+strings.Replace(this.ActualLRPKey.GoString(), `&`, ``, 1)+",\n")
-	s = append(s, "ActualLRPInstanceKey: "+strings.Replace(this.ActualLRPInstanceKey.GoString(), `&`, ``, 1)+",\n")
-	s = append(s, "CrashCount: "+fmt.Sprintf("%#v", this.CrashCount)+",\n")
-	s = append(s, "CrashReason: "+fmt.Sprintf("%#v", this.CrashReason)+",\n")
-	s = append(s, "Since: "+fmt.Sprintf("%#v", this.Since)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func valueToGoStringEvents(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if this.LastEventId != that1.LastEventId {
+		return false
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+	return true
+}
+func (this *EventsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*EventsRequest)
+	if !ok {
+		that2, ok := that.(EventsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Domain != that1.Domain {
+		return false
+	}
+	if len(this.ProcessGuids) != len(that1.ProcessGuids) {
+		return false
+	}
+	for i := range this.ProcessGuids {
+		if this.ProcessGuids[i] != that1.ProcessGuids[i] {
+			return false
+		}
+	}
+	if this.CellId != that1.CellId {
+		return false
+	}
+	if len(this.EventTypes) != len(that1.EventTypes) {
+		return false
+	}
+	for i := range this.EventTypes {
+		if this.EventTypes[i] != that1.EventTypes[i] {
+			return false
+		}
+	}
+	if this.LastEventId != that1.LastEventId {
+		return false
+	}
+	return true
+}
+func (this *EventEnvelope) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*EventEnvelope)
+	if !ok {
+		that2, ok := that.(EventEnvelope)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !bytes.Equal(this.Payload, that1.Payload) {
+		return false
+	}
+	return true
+}
+func (this *ActualLRPCreatedEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.ActualLRPCreatedEvent{")
+	if this.ActualLrpGroup != nil {
+		s = append(s, "ActualLrpGroup: "+fmt.Sprintf("%#v", this.ActualLrpGroup)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ActualLRPChangedEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.ActualLRPChangedEvent{")
+	if this.Before != nil {
+		s = append(s, "Before: "+fmt.Sprintf("%#v", this.Before)+",\n")
+	}
+	if this.After != nil {
+		s = append(s, "After: "+fmt.Sprintf("%#v", this.After)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ActualLRPRemovedEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.ActualLRPRemovedEvent{")
+	if this.ActualLrpGroup != nil {
+		s = append(s, "ActualLrpGroup: "+fmt.Sprintf("%#v", this.ActualLrpGroup)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DesiredLRPCreatedEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.DesiredLRPCreatedEvent{")
+	if this.DesiredLrp != nil {
+		s = append(s, "DesiredLrp: "+fmt.Sprintf("%#v", this.DesiredLrp)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DesiredLRPChangedEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.DesiredLRPChangedEvent{")
+	if this.Before != nil {
+		s = append(s, "Before: "+fmt.Sprintf("%#v", this.Before)+",\n")
+	}
+	if this.After != nil {
+		s = append(s, "After: "+fmt.Sprintf("%#v", this.After)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DesiredLRPRemovedEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.DesiredLRPRemovedEvent{")
+	if this.DesiredLrp != nil {
+		s = append(s, "DesiredLrp: "+fmt.Sprintf("%#v", this.DesiredLrp)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ActualLRPCrashedEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&models.ActualLRPCrashedEvent{")
+	s = append(s, "ActualLRPKey: "+strings.Replace(this.ActualLRPKey.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "ActualLRPInstanceKey: "+strings.Replace(this.ActualLRPInstanceKey.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "CrashCount: "+fmt.Sprintf("%#v", this.CrashCount)+",\n")
+	s = append(s, "CrashReason: "+fmt.Sprintf("%#v", this.CrashReason)+",\n")
+	s = append(s, "Since: "+fmt.Sprintf("%#v", this.Since)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TaskCreatedEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.TaskCreatedEvent{")
+	if this.Task != nil {
+		s = append(s, "Task: "+fmt.Sprintf("%#v", this.Task)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TaskChangedEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.TaskChangedEvent{")
+	if this.Before != nil {
+		s = append(s, "Before: "+fmt.Sprintf("%#v", this.Before)+",\n")
+	}
+	if this.After != nil {
+		s = append(s, "After: "+fmt.Sprintf("%#v", this.After)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TaskRemovedEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.TaskRemovedEvent{")
+	if this.Task != nil {
+		s = append(s, "Task: "+fmt.Sprintf("%#v", this.Task)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ResyncRequiredEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.ResyncRequiredEvent{")
+	s = append(s, "LastEventId: "+fmt.Sprintf("%#v", this.LastEventId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EventsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&models.EventsRequest{")
+	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
+	if this.ProcessGuids != nil {
+		s = append(s, "ProcessGuids: "+fmt.Sprintf("%#v", this.ProcessGuids)+",\n")
+	}
+	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
+	if this.EventTypes != nil {
+		s = append(s, "EventTypes: "+fmt.Sprintf("%#v", this.EventTypes)+",\n")
+	}
+	s = append(s, "LastEventId: "+fmt.Sprintf("%#v", this.LastEventId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EventEnvelope) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.EventEnvelope{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Payload: "+fmt.Sprintf("%#v", this.Payload)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringEvents(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
 }
 func (m *ActualLRPCreatedEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -727,34 +1171,239 @@ func (m *ActualLRPCrashedEvent) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeFixed64Events(dAtA []byte, offset int, v uint64) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	dAtA[offset+4] = uint8(v >> 32)
-	dAtA[offset+5] = uint8(v >> 40)
-	dAtA[offset+6] = uint8(v >> 48)
-	dAtA[offset+7] = uint8(v >> 56)
-	return offset + 8
-}
-func encodeFixed32Events(dAtA []byte, offset int, v uint32) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	return offset + 4
-}
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TaskCreatedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *ActualLRPCreatedEvent) Size() (n int) {
+
+func (m *TaskCreatedEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Task != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintEvents(dAtA, i, uint64(m.Task.Size()))
+		n11, err := m.Task.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	return i, nil
+}
+
+func (m *TaskChangedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskChangedEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Before != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintEvents(dAtA, i, uint64(m.Before.Size()))
+		n12, err := m.Before.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	if m.After != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintEvents(dAtA, i, uint64(m.After.Size()))
+		n13, err := m.After.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	return i, nil
+}
+
+func (m *TaskRemovedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskRemovedEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Task != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintEvents(dAtA, i, uint64(m.Task.Size()))
+		n14, err := m.Task.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	return i, nil
+}
+
+func (m *ResyncRequiredEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResyncRequiredEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintEvents(dAtA, i, uint64(m.LastEventId))
+	return i, nil
+}
+
+func (m *EventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintEvents(dAtA, i, uint64(len(m.Domain)))
+	i += copy(dAtA[i:], m.Domain)
+	if len(m.ProcessGuids) > 0 {
+		for _, s := range m.ProcessGuids {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintEvents(dAtA, i, uint64(len(m.CellId)))
+	i += copy(dAtA[i:], m.CellId)
+	if len(m.EventTypes) > 0 {
+		for _, s := range m.EventTypes {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintEvents(dAtA, i, uint64(m.LastEventId))
+	return i, nil
+}
+
+func (m *EventEnvelope) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventEnvelope) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintEvents(dAtA, i, uint64(len(m.Type)))
+	i += copy(dAtA[i:], m.Type)
+	if m.Payload != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Payload)))
+		i += copy(dAtA[i:], m.Payload)
+	}
+	return i, nil
+}
+
+func encodeFixed64Events(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Events(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *ActualLRPCreatedEvent) Size() (n int) {
 	var l int
 	_ = l
 	if m.ActualLrpGroup != nil {
@@ -836,6 +1485,83 @@ func (m *ActualLRPCrashedEvent) Size() (n int) {
 	return n
 }
 
+func (m *TaskCreatedEvent) Size() (n int) {
+	var l int
+	_ = l
+	if m.Task != nil {
+		l = m.Task.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *TaskChangedEvent) Size() (n int) {
+	var l int
+	_ = l
+	if m.Before != nil {
+		l = m.Before.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.After != nil {
+		l = m.After.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *TaskRemovedEvent) Size() (n int) {
+	var l int
+	_ = l
+	if m.Task != nil {
+		l = m.Task.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *ResyncRequiredEvent) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovEvents(uint64(m.LastEventId))
+	return n
+}
+
+func (m *EventsRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Domain)
+	n += 1 + l + sovEvents(uint64(l))
+	if len(m.ProcessGuids) > 0 {
+		for _, s := range m.ProcessGuids {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = len(m.CellId)
+	n += 1 + l + sovEvents(uint64(l))
+	if len(m.EventTypes) > 0 {
+		for _, s := range m.EventTypes {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	n += 1 + sovEvents(uint64(m.LastEventId))
+	return n
+}
+
+func (m *EventEnvelope) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovEvents(uint64(m.Id))
+	l = len(m.Type)
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Payload != nil {
+		l = len(m.Payload)
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	for {
 		n++
@@ -925,15 +1651,823 @@ func (this *ActualLRPCrashedEvent) String() string {
 	}, "")
 	return s
 }
+func (this *TaskCreatedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskCreatedEvent{`,
+		`Task:` + strings.Replace(fmt.Sprintf("%v", this.Task), "Task", "Task", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TaskChangedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskChangedEvent{`,
+		`Before:` + strings.Replace(fmt.Sprintf("%v", this.Before), "Task", "Task", 1) + `,`,
+		`After:` + strings.Replace(fmt.Sprintf("%v", this.After), "Task", "Task", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TaskRemovedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskRemovedEvent{`,
+		`Task:` + strings.Replace(fmt.Sprintf("%v", this.Task), "Task", "Task", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResyncRequiredEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResyncRequiredEvent{`,
+		`LastEventId:` + fmt.Sprintf("%v", this.LastEventId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventsRequest{`,
+		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
+		`ProcessGuids:` + fmt.Sprintf("%v", this.ProcessGuids) + `,`,
+		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
+		`EventTypes:` + fmt.Sprintf("%v", this.EventTypes) + `,`,
+		`LastEventId:` + fmt.Sprintf("%v", this.LastEventId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventEnvelope) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventEnvelope{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringEvents(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ActualLRPCreatedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActualLRPCreatedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActualLRPCreatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrpGroup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActualLrpGroup == nil {
+				m.ActualLrpGroup = &ActualLRPGroup{}
+			}
+			if err := m.ActualLrpGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActualLRPChangedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActualLRPChangedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActualLRPChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Before == nil {
+				m.Before = &ActualLRPGroup{}
+			}
+			if err := m.Before.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.After == nil {
+				m.After = &ActualLRPGroup{}
+			}
+			if err := m.After.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActualLRPRemovedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActualLRPRemovedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActualLRPRemovedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrpGroup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActualLrpGroup == nil {
+				m.ActualLrpGroup = &ActualLRPGroup{}
+			}
+			if err := m.ActualLrpGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DesiredLRPCreatedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesiredLRPCreatedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesiredLRPCreatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredLrp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DesiredLrp == nil {
+				m.DesiredLrp = &DesiredLRP{}
+			}
+			if err := m.DesiredLrp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DesiredLRPChangedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesiredLRPChangedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesiredLRPChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Before == nil {
+				m.Before = &DesiredLRP{}
+			}
+			if err := m.Before.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.After == nil {
+				m.After = &DesiredLRP{}
+			}
+			if err := m.After.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DesiredLRPRemovedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesiredLRPRemovedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesiredLRPRemovedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredLrp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DesiredLrp == nil {
+				m.DesiredLrp = &DesiredLRP{}
+			}
+			if err := m.DesiredLrp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActualLRPCrashedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActualLRPCrashedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActualLRPCrashedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualLRPKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActualLRPKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualLRPInstanceKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActualLRPInstanceKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrashCount", wireType)
+			}
+			m.CrashCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CrashCount |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrashReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CrashReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			m.Since = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Since |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ActualLRPCreatedEvent) Unmarshal(dAtA []byte) error {
+func (m *TaskCreatedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -956,15 +2490,15 @@ func (m *ActualLRPCreatedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActualLRPCreatedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskCreatedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActualLRPCreatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskCreatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrpGroup", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -988,10 +2522,10 @@ func (m *ActualLRPCreatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ActualLrpGroup == nil {
-				m.ActualLrpGroup = &ActualLRPGroup{}
+			if m.Task == nil {
+				m.Task = &Task{}
 			}
-			if err := m.ActualLrpGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1016,7 +2550,7 @@ func (m *ActualLRPCreatedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActualLRPChangedEvent) Unmarshal(dAtA []byte) error {
+func (m *TaskChangedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1039,10 +2573,10 @@ func (m *ActualLRPChangedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActualLRPChangedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskChangedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActualLRPChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1072,7 +2606,7 @@ func (m *ActualLRPChangedEvent) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Before == nil {
-				m.Before = &ActualLRPGroup{}
+				m.Before = &Task{}
 			}
 			if err := m.Before.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1105,7 +2639,7 @@ func (m *ActualLRPChangedEvent) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.After == nil {
-				m.After = &ActualLRPGroup{}
+				m.After = &Task{}
 			}
 			if err := m.After.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1132,7 +2666,7 @@ func (m *ActualLRPChangedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActualLRPRemovedEvent) Unmarshal(dAtA []byte) error {
+func (m *TaskRemovedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1155,15 +2689,15 @@ func (m *ActualLRPRemovedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActualLRPRemovedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskRemovedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActualLRPRemovedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskRemovedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrpGroup", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1187,10 +2721,10 @@ func (m *ActualLRPRemovedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ActualLrpGroup == nil {
-				m.ActualLrpGroup = &ActualLRPGroup{}
+			if m.Task == nil {
+				m.Task = &Task{}
 			}
-			if err := m.ActualLrpGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1215,7 +2749,7 @@ func (m *ActualLRPRemovedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DesiredLRPCreatedEvent) Unmarshal(dAtA []byte) error {
+func (m *ResyncRequiredEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1238,17 +2772,17 @@ func (m *DesiredLRPCreatedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DesiredLRPCreatedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResyncRequiredEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DesiredLRPCreatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResyncRequiredEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredLrp", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEventId", wireType)
 			}
-			var msglen int
+			m.LastEventId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1258,25 +2792,11 @@ func (m *DesiredLRPCreatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.LastEventId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DesiredLrp == nil {
-				m.DesiredLrp = &DesiredLRP{}
-			}
-			if err := m.DesiredLrp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1298,7 +2818,7 @@ func (m *DesiredLRPCreatedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DesiredLRPChangedEvent) Unmarshal(dAtA []byte) error {
+func (m *EventsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1321,17 +2841,17 @@ func (m *DesiredLRPChangedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DesiredLRPChangedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DesiredLRPChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1341,30 +2861,26 @@ func (m *DesiredLRPChangedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Before == nil {
-				m.Before = &DesiredLRP{}
-			}
-			if err := m.Before.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuids", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1374,80 +2890,55 @@ func (m *DesiredLRPChangedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.After == nil {
-				m.After = &DesiredLRP{}
-			}
-			if err := m.After.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ProcessGuids = append(m.ProcessGuids, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthEvents
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DesiredLRPRemovedEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DesiredLRPRemovedEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DesiredLRPRemovedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.CellId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredLrp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTypes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1457,25 +2948,40 @@ func (m *DesiredLRPRemovedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DesiredLrp == nil {
-				m.DesiredLrp = &DesiredLRP{}
+			m.EventTypes = append(m.EventTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEventId", wireType)
 			}
-			if err := m.DesiredLrp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.LastEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastEventId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1497,7 +3003,7 @@ func (m *DesiredLRPRemovedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActualLRPCrashedEvent) Unmarshal(dAtA []byte) error {
+func (m *EventEnvelope) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1520,17 +3026,17 @@ func (m *ActualLRPCrashedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActualLRPCrashedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventEnvelope: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActualLRPCrashedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventEnvelope: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActualLRPKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1540,27 +3046,16 @@ func (m *ActualLRPCrashedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Id |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ActualLRPKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActualLRPInstanceKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1570,46 +3065,26 @@ func (m *ActualLRPCrashedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ActualLRPInstanceKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CrashCount", wireType)
-			}
-			m.CrashCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CrashCount |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CrashReason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1619,40 +3094,23 @@ func (m *ActualLRPCrashedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CrashReason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
-			}
-			m.Since = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Since |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1782,35 +3240,49 @@ var (
 func init() { proto.RegisterFile("events.proto", fileDescriptorEvents) }
 
 var fileDescriptorEvents = []byte{
-	// 473 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xac, 0x92, 0xc1, 0x8a, 0xd3, 0x40,
-	0x18, 0xc7, 0x33, 0xbb, 0xdb, 0x05, 0xa7, 0x65, 0xd1, 0xb0, 0xd6, 0x50, 0x64, 0x5a, 0x0a, 0x42,
-	0x90, 0x9a, 0x05, 0x7d, 0x01, 0xb7, 0xab, 0xa8, 0x6c, 0x05, 0xc9, 0xcd, 0x53, 0x99, 0xa6, 0x5f,
-	0xd3, 0x60, 0x93, 0x09, 0x93, 0xc9, 0x42, 0x6f, 0x3e, 0x82, 0x8f, 0xe1, 0xa3, 0x2c, 0x9e, 0x7a,
-	0xf4, 0x54, 0x6c, 0xbc, 0xc8, 0x9e, 0xf6, 0x11, 0x24, 0x33, 0x93, 0xee, 0x64, 0xbb, 0x28, 0x88,
-	0xb7, 0xcc, 0xf7, 0xfd, 0xbf, 0xdf, 0x7c, 0xf3, 0x23, 0xb8, 0x05, 0x17, 0x90, 0x88, 0xcc, 0x4b,
-	0x39, 0x13, 0xcc, 0x3e, 0x8c, 0xd9, 0x14, 0x16, 0x59, 0xe7, 0x59, 0x18, 0x89, 0x79, 0x3e, 0xf1,
-	0x02, 0x16, 0x9f, 0x84, 0x2c, 0x64, 0x27, 0xb2, 0x3d, 0xc9, 0x67, 0xf2, 0x24, 0x0f, 0xf2, 0x4b,
-	0x8d, 0x75, 0xee, 0xd3, 0x40, 0xe4, 0x74, 0x31, 0x5e, 0xf0, 0x54, 0x57, 0x1e, 0x4c, 0x21, 0x8b,
-	0x38, 0x4c, 0x6f, 0x4a, 0xfd, 0x8f, 0xf8, 0xe1, 0xa9, 0x8c, 0x8d, 0xfc, 0x0f, 0x67, 0x1c, 0xa8,
-	0x80, 0xe9, 0xeb, 0xf2, 0x6e, 0xfb, 0x25, 0x36, 0xe6, 0xc7, 0x21, 0x67, 0x79, 0xea, 0xa0, 0x1e,
-	0x72, 0x9b, 0xcf, 0xdb, 0x9e, 0xda, 0xc7, 0xdb, 0x0e, 0xbe, 0x29, 0xbb, 0xfe, 0x91, 0xca, 0x8f,
-	0x78, 0x2a, 0xcf, 0xfd, 0xdc, 0x44, 0xcf, 0x69, 0x12, 0x56, 0x68, 0x0f, 0x1f, 0x4e, 0x60, 0xc6,
-	0x38, 0xfc, 0x05, 0xa8, 0x53, 0xf6, 0x00, 0x37, 0xe8, 0x4c, 0x00, 0x77, 0xf6, 0xfe, 0x18, 0x57,
-	0xa1, 0xda, 0x8b, 0x7c, 0x88, 0xd9, 0xc5, 0xff, 0x7b, 0xd1, 0x7b, 0xdc, 0x7e, 0xa5, 0x0c, 0xde,
-	0xb6, 0xf5, 0x02, 0x37, 0x0d, 0xb7, 0x1a, 0x6b, 0x57, 0xd8, 0x9b, 0x21, 0x1f, 0xeb, 0xd8, 0x88,
-	0xa7, 0xfd, 0xa4, 0x86, 0x33, 0x0d, 0x3d, 0xbd, 0x65, 0xe8, 0x2e, 0x52, 0x65, 0xc7, 0xad, 0xdb,
-	0xb9, 0x2b, 0xaa, 0xcd, 0xd4, 0xd6, 0xaf, 0xa9, 0xf9, 0xa7, 0xf5, 0xbf, 0xed, 0xd5, 0xfe, 0x1d,
-	0x9a, 0xcd, 0x2b, 0xdc, 0x5b, 0x7c, 0x64, 0x98, 0xfe, 0x04, 0x4b, 0x4d, 0x3c, 0xde, 0xf1, 0x7c,
-	0x0e, 0xcb, 0x61, 0xeb, 0x72, 0xdd, 0xb5, 0x56, 0xeb, 0x2e, 0xba, 0x5a, 0x77, 0x2d, 0xbf, 0xb5,
-	0x75, 0x7e, 0x0e, 0x4b, 0x9b, 0xe2, 0x47, 0x06, 0x29, 0x4a, 0x32, 0x41, 0x93, 0x00, 0x24, 0x52,
-	0x3d, 0xf7, 0xf1, 0x0e, 0xf2, 0x9d, 0x0e, 0xed, 0xa2, 0x8f, 0xb7, 0x68, 0x23, 0x63, 0x3f, 0xc1,
-	0xcd, 0xa0, 0x5c, 0x7e, 0x1c, 0xb0, 0x3c, 0x11, 0xce, 0x7e, 0x0f, 0xb9, 0x8d, 0xe1, 0x41, 0x39,
-	0xe8, 0x63, 0xd9, 0x38, 0x2b, 0xeb, 0xf6, 0x29, 0x6e, 0xa9, 0x18, 0x07, 0x9a, 0xb1, 0xc4, 0x39,
-	0xe8, 0x21, 0xf7, 0xde, 0x90, 0x94, 0xb9, 0xab, 0x75, 0xb7, 0x6d, 0xf6, 0x06, 0x2c, 0x8e, 0x04,
-	0xc4, 0xa9, 0x58, 0xfa, 0x0a, 0xed, 0xcb, 0xb2, 0xdd, 0xc1, 0x8d, 0x2c, 0x4a, 0x02, 0x70, 0x1a,
-	0x3d, 0xe4, 0xee, 0xeb, 0x3b, 0x54, 0x69, 0x38, 0x58, 0x6d, 0x88, 0xf5, 0x7d, 0x43, 0xac, 0xeb,
-	0x0d, 0x41, 0x9f, 0x0b, 0x82, 0xbe, 0x16, 0x04, 0x5d, 0x16, 0x04, 0xad, 0x0a, 0x82, 0x7e, 0x14,
-	0x04, 0xfd, 0x2a, 0x88, 0x75, 0x5d, 0x10, 0xf4, 0xe5, 0x27, 0xb1, 0x7e, 0x07, 0x00, 0x00, 0xff,
-	0xff, 0xab, 0x2f, 0x45, 0x5d, 0x20, 0x04, 0x00, 0x00,
+	// 695 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x18, 0xcd, 0xe4, 0x07, 0xc4, 0x97, 0x80, 0xb8, 0x73, 0xb9, 0x5c, 0x0b, 0x71, 0x9d, 0x28, 0xb7,
+	0x95, 0xac, 0x8a, 0x06, 0xa9, 0xed, 0xbe, 0x25, 0x14, 0x51, 0x04, 0x95, 0x2a, 0x8b, 0x4d, 0xa5,
+	0x4a, 0xd6, 0x60, 0x0f, 0xc1, 0xc2, 0xf1, 0xb8, 0x9e, 0x31, 0x92, 0x77, 0x7d, 0x84, 0x3e, 0x46,
+	0x1f, 0xa2, 0x0f, 0x80, 0xba, 0x62, 0xd9, 0x55, 0x54, 0xdc, 0x4d, 0xc5, 0x8a, 0x47, 0xa8, 0x66,
+	0x3c, 0xa6, 0x76, 0x42, 0x8b, 0x54, 0x75, 0xe7, 0x39, 0xdf, 0x39, 0x67, 0xce, 0x1c, 0x7d, 0x09,
+	0x74, 0xe8, 0x19, 0x0d, 0x05, 0x1f, 0x44, 0x31, 0x13, 0x0c, 0xcf, 0x8d, 0x99, 0x47, 0x03, 0xbe,
+	0xf6, 0x70, 0xe4, 0x8b, 0x93, 0xe4, 0x68, 0xe0, 0xb2, 0xf1, 0xe6, 0x88, 0x8d, 0xd8, 0xa6, 0x1a,
+	0x1f, 0x25, 0xc7, 0xea, 0xa4, 0x0e, 0xea, 0x2b, 0x97, 0xad, 0x2d, 0x13, 0x57, 0x24, 0x24, 0x70,
+	0x82, 0x38, 0xd2, 0xc8, 0x5f, 0x1e, 0xe5, 0x7e, 0x4c, 0xbd, 0x12, 0x04, 0x82, 0xf0, 0xd3, 0xfc,
+	0xbb, 0xff, 0x1a, 0xfe, 0xd9, 0x52, 0x92, 0x03, 0xfb, 0xd5, 0x76, 0x4c, 0x89, 0xa0, 0xde, 0x8e,
+	0xcc, 0x81, 0x9f, 0x41, 0xc9, 0xcb, 0x19, 0xc5, 0x2c, 0x89, 0x0c, 0xd4, 0x43, 0x56, 0xfb, 0xd1,
+	0xea, 0x20, 0xcf, 0x36, 0xb8, 0x11, 0xee, 0xca, 0xa9, 0xbd, 0x94, 0xf3, 0x0f, 0xe2, 0x48, 0x9d,
+	0xfb, 0x49, 0xd9, 0xfa, 0x84, 0x84, 0xa3, 0xc2, 0x7a, 0x00, 0x73, 0x47, 0xf4, 0x98, 0xc5, 0xf4,
+	0x0e, 0x43, 0xcd, 0xc2, 0x1b, 0xd0, 0x22, 0xc7, 0x82, 0xc6, 0x46, 0xfd, 0x97, 0xf4, 0x9c, 0x54,
+	0x79, 0x91, 0x4d, 0xc7, 0xec, 0xec, 0xcf, 0xbd, 0xe8, 0x25, 0xac, 0x3e, 0xcf, 0xdb, 0x9c, 0x6e,
+	0xeb, 0x31, 0xb4, 0x4b, 0x3d, 0x6b, 0x5b, 0x5c, 0xd8, 0xfe, 0x10, 0xd9, 0xa0, 0x69, 0x07, 0x71,
+	0xd4, 0x0f, 0x2b, 0x76, 0xe5, 0x86, 0x1e, 0x4c, 0x35, 0x74, 0x9b, 0x53, 0xd1, 0x8e, 0x55, 0x6d,
+	0xe7, 0x36, 0xaa, 0x6e, 0xa6, 0x12, 0xbf, 0x52, 0xcd, 0x6f, 0xc5, 0xff, 0x54, 0xaf, 0xec, 0x0e,
+	0xe1, 0x27, 0x85, 0xdd, 0x0b, 0x58, 0x2a, 0x35, 0x7d, 0x4a, 0x53, 0xed, 0xb8, 0x32, 0xd3, 0xf3,
+	0x3e, 0x4d, 0x87, 0x9d, 0xf3, 0x49, 0xb7, 0x76, 0x31, 0xe9, 0xa2, 0xab, 0x49, 0xb7, 0x66, 0x77,
+	0x6e, 0x3a, 0xdf, 0xa7, 0x29, 0x26, 0xf0, 0x6f, 0xc9, 0xc9, 0x0f, 0xb9, 0x20, 0xa1, 0x4b, 0x95,
+	0x65, 0xfe, 0xdc, 0xf5, 0x19, 0xcb, 0x3d, 0x4d, 0x9a, 0xb5, 0x5e, 0xb9, 0xb1, 0x2e, 0x71, 0xf0,
+	0x7d, 0x68, 0xbb, 0x32, 0xbc, 0xe3, 0xb2, 0x24, 0x14, 0x46, 0xa3, 0x87, 0xac, 0xd6, 0xb0, 0x29,
+	0x85, 0x36, 0xa8, 0xc1, 0xb6, 0xc4, 0xf1, 0x16, 0x74, 0x72, 0x5a, 0x4c, 0x09, 0x67, 0xa1, 0xd1,
+	0xec, 0x21, 0x6b, 0x61, 0x68, 0x4a, 0xde, 0xd5, 0xa4, 0xbb, 0x5a, 0x9e, 0x6d, 0xb0, 0xb1, 0x2f,
+	0xe8, 0x38, 0x12, 0xa9, 0x9d, 0x5b, 0xdb, 0x0a, 0xc6, 0x6b, 0xd0, 0xe2, 0x7e, 0xe8, 0x52, 0xa3,
+	0xd5, 0x43, 0x56, 0x43, 0xdf, 0x91, 0x43, 0xfd, 0x27, 0xb0, 0x7c, 0x48, 0xf8, 0x69, 0x65, 0xa9,
+	0x7a, 0xd0, 0x94, 0xbf, 0x54, 0x5d, 0x5e, 0xa7, 0x78, 0xa9, 0xe4, 0xd9, 0x6a, 0xd2, 0x7f, 0xa3,
+	0x55, 0xe5, 0xdd, 0xb9, 0x37, 0xb5, 0x3b, 0x55, 0x5d, 0xb1, 0x35, 0xfd, 0xea, 0xd6, 0x54, 0x49,
+	0x7a, 0x5f, 0x74, 0xa6, 0xca, 0xa6, 0xdc, 0x9d, 0xe9, 0x29, 0xfc, 0x6d, 0x53, 0x9e, 0x86, 0xae,
+	0x4d, 0xdf, 0x26, 0x7e, 0x5c, 0x08, 0x2d, 0x58, 0x0c, 0x08, 0x17, 0x8e, 0xfa, 0x97, 0x73, 0x7c,
+	0x4f, 0x39, 0x34, 0x75, 0x09, 0x6d, 0x39, 0x52, 0xbc, 0x3d, 0xaf, 0xff, 0x11, 0xc1, 0xa2, 0xfa,
+	0xe6, 0xd2, 0x81, 0x72, 0x81, 0xd7, 0x61, 0xce, 0x63, 0x63, 0xe2, 0x87, 0x4a, 0xb4, 0xa0, 0x45,
+	0x1a, 0xc3, 0xff, 0xc3, 0x62, 0x14, 0x33, 0x97, 0x72, 0xee, 0x8c, 0x12, 0xdf, 0xe3, 0x46, 0xbd,
+	0xd7, 0xb0, 0x16, 0xec, 0x8e, 0x06, 0x77, 0x25, 0x86, 0xff, 0x83, 0x79, 0x97, 0x06, 0x81, 0xbc,
+	0xb8, 0x51, 0xf6, 0x90, 0xe0, 0x9e, 0x87, 0xbb, 0xd0, 0xce, 0x83, 0x89, 0x34, 0xa2, 0xdc, 0x68,
+	0x2a, 0x07, 0x50, 0xd0, 0xa1, 0x44, 0x66, 0xe3, 0xb7, 0x7e, 0x16, 0xdf, 0xd1, 0xe9, 0x77, 0xc2,
+	0x33, 0x1a, 0xb0, 0x88, 0xe2, 0x15, 0xa8, 0x4f, 0x3d, 0xb7, 0xee, 0x7b, 0xd8, 0x80, 0xa6, 0xbc,
+	0xcb, 0xa8, 0x97, 0xd2, 0x28, 0x04, 0x9b, 0x30, 0x1f, 0x91, 0x34, 0x60, 0x24, 0x8f, 0xda, 0xd1,
+	0xc3, 0x02, 0x1c, 0x6e, 0x5c, 0x5c, 0x9a, 0xb5, 0xcf, 0x97, 0x66, 0xed, 0xfa, 0xd2, 0x44, 0xef,
+	0x32, 0x13, 0x7d, 0xc8, 0x4c, 0x74, 0x9e, 0x99, 0xe8, 0x22, 0x33, 0xd1, 0x97, 0xcc, 0x44, 0xdf,
+	0x32, 0xb3, 0x76, 0x9d, 0x99, 0xe8, 0xfd, 0x57, 0xb3, 0xf6, 0x3d, 0x00, 0x00, 0xff, 0xff, 0xf6,
+	0x3e, 0xcb, 0x40, 0x57, 0x06, 0x00, 0x00,
 }
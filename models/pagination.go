@@ -0,0 +1,130 @@
+package models
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+)
+
+// Page tokens are opaque to clients. They encode the sort key of the last
+// record the store scanned for the previous page, whether or not that record
+// was returned, so that listing can resume strictly after it.
+
+func encodePageToken(keys ...string) string {
+	payload, _ := json.Marshal(keys)
+	return base64.RawURLEncoding.EncodeToString(payload)
+}
+
+func decodePageToken(token string, keyCount int) ([]string, bool) {
+	payload, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, false
+	}
+
+	var keys []string
+	err = json.Unmarshal(payload, &keys)
+	if err != nil || len(keys) != keyCount || keys[0] == "" {
+		return nil, false
+	}
+
+	return keys, true
+}
+
+func validatePage(validationError ValidationError, pageSize int32, pageToken string, keyCount int) ValidationError {
+	if pageSize < 0 {
+		validationError = validationError.Append(ErrInvalidField{"page_size"})
+	}
+
+	if pageToken != "" {
+		keys, ok := decodePageToken(pageToken, keyCount)
+		if !ok {
+			validationError = validationError.Append(ErrInvalidField{"page_token"})
+		} else if keyCount == 2 {
+			if _, err := strconv.ParseInt(keys[1], 10, 32); err != nil {
+				validationError = validationError.Append(ErrInvalidField{"page_token"})
+			}
+		}
+	}
+
+	return validationError
+}
+
+func (req *TasksRequest) Filter() TaskFilter {
+	filter := TaskFilter{
+		Domain:   req.Domain,
+		CellID:   req.CellId,
+		PageSize: int(req.PageSize),
+	}
+
+	if keys, ok := decodePageToken(req.PageToken, 1); ok {
+		filter.AfterTaskGuid = keys[0]
+	}
+
+	return filter
+}
+
+// NextPageToken returns the token for the page resuming after lastTaskGuid,
+// or an empty string when lastTaskGuid is empty because the store scanned
+// fewer records than the page requested by the filter.
+func (filter TaskFilter) NextPageToken(lastTaskGuid string) string {
+	if filter.PageSize <= 0 || lastTaskGuid == "" {
+		return ""
+	}
+
+	return encodePageToken(lastTaskGuid)
+}
+
+func (req *DesiredLRPsRequest) Filter() DesiredLRPFilter {
+	filter := DesiredLRPFilter{
+		Domain:       req.Domain,
+		ProcessGuids: req.ProcessGuids,
+		PageSize:     int(req.PageSize),
+	}
+
+	if keys, ok := decodePageToken(req.PageToken, 1); ok {
+		filter.AfterProcessGuid = keys[0]
+	}
+
+	return filter
+}
+
+// NextPageToken returns the token for the page resuming after
+// lastProcessGuid, or an empty string when lastProcessGuid is empty because
+// the store scanned fewer records than the page requested by the filter.
+func (filter DesiredLRPFilter) NextPageToken(lastProcessGuid string) string {
+	if filter.PageSize <= 0 || lastProcessGuid == "" {
+		return ""
+	}
+
+	return encodePageToken(lastProcessGuid)
+}
+
+func (req *ActualLRPGroupsRequest) Filter() ActualLRPFilter {
+	filter := ActualLRPFilter{
+		Domain:   req.Domain,
+		CellID:   req.CellId,
+		PageSize: int(req.PageSize),
+	}
+
+	if keys, ok := decodePageToken(req.PageToken, 2); ok {
+		index, err := strconv.ParseInt(keys[1], 10, 32)
+		if err == nil {
+			filter.AfterProcessGuid = keys[0]
+			filter.AfterIndex = int32(index)
+		}
+	}
+
+	return filter
+}
+
+// NextPageToken returns the token for the page resuming after the actual LRP
+// group at lastProcessGuid and lastIndex, or an empty string when
+// lastProcessGuid is empty because the store scanned fewer records than the
+// page requested by the filter.
+func (filter ActualLRPFilter) NextPageToken(lastProcessGuid string, lastIndex int32) string {
+	if filter.PageSize <= 0 || lastProcessGuid == "" {
+		return ""
+	}
+
+	return encodePageToken(lastProcessGuid, strconv.Itoa(int(lastIndex)))
+}
@@ -0,0 +1,85 @@
+package models_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Pagination", func() {
+	Describe("TaskFilter", func() {
+		Describe("NextPageToken", func() {
+			It("returns a token resuming after the last task scanned", func() {
+				token := models.TaskFilter{PageSize: 2}.NextPageToken("task-guid-2")
+				Expect(token).NotTo(BeEmpty())
+
+				request := models.TasksRequest{PageSize: 2, PageToken: token}
+				Expect(request.Validate()).To(Succeed())
+				Expect(request.Filter().AfterTaskGuid).To(Equal("task-guid-2"))
+			})
+
+			It("returns an empty token when the scan did not fill the page", func() {
+				Expect(models.TaskFilter{PageSize: 2}.NextPageToken("")).To(BeEmpty())
+			})
+
+			It("returns an empty token when the filter is not paged", func() {
+				Expect(models.TaskFilter{}.NextPageToken("task-guid-2")).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("DesiredLRPFilter", func() {
+		Describe("NextPageToken", func() {
+			It("returns a token resuming after the last desired lrp scanned", func() {
+				token := models.DesiredLRPFilter{PageSize: 2}.NextPageToken("process-guid-2")
+				Expect(token).NotTo(BeEmpty())
+
+				request := models.DesiredLRPsRequest{PageSize: 2, PageToken: token}
+				Expect(request.Validate()).To(Succeed())
+				Expect(request.Filter().AfterProcessGuid).To(Equal("process-guid-2"))
+			})
+
+			It("returns an empty token when the scan did not fill the page", func() {
+				Expect(models.DesiredLRPFilter{PageSize: 2}.NextPageToken("")).To(BeEmpty())
+			})
+
+			It("returns an empty token when the filter is not paged", func() {
+				Expect(models.DesiredLRPFilter{}.NextPageToken("process-guid-2")).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("ActualLRPFilter", func() {
+		Describe("NextPageToken", func() {
+			It("returns a token resuming after the last actual lrp group scanned", func() {
+				token := models.ActualLRPFilter{PageSize: 2}.NextPageToken("process-guid-2", 4)
+				Expect(token).NotTo(BeEmpty())
+
+				request := models.ActualLRPGroupsRequest{PageSize: 2, PageToken: token}
+				Expect(request.Validate()).To(Succeed())
+				filter := request.Filter()
+				Expect(filter.AfterProcessGuid).To(Equal("process-guid-2"))
+				Expect(filter.AfterIndex).To(BeEquivalentTo(4))
+			})
+
+			It("resumes after index 0", func() {
+				token := models.ActualLRPFilter{PageSize: 2}.NextPageToken("process-guid-2", 0)
+
+				request := models.ActualLRPGroupsRequest{PageSize: 2, PageToken: token}
+				Expect(request.Validate()).To(Succeed())
+				filter := request.Filter()
+				Expect(filter.AfterProcessGuid).To(Equal("process-guid-2"))
+				Expect(filter.AfterIndex).To(BeEquivalentTo(0))
+			})
+
+			It("returns an empty token when the scan did not fill the page", func() {
+				Expect(models.ActualLRPFilter{PageSize: 2}.NextPageToken("", 0)).To(BeEmpty())
+			})
+
+			It("returns an empty token when the filter is not paged", func() {
+				Expect(models.ActualLRPFilter{}.NextPageToken("process-guid-2", 4)).To(BeEmpty())
+			})
+		})
+	})
+})
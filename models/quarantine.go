@@ -0,0 +1,73 @@
+package models
+
+// The tables whose undecodable rows are moved to quarantine, as named by
+// QuarantinedRecord.TableName.
+const (
+	QuarantinedDesiredLRPsTable = "desired_lrps"
+	QuarantinedActualLRPsTable  = "actual_lrps"
+	QuarantinedTasksTable       = "tasks"
+)
+
+func validQuarantinedTableName(tableName string) bool {
+	switch tableName {
+	case QuarantinedDesiredLRPsTable, QuarantinedActualLRPsTable, QuarantinedTasksTable:
+		return true
+	}
+	return false
+}
+
+func (request *QuarantinedRecordsRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.TableName != "" && !validQuarantinedTableName(request.TableName) {
+		validationError = validationError.Append(ErrInvalidField{"table_name"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+func (request *QuarantinedRecordRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.Id <= 0 {
+		validationError = validationError.Append(ErrInvalidField{"id"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+func (request *RestoreQuarantinedRecordRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.Id <= 0 {
+		validationError = validationError.Append(ErrInvalidField{"id"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+func (request *RestoreQuarantinedRecordsRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.TableName != "" && !validQuarantinedTableName(request.TableName) {
+		validationError = validationError.Append(ErrInvalidField{"table_name"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
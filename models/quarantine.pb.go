@@ -0,0 +1,2375 @@
+// Code generated by protoc-gen-gogo.
+// source: quarantine.proto
+// DO NOT EDIT!
+
+package models
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+
+import strings "strings"
+import reflect "reflect"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type QuarantinedRecord struct {
+	Id            int64  `protobuf:"varint,1,opt,name=id" json:"id"`
+	TableName     string `protobuf:"bytes,2,opt,name=table_name,json=tableName" json:"table_name"`
+	Guid          string `protobuf:"bytes,3,opt,name=guid" json:"guid"`
+	Reason        string `protobuf:"bytes,4,opt,name=reason" json:"reason"`
+	QuarantinedAt int64  `protobuf:"varint,5,opt,name=quarantined_at,json=quarantinedAt" json:"quarantined_at"`
+	Record        string `protobuf:"bytes,6,opt,name=record" json:"record"`
+}
+
+func (m *QuarantinedRecord) Reset()                    { *m = QuarantinedRecord{} }
+func (*QuarantinedRecord) ProtoMessage()               {}
+func (*QuarantinedRecord) Descriptor() ([]byte, []int) { return fileDescriptorQuarantine, []int{0} }
+
+func (m *QuarantinedRecord) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *QuarantinedRecord) GetTableName() string {
+	if m != nil {
+		return m.TableName
+	}
+	return ""
+}
+
+func (m *QuarantinedRecord) GetGuid() string {
+	if m != nil {
+		return m.Guid
+	}
+	return ""
+}
+
+func (m *QuarantinedRecord) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *QuarantinedRecord) GetQuarantinedAt() int64 {
+	if m != nil {
+		return m.QuarantinedAt
+	}
+	return 0
+}
+
+func (m *QuarantinedRecord) GetRecord() string {
+	if m != nil {
+		return m.Record
+	}
+	return ""
+}
+
+type QuarantinedRecordsRequest struct {
+	TableName string `protobuf:"bytes,1,opt,name=table_name,json=tableName" json:"table_name"`
+}
+
+func (m *QuarantinedRecordsRequest) Reset()      { *m = QuarantinedRecordsRequest{} }
+func (*QuarantinedRecordsRequest) ProtoMessage() {}
+func (*QuarantinedRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorQuarantine, []int{1}
+}
+
+func (m *QuarantinedRecordsRequest) GetTableName() string {
+	if m != nil {
+		return m.TableName
+	}
+	return ""
+}
+
+type QuarantinedRecordsResponse struct {
+	Error              *Error               `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	QuarantinedRecords []*QuarantinedRecord `protobuf:"bytes,2,rep,name=quarantined_records,json=quarantinedRecords" json:"quarantined_records,omitempty"`
+}
+
+func (m *QuarantinedRecordsResponse) Reset()      { *m = QuarantinedRecordsResponse{} }
+func (*QuarantinedRecordsResponse) ProtoMessage() {}
+func (*QuarantinedRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorQuarantine, []int{2}
+}
+
+func (m *QuarantinedRecordsResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *QuarantinedRecordsResponse) GetQuarantinedRecords() []*QuarantinedRecord {
+	if m != nil {
+		return m.QuarantinedRecords
+	}
+	return nil
+}
+
+type QuarantinedRecordRequest struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id"`
+}
+
+func (m *QuarantinedRecordRequest) Reset()      { *m = QuarantinedRecordRequest{} }
+func (*QuarantinedRecordRequest) ProtoMessage() {}
+func (*QuarantinedRecordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorQuarantine, []int{3}
+}
+
+func (m *QuarantinedRecordRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type QuarantinedRecordResponse struct {
+	Error             *Error             `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	QuarantinedRecord *QuarantinedRecord `protobuf:"bytes,2,opt,name=quarantined_record,json=quarantinedRecord" json:"quarantined_record,omitempty"`
+}
+
+func (m *QuarantinedRecordResponse) Reset()      { *m = QuarantinedRecordResponse{} }
+func (*QuarantinedRecordResponse) ProtoMessage() {}
+func (*QuarantinedRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorQuarantine, []int{4}
+}
+
+func (m *QuarantinedRecordResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *QuarantinedRecordResponse) GetQuarantinedRecord() *QuarantinedRecord {
+	if m != nil {
+		return m.QuarantinedRecord
+	}
+	return nil
+}
+
+type RestoreQuarantinedRecordRequest struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id"`
+}
+
+func (m *RestoreQuarantinedRecordRequest) Reset()      { *m = RestoreQuarantinedRecordRequest{} }
+func (*RestoreQuarantinedRecordRequest) ProtoMessage() {}
+func (*RestoreQuarantinedRecordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorQuarantine, []int{5}
+}
+
+func (m *RestoreQuarantinedRecordRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type RestoreQuarantinedRecordResponse struct {
+	Error *Error `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *RestoreQuarantinedRecordResponse) Reset()      { *m = RestoreQuarantinedRecordResponse{} }
+func (*RestoreQuarantinedRecordResponse) ProtoMessage() {}
+func (*RestoreQuarantinedRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorQuarantine, []int{6}
+}
+
+func (m *RestoreQuarantinedRecordResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+type RestoreQuarantinedRecordsRequest struct {
+	TableName string `protobuf:"bytes,1,opt,name=table_name,json=tableName" json:"table_name"`
+}
+
+func (m *RestoreQuarantinedRecordsRequest) Reset()      { *m = RestoreQuarantinedRecordsRequest{} }
+func (*RestoreQuarantinedRecordsRequest) ProtoMessage() {}
+func (*RestoreQuarantinedRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorQuarantine, []int{7}
+}
+
+func (m *RestoreQuarantinedRecordsRequest) GetTableName() string {
+	if m != nil {
+		return m.TableName
+	}
+	return ""
+}
+
+type RestoreQuarantinedRecordsResponse struct {
+	Error       *Error  `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	RestoredIds []int64 `protobuf:"varint,2,rep,name=restored_ids,json=restoredIds" json:"restored_ids,omitempty"`
+	FailedIds   []int64 `protobuf:"varint,3,rep,name=failed_ids,json=failedIds" json:"failed_ids,omitempty"`
+}
+
+func (m *RestoreQuarantinedRecordsResponse) Reset()      { *m = RestoreQuarantinedRecordsResponse{} }
+func (*RestoreQuarantinedRecordsResponse) ProtoMessage() {}
+func (*RestoreQuarantinedRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorQuarantine, []int{8}
+}
+
+func (m *RestoreQuarantinedRecordsResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *RestoreQuarantinedRecordsResponse) GetRestoredIds() []int64 {
+	if m != nil {
+		return m.RestoredIds
+	}
+	return nil
+}
+
+func (m *RestoreQuarantinedRecordsResponse) GetFailedIds() []int64 {
+	if m != nil {
+		return m.FailedIds
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QuarantinedRecord)(nil), "models.QuarantinedRecord")
+	proto.RegisterType((*QuarantinedRecordsRequest)(nil), "models.QuarantinedRecordsRequest")
+	proto.RegisterType((*QuarantinedRecordsResponse)(nil), "models.QuarantinedRecordsResponse")
+	proto.RegisterType((*QuarantinedRecordRequest)(nil), "models.QuarantinedRecordRequest")
+	proto.RegisterType((*QuarantinedRecordResponse)(nil), "models.QuarantinedRecordResponse")
+	proto.RegisterType((*RestoreQuarantinedRecordRequest)(nil), "models.RestoreQuarantinedRecordRequest")
+	proto.RegisterType((*RestoreQuarantinedRecordResponse)(nil), "models.RestoreQuarantinedRecordResponse")
+	proto.RegisterType((*RestoreQuarantinedRecordsRequest)(nil), "models.RestoreQuarantinedRecordsRequest")
+	proto.RegisterType((*RestoreQuarantinedRecordsResponse)(nil), "models.RestoreQuarantinedRecordsResponse")
+}
+func (this *QuarantinedRecord) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*QuarantinedRecord)
+	if !ok {
+		that2, ok := that.(QuarantinedRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.TableName != that1.TableName {
+		return false
+	}
+	if this.Guid != that1.Guid {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if this.QuarantinedAt != that1.QuarantinedAt {
+		return false
+	}
+	if this.Record != that1.Record {
+		return false
+	}
+	return true
+}
+func (this *QuarantinedRecordsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*QuarantinedRecordsRequest)
+	if !ok {
+		that2, ok := that.(QuarantinedRecordsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.TableName != that1.TableName {
+		return false
+	}
+	return true
+}
+func (this *QuarantinedRecordsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*QuarantinedRecordsResponse)
+	if !ok {
+		that2, ok := that.(QuarantinedRecordsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if len(this.QuarantinedRecords) != len(that1.QuarantinedRecords) {
+		return false
+	}
+	for i := range this.QuarantinedRecords {
+		if !this.QuarantinedRecords[i].Equal(that1.QuarantinedRecords[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *QuarantinedRecordRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*QuarantinedRecordRequest)
+	if !ok {
+		that2, ok := that.(QuarantinedRecordRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	return true
+}
+func (this *QuarantinedRecordResponse) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*QuarantinedRecordResponse)
+	if !ok {
+		that2, ok := that.(QuarantinedRecordResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if !this.QuarantinedRecord.Equal(that1.QuarantinedRecord) {
+		return false
+	}
+	return true
+}
+func (this *RestoreQuarantinedRecordRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*RestoreQuarantinedRecordRequest)
+	if !ok {
+		that2, ok := that.(RestoreQuarantinedRecordRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	return true
+}
+func (this *RestoreQuarantinedRecordResponse) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*RestoreQuarantinedRecordResponse)
+	if !ok {
+		that2, ok := that.(RestoreQuarantinedRecordResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	return true
+}
+func (this *RestoreQuarantinedRecordsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*RestoreQuarantinedRecordsRequest)
+	if !ok {
+		that2, ok := that.(RestoreQuarantinedRecordsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.TableName != that1.TableName {
+		return false
+	}
+	return true
+}
+func (this *RestoreQuarantinedRecordsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*RestoreQuarantinedRecordsResponse)
+	if !ok {
+		that2, ok := that.(RestoreQuarantinedRecordsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if len(this.RestoredIds) != len(that1.RestoredIds) {
+		return false
+	}
+	for i := range this.RestoredIds {
+		if this.RestoredIds[i] != that1.RestoredIds[i] {
+			return false
+		}
+	}
+	if len(this.FailedIds) != len(that1.FailedIds) {
+		return false
+	}
+	for i := range this.FailedIds {
+		if this.FailedIds[i] != that1.FailedIds[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *QuarantinedRecord) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&models.QuarantinedRecord{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "TableName: "+fmt.Sprintf("%#v", this.TableName)+",\n")
+	s = append(s, "Guid: "+fmt.Sprintf("%#v", this.Guid)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "QuarantinedAt: "+fmt.Sprintf("%#v", this.QuarantinedAt)+",\n")
+	s = append(s, "Record: "+fmt.Sprintf("%#v", this.Record)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *QuarantinedRecordsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.QuarantinedRecordsRequest{")
+	s = append(s, "TableName: "+fmt.Sprintf("%#v", this.TableName)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *QuarantinedRecordsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.QuarantinedRecordsResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.QuarantinedRecords != nil {
+		s = append(s, "QuarantinedRecords: "+fmt.Sprintf("%#v", this.QuarantinedRecords)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *QuarantinedRecordRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.QuarantinedRecordRequest{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *QuarantinedRecordResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.QuarantinedRecordResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.QuarantinedRecord != nil {
+		s = append(s, "QuarantinedRecord: "+fmt.Sprintf("%#v", this.QuarantinedRecord)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RestoreQuarantinedRecordRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.RestoreQuarantinedRecordRequest{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RestoreQuarantinedRecordResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.RestoreQuarantinedRecordResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RestoreQuarantinedRecordsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.RestoreQuarantinedRecordsRequest{")
+	s = append(s, "TableName: "+fmt.Sprintf("%#v", this.TableName)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RestoreQuarantinedRecordsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.RestoreQuarantinedRecordsResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.RestoredIds != nil {
+		s = append(s, "RestoredIds: "+fmt.Sprintf("%#v", this.RestoredIds)+",\n")
+	}
+	if this.FailedIds != nil {
+		s = append(s, "FailedIds: "+fmt.Sprintf("%#v", this.FailedIds)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringQuarantine(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *QuarantinedRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuarantinedRecord) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintQuarantine(dAtA, i, uint64(m.Id))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintQuarantine(dAtA, i, uint64(len(m.TableName)))
+	i += copy(dAtA[i:], m.TableName)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintQuarantine(dAtA, i, uint64(len(m.Guid)))
+	i += copy(dAtA[i:], m.Guid)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintQuarantine(dAtA, i, uint64(len(m.Reason)))
+	i += copy(dAtA[i:], m.Reason)
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintQuarantine(dAtA, i, uint64(m.QuarantinedAt))
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintQuarantine(dAtA, i, uint64(len(m.Record)))
+	i += copy(dAtA[i:], m.Record)
+	return i, nil
+}
+
+func (m *QuarantinedRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuarantinedRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintQuarantine(dAtA, i, uint64(len(m.TableName)))
+	i += copy(dAtA[i:], m.TableName)
+	return i, nil
+}
+
+func (m *QuarantinedRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuarantinedRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQuarantine(dAtA, i, uint64(m.Error.Size()))
+		n1, err := m.Error.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if len(m.QuarantinedRecords) > 0 {
+		for _, msg := range m.QuarantinedRecords {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintQuarantine(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *QuarantinedRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuarantinedRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintQuarantine(dAtA, i, uint64(m.Id))
+	return i, nil
+}
+
+func (m *QuarantinedRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuarantinedRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQuarantine(dAtA, i, uint64(m.Error.Size()))
+		n2, err := m.Error.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.QuarantinedRecord != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintQuarantine(dAtA, i, uint64(m.QuarantinedRecord.Size()))
+		n3, err := m.QuarantinedRecord.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	return i, nil
+}
+
+func (m *RestoreQuarantinedRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreQuarantinedRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintQuarantine(dAtA, i, uint64(m.Id))
+	return i, nil
+}
+
+func (m *RestoreQuarantinedRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreQuarantinedRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQuarantine(dAtA, i, uint64(m.Error.Size()))
+		n4, err := m.Error.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	return i, nil
+}
+
+func (m *RestoreQuarantinedRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreQuarantinedRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintQuarantine(dAtA, i, uint64(len(m.TableName)))
+	i += copy(dAtA[i:], m.TableName)
+	return i, nil
+}
+
+func (m *RestoreQuarantinedRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreQuarantinedRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQuarantine(dAtA, i, uint64(m.Error.Size()))
+		n5, err := m.Error.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if len(m.RestoredIds) > 0 {
+		for _, num := range m.RestoredIds {
+			dAtA[i] = 0x10
+			i++
+			i = encodeVarintQuarantine(dAtA, i, uint64(num))
+		}
+	}
+	if len(m.FailedIds) > 0 {
+		for _, num := range m.FailedIds {
+			dAtA[i] = 0x18
+			i++
+			i = encodeVarintQuarantine(dAtA, i, uint64(num))
+		}
+	}
+	return i, nil
+}
+
+func encodeFixed64Quarantine(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Quarantine(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintQuarantine(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *QuarantinedRecord) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovQuarantine(uint64(m.Id))
+	l = len(m.TableName)
+	n += 1 + l + sovQuarantine(uint64(l))
+	l = len(m.Guid)
+	n += 1 + l + sovQuarantine(uint64(l))
+	l = len(m.Reason)
+	n += 1 + l + sovQuarantine(uint64(l))
+	n += 1 + sovQuarantine(uint64(m.QuarantinedAt))
+	l = len(m.Record)
+	n += 1 + l + sovQuarantine(uint64(l))
+	return n
+}
+
+func (m *QuarantinedRecordsRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.TableName)
+	n += 1 + l + sovQuarantine(uint64(l))
+	return n
+}
+
+func (m *QuarantinedRecordsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovQuarantine(uint64(l))
+	}
+	if len(m.QuarantinedRecords) > 0 {
+		for _, e := range m.QuarantinedRecords {
+			l = e.Size()
+			n += 1 + l + sovQuarantine(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuarantinedRecordRequest) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovQuarantine(uint64(m.Id))
+	return n
+}
+
+func (m *QuarantinedRecordResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovQuarantine(uint64(l))
+	}
+	if m.QuarantinedRecord != nil {
+		l = m.QuarantinedRecord.Size()
+		n += 1 + l + sovQuarantine(uint64(l))
+	}
+	return n
+}
+
+func (m *RestoreQuarantinedRecordRequest) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovQuarantine(uint64(m.Id))
+	return n
+}
+
+func (m *RestoreQuarantinedRecordResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovQuarantine(uint64(l))
+	}
+	return n
+}
+
+func (m *RestoreQuarantinedRecordsRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.TableName)
+	n += 1 + l + sovQuarantine(uint64(l))
+	return n
+}
+
+func (m *RestoreQuarantinedRecordsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovQuarantine(uint64(l))
+	}
+	if len(m.RestoredIds) > 0 {
+		for _, e := range m.RestoredIds {
+			n += 1 + sovQuarantine(uint64(e))
+		}
+	}
+	if len(m.FailedIds) > 0 {
+		for _, e := range m.FailedIds {
+			n += 1 + sovQuarantine(uint64(e))
+		}
+	}
+	return n
+}
+
+func sovQuarantine(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozQuarantine(x uint64) (n int) {
+	return sovQuarantine(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *QuarantinedRecord) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QuarantinedRecord{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`TableName:` + fmt.Sprintf("%v", this.TableName) + `,`,
+		`Guid:` + fmt.Sprintf("%v", this.Guid) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`QuarantinedAt:` + fmt.Sprintf("%v", this.QuarantinedAt) + `,`,
+		`Record:` + fmt.Sprintf("%v", this.Record) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QuarantinedRecordsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QuarantinedRecordsRequest{`,
+		`TableName:` + fmt.Sprintf("%v", this.TableName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QuarantinedRecordsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QuarantinedRecordsResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`QuarantinedRecords:` + strings.Replace(fmt.Sprintf("%v", this.QuarantinedRecords), "QuarantinedRecord", "QuarantinedRecord", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QuarantinedRecordRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QuarantinedRecordRequest{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QuarantinedRecordResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QuarantinedRecordResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`QuarantinedRecord:` + strings.Replace(fmt.Sprintf("%v", this.QuarantinedRecord), "QuarantinedRecord", "QuarantinedRecord", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RestoreQuarantinedRecordRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RestoreQuarantinedRecordRequest{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RestoreQuarantinedRecordResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RestoreQuarantinedRecordResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RestoreQuarantinedRecordsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RestoreQuarantinedRecordsRequest{`,
+		`TableName:` + fmt.Sprintf("%v", this.TableName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RestoreQuarantinedRecordsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RestoreQuarantinedRecordsResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`RestoredIds:` + fmt.Sprintf("%v", this.RestoredIds) + `,`,
+		`FailedIds:` + fmt.Sprintf("%v", this.FailedIds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringQuarantine(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *QuarantinedRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuarantine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuarantinedRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuarantinedRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TableName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuarantinedAt", wireType)
+			}
+			m.QuarantinedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuarantinedAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Record = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuarantine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuarantinedRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuarantine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuarantinedRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuarantinedRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TableName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuarantine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuarantinedRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuarantine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuarantinedRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuarantinedRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuarantinedRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuarantinedRecords = append(m.QuarantinedRecords, &QuarantinedRecord{})
+			if err := m.QuarantinedRecords[len(m.QuarantinedRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuarantine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuarantinedRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuarantine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuarantinedRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuarantinedRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuarantine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuarantinedRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuarantine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuarantinedRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuarantinedRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuarantinedRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QuarantinedRecord == nil {
+				m.QuarantinedRecord = &QuarantinedRecord{}
+			}
+			if err := m.QuarantinedRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuarantine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestoreQuarantinedRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuarantine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoreQuarantinedRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoreQuarantinedRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuarantine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestoreQuarantinedRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuarantine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoreQuarantinedRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoreQuarantinedRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuarantine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestoreQuarantinedRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuarantine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoreQuarantinedRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoreQuarantinedRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TableName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuarantine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestoreQuarantinedRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuarantine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoreQuarantinedRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoreQuarantinedRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuarantine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RestoredIds = append(m.RestoredIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuarantine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuarantine
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuarantine
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RestoredIds = append(m.RestoredIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestoredIds", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuarantine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.FailedIds = append(m.FailedIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuarantine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuarantine
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuarantine
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.FailedIds = append(m.FailedIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuarantine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuarantine(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuarantine
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthQuarantine
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowQuarantine
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipQuarantine(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthQuarantine = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuarantine   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("quarantine.proto", fileDescriptorQuarantine) }
+
+var fileDescriptorQuarantine = []byte{
+	// 438 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x94, 0x52, 0xc1, 0x6e, 0xd4, 0x30,
+	0x10, 0x8d, 0x37, 0xdb, 0x95, 0x76, 0x42, 0x11, 0x35, 0x1c, 0xdc, 0x15, 0xb8, 0x69, 0x7a, 0x59,
+	0x09, 0x48, 0x51, 0x2f, 0x5c, 0xa1, 0x12, 0x82, 0x72, 0x40, 0x22, 0x3f, 0xb0, 0xf2, 0xd6, 0x6e,
+	0x88, 0xb4, 0x89, 0xbb, 0xb6, 0x73, 0xe7, 0x5c, 0x09, 0x89, 0xcf, 0xe0, 0x53, 0x7a, 0x2c, 0x37,
+	0x4e, 0x88, 0x0d, 0x17, 0x8e, 0xfd, 0x04, 0xb4, 0x76, 0x0a, 0x81, 0xa4, 0x2b, 0xe5, 0xe6, 0x79,
+	0x6f, 0xe6, 0xcd, 0xbc, 0x19, 0xc3, 0xbd, 0x65, 0xc9, 0x14, 0x2b, 0x4c, 0x56, 0x88, 0xf8, 0x5c,
+	0x49, 0x23, 0xf1, 0x28, 0x97, 0x5c, 0x2c, 0xf4, 0xe4, 0x69, 0x9a, 0x99, 0x0f, 0xe5, 0x3c, 0x3e,
+	0x95, 0xf9, 0x61, 0x2a, 0x53, 0x79, 0x68, 0xe9, 0x79, 0x79, 0x66, 0x23, 0x1b, 0xd8, 0x97, 0x2b,
+	0x9b, 0x04, 0x42, 0x29, 0xa9, 0x5c, 0x10, 0x7d, 0x45, 0xb0, 0xf3, 0xfe, 0x8f, 0x30, 0x4f, 0xc4,
+	0xa9, 0x54, 0x1c, 0x3f, 0x80, 0x41, 0xc6, 0x09, 0x0a, 0xd1, 0xd4, 0x3f, 0x1e, 0x5e, 0x7e, 0xdf,
+	0xf3, 0x92, 0x41, 0xc6, 0xf1, 0x01, 0x80, 0x61, 0xf3, 0x85, 0x98, 0x15, 0x2c, 0x17, 0x64, 0x10,
+	0xa2, 0xe9, 0xb8, 0x66, 0xc7, 0x16, 0x7f, 0xc7, 0x72, 0x81, 0x09, 0x0c, 0xd3, 0x32, 0xe3, 0xc4,
+	0x6f, 0xd0, 0x16, 0xc1, 0x0f, 0x61, 0xa4, 0x04, 0xd3, 0xb2, 0x20, 0xc3, 0x06, 0x57, 0x63, 0xf8,
+	0x31, 0xdc, 0xfd, 0x6b, 0x90, 0xcf, 0x98, 0x21, 0x5b, 0x8d, 0xf6, 0xdb, 0x0d, 0xee, 0xa5, 0x71,
+	0x52, 0xeb, 0x49, 0xc9, 0xe8, 0x5f, 0xa9, 0x35, 0x16, 0xbd, 0x80, 0xdd, 0x96, 0x25, 0x9d, 0x88,
+	0x65, 0x29, 0xb4, 0xf9, 0xcf, 0x04, 0xea, 0x34, 0x11, 0x7d, 0x42, 0x30, 0xe9, 0x92, 0xd0, 0xe7,
+	0xb2, 0xd0, 0x02, 0x1f, 0xc0, 0x96, 0xdd, 0xa1, 0x2d, 0x0f, 0x8e, 0xb6, 0x63, 0x77, 0x88, 0xf8,
+	0xd5, 0x1a, 0x4c, 0x1c, 0x87, 0xdf, 0xc2, 0xfd, 0xa6, 0x21, 0x37, 0x9b, 0x26, 0x83, 0xd0, 0x9f,
+	0x06, 0x47, 0xbb, 0x37, 0x25, 0xad, 0x2e, 0x09, 0x5e, 0xb6, 0x1a, 0x47, 0xcf, 0x80, 0xb4, 0x13,
+	0x6b, 0x43, 0x9d, 0xb7, 0x8a, 0x2e, 0x50, 0xc7, 0x12, 0xfa, 0x19, 0x78, 0x03, 0xb8, 0x6d, 0xc0,
+	0x9e, 0x7d, 0xe3, 0xfc, 0x3b, 0xad, 0xf9, 0xa3, 0xe7, 0xb0, 0x97, 0x08, 0x6d, 0xa4, 0x12, 0x3d,
+	0x5d, 0xbc, 0x86, 0xf0, 0xf6, 0xc2, 0x1e, 0x5e, 0x36, 0x09, 0xf5, 0xfb, 0x19, 0x17, 0x08, 0xf6,
+	0x37, 0x28, 0xf5, 0xd9, 0xef, 0x3e, 0xdc, 0x51, 0x4e, 0x89, 0xcf, 0xb2, 0xfa, 0x67, 0xf8, 0x49,
+	0x70, 0x83, 0x9d, 0x70, 0x8d, 0x1f, 0x01, 0x9c, 0xb1, 0x6c, 0x51, 0x27, 0xf8, 0x36, 0x61, 0xec,
+	0x90, 0x13, 0xae, 0x8f, 0x9f, 0x5c, 0xad, 0xa8, 0xf7, 0x6d, 0x45, 0xbd, 0xeb, 0x15, 0x45, 0x1f,
+	0x2b, 0x8a, 0xbe, 0x54, 0x14, 0x5d, 0x56, 0x14, 0x5d, 0x55, 0x14, 0xfd, 0xa8, 0x28, 0xfa, 0x55,
+	0x51, 0xef, 0xba, 0xa2, 0xe8, 0xf3, 0x4f, 0xea, 0xfd, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x95, 0x44,
+	0x6c, 0x7b, 0x41, 0x04, 0x00, 0x00,
+}
@@ -0,0 +1,78 @@
+package models_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("QuarantinedRecord", func() {
+	Describe("QuarantinedRecordsRequest", func() {
+		Describe("Validate", func() {
+			It("accepts no table name", func() {
+				request := models.QuarantinedRecordsRequest{}
+				Expect(request.Validate()).To(BeNil())
+			})
+
+			It("accepts the quarantined tables", func() {
+				for _, tableName := range []string{
+					models.QuarantinedDesiredLRPsTable,
+					models.QuarantinedActualLRPsTable,
+					models.QuarantinedTasksTable,
+				} {
+					request := models.QuarantinedRecordsRequest{TableName: tableName}
+					Expect(request.Validate()).To(BeNil())
+				}
+			})
+
+			It("rejects other tables", func() {
+				request := models.QuarantinedRecordsRequest{TableName: "domains"}
+				Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"table_name"}))
+			})
+		})
+	})
+
+	Describe("QuarantinedRecordRequest", func() {
+		Describe("Validate", func() {
+			It("requires a positive id", func() {
+				request := models.QuarantinedRecordRequest{}
+				Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"id"}))
+
+				request.Id = 1
+				Expect(request.Validate()).To(BeNil())
+			})
+		})
+	})
+
+	Describe("RestoreQuarantinedRecordRequest", func() {
+		Describe("Validate", func() {
+			It("requires a positive id", func() {
+				request := models.RestoreQuarantinedRecordRequest{}
+				Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"id"}))
+
+				request.Id = 1
+				Expect(request.Validate()).To(BeNil())
+			})
+		})
+	})
+
+	Describe("RestoreQuarantinedRecordsRequest", func() {
+		Describe("Validate", func() {
+			It("accepts an empty table name", func() {
+				request := models.RestoreQuarantinedRecordsRequest{}
+				Expect(request.Validate()).To(BeNil())
+			})
+
+			It("accepts the quarantined tables", func() {
+				request := models.RestoreQuarantinedRecordsRequest{TableName: models.QuarantinedTasksTable}
+				Expect(request.Validate()).To(BeNil())
+			})
+
+			It("rejects other tables", func() {
+				request := models.RestoreQuarantinedRecordsRequest{TableName: "domains"}
+				Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"table_name"}))
+			})
+		})
+	})
+})
@@ -0,0 +1,51 @@
+package models
+
+import "time"
+
+// RestartCalculator returns the calculator used to decide when crashed
+// instances of a DesiredLRP with this policy are restarted. Fields left unset
+// fall back to the defaults, and a nil policy yields the default calculator.
+func (p *RestartPolicy) RestartCalculator() RestartCalculator {
+	if p == nil {
+		return NewDefaultRestartCalculator()
+	}
+
+	if p.NeverRestart {
+		return NewRestartCalculator(0, DefaultMaxBackoffDuration, 0)
+	}
+
+	immediateRestarts := int32(DefaultImmediateRestarts)
+	if p.ImmediateRestarts != nil {
+		immediateRestarts = *p.ImmediateRestarts
+	}
+
+	maxBackoffDuration := DefaultMaxBackoffDuration
+	if p.MaxBackoffDurationMs != nil {
+		maxBackoffDuration = time.Duration(*p.MaxBackoffDurationMs) * time.Millisecond
+	}
+
+	maxRestartAttempts := int32(DefaultMaxRestarts)
+	if p.MaxRestartAttempts != nil {
+		maxRestartAttempts = *p.MaxRestartAttempts
+	}
+
+	return NewRestartCalculator(immediateRestarts, maxBackoffDuration, maxRestartAttempts)
+}
+
+func (p *RestartPolicy) Validate() error {
+	var validationError ValidationError
+
+	if p.GetImmediateRestarts() < 0 {
+		validationError = validationError.Append(ErrInvalidField{"immediate_restarts"})
+	}
+
+	if p.MaxBackoffDurationMs != nil && time.Duration(*p.MaxBackoffDurationMs)*time.Millisecond < CrashBackoffMinDuration {
+		validationError = validationError.Append(ErrInvalidField{"max_backoff_duration_ms"})
+	}
+
+	if p.GetMaxRestartAttempts() < 0 {
+		validationError = validationError.Append(ErrInvalidField{"max_restart_attempts"})
+	}
+
+	return validationError.ToError()
+}
@@ -0,0 +1,76 @@
+package models_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RestartPolicy", func() {
+	int32Ptr := func(i int32) *int32 { return &i }
+	int64Ptr := func(i int64) *int64 { return &i }
+
+	Describe("RestartCalculator", func() {
+		It("returns the default calculator for a nil policy", func() {
+			var policy *models.RestartPolicy
+			Expect(policy.RestartCalculator()).To(Equal(models.NewDefaultRestartCalculator()))
+		})
+
+		It("falls back to the defaults for unset fields", func() {
+			policy := &models.RestartPolicy{}
+			Expect(policy.RestartCalculator()).To(Equal(models.NewDefaultRestartCalculator()))
+		})
+
+		It("uses the fields that are set", func() {
+			policy := &models.RestartPolicy{
+				ImmediateRestarts:    int32Ptr(1),
+				MaxBackoffDurationMs: int64Ptr((2 * time.Minute).Nanoseconds() / 1000000),
+				MaxRestartAttempts:   int32Ptr(10),
+			}
+			Expect(policy.RestartCalculator()).To(Equal(models.NewRestartCalculator(1, 2*time.Minute, 10)))
+		})
+
+		It("allows disabling immediate restarts", func() {
+			policy := &models.RestartPolicy{ImmediateRestarts: int32Ptr(0)}
+			calc := policy.RestartCalculator()
+			Expect(calc.ShouldRestart(0, 0, 0)).To(BeFalse())
+			Expect(calc.ShouldRestart(models.CrashBackoffMinDuration.Nanoseconds(), 0, 0)).To(BeTrue())
+		})
+
+		Context("when never_restart is set", func() {
+			It("never restarts, regardless of the other fields", func() {
+				policy := &models.RestartPolicy{
+					NeverRestart:      true,
+					ImmediateRestarts: int32Ptr(5),
+				}
+				calc := policy.RestartCalculator()
+				Expect(calc.ShouldRestart(0, 0, 0)).To(BeFalse())
+				Expect(calc.ShouldRestart(time.Hour.Nanoseconds(), 0, 1)).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("Validate", func() {
+		It("is valid when empty", func() {
+			Expect((&models.RestartPolicy{}).Validate()).To(Succeed())
+		})
+
+		It("requires non-negative immediate_restarts", func() {
+			err := (&models.RestartPolicy{ImmediateRestarts: int32Ptr(-1)}).Validate()
+			Expect(err).To(MatchError(ContainSubstring("immediate_restarts")))
+		})
+
+		It("requires max_backoff_duration_ms to be at least the minimum crash backoff", func() {
+			err := (&models.RestartPolicy{MaxBackoffDurationMs: int64Ptr(1000)}).Validate()
+			Expect(err).To(MatchError(ContainSubstring("max_backoff_duration_ms")))
+		})
+
+		It("requires non-negative max_restart_attempts", func() {
+			err := (&models.RestartPolicy{MaxRestartAttempts: int32Ptr(-1)}).Validate()
+			Expect(err).To(MatchError(ContainSubstring("max_restart_attempts")))
+		})
+	})
+})
@@ -65,6 +65,41 @@ func (r *Routes) Equal(other Routes) bool {
 	return true
 }
 
+// equivalent reports whether both sets of routes hold the same JSON for the
+// same keys. Unlike Equal, it tolerates nil values and whitespace differences
+// in the JSON, and treats nil and empty routes as the same.
+func (r Routes) equivalent(other Routes) bool {
+	if len(r) != len(other) {
+		return false
+	}
+
+	for k, v := range r {
+		o, ok := other[k]
+		if !ok {
+			return false
+		}
+
+		if !rawJSONEqual(v, o) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func rawJSONEqual(a, b *json.RawMessage) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	var compactA, compactB bytes.Buffer
+	if json.Compact(&compactA, *a) != nil || json.Compact(&compactB, *b) != nil {
+		return bytes.Equal(*a, *b)
+	}
+
+	return bytes.Equal(compactA.Bytes(), compactB.Bytes())
+}
+
 func (r Routes) Validate() error {
 	totalRoutesLength := 0
 	if r != nil {
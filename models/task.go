@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/url"
 	"regexp"
+	"time"
 
 	"code.cloudfoundry.org/bbs/format"
 	"code.cloudfoundry.org/lager"
@@ -17,8 +18,13 @@ type TaskChange struct {
 }
 
 type TaskFilter struct {
-	Domain string
-	CellID string
+	Domain        string
+	CellID        string
+	PageSize      int
+	AfterTaskGuid string
+	// AllowedDomains, when non-nil, restricts the listing to tasks in these
+	// domains.
+	AllowedDomains []string
 }
 
 func (t *Task) Version() format.Version {
@@ -100,6 +106,46 @@ func (t *Task) ValidateTransitionTo(to Task_State) error {
 	return nil
 }
 
+// FailAttempt records a failed attempt of the task. If the task's retry policy
+// allows another attempt for this class of failure, the task is returned to
+// Pending and FailAttempt returns true. Otherwise the task is left untouched
+// apart from its failure history, and the caller should complete it as
+// failed.
+func (t *Task) FailAttempt(class TaskFailureClass, failureReason string, now int64) bool {
+	policy := t.GetRetryPolicy()
+	if policy == nil {
+		return false
+	}
+
+	attempt := t.RetryCount + 1
+	t.FailureHistory = append(t.FailureHistory, &TaskAttemptFailure{
+		Attempt:       attempt,
+		FailureClass:  class,
+		FailureReason: failureReason,
+		CellId:        t.CellId,
+		FailedAt:      now,
+	})
+
+	if !policy.Retries(class, attempt) {
+		return false
+	}
+
+	t.RetryCount = attempt
+	t.State = Task_Pending
+	t.CellId = ""
+	t.UpdatedAt = now
+	return true
+}
+
+// InRetryBackoff reports whether the task has been returned to Pending by a
+// retry and is still waiting out the backoff of its retry policy.
+func (t *Task) InRetryBackoff(now int64) bool {
+	if t.State != Task_Pending || t.RetryCount == 0 {
+		return false
+	}
+	return t.UpdatedAt+t.GetRetryPolicy().Backoff().Nanoseconds() > now
+}
+
 func newTaskDefWithCachedDependenciesAsActions(t *TaskDefinition) *TaskDefinition {
 	t = t.Copy()
 	if len(t.CachedDependencies) > 0 {
@@ -186,6 +232,13 @@ func (def *TaskDefinition) Validate() error {
 		validationError = validationError.Append(err)
 	}
 
+	if def.RetryPolicy != nil {
+		if err := def.RetryPolicy.Validate(); err != nil {
+			validationError = validationError.Append(ErrInvalidField{"retry_policy"})
+			validationError = validationError.Append(err)
+		}
+	}
+
 	if !validationError.Empty() {
 		return validationError
 	}
@@ -196,3 +249,48 @@ func (def *TaskDefinition) Validate() error {
 func (t *TaskDefinition) Version() format.Version {
 	return format.V2
 }
+
+// Retries reports whether the policy allows another attempt after the given
+// attempt failed with the given class of failure. A policy without any
+// retryable failures treats every class of failure as retryable.
+func (p *TaskRetryPolicy) Retries(class TaskFailureClass, failedAttempt int32) bool {
+	if p == nil || failedAttempt >= p.MaxAttempts {
+		return false
+	}
+
+	if len(p.RetryableFailures) == 0 {
+		return true
+	}
+
+	for _, retryable := range p.RetryableFailures {
+		if retryable == class {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *TaskRetryPolicy) Backoff() time.Duration {
+	return time.Duration(p.GetBackoffMs()) * time.Millisecond
+}
+
+func (p *TaskRetryPolicy) Validate() error {
+	var validationError ValidationError
+
+	if p.GetMaxAttempts() < 0 {
+		validationError = validationError.Append(ErrInvalidField{"max_attempts"})
+	}
+
+	if p.GetBackoffMs() < 0 {
+		validationError = validationError.Append(ErrInvalidField{"backoff_ms"})
+	}
+
+	for _, class := range p.GetRetryableFailures() {
+		if _, ok := TaskFailureClass_name[int32(class)]; !ok || class == TaskFailureClass_UnclassifiedFailure {
+			validationError = validationError.Append(ErrInvalidField{"retryable_failures"})
+			break
+		}
+	}
+
+	return validationError.ToError()
+}
@@ -21,6 +21,46 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
+type TaskFailureClass int32
+
+const (
+	TaskFailureClass_UnclassifiedFailure TaskFailureClass = 0
+	TaskFailureClass_PlacementFailure    TaskFailureClass = 1
+	TaskFailureClass_CellDisappeared     TaskFailureClass = 2
+	TaskFailureClass_ExitFailure         TaskFailureClass = 3
+)
+
+var TaskFailureClass_name = map[int32]string{
+	0: "UnclassifiedFailure",
+	1: "PlacementFailure",
+	2: "CellDisappeared",
+	3: "ExitFailure",
+}
+var TaskFailureClass_value = map[string]int32{
+	"UnclassifiedFailure": 0,
+	"PlacementFailure":    1,
+	"CellDisappeared":     2,
+	"ExitFailure":         3,
+}
+
+func (x TaskFailureClass) Enum() *TaskFailureClass {
+	p := new(TaskFailureClass)
+	*p = x
+	return p
+}
+func (x TaskFailureClass) MarshalJSON() ([]byte, error) {
+	return proto.MarshalJSONEnum(TaskFailureClass_name, int32(x))
+}
+func (x *TaskFailureClass) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(TaskFailureClass_value, data, "TaskFailureClass")
+	if err != nil {
+		return err
+	}
+	*x = TaskFailureClass(value)
+	return nil
+}
+func (TaskFailureClass) EnumDescriptor() ([]byte, []int) { return fileDescriptorTask, []int{0} }
+
 type Task_State int32
 
 const (
@@ -62,7 +102,7 @@ func (x *Task_State) UnmarshalJSON(data []byte) error {
 	*x = Task_State(value)
 	return nil
 }
-func (Task_State) EnumDescriptor() ([]byte, []int) { return fileDescriptorTask, []int{1, 0} }
+func (Task_State) EnumDescriptor() ([]byte, []int) { return fileDescriptorTask, []int{3, 0} }
 
 type TaskDefinition struct {
 	RootFs                        string                 `protobuf:"bytes,1,opt,name=root_fs,json=rootFs" json:"rootfs"`
@@ -87,6 +127,7 @@ type TaskDefinition struct {
 	PlacementTags                 []string               `protobuf:"bytes,20,rep,name=PlacementTags" json:"placement_tags,omitempty"`
 	MaxPids                       int32                  `protobuf:"varint,21,opt,name=max_pids,json=maxPids" json:"max_pids"`
 	CertificateProperties         *CertificateProperties `protobuf:"bytes,22,opt,name=certificate_properties,json=certificateProperties" json:"certificate_properties,omitempty"`
+	RetryPolicy                   *TaskRetryPolicy       `protobuf:"bytes,23,opt,name=retry_policy,json=retryPolicy" json:"retry_policy,omitempty"`
 }
 
 func (m *TaskDefinition) Reset()                    { *m = TaskDefinition{} }
@@ -247,23 +288,110 @@ func (m *TaskDefinition) GetCertificateProperties() *CertificateProperties {
 	return nil
 }
 
+func (m *TaskDefinition) GetRetryPolicy() *TaskRetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
+type TaskRetryPolicy struct {
+	MaxAttempts       int32              `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts" json:"max_attempts"`
+	BackoffMs         int64              `protobuf:"varint,2,opt,name=backoff_ms,json=backoffMs" json:"backoff_ms"`
+	RetryableFailures []TaskFailureClass `protobuf:"varint,3,rep,name=retryable_failures,json=retryableFailures,enum=models.TaskFailureClass" json:"retryable_failures,omitempty"`
+}
+
+func (m *TaskRetryPolicy) Reset()                    { *m = TaskRetryPolicy{} }
+func (*TaskRetryPolicy) ProtoMessage()               {}
+func (*TaskRetryPolicy) Descriptor() ([]byte, []int) { return fileDescriptorTask, []int{1} }
+
+func (m *TaskRetryPolicy) GetMaxAttempts() int32 {
+	if m != nil {
+		return m.MaxAttempts
+	}
+	return 0
+}
+
+func (m *TaskRetryPolicy) GetBackoffMs() int64 {
+	if m != nil {
+		return m.BackoffMs
+	}
+	return 0
+}
+
+func (m *TaskRetryPolicy) GetRetryableFailures() []TaskFailureClass {
+	if m != nil {
+		return m.RetryableFailures
+	}
+	return nil
+}
+
+type TaskAttemptFailure struct {
+	Attempt       int32            `protobuf:"varint,1,opt,name=attempt" json:"attempt"`
+	FailureClass  TaskFailureClass `protobuf:"varint,2,opt,name=failure_class,json=failureClass,enum=models.TaskFailureClass" json:"failure_class"`
+	FailureReason string           `protobuf:"bytes,3,opt,name=failure_reason,json=failureReason" json:"failure_reason"`
+	CellId        string           `protobuf:"bytes,4,opt,name=cell_id,json=cellId" json:"cell_id"`
+	FailedAt      int64            `protobuf:"varint,5,opt,name=failed_at,json=failedAt" json:"failed_at"`
+}
+
+func (m *TaskAttemptFailure) Reset()                    { *m = TaskAttemptFailure{} }
+func (*TaskAttemptFailure) ProtoMessage()               {}
+func (*TaskAttemptFailure) Descriptor() ([]byte, []int) { return fileDescriptorTask, []int{2} }
+
+func (m *TaskAttemptFailure) GetAttempt() int32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *TaskAttemptFailure) GetFailureClass() TaskFailureClass {
+	if m != nil {
+		return m.FailureClass
+	}
+	return TaskFailureClass_UnclassifiedFailure
+}
+
+func (m *TaskAttemptFailure) GetFailureReason() string {
+	if m != nil {
+		return m.FailureReason
+	}
+	return ""
+}
+
+func (m *TaskAttemptFailure) GetCellId() string {
+	if m != nil {
+		return m.CellId
+	}
+	return ""
+}
+
+func (m *TaskAttemptFailure) GetFailedAt() int64 {
+	if m != nil {
+		return m.FailedAt
+	}
+	return 0
+}
+
 type Task struct {
 	*TaskDefinition  `protobuf:"bytes,1,opt,name=task_definition,json=taskDefinition,embedded=task_definition" json:""`
-	TaskGuid         string     `protobuf:"bytes,2,opt,name=task_guid,json=taskGuid" json:"task_guid"`
-	Domain           string     `protobuf:"bytes,3,opt,name=domain" json:"domain"`
-	CreatedAt        int64      `protobuf:"varint,4,opt,name=created_at,json=createdAt" json:"created_at"`
-	UpdatedAt        int64      `protobuf:"varint,5,opt,name=updated_at,json=updatedAt" json:"updated_at"`
-	FirstCompletedAt int64      `protobuf:"varint,6,opt,name=first_completed_at,json=firstCompletedAt" json:"first_completed_at"`
-	State            Task_State `protobuf:"varint,7,opt,name=state,enum=models.Task_State" json:"state"`
-	CellId           string     `protobuf:"bytes,8,opt,name=cell_id,json=cellId" json:"cell_id"`
-	Result           string     `protobuf:"bytes,9,opt,name=result" json:"result"`
-	Failed           bool       `protobuf:"varint,10,opt,name=failed" json:"failed"`
-	FailureReason    string     `protobuf:"bytes,11,opt,name=failure_reason,json=failureReason" json:"failure_reason"`
+	TaskGuid         string                `protobuf:"bytes,2,opt,name=task_guid,json=taskGuid" json:"task_guid"`
+	Domain           string                `protobuf:"bytes,3,opt,name=domain" json:"domain"`
+	CreatedAt        int64                 `protobuf:"varint,4,opt,name=created_at,json=createdAt" json:"created_at"`
+	UpdatedAt        int64                 `protobuf:"varint,5,opt,name=updated_at,json=updatedAt" json:"updated_at"`
+	FirstCompletedAt int64                 `protobuf:"varint,6,opt,name=first_completed_at,json=firstCompletedAt" json:"first_completed_at"`
+	State            Task_State            `protobuf:"varint,7,opt,name=state,enum=models.Task_State" json:"state"`
+	CellId           string                `protobuf:"bytes,8,opt,name=cell_id,json=cellId" json:"cell_id"`
+	Result           string                `protobuf:"bytes,9,opt,name=result" json:"result"`
+	Failed           bool                  `protobuf:"varint,10,opt,name=failed" json:"failed"`
+	FailureReason    string                `protobuf:"bytes,11,opt,name=failure_reason,json=failureReason" json:"failure_reason"`
+	RetryCount       int32                 `protobuf:"varint,12,opt,name=retry_count,json=retryCount" json:"retry_count,omitempty"`
+	FailureHistory   []*TaskAttemptFailure `protobuf:"bytes,13,rep,name=failure_history,json=failureHistory" json:"failure_history,omitempty"`
 }
 
 func (m *Task) Reset()                    { *m = Task{} }
 func (*Task) ProtoMessage()               {}
-func (*Task) Descriptor() ([]byte, []int) { return fileDescriptorTask, []int{1} }
+func (*Task) Descriptor() ([]byte, []int) { return fileDescriptorTask, []int{3} }
 
 func (m *Task) GetTaskGuid() string {
 	if m != nil {
@@ -335,11 +463,35 @@ func (m *Task) GetFailureReason() string {
 	return ""
 }
 
+func (m *Task) GetRetryCount() int32 {
+	if m != nil {
+		return m.RetryCount
+	}
+	return 0
+}
+
+func (m *Task) GetFailureHistory() []*TaskAttemptFailure {
+	if m != nil {
+		return m.FailureHistory
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TaskDefinition)(nil), "models.TaskDefinition")
+	proto.RegisterType((*TaskRetryPolicy)(nil), "models.TaskRetryPolicy")
+	proto.RegisterType((*TaskAttemptFailure)(nil), "models.TaskAttemptFailure")
 	proto.RegisterType((*Task)(nil), "models.Task")
+	proto.RegisterEnum("models.TaskFailureClass", TaskFailureClass_name, TaskFailureClass_value)
 	proto.RegisterEnum("models.Task_State", Task_State_name, Task_State_value)
 }
+func (x TaskFailureClass) String() string {
+	s, ok := TaskFailureClass_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
 func (x Task_State) String() string {
 	s, ok := Task_State_name[int32(x)]
 	if ok {
@@ -463,6 +615,92 @@ func (this *TaskDefinition) Equal(that interface{}) bool {
 	if !this.CertificateProperties.Equal(that1.CertificateProperties) {
 		return false
 	}
+	if !this.RetryPolicy.Equal(that1.RetryPolicy) {
+		return false
+	}
+	return true
+}
+func (this *TaskRetryPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*TaskRetryPolicy)
+	if !ok {
+		that2, ok := that.(TaskRetryPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.MaxAttempts != that1.MaxAttempts {
+		return false
+	}
+	if this.BackoffMs != that1.BackoffMs {
+		return false
+	}
+	if len(this.RetryableFailures) != len(that1.RetryableFailures) {
+		return false
+	}
+	for i := range this.RetryableFailures {
+		if this.RetryableFailures[i] != that1.RetryableFailures[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *TaskAttemptFailure) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*TaskAttemptFailure)
+	if !ok {
+		that2, ok := that.(TaskAttemptFailure)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Attempt != that1.Attempt {
+		return false
+	}
+	if this.FailureClass != that1.FailureClass {
+		return false
+	}
+	if this.FailureReason != that1.FailureReason {
+		return false
+	}
+	if this.CellId != that1.CellId {
+		return false
+	}
+	if this.FailedAt != that1.FailedAt {
+		return false
+	}
 	return true
 }
 func (this *Task) Equal(that interface{}) bool {
@@ -523,13 +761,24 @@ func (this *Task) Equal(that interface{}) bool {
 	if this.FailureReason != that1.FailureReason {
 		return false
 	}
+	if this.RetryCount != that1.RetryCount {
+		return false
+	}
+	if len(this.FailureHistory) != len(that1.FailureHistory) {
+		return false
+	}
+	for i := range this.FailureHistory {
+		if !this.FailureHistory[i].Equal(that1.FailureHistory[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *TaskDefinition) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 26)
+	s := make([]string, 0, 27)
 	s = append(s, "&models.TaskDefinition{")
 	s = append(s, "RootFs: "+fmt.Sprintf("%#v", this.RootFs)+",\n")
 	if this.EnvironmentVariables != nil {
@@ -569,6 +818,37 @@ func (this *TaskDefinition) GoString() string {
 	if this.CertificateProperties != nil {
 		s = append(s, "CertificateProperties: "+fmt.Sprintf("%#v", this.CertificateProperties)+",\n")
 	}
+	if this.RetryPolicy != nil {
+		s = append(s, "RetryPolicy: "+fmt.Sprintf("%#v", this.RetryPolicy)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TaskRetryPolicy) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.TaskRetryPolicy{")
+	s = append(s, "MaxAttempts: "+fmt.Sprintf("%#v", this.MaxAttempts)+",\n")
+	s = append(s, "BackoffMs: "+fmt.Sprintf("%#v", this.BackoffMs)+",\n")
+	if this.RetryableFailures != nil {
+		s = append(s, "RetryableFailures: "+fmt.Sprintf("%#v", this.RetryableFailures)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TaskAttemptFailure) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&models.TaskAttemptFailure{")
+	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
+	s = append(s, "FailureClass: "+fmt.Sprintf("%#v", this.FailureClass)+",\n")
+	s = append(s, "FailureReason: "+fmt.Sprintf("%#v", this.FailureReason)+",\n")
+	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
+	s = append(s, "FailedAt: "+fmt.Sprintf("%#v", this.FailedAt)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -576,7 +856,7 @@ func (this *Task) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 15)
+	s := make([]string, 0, 17)
 	s = append(s, "&models.Task{")
 	if this.TaskDefinition != nil {
 		s = append(s, "TaskDefinition: "+fmt.Sprintf("%#v", this.TaskDefinition)+",\n")
@@ -591,6 +871,10 @@ func (this *Task) GoString() string {
 	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
 	s = append(s, "Failed: "+fmt.Sprintf("%#v", this.Failed)+",\n")
 	s = append(s, "FailureReason: "+fmt.Sprintf("%#v", this.FailureReason)+",\n")
+	s = append(s, "RetryCount: "+fmt.Sprintf("%#v", this.RetryCount)+",\n")
+	if this.FailureHistory != nil {
+		s = append(s, "FailureHistory: "+fmt.Sprintf("%#v", this.FailureHistory)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -780,6 +1064,84 @@ func (m *TaskDefinition) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n3
 	}
+	if m.RetryPolicy != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(m.RetryPolicy.Size()))
+		n4, err := m.RetryPolicy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	return i, nil
+}
+
+func (m *TaskRetryPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskRetryPolicy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintTask(dAtA, i, uint64(m.MaxAttempts))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintTask(dAtA, i, uint64(m.BackoffMs))
+	if len(m.RetryableFailures) > 0 {
+		for _, num := range m.RetryableFailures {
+			dAtA[i] = 0x18
+			i++
+			i = encodeVarintTask(dAtA, i, uint64(num))
+		}
+	}
+	return i, nil
+}
+
+func (m *TaskAttemptFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskAttemptFailure) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintTask(dAtA, i, uint64(m.Attempt))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintTask(dAtA, i, uint64(m.FailureClass))
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintTask(dAtA, i, uint64(len(m.FailureReason)))
+	i += copy(dAtA[i:], m.FailureReason)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintTask(dAtA, i, uint64(len(m.CellId)))
+	i += copy(dAtA[i:], m.CellId)
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintTask(dAtA, i, uint64(m.FailedAt))
 	return i, nil
 }
 
@@ -802,11 +1164,11 @@ func (m *Task) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintTask(dAtA, i, uint64(m.TaskDefinition.Size()))
-		n4, err := m.TaskDefinition.MarshalTo(dAtA[i:])
+		n5, err := m.TaskDefinition.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n5
 	}
 	dAtA[i] = 0x12
 	i++
@@ -848,6 +1210,21 @@ func (m *Task) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	i = encodeVarintTask(dAtA, i, uint64(len(m.FailureReason)))
 	i += copy(dAtA[i:], m.FailureReason)
+	dAtA[i] = 0x60
+	i++
+	i = encodeVarintTask(dAtA, i, uint64(m.RetryCount))
+	if len(m.FailureHistory) > 0 {
+		for _, msg := range m.FailureHistory {
+			dAtA[i] = 0x6a
+			i++
+			i = encodeVarintTask(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -946,20 +1323,50 @@ func (m *TaskDefinition) Size() (n int) {
 		l = m.CertificateProperties.Size()
 		n += 2 + l + sovTask(uint64(l))
 	}
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 2 + l + sovTask(uint64(l))
+	}
 	return n
 }
 
-func (m *Task) Size() (n int) {
+func (m *TaskRetryPolicy) Size() (n int) {
 	var l int
 	_ = l
-	if m.TaskDefinition != nil {
-		l = m.TaskDefinition.Size()
-		n += 1 + l + sovTask(uint64(l))
+	n += 1 + sovTask(uint64(m.MaxAttempts))
+	n += 1 + sovTask(uint64(m.BackoffMs))
+	if len(m.RetryableFailures) > 0 {
+		for _, e := range m.RetryableFailures {
+			n += 1 + sovTask(uint64(e))
+		}
 	}
-	l = len(m.TaskGuid)
-	n += 1 + l + sovTask(uint64(l))
-	l = len(m.Domain)
-	n += 1 + l + sovTask(uint64(l))
+	return n
+}
+
+func (m *TaskAttemptFailure) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovTask(uint64(m.Attempt))
+	n += 1 + sovTask(uint64(m.FailureClass))
+	l = len(m.FailureReason)
+	n += 1 + l + sovTask(uint64(l))
+	l = len(m.CellId)
+	n += 1 + l + sovTask(uint64(l))
+	n += 1 + sovTask(uint64(m.FailedAt))
+	return n
+}
+
+func (m *Task) Size() (n int) {
+	var l int
+	_ = l
+	if m.TaskDefinition != nil {
+		l = m.TaskDefinition.Size()
+		n += 1 + l + sovTask(uint64(l))
+	}
+	l = len(m.TaskGuid)
+	n += 1 + l + sovTask(uint64(l))
+	l = len(m.Domain)
+	n += 1 + l + sovTask(uint64(l))
 	n += 1 + sovTask(uint64(m.CreatedAt))
 	n += 1 + sovTask(uint64(m.UpdatedAt))
 	n += 1 + sovTask(uint64(m.FirstCompletedAt))
@@ -971,6 +1378,13 @@ func (m *Task) Size() (n int) {
 	n += 2
 	l = len(m.FailureReason)
 	n += 1 + l + sovTask(uint64(l))
+	n += 1 + sovTask(uint64(m.RetryCount))
+	if len(m.FailureHistory) > 0 {
+		for _, e := range m.FailureHistory {
+			l = e.Size()
+			n += 1 + l + sovTask(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1014,6 +1428,33 @@ func (this *TaskDefinition) String() string {
 		`PlacementTags:` + fmt.Sprintf("%v", this.PlacementTags) + `,`,
 		`MaxPids:` + fmt.Sprintf("%v", this.MaxPids) + `,`,
 		`CertificateProperties:` + strings.Replace(fmt.Sprintf("%v", this.CertificateProperties), "CertificateProperties", "CertificateProperties", 1) + `,`,
+		`RetryPolicy:` + strings.Replace(fmt.Sprintf("%v", this.RetryPolicy), "TaskRetryPolicy", "TaskRetryPolicy", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TaskRetryPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskRetryPolicy{`,
+		`MaxAttempts:` + fmt.Sprintf("%v", this.MaxAttempts) + `,`,
+		`BackoffMs:` + fmt.Sprintf("%v", this.BackoffMs) + `,`,
+		`RetryableFailures:` + fmt.Sprintf("%v", this.RetryableFailures) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TaskAttemptFailure) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskAttemptFailure{`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`FailureClass:` + fmt.Sprintf("%v", this.FailureClass) + `,`,
+		`FailureReason:` + fmt.Sprintf("%v", this.FailureReason) + `,`,
+		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
+		`FailedAt:` + fmt.Sprintf("%v", this.FailedAt) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1034,6 +1475,8 @@ func (this *Task) String() string {
 		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
 		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
 		`FailureReason:` + fmt.Sprintf("%v", this.FailureReason) + `,`,
+		`RetryCount:` + fmt.Sprintf("%v", this.RetryCount) + `,`,
+		`FailureHistory:` + strings.Replace(fmt.Sprintf("%v", this.FailureHistory), "TaskAttemptFailure", "TaskAttemptFailure", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1684,6 +2127,354 @@ func (m *TaskDefinition) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &TaskRetryPolicy{}
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskRetryPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskRetryPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskRetryPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAttempts", wireType)
+			}
+			m.MaxAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAttempts |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackoffMs", wireType)
+			}
+			m.BackoffMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BackoffMs |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v TaskFailureClass
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTask
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (TaskFailureClass(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RetryableFailures = append(m.RetryableFailures, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTask
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTask
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v TaskFailureClass
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTask
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (TaskFailureClass(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RetryableFailures = append(m.RetryableFailures, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryableFailures", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskAttemptFailure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskAttemptFailure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskAttemptFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureClass", wireType)
+			}
+			m.FailureClass = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailureClass |= (TaskFailureClass(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedAt", wireType)
+			}
+			m.FailedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -2008,6 +2799,56 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 			}
 			m.FailureReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryCount", wireType)
+			}
+			m.RetryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryCount |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureHistory = append(m.FailureHistory, &TaskAttemptFailure{})
+			if err := m.FailureHistory[len(m.FailureHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -2137,75 +2978,93 @@ var (
 func init() { proto.RegisterFile("task.proto", fileDescriptorTask) }
 
 var fileDescriptorTask = []byte{
-	// 1112 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x84, 0x95, 0x4f, 0x6f, 0xdb, 0x36,
-	0x18, 0xc6, 0xa3, 0x26, 0xf1, 0x1f, 0x3a, 0x76, 0x53, 0x36, 0x49, 0xb5, 0xb4, 0x91, 0x9d, 0x6e,
-	0x6b, 0xbd, 0xad, 0x73, 0x01, 0x9f, 0x77, 0x58, 0x9c, 0xae, 0x45, 0x81, 0x75, 0x08, 0x9c, 0xb6,
-	0xeb, 0x8d, 0xa0, 0x25, 0x5a, 0x21, 0x42, 0x89, 0x02, 0x49, 0xb9, 0x35, 0x06, 0x0c, 0xfb, 0x08,
-	0x3b, 0xed, 0x2b, 0x6c, 0x1f, 0xa5, 0xc7, 0x1e, 0x77, 0x32, 0x56, 0xef, 0x32, 0xf8, 0xd4, 0x8f,
-	0x30, 0x90, 0xa2, 0x1c, 0x7a, 0xf3, 0xb0, 0x93, 0xcd, 0xe7, 0xf9, 0xf1, 0x7d, 0x29, 0x8a, 0x7c,
-	0x04, 0x80, 0xc2, 0xf2, 0xb2, 0x97, 0x09, 0xae, 0x38, 0xac, 0x24, 0x3c, 0x22, 0x4c, 0x1e, 0x7e,
-	0x19, 0x53, 0x75, 0x91, 0x8f, 0x7a, 0x21, 0x4f, 0x1e, 0xc6, 0x3c, 0xe6, 0x0f, 0x8d, 0x3d, 0xca,
-	0xc7, 0x66, 0x64, 0x06, 0xe6, 0x5f, 0x31, 0xed, 0xb0, 0x89, 0x43, 0x45, 0x79, 0x2a, 0xed, 0xf0,
-	0x36, 0x49, 0x27, 0x54, 0xf0, 0x34, 0x21, 0xa9, 0x42, 0x13, 0x2c, 0x28, 0x1e, 0x31, 0x52, 0x9a,
-	0x7b, 0x92, 0x84, 0xb9, 0xa0, 0x6a, 0x8a, 0x62, 0xc1, 0xf3, 0xcc, 0xaa, 0xb7, 0x42, 0x1c, 0x5e,
-	0x90, 0x08, 0x45, 0x24, 0x23, 0x69, 0x44, 0xd2, 0x70, 0x6a, 0x0d, 0x38, 0xe1, 0x2c, 0x4f, 0x08,
-	0x4a, 0x78, 0x9e, 0xaa, 0xb2, 0x5d, 0x4a, 0xd4, 0x6b, 0x2e, 0xec, 0xa2, 0x0f, 0xef, 0x84, 0x44,
-	0x28, 0x3a, 0xa6, 0x21, 0x56, 0x04, 0x65, 0x82, 0x67, 0x7a, 0x58, 0xf6, 0xbb, 0xfb, 0x6b, 0x03,
-	0xb4, 0x9e, 0x63, 0x79, 0xf9, 0x88, 0x8c, 0x69, 0x4a, 0xf5, 0x32, 0xe1, 0x7d, 0x50, 0x15, 0x9c,
-	0x2b, 0x34, 0x96, 0xbe, 0xd7, 0xf1, 0xba, 0xf5, 0x41, 0xeb, 0xed, 0xac, 0xbd, 0xb1, 0x98, 0xb5,
-	0x2b, 0x5a, 0x1e, 0xcb, 0xa1, 0xf9, 0x7d, 0x2c, 0x61, 0x08, 0xf6, 0xd7, 0x3e, 0x8a, 0x7f, 0xad,
-	0xb3, 0xd9, 0x6d, 0xf4, 0x6f, 0xf7, 0x8a, 0xed, 0xea, 0x7d, 0x73, 0x05, 0xbd, 0xb4, 0xcc, 0xe0,
-	0xc6, 0x62, 0xd6, 0x6e, 0x92, 0x74, 0xf2, 0x80, 0x27, 0x54, 0x91, 0x24, 0x53, 0xd3, 0xe1, 0x1e,
-	0xf9, 0x37, 0x27, 0xe1, 0x3d, 0x50, 0x29, 0xb6, 0xcf, 0xdf, 0xec, 0x78, 0xdd, 0x46, 0xbf, 0x55,
-	0x56, 0x3d, 0x31, 0xea, 0xd0, 0xba, 0xf0, 0x08, 0x54, 0x23, 0x2a, 0x2f, 0x51, 0x32, 0xf2, 0xb7,
-	0x3a, 0x5e, 0x77, 0x7b, 0xb0, 0xa5, 0x57, 0x3d, 0xac, 0x68, 0xf1, 0xd9, 0x08, 0x1e, 0x83, 0x7a,
-	0x42, 0x12, 0x2e, 0xa6, 0x1a, 0xd8, 0x76, 0x80, 0x5a, 0x21, 0x3f, 0x1b, 0xc1, 0x8f, 0x01, 0x08,
-	0xb3, 0x1c, 0xbd, 0x26, 0x34, 0xbe, 0x50, 0x7e, 0xa5, 0xe3, 0x75, 0x9b, 0x96, 0xa9, 0x87, 0x59,
-	0xfe, 0xbd, 0x91, 0xe1, 0x27, 0x00, 0x64, 0x82, 0x4e, 0x28, 0x23, 0x31, 0x89, 0xfc, 0x6a, 0xc7,
-	0xeb, 0xd6, 0x2c, 0xe4, 0xe8, 0xba, 0x14, 0xe3, 0x31, 0x92, 0x3c, 0x17, 0x21, 0xf1, 0x6b, 0x66,
-	0x17, 0x6d, 0x29, 0xc6, 0xe3, 0x73, 0x23, 0xc3, 0x36, 0xa8, 0x69, 0x28, 0xce, 0x69, 0xe4, 0xd7,
-	0x1d, 0xa4, 0xca, 0x78, 0xfc, 0x24, 0xa7, 0x11, 0xbc, 0x0f, 0x76, 0x12, 0xa2, 0x04, 0x0d, 0x65,
-	0x01, 0x01, 0x07, 0x6a, 0x58, 0xc7, 0x80, 0x9f, 0x82, 0x86, 0x20, 0x32, 0x67, 0x0a, 0x8d, 0x29,
-	0x23, 0x7e, 0xc3, 0xe1, 0x40, 0x61, 0x3c, 0xa6, 0x8c, 0x40, 0x0c, 0x6e, 0x85, 0x3c, 0xc9, 0x18,
-	0xd1, 0x1b, 0x86, 0x42, 0xcc, 0xd8, 0x08, 0x87, 0x97, 0x28, 0x17, 0xcc, 0xdf, 0x31, 0x53, 0x3e,
-	0xb3, 0x2f, 0xfa, 0xf8, 0x3f, 0x30, 0xe7, 0x65, 0xed, 0x5f, 0x21, 0xa7, 0x96, 0x78, 0x21, 0x18,
-	0xfc, 0x0a, 0x00, 0x9c, 0xa6, 0x5c, 0x61, 0xf3, 0xc6, 0x9a, 0xa6, 0xea, 0x1d, 0x5b, 0x75, 0xef,
-	0xca, 0x71, 0x0a, 0x39, 0x3c, 0x7c, 0x05, 0x76, 0x48, 0x2c, 0x88, 0x94, 0x48, 0xe4, 0xfa, 0x1c,
-	0xb5, 0xcc, 0x39, 0xfa, 0xa8, 0x7c, 0xe3, 0xe7, 0xf6, 0x6a, 0x3c, 0xd1, 0x37, 0x63, 0x98, 0x33,
-	0x32, 0x38, 0x5c, 0xcc, 0xda, 0x07, 0xee, 0x14, 0xa7, 0x70, 0xa3, 0xd0, 0x35, 0x27, 0x21, 0x03,
-	0x37, 0xff, 0x79, 0x85, 0x28, 0x91, 0xfe, 0x75, 0xd3, 0xc0, 0x2f, 0x1b, 0x9c, 0x1a, 0xe4, 0xd1,
-	0xf2, 0x92, 0x0d, 0x8e, 0x17, 0xb3, 0xf6, 0xd1, 0x9a, 0x89, 0x4e, 0x1b, 0x18, 0xae, 0x4e, 0xa2,
-	0x44, 0xc2, 0x57, 0x60, 0x8f, 0x91, 0x18, 0x87, 0x53, 0x14, 0xf1, 0xd7, 0x29, 0xe3, 0x38, 0x42,
-	0xb9, 0x24, 0xc2, 0xdf, 0x35, 0xfb, 0x71, 0xcf, 0xee, 0x47, 0xb0, 0x8e, 0x71, 0x2b, 0x17, 0xfe,
-	0x23, 0x6b, 0xbf, 0x90, 0x44, 0xc0, 0x1f, 0x40, 0x47, 0x89, 0x5c, 0x2a, 0x12, 0x21, 0x39, 0x95,
-	0x8a, 0x24, 0xc8, 0xb9, 0xdd, 0x12, 0x65, 0x58, 0x5d, 0xf8, 0x37, 0x4c, 0x97, 0xbe, 0xed, 0xf2,
-	0xf9, 0xff, 0xf1, 0x4e, 0xc7, 0x23, 0xcb, 0x9e, 0x1b, 0xf4, 0xd4, 0x21, 0xcf, 0xb0, 0xba, 0x80,
-	0x2f, 0x40, 0xd3, 0x8d, 0x1b, 0xe9, 0x43, 0xb3, 0x7d, 0x37, 0xcb, 0xed, 0x7b, 0x69, 0xcc, 0x67,
-	0xda, 0x1b, 0xdc, 0x5e, 0xcc, 0xda, 0xb7, 0x56, 0x68, 0xa7, 0xcf, 0xce, 0xe4, 0x8a, 0x94, 0xf0,
-	0x6b, 0x50, 0xb5, 0x89, 0xe5, 0xdf, 0x34, 0x57, 0xfc, 0x7a, 0x59, 0xf0, 0xbb, 0x42, 0x1e, 0xec,
-	0x2f, 0x66, 0xed, 0x1b, 0x96, 0x71, 0xca, 0x94, 0xd3, 0xe0, 0x00, 0x34, 0xcf, 0x18, 0x0e, 0x89,
-	0x4e, 0x8e, 0xe7, 0x38, 0x96, 0xfe, 0x5e, 0x67, 0x53, 0x1f, 0xbc, 0xc5, 0xac, 0xed, 0x67, 0xa5,
-	0x81, 0x14, 0x8e, 0xdd, 0x45, 0xac, 0x4e, 0xd1, 0xb7, 0x31, 0xc1, 0x6f, 0x50, 0x46, 0x23, 0xe9,
-	0xef, 0x3b, 0xf9, 0x50, 0x4d, 0xf0, 0x9b, 0x33, 0x1a, 0x49, 0xf8, 0x23, 0x38, 0x58, 0x9f, 0xa4,
-	0xfe, 0x81, 0x59, 0xf5, 0xd1, 0xf2, 0x14, 0x5d, 0x51, 0x67, 0x4b, 0x68, 0xd0, 0x7d, 0x3b, 0x6b,
-	0x7b, 0x8b, 0x59, 0xbb, 0xb3, 0xbe, 0xc8, 0xca, 0xd5, 0x5a, 0x57, 0xe0, 0xee, 0x2f, 0x5b, 0x60,
-	0x4b, 0x27, 0x35, 0x7c, 0x0a, 0xae, 0xeb, 0x6f, 0x12, 0x8a, 0x96, 0x91, 0x6d, 0x72, 0xba, 0xd1,
-	0x3f, 0x28, 0x57, 0xb0, 0x1a, 0xe8, 0x83, 0xda, 0xbb, 0xa2, 0xf5, 0xc6, 0xb0, 0xa5, 0x56, 0xa3,
-	0xfe, 0x18, 0xd4, 0x4d, 0x29, 0x13, 0x2f, 0xd7, 0x9c, 0xd8, 0xa8, 0x69, 0xd9, 0x64, 0xcb, 0x1d,
-	0x50, 0x89, 0x78, 0x82, 0x69, 0x91, 0xbf, 0xf5, 0x65, 0xac, 0x1a, 0xcd, 0x64, 0xa6, 0x20, 0x58,
-	0x9f, 0x2f, 0xac, 0x4c, 0xf0, 0x6e, 0x2e, 0x33, 0xb3, 0xd0, 0x4f, 0x94, 0x86, 0xf2, 0x2c, 0x2a,
-	0xa1, 0x6d, 0x17, 0xb2, 0xfa, 0x89, 0x82, 0x7d, 0x00, 0xc7, 0x54, 0x48, 0x85, 0x6c, 0xb0, 0x14,
-	0x70, 0xc5, 0x81, 0x77, 0x8d, 0x7f, 0x5a, 0xda, 0x27, 0x0a, 0xf6, 0xc0, 0xb6, 0x54, 0x58, 0x11,
-	0x93, 0xc3, 0xad, 0x3e, 0x74, 0x9f, 0xbf, 0x77, 0xae, 0x1d, 0x3b, 0xb5, 0xc0, 0xf4, 0x37, 0x22,
-	0x24, 0x8c, 0x21, 0x1a, 0xad, 0x64, 0x72, 0x45, 0x8b, 0x4f, 0xcd, 0xa3, 0x16, 0x69, 0xb9, 0x12,
-	0xc7, 0x56, 0xd3, 0xee, 0x18, 0x53, 0x46, 0x8a, 0x1c, 0x2e, 0x53, 0xdf, 0x6a, 0xf0, 0x0b, 0xd0,
-	0xd2, 0xff, 0x72, 0x41, 0x90, 0x20, 0x58, 0xf2, 0x74, 0x25, 0x85, 0x9b, 0xd6, 0x1b, 0x1a, 0xeb,
-	0xee, 0xb7, 0x60, 0xdb, 0xac, 0x0e, 0x36, 0x40, 0xf5, 0x69, 0x3a, 0xc1, 0x8c, 0x46, 0xbb, 0x1b,
-	0x7a, 0x70, 0x46, 0xd2, 0x88, 0xa6, 0xf1, 0xae, 0xa7, 0x07, 0xc3, 0x3c, 0x4d, 0xf5, 0xe0, 0x1a,
-	0x6c, 0x82, 0xfa, 0xf2, 0xb1, 0x77, 0x37, 0xf5, 0x70, 0x48, 0x24, 0x67, 0x13, 0xed, 0x6e, 0x0d,
-	0x1e, 0xbc, 0x7b, 0x1f, 0x78, 0xbf, 0xbf, 0x0f, 0x36, 0x3e, 0xbc, 0x0f, 0xbc, 0x9f, 0xe6, 0x81,
-	0xf7, 0xdb, 0x3c, 0xf0, 0xde, 0xce, 0x03, 0xef, 0xdd, 0x3c, 0xf0, 0xfe, 0x98, 0x07, 0xde, 0x5f,
-	0xf3, 0x60, 0xe3, 0xc3, 0x3c, 0xf0, 0x7e, 0xfe, 0x33, 0xd8, 0xf8, 0x3b, 0x00, 0x00, 0xff, 0xff,
-	0x1d, 0x86, 0xdd, 0x12, 0xd0, 0x08, 0x00, 0x00,
+	// 1402 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x84, 0x56, 0xcb, 0x6e, 0x1b, 0x37,
+	0x17, 0xf6, 0x58, 0xd6, 0xc5, 0x94, 0x25, 0xcb, 0xf4, 0x6d, 0xe2, 0xd8, 0x92, 0xe2, 0xff, 0x6f,
+	0xa2, 0xa6, 0xa9, 0x03, 0x78, 0x5d, 0x14, 0xb5, 0xe4, 0x24, 0x0d, 0x50, 0x17, 0x86, 0x1c, 0xa7,
+	0xd9, 0x0d, 0xa8, 0x19, 0x6a, 0x4c, 0x78, 0x66, 0x38, 0x20, 0x39, 0x8a, 0x85, 0x02, 0x45, 0x1f,
+	0xa1, 0x8f, 0xd1, 0x67, 0xe8, 0x13, 0x04, 0x5d, 0x65, 0xd9, 0x95, 0xd0, 0xa8, 0x9b, 0x40, 0xab,
+	0xbc, 0x41, 0x0b, 0x72, 0x38, 0x12, 0xe5, 0x28, 0xed, 0x4a, 0xe2, 0xf7, 0x7d, 0xe7, 0xc2, 0xcb,
+	0x39, 0x67, 0x00, 0x10, 0x88, 0x5f, 0x1f, 0xc5, 0x8c, 0x0a, 0x0a, 0x0b, 0x21, 0xf5, 0x70, 0xc0,
+	0xf7, 0xbe, 0xf4, 0x89, 0xb8, 0x4a, 0x7a, 0x47, 0x2e, 0x0d, 0x1f, 0xfb, 0xd4, 0xa7, 0x8f, 0x15,
+	0xdd, 0x4b, 0xfa, 0x6a, 0xa5, 0x16, 0xea, 0x5f, 0x6a, 0xb6, 0x57, 0x41, 0xae, 0x20, 0x34, 0xe2,
+	0x7a, 0x79, 0x17, 0x47, 0x03, 0xc2, 0x68, 0x14, 0xe2, 0x48, 0x38, 0x03, 0xc4, 0x08, 0xea, 0x05,
+	0x38, 0x23, 0xb7, 0x38, 0x76, 0x13, 0x46, 0xc4, 0xd0, 0xf1, 0x19, 0x4d, 0x62, 0x8d, 0xee, 0xba,
+	0xc8, 0xbd, 0xc2, 0x9e, 0xe3, 0xe1, 0x18, 0x47, 0x1e, 0x8e, 0xdc, 0xa1, 0x26, 0xe0, 0x80, 0x06,
+	0x49, 0x88, 0x9d, 0x90, 0x26, 0x91, 0xc8, 0xc2, 0x45, 0x58, 0xbc, 0xa6, 0x4c, 0x27, 0xbd, 0xb7,
+	0xef, 0x62, 0x26, 0x48, 0x9f, 0xb8, 0x48, 0x60, 0x27, 0x66, 0x34, 0x96, 0xcb, 0x2c, 0xde, 0xe1,
+	0xdf, 0x65, 0x50, 0x7d, 0x81, 0xf8, 0xf5, 0x29, 0xee, 0x93, 0x88, 0xc8, 0x34, 0xe1, 0x03, 0x50,
+	0x64, 0x94, 0x0a, 0xa7, 0xcf, 0x6d, 0xab, 0x69, 0xb5, 0x56, 0xdb, 0xd5, 0x37, 0xa3, 0xc6, 0xd2,
+	0x64, 0xd4, 0x28, 0x48, 0xb8, 0xcf, 0xbb, 0xea, 0xf7, 0x29, 0x87, 0x2e, 0xd8, 0x5e, 0xb8, 0x15,
+	0x7b, 0xb9, 0x99, 0x6b, 0x95, 0x8f, 0xef, 0x1e, 0xa5, 0xc7, 0x75, 0xf4, 0x64, 0x26, 0x7a, 0xa9,
+	0x35, 0xed, 0x8d, 0xc9, 0xa8, 0x51, 0xc1, 0xd1, 0xe0, 0x11, 0x0d, 0x89, 0xc0, 0x61, 0x2c, 0x86,
+	0xdd, 0x2d, 0xfc, 0xb1, 0x8e, 0xc3, 0xfb, 0xa0, 0x90, 0x1e, 0x9f, 0x9d, 0x6b, 0x5a, 0xad, 0xf2,
+	0x71, 0x35, 0xf3, 0x7a, 0xa2, 0xd0, 0xae, 0x66, 0xe1, 0x01, 0x28, 0x7a, 0x84, 0x5f, 0x3b, 0x61,
+	0xcf, 0x5e, 0x69, 0x5a, 0xad, 0x7c, 0x7b, 0x45, 0x66, 0xdd, 0x2d, 0x48, 0xf0, 0xac, 0x07, 0xef,
+	0x81, 0xd5, 0x10, 0x87, 0x94, 0x0d, 0xa5, 0x20, 0x6f, 0x08, 0x4a, 0x29, 0x7c, 0xd6, 0x83, 0xff,
+	0x03, 0xc0, 0x8d, 0x13, 0xe7, 0x35, 0x26, 0xfe, 0x95, 0xb0, 0x0b, 0x4d, 0xab, 0x55, 0xd1, 0x9a,
+	0x55, 0x37, 0x4e, 0x7e, 0x50, 0x30, 0xfc, 0x3f, 0x00, 0x31, 0x23, 0x03, 0x12, 0x60, 0x1f, 0x7b,
+	0x76, 0xb1, 0x69, 0xb5, 0x4a, 0x5a, 0x64, 0xe0, 0xd2, 0x55, 0x40, 0x7d, 0x87, 0xd3, 0x84, 0xb9,
+	0xd8, 0x2e, 0xa9, 0x53, 0xd4, 0xae, 0x02, 0xea, 0x5f, 0x28, 0x18, 0x36, 0x40, 0x49, 0x8a, 0xfc,
+	0x84, 0x78, 0xf6, 0xaa, 0x21, 0x29, 0x06, 0xd4, 0x7f, 0x96, 0x10, 0x0f, 0x3e, 0x00, 0x6b, 0x21,
+	0x16, 0x8c, 0xb8, 0x3c, 0x15, 0x01, 0x43, 0x54, 0xd6, 0x8c, 0x12, 0x7e, 0x06, 0xca, 0x0c, 0xf3,
+	0x24, 0x10, 0x4e, 0x9f, 0x04, 0xd8, 0x2e, 0x1b, 0x3a, 0x90, 0x12, 0x4f, 0x49, 0x80, 0x21, 0x02,
+	0xbb, 0x2e, 0x0d, 0xe3, 0x00, 0xcb, 0x03, 0x73, 0x5c, 0x14, 0x04, 0x3d, 0xe4, 0x5e, 0x3b, 0x09,
+	0x0b, 0xec, 0x35, 0x65, 0xf2, 0xb9, 0xbe, 0xe8, 0x7b, 0x9f, 0x90, 0x19, 0x97, 0xb5, 0x3d, 0x93,
+	0x74, 0xb4, 0xe2, 0x92, 0x05, 0xf0, 0x2b, 0x00, 0x50, 0x14, 0x51, 0x81, 0xd4, 0x8d, 0x55, 0x94,
+	0xd7, 0x7d, 0xed, 0x75, 0x6b, 0xc6, 0x18, 0x8e, 0x0c, 0x3d, 0x7c, 0x05, 0xd6, 0xb0, 0xcf, 0x30,
+	0xe7, 0x0e, 0x4b, 0xe4, 0x3b, 0xaa, 0xaa, 0x77, 0x74, 0x27, 0xbb, 0xf1, 0x0b, 0x5d, 0x1a, 0xcf,
+	0x64, 0x65, 0x74, 0x93, 0x00, 0xb7, 0xf7, 0x26, 0xa3, 0xc6, 0x8e, 0x69, 0x62, 0x38, 0x2e, 0xa7,
+	0xb8, 0xd4, 0x71, 0x18, 0x80, 0xcd, 0xdb, 0x25, 0x44, 0x30, 0xb7, 0xd7, 0x55, 0x00, 0x3b, 0x0b,
+	0xd0, 0x51, 0x92, 0xd3, 0x69, 0x91, 0xb5, 0xef, 0x4d, 0x46, 0x8d, 0x83, 0x05, 0x86, 0x46, 0x18,
+	0xe8, 0xce, 0x1b, 0x11, 0xcc, 0xe1, 0x2b, 0xb0, 0x15, 0x60, 0x1f, 0xb9, 0x43, 0xc7, 0xa3, 0xaf,
+	0xa3, 0x80, 0x22, 0xcf, 0x49, 0x38, 0x66, 0x76, 0x4d, 0x9d, 0xc7, 0x7d, 0x7d, 0x1e, 0xf5, 0x45,
+	0x1a, 0xd3, 0x73, 0xca, 0x9f, 0x6a, 0xfa, 0x92, 0x63, 0x06, 0x7f, 0x04, 0x4d, 0xc1, 0x12, 0x2e,
+	0xb0, 0xe7, 0xf0, 0x21, 0x17, 0x38, 0x74, 0x8c, 0xea, 0xe6, 0x4e, 0x8c, 0xc4, 0x95, 0xbd, 0xa1,
+	0xa2, 0x1c, 0xeb, 0x28, 0x0f, 0xff, 0x4b, 0x6f, 0x44, 0x3c, 0xd0, 0xda, 0x0b, 0x25, 0xed, 0x18,
+	0xca, 0x73, 0x24, 0xae, 0xe0, 0x25, 0xa8, 0x98, 0xed, 0x86, 0xdb, 0x50, 0x1d, 0xdf, 0x66, 0x76,
+	0x7c, 0x2f, 0x15, 0x79, 0x26, 0xb9, 0xf6, 0xdd, 0xc9, 0xa8, 0xb1, 0x3b, 0xa7, 0x36, 0xe2, 0xac,
+	0x0d, 0x66, 0x4a, 0x0e, 0xbf, 0x01, 0x45, 0xdd, 0xb1, 0xec, 0x4d, 0x55, 0xe2, 0xeb, 0x99, 0xc3,
+	0xef, 0x53, 0xb8, 0xbd, 0x3d, 0x19, 0x35, 0x36, 0xb4, 0xc6, 0x70, 0x93, 0x99, 0xc1, 0x36, 0xa8,
+	0x9c, 0x07, 0xc8, 0xc5, 0xb2, 0x73, 0xbc, 0x40, 0x3e, 0xb7, 0xb7, 0x9a, 0x39, 0xf9, 0xf0, 0x26,
+	0xa3, 0x86, 0x1d, 0x67, 0x84, 0x23, 0x90, 0x6f, 0x26, 0x31, 0x6f, 0x22, 0xab, 0x31, 0x44, 0x37,
+	0x4e, 0x4c, 0x3c, 0x6e, 0x6f, 0x1b, 0xfd, 0xa1, 0x18, 0xa2, 0x9b, 0x73, 0xe2, 0x71, 0xf8, 0x13,
+	0xd8, 0x59, 0xdc, 0x49, 0xed, 0x1d, 0x95, 0xf5, 0xc1, 0xf4, 0x15, 0xcd, 0x54, 0xe7, 0x53, 0x51,
+	0xbb, 0xf5, 0x66, 0xd4, 0xb0, 0x26, 0xa3, 0x46, 0x73, 0xb1, 0x93, 0xb9, 0xd2, 0x5a, 0xe4, 0x00,
+	0xbe, 0x04, 0x6b, 0x0c, 0x0b, 0x36, 0x74, 0x62, 0x1a, 0x10, 0x77, 0x68, 0xef, 0xaa, 0xa8, 0xbb,
+	0x59, 0x54, 0xd9, 0xc4, 0xbb, 0x92, 0x3f, 0x57, 0x74, 0x5a, 0x1a, 0xa6, 0x81, 0x59, 0x1a, 0x6c,
+	0x26, 0x3c, 0xfc, 0xdd, 0x02, 0xeb, 0xb7, 0x8c, 0x55, 0xe7, 0x41, 0x37, 0x0e, 0x12, 0xca, 0x20,
+	0x9d, 0x03, 0xf9, 0x69, 0xe7, 0x41, 0x37, 0x27, 0x9a, 0x90, 0x8d, 0x4e, 0x96, 0x3e, 0xed, 0xf7,
+	0x9d, 0x50, 0xf6, 0x7d, 0xab, 0x95, 0xcb, 0x1a, 0x9d, 0xc6, 0xcf, 0x38, 0x24, 0x00, 0xaa, 0x80,
+	0xb2, 0xa1, 0x3b, 0x7d, 0x44, 0x82, 0x84, 0x61, 0x6e, 0xe7, 0x9a, 0xb9, 0x56, 0x75, 0x56, 0x7b,
+	0x32, 0x85, 0xa7, 0x29, 0xd7, 0x09, 0x10, 0xe7, 0xed, 0xe6, 0x64, 0xd4, 0xd8, 0xff, 0xd8, 0xce,
+	0xd8, 0xc6, 0xc6, 0x94, 0xd5, 0x86, 0xfc, 0xf0, 0xbd, 0x05, 0xa0, 0xf4, 0xa4, 0x13, 0xd4, 0x38,
+	0xac, 0x83, 0xa2, 0xde, 0xcb, 0xdc, 0x56, 0x32, 0x10, 0x76, 0x40, 0x45, 0xfb, 0x77, 0x5c, 0x19,
+	0x5c, 0xed, 0xe4, 0xdf, 0x92, 0x4b, 0xed, 0xd7, 0xfa, 0x06, 0x06, 0xbf, 0x00, 0xd5, 0xcc, 0x09,
+	0xc3, 0x88, 0xeb, 0x89, 0x95, 0x35, 0xe2, 0x2c, 0x40, 0x57, 0x51, 0x72, 0x5c, 0xb9, 0x38, 0x08,
+	0x1c, 0xe2, 0xd9, 0x2b, 0x86, 0xaa, 0x20, 0xc1, 0xe7, 0x9e, 0x1c, 0x57, 0x52, 0x8f, 0x3d, 0x07,
+	0x09, 0x3b, 0x6f, 0x1c, 0x6b, 0x29, 0x85, 0x4f, 0xc4, 0xe1, 0x6f, 0x79, 0xb0, 0x22, 0xf3, 0x82,
+	0xcf, 0xc1, 0xba, 0xfc, 0x46, 0x71, 0xbc, 0xe9, 0x08, 0x57, 0x9b, 0x2c, 0x1f, 0xef, 0x98, 0xe9,
+	0xcf, 0x06, 0x7c, 0xbb, 0xf4, 0x36, 0x7d, 0x8a, 0x4b, 0xdd, 0xaa, 0x98, 0x63, 0x64, 0x58, 0xe5,
+	0x4a, 0x8d, 0x9b, 0x65, 0x23, 0xaf, 0x92, 0x84, 0xd5, 0xac, 0xd9, 0x07, 0x05, 0x8f, 0x86, 0x88,
+	0xcc, 0xef, 0x4e, 0x63, 0x6a, 0x86, 0x32, 0x8c, 0x44, 0x9a, 0xf8, 0x8a, 0xf9, 0x1e, 0x34, 0x7e,
+	0x22, 0xa4, 0x28, 0x89, 0x3d, 0x24, 0x3e, 0xde, 0xdd, 0xaa, 0xc6, 0x4f, 0x04, 0x3c, 0x06, 0xb0,
+	0x4f, 0x18, 0x17, 0x8e, 0x1e, 0x34, 0xa9, 0xb8, 0x60, 0x88, 0x6b, 0x8a, 0xef, 0x64, 0xf4, 0x89,
+	0x80, 0x47, 0x20, 0xcf, 0x05, 0x12, 0x58, 0xcd, 0xe5, 0xea, 0x31, 0x34, 0xf7, 0x7f, 0x74, 0x21,
+	0x19, 0x6d, 0x9a, 0xca, 0xcc, 0x4b, 0x28, 0x2d, 0xb8, 0x84, 0x7d, 0x50, 0x48, 0xa7, 0xe7, 0xdc,
+	0x78, 0xd6, 0x98, 0x64, 0xd3, 0xbb, 0xb0, 0x81, 0xf1, 0x15, 0xa0, 0xb1, 0x05, 0x8f, 0xa1, 0xfc,
+	0xe9, 0xc7, 0xf0, 0x35, 0x48, 0x2b, 0xd2, 0x71, 0x65, 0x47, 0x54, 0xc3, 0x38, 0xdf, 0x3e, 0xd0,
+	0x0d, 0x7c, 0xdb, 0xa0, 0xcc, 0xb9, 0xa9, 0xe0, 0x8e, 0x44, 0x61, 0x0f, 0xac, 0x67, 0xc1, 0xae,
+	0x08, 0x17, 0x94, 0x0d, 0xed, 0x8a, 0x6a, 0xcd, 0x7b, 0xe6, 0x09, 0xcc, 0xd7, 0x44, 0xfb, 0x60,
+	0x32, 0x6a, 0xdc, 0xb9, 0x65, 0x66, 0xf8, 0xcf, 0xd2, 0xff, 0x36, 0x65, 0x0e, 0xbf, 0x03, 0x79,
+	0x75, 0x82, 0xb0, 0x0c, 0x8a, 0xcf, 0xa3, 0x01, 0x0a, 0x88, 0x57, 0x5b, 0x92, 0x8b, 0x73, 0x1c,
+	0x79, 0x24, 0xf2, 0x6b, 0x96, 0x5c, 0x74, 0x93, 0x28, 0x92, 0x8b, 0x65, 0x58, 0x01, 0xab, 0xd3,
+	0xab, 0xa9, 0xe5, 0xe4, 0xb2, 0x8b, 0x39, 0x0d, 0x06, 0x92, 0x5d, 0x79, 0xe8, 0x83, 0xda, 0xed,
+	0x9a, 0x82, 0xbb, 0x60, 0xf3, 0x32, 0x52, 0xe5, 0x47, 0xfa, 0x04, 0x7b, 0x9a, 0xab, 0x2d, 0xc1,
+	0x2d, 0x50, 0x9b, 0xf6, 0xea, 0x0c, 0xb5, 0xe0, 0x26, 0x58, 0xef, 0xe0, 0x20, 0x38, 0x25, 0x1c,
+	0xc5, 0x31, 0x46, 0x0c, 0x7b, 0xb5, 0x65, 0xb8, 0x0e, 0xca, 0x4f, 0x6e, 0xc8, 0x54, 0x95, 0x6b,
+	0x3f, 0x7a, 0xfb, 0xae, 0x6e, 0xfd, 0xf1, 0xae, 0xbe, 0xf4, 0xe1, 0x5d, 0xdd, 0xfa, 0x79, 0x5c,
+	0xb7, 0x7e, 0x1d, 0xd7, 0xad, 0x37, 0xe3, 0xba, 0xf5, 0x76, 0x5c, 0xb7, 0xfe, 0x1c, 0xd7, 0xad,
+	0xf7, 0xe3, 0xfa, 0xd2, 0x87, 0x71, 0xdd, 0xfa, 0xe5, 0xaf, 0xfa, 0xd2, 0x3f, 0x01, 0x00, 0x00,
+	0xff, 0xff, 0x24, 0x3e, 0xa5, 0x0f, 0xed, 0x0b, 0x00, 0x00,
 }
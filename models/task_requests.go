@@ -24,6 +24,22 @@ func (req *DesireTaskRequest) Validate() error {
 	return nil
 }
 
+// Validate only checks that the request is not empty. Each DesireTaskRequest
+// is validated separately so that an invalid item fails on its own.
+func (req *DesireTasksRequest) Validate() error {
+	var validationError ValidationError
+
+	if len(req.Tasks) == 0 {
+		validationError = validationError.Append(ErrInvalidField{"tasks"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
 func (req *StartTaskRequest) Validate() error {
 	var validationError ValidationError
 
@@ -76,6 +92,14 @@ func (req *FailTaskRequest) Validate() error {
 }
 
 func (req *TasksRequest) Validate() error {
+	var validationError ValidationError
+
+	validationError = validatePage(validationError, req.PageSize, req.PageToken, 1)
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
 	return nil
 }
 
@@ -107,6 +131,20 @@ func (request *TaskGuidRequest) Validate() error {
 	return nil
 }
 
+func (request *TaskGuidsRequest) Validate() error {
+	var validationError ValidationError
+
+	if len(request.TaskGuids) == 0 {
+		validationError = validationError.Append(ErrInvalidField{"task_guids"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
 func (request *ConvergeTasksRequest) Validate() error {
 	return nil
 }
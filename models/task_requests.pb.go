@@ -67,6 +67,69 @@ func (m *DesireTaskRequest) GetDomain() string {
 	return ""
 }
 
+type DesireTasksRequest struct {
+	Tasks []*DesireTaskRequest `protobuf:"bytes,1,rep,name=tasks" json:"tasks,omitempty"`
+}
+
+func (m *DesireTasksRequest) Reset()                    { *m = DesireTasksRequest{} }
+func (*DesireTasksRequest) ProtoMessage()               {}
+func (*DesireTasksRequest) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{2} }
+
+func (m *DesireTasksRequest) GetTasks() []*DesireTaskRequest {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+type TaskLifecycleResult struct {
+	TaskGuid string `protobuf:"bytes,1,opt,name=task_guid,json=taskGuid" json:"task_guid"`
+	Error    *Error `protobuf:"bytes,2,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *TaskLifecycleResult) Reset()                    { *m = TaskLifecycleResult{} }
+func (*TaskLifecycleResult) ProtoMessage()               {}
+func (*TaskLifecycleResult) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{3} }
+
+func (m *TaskLifecycleResult) GetTaskGuid() string {
+	if m != nil {
+		return m.TaskGuid
+	}
+	return ""
+}
+
+func (m *TaskLifecycleResult) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+type TasksLifecycleResponse struct {
+	Error   *Error                 `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	Results []*TaskLifecycleResult `protobuf:"bytes,2,rep,name=results" json:"results,omitempty"`
+}
+
+func (m *TasksLifecycleResponse) Reset()      { *m = TasksLifecycleResponse{} }
+func (*TasksLifecycleResponse) ProtoMessage() {}
+func (*TasksLifecycleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorTaskRequests, []int{4}
+}
+
+func (m *TasksLifecycleResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *TasksLifecycleResponse) GetResults() []*TaskLifecycleResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
 type StartTaskRequest struct {
 	TaskGuid string `protobuf:"bytes,1,opt,name=task_guid,json=taskGuid" json:"task_guid"`
 	CellId   string `protobuf:"bytes,2,opt,name=cell_id,json=cellId" json:"cell_id"`
@@ -74,7 +137,7 @@ type StartTaskRequest struct {
 
 func (m *StartTaskRequest) Reset()                    { *m = StartTaskRequest{} }
 func (*StartTaskRequest) ProtoMessage()               {}
-func (*StartTaskRequest) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{2} }
+func (*StartTaskRequest) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{5} }
 
 func (m *StartTaskRequest) GetTaskGuid() string {
 	if m != nil {
@@ -97,7 +160,7 @@ type StartTaskResponse struct {
 
 func (m *StartTaskResponse) Reset()                    { *m = StartTaskResponse{} }
 func (*StartTaskResponse) ProtoMessage()               {}
-func (*StartTaskResponse) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{3} }
+func (*StartTaskResponse) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{6} }
 
 func (m *StartTaskResponse) GetError() *Error {
 	if m != nil {
@@ -120,7 +183,7 @@ type FailTaskRequest struct {
 
 func (m *FailTaskRequest) Reset()                    { *m = FailTaskRequest{} }
 func (*FailTaskRequest) ProtoMessage()               {}
-func (*FailTaskRequest) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{4} }
+func (*FailTaskRequest) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{7} }
 
 func (m *FailTaskRequest) GetTaskGuid() string {
 	if m != nil {
@@ -142,7 +205,7 @@ type TaskGuidRequest struct {
 
 func (m *TaskGuidRequest) Reset()                    { *m = TaskGuidRequest{} }
 func (*TaskGuidRequest) ProtoMessage()               {}
-func (*TaskGuidRequest) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{5} }
+func (*TaskGuidRequest) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{8} }
 
 func (m *TaskGuidRequest) GetTaskGuid() string {
 	if m != nil {
@@ -151,6 +214,21 @@ func (m *TaskGuidRequest) GetTaskGuid() string {
 	return ""
 }
 
+type TaskGuidsRequest struct {
+	TaskGuids []string `protobuf:"bytes,1,rep,name=task_guids,json=taskGuids" json:"task_guids,omitempty"`
+}
+
+func (m *TaskGuidsRequest) Reset()                    { *m = TaskGuidsRequest{} }
+func (*TaskGuidsRequest) ProtoMessage()               {}
+func (*TaskGuidsRequest) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{9} }
+
+func (m *TaskGuidsRequest) GetTaskGuids() []string {
+	if m != nil {
+		return m.TaskGuids
+	}
+	return nil
+}
+
 type CompleteTaskRequest struct {
 	TaskGuid      string `protobuf:"bytes,1,opt,name=task_guid,json=taskGuid" json:"task_guid"`
 	CellId        string `protobuf:"bytes,2,opt,name=cell_id,json=cellId" json:"cell_id"`
@@ -161,7 +239,7 @@ type CompleteTaskRequest struct {
 
 func (m *CompleteTaskRequest) Reset()                    { *m = CompleteTaskRequest{} }
 func (*CompleteTaskRequest) ProtoMessage()               {}
-func (*CompleteTaskRequest) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{6} }
+func (*CompleteTaskRequest) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{10} }
 
 func (m *CompleteTaskRequest) GetTaskGuid() string {
 	if m != nil {
@@ -207,9 +285,11 @@ type TaskCallbackResponse struct {
 	CreatedAt     int64  `protobuf:"varint,6,opt,name=created_at,json=createdAt" json:"created_at"`
 }
 
-func (m *TaskCallbackResponse) Reset()                    { *m = TaskCallbackResponse{} }
-func (*TaskCallbackResponse) ProtoMessage()               {}
-func (*TaskCallbackResponse) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{7} }
+func (m *TaskCallbackResponse) Reset()      { *m = TaskCallbackResponse{} }
+func (*TaskCallbackResponse) ProtoMessage() {}
+func (*TaskCallbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorTaskRequests, []int{11}
+}
 
 func (m *TaskCallbackResponse) GetTaskGuid() string {
 	if m != nil {
@@ -259,9 +339,11 @@ type ConvergeTasksRequest struct {
 	ExpireCompletedTaskDuration int64 `protobuf:"varint,3,opt,name=expire_completed_task_duration,json=expireCompletedTaskDuration" json:"expire_completed_task_duration"`
 }
 
-func (m *ConvergeTasksRequest) Reset()                    { *m = ConvergeTasksRequest{} }
-func (*ConvergeTasksRequest) ProtoMessage()               {}
-func (*ConvergeTasksRequest) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{8} }
+func (m *ConvergeTasksRequest) Reset()      { *m = ConvergeTasksRequest{} }
+func (*ConvergeTasksRequest) ProtoMessage() {}
+func (*ConvergeTasksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorTaskRequests, []int{12}
+}
 
 func (m *ConvergeTasksRequest) GetKickTaskDuration() int64 {
 	if m != nil {
@@ -291,7 +373,7 @@ type ConvergeTasksResponse struct {
 func (m *ConvergeTasksResponse) Reset()      { *m = ConvergeTasksResponse{} }
 func (*ConvergeTasksResponse) ProtoMessage() {}
 func (*ConvergeTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptorTaskRequests, []int{9}
+	return fileDescriptorTaskRequests, []int{13}
 }
 
 func (m *ConvergeTasksResponse) GetError() *Error {
@@ -302,13 +384,15 @@ func (m *ConvergeTasksResponse) GetError() *Error {
 }
 
 type TasksRequest struct {
-	Domain string `protobuf:"bytes,1,opt,name=domain" json:"domain"`
-	CellId string `protobuf:"bytes,2,opt,name=cell_id,json=cellId" json:"cell_id"`
+	Domain    string `protobuf:"bytes,1,opt,name=domain" json:"domain"`
+	CellId    string `protobuf:"bytes,2,opt,name=cell_id,json=cellId" json:"cell_id"`
+	PageSize  int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize" json:"page_size"`
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken" json:"page_token"`
 }
 
 func (m *TasksRequest) Reset()                    { *m = TasksRequest{} }
 func (*TasksRequest) ProtoMessage()               {}
-func (*TasksRequest) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{10} }
+func (*TasksRequest) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{14} }
 
 func (m *TasksRequest) GetDomain() string {
 	if m != nil {
@@ -324,14 +408,29 @@ func (m *TasksRequest) GetCellId() string {
 	return ""
 }
 
+func (m *TasksRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *TasksRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
 type TasksResponse struct {
-	Error *Error  `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
-	Tasks []*Task `protobuf:"bytes,2,rep,name=tasks" json:"tasks,omitempty"`
+	Error         *Error  `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	Tasks         []*Task `protobuf:"bytes,2,rep,name=tasks" json:"tasks,omitempty"`
+	NextPageToken string  `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken" json:"next_page_token"`
 }
 
 func (m *TasksResponse) Reset()                    { *m = TasksResponse{} }
 func (*TasksResponse) ProtoMessage()               {}
-func (*TasksResponse) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{11} }
+func (*TasksResponse) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{15} }
 
 func (m *TasksResponse) GetError() *Error {
 	if m != nil {
@@ -347,13 +446,20 @@ func (m *TasksResponse) GetTasks() []*Task {
 	return nil
 }
 
+func (m *TasksResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
 type TaskByGuidRequest struct {
 	TaskGuid string `protobuf:"bytes,1,opt,name=task_guid,json=taskGuid" json:"task_guid"`
 }
 
 func (m *TaskByGuidRequest) Reset()                    { *m = TaskByGuidRequest{} }
 func (*TaskByGuidRequest) ProtoMessage()               {}
-func (*TaskByGuidRequest) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{12} }
+func (*TaskByGuidRequest) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{16} }
 
 func (m *TaskByGuidRequest) GetTaskGuid() string {
 	if m != nil {
@@ -369,7 +475,7 @@ type TaskResponse struct {
 
 func (m *TaskResponse) Reset()                    { *m = TaskResponse{} }
 func (*TaskResponse) ProtoMessage()               {}
-func (*TaskResponse) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{13} }
+func (*TaskResponse) Descriptor() ([]byte, []int) { return fileDescriptorTaskRequests, []int{17} }
 
 func (m *TaskResponse) GetError() *Error {
 	if m != nil {
@@ -388,10 +494,14 @@ func (m *TaskResponse) GetTask() *Task {
 func init() {
 	proto.RegisterType((*TaskLifecycleResponse)(nil), "models.TaskLifecycleResponse")
 	proto.RegisterType((*DesireTaskRequest)(nil), "models.DesireTaskRequest")
+	proto.RegisterType((*DesireTasksRequest)(nil), "models.DesireTasksRequest")
+	proto.RegisterType((*TaskLifecycleResult)(nil), "models.TaskLifecycleResult")
+	proto.RegisterType((*TasksLifecycleResponse)(nil), "models.TasksLifecycleResponse")
 	proto.RegisterType((*StartTaskRequest)(nil), "models.StartTaskRequest")
 	proto.RegisterType((*StartTaskResponse)(nil), "models.StartTaskResponse")
 	proto.RegisterType((*FailTaskRequest)(nil), "models.FailTaskRequest")
 	proto.RegisterType((*TaskGuidRequest)(nil), "models.TaskGuidRequest")
+	proto.RegisterType((*TaskGuidsRequest)(nil), "models.TaskGuidsRequest")
 	proto.RegisterType((*CompleteTaskRequest)(nil), "models.CompleteTaskRequest")
 	proto.RegisterType((*TaskCallbackResponse)(nil), "models.TaskCallbackResponse")
 	proto.RegisterType((*ConvergeTasksRequest)(nil), "models.ConvergeTasksRequest")
@@ -467,6 +577,112 @@ func (this *DesireTaskRequest) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DesireTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*DesireTasksRequest)
+	if !ok {
+		that2, ok := that.(DesireTasksRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if len(this.Tasks) != len(that1.Tasks) {
+		return false
+	}
+	for i := range this.Tasks {
+		if !this.Tasks[i].Equal(that1.Tasks[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *TaskLifecycleResult) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*TaskLifecycleResult)
+	if !ok {
+		that2, ok := that.(TaskLifecycleResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.TaskGuid != that1.TaskGuid {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	return true
+}
+func (this *TasksLifecycleResponse) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*TasksLifecycleResponse)
+	if !ok {
+		that2, ok := that.(TasksLifecycleResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if len(this.Results) != len(that1.Results) {
+		return false
+	}
+	for i := range this.Results {
+		if !this.Results[i].Equal(that1.Results[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *StartTaskRequest) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
@@ -596,6 +812,41 @@ func (this *TaskGuidRequest) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TaskGuidsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*TaskGuidsRequest)
+	if !ok {
+		that2, ok := that.(TaskGuidsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if len(this.TaskGuids) != len(that1.TaskGuids) {
+		return false
+	}
+	for i := range this.TaskGuids {
+		if this.TaskGuids[i] != that1.TaskGuids[i] {
+			return false
+		}
+	}
+	return true
+}
 func (this *CompleteTaskRequest) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
@@ -780,6 +1031,12 @@ func (this *TasksRequest) Equal(that interface{}) bool {
 	if this.CellId != that1.CellId {
 		return false
 	}
+	if this.PageSize != that1.PageSize {
+		return false
+	}
+	if this.PageToken != that1.PageToken {
+		return false
+	}
 	return true
 }
 func (this *TasksResponse) Equal(that interface{}) bool {
@@ -818,6 +1075,9 @@ func (this *TasksResponse) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.NextPageToken != that1.NextPageToken {
+		return false
+	}
 	return true
 }
 func (this *TaskByGuidRequest) Equal(that interface{}) bool {
@@ -909,6 +1169,46 @@ func (this *DesireTaskRequest) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *DesireTasksRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.DesireTasksRequest{")
+	if this.Tasks != nil {
+		s = append(s, "Tasks: "+fmt.Sprintf("%#v", this.Tasks)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TaskLifecycleResult) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.TaskLifecycleResult{")
+	s = append(s, "TaskGuid: "+fmt.Sprintf("%#v", this.TaskGuid)+",\n")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TasksLifecycleResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.TasksLifecycleResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Results != nil {
+		s = append(s, "Results: "+fmt.Sprintf("%#v", this.Results)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *StartTaskRequest) GoString() string {
 	if this == nil {
 		return "nil"
@@ -954,6 +1254,18 @@ func (this *TaskGuidRequest) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *TaskGuidsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.TaskGuidsRequest{")
+	if this.TaskGuids != nil {
+		s = append(s, "TaskGuids: "+fmt.Sprintf("%#v", this.TaskGuids)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *CompleteTaskRequest) GoString() string {
 	if this == nil {
 		return "nil"
@@ -1011,10 +1323,12 @@ func (this *TasksRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 8)
 	s = append(s, "&models.TasksRequest{")
 	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
 	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
+	s = append(s, "PageSize: "+fmt.Sprintf("%#v", this.PageSize)+",\n")
+	s = append(s, "PageToken: "+fmt.Sprintf("%#v", this.PageToken)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1022,7 +1336,7 @@ func (this *TasksResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&models.TasksResponse{")
 	if this.Error != nil {
 		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
@@ -1030,6 +1344,7 @@ func (this *TasksResponse) GoString() string {
 	if this.Tasks != nil {
 		s = append(s, "Tasks: "+fmt.Sprintf("%#v", this.Tasks)+",\n")
 	}
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1130,7 +1445,7 @@ func (m *DesireTaskRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *StartTaskRequest) Marshal() (dAtA []byte, err error) {
+func (m *DesireTasksRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1140,39 +1455,47 @@ func (m *StartTaskRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StartTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DesireTasksRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintTaskRequests(dAtA, i, uint64(len(m.TaskGuid)))
-	i += copy(dAtA[i:], m.TaskGuid)
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintTaskRequests(dAtA, i, uint64(len(m.CellId)))
-	i += copy(dAtA[i:], m.CellId)
-	return i, nil
-}
-
-func (m *StartTaskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if len(m.Tasks) > 0 {
+		for _, msg := range m.Tasks {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintTaskRequests(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *TaskLifecycleResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *StartTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TaskLifecycleResult) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintTaskRequests(dAtA, i, uint64(len(m.TaskGuid)))
+	i += copy(dAtA[i:], m.TaskGuid)
 	if m.Error != nil {
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
 		i++
 		i = encodeVarintTaskRequests(dAtA, i, uint64(m.Error.Size()))
 		n3, err := m.Error.MarshalTo(dAtA[i:])
@@ -1181,6 +1504,100 @@ func (m *StartTaskResponse) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n3
 	}
+	return i, nil
+}
+
+func (m *TasksLifecycleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TasksLifecycleResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTaskRequests(dAtA, i, uint64(m.Error.Size()))
+		n4, err := m.Error.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if len(m.Results) > 0 {
+		for _, msg := range m.Results {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintTaskRequests(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *StartTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintTaskRequests(dAtA, i, uint64(len(m.TaskGuid)))
+	i += copy(dAtA[i:], m.TaskGuid)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintTaskRequests(dAtA, i, uint64(len(m.CellId)))
+	i += copy(dAtA[i:], m.CellId)
+	return i, nil
+}
+
+func (m *StartTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTaskRequests(dAtA, i, uint64(m.Error.Size()))
+		n5, err := m.Error.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
 	dAtA[i] = 0x10
 	i++
 	if m.ShouldStart {
@@ -1240,6 +1657,39 @@ func (m *TaskGuidRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *TaskGuidsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskGuidsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaskGuids) > 0 {
+		for _, s := range m.TaskGuids {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
 func (m *CompleteTaskRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1373,11 +1823,11 @@ func (m *ConvergeTasksResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintTaskRequests(dAtA, i, uint64(m.Error.Size()))
-		n4, err := m.Error.MarshalTo(dAtA[i:])
+		n6, err := m.Error.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n6
 	}
 	return i, nil
 }
@@ -1405,6 +1855,13 @@ func (m *TasksRequest) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	i = encodeVarintTaskRequests(dAtA, i, uint64(len(m.CellId)))
 	i += copy(dAtA[i:], m.CellId)
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintTaskRequests(dAtA, i, uint64(m.PageSize))
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintTaskRequests(dAtA, i, uint64(len(m.PageToken)))
+	i += copy(dAtA[i:], m.PageToken)
 	return i, nil
 }
 
@@ -1427,11 +1884,11 @@ func (m *TasksResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintTaskRequests(dAtA, i, uint64(m.Error.Size()))
-		n5, err := m.Error.MarshalTo(dAtA[i:])
+		n7, err := m.Error.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n7
 	}
 	if len(m.Tasks) > 0 {
 		for _, msg := range m.Tasks {
@@ -1445,6 +1902,10 @@ func (m *TasksResponse) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintTaskRequests(dAtA, i, uint64(len(m.NextPageToken)))
+	i += copy(dAtA[i:], m.NextPageToken)
 	return i, nil
 }
 
@@ -1489,21 +1950,21 @@ func (m *TaskResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintTaskRequests(dAtA, i, uint64(m.Error.Size()))
-		n6, err := m.Error.MarshalTo(dAtA[i:])
+		n8, err := m.Error.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n8
 	}
 	if m.Task != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintTaskRequests(dAtA, i, uint64(m.Task.Size()))
-		n7, err := m.Task.MarshalTo(dAtA[i:])
+		n9, err := m.Task.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n9
 	}
 	return i, nil
 }
@@ -1559,6 +2020,46 @@ func (m *DesireTaskRequest) Size() (n int) {
 	return n
 }
 
+func (m *DesireTasksRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Tasks) > 0 {
+		for _, e := range m.Tasks {
+			l = e.Size()
+			n += 1 + l + sovTaskRequests(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TaskLifecycleResult) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.TaskGuid)
+	n += 1 + l + sovTaskRequests(uint64(l))
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovTaskRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *TasksLifecycleResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovTaskRequests(uint64(l))
+	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTaskRequests(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *StartTaskRequest) Size() (n int) {
 	var l int
 	_ = l
@@ -1598,6 +2099,18 @@ func (m *TaskGuidRequest) Size() (n int) {
 	return n
 }
 
+func (m *TaskGuidsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.TaskGuids) > 0 {
+		for _, s := range m.TaskGuids {
+			l = len(s)
+			n += 1 + l + sovTaskRequests(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *CompleteTaskRequest) Size() (n int) {
 	var l int
 	_ = l
@@ -1655,6 +2168,9 @@ func (m *TasksRequest) Size() (n int) {
 	n += 1 + l + sovTaskRequests(uint64(l))
 	l = len(m.CellId)
 	n += 1 + l + sovTaskRequests(uint64(l))
+	n += 1 + sovTaskRequests(uint64(m.PageSize))
+	l = len(m.PageToken)
+	n += 1 + l + sovTaskRequests(uint64(l))
 	return n
 }
 
@@ -1671,6 +2187,8 @@ func (m *TasksResponse) Size() (n int) {
 			n += 1 + l + sovTaskRequests(uint64(l))
 		}
 	}
+	l = len(m.NextPageToken)
+	n += 1 + l + sovTaskRequests(uint64(l))
 	return n
 }
 
@@ -1731,6 +2249,38 @@ func (this *DesireTaskRequest) String() string {
 	}, "")
 	return s
 }
+func (this *DesireTasksRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DesireTasksRequest{`,
+		`Tasks:` + strings.Replace(fmt.Sprintf("%v", this.Tasks), "DesireTaskRequest", "DesireTaskRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TaskLifecycleResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskLifecycleResult{`,
+		`TaskGuid:` + fmt.Sprintf("%v", this.TaskGuid) + `,`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TasksLifecycleResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TasksLifecycleResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Results:` + strings.Replace(fmt.Sprintf("%v", this.Results), "TaskLifecycleResult", "TaskLifecycleResult", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *StartTaskRequest) String() string {
 	if this == nil {
 		return "nil"
@@ -1774,6 +2324,16 @@ func (this *TaskGuidRequest) String() string {
 	}, "")
 	return s
 }
+func (this *TaskGuidsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskGuidsRequest{`,
+		`TaskGuids:` + fmt.Sprintf("%v", this.TaskGuids) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *CompleteTaskRequest) String() string {
 	if this == nil {
 		return "nil"
@@ -1832,6 +2392,8 @@ func (this *TasksRequest) String() string {
 	s := strings.Join([]string{`&TasksRequest{`,
 		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
 		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
+		`PageSize:` + fmt.Sprintf("%v", this.PageSize) + `,`,
+		`PageToken:` + fmt.Sprintf("%v", this.PageToken) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1843,6 +2405,7 @@ func (this *TasksResponse) String() string {
 	s := strings.Join([]string{`&TasksResponse{`,
 		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
 		`Tasks:` + strings.Replace(fmt.Sprintf("%v", this.Tasks), "Task", "Task", 1) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1861,22 +2424,327 @@ func (this *TaskResponse) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&TaskResponse{`,
-		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
-		`Task:` + strings.Replace(fmt.Sprintf("%v", this.Task), "Task", "Task", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringTaskRequests(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	s := strings.Join([]string{`&TaskResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Task:` + strings.Replace(fmt.Sprintf("%v", this.Task), "Task", "Task", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringTaskRequests(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *TaskLifecycleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskLifecycleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskLifecycleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DesireTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesireTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesireTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskDefinition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskDefinition == nil {
+				m.TaskDefinition = &TaskDefinition{}
+			}
+			if err := m.TaskDefinition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DesireTasksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesireTasksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesireTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tasks = append(m.Tasks, &DesireTaskRequest{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *TaskLifecycleResponse) Unmarshal(dAtA []byte) error {
+func (m *TaskLifecycleResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1899,13 +2767,42 @@ func (m *TaskLifecycleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskLifecycleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskLifecycleResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskLifecycleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskLifecycleResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
@@ -1959,7 +2856,7 @@ func (m *TaskLifecycleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DesireTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *TasksLifecycleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1982,15 +2879,15 @@ func (m *DesireTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DesireTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TasksLifecycleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DesireTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TasksLifecycleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskDefinition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2014,18 +2911,18 @@ func (m *DesireTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskDefinition == nil {
-				m.TaskDefinition = &TaskDefinition{}
+			if m.Error == nil {
+				m.Error = &Error{}
 			}
-			if err := m.TaskDefinition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskGuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTaskRequests
@@ -2035,49 +2932,22 @@ func (m *DesireTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTaskRequests
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskGuid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTaskRequests
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTaskRequests
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Results = append(m.Results, &TaskLifecycleResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2498,6 +3368,85 @@ func (m *TaskGuidRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TaskGuidsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskGuidsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskGuidsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskGuids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskGuids = append(m.TaskGuids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CompleteTaskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3166,6 +4115,54 @@ func (m *TasksRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.CellId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTaskRequests(dAtA[iNdEx:])
@@ -3280,6 +4277,35 @@ func (m *TasksResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTaskRequests(dAtA[iNdEx:])
@@ -3604,48 +4630,56 @@ var (
 func init() { proto.RegisterFile("task_requests.proto", fileDescriptorTaskRequests) }
 
 var fileDescriptorTaskRequests = []byte{
-	// 682 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xac, 0x94, 0x4f, 0x6f, 0x13, 0x3d,
-	0x10, 0xc6, 0xe3, 0x24, 0xcd, 0xdb, 0x4e, 0xfa, 0x77, 0xdb, 0x17, 0x85, 0x52, 0xb6, 0x61, 0x7b,
-	0x20, 0x12, 0x25, 0x95, 0x2a, 0xc4, 0xa9, 0x17, 0xd2, 0x16, 0x54, 0xc1, 0x01, 0x2d, 0x41, 0xe2,
-	0xb6, 0x72, 0x77, 0x9d, 0xd4, 0xca, 0x66, 0x1d, 0x6c, 0x2f, 0xa2, 0x37, 0x2e, 0xdc, 0xf9, 0x18,
-	0x88, 0x4f, 0xc1, 0xb1, 0xc7, 0x1e, 0x39, 0xa0, 0x8a, 0x2e, 0x17, 0xd4, 0x53, 0x3f, 0x02, 0xb2,
-	0xbd, 0x69, 0x36, 0x69, 0x11, 0x89, 0xc4, 0x6d, 0x3d, 0xcf, 0xf8, 0xe7, 0x67, 0xec, 0x9d, 0x81,
-	0x65, 0x89, 0x45, 0xc7, 0xe3, 0xe4, 0x6d, 0x4c, 0x84, 0x14, 0xf5, 0x1e, 0x67, 0x92, 0x59, 0xa5,
-	0x2e, 0x0b, 0x48, 0x28, 0x56, 0x1f, 0xb6, 0xa9, 0x3c, 0x8a, 0x0f, 0xeb, 0x3e, 0xeb, 0x6e, 0xb5,
-	0x59, 0x9b, 0x6d, 0x69, 0xf9, 0x30, 0x6e, 0xe9, 0x95, 0x5e, 0xe8, 0x2f, 0xb3, 0x6d, 0x15, 0x14,
-	0x2b, 0xfd, 0x2e, 0x13, 0xce, 0x19, 0x37, 0x0b, 0x67, 0x07, 0xfe, 0x6f, 0x62, 0xd1, 0x79, 0x41,
-	0x5b, 0xc4, 0x3f, 0xf6, 0x43, 0xe2, 0x12, 0xd1, 0x63, 0x91, 0x20, 0xd6, 0x06, 0x4c, 0xe9, 0xbc,
-	0x0a, 0xaa, 0xa2, 0x5a, 0x79, 0x7b, 0xae, 0x6e, 0x0e, 0xae, 0xef, 0xab, 0xa0, 0x6b, 0x34, 0xe7,
-	0x0b, 0x82, 0xa5, 0x3d, 0x22, 0x28, 0x27, 0x0a, 0xe2, 0x1a, 0xab, 0x56, 0x13, 0x16, 0xb4, 0xf5,
-	0x80, 0xb4, 0x68, 0x44, 0x25, 0x65, 0x51, 0x0a, 0xb9, 0xd5, 0x87, 0xa8, 0xec, 0xbd, 0x2b, 0xb5,
-	0xb1, 0x7c, 0x71, 0xb6, 0x3e, 0xba, 0xc5, 0x9d, 0x97, 0x43, 0x49, 0xd6, 0x3d, 0x98, 0xd1, 0x29,
-	0xed, 0x98, 0x06, 0x95, 0x7c, 0x15, 0xd5, 0x66, 0x1a, 0xc5, 0x93, 0xb3, 0xf5, 0x9c, 0x3b, 0xad,
-	0xc2, 0xcf, 0x62, 0x1a, 0x58, 0x6b, 0x50, 0x0a, 0x58, 0x17, 0xd3, 0xa8, 0x52, 0xc8, 0xe8, 0x69,
-	0xcc, 0x69, 0xc2, 0xe2, 0x2b, 0x89, 0xb9, 0xcc, 0x5a, 0x1d, 0x82, 0xa2, 0x1b, 0xa1, 0x77, 0xe1,
-	0x3f, 0x9f, 0x84, 0xa1, 0x37, 0x72, 0x6a, 0x49, 0x05, 0x0f, 0x02, 0x07, 0xc3, 0x52, 0x86, 0x3a,
-	0xc1, 0xe5, 0x59, 0xf7, 0x61, 0x56, 0x1c, 0xb1, 0x38, 0x0c, 0x3c, 0xa1, 0x00, 0x9a, 0x3e, 0x9d,
-	0xd2, 0xcb, 0x46, 0xd1, 0x64, 0x07, 0xc3, 0xc2, 0x53, 0x4c, 0xc3, 0x09, 0x7d, 0x3f, 0x80, 0xf9,
-	0x16, 0xa6, 0x61, 0xcc, 0x89, 0xc7, 0x09, 0x16, 0x2c, 0x1a, 0xb2, 0x3f, 0x97, 0x6a, 0xae, 0x96,
-	0x9c, 0x47, 0xb0, 0xd0, 0x4c, 0x37, 0x8e, 0x7f, 0x84, 0xf3, 0x15, 0xc1, 0xf2, 0x2e, 0xeb, 0xf6,
-	0x42, 0x22, 0xc9, 0x3f, 0xbd, 0x55, 0xf5, 0x92, 0xca, 0x20, 0x09, 0xf4, 0x4b, 0xf6, 0x6f, 0x25,
-	0x8d, 0xdd, 0x50, 0x5a, 0xf1, 0x8f, 0xa5, 0x29, 0x14, 0x27, 0x22, 0x0e, 0x65, 0x65, 0x2a, 0x7b,
-	0x90, 0x89, 0x39, 0x1f, 0xf3, 0xb0, 0xa2, 0xac, 0xef, 0xe2, 0x30, 0x3c, 0xc4, 0xfe, 0xe0, 0x09,
-	0xc7, 0xa8, 0x61, 0x60, 0x32, 0x3f, 0x96, 0xc9, 0xc2, 0x38, 0x26, 0x8b, 0xd7, 0x4d, 0x5a, 0x3b,
-	0x00, 0x38, 0x8a, 0x98, 0xc4, 0xba, 0x97, 0x4c, 0x19, 0x6b, 0x2a, 0xe3, 0xe2, 0x6c, 0x7d, 0x65,
-	0xa0, 0x6c, 0xb2, 0x2e, 0x95, 0xa4, 0xdb, 0x93, 0xc7, 0x6e, 0x26, 0xdf, 0xda, 0x00, 0xf0, 0x39,
-	0xc1, 0x92, 0x04, 0x1e, 0x96, 0x95, 0x52, 0x15, 0xd5, 0x0a, 0x29, 0x7f, 0x26, 0x8d, 0x3f, 0x91,
-	0xce, 0x77, 0x04, 0x2b, 0xbb, 0x2c, 0x7a, 0x47, 0x78, 0x5b, 0x3f, 0xa5, 0xe8, 0xbf, 0xe5, 0x36,
-	0x58, 0x1d, 0xea, 0x77, 0x3c, 0xd3, 0x9e, 0x31, 0xc7, 0x57, 0xfd, 0xdc, 0xa7, 0x2c, 0x2a, 0x5d,
-	0x77, 0x74, 0xaa, 0x5a, 0xfb, 0xb0, 0x46, 0xde, 0xf7, 0x28, 0x27, 0x5e, 0x8f, 0x44, 0x01, 0x8d,
-	0xda, 0x23, 0xbb, 0xf3, 0x99, 0xdd, 0xb7, 0x4d, 0xe6, 0x4b, 0x93, 0x38, 0x84, 0x39, 0x00, 0x3b,
-	0xc5, 0xf8, 0xe9, 0x4f, 0x16, 0x8c, 0x80, 0x0a, 0x19, 0xd0, 0x1d, 0x93, 0xdb, 0xff, 0x1f, 0x83,
-	0x2c, 0x4a, 0x8d, 0xb9, 0x91, 0xea, 0x26, 0x19, 0x73, 0xcf, 0x61, 0x76, 0xe8, 0x4e, 0x06, 0x73,
-	0x06, 0x5d, 0x9f, 0x33, 0x7f, 0x1b, 0x18, 0x6f, 0x60, 0x6e, 0x72, 0x0b, 0x96, 0x03, 0x53, 0xaa,
-	0x74, 0x51, 0xc9, 0x57, 0x0b, 0xb5, 0xf2, 0xf6, 0x6c, 0x76, 0x92, 0xba, 0x46, 0x72, 0x1e, 0xc3,
-	0x92, 0x5a, 0x36, 0x8e, 0x27, 0x6c, 0xe3, 0xd7, 0xa6, 0xbc, 0xc9, 0x0c, 0x55, 0xa1, 0xa8, 0x00,
-	0xba, 0xc4, 0x51, 0x3f, 0x5a, 0x69, 0x6c, 0x9e, 0x9e, 0xdb, 0xb9, 0x6f, 0xe7, 0x76, 0xee, 0xf2,
-	0xdc, 0x46, 0x1f, 0x12, 0x1b, 0x7d, 0x4e, 0x6c, 0x74, 0x92, 0xd8, 0xe8, 0x34, 0xb1, 0xd1, 0x8f,
-	0xc4, 0x46, 0xbf, 0x12, 0x3b, 0x77, 0x99, 0xd8, 0xe8, 0xd3, 0x4f, 0x3b, 0xf7, 0x3b, 0x00, 0x00,
-	0xff, 0xff, 0x3a, 0x36, 0x69, 0x2d, 0xee, 0x06, 0x00, 0x00,
+	// 816 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xce, 0xe4, 0xd7, 0x6e, 0x5e, 0xda, 0x4d, 0xeb, 0x94, 0x95, 0x77, 0xb7, 0xeb, 0x66, 0xbd,
+	0x07, 0x22, 0x51, 0x52, 0x51, 0x01, 0xa7, 0x5e, 0x48, 0x5b, 0x50, 0x25, 0x0e, 0x95, 0x1b, 0x8e,
+	0xc8, 0x9a, 0xda, 0x93, 0x74, 0x14, 0xc7, 0x13, 0x3c, 0x63, 0xd4, 0xf6, 0xc4, 0x05, 0xce, 0x48,
+	0xfc, 0x13, 0x88, 0xbf, 0x82, 0x63, 0x8f, 0x3d, 0x72, 0x40, 0x15, 0x0d, 0x17, 0xd4, 0x53, 0xff,
+	0x04, 0x34, 0x33, 0x76, 0x62, 0xa7, 0x05, 0x12, 0xb4, 0xb7, 0xf8, 0x7d, 0xdf, 0xfb, 0xe6, 0x7b,
+	0x6f, 0x5e, 0xde, 0x40, 0x53, 0x60, 0x3e, 0x74, 0x23, 0xf2, 0x4d, 0x4c, 0xb8, 0xe0, 0x9d, 0x71,
+	0xc4, 0x04, 0x33, 0xaa, 0x23, 0xe6, 0x93, 0x80, 0xbf, 0xfc, 0x70, 0x40, 0xc5, 0x59, 0x7c, 0xda,
+	0xf1, 0xd8, 0x68, 0x67, 0xc0, 0x06, 0x6c, 0x47, 0xc1, 0xa7, 0x71, 0x5f, 0x7d, 0xa9, 0x0f, 0xf5,
+	0x4b, 0xa7, 0xbd, 0x04, 0xa9, 0x95, 0xfc, 0xae, 0x93, 0x28, 0x62, 0x91, 0xfe, 0xb0, 0xf7, 0xe0,
+	0xbd, 0x1e, 0xe6, 0xc3, 0x2f, 0x69, 0x9f, 0x78, 0x17, 0x5e, 0x40, 0x1c, 0xc2, 0xc7, 0x2c, 0xe4,
+	0xc4, 0x78, 0x0b, 0x15, 0xc5, 0x33, 0x51, 0x0b, 0xb5, 0xeb, 0xbb, 0xab, 0x1d, 0x7d, 0x70, 0xe7,
+	0x50, 0x06, 0x1d, 0x8d, 0xd9, 0xbf, 0x20, 0x58, 0x3f, 0x20, 0x9c, 0x46, 0x44, 0x8a, 0x38, 0xda,
+	0xaa, 0xd1, 0x83, 0x86, 0xb2, 0xee, 0x93, 0x3e, 0x0d, 0xa9, 0xa0, 0x2c, 0x4c, 0x44, 0x9e, 0xa7,
+	0x22, 0x92, 0x7d, 0x30, 0x45, 0xbb, 0xcd, 0xbb, 0x9b, 0xad, 0xf9, 0x14, 0xe7, 0x99, 0xc8, 0x91,
+	0x8c, 0x37, 0x50, 0x53, 0x94, 0x41, 0x4c, 0x7d, 0xb3, 0xd8, 0x42, 0xed, 0x5a, 0xb7, 0x7c, 0x75,
+	0xb3, 0x55, 0x70, 0x9e, 0xca, 0xf0, 0x17, 0x31, 0xf5, 0x8d, 0x4d, 0xa8, 0xfa, 0x6c, 0x84, 0x69,
+	0x68, 0x96, 0x32, 0x78, 0x12, 0xb3, 0x0f, 0xc1, 0x98, 0x79, 0xe5, 0xa9, 0xd9, 0x1d, 0xa8, 0xc8,
+	0x7c, 0x6e, 0xa2, 0x56, 0xa9, 0x5d, 0xdf, 0x7d, 0x91, 0x5a, 0x7c, 0x50, 0x96, 0xa3, 0x79, 0xf6,
+	0xd7, 0xd0, 0x9c, 0xef, 0x58, 0x1c, 0x88, 0xbc, 0x3d, 0xf4, 0xa8, 0xbd, 0x69, 0x4b, 0x8b, 0xff,
+	0xd2, 0x52, 0x01, 0xcf, 0x95, 0xbf, 0xff, 0x77, 0x23, 0xc6, 0x27, 0xf0, 0x24, 0x52, 0x86, 0xb8,
+	0x59, 0x54, 0x05, 0xbd, 0xca, 0xf6, 0x7c, 0xce, 0xb4, 0x93, 0x72, 0xed, 0x1e, 0xac, 0x9d, 0x08,
+	0x1c, 0x89, 0xec, 0x35, 0x2e, 0x50, 0xd1, 0x6b, 0x78, 0xe2, 0x91, 0x20, 0x70, 0xe7, 0x6e, 0xa4,
+	0x2a, 0x83, 0x47, 0xbe, 0x8d, 0x61, 0x3d, 0xa3, 0xba, 0x4c, 0x19, 0xef, 0xc3, 0x0a, 0x3f, 0x63,
+	0x71, 0xe0, 0xbb, 0x5c, 0x0a, 0x28, 0xf5, 0xa7, 0x89, 0x7a, 0x5d, 0x23, 0x4a, 0xd9, 0xc6, 0xd0,
+	0xf8, 0x1c, 0xd3, 0x60, 0x49, 0xdf, 0x1f, 0xc0, 0xb3, 0x3e, 0xa6, 0x41, 0x1c, 0x11, 0x37, 0x22,
+	0x98, 0xb3, 0x30, 0x67, 0x7f, 0x35, 0xc1, 0x1c, 0x05, 0xd9, 0x1f, 0x43, 0xa3, 0x97, 0x24, 0x2e,
+	0x7e, 0x84, 0xfd, 0x11, 0xac, 0xa5, 0x59, 0xd3, 0x59, 0x7b, 0x0d, 0x30, 0x4d, 0xd3, 0x03, 0x57,
+	0x73, 0x6a, 0x69, 0x06, 0xb7, 0x7f, 0x45, 0xd0, 0xdc, 0x67, 0xa3, 0x71, 0x40, 0x04, 0x79, 0xa7,
+	0x17, 0x21, 0xff, 0x18, 0xb2, 0x26, 0xe2, 0x9b, 0xa5, 0x4c, 0x23, 0x93, 0xd8, 0x23, 0xdd, 0x28,
+	0xff, 0x63, 0x37, 0xa4, 0x94, 0x1e, 0x1a, 0xb3, 0x92, 0x3d, 0x48, 0xc7, 0xec, 0xef, 0x8b, 0xb0,
+	0x21, 0xad, 0xef, 0xe3, 0x20, 0x38, 0xc5, 0xde, 0xec, 0xd6, 0x17, 0xa8, 0x61, 0x66, 0xb2, 0xb8,
+	0x90, 0xc9, 0xd2, 0x22, 0x26, 0xcb, 0x0f, 0x4d, 0x1a, 0x7b, 0x00, 0x38, 0x0c, 0x99, 0xc0, 0x6a,
+	0x35, 0xe9, 0x32, 0x36, 0x25, 0xe3, 0xee, 0x66, 0x6b, 0x63, 0x86, 0x6c, 0xb3, 0x11, 0x15, 0x64,
+	0x34, 0x16, 0x17, 0x4e, 0x86, 0x6f, 0xbc, 0x05, 0xf0, 0x22, 0x82, 0x05, 0xf1, 0x5d, 0x2c, 0xcc,
+	0x6a, 0x0b, 0xb5, 0x4b, 0x89, 0x7e, 0x2d, 0x89, 0x7f, 0x26, 0xec, 0xdf, 0x11, 0x6c, 0xec, 0xb3,
+	0xf0, 0x5b, 0x12, 0x0d, 0xf2, 0xeb, 0x66, 0x17, 0x8c, 0x21, 0xf5, 0x86, 0xae, 0xde, 0x76, 0x71,
+	0x84, 0xa7, 0xeb, 0x31, 0x55, 0x59, 0x93, 0xb8, 0x5a, 0x90, 0x09, 0x6a, 0x1c, 0xc2, 0x26, 0x39,
+	0x1f, 0xd3, 0x88, 0xb8, 0x63, 0x12, 0xfa, 0x34, 0x1c, 0xcc, 0x65, 0x17, 0x33, 0xd9, 0x2f, 0x34,
+	0xf3, 0x58, 0x13, 0x73, 0x32, 0x47, 0x60, 0x25, 0x32, 0x5e, 0x32, 0x64, 0xfe, 0x9c, 0x50, 0x29,
+	0x23, 0xf4, 0x4a, 0x73, 0xd3, 0x79, 0xf4, 0xb3, 0x52, 0xf2, 0xd5, 0x98, 0xab, 0x6e, 0x99, 0x57,
+	0xe3, 0x27, 0x04, 0x2b, 0xb9, 0xa6, 0xcc, 0xf6, 0x36, 0x7a, 0xb8, 0xb7, 0xff, 0x6b, 0xb6, 0xdf,
+	0x40, 0x6d, 0x8c, 0x07, 0xc4, 0xe5, 0xf4, 0x92, 0xa8, 0x0a, 0x2a, 0xe9, 0x64, 0xc9, 0xf0, 0x09,
+	0xbd, 0x94, 0xae, 0x40, 0x51, 0x04, 0x1b, 0x92, 0xfc, 0x70, 0xab, 0xd4, 0x9e, 0x0c, 0xdb, 0x3f,
+	0x20, 0x58, 0x5d, 0xbe, 0x18, 0xc3, 0x4e, 0xdf, 0x0f, 0xbd, 0x6e, 0x57, 0xb2, 0xeb, 0x36, 0x79,
+	0x32, 0x8c, 0x6d, 0x68, 0x84, 0xe4, 0x5c, 0xb8, 0x19, 0x13, 0xb9, 0xe1, 0x95, 0xe0, 0xf1, 0xd4,
+	0xc8, 0xa7, 0xb0, 0x2e, 0x93, 0xbb, 0x17, 0x4b, 0x6e, 0x9c, 0xaf, 0x74, 0x57, 0x97, 0xb3, 0xdf,
+	0x82, 0xb2, 0x14, 0x48, 0x9e, 0xa4, 0xbc, 0x7b, 0x85, 0x74, 0xb7, 0xaf, 0x6f, 0xad, 0xc2, 0x6f,
+	0xb7, 0x56, 0xe1, 0xfe, 0xd6, 0x42, 0xdf, 0x4d, 0x2c, 0xf4, 0xf3, 0xc4, 0x42, 0x57, 0x13, 0x0b,
+	0x5d, 0x4f, 0x2c, 0xf4, 0xc7, 0xc4, 0x42, 0x7f, 0x4d, 0xac, 0xc2, 0xfd, 0xc4, 0x42, 0x3f, 0xfe,
+	0x69, 0x15, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x41, 0xfc, 0x9e, 0xe3, 0xb5, 0x08, 0x00, 0x00,
 }
@@ -8,6 +8,63 @@ import (
 )
 
 var _ = Describe("Task requests", func() {
+	Describe("TasksRequest", func() {
+		Describe("Validate", func() {
+			var request models.TasksRequest
+
+			BeforeEach(func() {
+				request = models.TasksRequest{
+					PageSize:  10,
+					PageToken: models.TaskFilter{PageSize: 1}.NextPageToken("some-guid"),
+				}
+			})
+
+			Context("when valid", func() {
+				It("returns nil", func() {
+					Expect(request.Validate()).To(BeNil())
+				})
+			})
+
+			Context("when the PageSize is negative", func() {
+				BeforeEach(func() {
+					request.PageSize = -1
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"page_size"}))
+				})
+			})
+
+			Context("when the PageToken is malformed", func() {
+				BeforeEach(func() {
+					request.PageToken = "garbage"
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"page_token"}))
+				})
+			})
+		})
+
+		Describe("Filter", func() {
+			It("resumes after the task in the page token", func() {
+				request := models.TasksRequest{
+					Domain:    "some-domain",
+					CellId:    "some-cell",
+					PageSize:  10,
+					PageToken: models.TaskFilter{PageSize: 1}.NextPageToken("some-guid"),
+				}
+
+				Expect(request.Filter()).To(Equal(models.TaskFilter{
+					Domain:        "some-domain",
+					CellID:        "some-cell",
+					PageSize:      10,
+					AfterTaskGuid: "some-guid",
+				}))
+			})
+		})
+	})
+
 	Describe("TaskByGuidRequest", func() {
 		Describe("Validate", func() {
 			var request models.TaskByGuidRequest
@@ -96,6 +153,40 @@ var _ = Describe("Task requests", func() {
 		})
 	})
 
+	Describe("DesireTasksRequest", func() {
+		Describe("Validate", func() {
+			It("is valid with at least one task", func() {
+				request := models.DesireTasksRequest{
+					Tasks: []*models.DesireTaskRequest{{
+						TaskGuid:       "t-guid",
+						Domain:         "domain",
+						TaskDefinition: model_helpers.NewValidTaskDefinition(),
+					}},
+				}
+				Expect(request.Validate()).To(BeNil())
+			})
+
+			It("returns a validation error when there are no tasks", func() {
+				request := models.DesireTasksRequest{}
+				Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"tasks"}))
+			})
+		})
+	})
+
+	Describe("TaskGuidsRequest", func() {
+		Describe("Validate", func() {
+			It("is valid with at least one task guid", func() {
+				request := models.TaskGuidsRequest{TaskGuids: []string{"t-guid"}}
+				Expect(request.Validate()).To(BeNil())
+			})
+
+			It("returns a validation error when there are no task guids", func() {
+				request := models.TaskGuidsRequest{}
+				Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"task_guids"}))
+			})
+		})
+	})
+
 	Describe("CompleteTaskRequest", func() {
 		Describe("Validate", func() {
 			var request models.CompleteTaskRequest
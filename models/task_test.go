@@ -305,6 +305,101 @@ var _ = Describe("Task", func() {
 		})
 	})
 
+	Describe("FailAttempt", func() {
+		BeforeEach(func() {
+			task = models.Task{
+				TaskGuid: "some-guid",
+				Domain:   "some-domain",
+				State:    models.Task_Running,
+				CellId:   "some-cell",
+				TaskDefinition: &models.TaskDefinition{
+					RootFs: "some:rootfs",
+					Action: models.WrapAction(&models.RunAction{Path: "ls", User: "me"}),
+				},
+			}
+		})
+
+		Context("when the task has no retry policy", func() {
+			It("does not retry or record the failure", func() {
+				Expect(task.FailAttempt(models.TaskFailureClass_ExitFailure, "boom", 10)).To(BeFalse())
+				Expect(task.State).To(Equal(models.Task_Running))
+				Expect(task.FailureHistory).To(BeEmpty())
+			})
+		})
+
+		Context("when the task has a retry policy", func() {
+			BeforeEach(func() {
+				task.RetryPolicy = &models.TaskRetryPolicy{
+					MaxAttempts:       2,
+					RetryableFailures: []models.TaskFailureClass{models.TaskFailureClass_CellDisappeared},
+				}
+			})
+
+			It("returns the task to pending and records the failed attempt", func() {
+				Expect(task.FailAttempt(models.TaskFailureClass_CellDisappeared, "cell went away", 10)).To(BeTrue())
+				Expect(task.State).To(Equal(models.Task_Pending))
+				Expect(task.CellId).To(BeEmpty())
+				Expect(task.UpdatedAt).To(BeEquivalentTo(10))
+				Expect(task.RetryCount).To(BeEquivalentTo(1))
+				Expect(task.FailureHistory).To(Equal([]*models.TaskAttemptFailure{{
+					Attempt:       1,
+					FailureClass:  models.TaskFailureClass_CellDisappeared,
+					FailureReason: "cell went away",
+					CellId:        "some-cell",
+					FailedAt:      10,
+				}}))
+			})
+
+			It("does not retry failures that are not retryable", func() {
+				Expect(task.FailAttempt(models.TaskFailureClass_ExitFailure, "boom", 10)).To(BeFalse())
+				Expect(task.State).To(Equal(models.Task_Running))
+				Expect(task.RetryCount).To(BeEquivalentTo(0))
+				Expect(task.FailureHistory).To(HaveLen(1))
+			})
+
+			It("does not retry once the attempts are exhausted", func() {
+				task.RetryCount = 1
+				Expect(task.FailAttempt(models.TaskFailureClass_CellDisappeared, "cell went away", 10)).To(BeFalse())
+				Expect(task.State).To(Equal(models.Task_Running))
+				Expect(task.FailureHistory).To(HaveLen(1))
+				Expect(task.FailureHistory[0].Attempt).To(BeEquivalentTo(2))
+			})
+
+			Context("when no retryable failures are listed", func() {
+				BeforeEach(func() {
+					task.RetryPolicy.RetryableFailures = nil
+				})
+
+				It("retries every class of failure", func() {
+					Expect(task.FailAttempt(models.TaskFailureClass_PlacementFailure, "no room", 10)).To(BeTrue())
+				})
+			})
+		})
+	})
+
+	Describe("InRetryBackoff", func() {
+		BeforeEach(func() {
+			task = models.Task{
+				State:      models.Task_Pending,
+				UpdatedAt:  int64(10 * time.Second),
+				RetryCount: 1,
+				TaskDefinition: &models.TaskDefinition{
+					RetryPolicy: &models.TaskRetryPolicy{MaxAttempts: 3, BackoffMs: 5000},
+				},
+			}
+		})
+
+		It("is true until the backoff has elapsed", func() {
+			Expect(task.InRetryBackoff(int64(14 * time.Second))).To(BeTrue())
+			Expect(task.InRetryBackoff(int64(15 * time.Second))).To(BeFalse())
+		})
+
+		It("is false for tasks that have not been retried", func() {
+			task.RetryCount = 0
+			Expect(task.InRetryBackoff(int64(14 * time.Second))).To(BeFalse())
+		})
+	})
+
 	Describe("Validate", func() {
 		Context("when the task has a domain, valid guid, stack, and valid action", func() {
 			It("is valid", func() {
@@ -494,6 +589,39 @@ var _ = Describe("Task", func() {
 					},
 				},
 			},
+			{
+				"retry_policy",
+				&models.Task{
+					Domain:   "some-domain",
+					TaskGuid: "task-guid",
+					TaskDefinition: &models.TaskDefinition{
+						RootFs: "some:rootfs",
+						Action: models.WrapAction(&models.RunAction{
+							Path: "ls",
+							User: "me",
+						}),
+						RetryPolicy: &models.TaskRetryPolicy{MaxAttempts: -1},
+					},
+				},
+			},
+			{
+				"retryable_failures",
+				&models.Task{
+					Domain:   "some-domain",
+					TaskGuid: "task-guid",
+					TaskDefinition: &models.TaskDefinition{
+						RootFs: "some:rootfs",
+						Action: models.WrapAction(&models.RunAction{
+							Path: "ls",
+							User: "me",
+						}),
+						RetryPolicy: &models.TaskRetryPolicy{
+							MaxAttempts:       2,
+							RetryableFailures: []models.TaskFailureClass{models.TaskFailureClass_UnclassifiedFailure},
+						},
+					},
+				},
+			},
 			{
 				"max_pids",
 				&models.Task{
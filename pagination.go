@@ -0,0 +1,163 @@
+package bbs
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+// TaskIterator fetches the Tasks matching a filter one page at a time.
+type TaskIterator interface {
+	// Returns true once the last page has been fetched
+	Done() bool
+
+	// Fetches the next page of Tasks
+	Next() ([]*models.Task, error)
+}
+
+// DesiredLRPIterator fetches the DesiredLRPs matching a filter one page at a time.
+type DesiredLRPIterator interface {
+	// Returns true once the last page has been fetched
+	Done() bool
+
+	// Fetches the next page of DesiredLRPs
+	Next() ([]*models.DesiredLRP, error)
+}
+
+// ActualLRPGroupIterator fetches the ActualLRPGroups matching a filter one
+// page at a time.
+type ActualLRPGroupIterator interface {
+	// Returns true once the last page has been fetched
+	Done() bool
+
+	// Fetches the next page of ActualLRPGroups
+	Next() ([]*models.ActualLRPGroup, error)
+}
+
+type taskIterator struct {
+	client  *client
+	logger  lager.Logger
+	request models.TasksRequest
+	done    bool
+}
+
+func (c *client) TaskPages(logger lager.Logger, filter models.TaskFilter) TaskIterator {
+	return &taskIterator{
+		client: c,
+		logger: logger,
+		request: models.TasksRequest{
+			Domain:   filter.Domain,
+			CellId:   filter.CellID,
+			PageSize: int32(filter.PageSize),
+		},
+	}
+}
+
+func (i *taskIterator) Done() bool {
+	return i.done
+}
+
+func (i *taskIterator) Next() ([]*models.Task, error) {
+	if i.done {
+		return []*models.Task{}, nil
+	}
+
+	response := models.TasksResponse{}
+	err := i.client.doRequest(i.logger, TasksRoute, nil, nil, &i.request, &response)
+	if err != nil {
+		return nil, err
+	}
+	err = response.Error.ToError()
+	if err != nil {
+		return nil, err
+	}
+
+	i.request.PageToken = response.NextPageToken
+	i.done = response.NextPageToken == ""
+	return response.Tasks, nil
+}
+
+type desiredLRPIterator struct {
+	client  *client
+	logger  lager.Logger
+	request models.DesiredLRPsRequest
+	done    bool
+}
+
+func (c *client) DesiredLRPPages(logger lager.Logger, filter models.DesiredLRPFilter) DesiredLRPIterator {
+	return &desiredLRPIterator{
+		client: c,
+		logger: logger,
+		request: models.DesiredLRPsRequest{
+			Domain:       filter.Domain,
+			ProcessGuids: filter.ProcessGuids,
+			PageSize:     int32(filter.PageSize),
+		},
+	}
+}
+
+func (i *desiredLRPIterator) Done() bool {
+	return i.done
+}
+
+func (i *desiredLRPIterator) Next() ([]*models.DesiredLRP, error) {
+	if i.done {
+		return []*models.DesiredLRP{}, nil
+	}
+
+	response := models.DesiredLRPsResponse{}
+	err := i.client.doRequest(i.logger, DesiredLRPsRoute, nil, nil, &i.request, &response)
+	if err != nil {
+		return nil, err
+	}
+	err = response.Error.ToError()
+	if err != nil {
+		return nil, err
+	}
+
+	i.request.PageToken = response.NextPageToken
+	i.done = response.NextPageToken == ""
+	return response.DesiredLrps, nil
+}
+
+type actualLRPGroupIterator struct {
+	client  *client
+	logger  lager.Logger
+	request models.ActualLRPGroupsRequest
+	done    bool
+}
+
+func (c *client) ActualLRPGroupPages(logger lager.Logger, filter models.ActualLRPFilter) ActualLRPGroupIterator {
+	return &actualLRPGroupIterator{
+		client: c,
+		logger: logger,
+		request: models.ActualLRPGroupsRequest{
+			Domain:   filter.Domain,
+			CellId:   filter.CellID,
+			PageSize: int32(filter.PageSize),
+		},
+	}
+}
+
+func (i *actualLRPGroupIterator) Done() bool {
+	return i.done
+}
+
+func (i *actualLRPGroupIterator) Next() ([]*models.ActualLRPGroup, error) {
+	if i.done {
+		return []*models.ActualLRPGroup{}, nil
+	}
+
+	response := models.ActualLRPGroupsResponse{}
+	err := i.client.doRequest(i.logger, ActualLRPGroupsRoute, nil, nil, &i.request, &response)
+	if err != nil {
+		return nil, err
+	}
+	err = response.Error.ToError()
+	if err != nil {
+		return nil, err
+	}
+
+	i.request.PageToken = response.NextPageToken
+	i.done = response.NextPageToken == ""
+	return response.ActualLrpGroups, nil
+}
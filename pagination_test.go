@@ -0,0 +1,225 @@
+package bbs_test
+
+import (
+	"io/ioutil"
+	"net/http"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/models"
+	"github.com/gogo/protobuf/proto"
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Pagination", func() {
+	var (
+		fakeServer *ghttp.Server
+		client     bbs.Client
+	)
+
+	verifyProtoRequest := func(expected, actual proto.Message) http.HandlerFunc {
+		return func(w http.ResponseWriter, req *http.Request) {
+			body, err := ioutil.ReadAll(req.Body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(proto.Unmarshal(body, actual)).To(Succeed())
+			Expect(actual).To(Equal(expected))
+		}
+	}
+
+	respondWithProto := func(message proto.Message) http.HandlerFunc {
+		body, err := proto.Marshal(message)
+		Expect(err).NotTo(HaveOccurred())
+		return ghttp.RespondWith(http.StatusOK, body, http.Header{"Content-Type": []string{bbs.ProtoContentType}})
+	}
+
+	BeforeEach(func() {
+		fakeServer = ghttp.NewServer()
+		client = bbs.NewClient(fakeServer.URL())
+	})
+
+	AfterEach(func() {
+		fakeServer.Close()
+	})
+
+	Describe("TaskPages", func() {
+		var iterator bbs.TaskIterator
+
+		BeforeEach(func() {
+			iterator = client.TaskPages(logger, models.TaskFilter{Domain: "some-domain", CellID: "some-cell", PageSize: 2})
+		})
+
+		Context("when the server returns a token for the next page", func() {
+			BeforeEach(func() {
+				fakeServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/v1/tasks/list.r2"),
+						verifyProtoRequest(&models.TasksRequest{
+							Domain:   "some-domain",
+							CellId:   "some-cell",
+							PageSize: 2,
+						}, &models.TasksRequest{}),
+						respondWithProto(&models.TasksResponse{
+							Tasks:         []*models.Task{{TaskGuid: "task-guid-1"}, {TaskGuid: "task-guid-2"}},
+							NextPageToken: "some-token",
+						}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/v1/tasks/list.r2"),
+						verifyProtoRequest(&models.TasksRequest{
+							Domain:    "some-domain",
+							CellId:    "some-cell",
+							PageSize:  2,
+							PageToken: "some-token",
+						}, &models.TasksRequest{}),
+						respondWithProto(&models.TasksResponse{
+							Tasks: []*models.Task{{TaskGuid: "task-guid-3"}},
+						}),
+					),
+				)
+			})
+
+			It("fetches pages with the token until the server returns none", func() {
+				Expect(iterator.Done()).To(BeFalse())
+
+				tasks, err := iterator.Next()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tasks).To(Equal([]*models.Task{{TaskGuid: "task-guid-1"}, {TaskGuid: "task-guid-2"}}))
+				Expect(iterator.Done()).To(BeFalse())
+
+				tasks, err = iterator.Next()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tasks).To(Equal([]*models.Task{{TaskGuid: "task-guid-3"}}))
+				Expect(iterator.Done()).To(BeTrue())
+
+				tasks, err = iterator.Next()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tasks).To(BeEmpty())
+				Expect(fakeServer.ReceivedRequests()).To(HaveLen(2))
+			})
+		})
+
+		Context("when the server returns a short page with a token", func() {
+			BeforeEach(func() {
+				fakeServer.AppendHandlers(
+					respondWithProto(&models.TasksResponse{NextPageToken: "some-token"}),
+					respondWithProto(&models.TasksResponse{}),
+				)
+			})
+
+			It("keeps fetching pages", func() {
+				tasks, err := iterator.Next()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tasks).To(BeEmpty())
+				Expect(iterator.Done()).To(BeFalse())
+
+				_, err = iterator.Next()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(iterator.Done()).To(BeTrue())
+			})
+		})
+
+		Context("when the server returns an error", func() {
+			BeforeEach(func() {
+				fakeServer.AppendHandlers(
+					respondWithProto(&models.TasksResponse{Error: models.ErrUnknownError}),
+				)
+			})
+
+			It("returns the error without finishing", func() {
+				_, err := iterator.Next()
+				Expect(err).To(Equal(models.ErrUnknownError))
+				Expect(iterator.Done()).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("DesiredLRPPages", func() {
+		var iterator bbs.DesiredLRPIterator
+
+		BeforeEach(func() {
+			iterator = client.DesiredLRPPages(logger, models.DesiredLRPFilter{Domain: "some-domain", PageSize: 2})
+
+			fakeServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/desired_lrps/list.r2"),
+					verifyProtoRequest(&models.DesiredLRPsRequest{
+						Domain:   "some-domain",
+						PageSize: 2,
+					}, &models.DesiredLRPsRequest{}),
+					respondWithProto(&models.DesiredLRPsResponse{
+						DesiredLrps:   []*models.DesiredLRP{{ProcessGuid: "process-guid-1"}},
+						NextPageToken: "some-token",
+					}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/desired_lrps/list.r2"),
+					verifyProtoRequest(&models.DesiredLRPsRequest{
+						Domain:    "some-domain",
+						PageSize:  2,
+						PageToken: "some-token",
+					}, &models.DesiredLRPsRequest{}),
+					respondWithProto(&models.DesiredLRPsResponse{
+						DesiredLrps: []*models.DesiredLRP{{ProcessGuid: "process-guid-2"}},
+					}),
+				),
+			)
+		})
+
+		It("fetches pages with the token until the server returns none", func() {
+			desiredLRPs, err := iterator.Next()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(desiredLRPs).To(Equal([]*models.DesiredLRP{{ProcessGuid: "process-guid-1"}}))
+			Expect(iterator.Done()).To(BeFalse())
+
+			desiredLRPs, err = iterator.Next()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(desiredLRPs).To(Equal([]*models.DesiredLRP{{ProcessGuid: "process-guid-2"}}))
+			Expect(iterator.Done()).To(BeTrue())
+		})
+	})
+
+	Describe("ActualLRPGroupPages", func() {
+		var iterator bbs.ActualLRPGroupIterator
+
+		BeforeEach(func() {
+			iterator = client.ActualLRPGroupPages(logger, models.ActualLRPFilter{CellID: "some-cell", PageSize: 2})
+
+			fakeServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/actual_lrp_groups/list"),
+					verifyProtoRequest(&models.ActualLRPGroupsRequest{
+						CellId:   "some-cell",
+						PageSize: 2,
+					}, &models.ActualLRPGroupsRequest{}),
+					respondWithProto(&models.ActualLRPGroupsResponse{
+						ActualLrpGroups: []*models.ActualLRPGroup{{Instance: &models.ActualLRP{State: models.ActualLRPStateRunning}}},
+						NextPageToken:   "some-token",
+					}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/actual_lrp_groups/list"),
+					verifyProtoRequest(&models.ActualLRPGroupsRequest{
+						CellId:    "some-cell",
+						PageSize:  2,
+						PageToken: "some-token",
+					}, &models.ActualLRPGroupsRequest{}),
+					respondWithProto(&models.ActualLRPGroupsResponse{}),
+				),
+			)
+		})
+
+		It("fetches pages with the token until the server returns none", func() {
+			groups, err := iterator.Next()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(groups).To(HaveLen(1))
+			Expect(iterator.Done()).To(BeFalse())
+
+			groups, err = iterator.Next()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(groups).To(BeEmpty())
+			Expect(iterator.Done()).To(BeTrue())
+		})
+	})
+})
@@ -40,10 +40,22 @@ const (
 	DesiredLRPsRoute_r0             = "DesiredLRPs"             // Deprecated
 	DesiredLRPByProcessGuidRoute_r0 = "DesiredLRPByProcessGuid" // Deprecated
 
+	DesiredLRPRevisionsRoute = "DesiredLRPRevisions"
+	DesiredLRPRevisionRoute  = "DesiredLRPRevision"
+
+	DesiredLRPRolloutRoute = "DesiredLRPRollout"
+
 	// Desire LRP Lifecycle
-	DesireDesiredLRPRoute = "DesireDesiredLRP_r2"
-	UpdateDesiredLRPRoute = "UpdateDesireLRP"
-	RemoveDesiredLRPRoute = "RemoveDesiredLRP"
+	DesireDesiredLRPRoute   = "DesireDesiredLRP_r2"
+	UpdateDesiredLRPRoute   = "UpdateDesireLRP"
+	RemoveDesiredLRPRoute   = "RemoveDesiredLRP"
+	RedeployDesiredLRPRoute = "RedeployDesiredLRP"
+
+	DesireDesiredLRPsRoute = "DesireDesiredLRPs"
+	RemoveDesiredLRPsRoute = "RemoveDesiredLRPs"
+	ApplyDesiredLRPsRoute  = "ApplyDesiredLRPs"
+
+	RollbackDesiredLRPRoute = "RollbackDesiredLRP"
 
 	DesireDesiredLRPRoute_r1 = "DesireDesiredLRP_r1"
 	DesireDesiredLRPRoute_r0 = "DesireDesiredLRP"
@@ -58,6 +70,9 @@ const (
 	CompleteTaskRoute  = "CompleteTask"
 	ResolvingTaskRoute = "ResolvingTask"
 	DeleteTaskRoute    = "DeleteTask"
+	DesireTasksRoute   = "DesireTasks"
+	CancelTasksRoute   = "CancelTasks"
+	DeleteTasksRoute   = "DeleteTasks"
 
 	TasksRoute_r1      = "Tasks_r1"      // Deprecated
 	TaskByGuidRoute_r1 = "TaskByGuid_r1" // Deprecated
@@ -68,11 +83,25 @@ const (
 	TaskByGuidRoute_r0 = "TaskByGuid"    // Deprecated
 
 	// Event Streaming
-	EventStreamRoute_r0 = "EventStream_r0"
+	EventStreamRoute_r0     = "EventStream_r0"
+	TaskEventStreamRoute_r0 = "TaskEventStream_r0"
 
 	// Cell Presence
 	CellsRoute    = "Cells_r2"
 	CellsRoute_r1 = "Cells_r1"
+
+	// Audit Log
+	AuditEventsRoute = "AuditEvents"
+
+	// Quarantine
+	QuarantinedRecordsRoute        = "QuarantinedRecords"
+	QuarantinedRecordRoute         = "QuarantinedRecord"
+	RestoreQuarantinedRecordRoute  = "RestoreQuarantinedRecord"
+	RestoreQuarantinedRecordsRoute = "RestoreQuarantinedRecords"
+
+	// Encryption
+	EncryptionProgressRoute         = "EncryptionProgress"
+	VerifyEncryptionKeyRetiredRoute = "VerifyEncryptionKeyRetired"
 )
 
 var Routes = rata.Routes{
@@ -114,11 +143,21 @@ var Routes = rata.Routes{
 	{Path: "/v1/desired_lrps/list", Method: "POST", Name: DesiredLRPsRoute_r0},                               // Deprecated
 	{Path: "/v1/desired_lrps/get_by_process_guid", Method: "POST", Name: DesiredLRPByProcessGuidRoute_r0},    // Deprecated
 
+	{Path: "/v1/desired_lrp_revisions/list", Method: "POST", Name: DesiredLRPRevisionsRoute},
+	{Path: "/v1/desired_lrp_revisions/get", Method: "POST", Name: DesiredLRPRevisionRoute},
+
+	{Path: "/v1/desired_lrp_rollouts/get", Method: "POST", Name: DesiredLRPRolloutRoute},
+
 	// Desire LPR Lifecycle
 	{Path: "/v1/desired_lrp/desire.r2", Method: "POST", Name: DesireDesiredLRPRoute},
 	{Path: "/v1/desired_lrp/desire.r1", Method: "POST", Name: DesireDesiredLRPRoute_r1}, // Deprecated
 	{Path: "/v1/desired_lrp/update", Method: "POST", Name: UpdateDesiredLRPRoute},
 	{Path: "/v1/desired_lrp/remove", Method: "POST", Name: RemoveDesiredLRPRoute},
+	{Path: "/v1/desired_lrp/redeploy", Method: "POST", Name: RedeployDesiredLRPRoute},
+	{Path: "/v1/desired_lrp/bulk_desire", Method: "POST", Name: DesireDesiredLRPsRoute},
+	{Path: "/v1/desired_lrp/bulk_remove", Method: "POST", Name: RemoveDesiredLRPsRoute},
+	{Path: "/v1/desired_lrp/apply", Method: "POST", Name: ApplyDesiredLRPsRoute},
+	{Path: "/v1/desired_lrp/rollback", Method: "POST", Name: RollbackDesiredLRPRoute},
 	{Path: "/v1/desired_lrp/desire", Method: "POST", Name: DesireDesiredLRPRoute_r0}, // Deprecated
 
 	// Tasks
@@ -139,13 +178,30 @@ var Routes = rata.Routes{
 	{Path: "/v1/tasks/complete", Method: "POST", Name: CompleteTaskRoute},
 	{Path: "/v1/tasks/resolving", Method: "POST", Name: ResolvingTaskRoute},
 	{Path: "/v1/tasks/delete", Method: "POST", Name: DeleteTaskRoute},
+	{Path: "/v1/tasks/bulk_desire", Method: "POST", Name: DesireTasksRoute},
+	{Path: "/v1/tasks/bulk_cancel", Method: "POST", Name: CancelTasksRoute},
+	{Path: "/v1/tasks/bulk_delete", Method: "POST", Name: DeleteTasksRoute},
 
 	{Path: "/v1/tasks/desire", Method: "POST", Name: DesireTaskRoute_r0}, // Deprecated
 
 	// Event Streaming
 	{Path: "/v1/events", Method: "GET", Name: EventStreamRoute_r0},
+	{Path: "/v1/events/tasks", Method: "GET", Name: TaskEventStreamRoute_r0},
 
 	// Cells
 	{Path: "/v1/cells/list.r1", Method: "POST", Name: CellsRoute},
 	{Path: "/v1/cells/list.r1", Method: "GET", Name: CellsRoute_r1}, // Deprecated
+
+	// Audit Log
+	{Path: "/v1/audit_events/list", Method: "POST", Name: AuditEventsRoute},
+
+	// Quarantine
+	{Path: "/v1/quarantined_records/list", Method: "POST", Name: QuarantinedRecordsRoute},
+	{Path: "/v1/quarantined_records/get", Method: "POST", Name: QuarantinedRecordRoute},
+	{Path: "/v1/quarantined_records/restore", Method: "POST", Name: RestoreQuarantinedRecordRoute},
+	{Path: "/v1/quarantined_records/restore_all", Method: "POST", Name: RestoreQuarantinedRecordsRoute},
+
+	// Encryption
+	{Path: "/v1/encryption/progress", Method: "POST", Name: EncryptionProgressRoute},
+	{Path: "/v1/encryption/verify_key_retired", Method: "POST", Name: VerifyEncryptionKeyRetiredRoute},
 }
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/events/eventfakes"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
 	"code.cloudfoundry.org/bbs/taskworkpool"
@@ -46,9 +47,12 @@ var _ = Describe("TaskWorker", func() {
 		var (
 			callbackURL string
 			taskDB      *dbfakes.FakeTaskDB
+			taskHub     *eventfakes.FakeHub
 			statusCodes chan int
 			task        *models.Task
 
+			resolvingTaskBefore, resolvingTaskAfter *models.Task
+
 			httpClient *http.Client
 		)
 
@@ -62,15 +66,22 @@ var _ = Describe("TaskWorker", func() {
 
 			callbackURL = fakeServer.URL() + "/the-callback/url"
 			taskDB = new(dbfakes.FakeTaskDB)
-			taskDB.ResolvingTaskReturns(nil)
-			taskDB.DeleteTaskReturns(nil)
+			taskHub = new(eventfakes.FakeHub)
+
+			resolvingTaskBefore = model_helpers.NewValidTask("the-task-guid")
+			resolvingTaskBefore.State = models.Task_Completed
+			resolvingTaskAfter = model_helpers.NewValidTask("the-task-guid")
+			resolvingTaskAfter.State = models.Task_Resolving
+
+			taskDB.ResolvingTaskReturns(resolvingTaskBefore, resolvingTaskAfter, nil)
+			taskDB.DeleteTaskReturns(resolvingTaskAfter, nil)
 		})
 
 		simulateTaskCompleting := func(signals <-chan os.Signal, ready chan<- struct{}) error {
 			close(ready)
 			task = model_helpers.NewValidTask("the-task-guid")
 			task.CompletionCallbackUrl = callbackURL
-			taskworkpool.HandleCompletedTask(logger, httpClient, taskDB, task)
+			taskworkpool.HandleCompletedTask(logger, httpClient, taskDB, taskHub, task)
 			return nil
 		}
 
@@ -96,14 +107,26 @@ var _ = Describe("TaskWorker", func() {
 				Expect(actualGuid).To(Equal("the-task-guid"))
 			})
 
+			It("emits a TaskChangedEvent to the hub", func() {
+				statusCodes <- 200
+
+				Eventually(taskHub.EmitCallCount).Should(BeNumerically(">=", 1))
+				event := taskHub.EmitArgsForCall(0)
+				Expect(event).To(Equal(models.NewTaskChangedEvent(resolvingTaskBefore, resolvingTaskAfter)))
+			})
+
 			Context("when marking the task as resolving fails", func() {
 				BeforeEach(func() {
-					taskDB.ResolvingTaskReturns(models.NewError(models.Error_UnknownError, "failed to resolve task"))
+					taskDB.ResolvingTaskReturns(nil, nil, models.NewError(models.Error_UnknownError, "failed to resolve task"))
 				})
 
 				It("does not make a request to the task's callback URL", func() {
 					Consistently(fakeServer.ReceivedRequests, 0.25).Should(BeEmpty())
 				})
+
+				It("does not emit an event", func() {
+					Consistently(taskHub.EmitCallCount, 0.25).Should(Equal(0))
+				})
 			})
 
 			Context("when marking the task as resolving succeeds", func() {
@@ -136,6 +159,14 @@ var _ = Describe("TaskWorker", func() {
 						_, actualGuid := taskDB.DeleteTaskArgsForCall(0)
 						Expect(actualGuid).To(Equal("the-task-guid"))
 					})
+
+					It("emits a TaskRemovedEvent to the hub", func() {
+						statusCodes <- 200
+
+						Eventually(taskHub.EmitCallCount).Should(Equal(2))
+						events := []models.Event{taskHub.EmitArgsForCall(0), taskHub.EmitArgsForCall(1)}
+						Expect(events).To(ContainElement(models.NewTaskRemovedEvent(resolvingTaskAfter)))
+					})
 				})
 
 				Context("when the request fails with a 4xx response code", func() {
@@ -203,7 +234,7 @@ var _ = Describe("TaskWorker", func() {
 
 				Context("when DeleteTask fails", func() {
 					BeforeEach(func() {
-						taskDB.DeleteTaskReturns(&models.Error{})
+						taskDB.DeleteTaskReturns(nil, &models.Error{})
 					})
 
 					It("logs an error and returns", func() {
@@ -213,6 +244,14 @@ var _ = Describe("TaskWorker", func() {
 						Eventually(taskDB.DeleteTaskCallCount).Should(Equal(1))
 						Eventually(logger.TestSink.LogMessages).Should(ContainElement("test.handle-completed-task.delete-task-failed"))
 					})
+
+					It("does not emit a TaskRemovedEvent", func() {
+						Eventually(fakeServer.ReceivedRequests).Should(HaveLen(1))
+						statusCodes <- 200
+
+						Eventually(taskDB.DeleteTaskCallCount).Should(Equal(1))
+						Consistently(taskHub.EmitCallCount, 0.25).Should(Equal(1))
+					})
 				})
 
 				Context("when the request fails with a timeout", func() {
@@ -11,6 +11,7 @@ import (
 const (
 	mysqlFlavor    = "mysql"
 	postgresFlavor = "postgres"
+	sqliteFlavor   = "sqlite3"
 )
 
 func UseSQL() bool {
@@ -33,6 +34,10 @@ func UsePostgres() bool {
 	return driver() == postgresFlavor
 }
 
+func UseSQLite() bool {
+	return driver() == sqliteFlavor
+}
+
 func NewSQLRunner(dbName string) sqlrunner.SQLRunner {
 	var sqlRunner sqlrunner.SQLRunner
 
@@ -40,6 +45,8 @@ func NewSQLRunner(dbName string) sqlrunner.SQLRunner {
 		sqlRunner = sqlrunner.NewMySQLRunner(dbName)
 	} else if UsePostgres() {
 		sqlRunner = sqlrunner.NewPostgresRunner(dbName)
+	} else if UseSQLite() {
+		sqlRunner = sqlrunner.NewSQLiteRunner(dbName)
 	} else {
 		panic(fmt.Sprintf("driver '%s' is not supported", driver()))
 	}
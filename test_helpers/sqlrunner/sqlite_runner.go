@@ -0,0 +1,79 @@
+package sqlrunner
+
+import (
+	"database/sql"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	_ "github.com/mattn/go-sqlite3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// SQLiteRunner is responsible for creating and tearing down a test database
+// in a temporary file. Unlike the other runners it needs no database service.
+type SQLiteRunner struct {
+	sqlDBName string
+	dir       string
+	db        *sql.DB
+}
+
+func NewSQLiteRunner(sqlDBName string) *SQLiteRunner {
+	return &SQLiteRunner{
+		sqlDBName: sqlDBName,
+	}
+}
+
+func (s *SQLiteRunner) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	defer GinkgoRecover()
+
+	var err error
+	s.dir, err = ioutil.TempDir("", "bbs-sqlite")
+	Expect(err).NotTo(HaveOccurred())
+
+	s.db, err = sql.Open("sqlite3", s.ConnectionString())
+	Expect(err).NotTo(HaveOccurred())
+	Expect(s.db.Ping()).NotTo(HaveOccurred())
+
+	close(ready)
+
+	<-signals
+
+	Expect(s.db.Close()).To(Succeed())
+	s.db = nil
+	Expect(os.RemoveAll(s.dir)).To(Succeed())
+
+	return nil
+}
+
+func (s *SQLiteRunner) ConnectionString() string {
+	return filepath.Join(s.dir, s.sqlDBName+".db")
+}
+
+func (s *SQLiteRunner) DriverName() string {
+	return "sqlite3"
+}
+
+func (s *SQLiteRunner) DB() *sql.DB {
+	return s.db
+}
+
+func (s *SQLiteRunner) ResetTables(tables []string) {
+	for _, name := range tables {
+		query := fmt.Sprintf("DELETE FROM %s", name)
+		_, err := s.db.Exec(query)
+		if err != nil && strings.HasPrefix(err.Error(), "no such table") {
+			// missing table error, it's fine because we're trying to empty it
+			continue
+		}
+
+		Expect(err).NotTo(HaveOccurred())
+	}
+}
+
+func (s *SQLiteRunner) Reset() {
+	s.ResetTables([]string{"domains", "configurations", "tasks", "desired_lrps", "actual_lrps", "locks"})
+}
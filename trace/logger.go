@@ -0,0 +1,43 @@
+package trace
+
+import "code.cloudfoundry.org/lager"
+
+// traceLogger carries the trace of a request along with the loggers passed
+// down from its handler, so that the clients called while serving it can pass
+// the trace on.
+type traceLogger struct {
+	lager.Logger
+	trace Trace
+}
+
+// WithTrace returns a logger that logs the ids of t, and whose sessions carry
+// t for FromLogger.
+func WithTrace(logger lager.Logger, t Trace) lager.Logger {
+	return traceLogger{
+		Logger: logger.WithData(t.LagerData()),
+		trace:  t,
+	}
+}
+
+// FromLogger returns the trace carried by logger, if it was derived from a
+// logger returned by WithTrace.
+func FromLogger(logger lager.Logger) (Trace, bool) {
+	if l, ok := logger.(traceLogger); ok {
+		return l.trace, true
+	}
+	return Trace{}, false
+}
+
+func (l traceLogger) Session(task string, data ...lager.Data) lager.Logger {
+	return traceLogger{
+		Logger: l.Logger.Session(task, data...),
+		trace:  l.trace,
+	}
+}
+
+func (l traceLogger) WithData(data lager.Data) lager.Logger {
+	return traceLogger{
+		Logger: l.Logger.WithData(data),
+		trace:  l.trace,
+	}
+}
@@ -0,0 +1 @@
+package trace // import "code.cloudfoundry.org/bbs/trace"
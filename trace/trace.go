@@ -0,0 +1,167 @@
+package trace
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/bbs/guidprovider"
+	"code.cloudfoundry.org/lager"
+)
+
+const (
+	RequestIDHeader   = "X-Request-Id"
+	TraceparentHeader = "traceparent"
+
+	maxRequestIDLength = 200
+
+	traceparentVersion = "00"
+	traceIDLength      = 16
+	spanIDLength       = 8
+)
+
+// Trace identifies a request across the BBS and the components it calls. The
+// RequestID is passed on in the X-Request-Id header, and the TraceID and
+// SpanID in a W3C traceparent header.
+type Trace struct {
+	RequestID string
+	TraceID   string
+	SpanID    string
+	Flags     string
+}
+
+// New starts a trace with a new request id and trace id.
+func New() Trace {
+	return Trace{
+		RequestID: newRequestID(),
+		TraceID:   randomHex(traceIDLength),
+		SpanID:    randomHex(spanIDLength),
+		Flags:     "00",
+	}
+}
+
+// FromRequest continues the trace of the X-Request-Id and traceparent headers
+// of req in a new span. Missing or malformed headers are replaced with new
+// ids.
+func FromRequest(req *http.Request) Trace {
+	t := New()
+
+	requestID := req.Header.Get(RequestIDHeader)
+	if validRequestID(requestID) {
+		t.RequestID = requestID
+	}
+
+	traceID, flags, ok := parseTraceparent(req.Header.Get(TraceparentHeader))
+	if ok {
+		t.TraceID = traceID
+		t.Flags = flags
+	}
+
+	return t
+}
+
+// Traceparent returns the traceparent header naming the span of t as the
+// parent of the request being made.
+func (t Trace) Traceparent() string {
+	return fmt.Sprintf("%s-%s-%s-%s", traceparentVersion, t.TraceID, t.SpanID, t.Flags)
+}
+
+// SetHeaders sets the X-Request-Id and traceparent headers of an outgoing
+// request made as part of t.
+func (t Trace) SetHeaders(header http.Header) {
+	header.Set(RequestIDHeader, t.RequestID)
+	header.Set(TraceparentHeader, t.Traceparent())
+}
+
+func (t Trace) LagerData() lager.Data {
+	return lager.Data{
+		"request-id": t.RequestID,
+		"trace-id":   t.TraceID,
+		"span-id":    t.SpanID,
+	}
+}
+
+type traceKey struct{}
+
+// NewContext returns a copy of ctx carrying t, so that the handlers serving a
+// request share the trace started for it.
+func NewContext(ctx context.Context, t Trace) context.Context {
+	return context.WithValue(ctx, traceKey{}, t)
+}
+
+func FromContext(ctx context.Context) (Trace, bool) {
+	t, ok := ctx.Value(traceKey{}).(Trace)
+	return t, ok
+}
+
+func newRequestID() string {
+	guid, err := guidprovider.DefaultGuidProvider.NextGUID()
+	if err != nil {
+		return randomHex(traceIDLength)
+	}
+	return guid
+}
+
+func randomHex(length int) string {
+	id := make([]byte, length)
+	_, err := rand.Read(id)
+	if err != nil {
+		panic(err) // the system's source of randomness is broken
+	}
+	return hex.EncodeToString(id)
+}
+
+func validRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+
+	for _, r := range requestID {
+		if r < '!' || r > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// parseTraceparent returns the trace id and flags of a version 00 traceparent
+// header, and whether the header is valid.
+func parseTraceparent(traceparent string) (string, string, bool) {
+	parts := strings.Split(traceparent, "-")
+	if len(parts) != 4 || parts[0] != traceparentVersion {
+		return "", "", false
+	}
+
+	traceID, parentID, flags := parts[1], parts[2], parts[3]
+	if !validHex(traceID, traceIDLength) || !validHex(parentID, spanIDLength) || !validHex(flags, 1) {
+		return "", "", false
+	}
+
+	return traceID, flags, true
+}
+
+// validHex reports whether id is the lower case hex encoding of length bytes,
+// not all of them zero.
+func validHex(id string, length int) bool {
+	if len(id) != 2*length || strings.ToLower(id) != id {
+		return false
+	}
+
+	decoded, err := hex.DecodeString(id)
+	if err != nil {
+		return false
+	}
+
+	if length == 1 {
+		return true
+	}
+	for _, b := range decoded {
+		if b != 0 {
+			return true
+		}
+	}
+	return false
+}
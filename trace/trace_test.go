@@ -0,0 +1,127 @@
+package trace_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/trace"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/onsi/gomega/gbytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Trace", func() {
+	Describe("New", func() {
+		It("starts a trace with new ids", func() {
+			t := trace.New()
+			Expect(t.RequestID).NotTo(BeEmpty())
+			Expect(t.TraceID).To(MatchRegexp("^[0-9a-f]{32}$"))
+			Expect(t.SpanID).To(MatchRegexp("^[0-9a-f]{16}$"))
+			Expect(t.Flags).To(Equal("00"))
+
+			Expect(trace.New().RequestID).NotTo(Equal(t.RequestID))
+			Expect(trace.New().TraceID).NotTo(Equal(t.TraceID))
+		})
+	})
+
+	Describe("FromRequest", func() {
+		var req *http.Request
+
+		BeforeEach(func() {
+			var err error
+			req, err = http.NewRequest("GET", "http://example.com", nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("continues the trace in the headers in a new span", func() {
+			req.Header.Set("X-Request-Id", "some-request-id")
+			req.Header.Set("traceparent", "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+
+			t := trace.FromRequest(req)
+			Expect(t.RequestID).To(Equal("some-request-id"))
+			Expect(t.TraceID).To(Equal("0af7651916cd43dd8448eb211c80319c"))
+			Expect(t.SpanID).To(MatchRegexp("^[0-9a-f]{16}$"))
+			Expect(t.SpanID).NotTo(Equal("b7ad6b7169203331"))
+			Expect(t.Flags).To(Equal("01"))
+		})
+
+		It("starts a new trace when there are no headers", func() {
+			t := trace.FromRequest(req)
+			Expect(t.RequestID).NotTo(BeEmpty())
+			Expect(t.TraceID).To(MatchRegexp("^[0-9a-f]{32}$"))
+		})
+
+		DescribeTable("ignores malformed headers",
+			func(requestID, traceparent string) {
+				req.Header.Set("X-Request-Id", requestID)
+				req.Header.Set("traceparent", traceparent)
+
+				t := trace.FromRequest(req)
+				Expect(t.RequestID).NotTo(Equal(requestID))
+				Expect(t.TraceID).NotTo(Equal("0af7651916cd43dd8448eb211c80319c"))
+			},
+			Entry("spaces and an unknown version", "some request id", "01-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"),
+			Entry("a short trace id", "", "00-0af7651916cd43dd8448eb21-b7ad6b7169203331-01"),
+			Entry("upper case hex", "", "00-0AF7651916CD43DD8448EB211C80319C-b7ad6b7169203331-01"),
+			Entry("a zero parent id", "", "00-0af7651916cd43dd8448eb211c80319c-0000000000000000-01"),
+			Entry("missing flags", "", "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331"),
+		)
+
+		It("ignores an all zero trace id", func() {
+			req.Header.Set("traceparent", "00-00000000000000000000000000000000-b7ad6b7169203331-01")
+			Expect(trace.FromRequest(req).TraceID).NotTo(Equal("00000000000000000000000000000000"))
+		})
+	})
+
+	Describe("SetHeaders", func() {
+		It("names the span of the trace as the parent", func() {
+			t := trace.Trace{
+				RequestID: "some-request-id",
+				TraceID:   "0af7651916cd43dd8448eb211c80319c",
+				SpanID:    "b7ad6b7169203331",
+				Flags:     "01",
+			}
+
+			header := http.Header{}
+			t.SetHeaders(header)
+			Expect(header.Get("X-Request-Id")).To(Equal("some-request-id"))
+			Expect(header.Get("traceparent")).To(Equal("00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"))
+		})
+	})
+
+	Describe("WithTrace", func() {
+		var (
+			logger *lagertest.TestLogger
+			t      trace.Trace
+		)
+
+		BeforeEach(func() {
+			logger = lagertest.NewTestLogger("test")
+			t = trace.New()
+		})
+
+		It("logs the ids of the trace", func() {
+			trace.WithTrace(logger, t).Info("some-message")
+			Expect(logger.Buffer()).To(gbytes.Say(`"request-id":"` + t.RequestID + `"`))
+		})
+
+		It("carries the trace through sessions and data", func() {
+			traced := trace.WithTrace(logger, t).Session("some-session").WithData(lager.Data{"some": "data"})
+
+			fromLogger, ok := trace.FromLogger(traced)
+			Expect(ok).To(BeTrue())
+			Expect(fromLogger).To(Equal(t))
+
+			traced.Info("some-message")
+			Expect(logger.Buffer()).To(gbytes.Say(`test.some-session.some-message.*"request-id":"` + t.RequestID + `"`))
+		})
+
+		It("finds no trace on other loggers", func() {
+			_, ok := trace.FromLogger(logger.Session("some-session"))
+			Expect(ok).To(BeFalse())
+		})
+	})
+})
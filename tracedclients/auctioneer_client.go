@@ -0,0 +1,70 @@
+package tracedclients
+
+import (
+	"net/http"
+	"net/url"
+
+	"code.cloudfoundry.org/auctioneer"
+	"code.cloudfoundry.org/cfhttp"
+	"code.cloudfoundry.org/lager"
+)
+
+// auctioneerClient requests auctions as the auctioneer's own client does, and
+// passes the trace of the logger it is called with on to the auctioneer.
+type auctioneerClient struct {
+	httpClient         *http.Client
+	insecureHTTPClient *http.Client
+	url                string
+	requireTLS         bool
+}
+
+// NewAuctioneerClient returns a client for the auctioneer at auctioneerURL.
+func NewAuctioneerClient(auctioneerURL string) auctioneer.Client {
+	return &auctioneerClient{
+		httpClient: cfhttp.NewClient(),
+		url:        auctioneerURL,
+	}
+}
+
+// NewSecureAuctioneerClient returns a client for the auctioneer at
+// auctioneerURL that authenticates with the given certificate. Unless
+// requireTLS is set, requests the auctioneer does not accept over TLS are
+// retried over plain HTTP.
+func NewSecureAuctioneerClient(auctioneerURL, caFile, certFile, keyFile string, requireTLS bool) (auctioneer.Client, error) {
+	httpClient, err := newTLSClient(certFile, keyFile, caFile, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &auctioneerClient{
+		httpClient:         httpClient,
+		insecureHTTPClient: cfhttp.NewClient(),
+		url:                auctioneerURL,
+		requireTLS:         requireTLS,
+	}, nil
+}
+
+func (c *auctioneerClient) RequestLRPAuctions(logger lager.Logger, lrpStarts []*auctioneer.LRPStartRequest) error {
+	logger = logger.Session("request-lrp-auctions")
+	return c.requestAuctions(logger, auctioneer.CreateLRPAuctionsRoute, lrpStarts)
+}
+
+func (c *auctioneerClient) RequestTaskAuctions(logger lager.Logger, tasks []*auctioneer.TaskStartRequest) error {
+	logger = logger.Session("request-task-auctions")
+	return c.requestAuctions(logger, auctioneer.CreateTaskAuctionsRoute, tasks)
+}
+
+func (c *auctioneerClient) requestAuctions(logger lager.Logger, route string, starts interface{}) error {
+	err := doRequest(logger, c.httpClient, c.url, auctioneer.Routes, route, nil, starts)
+	if err == nil || c.requireTLS || c.insecureHTTPClient == nil {
+		return err
+	}
+
+	logger.Info("retrying-over-http", lager.Data{"error": err.Error()})
+	insecureURL, parseErr := url.Parse(c.url)
+	if parseErr != nil {
+		return err
+	}
+	insecureURL.Scheme = "http"
+	return doRequest(logger, c.insecureHTTPClient, insecureURL.String(), auctioneer.Routes, route, nil, starts)
+}
@@ -0,0 +1,99 @@
+package tracedclients_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/auctioneer"
+	"code.cloudfoundry.org/bbs/trace"
+	"code.cloudfoundry.org/bbs/tracedclients"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("AuctioneerClient", func() {
+	var (
+		logger           *lagertest.TestLogger
+		fakeAuctioneer   *ghttp.Server
+		auctioneerClient auctioneer.Client
+		requestTrace     trace.Trace
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeAuctioneer = ghttp.NewServer()
+		auctioneerClient = tracedclients.NewAuctioneerClient(fakeAuctioneer.URL())
+		requestTrace = trace.New()
+	})
+
+	AfterEach(func() {
+		fakeAuctioneer.Close()
+	})
+
+	Describe("RequestLRPAuctions", func() {
+		var lrpStarts []*auctioneer.LRPStartRequest
+
+		BeforeEach(func() {
+			lrpStarts = []*auctioneer.LRPStartRequest{{ProcessGuid: "some-guid", Domain: "some-domain", Indices: []int{0, 1}}}
+			fakeAuctioneer.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("POST", "/v1/lrps"),
+				ghttp.VerifyJSONRepresenting(lrpStarts),
+				ghttp.VerifyHeaderKV(trace.RequestIDHeader, requestTrace.RequestID),
+				ghttp.VerifyHeaderKV(trace.TraceparentHeader, requestTrace.Traceparent()),
+				ghttp.RespondWith(http.StatusAccepted, nil),
+			))
+		})
+
+		It("passes the trace of the logger on to the auctioneer", func() {
+			err := auctioneerClient.RequestLRPAuctions(trace.WithTrace(logger, requestTrace), lrpStarts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeAuctioneer.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
+	Describe("RequestTaskAuctions", func() {
+		var tasks []*auctioneer.TaskStartRequest
+
+		BeforeEach(func() {
+			tasks = []*auctioneer.TaskStartRequest{{}}
+			tasks[0].TaskGuid = "some-task-guid"
+		})
+
+		It("passes the trace of the logger on to the auctioneer", func() {
+			fakeAuctioneer.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("POST", "/v1/tasks"),
+				ghttp.VerifyJSONRepresenting(tasks),
+				ghttp.VerifyHeaderKV(trace.RequestIDHeader, requestTrace.RequestID),
+				ghttp.VerifyHeaderKV(trace.TraceparentHeader, requestTrace.Traceparent()),
+				ghttp.RespondWith(http.StatusAccepted, nil),
+			))
+
+			err := auctioneerClient.RequestTaskAuctions(trace.WithTrace(logger, requestTrace), tasks)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeAuctioneer.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		Context("when the logger carries no trace", func() {
+			It("starts a new trace for the request", func() {
+				fakeAuctioneer.AppendHandlers(ghttp.RespondWith(http.StatusAccepted, nil))
+
+				err := auctioneerClient.RequestTaskAuctions(logger, tasks)
+				Expect(err).NotTo(HaveOccurred())
+
+				request := fakeAuctioneer.ReceivedRequests()[0]
+				Expect(request.Header.Get(trace.RequestIDHeader)).NotTo(BeEmpty())
+				Expect(request.Header.Get(trace.TraceparentHeader)).NotTo(BeEmpty())
+			})
+		})
+
+		Context("when the auctioneer does not accept the request", func() {
+			It("returns an error", func() {
+				fakeAuctioneer.AppendHandlers(ghttp.RespondWith(http.StatusInternalServerError, nil))
+
+				err := auctioneerClient.RequestTaskAuctions(logger, tasks)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
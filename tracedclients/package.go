@@ -0,0 +1 @@
+package tracedclients // import "code.cloudfoundry.org/bbs/tracedclients"
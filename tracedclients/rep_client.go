@@ -0,0 +1,112 @@
+package tracedclients
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/rep"
+	"github.com/tedsuo/rata"
+)
+
+var ErrRepTLSRequired = errors.New("the cell does not serve its rep over TLS and TLS is required")
+
+//go:generate counterfeiter . RepClientFactory
+
+// RepClientFactory creates the clients the BBS uses to stop instances and
+// cancel tasks on the cells.
+type RepClientFactory interface {
+	// CreateClient returns a client for the rep at url, or at address if the
+	// rep has no TLS url, whose requests pass on the trace of logger.
+	CreateClient(logger lager.Logger, address, url string) (RepClient, error)
+}
+
+//go:generate counterfeiter . RepClient
+
+type RepClient interface {
+	StopLRPInstance(key models.ActualLRPKey, instanceKey models.ActualLRPInstanceKey) error
+	CancelTask(taskGuid string) error
+}
+
+type repClientFactory struct {
+	httpClient    *http.Client
+	tlsHTTPClient *http.Client
+	requireTLS    bool
+}
+
+// NewRepClientFactory returns a factory for clients that call the rep over
+// TLS when tlsConfig names a certificate, and over plain HTTP with httpClient
+// otherwise.
+func NewRepClientFactory(httpClient *http.Client, tlsConfig *rep.TLSConfig) (RepClientFactory, error) {
+	factory := &repClientFactory{httpClient: httpClient}
+	if tlsConfig == nil {
+		return factory, nil
+	}
+
+	factory.requireTLS = tlsConfig.RequireTLS
+	if tlsConfig.CertFile == "" && tlsConfig.KeyFile == "" && tlsConfig.CaCertFile == "" {
+		if factory.requireTLS {
+			return nil, ErrRepTLSRequired
+		}
+		return factory, nil
+	}
+
+	var err error
+	factory.tlsHTTPClient, err = newTLSClient(tlsConfig.CertFile, tlsConfig.KeyFile, tlsConfig.CaCertFile, tlsConfig.ClientCacheSize)
+	if err != nil {
+		return nil, err
+	}
+	return factory, nil
+}
+
+func (f *repClientFactory) CreateClient(logger lager.Logger, address, url string) (RepClient, error) {
+	client := &repClient{logger: logger, httpClient: f.httpClient, address: address}
+	if f.tlsHTTPClient != nil && url != "" {
+		client.tlsHTTPClient = f.tlsHTTPClient
+		client.url = url
+		client.requireTLS = f.requireTLS
+	} else if f.requireTLS {
+		return nil, ErrRepTLSRequired
+	}
+	return client, nil
+}
+
+// repClient calls the rep as the rep's own client does, over TLS when it has
+// a url and, unless TLS is required, over plain HTTP at its address when the
+// TLS request fails.
+type repClient struct {
+	logger        lager.Logger
+	httpClient    *http.Client
+	tlsHTTPClient *http.Client
+	address       string
+	url           string
+	requireTLS    bool
+}
+
+func (c *repClient) StopLRPInstance(key models.ActualLRPKey, instanceKey models.ActualLRPInstanceKey) error {
+	return c.doRequest(c.logger.Session("stop-lrp-instance"), rep.StopLRPInstanceRoute, rata.Params{
+		"process_guid":  key.ProcessGuid,
+		"instance_guid": instanceKey.InstanceGuid,
+		"index":         strconv.Itoa(int(key.Index)),
+	})
+}
+
+func (c *repClient) CancelTask(taskGuid string) error {
+	return c.doRequest(c.logger.Session("cancel-task"), rep.CancelTaskRoute, rata.Params{"task_guid": taskGuid})
+}
+
+func (c *repClient) doRequest(logger lager.Logger, route string, params rata.Params) error {
+	if c.tlsHTTPClient == nil {
+		return doRequest(logger, c.httpClient, c.address, rep.Routes, route, params, nil)
+	}
+
+	err := doRequest(logger, c.tlsHTTPClient, c.url, rep.Routes, route, params, nil)
+	if err == nil || c.requireTLS || c.address == "" {
+		return err
+	}
+
+	logger.Info("retrying-over-http", lager.Data{"error": err.Error()})
+	return doRequest(logger, c.httpClient, c.address, rep.Routes, route, params, nil)
+}
@@ -0,0 +1,86 @@
+package tracedclients_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/trace"
+	"code.cloudfoundry.org/bbs/tracedclients"
+	"code.cloudfoundry.org/cfhttp"
+	"code.cloudfoundry.org/lager/lagertest"
+	"code.cloudfoundry.org/rep"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("RepClient", func() {
+	var (
+		logger       *lagertest.TestLogger
+		fakeRep      *ghttp.Server
+		factory      tracedclients.RepClientFactory
+		repClient    tracedclients.RepClient
+		requestTrace trace.Trace
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeRep = ghttp.NewServer()
+		requestTrace = trace.New()
+
+		var err error
+		factory, err = tracedclients.NewRepClientFactory(cfhttp.NewClient(), &rep.TLSConfig{})
+		Expect(err).NotTo(HaveOccurred())
+
+		repClient, err = factory.CreateClient(trace.WithTrace(logger, requestTrace), fakeRep.URL(), "")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		fakeRep.Close()
+	})
+
+	Describe("StopLRPInstance", func() {
+		It("passes the trace of the logger on to the rep", func() {
+			fakeRep.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("POST", "/v1/lrps/some-guid/instances/some-instance-guid/stop"),
+				ghttp.VerifyHeaderKV(trace.RequestIDHeader, requestTrace.RequestID),
+				ghttp.VerifyHeaderKV(trace.TraceparentHeader, requestTrace.Traceparent()),
+				ghttp.RespondWith(http.StatusAccepted, nil),
+			))
+
+			key := models.NewActualLRPKey("some-guid", 2, "some-domain")
+			instanceKey := models.NewActualLRPInstanceKey("some-instance-guid", "some-cell")
+			Expect(repClient.StopLRPInstance(key, instanceKey)).To(Succeed())
+			Expect(fakeRep.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
+	Describe("CancelTask", func() {
+		It("passes the trace of the logger on to the rep", func() {
+			fakeRep.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("POST", "/v1/tasks/some-task-guid/cancel"),
+				ghttp.VerifyHeaderKV(trace.RequestIDHeader, requestTrace.RequestID),
+				ghttp.VerifyHeaderKV(trace.TraceparentHeader, requestTrace.Traceparent()),
+				ghttp.RespondWith(http.StatusAccepted, nil),
+			))
+
+			Expect(repClient.CancelTask("some-task-guid")).To(Succeed())
+			Expect(fakeRep.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		Context("when the rep does not accept the request", func() {
+			It("returns an error", func() {
+				fakeRep.AppendHandlers(ghttp.RespondWith(http.StatusNotFound, nil))
+				Expect(repClient.CancelTask("some-task-guid")).NotTo(Succeed())
+			})
+		})
+	})
+
+	Context("when TLS is required but no certificate is configured", func() {
+		It("fails to create the factory", func() {
+			_, err := tracedclients.NewRepClientFactory(cfhttp.NewClient(), &rep.TLSConfig{RequireTLS: true})
+			Expect(err).To(Equal(tracedclients.ErrRepTLSRequired))
+		})
+	})
+})
@@ -0,0 +1,81 @@
+package tracedclients
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/trace"
+	"code.cloudfoundry.org/cfhttp"
+	"code.cloudfoundry.org/lager"
+	"github.com/tedsuo/rata"
+)
+
+// newTLSClient returns an HTTP client that presents the given certificate and
+// trusts the given CA.
+func newTLSClient(certFile, keyFile, caFile string, sessionCacheSize int) (*http.Client, error) {
+	tlsConfig, err := cfhttp.NewTLSConfig(certFile, keyFile, caFile)
+	if err != nil {
+		return nil, err
+	}
+	if sessionCacheSize > 0 {
+		tlsConfig.ClientSessionCache = tls.NewLRUClientSessionCache(sessionCacheSize)
+	}
+
+	client := cfhttp.NewClient()
+	transport, ok := client.Transport.(*http.Transport)
+	if !ok {
+		transport = &http.Transport{}
+	}
+	transport.TLSClientConfig = tlsConfig
+	client.Transport = transport
+	return client, nil
+}
+
+// doRequest makes the request for the named route of routes at baseURL with
+// body as its JSON payload. The request carries the X-Request-Id and
+// traceparent headers of the trace of logger, or of a new trace if logger
+// has none, and fails unless it is accepted.
+func doRequest(logger lager.Logger, httpClient *http.Client, baseURL string, routes rata.Routes, route string, params rata.Params, body interface{}) error {
+	var payload []byte
+	if body != nil {
+		var err error
+		payload, err = json.Marshal(body)
+		if err != nil {
+			return err
+		}
+	}
+
+	request, err := rata.NewRequestGenerator(baseURL, routes).CreateRequest(route, params, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	requestTrace, ok := trace.FromLogger(logger)
+	if !ok {
+		requestTrace = trace.New()
+	}
+	requestTrace.SetHeaders(request.Header)
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// drain the body so that the connection can be reused
+		_, _ = io.Copy(ioutil.Discard, response.Body)
+		_ = response.Body.Close()
+	}()
+
+	if response.StatusCode != http.StatusAccepted {
+		return fmt.Errorf("http error: status code %d (%s)", response.StatusCode, http.StatusText(response.StatusCode))
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+package tracedclients_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestTracedClients(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Traced Clients Suite")
+}
@@ -0,0 +1,122 @@
+// This file was generated by counterfeiter
+package tracedclientsfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/tracedclients"
+)
+
+type FakeRepClient struct {
+	StopLRPInstanceStub        func(key models.ActualLRPKey, instanceKey models.ActualLRPInstanceKey) error
+	stopLRPInstanceMutex       sync.RWMutex
+	stopLRPInstanceArgsForCall []struct {
+		key         models.ActualLRPKey
+		instanceKey models.ActualLRPInstanceKey
+	}
+	stopLRPInstanceReturns struct {
+		result1 error
+	}
+	CancelTaskStub        func(taskGuid string) error
+	cancelTaskMutex       sync.RWMutex
+	cancelTaskArgsForCall []struct {
+		taskGuid string
+	}
+	cancelTaskReturns struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRepClient) StopLRPInstance(key models.ActualLRPKey, instanceKey models.ActualLRPInstanceKey) error {
+	fake.stopLRPInstanceMutex.Lock()
+	fake.stopLRPInstanceArgsForCall = append(fake.stopLRPInstanceArgsForCall, struct {
+		key         models.ActualLRPKey
+		instanceKey models.ActualLRPInstanceKey
+	}{key, instanceKey})
+	fake.recordInvocation("StopLRPInstance", []interface{}{key, instanceKey})
+	fake.stopLRPInstanceMutex.Unlock()
+	if fake.StopLRPInstanceStub != nil {
+		return fake.StopLRPInstanceStub(key, instanceKey)
+	} else {
+		return fake.stopLRPInstanceReturns.result1
+	}
+}
+
+func (fake *FakeRepClient) StopLRPInstanceCallCount() int {
+	fake.stopLRPInstanceMutex.RLock()
+	defer fake.stopLRPInstanceMutex.RUnlock()
+	return len(fake.stopLRPInstanceArgsForCall)
+}
+
+func (fake *FakeRepClient) StopLRPInstanceArgsForCall(i int) (models.ActualLRPKey, models.ActualLRPInstanceKey) {
+	fake.stopLRPInstanceMutex.RLock()
+	defer fake.stopLRPInstanceMutex.RUnlock()
+	return fake.stopLRPInstanceArgsForCall[i].key, fake.stopLRPInstanceArgsForCall[i].instanceKey
+}
+
+func (fake *FakeRepClient) StopLRPInstanceReturns(result1 error) {
+	fake.StopLRPInstanceStub = nil
+	fake.stopLRPInstanceReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepClient) CancelTask(taskGuid string) error {
+	fake.cancelTaskMutex.Lock()
+	fake.cancelTaskArgsForCall = append(fake.cancelTaskArgsForCall, struct {
+		taskGuid string
+	}{taskGuid})
+	fake.recordInvocation("CancelTask", []interface{}{taskGuid})
+	fake.cancelTaskMutex.Unlock()
+	if fake.CancelTaskStub != nil {
+		return fake.CancelTaskStub(taskGuid)
+	} else {
+		return fake.cancelTaskReturns.result1
+	}
+}
+
+func (fake *FakeRepClient) CancelTaskCallCount() int {
+	fake.cancelTaskMutex.RLock()
+	defer fake.cancelTaskMutex.RUnlock()
+	return len(fake.cancelTaskArgsForCall)
+}
+
+func (fake *FakeRepClient) CancelTaskArgsForCall(i int) string {
+	fake.cancelTaskMutex.RLock()
+	defer fake.cancelTaskMutex.RUnlock()
+	return fake.cancelTaskArgsForCall[i].taskGuid
+}
+
+func (fake *FakeRepClient) CancelTaskReturns(result1 error) {
+	fake.CancelTaskStub = nil
+	fake.cancelTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.stopLRPInstanceMutex.RLock()
+	defer fake.stopLRPInstanceMutex.RUnlock()
+	fake.cancelTaskMutex.RLock()
+	defer fake.cancelTaskMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeRepClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ tracedclients.RepClient = new(FakeRepClient)
@@ -0,0 +1,83 @@
+// This file was generated by counterfeiter
+package tracedclientsfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/bbs/tracedclients"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakeRepClientFactory struct {
+	CreateClientStub        func(logger lager.Logger, address, url string) (tracedclients.RepClient, error)
+	createClientMutex       sync.RWMutex
+	createClientArgsForCall []struct {
+		logger  lager.Logger
+		address string
+		url     string
+	}
+	createClientReturns struct {
+		result1 tracedclients.RepClient
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRepClientFactory) CreateClient(logger lager.Logger, address string, url string) (tracedclients.RepClient, error) {
+	fake.createClientMutex.Lock()
+	fake.createClientArgsForCall = append(fake.createClientArgsForCall, struct {
+		logger  lager.Logger
+		address string
+		url     string
+	}{logger, address, url})
+	fake.recordInvocation("CreateClient", []interface{}{logger, address, url})
+	fake.createClientMutex.Unlock()
+	if fake.CreateClientStub != nil {
+		return fake.CreateClientStub(logger, address, url)
+	} else {
+		return fake.createClientReturns.result1, fake.createClientReturns.result2
+	}
+}
+
+func (fake *FakeRepClientFactory) CreateClientCallCount() int {
+	fake.createClientMutex.RLock()
+	defer fake.createClientMutex.RUnlock()
+	return len(fake.createClientArgsForCall)
+}
+
+func (fake *FakeRepClientFactory) CreateClientArgsForCall(i int) (lager.Logger, string, string) {
+	fake.createClientMutex.RLock()
+	defer fake.createClientMutex.RUnlock()
+	return fake.createClientArgsForCall[i].logger, fake.createClientArgsForCall[i].address, fake.createClientArgsForCall[i].url
+}
+
+func (fake *FakeRepClientFactory) CreateClientReturns(result1 tracedclients.RepClient, result2 error) {
+	fake.CreateClientStub = nil
+	fake.createClientReturns = struct {
+		result1 tracedclients.RepClient
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepClientFactory) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createClientMutex.RLock()
+	defer fake.createClientMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeRepClientFactory) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ tracedclients.RepClientFactory = new(FakeRepClientFactory)